@@ -0,0 +1,134 @@
+package azure
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/caarlos0/env/v11"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/action/common"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+)
+
+const apiVersion = "7.1"
+
+// pullRequestEnv is the environment of an Azure Pipelines run for a pull request.
+// https://learn.microsoft.com/en-us/azure/devops/pipelines/build/variables
+type pullRequestEnv struct {
+	CollectionURI string `env:"SYSTEM_COLLECTIONURI,required,notEmpty"`
+	ProjectID     string `env:"SYSTEM_TEAMPROJECTID,required,notEmpty"`
+	RepositoryID  string `env:"BUILD_REPOSITORY_ID,required,notEmpty"`
+	PullRequestID string `env:"SYSTEM_PULLREQUEST_PULLREQUESTID"`
+	// The job access token must be mapped to the environment explicitly in the pipeline.
+	Token string `env:"SYSTEM_ACCESSTOKEN"`
+}
+
+type thread struct {
+	ID       int64           `json:"id"`
+	Comments []threadComment `json:"comments"`
+}
+
+type threadComment struct {
+	ID              int64  `json:"id,omitempty"`
+	ParentCommentID int64  `json:"parentCommentId"`
+	Content         string `json:"content"`
+	// 1 is text.
+	CommentType int  `json:"commentType"`
+	IsDeleted   bool `json:"isDeleted,omitempty"`
+}
+
+// UpsertPullRequestComment creates or updates the SQL review comment thread on the pull request.
+func UpsertPullRequestComment(resp *v1pb.CheckReleaseResponse) error {
+	pre, err := env.ParseAs[pullRequestEnv]()
+	if err != nil {
+		return errors.Wrap(err, "failed to parse Azure DevOps environment variables")
+	}
+	if pre.PullRequestID == "" {
+		fmt.Println("not a pull request build, will not create a comment.")
+		return nil
+	}
+	if pre.Token == "" {
+		fmt.Println("SYSTEM_ACCESSTOKEN is not set, will not create a comment.")
+		return nil
+	}
+	return upsertPullRequestComment(resp, &pre)
+}
+
+func upsertPullRequestComment(resp *v1pb.CheckReleaseResponse, pre *pullRequestEnv) error {
+	client := &http.Client{}
+	threadsURL := fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullRequests/%s/threads",
+		strings.TrimSuffix(pre.CollectionURI, "/"), pre.ProjectID, pre.RepositoryID, pre.PullRequestID)
+	message := common.BuildMarkdownCommentMessage(resp)
+
+	var threads struct {
+		Value []thread `json:"value"`
+	}
+	if err := sendJSONRequest(client, http.MethodGet, threadsURL, pre.Token, nil, &threads); err != nil {
+		return errors.Wrapf(err, "failed to list pull request threads")
+	}
+	for _, t := range threads.Value {
+		if len(t.Comments) == 0 {
+			continue
+		}
+		// The SQL review comment is the first comment of the thread.
+		c := t.Comments[0]
+		if !c.IsDeleted && strings.HasPrefix(c.Content, common.MarkdownCommentHeader) {
+			commentURL := fmt.Sprintf("%s/%d/comments/%d", threadsURL, t.ID, c.ID)
+			if err := sendJSONRequest(client, http.MethodPatch, commentURL, pre.Token, map[string]string{"content": message}, nil); err != nil {
+				return errors.Wrapf(err, "failed to update pull request comment")
+			}
+			return nil
+		}
+	}
+
+	body := map[string]any{
+		"comments": []threadComment{{ParentCommentID: 0, Content: message, CommentType: 1}},
+		// 1 is active.
+		"status": 1,
+	}
+	if err := sendJSONRequest(client, http.MethodPost, threadsURL, pre.Token, body, nil); err != nil {
+		return errors.Wrapf(err, "failed to create pull request thread")
+	}
+	return nil
+}
+
+func sendJSONRequest(client *http.Client, method, url, token string, body, out any) error {
+	var reader io.Reader
+	if body != nil {
+		bodyBytes, err := json.Marshal(body)
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal request body")
+		}
+		reader = bytes.NewReader(bodyBytes)
+	}
+	req, err := http.NewRequest(method, url+"?api-version="+apiVersion, reader)
+	if err != nil {
+		return errors.Wrap(err, "failed to create request")
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "failed to send request")
+	}
+	defer resp.Body.Close()
+	respBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Wrap(err, "failed to read response body")
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return errors.Errorf("status code: %d, response body: %s", resp.StatusCode, string(respBytes))
+	}
+	if out != nil {
+		if err := json.Unmarshal(respBytes, out); err != nil {
+			return errors.Wrap(err, "failed to unmarshal response body")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package azure
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/action/common"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+)
+
+func TestUpsertPullRequestComment(t *testing.T) {
+	resp := &v1pb.CheckReleaseResponse{RiskLevel: v1pb.RiskLevel_LOW}
+
+	for _, tc := range []struct {
+		name       string
+		threads    []thread
+		wantMethod string
+		wantPath   string
+	}{
+		{
+			name:       "create",
+			threads:    []thread{{ID: 1, Comments: []threadComment{{ID: 1, Content: "LGTM"}}}, {ID: 2}},
+			wantMethod: http.MethodPost,
+			wantPath:   "/org/project/_apis/git/repositories/repo/pullRequests/5/threads",
+		},
+		{
+			name: "update",
+			threads: []thread{
+				{ID: 1, Comments: []threadComment{{ID: 1, Content: common.MarkdownCommentHeader, IsDeleted: true}}},
+				{ID: 2, Comments: []threadComment{{ID: 4, Content: common.MarkdownCommentHeader + "\n\nold"}}},
+			},
+			wantMethod: http.MethodPatch,
+			wantPath:   "/org/project/_apis/git/repositories/repo/pullRequests/5/threads/2/comments/4",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var gotMethod, gotPath, gotContent string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+				require.Equal(t, apiVersion, r.URL.Query().Get("api-version"))
+				if r.Method == http.MethodGet {
+					require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"value": tc.threads}))
+					return
+				}
+				gotMethod, gotPath = r.Method, r.URL.Path
+				var body struct {
+					Content  string          `json:"content"`
+					Comments []threadComment `json:"comments"`
+				}
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				gotContent = body.Content
+				if len(body.Comments) > 0 {
+					gotContent = body.Comments[0].Content
+				}
+				_, _ = w.Write([]byte("{}"))
+			}))
+			defer server.Close()
+
+			err := upsertPullRequestComment(resp, &pullRequestEnv{
+				CollectionURI: server.URL + "/org/",
+				ProjectID:     "project",
+				RepositoryID:  "repo",
+				PullRequestID: "5",
+				Token:         "token",
+			})
+			require.NoError(t, err)
+			require.Equal(t, tc.wantMethod, gotMethod)
+			require.Equal(t, tc.wantPath, gotPath)
+			require.True(t, strings.HasPrefix(gotContent, common.MarkdownCommentHeader))
+			require.Contains(t, gotContent, "🟢 Low")
+		})
+	}
+}
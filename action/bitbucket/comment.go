@@ -0,0 +1,123 @@
+package bitbucket
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/caarlos0/env/v11"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/action/common"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+)
+
+const defaultAPIURL = "https://api.bitbucket.org/2.0"
+
+// pullRequestEnv is the environment of a Bitbucket pull request pipeline.
+// https://support.atlassian.com/bitbucket-cloud/docs/variables-and-secrets/
+type pullRequestEnv struct {
+	Workspace     string `env:"BITBUCKET_WORKSPACE,required,notEmpty"`
+	RepoSlug      string `env:"BITBUCKET_REPO_SLUG,required,notEmpty"`
+	PullRequestID string `env:"BITBUCKET_PR_ID"`
+	// The pipeline proxy only authenticates the reports API, so a repository access token is required for comments.
+	Token string `env:"BITBUCKET_ACCESS_TOKEN"`
+}
+
+type comment struct {
+	ID      int64 `json:"id"`
+	Deleted bool  `json:"deleted"`
+	Content struct {
+		Raw string `json:"raw"`
+	} `json:"content"`
+}
+
+type commentPage struct {
+	Values []comment `json:"values"`
+	Next   string    `json:"next"`
+}
+
+// UpsertPullRequestComment creates or updates the SQL review comment on the pull request.
+func UpsertPullRequestComment(resp *v1pb.CheckReleaseResponse) error {
+	pre, err := env.ParseAs[pullRequestEnv]()
+	if err != nil {
+		return errors.Wrap(err, "failed to parse Bitbucket environment variables")
+	}
+	if pre.PullRequestID == "" {
+		fmt.Println("not a pull request pipeline, will not create a comment.")
+		return nil
+	}
+	if pre.Token == "" {
+		fmt.Println("BITBUCKET_ACCESS_TOKEN is not set, will not create a comment.")
+		return nil
+	}
+	return upsertPullRequestComment(resp, defaultAPIURL, &pre)
+}
+
+func upsertPullRequestComment(resp *v1pb.CheckReleaseResponse, apiURL string, pre *pullRequestEnv) error {
+	client := &http.Client{}
+	commentsURL := fmt.Sprintf("%s/repositories/%s/%s/pullrequests/%s/comments", apiURL, pre.Workspace, pre.RepoSlug, pre.PullRequestID)
+	body := map[string]any{
+		"content": map[string]string{"raw": common.BuildMarkdownCommentMessage(resp)},
+	}
+
+	nextURL := commentsURL + "?pagelen=100"
+	for nextURL != "" {
+		var page commentPage
+		if err := sendJSONRequest(client, http.MethodGet, nextURL, pre.Token, nil, &page); err != nil {
+			return errors.Wrapf(err, "failed to list pull request comments")
+		}
+		for _, c := range page.Values {
+			if !c.Deleted && strings.HasPrefix(c.Content.Raw, common.MarkdownCommentHeader) {
+				if err := sendJSONRequest(client, http.MethodPut, fmt.Sprintf("%s/%d", commentsURL, c.ID), pre.Token, body, nil); err != nil {
+					return errors.Wrapf(err, "failed to update pull request comment")
+				}
+				return nil
+			}
+		}
+		nextURL = page.Next
+	}
+	if err := sendJSONRequest(client, http.MethodPost, commentsURL, pre.Token, body, nil); err != nil {
+		return errors.Wrapf(err, "failed to create pull request comment")
+	}
+	return nil
+}
+
+func sendJSONRequest(client *http.Client, method, url, token string, body, out any) error {
+	var reader io.Reader
+	if body != nil {
+		bodyBytes, err := json.Marshal(body)
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal request body")
+		}
+		reader = bytes.NewReader(bodyBytes)
+	}
+	req, err := http.NewRequest(method, url, reader)
+	if err != nil {
+		return errors.Wrap(err, "failed to create request")
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "failed to send request")
+	}
+	defer resp.Body.Close()
+	respBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Wrap(err, "failed to read response body")
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return errors.Errorf("status code: %d, response body: %s", resp.StatusCode, string(respBytes))
+	}
+	if out != nil {
+		if err := json.Unmarshal(respBytes, out); err != nil {
+			return errors.Wrap(err, "failed to unmarshal response body")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package bitbucket
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/action/common"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+)
+
+func TestUpsertPullRequestComment(t *testing.T) {
+	resp := &v1pb.CheckReleaseResponse{RiskLevel: v1pb.RiskLevel_MODERATE}
+
+	for _, tc := range []struct {
+		name       string
+		existing   string
+		wantMethod string
+		wantPath   string
+	}{
+		{
+			name:       "create",
+			existing:   "LGTM",
+			wantMethod: http.MethodPost,
+			wantPath:   "/repositories/ws/repo/pullrequests/3/comments",
+		},
+		{
+			name:       "update",
+			existing:   common.MarkdownCommentHeader + "\n\nold",
+			wantMethod: http.MethodPut,
+			wantPath:   "/repositories/ws/repo/pullrequests/3/comments/12",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var gotMethod, gotPath, gotRaw string
+			var server *httptest.Server
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+				if r.Method == http.MethodGet {
+					// The existing comment is on the second page.
+					page := commentPage{}
+					if r.URL.Query().Get("page") == "2" {
+						c := comment{ID: 12}
+						c.Content.Raw = tc.existing
+						page.Values = []comment{c}
+					} else {
+						page.Values = []comment{{ID: 11, Deleted: true}}
+						page.Next = server.URL + r.URL.Path + "?page=2"
+					}
+					require.NoError(t, json.NewEncoder(w).Encode(page))
+					return
+				}
+				gotMethod, gotPath = r.Method, r.URL.Path
+				var body struct {
+					Content struct {
+						Raw string `json:"raw"`
+					} `json:"content"`
+				}
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				gotRaw = body.Content.Raw
+				w.WriteHeader(http.StatusCreated)
+				_, _ = w.Write([]byte("{}"))
+			}))
+			defer server.Close()
+
+			err := upsertPullRequestComment(resp, server.URL, &pullRequestEnv{
+				Workspace:     "ws",
+				RepoSlug:      "repo",
+				PullRequestID: "3",
+				Token:         "token",
+			})
+			require.NoError(t, err)
+			require.Equal(t, tc.wantMethod, gotMethod)
+			require.Equal(t, tc.wantPath, gotPath)
+			require.True(t, strings.HasPrefix(gotRaw, common.MarkdownCommentHeader))
+			require.Contains(t, gotRaw, "🟡 Moderate")
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"connectrpc.com/connect"
 	"github.com/pkg/errors"
 	"golang.org/x/sync/singleflight"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 
 	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
 	"github.com/bytebase/bytebase/backend/generated-go/v1/v1connect"
@@ -66,10 +67,20 @@ type client struct {
 	serviceAccountSecret string
 
 	// Connect RPC service clients
-	releaseClient  v1connect.ReleaseServiceClient
-	planClient     v1connect.PlanServiceClient
-	rolloutClient  v1connect.RolloutServiceClient
-	actuatorClient v1connect.ActuatorServiceClient
+	releaseClient       v1connect.ReleaseServiceClient
+	planClient          v1connect.PlanServiceClient
+	rolloutClient       v1connect.RolloutServiceClient
+	actuatorClient      v1connect.ActuatorServiceClient
+	sqlClient           v1connect.SQLServiceClient
+	sheetClient         v1connect.SheetServiceClient
+	revisionClient      v1connect.RevisionServiceClient
+	databaseClient      v1connect.DatabaseServiceClient
+	databaseGroupClient v1connect.DatabaseGroupServiceClient
+	projectClient       v1connect.ProjectServiceClient
+	reviewConfigClient  v1connect.ReviewConfigServiceClient
+	settingClient       v1connect.SettingServiceClient
+	orgPolicyClient     v1connect.OrgPolicyServiceClient
+	workspaceClient     v1connect.WorkspaceServiceClient
 
 	// Client options
 	options clientOptions
@@ -122,6 +133,16 @@ func newClient(url, accessToken, serviceAccount, serviceAccountSecret string, op
 		planClient:           v1connect.NewPlanServiceClient(httpClient, url, interceptors),
 		rolloutClient:        v1connect.NewRolloutServiceClient(httpClient, url, interceptors),
 		actuatorClient:       v1connect.NewActuatorServiceClient(httpClient, url, interceptors),
+		sqlClient:            v1connect.NewSQLServiceClient(httpClient, url, interceptors),
+		sheetClient:          v1connect.NewSheetServiceClient(httpClient, url, interceptors),
+		revisionClient:       v1connect.NewRevisionServiceClient(httpClient, url, interceptors),
+		databaseClient:       v1connect.NewDatabaseServiceClient(httpClient, url, interceptors),
+		databaseGroupClient:  v1connect.NewDatabaseGroupServiceClient(httpClient, url, interceptors),
+		projectClient:        v1connect.NewProjectServiceClient(httpClient, url, interceptors),
+		reviewConfigClient:   v1connect.NewReviewConfigServiceClient(httpClient, url, interceptors),
+		settingClient:        v1connect.NewSettingServiceClient(httpClient, url, interceptors),
+		orgPolicyClient:      v1connect.NewOrgPolicyServiceClient(httpClient, url, interceptors),
+		workspaceClient:      v1connect.NewWorkspaceServiceClient(httpClient, url, interceptors),
 	}, nil
 }
 
@@ -182,6 +203,14 @@ func (c *client) checkRelease(ctx context.Context, r *v1pb.CheckReleaseRequest)
 	return resp.Msg, nil
 }
 
+func (c *client) formatStatement(ctx context.Context, r *v1pb.FormatStatementRequest) (*v1pb.FormatStatementResponse, error) {
+	resp, err := c.sqlClient.FormatStatement(ctx, connect.NewRequest(r))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to format statement")
+	}
+	return resp.Msg, nil
+}
+
 func (c *client) createRelease(ctx context.Context, project string, r *v1pb.Release, releaseIDTemplate, releaseIDTimezone string) (*v1pb.Release, error) {
 	req := connect.NewRequest(&v1pb.CreateReleaseRequest{
 		Parent:            project,
@@ -196,6 +225,22 @@ func (c *client) createRelease(ctx context.Context, project string, r *v1pb.Rele
 	return resp.Msg, nil
 }
 
+func (c *client) exportRelease(ctx context.Context, r *v1pb.ExportReleaseRequest) (*v1pb.ReleaseBundle, error) {
+	resp, err := c.releaseClient.ExportRelease(ctx, connect.NewRequest(r))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to export release")
+	}
+	return resp.Msg, nil
+}
+
+func (c *client) importRelease(ctx context.Context, r *v1pb.ImportReleaseRequest) (*v1pb.Release, error) {
+	resp, err := c.releaseClient.ImportRelease(ctx, connect.NewRequest(r))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to import release")
+	}
+	return resp.Msg, nil
+}
+
 func (c *client) getPlan(ctx context.Context, planName string) (*v1pb.Plan, error) {
 	resp, err := c.planClient.GetPlan(ctx,
 		connect.NewRequest(&v1pb.GetPlanRequest{
@@ -219,6 +264,162 @@ func (c *client) createPlan(ctx context.Context, project string, r *v1pb.Plan) (
 	return resp.Msg, nil
 }
 
+func (c *client) updatePlan(ctx context.Context, plan *v1pb.Plan, updateMask []string) (*v1pb.Plan, error) {
+	resp, err := c.planClient.UpdatePlan(ctx, connect.NewRequest(&v1pb.UpdatePlanRequest{
+		Plan:       plan,
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: updateMask},
+	}))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to update plan")
+	}
+	return resp.Msg, nil
+}
+
+func (c *client) getPlanCheckRun(ctx context.Context, planCheckRunName string) (*v1pb.PlanCheckRun, error) {
+	resp, err := c.planClient.GetPlanCheckRun(ctx,
+		connect.NewRequest(&v1pb.GetPlanCheckRunRequest{
+			Name: planCheckRunName,
+		}))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get plan check run")
+	}
+	return resp.Msg, nil
+}
+
+func (c *client) previewPlanApproval(ctx context.Context, planName string) (*v1pb.PreviewPlanApprovalResponse, error) {
+	resp, err := c.planClient.PreviewPlanApproval(ctx,
+		connect.NewRequest(&v1pb.PreviewPlanApprovalRequest{
+			Name: planName,
+		}))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to preview plan approval")
+	}
+	return resp.Msg, nil
+}
+
+func (c *client) createSheet(ctx context.Context, project string, content []byte) (*v1pb.Sheet, error) {
+	resp, err := c.sheetClient.CreateSheet(ctx, connect.NewRequest(&v1pb.CreateSheetRequest{
+		Parent: project,
+		Sheet:  &v1pb.Sheet{Content: content},
+	}))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create sheet")
+	}
+	return resp.Msg, nil
+}
+
+// listAllRevisions lists all the revisions of the database.
+func (c *client) listAllRevisions(ctx context.Context, database string) ([]*v1pb.Revision, error) {
+	var revisions []*v1pb.Revision
+	pageToken := ""
+	for {
+		resp, err := c.revisionClient.ListRevisions(ctx, connect.NewRequest(&v1pb.ListRevisionsRequest{
+			Parent:    database,
+			PageSize:  c.options.pageSize,
+			PageToken: pageToken,
+		}))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to list revisions")
+		}
+		revisions = append(revisions, resp.Msg.Revisions...)
+		pageToken = resp.Msg.NextPageToken
+		if pageToken == "" {
+			return revisions, nil
+		}
+	}
+}
+
+func (c *client) diffSchema(ctx context.Context, database string, schema string) (*v1pb.DiffSchemaResponse, error) {
+	resp, err := c.databaseClient.DiffSchema(ctx, connect.NewRequest(&v1pb.DiffSchemaRequest{
+		Name:   database,
+		Target: &v1pb.DiffSchemaRequest_Schema{Schema: schema},
+	}))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to diff schema")
+	}
+	return resp.Msg, nil
+}
+
+func (c *client) getDatabaseSDLSchemaFiles(ctx context.Context, database string) (*v1pb.DatabaseSDLSchemaFiles, error) {
+	resp, err := c.databaseClient.GetDatabaseSDLSchemaFiles(ctx, connect.NewRequest(&v1pb.GetDatabaseSDLSchemaFilesRequest{
+		Name: database + "/sdlSchema",
+	}))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get database SDL schema files")
+	}
+	return resp.Msg, nil
+}
+
+func (c *client) getDatabaseGroup(ctx context.Context, databaseGroupName string) (*v1pb.DatabaseGroup, error) {
+	resp, err := c.databaseGroupClient.GetDatabaseGroup(ctx, connect.NewRequest(&v1pb.GetDatabaseGroupRequest{
+		Name: databaseGroupName,
+		View: v1pb.DatabaseGroupView_DATABASE_GROUP_VIEW_FULL,
+	}))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get database group")
+	}
+	return resp.Msg, nil
+}
+
+func (c *client) listDatabaseGroups(ctx context.Context, project string) ([]*v1pb.DatabaseGroup, error) {
+	resp, err := c.databaseGroupClient.ListDatabaseGroups(ctx, connect.NewRequest(&v1pb.ListDatabaseGroupsRequest{
+		Parent: project,
+		View:   v1pb.DatabaseGroupView_DATABASE_GROUP_VIEW_BASIC,
+	}))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list database groups")
+	}
+	return resp.Msg.DatabaseGroups, nil
+}
+
+func (c *client) getProject(ctx context.Context, projectName string) (*v1pb.Project, error) {
+	resp, err := c.projectClient.GetProject(ctx, connect.NewRequest(&v1pb.GetProjectRequest{
+		Name: projectName,
+	}))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get project")
+	}
+	return resp.Msg, nil
+}
+
+func (c *client) getReviewConfig(ctx context.Context, reviewConfigName string) (*v1pb.ReviewConfig, error) {
+	resp, err := c.reviewConfigClient.GetReviewConfig(ctx, connect.NewRequest(&v1pb.GetReviewConfigRequest{
+		Name: reviewConfigName,
+	}))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get review config")
+	}
+	return resp.Msg, nil
+}
+
+func (c *client) getSetting(ctx context.Context, settingName string) (*v1pb.Setting, error) {
+	resp, err := c.settingClient.GetSetting(ctx, connect.NewRequest(&v1pb.GetSettingRequest{
+		Name: settingName,
+	}))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get setting")
+	}
+	return resp.Msg, nil
+}
+
+func (c *client) getPolicy(ctx context.Context, policyName string) (*v1pb.Policy, error) {
+	resp, err := c.orgPolicyClient.GetPolicy(ctx, connect.NewRequest(&v1pb.GetPolicyRequest{
+		Name: policyName,
+	}))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get policy")
+	}
+	return resp.Msg, nil
+}
+
+func (c *client) batchApply(ctx context.Context, r *v1pb.BatchApplyRequest) (*v1pb.BatchApplyResponse, error) {
+	resp, err := c.workspaceClient.BatchApply(ctx, connect.NewRequest(r))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to batch apply")
+	}
+	return resp.Msg, nil
+}
+
 func (c *client) getRollout(ctx context.Context, rolloutName string) (*v1pb.Rollout, error) {
 	resp, err := c.rolloutClient.GetRollout(ctx,
 		connect.NewRequest(&v1pb.GetRolloutRequest{
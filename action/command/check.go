@@ -98,7 +98,13 @@ func runCheck(w *world.World) func(*cobra.Command, []string) error {
 			if err := gitlab.WriteReleaseCheckToCodeQualityJSON(checkReleaseResponse); err != nil {
 				return err
 			}
+			if err := gitlab.UpsertMergeRequestComment(checkReleaseResponse); err != nil {
+				w.Logger.Warn("failed to upsert comment on the merge request", "error", err)
+			}
 		case world.AzureDevOps:
+			if err := azure.UpsertPullRequestComment(checkReleaseResponse); err != nil {
+				w.Logger.Warn("failed to upsert comment on the pull request", "error", err)
+			}
 			if err := azure.LoggingReleaseChecks(checkReleaseResponse); err != nil {
 				return err
 			}
@@ -106,6 +112,9 @@ func runCheck(w *world.World) func(*cobra.Command, []string) error {
 			if err := bitbucket.CreateBitbucketReport(checkReleaseResponse); err != nil {
 				return err
 			}
+			if err := bitbucket.UpsertPullRequestComment(checkReleaseResponse); err != nil {
+				w.Logger.Warn("failed to upsert comment on the pull request", "error", err)
+			}
 		default:
 			// Unknown platform, no specific output handling
 		}
@@ -115,20 +124,7 @@ func runCheck(w *world.World) func(*cobra.Command, []string) error {
 			return nil
 		}
 
-		var errorCount, warningCount int
-		for _, result := range checkReleaseResponse.Results {
-			for _, advice := range result.Advices {
-				switch advice.Status {
-				case v1pb.Advice_ERROR:
-					errorCount++
-				case v1pb.Advice_WARNING:
-					warningCount++
-				default:
-					// Other advice statuses don't affect counts
-				}
-			}
-		}
-
+		errorCount, warningCount := countAdvices(checkReleaseResponse)
 		if errorCount > 0 {
 			return errors.Errorf("found %d error(s) in release check. view on Bytebase", errorCount)
 		}
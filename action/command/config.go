@@ -0,0 +1,722 @@
+package command
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"gopkg.in/yaml.v3"
+
+	"github.com/bytebase/bytebase/action/args"
+	"github.com/bytebase/bytebase/action/command/output"
+	"github.com/bytebase/bytebase/action/world"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+)
+
+// reviewConfigTag is the tag of the project tag policy that binds the SQL review config to the project.
+const reviewConfigTag = "bb.tag.review_config"
+
+// projectConfigFields are the project fields managed by the config file, which are also the update mask paths of the project.
+var projectConfigFields = []string{
+	"title",
+	"data_classification_config_id",
+	"issue_labels",
+	"force_issue_labels",
+	"enforce_issue_title",
+	"enforce_sql_review",
+	"postgres_database_tenant_mode",
+	"allow_self_approval",
+	"execution_retry_policy",
+	"ci_sampling_size",
+	"parallel_tasks_per_rollout",
+	"require_issue_approval",
+	"require_plan_check_no_error",
+	"allow_request_role",
+	"allow_just_in_time_access",
+	"query_result_cache",
+	"break_glass_access",
+	"labels",
+}
+
+// workspaceConfigKeys are the keys of the workspace resources shared by all projects,
+// which are only managed with --workspace-resources.
+var workspaceConfigKeys = []string{"environments", "approvalRules", "maskingRules"}
+
+func NewConfigCommand(w *world.World) *cobra.Command {
+	// bytebase-action config flags
+	cmdConfig := &cobra.Command{
+		Use:               "config",
+		Short:             "Manage the project settings, review config, environments, approval rules, masking policies and database groups as code",
+		Args:              cobra.NoArgs,
+		PersistentPreRunE: configPreRun(w),
+	}
+	cmdConfig.PersistentFlags().StringVar(&w.ConfigFile, "config-file", "bytebase.yaml", "The path of the config file in YAML")
+	cmdConfig.PersistentFlags().BoolVar(&w.ConfigWorkspaceResources, "workspace-resources", false, "Also manage the environments, approval rules and masking rules shared by all projects of the workspace")
+
+	cmdExport := &cobra.Command{
+		Use:               "export",
+		Short:             "Export the current configuration on the server to the config file",
+		Args:              cobra.NoArgs,
+		PersistentPreRunE: subcommandPreRun(),
+		RunE:              runConfigExport(w),
+	}
+	cmdDiff := &cobra.Command{
+		Use:               "diff",
+		Short:             "Show the difference between the config file and the current configuration on the server",
+		Args:              cobra.NoArgs,
+		PersistentPreRunE: subcommandPreRun(),
+		RunE:              runConfigDiff(w),
+	}
+	cmdApply := &cobra.Command{
+		Use:               "apply",
+		Short:             "Apply the config file to the server, rejecting the resources changed since they are compared",
+		Args:              cobra.NoArgs,
+		PersistentPreRunE: subcommandPreRun(),
+		RunE:              runConfigApply(w),
+	}
+
+	cmdConfig.AddCommand(cmdExport)
+	cmdConfig.AddCommand(cmdDiff)
+	cmdConfig.AddCommand(cmdApply)
+	return cmdConfig
+}
+
+func configPreRun(w *world.World) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		if p := cmd.Parent(); p != nil {
+			if p.PersistentPreRunE != nil {
+				if err := p.PersistentPreRunE(cmd, args); err != nil {
+					return err
+				}
+			}
+		}
+		if w.ConfigFile == "" {
+			return errors.Errorf("config-file is required")
+		}
+		return nil
+	}
+}
+
+// runConfigExport writes all the managed resources on the server to the config file.
+func runConfigExport(w *world.World) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, _ []string) error {
+		defer func() {
+			output.WriteOutput(w)
+		}()
+		ctx := cmd.Context()
+		client, resources, _, err := newConfigClient(ctx, w)
+		if err != nil {
+			return err
+		}
+		defer client.close()
+
+		messages := map[string]proto.Message{}
+		for _, r := range resources {
+			m, err := r.fetch(ctx)
+			if err != nil {
+				return err
+			}
+			messages[r.key] = m
+		}
+		content, err := marshalConfig(resources, messages)
+		if err != nil {
+			return err
+		}
+		if dir := filepath.Dir(w.ConfigFile); dir != "" {
+			if err := os.MkdirAll(dir, 0755); err != nil {
+				return errors.Wrapf(err, "failed to create directory for %q", w.ConfigFile)
+			}
+		}
+		if err := os.WriteFile(w.ConfigFile, content, 0644); err != nil {
+			return errors.Wrapf(err, "failed to write config file %q", w.ConfigFile)
+		}
+		w.Logger.Info("config exported", "project", w.Project, "file", w.ConfigFile)
+		return nil
+	}
+}
+
+// runConfigDiff prints the changes to apply for the config file.
+func runConfigDiff(w *world.World) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, _ []string) error {
+		defer func() {
+			output.WriteOutput(w)
+		}()
+		ctx := cmd.Context()
+		client, resources, _, err := newConfigClient(ctx, w)
+		if err != nil {
+			return err
+		}
+		defer client.close()
+
+		desired, err := readConfigFile(w.ConfigFile, resources)
+		if err != nil {
+			return err
+		}
+		changes, err := computeConfigChanges(ctx, resources, desired)
+		if err != nil {
+			return err
+		}
+		for _, change := range changes {
+			w.OutputMap.ChangedResources = append(w.OutputMap.ChangedResources, change.resource.key)
+			fmt.Fprint(cmd.OutOrStdout(), change.diff)
+		}
+		w.Logger.Info("config diffed", "project", w.Project, "file", w.ConfigFile, "changedResources", len(changes))
+		return nil
+	}
+}
+
+// runConfigApply applies the changed resources by a single BatchApply request with the etags
+// read before comparing them. The server writes the resources in one transaction, so none of
+// them is applied if any of them fails or has changed since then.
+func runConfigApply(w *world.World) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, _ []string) error {
+		defer func() {
+			output.WriteOutput(w)
+		}()
+		ctx := cmd.Context()
+		client, resources, workspace, err := newConfigClient(ctx, w)
+		if err != nil {
+			return err
+		}
+		defer client.close()
+
+		desired, err := readConfigFile(w.ConfigFile, resources)
+		if err != nil {
+			return err
+		}
+		batch, err := newConfigBatchApply(ctx, client, workspace, resources, desired)
+		if err != nil {
+			return err
+		}
+		changes, err := computeConfigChanges(ctx, resources, desired)
+		if err != nil {
+			return err
+		}
+		if len(changes) == 0 {
+			w.Logger.Info("config is up to date", "project", w.Project, "file", w.ConfigFile)
+			return nil
+		}
+		request := &v1pb.BatchApplyRequest{Name: workspace}
+		var keys, changedKeys []string
+		for _, change := range changes {
+			for _, r := range batch[change.resource.key] {
+				request.Resources = append(request.Resources, r)
+				keys = append(keys, change.resource.key)
+			}
+			changedKeys = append(changedKeys, change.resource.key)
+		}
+		w.Logger.Info("applying config", "resources", changedKeys)
+		response, err := client.batchApply(ctx, request)
+		if err == nil {
+			err = getBatchApplyError(keys, response)
+		}
+		if err != nil {
+			return errors.Wrapf(err, "failed to apply the config")
+		}
+		w.OutputMap.ChangedResources = changedKeys
+		w.Logger.Info("config applied", "project", w.Project, "file", w.ConfigFile, "changedResources", len(changes))
+		return nil
+	}
+}
+
+// newConfigBatchApply returns the BatchApply resources of the config file by key, with the etags
+// of the resources on the server.
+func newConfigBatchApply(ctx context.Context, c *client, workspace string, resources []*configResource, desired map[string]proto.Message) (map[string][]*v1pb.BatchApplyRequest_Resource, error) {
+	request := &v1pb.BatchApplyRequest{Name: workspace, ValidateOnly: true}
+	var keys []string
+	for _, r := range resources {
+		d, ok := desired[r.key]
+		if !ok || r.batchApply == nil {
+			continue
+		}
+		batchResources, err := r.batchApply(ctx, d)
+		if err != nil {
+			return nil, err
+		}
+		for _, resource := range batchResources {
+			request.Resources = append(request.Resources, resource)
+			keys = append(keys, r.key)
+		}
+	}
+	batch := map[string][]*v1pb.BatchApplyRequest_Resource{}
+	if len(request.Resources) == 0 {
+		return batch, nil
+	}
+	if workspace == "" {
+		return nil, errors.Errorf("the workspace is unknown to apply the config")
+	}
+	response, err := c.batchApply(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	if err := getBatchApplyError(keys, response); err != nil {
+		return nil, err
+	}
+	for i, result := range response.Results {
+		request.Resources[i].Etag = result.Etag
+		batch[keys[i]] = append(batch[keys[i]], request.Resources[i])
+	}
+	return batch, nil
+}
+
+// getBatchApplyError returns the error of the first failed resource in the BatchApply response.
+func getBatchApplyError(keys []string, response *v1pb.BatchApplyResponse) error {
+	for i, result := range response.Results {
+		if result.Status == v1pb.BatchApplyResponse_Result_FAILED && i < len(keys) {
+			return errors.Errorf("failed to apply %s: %s", keys[i], result.Error)
+		}
+	}
+	return nil
+}
+
+// newConfigClient returns the client, the resources managed by the config file and the workspace.
+func newConfigClient(ctx context.Context, w *world.World) (*client, []*configResource, string, error) {
+	client, err := newClientFromWorld(w)
+	if err != nil {
+		return nil, nil, "", errors.Wrapf(err, "failed to create client")
+	}
+
+	// Check version compatibility
+	checkVersionCompatibility(w, client, args.Version)
+
+	actuatorInfo, err := client.getActuatorInfo(ctx)
+	if err != nil {
+		client.close()
+		return nil, nil, "", err
+	}
+	resources := newConfigResources(client, w.Project, actuatorInfo.Workspace)
+	if !w.ConfigWorkspaceResources {
+		resources = slices.DeleteFunc(resources, func(r *configResource) bool {
+			return slices.Contains(workspaceConfigKeys, r.key)
+		})
+	}
+	return client, resources, actuatorInfo.Workspace, nil
+}
+
+// configResource is a resource managed by the config file.
+type configResource struct {
+	// key is the key of the resource in the config file.
+	key string
+	// newMessage returns an empty message of the resource.
+	newMessage func() proto.Message
+	// normalize clears the fields not managed by the config file, so that the messages from the file and the server are comparable.
+	normalize func(proto.Message)
+	// get returns the resource on the server, or an empty message if it doesn't exist.
+	get func(ctx context.Context) (proto.Message, error)
+	// batchApply returns the BatchApply resources to update the resource on the server to the message.
+	batchApply func(ctx context.Context, m proto.Message) ([]*v1pb.BatchApplyRequest_Resource, error)
+}
+
+// fetch returns the normalized resource on the server.
+func (r *configResource) fetch(ctx context.Context) (proto.Message, error) {
+	m, err := r.get(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get %s", r.key)
+	}
+	if r.normalize != nil {
+		r.normalize(m)
+	}
+	return m, nil
+}
+
+// newConfigResources returns the resources managed by the config file in the order to apply.
+// The workspace-level resources come first because the project-level ones may refer to them.
+func newConfigResources(c *client, project, workspace string) []*configResource {
+	return []*configResource{
+		{
+			key:        "environments",
+			newMessage: func() proto.Message { return &v1pb.EnvironmentSetting{} },
+			get: func(ctx context.Context) (proto.Message, error) {
+				setting, err := getSettingIfExists(ctx, c, "settings/ENVIRONMENT")
+				if err != nil {
+					return nil, err
+				}
+				if v := setting.GetValue().GetEnvironment(); v != nil {
+					return v, nil
+				}
+				return &v1pb.EnvironmentSetting{}, nil
+			},
+			batchApply: func(_ context.Context, m proto.Message) ([]*v1pb.BatchApplyRequest_Resource, error) {
+				return []*v1pb.BatchApplyRequest_Resource{newSettingBatchApplyResource(&v1pb.Setting{
+					Name:  "settings/ENVIRONMENT",
+					Value: &v1pb.SettingValue{Value: &v1pb.SettingValue_Environment{Environment: m.(*v1pb.EnvironmentSetting)}},
+				})}, nil
+			},
+		},
+		{
+			key:        "approvalRules",
+			newMessage: func() proto.Message { return &v1pb.WorkspaceApprovalSetting{} },
+			get: func(ctx context.Context) (proto.Message, error) {
+				setting, err := getSettingIfExists(ctx, c, "settings/WORKSPACE_APPROVAL")
+				if err != nil {
+					return nil, err
+				}
+				if v := setting.GetValue().GetWorkspaceApproval(); v != nil {
+					return v, nil
+				}
+				return &v1pb.WorkspaceApprovalSetting{}, nil
+			},
+			batchApply: func(_ context.Context, m proto.Message) ([]*v1pb.BatchApplyRequest_Resource, error) {
+				return []*v1pb.BatchApplyRequest_Resource{newSettingBatchApplyResource(&v1pb.Setting{
+					Name:  "settings/WORKSPACE_APPROVAL",
+					Value: &v1pb.SettingValue{Value: &v1pb.SettingValue_WorkspaceApproval{WorkspaceApproval: m.(*v1pb.WorkspaceApprovalSetting)}},
+				})}, nil
+			},
+		},
+		{
+			key:        "maskingRules",
+			newMessage: func() proto.Message { return &v1pb.MaskingRulePolicy{} },
+			get: func(ctx context.Context) (proto.Message, error) {
+				if workspace == "" {
+					return nil, errors.Errorf("the workspace is unknown to manage the masking rules")
+				}
+				policy, err := getPolicyIfExists(ctx, c, workspace+"/policies/masking_rule")
+				if err != nil {
+					return nil, err
+				}
+				if v := policy.GetMaskingRulePolicy(); v != nil {
+					return v, nil
+				}
+				return &v1pb.MaskingRulePolicy{}, nil
+			},
+			batchApply: func(_ context.Context, m proto.Message) ([]*v1pb.BatchApplyRequest_Resource, error) {
+				return []*v1pb.BatchApplyRequest_Resource{newPolicyBatchApplyResource(&v1pb.Policy{
+					Name:   workspace + "/policies/masking_rule",
+					Type:   v1pb.PolicyType_MASKING_RULE,
+					Policy: &v1pb.Policy_MaskingRulePolicy{MaskingRulePolicy: m.(*v1pb.MaskingRulePolicy)},
+				}, "masking_rule_policy")}, nil
+			},
+		},
+		{
+			key:        "project",
+			newMessage: func() proto.Message { return &v1pb.Project{} },
+			normalize: func(m proto.Message) {
+				keepFields(m, projectConfigFields)
+			},
+			get: func(ctx context.Context) (proto.Message, error) {
+				return c.getProject(ctx, project)
+			},
+			batchApply: func(_ context.Context, m proto.Message) ([]*v1pb.BatchApplyRequest_Resource, error) {
+				p := proto.CloneOf(m.(*v1pb.Project))
+				p.Name = project
+				return []*v1pb.BatchApplyRequest_Resource{{
+					Resource:   &v1pb.BatchApplyRequest_Resource_Project{Project: p},
+					UpdateMask: &fieldmaskpb.FieldMask{Paths: projectConfigFields},
+				}}, nil
+			},
+		},
+		{
+			// The SQL review config bound to the project.
+			// An empty review config means no review config is bound, and the unbound review config is kept in the workspace.
+			key:        "reviewConfig",
+			newMessage: func() proto.Message { return &v1pb.ReviewConfig{} },
+			normalize: func(m proto.Message) {
+				// The resources are computed from the tag policies.
+				m.(*v1pb.ReviewConfig).Resources = nil
+			},
+			get: func(ctx context.Context) (proto.Message, error) {
+				policy, err := getPolicyIfExists(ctx, c, project+"/policies/tag")
+				if err != nil {
+					return nil, err
+				}
+				name := policy.GetTagPolicy().GetTags()[reviewConfigTag]
+				if name == "" {
+					return &v1pb.ReviewConfig{}, nil
+				}
+				return c.getReviewConfig(ctx, name)
+			},
+			// The review config is bound to the project by the tag policy.
+			batchApply: func(ctx context.Context, m proto.Message) ([]*v1pb.BatchApplyRequest_Resource, error) {
+				reviewConfig := m.(*v1pb.ReviewConfig)
+				policy, err := getPolicyIfExists(ctx, c, project+"/policies/tag")
+				if err != nil {
+					return nil, err
+				}
+				tags := map[string]string{}
+				for k, v := range policy.GetTagPolicy().GetTags() {
+					tags[k] = v
+				}
+				var resources []*v1pb.BatchApplyRequest_Resource
+				if reviewConfig.Name == "" {
+					delete(tags, reviewConfigTag)
+				} else {
+					tags[reviewConfigTag] = reviewConfig.Name
+					resources = append(resources, &v1pb.BatchApplyRequest_Resource{
+						Resource: &v1pb.BatchApplyRequest_Resource_ReviewConfig{ReviewConfig: reviewConfig},
+					})
+				}
+				return append(resources, newPolicyBatchApplyResource(&v1pb.Policy{
+					Name:   project + "/policies/tag",
+					Type:   v1pb.PolicyType_TAG,
+					Policy: &v1pb.Policy_TagPolicy{TagPolicy: &v1pb.TagPolicy{Tags: tags}},
+				}, "tag_policy")), nil
+			},
+		},
+		{
+			key:        "maskingExemptions",
+			newMessage: func() proto.Message { return &v1pb.MaskingExemptionPolicy{} },
+			get: func(ctx context.Context) (proto.Message, error) {
+				policy, err := getPolicyIfExists(ctx, c, project+"/policies/masking_exemption")
+				if err != nil {
+					return nil, err
+				}
+				if v := policy.GetMaskingExemptionPolicy(); v != nil {
+					return v, nil
+				}
+				return &v1pb.MaskingExemptionPolicy{}, nil
+			},
+			batchApply: func(_ context.Context, m proto.Message) ([]*v1pb.BatchApplyRequest_Resource, error) {
+				return []*v1pb.BatchApplyRequest_Resource{newPolicyBatchApplyResource(&v1pb.Policy{
+					Name:   project + "/policies/masking_exemption",
+					Type:   v1pb.PolicyType_MASKING_EXEMPTION,
+					Policy: &v1pb.Policy_MaskingExemptionPolicy{MaskingExemptionPolicy: m.(*v1pb.MaskingExemptionPolicy)},
+				}, "masking_exemption_policy")}, nil
+			},
+		},
+		{
+			key:        "databaseGroups",
+			newMessage: func() proto.Message { return &v1pb.ListDatabaseGroupsResponse{} },
+			normalize: func(m proto.Message) {
+				groups := m.(*v1pb.ListDatabaseGroupsResponse).DatabaseGroups
+				for _, g := range groups {
+					g.MatchedDatabases = nil
+				}
+				slices.SortFunc(groups, func(a, b *v1pb.DatabaseGroup) int {
+					return strings.Compare(a.Name, b.Name)
+				})
+			},
+			get: func(ctx context.Context) (proto.Message, error) {
+				groups, err := c.listDatabaseGroups(ctx, project)
+				if err != nil {
+					return nil, err
+				}
+				return &v1pb.ListDatabaseGroupsResponse{DatabaseGroups: groups}, nil
+			},
+			// The database groups absent from the config file are deleted.
+			batchApply: func(_ context.Context, m proto.Message) ([]*v1pb.BatchApplyRequest_Resource, error) {
+				return []*v1pb.BatchApplyRequest_Resource{{
+					Resource: &v1pb.BatchApplyRequest_Resource_DatabaseGroups{DatabaseGroups: &v1pb.BatchApplyRequest_DatabaseGroupsResource{
+						Parent:         project,
+						DatabaseGroups: m.(*v1pb.ListDatabaseGroupsResponse).DatabaseGroups,
+					}},
+				}}, nil
+			},
+		},
+	}
+}
+
+// newSettingBatchApplyResource returns the BatchApply resource to update the value of the setting.
+func newSettingBatchApplyResource(setting *v1pb.Setting) *v1pb.BatchApplyRequest_Resource {
+	return &v1pb.BatchApplyRequest_Resource{
+		Resource: &v1pb.BatchApplyRequest_Resource_Setting{Setting: setting},
+	}
+}
+
+// newPolicyBatchApplyResource returns the BatchApply resource to update the payload of the policy.
+func newPolicyBatchApplyResource(policy *v1pb.Policy, payloadField string) *v1pb.BatchApplyRequest_Resource {
+	return &v1pb.BatchApplyRequest_Resource{
+		Resource:   &v1pb.BatchApplyRequest_Resource_Policy{Policy: policy},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{payloadField}},
+	}
+}
+
+func getSettingIfExists(ctx context.Context, c *client, name string) (*v1pb.Setting, error) {
+	setting, err := c.getSetting(ctx, name)
+	if err != nil {
+		if connect.CodeOf(err) == connect.CodeNotFound {
+			return &v1pb.Setting{}, nil
+		}
+		return nil, err
+	}
+	return setting, nil
+}
+
+func getPolicyIfExists(ctx context.Context, c *client, name string) (*v1pb.Policy, error) {
+	policy, err := c.getPolicy(ctx, name)
+	if err != nil {
+		if connect.CodeOf(err) == connect.CodeNotFound {
+			return &v1pb.Policy{}, nil
+		}
+		return nil, err
+	}
+	return policy, nil
+}
+
+// keepFields clears the fields of the message except the given ones.
+func keepFields(m proto.Message, fields []string) {
+	r := m.ProtoReflect()
+	r.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		if !slices.Contains(fields, string(fd.Name())) {
+			r.Clear(fd)
+		}
+		return true
+	})
+}
+
+// configChange is a resource whose config file content differs from the server.
+type configChange struct {
+	resource *configResource
+	// current is the resource on the server before the apply.
+	current proto.Message
+	desired proto.Message
+	// diff is the unified diff of the resource in YAML.
+	diff string
+}
+
+// computeConfigChanges compares the resources in the config file with the server.
+// The resources absent from the config file are not managed and skipped.
+func computeConfigChanges(ctx context.Context, resources []*configResource, desired map[string]proto.Message) ([]*configChange, error) {
+	var changes []*configChange
+	for _, r := range resources {
+		d, ok := desired[r.key]
+		if !ok {
+			continue
+		}
+		current, err := r.fetch(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if proto.Equal(current, d) {
+			continue
+		}
+		diff, err := diffConfigResource(r.key, current, d)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, &configChange{
+			resource: r,
+			current:  current,
+			desired:  d,
+			diff:     diff,
+		})
+	}
+	return changes, nil
+}
+
+// diffConfigResource returns the unified diff of the resource between the server and the config file in YAML.
+func diffConfigResource(key string, current, desired proto.Message) (string, error) {
+	a, err := marshalConfigValue(key, current)
+	if err != nil {
+		return "", err
+	}
+	b, err := marshalConfigValue(key, desired)
+	if err != nil {
+		return "", err
+	}
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        splitLines(string(a)),
+		B:        splitLines(string(b)),
+		FromFile: "server/" + key,
+		ToFile:   "config/" + key,
+		Context:  3,
+	})
+}
+
+// splitLines splits the text into lines with the line endings, which are the input of the unified diff.
+func splitLines(s string) []string {
+	lines := strings.SplitAfter(s, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// readConfigFile reads the resources from the config file.
+func readConfigFile(path string, resources []*configResource) (map[string]proto.Message, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read config file %s", path)
+	}
+	var values map[string]any
+	if err := yaml.Unmarshal(content, &values); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse config file %s", path)
+	}
+	messages := map[string]proto.Message{}
+	for key, value := range values {
+		i := slices.IndexFunc(resources, func(r *configResource) bool { return r.key == key })
+		if i < 0 {
+			if slices.Contains(workspaceConfigKeys, key) {
+				return nil, errors.Errorf("%q in config file %s is shared by all projects of the workspace, use --workspace-resources to manage it", key, path)
+			}
+			return nil, errors.Errorf("unknown key %q in config file %s", key, path)
+		}
+		r := resources[i]
+		jsonContent, err := json.Marshal(value)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to convert %s to JSON", key)
+		}
+		m := r.newMessage()
+		if value != nil {
+			if err := protojson.Unmarshal(jsonContent, m); err != nil {
+				return nil, errors.Wrapf(err, "failed to unmarshal %s in config file %s", key, path)
+			}
+		}
+		if r.normalize != nil {
+			r.normalize(m)
+		}
+		messages[key] = m
+	}
+	return messages, nil
+}
+
+// marshalConfig marshals the resources to the config file in YAML, in the order of the resources.
+func marshalConfig(resources []*configResource, messages map[string]proto.Message) ([]byte, error) {
+	root := &yaml.Node{Kind: yaml.MappingNode}
+	for _, r := range resources {
+		m, ok := messages[r.key]
+		if !ok {
+			continue
+		}
+		value, err := toYAMLValue(m)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to convert %s", r.key)
+		}
+		valueNode := &yaml.Node{}
+		if err := valueNode.Encode(value); err != nil {
+			return nil, errors.Wrapf(err, "failed to encode %s", r.key)
+		}
+		root.Content = append(root.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: r.key}, valueNode)
+	}
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(root); err != nil {
+		return nil, errors.Wrapf(err, "failed to marshal config")
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, errors.Wrapf(err, "failed to marshal config")
+	}
+	return buf.Bytes(), nil
+}
+
+// marshalConfigValue marshals a single resource in YAML as it appears in the config file.
+func marshalConfigValue(key string, m proto.Message) ([]byte, error) {
+	r := &configResource{key: key}
+	return marshalConfig([]*configResource{r}, map[string]proto.Message{key: m})
+}
+
+// toYAMLValue converts the message to a generic value through protojson, so that the YAML keys are the JSON names.
+func toYAMLValue(m proto.Message) (any, error) {
+	jsonContent, err := protojson.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	var value any
+	if err := json.Unmarshal(jsonContent, &value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
@@ -0,0 +1,162 @@
+package command
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/type/expr"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+)
+
+func TestConfigFileRoundTrip(t *testing.T) {
+	resources := newConfigResources(nil, "projects/hr", "workspaces/default")
+	messages := map[string]proto.Message{
+		"project": &v1pb.Project{
+			Title:             "HR",
+			AllowSelfApproval: true,
+			Labels:            map[string]string{"team": "hr"},
+		},
+		"reviewConfig": &v1pb.ReviewConfig{
+			Name:    "reviewConfigs/hr",
+			Title:   "HR review",
+			Enabled: true,
+			Rules: []*v1pb.SQLReviewRule{
+				{Type: v1pb.SQLReviewRule_TABLE_REQUIRE_PK, Level: v1pb.SQLReviewRule_ERROR, Engine: v1pb.Engine_POSTGRES},
+			},
+		},
+		"databaseGroups": &v1pb.ListDatabaseGroupsResponse{
+			DatabaseGroups: []*v1pb.DatabaseGroup{
+				{Name: "projects/hr/databaseGroups/prod", Title: "Prod", DatabaseExpr: &expr.Expr{Expression: `resource.environment_name == "prod"`}},
+			},
+		},
+	}
+	content, err := marshalConfig(resources, messages)
+	require.NoError(t, err)
+	// The keys are in the order of the resources.
+	require.Regexp(t, `(?s)^project:\n.*\nreviewConfig:\n.*\ndatabaseGroups:\n`, string(content))
+
+	path := filepath.Join(t.TempDir(), "bytebase.yaml")
+	require.NoError(t, os.WriteFile(path, content, 0644))
+	got, err := readConfigFile(path, resources)
+	require.NoError(t, err)
+	require.Empty(t, cmp.Diff(messages, got, protocmp.Transform()))
+}
+
+func TestReadConfigFile(t *testing.T) {
+	resources := newConfigResources(nil, "projects/hr", "workspaces/default")
+	path := filepath.Join(t.TempDir(), "bytebase.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+project:
+  name: projects/another
+  title: HR
+  state: DELETED
+reviewConfig:
+maskingExemptions:
+  exemptions: []
+databaseGroups:
+  databaseGroups:
+    - name: projects/hr/databaseGroups/b
+      title: B
+      matchedDatabases:
+        - name: instances/prod/databases/hr
+    - name: projects/hr/databaseGroups/a
+      title: A
+`), 0644))
+	got, err := readConfigFile(path, resources)
+	require.NoError(t, err)
+	want := map[string]proto.Message{
+		// The fields not managed by the config file are ignored.
+		"project": &v1pb.Project{Title: "HR"},
+		// An empty value means no review config is bound to the project.
+		"reviewConfig":      &v1pb.ReviewConfig{},
+		"maskingExemptions": &v1pb.MaskingExemptionPolicy{},
+		"databaseGroups": &v1pb.ListDatabaseGroupsResponse{
+			DatabaseGroups: []*v1pb.DatabaseGroup{
+				{Name: "projects/hr/databaseGroups/a", Title: "A"},
+				{Name: "projects/hr/databaseGroups/b", Title: "B"},
+			},
+		},
+	}
+	require.Empty(t, cmp.Diff(want, got, protocmp.Transform()))
+
+	require.NoError(t, os.WriteFile(path, []byte("projects:\n  title: HR\n"), 0644))
+	_, err = readConfigFile(path, resources)
+	require.ErrorContains(t, err, `unknown key "projects"`)
+
+	require.NoError(t, os.WriteFile(path, []byte("project:\n  titel: HR\n"), 0644))
+	_, err = readConfigFile(path, resources)
+	require.ErrorContains(t, err, "failed to unmarshal project")
+
+	// The workspace resources are only managed with --workspace-resources.
+	projectResources := slices.DeleteFunc(resources, func(r *configResource) bool {
+		return slices.Contains(workspaceConfigKeys, r.key)
+	})
+	require.NoError(t, os.WriteFile(path, []byte("maskingRules:\n  rules: []\n"), 0644))
+	_, err = readConfigFile(path, projectResources)
+	require.ErrorContains(t, err, "use --workspace-resources to manage it")
+}
+
+func TestGetBatchApplyError(t *testing.T) {
+	keys := []string{"project", "maskingExemptions"}
+	require.NoError(t, getBatchApplyError(keys, &v1pb.BatchApplyResponse{
+		Results: []*v1pb.BatchApplyResponse_Result{
+			{Status: v1pb.BatchApplyResponse_Result_UPDATED},
+			{Status: v1pb.BatchApplyResponse_Result_UNCHANGED},
+		},
+	}))
+	err := getBatchApplyError(keys, &v1pb.BatchApplyResponse{
+		Results: []*v1pb.BatchApplyResponse_Result{
+			{Status: v1pb.BatchApplyResponse_Result_NOT_APPLIED},
+			{Status: v1pb.BatchApplyResponse_Result_FAILED, Error: "there is concurrent update"},
+		},
+	})
+	require.ErrorContains(t, err, "failed to apply maskingExemptions: there is concurrent update")
+}
+
+func TestConfigBatchApplyResources(t *testing.T) {
+	resources := newConfigResources(nil, "projects/hr", "workspaces/default")
+	getResource := func(key string) *configResource {
+		i := slices.IndexFunc(resources, func(r *configResource) bool { return r.key == key })
+		require.NotEqual(t, -1, i)
+		return resources[i]
+	}
+
+	batch, err := getResource("environments").batchApply(context.Background(), &v1pb.EnvironmentSetting{
+		Environments: []*v1pb.EnvironmentSetting_Environment{{Id: "prod", Title: "Prod"}},
+	})
+	require.NoError(t, err)
+	require.Len(t, batch, 1)
+	require.Equal(t, "settings/ENVIRONMENT", batch[0].GetSetting().GetName())
+
+	batch, err = getResource("databaseGroups").batchApply(context.Background(), &v1pb.ListDatabaseGroupsResponse{
+		DatabaseGroups: []*v1pb.DatabaseGroup{{Name: "projects/hr/databaseGroups/all", Title: "All"}},
+	})
+	require.NoError(t, err)
+	require.Len(t, batch, 1)
+	require.Equal(t, "projects/hr", batch[0].GetDatabaseGroups().GetParent())
+	require.Len(t, batch[0].GetDatabaseGroups().GetDatabaseGroups(), 1)
+}
+
+func TestDiffConfigResource(t *testing.T) {
+	diff, err := diffConfigResource("project",
+		&v1pb.Project{Title: "HR", CiSamplingSize: 10},
+		&v1pb.Project{Title: "Human Resources", CiSamplingSize: 10},
+	)
+	require.NoError(t, err)
+	require.Equal(t, `--- server/project
++++ config/project
+@@ -1,3 +1,3 @@
+ project:
+   ciSamplingSize: 10
+-  title: HR
++  title: Human Resources
+`, diff)
+}
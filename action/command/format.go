@@ -0,0 +1,119 @@
+package command
+
+import (
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/bmatcuk/doublestar/v4"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/bytebase/bytebase/action/command/output"
+	"github.com/bytebase/bytebase/action/world"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+)
+
+func NewFormatCommand(w *world.World) *cobra.Command {
+	// bytebase-action format flags
+	cmdFormat := &cobra.Command{
+		Use:               "format",
+		Short:             "Format the SQL files",
+		Args:              cobra.NoArgs,
+		PersistentPreRunE: validateFormatFlags(w),
+		RunE:              runFormat(w),
+	}
+	cmdFormat.Flags().StringVar(&w.Engine, "engine", "", "The database engine of the SQL files, e.g. POSTGRES, MYSQL, MSSQL, ORACLE, SNOWFLAKE")
+	cmdFormat.Flags().BoolVar(&w.FormatCheck, "check", false, "Fail if any file is not formatted instead of rewriting the files")
+	return cmdFormat
+}
+
+func validateFormatFlags(w *world.World) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		if p := cmd.Parent(); p != nil {
+			if p.PersistentPreRunE != nil {
+				if err := p.PersistentPreRunE(cmd, args); err != nil {
+					return err
+				}
+			}
+		}
+		if w.FilePattern == "" {
+			return errors.Errorf("file-pattern is required")
+		}
+		if _, err := parseEngine(w.Engine); err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func parseEngine(engine string) (v1pb.Engine, error) {
+	v, ok := v1pb.Engine_value[strings.ToUpper(engine)]
+	if !ok || v1pb.Engine(v) == v1pb.Engine_ENGINE_UNSPECIFIED {
+		return v1pb.Engine_ENGINE_UNSPECIFIED, errors.Errorf("invalid engine %q", engine)
+	}
+	return v1pb.Engine(v), nil
+}
+
+func runFormat(w *world.World) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, _ []string) error {
+		defer func() {
+			output.WriteOutput(w)
+		}()
+		engine, err := parseEngine(w.Engine)
+		if err != nil {
+			return err
+		}
+		matches, err := doublestar.FilepathGlob(w.FilePattern)
+		if err != nil {
+			return err
+		}
+		if len(matches) == 0 {
+			return errors.Errorf("no files found for pattern: %s", w.FilePattern)
+		}
+		slices.Sort(matches)
+
+		client, err := newClientFromWorld(w)
+		if err != nil {
+			return err
+		}
+		defer client.close()
+
+		var unformatted []string
+		for _, m := range matches {
+			info, err := os.Stat(m)
+			if err != nil {
+				return err
+			}
+			content, err := os.ReadFile(m)
+			if err != nil {
+				return err
+			}
+			resp, err := client.formatStatement(cmd.Context(), &v1pb.FormatStatementRequest{
+				Statement: string(content),
+				Engine:    engine,
+			})
+			if err != nil {
+				return errors.Wrapf(err, "failed to format %s", m)
+			}
+			if resp.Statement == string(content) {
+				continue
+			}
+			unformatted = append(unformatted, m)
+			if w.FormatCheck {
+				w.Logger.Warn("file is not formatted", "file", m)
+				continue
+			}
+			if err := os.WriteFile(m, []byte(resp.Statement), info.Mode().Perm()); err != nil {
+				return errors.Wrapf(err, "failed to write %s", m)
+			}
+			w.Logger.Info("formatted file", "file", m)
+		}
+
+		w.OutputMap.UnformattedFiles = unformatted
+		if w.FormatCheck && len(unformatted) > 0 {
+			return errors.Errorf("found %d unformatted file(s): %s", len(unformatted), strings.Join(unformatted, ", "))
+		}
+		return nil
+	}
+}
@@ -0,0 +1,33 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+)
+
+func TestParseEngine(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected v1pb.Engine
+		wantErr  bool
+	}{
+		{input: "POSTGRES", expected: v1pb.Engine_POSTGRES},
+		{input: "mysql", expected: v1pb.Engine_MYSQL},
+		{input: "ENGINE_UNSPECIFIED", wantErr: true},
+		{input: "", wantErr: true},
+		{input: "unknown", wantErr: true},
+	}
+
+	for _, tc := range tests {
+		engine, err := parseEngine(tc.input)
+		if tc.wantErr {
+			require.Error(t, err, tc.input)
+			continue
+		}
+		require.NoError(t, err, tc.input)
+		require.Equal(t, tc.expected, engine, tc.input)
+	}
+}
@@ -0,0 +1,358 @@
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"os"
+	"slices"
+
+	"github.com/bmatcuk/doublestar/v4"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/yaml.v3"
+
+	"github.com/bytebase/bytebase/action/command/output"
+	"github.com/bytebase/bytebase/action/world"
+	"github.com/bytebase/bytebase/backend/component/sheet"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+	"github.com/bytebase/bytebase/backend/store/model"
+
+	// Import advisors to register their SQL review rules.
+	_ "github.com/bytebase/bytebase/backend/plugin/advisor/mssql"
+	_ "github.com/bytebase/bytebase/backend/plugin/advisor/mysql"
+	_ "github.com/bytebase/bytebase/backend/plugin/advisor/oceanbase"
+	_ "github.com/bytebase/bytebase/backend/plugin/advisor/oracle"
+	_ "github.com/bytebase/bytebase/backend/plugin/advisor/pg"
+	_ "github.com/bytebase/bytebase/backend/plugin/advisor/redshift"
+	_ "github.com/bytebase/bytebase/backend/plugin/advisor/snowflake"
+	_ "github.com/bytebase/bytebase/backend/plugin/advisor/tidb"
+)
+
+const (
+	// lintExitCodeWarning is the exit code if lint fails on warnings.
+	lintExitCodeWarning = 2
+	// lintExitCodeError is the exit code if lint fails on errors.
+	lintExitCodeError = 3
+)
+
+// ExitCodeError is an error with the exit code of the process.
+type ExitCodeError struct {
+	Code int
+	Err  error
+}
+
+func (e *ExitCodeError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ExitCodeError) Unwrap() error {
+	return e.Err
+}
+
+func NewLintCommand(w *world.World) *cobra.Command {
+	// bytebase-action lint flags
+	cmdLint := &cobra.Command{
+		Use:   "lint",
+		Short: "Run SQL review on the SQL files locally without connecting to Bytebase",
+		Long: `Run SQL review on the SQL files locally without connecting to Bytebase.
+The exit code is 2 if lint fails on warnings, and 3 if lint fails on errors.`,
+		Args:              cobra.NoArgs,
+		PersistentPreRunE: validateLintFlags(w),
+		RunE:              runLint(w),
+	}
+	cmdLint.Flags().StringVar(&w.Engine, "engine", "", "The database engine of the SQL files, e.g. POSTGRES, MYSQL, MSSQL, ORACLE, SNOWFLAKE")
+	cmdLint.Flags().StringVar(&w.ReviewConfig, "review-config", "", "The SQL review config file in YAML or JSON, e.g. exported from the Bytebase review config API. Only the builtin rules are checked if not specified")
+	cmdLint.Flags().StringVar(&w.SchemaSnapshot, "schema-snapshot", "", "The database schema snapshot file in JSON, e.g. exported from the Bytebase database metadata API")
+	cmdLint.Flags().StringVar(&w.CheckRelease, "check-release", "FAIL_ON_ERROR", "Whether to fail on warning/error. Valid values: SKIP, FAIL_ON_WARNING, FAIL_ON_ERROR")
+	return cmdLint
+}
+
+// validateLintFlags doesn't call the pre-run of the root command,
+// because lint doesn't need the Bytebase URL and credentials.
+func validateLintFlags(w *world.World) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, _ []string) error {
+		w.Logger = slog.New(slog.NewTextHandler(cmd.ErrOrStderr(), nil))
+		if w.FilePattern == "" {
+			return errors.Errorf("file-pattern is required")
+		}
+		if _, err := parseEngine(w.Engine); err != nil {
+			return err
+		}
+		switch w.CheckRelease {
+		case "SKIP", "FAIL_ON_WARNING", "FAIL_ON_ERROR":
+		default:
+			return errors.Errorf("invalid check-release value: %s. Valid values: SKIP, FAIL_ON_WARNING, FAIL_ON_ERROR", w.CheckRelease)
+		}
+		return nil
+	}
+}
+
+func runLint(w *world.World) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, _ []string) error {
+		defer func() {
+			output.WriteOutput(w)
+		}()
+		v1Engine, err := parseEngine(w.Engine)
+		if err != nil {
+			return err
+		}
+		// The engine enums of the v1 and store protos are the same.
+		engine := storepb.Engine(v1Engine)
+
+		var rules []*storepb.SQLReviewRule
+		if w.ReviewConfig != "" {
+			if rules, err = loadReviewRules(w.ReviewConfig); err != nil {
+				return err
+			}
+		}
+		dbSchema := &storepb.DatabaseSchemaMetadata{}
+		if w.SchemaSnapshot != "" {
+			if dbSchema, err = loadSchemaSnapshot(w.SchemaSnapshot); err != nil {
+				return err
+			}
+		}
+
+		matches, err := doublestar.FilepathGlob(w.FilePattern)
+		if err != nil {
+			return err
+		}
+		if len(matches) == 0 {
+			return errors.Errorf("no files found for pattern: %s", w.FilePattern)
+		}
+		slices.Sort(matches)
+
+		sheetManager := sheet.NewManager()
+		resp := &v1pb.CheckReleaseResponse{}
+		for _, m := range matches {
+			content, err := os.ReadFile(m)
+			if err != nil {
+				return err
+			}
+			advices, err := lintStatement(cmd.Context(), sheetManager, engine, string(content), rules, dbSchema, w.SchemaSnapshot != "")
+			if err != nil {
+				return errors.Wrapf(err, "failed to lint %s", m)
+			}
+			for _, advice := range advices {
+				logAdvice(w, m, advice)
+			}
+			resp.Results = append(resp.Results, &v1pb.CheckReleaseResponse_CheckResult{
+				File:    m,
+				Advices: advices,
+			})
+		}
+		w.OutputMap.CheckResults = resp
+
+		errorCount, warningCount := countAdvices(resp)
+		w.Logger.Info("lint finished", "fileCount", len(matches), "errorCount", errorCount, "warningCount", warningCount)
+		if w.CheckRelease == "SKIP" {
+			return nil
+		}
+		if errorCount > 0 {
+			return &ExitCodeError{Code: lintExitCodeError, Err: errors.Errorf("found %d error(s) in lint", errorCount)}
+		}
+		if warningCount > 0 && w.CheckRelease == "FAIL_ON_WARNING" {
+			return &ExitCodeError{Code: lintExitCodeWarning, Err: errors.Errorf("found %d warning(s) in lint", warningCount)}
+		}
+		return nil
+	}
+}
+
+// lintStatement runs the SQL review rules on the statement in the same way as the release check of Bytebase,
+// except that the rules requiring a database connection are skipped.
+func lintStatement(
+	ctx context.Context,
+	sheetManager *sheet.Manager,
+	engine storepb.Engine,
+	statement string,
+	rules []*storepb.SQLReviewRule,
+	dbSchema *storepb.DatabaseSchemaMetadata,
+	hasSnapshot bool,
+) ([]*v1pb.Advice, error) {
+	isObjectCaseSensitive := isObjectCaseSensitive(engine)
+	clonedSchema, ok := proto.Clone(dbSchema).(*storepb.DatabaseSchemaMetadata)
+	if !ok {
+		return nil, errors.New("failed to clone database schema metadata")
+	}
+	checkContext := advisor.Context{
+		DBSchema:         dbSchema,
+		DBType:           engine,
+		OriginalMetadata: model.NewDatabaseMetadata(dbSchema, nil, nil, engine, isObjectCaseSensitive),
+		FinalMetadata:    model.NewDatabaseMetadata(clonedSchema, nil, nil, engine, isObjectCaseSensitive),
+		CurrentDatabase:  dbSchema.GetName(),
+		// Use the owner in the schema snapshot instead of querying the current user.
+		TenantMode:            true,
+		IsObjectCaseSensitive: isObjectCaseSensitive,
+	}
+	// Without the schema snapshot, the walk through fails on every statement on existing objects.
+	// So the builtin walk through check is skipped, and the other rules check the partially walked through schema.
+	if !hasSnapshot {
+		checkContext.NoAppendBuiltin = true
+		userRuleTypes := make(map[storepb.SQLReviewRule_Type]bool, len(rules))
+		for _, rule := range rules {
+			userRuleTypes[rule.Type] = true
+		}
+		rules = slices.Clone(rules)
+		for _, rule := range advisor.GetBuiltinRules(engine) {
+			if rule.Type == storepb.SQLReviewRule_BUILTIN_WALK_THROUGH_CHECK || userRuleTypes[rule.Type] {
+				continue
+			}
+			rules = append(rules, rule)
+		}
+	}
+
+	res, err := advisor.SQLReviewCheck(ctx, sheetManager, statement, rules, checkContext)
+	if err != nil {
+		return nil, err
+	}
+	var advices []*v1pb.Advice
+	for _, advice := range res {
+		status := convertAdviceStatus(advice.Status)
+		if status != v1pb.Advice_WARNING && status != v1pb.Advice_ERROR {
+			continue
+		}
+		advices = append(advices, &v1pb.Advice{
+			Status:        status,
+			Code:          advice.Code,
+			Title:         advice.Title,
+			Content:       advice.Content,
+			StartPosition: convertPosition(advice.StartPosition),
+			EndPosition:   convertPosition(advice.EndPosition),
+		})
+	}
+	return advices, nil
+}
+
+// loadReviewRules loads the SQL review rules from the review config file in YAML or JSON.
+// The rules are empty if the review config is disabled.
+func loadReviewRules(path string) ([]*storepb.SQLReviewRule, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read review config %s", path)
+	}
+	// JSON is a subset of YAML, so both formats are parsed as YAML and converted to JSON for protojson.
+	var value any
+	if err := yaml.Unmarshal(content, &value); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse review config %s", path)
+	}
+	jsonContent, err := json.Marshal(value)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to convert review config %s to JSON", path)
+	}
+	reviewConfig := &v1pb.ReviewConfig{}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(jsonContent, reviewConfig); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal review config %s", path)
+	}
+	if !reviewConfig.Enabled {
+		return nil, nil
+	}
+	return convertToStoreSQLReviewRules(reviewConfig.Rules)
+}
+
+// convertToStoreSQLReviewRules converts the v1 SQL review rules to the store ones.
+// The messages share the same field numbers, so the rules are converted through the wire format.
+func convertToStoreSQLReviewRules(rules []*v1pb.SQLReviewRule) ([]*storepb.SQLReviewRule, error) {
+	var storeRules []*storepb.SQLReviewRule
+	for _, rule := range rules {
+		switch rule.Level {
+		case v1pb.SQLReviewRule_ERROR, v1pb.SQLReviewRule_WARNING:
+		default:
+			return nil, errors.Errorf("invalid level %v of rule %v", rule.Level, rule.Type)
+		}
+		b, err := proto.Marshal(rule)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to marshal rule %v", rule.Type)
+		}
+		storeRule := &storepb.SQLReviewRule{}
+		if err := proto.Unmarshal(b, storeRule); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal rule %v", rule.Type)
+		}
+		storeRules = append(storeRules, storeRule)
+	}
+	return storeRules, nil
+}
+
+// loadSchemaSnapshot loads the database schema from the snapshot file in JSON.
+// The v1 database metadata and the store schema metadata have the same JSON field names,
+// so the snapshot exported from the Bytebase database metadata API can be used directly.
+func loadSchemaSnapshot(path string) (*storepb.DatabaseSchemaMetadata, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read schema snapshot %s", path)
+	}
+	dbSchema := &storepb.DatabaseSchemaMetadata{}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(content, dbSchema); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal schema snapshot %s", path)
+	}
+	return dbSchema, nil
+}
+
+// isObjectCaseSensitive returns whether the object names are case sensitive by default for the engine.
+func isObjectCaseSensitive(engine storepb.Engine) bool {
+	switch engine {
+	case storepb.Engine_TIDB, storepb.Engine_MSSQL:
+		return false
+	default:
+		return true
+	}
+}
+
+func convertAdviceStatus(status storepb.Advice_Status) v1pb.Advice_Level {
+	switch status {
+	case storepb.Advice_SUCCESS:
+		return v1pb.Advice_SUCCESS
+	case storepb.Advice_WARNING:
+		return v1pb.Advice_WARNING
+	case storepb.Advice_ERROR:
+		return v1pb.Advice_ERROR
+	default:
+		return v1pb.Advice_ADVICE_LEVEL_UNSPECIFIED
+	}
+}
+
+func convertPosition(position *storepb.Position) *v1pb.Position {
+	if position == nil {
+		return nil
+	}
+	return &v1pb.Position{
+		Line:   position.Line,
+		Column: position.Column,
+	}
+}
+
+func logAdvice(w *world.World, file string, advice *v1pb.Advice) {
+	args := []any{
+		"file", file,
+		"line", advice.GetStartPosition().GetLine(),
+		"code", advice.Code,
+		"title", advice.Title,
+		"content", advice.Content,
+	}
+	if advice.Status == v1pb.Advice_ERROR {
+		w.Logger.Error("lint error", args...)
+		return
+	}
+	w.Logger.Warn("lint warning", args...)
+}
+
+// countAdvices returns the number of error and warning advices in the check results.
+func countAdvices(resp *v1pb.CheckReleaseResponse) (int, int) {
+	var errorCount, warningCount int
+	for _, result := range resp.Results {
+		for _, advice := range result.Advices {
+			switch advice.Status {
+			case v1pb.Advice_ERROR:
+				errorCount++
+			case v1pb.Advice_WARNING:
+				warningCount++
+			default:
+				// Other advice statuses don't affect counts
+			}
+		}
+	}
+	return errorCount, warningCount
+}
@@ -0,0 +1,97 @@
+package command
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/component/sheet"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+)
+
+func TestLoadReviewRules(t *testing.T) {
+	dir := t.TempDir()
+
+	yamlPath := filepath.Join(dir, "review.yaml")
+	require.NoError(t, os.WriteFile(yamlPath, []byte(`
+name: reviewConfigs/default
+title: Default
+enabled: true
+rules:
+  - type: STATEMENT_SELECT_NO_SELECT_ALL
+    level: ERROR
+    engine: POSTGRES
+  - type: NAMING_TABLE
+    level: WARNING
+    engine: POSTGRES
+    namingPayload:
+      format: "^[a-z]+(_[a-z]+)*$"
+      maxLength: 63
+`), 0o600))
+	rules, err := loadReviewRules(yamlPath)
+	require.NoError(t, err)
+	require.Len(t, rules, 2)
+	require.Equal(t, storepb.SQLReviewRule_STATEMENT_SELECT_NO_SELECT_ALL, rules[0].Type)
+	require.Equal(t, storepb.SQLReviewRule_ERROR, rules[0].Level)
+	require.Equal(t, storepb.Engine_POSTGRES, rules[0].Engine)
+	require.Equal(t, storepb.SQLReviewRule_WARNING, rules[1].Level)
+	require.Equal(t, "^[a-z]+(_[a-z]+)*$", rules[1].GetNamingPayload().GetFormat())
+	require.Equal(t, int32(63), rules[1].GetNamingPayload().GetMaxLength())
+
+	jsonPath := filepath.Join(dir, "review.json")
+	require.NoError(t, os.WriteFile(jsonPath, []byte(`{"enabled": false, "rules": [{"type": "TABLE_REQUIRE_PK", "level": "ERROR"}]}`), 0o600))
+	rules, err = loadReviewRules(jsonPath)
+	require.NoError(t, err)
+	require.Empty(t, rules)
+
+	_, err = convertToStoreSQLReviewRules([]*v1pb.SQLReviewRule{{Type: v1pb.SQLReviewRule_TABLE_REQUIRE_PK}})
+	require.Error(t, err)
+}
+
+func TestLintStatement(t *testing.T) {
+	rules := []*storepb.SQLReviewRule{
+		{
+			Type:  storepb.SQLReviewRule_STATEMENT_SELECT_NO_SELECT_ALL,
+			Level: storepb.SQLReviewRule_ERROR,
+		},
+		{
+			Type:  storepb.SQLReviewRule_TABLE_REQUIRE_PK,
+			Level: storepb.SQLReviewRule_WARNING,
+		},
+	}
+	dbSchema := &storepb.DatabaseSchemaMetadata{}
+
+	advices, err := lintStatement(context.Background(), sheet.NewManager(), storepb.Engine_POSTGRES, "SELECT * FROM t;\nCREATE TABLE t2 (id INT);", rules, dbSchema, false)
+	require.NoError(t, err)
+	var errorCount, warningCount int
+	for _, advice := range advices {
+		switch advice.Status {
+		case v1pb.Advice_ERROR:
+			errorCount++
+			require.Equal(t, int32(1), advice.GetStartPosition().GetLine())
+		case v1pb.Advice_WARNING:
+			warningCount++
+		default:
+		}
+	}
+	require.Equal(t, 1, errorCount)
+	require.Equal(t, 1, warningCount)
+
+	advices, err = lintStatement(context.Background(), sheet.NewManager(), storepb.Engine_POSTGRES, "SELECT id FROM t;", rules, dbSchema, false)
+	require.NoError(t, err)
+	require.Empty(t, advices)
+
+	// The walk through check is only run with the schema snapshot.
+	alter := "ALTER TABLE t3 ADD COLUMN name TEXT;"
+	advices, err = lintStatement(context.Background(), sheet.NewManager(), storepb.Engine_POSTGRES, alter, nil, dbSchema, false)
+	require.NoError(t, err)
+	require.Empty(t, advices)
+	advices, err = lintStatement(context.Background(), sheet.NewManager(), storepb.Engine_POSTGRES, alter, nil, dbSchema, true)
+	require.NoError(t, err)
+	require.Len(t, advices, 1)
+	require.Equal(t, v1pb.Advice_WARNING, advices[0].Status)
+}
@@ -0,0 +1,147 @@
+package output
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/action/common"
+	"github.com/bytebase/bytebase/action/world"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+)
+
+// junitPassedTestCaseName is the name of the test case for the file without any advice.
+const junitPassedTestCaseName = "SQL review"
+
+// JUnit XML report, in the format accepted by the common CI systems.
+// https://github.com/testmoapp/junitxml
+type junitTestSuites struct {
+	XMLName    xml.Name         `xml:"testsuites"`
+	Name       string           `xml:"name,attr"`
+	Tests      int              `xml:"tests,attr"`
+	Failures   int              `xml:"failures,attr"`
+	TestSuites []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name       string           `xml:"name,attr"`
+	Tests      int              `xml:"tests,attr"`
+	Failures   int              `xml:"failures,attr"`
+	Properties *junitProperties `xml:"properties,omitempty"`
+	TestCases  []junitTestCase  `xml:"testcase"`
+}
+
+type junitProperties struct {
+	Properties []junitProperty `xml:"property"`
+}
+
+type junitProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	File      string        `xml:"file,attr"`
+	Line      int           `xml:"line,attr,omitempty"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Content string `xml:",chardata"`
+}
+
+// writeJUnit writes the check results to the specified JUnit XML file.
+func writeJUnit(w *world.World) error {
+	if w.JUnitOutput == "" || w.OutputMap.CheckResults == nil {
+		return nil
+	}
+
+	w.Logger.Info("writing JUnit XML to file", "file", w.JUnitOutput)
+	content, err := xml.MarshalIndent(buildJUnit(w.OutputMap.CheckResults), "", "  ")
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal JUnit XML")
+	}
+	return writeFile(w.JUnitOutput, append([]byte(xml.Header), content...))
+}
+
+// buildJUnit builds a test suite for each file and target, and a test case for each rule with advices in the file.
+// A file without any advice has a single passed test case.
+func buildJUnit(resp *v1pb.CheckReleaseResponse) *junitTestSuites {
+	testSuites := &junitTestSuites{Name: "Bytebase SQL Review"}
+	for _, result := range resp.Results {
+		testSuite := junitTestSuite{Name: result.File}
+		if result.Target != "" {
+			testSuite.Name = fmt.Sprintf("%s (%s)", result.File, result.Target)
+			testSuite.Properties = &junitProperties{
+				Properties: []junitProperty{{Name: "target", Value: result.Target}},
+			}
+		}
+
+		// Group the advices by rule in order of appearance.
+		var ruleIDs []string
+		ruleAdvices := make(map[string][]*v1pb.Advice)
+		for _, advice := range result.Advices {
+			if advice.Status != v1pb.Advice_WARNING && advice.Status != v1pb.Advice_ERROR {
+				continue
+			}
+			ruleID := getAdviceRuleID(advice)
+			if _, ok := ruleAdvices[ruleID]; !ok {
+				ruleIDs = append(ruleIDs, ruleID)
+			}
+			ruleAdvices[ruleID] = append(ruleAdvices[ruleID], advice)
+		}
+
+		for _, ruleID := range ruleIDs {
+			testSuite.TestCases = append(testSuite.TestCases, buildJUnitTestCase(result.File, ruleID, ruleAdvices[ruleID]))
+		}
+		if len(testSuite.TestCases) == 0 {
+			testSuite.TestCases = append(testSuite.TestCases, junitTestCase{
+				Name:      junitPassedTestCaseName,
+				ClassName: result.File,
+				File:      result.File,
+			})
+		}
+
+		testSuite.Tests = len(testSuite.TestCases)
+		for _, testCase := range testSuite.TestCases {
+			if testCase.Failure != nil {
+				testSuite.Failures++
+			}
+		}
+		testSuites.Tests += testSuite.Tests
+		testSuites.Failures += testSuite.Failures
+		testSuites.TestSuites = append(testSuites.TestSuites, testSuite)
+	}
+	return testSuites
+}
+
+// buildJUnitTestCase builds a failed test case for the advices of a rule.
+// The failure type is ERROR if any of the advices is an error, otherwise WARNING.
+func buildJUnitTestCase(file string, ruleID string, advices []*v1pb.Advice) junitTestCase {
+	failureType := v1pb.Advice_WARNING
+	var lines []string
+	for _, advice := range advices {
+		if advice.Status == v1pb.Advice_ERROR {
+			failureType = v1pb.Advice_ERROR
+		}
+		line := common.ConvertLineToActionLine(int(advice.GetStartPosition().GetLine()))
+		lines = append(lines, fmt.Sprintf("%s:%d: (%s) Code %d - %s", file, line, advice.Status.String(), advice.Code, advice.Content))
+	}
+	return junitTestCase{
+		Name:      ruleID,
+		ClassName: file,
+		File:      file,
+		Line:      common.ConvertLineToActionLine(int(advices[0].GetStartPosition().GetLine())),
+		Failure: &junitFailure{
+			Message: advices[0].Content,
+			Type:    failureType.String(),
+			Content: strings.Join(lines, "\n"),
+		},
+	}
+}
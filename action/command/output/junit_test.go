@@ -0,0 +1,102 @@
+package output
+
+import (
+	"encoding/xml"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/action/world"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+)
+
+func TestBuildJUnit(t *testing.T) {
+	resp := &v1pb.CheckReleaseResponse{
+		Results: []*v1pb.CheckReleaseResponse_CheckResult{
+			{
+				File:   "1.sql",
+				Target: "instances/prod/databases/db",
+				Advices: []*v1pb.Advice{
+					{
+						Status:        v1pb.Advice_WARNING,
+						Code:          203,
+						Title:         "STATEMENT_SELECT_NO_SELECT_ALL",
+						Content:       "\"SELECT * FROM t\" uses SELECT all",
+						StartPosition: &v1pb.Position{Line: 2},
+					},
+					{
+						Status:        v1pb.Advice_ERROR,
+						Code:          601,
+						Title:         "TABLE_REQUIRE_PK",
+						Content:       "Table \"t\" requires PRIMARY KEY",
+						StartPosition: &v1pb.Position{Line: 5},
+					},
+					{
+						Status:        v1pb.Advice_ERROR,
+						Code:          203,
+						Title:         "STATEMENT_SELECT_NO_SELECT_ALL",
+						Content:       "\"SELECT * FROM t2\" uses SELECT all",
+						StartPosition: &v1pb.Position{Line: 7},
+					},
+				},
+			},
+			{
+				File: "2.sql",
+			},
+		},
+	}
+
+	testSuites := buildJUnit(resp)
+	require.Equal(t, 3, testSuites.Tests)
+	require.Equal(t, 2, testSuites.Failures)
+	require.Len(t, testSuites.TestSuites, 2)
+
+	testSuite := testSuites.TestSuites[0]
+	require.Equal(t, "1.sql (instances/prod/databases/db)", testSuite.Name)
+	require.Equal(t, 2, testSuite.Tests)
+	require.Equal(t, 2, testSuite.Failures)
+	require.Len(t, testSuite.TestCases, 2)
+
+	selectAll := testSuite.TestCases[0]
+	require.Equal(t, "STATEMENT_SELECT_NO_SELECT_ALL", selectAll.Name)
+	require.Equal(t, 2, selectAll.Line)
+	require.Equal(t, "ERROR", selectAll.Failure.Type)
+	require.Equal(t, "1.sql:2: (WARNING) Code 203 - \"SELECT * FROM t\" uses SELECT all\n1.sql:7: (ERROR) Code 203 - \"SELECT * FROM t2\" uses SELECT all", selectAll.Failure.Content)
+	require.Equal(t, "TABLE_REQUIRE_PK", testSuite.TestCases[1].Name)
+
+	passed := testSuites.TestSuites[1]
+	require.Equal(t, "2.sql", passed.Name)
+	require.Nil(t, passed.Properties)
+	require.Len(t, passed.TestCases, 1)
+	require.Equal(t, junitPassedTestCaseName, passed.TestCases[0].Name)
+	require.Nil(t, passed.TestCases[0].Failure)
+}
+
+func TestWriteJUnit(t *testing.T) {
+	junitFile := filepath.Join(t.TempDir(), "junit.xml")
+
+	w := world.NewWorld()
+	w.JUnitOutput = junitFile
+	w.OutputMap.CheckResults = &v1pb.CheckReleaseResponse{
+		Results: []*v1pb.CheckReleaseResponse_CheckResult{
+			{
+				File: "1.sql",
+				Advices: []*v1pb.Advice{
+					{Status: v1pb.Advice_WARNING, Code: 203, Title: "STATEMENT_SELECT_NO_SELECT_ALL", Content: "uses <SELECT> all"},
+				},
+			},
+		},
+	}
+	require.NoError(t, writeJUnit(w))
+
+	data, err := os.ReadFile(junitFile)
+	require.NoError(t, err)
+	require.Contains(t, string(data), xml.Header)
+
+	var testSuites junitTestSuites
+	require.NoError(t, xml.Unmarshal(data, &testSuites))
+	require.Equal(t, 1, testSuites.Failures)
+	require.Equal(t, "uses <SELECT> all", testSuites.TestSuites[0].TestCases[0].Failure.Message)
+}
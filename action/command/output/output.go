@@ -12,11 +12,17 @@ import (
 	"github.com/bytebase/bytebase/action/world"
 )
 
-// WriteOutput writes the output JSON and GitHub step summary if applicable.
+// WriteOutput writes the output JSON, the SARIF and JUnit reports of the check results, and GitHub step summary if applicable.
 func WriteOutput(w *world.World) {
 	if err := writeOutputJSON(w); err != nil {
 		w.Logger.Error("failed to write output JSON", "error", err)
 	}
+	if err := writeSARIF(w); err != nil {
+		w.Logger.Error("failed to write SARIF", "error", err)
+	}
+	if err := writeJUnit(w); err != nil {
+		w.Logger.Error("failed to write JUnit XML", "error", err)
+	}
 	if err := writeGitHubStepSummary(w); err != nil {
 		w.Logger.Error("failed to write GitHub step summary", "error", err)
 	}
@@ -66,6 +72,18 @@ func writeOutputJSON(w *world.World) error {
 		}
 		outputData["checkResults"] = checkResultsMap
 	}
+	if len(w.OutputMap.UnformattedFiles) > 0 {
+		outputData["unformattedFiles"] = w.OutputMap.UnformattedFiles
+	}
+	if w.OutputMap.PlanPreview != nil {
+		outputData["planPreview"] = w.OutputMap.PlanPreview
+	}
+	if len(w.OutputMap.PulledFiles) > 0 {
+		outputData["pulledFiles"] = w.OutputMap.PulledFiles
+	}
+	if len(w.OutputMap.ChangedResources) > 0 {
+		outputData["changedResources"] = w.OutputMap.ChangedResources
+	}
 
 	j, err := json.MarshalIndent(outputData, "", "  ")
 	if err != nil {
@@ -78,6 +96,19 @@ func writeOutputJSON(w *world.World) error {
 	return nil
 }
 
+// writeFile writes the content to the file, creating the parent directory if not exists.
+func writeFile(filename string, content []byte) error {
+	if dir := filepath.Dir(filename); dir != "" {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return errors.Wrapf(err, "failed to create output directory: %s", dir)
+		}
+	}
+	if err := os.WriteFile(filename, content, 0644); err != nil {
+		return errors.Wrapf(err, "failed to write output file: %s", filename)
+	}
+	return nil
+}
+
 // writeGitHubStepSummary writes the GitHub step summary for rollout operations.
 func writeGitHubStepSummary(w *world.World) error {
 	if w.Platform != world.GitHub || !w.IsRollout {
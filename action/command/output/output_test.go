@@ -190,3 +190,24 @@ func TestWriteOutputJSON_AllFieldsPopulated(t *testing.T) {
 	require.True(t, ok, "results should be an array")
 	require.Len(t, results, 1)
 }
+
+func TestWriteOutputJSON_UnformattedFiles(t *testing.T) {
+	tmpDir := t.TempDir()
+	outputFile := filepath.Join(tmpDir, "output.json")
+
+	w := world.NewWorld()
+	w.Output = outputFile
+	w.OutputMap.UnformattedFiles = []string{"migrations/1.sql", "migrations/2.sql"}
+
+	err := writeOutputJSON(w)
+	require.NoError(t, err)
+
+	data, err := os.ReadFile(outputFile)
+	require.NoError(t, err)
+
+	var result map[string]any
+	err = json.Unmarshal(data, &result)
+	require.NoError(t, err)
+
+	require.Equal(t, []any{"migrations/1.sql", "migrations/2.sql"}, result["unformattedFiles"])
+}
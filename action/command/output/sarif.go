@@ -0,0 +1,183 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/action/common"
+	"github.com/bytebase/bytebase/action/world"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+)
+
+// SARIF 2.1.0 log, reduced to the properties used by the check results.
+// https://docs.oasis-open.org/sarif/sarif/v2.1.0/sarif-v2.1.0.html
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID               string       `json:"id"`
+	ShortDescription sarifMessage `json:"shortDescription"`
+	HelpURI          string       `json:"helpUri,omitempty"`
+}
+
+type sarifResult struct {
+	RuleID     string            `json:"ruleId"`
+	Level      string            `json:"level"`
+	Message    sarifMessage      `json:"message"`
+	Locations  []sarifLocation   `json:"locations"`
+	Properties map[string]string `json:"properties,omitempty"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           sarifRegion           `json:"region"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn,omitempty"`
+	EndLine     int `json:"endLine,omitempty"`
+	EndColumn   int `json:"endColumn,omitempty"`
+}
+
+// writeSARIF writes the check results to the specified SARIF file.
+func writeSARIF(w *world.World) error {
+	if w.SARIFOutput == "" || w.OutputMap.CheckResults == nil {
+		return nil
+	}
+
+	w.Logger.Info("writing SARIF to file", "file", w.SARIFOutput)
+	content, err := json.MarshalIndent(buildSARIF(w.OutputMap.CheckResults), "", "  ")
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal SARIF")
+	}
+	return writeFile(w.SARIFOutput, content)
+}
+
+func buildSARIF(resp *v1pb.CheckReleaseResponse) *sarifLog {
+	run := sarifRun{
+		Tool: sarifTool{
+			Driver: sarifDriver{
+				Name:           "Bytebase",
+				InformationURI: "https://www.bytebase.com",
+				Rules:          []sarifRule{},
+			},
+		},
+		Results: []sarifResult{},
+	}
+	ruleIDs := make(map[string]bool)
+	for _, result := range resp.Results {
+		for _, advice := range result.Advices {
+			var level string
+			switch advice.Status {
+			case v1pb.Advice_WARNING:
+				level = "warning"
+			case v1pb.Advice_ERROR:
+				level = "error"
+			default:
+				continue
+			}
+
+			ruleID := getAdviceRuleID(advice)
+			if !ruleIDs[ruleID] {
+				ruleIDs[ruleID] = true
+				run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, sarifRule{
+					ID:               ruleID,
+					ShortDescription: sarifMessage{Text: advice.Title},
+					HelpURI:          getAdviceHelpURI(advice),
+				})
+			}
+
+			message := advice.Content
+			var properties map[string]string
+			if result.Target != "" {
+				// The same file is checked on multiple targets, so the target is part of the message
+				// to tell the results apart.
+				message = fmt.Sprintf("%s. Target: %s", message, result.Target)
+				properties = map[string]string{"target": result.Target}
+			}
+			run.Results = append(run.Results, sarifResult{
+				RuleID:  ruleID,
+				Level:   level,
+				Message: sarifMessage{Text: message},
+				Locations: []sarifLocation{
+					{
+						PhysicalLocation: sarifPhysicalLocation{
+							ArtifactLocation: sarifArtifactLocation{URI: result.File},
+							Region:           getSARIFRegion(advice),
+						},
+					},
+				},
+				Properties: properties,
+			})
+		}
+	}
+	return &sarifLog{
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Version: "2.1.0",
+		Runs:    []sarifRun{run},
+	}
+}
+
+// getSARIFRegion returns the region of the advice.
+// The positions are one-based as SARIF, and zero means unknown.
+func getSARIFRegion(advice *v1pb.Advice) sarifRegion {
+	region := sarifRegion{
+		StartLine:   common.ConvertLineToActionLine(int(advice.GetStartPosition().GetLine())),
+		StartColumn: int(advice.GetStartPosition().GetColumn()),
+	}
+	if endLine := int(advice.GetEndPosition().GetLine()); endLine >= region.StartLine {
+		region.EndLine = endLine
+		region.EndColumn = int(advice.GetEndPosition().GetColumn())
+	}
+	return region
+}
+
+// getAdviceRuleID returns the rule ID of the advice.
+// The title of the advice is the SQL review rule type, e.g. STATEMENT_SELECT_NO_SELECT_ALL.
+func getAdviceRuleID(advice *v1pb.Advice) string {
+	if advice.Title != "" {
+		return advice.Title
+	}
+	return strconv.Itoa(int(advice.Code))
+}
+
+func getAdviceHelpURI(advice *v1pb.Advice) string {
+	if advice.Code == 0 {
+		return ""
+	}
+	return "https://docs.bytebase.com/sql-review/error-codes#" + strconv.Itoa(int(advice.Code))
+}
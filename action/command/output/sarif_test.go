@@ -0,0 +1,91 @@
+package output
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/action/world"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+)
+
+func TestBuildSARIF(t *testing.T) {
+	resp := &v1pb.CheckReleaseResponse{
+		Results: []*v1pb.CheckReleaseResponse_CheckResult{
+			{
+				File:   "migrations/1.sql",
+				Target: "instances/prod/databases/db",
+				Advices: []*v1pb.Advice{
+					{
+						Status:        v1pb.Advice_ERROR,
+						Code:          203,
+						Title:         "STATEMENT_SELECT_NO_SELECT_ALL",
+						Content:       "\"SELECT * FROM t\" uses SELECT all",
+						StartPosition: &v1pb.Position{Line: 3, Column: 1},
+						EndPosition:   &v1pb.Position{Line: 3, Column: 17},
+					},
+					{
+						Status:  v1pb.Advice_WARNING,
+						Code:    203,
+						Title:   "STATEMENT_SELECT_NO_SELECT_ALL",
+						Content: "\"SELECT * FROM t2\" uses SELECT all",
+					},
+					{
+						Status: v1pb.Advice_SUCCESS,
+						Title:  "OK",
+					},
+				},
+			},
+		},
+	}
+
+	log := buildSARIF(resp)
+	require.Equal(t, "2.1.0", log.Version)
+	require.Len(t, log.Runs, 1)
+	run := log.Runs[0]
+	require.Len(t, run.Tool.Driver.Rules, 1)
+	require.Equal(t, "STATEMENT_SELECT_NO_SELECT_ALL", run.Tool.Driver.Rules[0].ID)
+	require.Equal(t, "https://docs.bytebase.com/sql-review/error-codes#203", run.Tool.Driver.Rules[0].HelpURI)
+
+	require.Len(t, run.Results, 2)
+	require.Equal(t, "error", run.Results[0].Level)
+	require.Equal(t, "\"SELECT * FROM t\" uses SELECT all. Target: instances/prod/databases/db", run.Results[0].Message.Text)
+	require.Equal(t, "instances/prod/databases/db", run.Results[0].Properties["target"])
+	location := run.Results[0].Locations[0].PhysicalLocation
+	require.Equal(t, "migrations/1.sql", location.ArtifactLocation.URI)
+	require.Equal(t, sarifRegion{StartLine: 3, StartColumn: 1, EndLine: 3, EndColumn: 17}, location.Region)
+
+	// The unknown position is reported on the first line.
+	require.Equal(t, "warning", run.Results[1].Level)
+	require.Equal(t, sarifRegion{StartLine: 1}, run.Results[1].Locations[0].PhysicalLocation.Region)
+}
+
+func TestWriteSARIF(t *testing.T) {
+	sarifFile := filepath.Join(t.TempDir(), "nested", "results.sarif")
+
+	w := world.NewWorld()
+	w.SARIFOutput = sarifFile
+	require.NoError(t, writeSARIF(w))
+	_, err := os.Stat(sarifFile)
+	require.True(t, os.IsNotExist(err), "SARIF should not be written without check results")
+
+	w.OutputMap.CheckResults = &v1pb.CheckReleaseResponse{
+		Results: []*v1pb.CheckReleaseResponse_CheckResult{{File: "1.sql"}},
+	}
+	require.NoError(t, writeSARIF(w))
+	data, err := os.ReadFile(sarifFile)
+	require.NoError(t, err)
+
+	var result map[string]any
+	require.NoError(t, json.Unmarshal(data, &result))
+	require.Equal(t, "https://json.schemastore.org/sarif-2.1.0.json", result["$schema"])
+	runs, ok := result["runs"].([]any)
+	require.True(t, ok, "runs should be an array")
+	require.Len(t, runs, 1)
+	run, ok := runs[0].(map[string]any)
+	require.True(t, ok, "run should be a map")
+	require.Equal(t, []any{}, run["results"])
+}
@@ -0,0 +1,310 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/bytebase/bytebase/action/args"
+	"github.com/bytebase/bytebase/action/command/output"
+	"github.com/bytebase/bytebase/action/common"
+	"github.com/bytebase/bytebase/action/world"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+)
+
+func NewPlanCommand(w *world.World) *cobra.Command {
+	// bytebase-action plan flags
+	cmdPlan := &cobra.Command{
+		Use:               "plan",
+		Short:             "Preview the rollout impact of the migrate files without creating an issue or rollout",
+		Args:              cobra.NoArgs,
+		PersistentPreRunE: planPreRun(w),
+		RunE:              runPlan(w),
+	}
+	return cmdPlan
+}
+
+func planPreRun(_ *world.World) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		if p := cmd.Parent(); p != nil {
+			if p.PersistentPreRunE != nil {
+				if err := p.PersistentPreRunE(cmd, args); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+}
+
+// runPlan previews the rollout of the release files.
+// Plan checks are skipped for the plans with a release, so it creates a throwaway plan with a sheet
+// of the pending statements for each database instead, and deletes the plan afterwards.
+func runPlan(w *world.World) func(command *cobra.Command, _ []string) error {
+	return func(command *cobra.Command, _ []string) error {
+		defer func() {
+			output.WriteOutput(w)
+		}()
+		ctx := command.Context()
+		client, err := newClientFromWorld(w)
+		if err != nil {
+			return errors.Wrapf(err, "failed to create client")
+		}
+		defer client.close()
+
+		// Check version compatibility
+		checkVersionCompatibility(w, client, args.Version)
+
+		releaseFiles, err := getReleaseFiles(w)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get release files")
+		}
+		if len(releaseFiles) == 0 {
+			return errors.Errorf("no release files found for pattern: %s", w.FilePattern)
+		}
+		databases, err := getPlanDatabases(ctx, client, w.Targets)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get target databases")
+		}
+
+		preview := &world.PlanPreview{}
+		w.OutputMap.PlanPreview = preview
+		var specs []*v1pb.Plan_Spec
+		for _, database := range databases {
+			databasePreview, statements, err := previewDatabase(ctx, w, client, database, releaseFiles)
+			if err != nil {
+				return errors.Wrapf(err, "failed to preview database %q", database)
+			}
+			preview.Databases = append(preview.Databases, databasePreview)
+			// Create one spec per statement, so that each file is checked on its own as the rollout applies it.
+			for _, statement := range statements {
+				if strings.TrimSpace(statement) == "" {
+					continue
+				}
+				sheet, err := client.createSheet(ctx, w.Project, []byte(statement))
+				if err != nil {
+					return errors.Wrapf(err, "failed to create sheet for database %q", database)
+				}
+				specs = append(specs, &v1pb.Plan_Spec{
+					Id: uuid.New().String(),
+					Config: &v1pb.Plan_Spec_ChangeDatabaseConfig{
+						ChangeDatabaseConfig: &v1pb.Plan_ChangeDatabaseConfig{
+							Targets: []string{database},
+							Sheet:   sheet.Name,
+						},
+					},
+				})
+			}
+		}
+		if len(specs) == 0 {
+			w.Logger.Info("no pending changes for the target databases")
+			logPlanPreview(w, preview)
+			return nil
+		}
+
+		plan, err := client.createPlan(ctx, w.Project, &v1pb.Plan{
+			Title: fmt.Sprintf("Dry run %s", w.CurrentTime.Format(versionFormat)),
+			Specs: specs,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "failed to create plan")
+		}
+		w.Logger.Info("throwaway plan created", "url", fmt.Sprintf("%s/%s", client.url, plan.Name))
+		defer func() {
+			// Use a fresh context so that the plan is deleted even if the context is cancelled.
+			if _, err := client.updatePlan(context.Background(), &v1pb.Plan{
+				Name:  plan.Name,
+				State: v1pb.State_DELETED,
+			}, []string{"state"}); err != nil {
+				w.Logger.Warn("failed to delete the throwaway plan", "plan", plan.Name, "error", err)
+				return
+			}
+			w.Logger.Info("throwaway plan deleted", "plan", plan.Name)
+		}()
+
+		planCheckRun, err := waitForPlanCheckRun(ctx, w, client, plan.Name)
+		if err != nil {
+			return errors.Wrapf(err, "failed to wait for plan checks")
+		}
+		applyPlanCheckRun(preview, planCheckRun)
+
+		approval, err := client.previewPlanApproval(ctx, plan.Name)
+		if err != nil {
+			return errors.Wrapf(err, "failed to preview approval")
+		}
+		if !approval.ApprovalFindingDone {
+			w.Logger.Warn("approval finding is not done")
+		}
+		applyPlanApproval(preview, approval)
+
+		logPlanPreview(w, preview)
+		return nil
+	}
+}
+
+// getPlanDatabases unfolds the database group targets into databases.
+func getPlanDatabases(ctx context.Context, client *client, targets []string) ([]string, error) {
+	var databases []string
+	for _, target := range targets {
+		if _, _, err := common.GetProjectIDDatabaseGroupID(target); err != nil {
+			databases = append(databases, target)
+			continue
+		}
+		databaseGroup, err := client.getDatabaseGroup(ctx, target)
+		if err != nil {
+			return nil, err
+		}
+		for _, database := range databaseGroup.MatchedDatabases {
+			databases = append(databases, database.Name)
+		}
+	}
+	return databases, nil
+}
+
+// previewDatabase previews the release files for the database.
+// It returns the statements to apply to the database, one per pending file or the generated DDL in declarative mode.
+func previewDatabase(ctx context.Context, w *world.World, client *client, database string, releaseFiles []*v1pb.Release_File) (*world.DatabasePreview, []string, error) {
+	preview := &world.DatabasePreview{Database: database}
+	if w.Declarative {
+		var schemas []string
+		for _, file := range releaseFiles {
+			schemas = append(schemas, string(file.Statement))
+		}
+		resp, err := client.diffSchema(ctx, database, strings.Join(schemas, "\n"))
+		if err != nil {
+			return nil, nil, err
+		}
+		preview.Statement = resp.Diff
+		return preview, []string{resp.Diff}, nil
+	}
+
+	revisions, err := client.listAllRevisions(ctx, database)
+	if err != nil {
+		return nil, nil, err
+	}
+	pendingFiles, appliedFiles := splitPendingFiles(releaseFiles, revisions)
+	var statements []string
+	for _, file := range pendingFiles {
+		preview.PendingFiles = append(preview.PendingFiles, file.Path)
+		statements = append(statements, string(file.Statement))
+	}
+	for _, file := range appliedFiles {
+		preview.AppliedFiles = append(preview.AppliedFiles, file.Path)
+	}
+	return preview, statements, nil
+}
+
+// splitPendingFiles splits the versioned release files into pending and applied files.
+// A file is applied if there is a versioned revision with the same version, the same as the rollout does.
+func splitPendingFiles(releaseFiles []*v1pb.Release_File, revisions []*v1pb.Revision) ([]*v1pb.Release_File, []*v1pb.Release_File) {
+	appliedVersions := make(map[string]bool)
+	for _, revision := range revisions {
+		if revision.Type == v1pb.Revision_VERSIONED {
+			appliedVersions[revision.Version] = true
+		}
+	}
+	var pendingFiles, appliedFiles []*v1pb.Release_File
+	for _, file := range releaseFiles {
+		if appliedVersions[file.Version] {
+			appliedFiles = append(appliedFiles, file)
+		} else {
+			pendingFiles = append(pendingFiles, file)
+		}
+	}
+	return pendingFiles, appliedFiles
+}
+
+func waitForPlanCheckRun(ctx context.Context, w *world.World, client *client, planName string) (*v1pb.PlanCheckRun, error) {
+	w.Logger.Info("waiting for plan checks")
+	for {
+		if ctx.Err() != nil {
+			return nil, errors.Wrapf(ctx.Err(), "context cancelled")
+		}
+		planCheckRun, err := client.getPlanCheckRun(ctx, planName+"/planCheckRun")
+		if err != nil {
+			return nil, err
+		}
+		switch planCheckRun.Status {
+		case v1pb.PlanCheckRun_DONE:
+			return planCheckRun, nil
+		case v1pb.PlanCheckRun_FAILED:
+			return nil, errors.Errorf("plan checks failed: %s", planCheckRun.Error)
+		case v1pb.PlanCheckRun_CANCELED:
+			return nil, errors.Errorf("plan checks canceled")
+		default:
+		}
+		time.Sleep(5 * time.Second)
+	}
+}
+
+// applyPlanCheckRun fills the statement summary and the advices of the plan checks into the database previews.
+func applyPlanCheckRun(preview *world.PlanPreview, planCheckRun *v1pb.PlanCheckRun) {
+	databasePreviews := make(map[string]*world.DatabasePreview)
+	for _, databasePreview := range preview.Databases {
+		databasePreviews[databasePreview.Database] = databasePreview
+	}
+	for _, result := range planCheckRun.Results {
+		databasePreview, ok := databasePreviews[result.Target]
+		if !ok {
+			continue
+		}
+		switch result.Type {
+		case v1pb.PlanCheckRun_Result_STATEMENT_SUMMARY_REPORT:
+			report := result.GetSqlSummaryReport()
+			if report == nil {
+				continue
+			}
+			for _, statementType := range report.StatementTypes {
+				databasePreview.StatementTypes = append(databasePreview.StatementTypes, statementType.String())
+			}
+			databasePreview.AffectedRows += report.AffectedRows
+		default:
+			if result.Status != v1pb.Advice_WARNING && result.Status != v1pb.Advice_ERROR {
+				continue
+			}
+			databasePreview.Advices = append(databasePreview.Advices, &world.PlanCheckAdvice{
+				Status:  result.Status.String(),
+				Title:   result.Title,
+				Content: result.Content,
+			})
+		}
+	}
+}
+
+func applyPlanApproval(preview *world.PlanPreview, approval *v1pb.PreviewPlanApprovalResponse) {
+	if approval.RiskLevel != v1pb.RiskLevel_RISK_LEVEL_UNSPECIFIED {
+		preview.RiskLevel = approval.RiskLevel.String()
+	}
+	if template := approval.ApprovalTemplate; template != nil {
+		preview.ApprovalTemplate = template.Title
+		preview.ApprovalRoles = template.GetFlow().GetRoles()
+	}
+}
+
+func logPlanPreview(w *world.World, preview *world.PlanPreview) {
+	for _, database := range preview.Databases {
+		w.Logger.Info("database preview",
+			"database", database.Database,
+			"pendingFiles", database.PendingFiles,
+			"appliedFiles", database.AppliedFiles,
+			"statementTypes", database.StatementTypes,
+			"affectedRows", database.AffectedRows,
+		)
+		if database.Statement != "" {
+			w.Logger.Info("generated DDL", "database", database.Database, "statement", database.Statement)
+		}
+		for _, advice := range database.Advices {
+			w.Logger.Warn("plan check advice", "database", database.Database, "status", advice.Status, "title", advice.Title, "content", advice.Content)
+		}
+	}
+	if preview.ApprovalTemplate == "" {
+		w.Logger.Info("no approval required", "riskLevel", preview.RiskLevel)
+		return
+	}
+	w.Logger.Info("approval required", "template", preview.ApprovalTemplate, "roles", preview.ApprovalRoles, "riskLevel", preview.RiskLevel)
+}
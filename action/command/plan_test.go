@@ -0,0 +1,95 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/action/world"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+)
+
+func TestSplitPendingFiles(t *testing.T) {
+	releaseFiles := []*v1pb.Release_File{
+		{Path: "migrations/1.0_init.sql", Version: "1.0"},
+		{Path: "migrations/1.1_add_email.sql", Version: "1.1"},
+		{Path: "migrations/1.2_add_index.sql", Version: "1.2"},
+	}
+	revisions := []*v1pb.Revision{
+		{Version: "1.0", Type: v1pb.Revision_VERSIONED},
+		// The declarative revisions don't count as applied versioned files.
+		{Version: "1.1", Type: v1pb.Revision_DECLARATIVE},
+	}
+
+	pendingFiles, appliedFiles := splitPendingFiles(releaseFiles, revisions)
+	require.Equal(t, []*v1pb.Release_File{releaseFiles[1], releaseFiles[2]}, pendingFiles)
+	require.Equal(t, []*v1pb.Release_File{releaseFiles[0]}, appliedFiles)
+}
+
+func TestApplyPlanCheckRun(t *testing.T) {
+	preview := &world.PlanPreview{
+		Databases: []*world.DatabasePreview{
+			{Database: "instances/prod/databases/hr"},
+			{Database: "instances/test/databases/hr"},
+		},
+	}
+	applyPlanCheckRun(preview, &v1pb.PlanCheckRun{
+		Status: v1pb.PlanCheckRun_DONE,
+		Results: []*v1pb.PlanCheckRun_Result{
+			{
+				Target: "instances/prod/databases/hr",
+				Type:   v1pb.PlanCheckRun_Result_STATEMENT_SUMMARY_REPORT,
+				Status: v1pb.Advice_SUCCESS,
+				Report: &v1pb.PlanCheckRun_Result_SqlSummaryReport_{
+					SqlSummaryReport: &v1pb.PlanCheckRun_Result_SqlSummaryReport{
+						StatementTypes: []v1pb.StatementType{v1pb.StatementType_ALTER_TABLE, v1pb.StatementType_UPDATE},
+						AffectedRows:   42,
+					},
+				},
+			},
+			{
+				Target:  "instances/prod/databases/hr",
+				Type:    v1pb.PlanCheckRun_Result_STATEMENT_ADVISE,
+				Status:  v1pb.Advice_WARNING,
+				Title:   "STATEMENT_AFFECTED_ROW_LIMIT",
+				Content: "The statement affects 42 rows",
+			},
+			{
+				Target: "instances/test/databases/hr",
+				Type:   v1pb.PlanCheckRun_Result_STATEMENT_ADVISE,
+				Status: v1pb.Advice_SUCCESS,
+				Title:  "OK",
+			},
+		},
+	})
+
+	prod := preview.Databases[0]
+	require.Equal(t, []string{"ALTER_TABLE", "UPDATE"}, prod.StatementTypes)
+	require.Equal(t, int64(42), prod.AffectedRows)
+	require.Equal(t, []*world.PlanCheckAdvice{
+		{Status: "WARNING", Title: "STATEMENT_AFFECTED_ROW_LIMIT", Content: "The statement affects 42 rows"},
+	}, prod.Advices)
+	test := preview.Databases[1]
+	require.Empty(t, test.StatementTypes)
+	require.Empty(t, test.Advices)
+}
+
+func TestApplyPlanApproval(t *testing.T) {
+	preview := &world.PlanPreview{}
+	applyPlanApproval(preview, &v1pb.PreviewPlanApprovalResponse{
+		ApprovalFindingDone: true,
+		RiskLevel:           v1pb.RiskLevel_HIGH,
+		ApprovalTemplate: &v1pb.ApprovalTemplate{
+			Title: "DBA review",
+			Flow:  &v1pb.ApprovalFlow{Roles: []string{"roles/projectOwner", "roles/workspaceDBA"}},
+		},
+	})
+	require.Equal(t, "HIGH", preview.RiskLevel)
+	require.Equal(t, "DBA review", preview.ApprovalTemplate)
+	require.Equal(t, []string{"roles/projectOwner", "roles/workspaceDBA"}, preview.ApprovalRoles)
+
+	preview = &world.PlanPreview{}
+	applyPlanApproval(preview, &v1pb.PreviewPlanApprovalResponse{ApprovalFindingDone: true, RiskLevel: v1pb.RiskLevel_LOW})
+	require.Equal(t, "LOW", preview.RiskLevel)
+	require.Empty(t, preview.ApprovalTemplate)
+}
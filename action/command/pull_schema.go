@@ -0,0 +1,119 @@
+package command
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/bmatcuk/doublestar/v4"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/bytebase/bytebase/action/args"
+	"github.com/bytebase/bytebase/action/command/output"
+	"github.com/bytebase/bytebase/action/common"
+	"github.com/bytebase/bytebase/action/world"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+)
+
+func NewPullSchemaCommand(w *world.World) *cobra.Command {
+	// bytebase-action pull-schema flags
+	cmdPullSchema := &cobra.Command{
+		Use:               "pull-schema",
+		Short:             "Export the database schema into a declarative directory with one file per object",
+		Args:              cobra.NoArgs,
+		PersistentPreRunE: validatePullSchemaFlags(w),
+		RunE:              runPullSchema(w),
+	}
+	cmdPullSchema.Flags().StringVar(&w.Database, "database", "", "The database to pull the schema from, e.g. instances/prod-sample-instance/databases/hr_prod")
+	cmdPullSchema.Flags().StringVar(&w.OutputDir, "output-dir", "schema", "The directory to write the schema files to")
+	cmdPullSchema.Flags().BoolVar(&w.Clean, "clean", false, "Remove the existing SQL files in the output directory before writing, so that the dropped objects are removed as well")
+	return cmdPullSchema
+}
+
+func validatePullSchemaFlags(w *world.World) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		if p := cmd.Parent(); p != nil {
+			if p.PersistentPreRunE != nil {
+				if err := p.PersistentPreRunE(cmd, args); err != nil {
+					return err
+				}
+			}
+		}
+		if _, _, err := common.GetInstanceDatabaseID(w.Database); err != nil {
+			return errors.Errorf("invalid database format, must be instances/{instance}/databases/{database}")
+		}
+		if w.OutputDir == "" {
+			return errors.Errorf("output-dir is required")
+		}
+		return nil
+	}
+}
+
+// runPullSchema writes the SDL schema files of the database into the output directory.
+// The directory can be rolled out with --declarative and --file-pattern "{output-dir}/**/*.sql".
+func runPullSchema(w *world.World) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, _ []string) error {
+		defer func() {
+			output.WriteOutput(w)
+		}()
+		client, err := newClientFromWorld(w)
+		if err != nil {
+			return errors.Wrapf(err, "failed to create client")
+		}
+		defer client.close()
+
+		// Check version compatibility
+		checkVersionCompatibility(w, client, args.Version)
+
+		resp, err := client.getDatabaseSDLSchemaFiles(cmd.Context(), w.Database)
+		if err != nil {
+			return err
+		}
+		if w.Clean {
+			if err := removeSQLFiles(w.OutputDir); err != nil {
+				return errors.Wrapf(err, "failed to clean output directory %q", w.OutputDir)
+			}
+		}
+		paths, err := writeSchemaFiles(w.OutputDir, resp.Files)
+		if err != nil {
+			return err
+		}
+		w.OutputMap.PulledFiles = paths
+		w.Logger.Info("schema pulled", "database", w.Database, "outputDir", w.OutputDir, "files", len(paths))
+		return nil
+	}
+}
+
+// writeSchemaFiles writes the files into the directory and returns the paths of the written files.
+func writeSchemaFiles(dir string, files []*v1pb.DatabaseSDLSchemaFiles_File) ([]string, error) {
+	var paths []string
+	for _, file := range files {
+		// The file paths are relative, guard against escaping the output directory.
+		if !filepath.IsLocal(file.Path) {
+			return nil, errors.Errorf("invalid schema file path %q", file.Path)
+		}
+		path := filepath.Join(dir, filepath.FromSlash(file.Path))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return nil, errors.Wrapf(err, "failed to create directory for %q", path)
+		}
+		if err := os.WriteFile(path, []byte(file.Content), 0644); err != nil {
+			return nil, errors.Wrapf(err, "failed to write file %q", path)
+		}
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
+
+// removeSQLFiles removes the SQL files in the directory recursively.
+func removeSQLFiles(dir string) error {
+	matches, err := doublestar.FilepathGlob(filepath.Join(dir, "**", "*.sql"))
+	if err != nil {
+		return err
+	}
+	for _, match := range matches {
+		if err := os.Remove(match); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package command
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+)
+
+func TestWriteSchemaFiles(t *testing.T) {
+	dir := t.TempDir()
+	stale := filepath.Join(dir, "schemas", "public", "tables", "dropped.sql")
+	require.NoError(t, os.MkdirAll(filepath.Dir(stale), 0755))
+	require.NoError(t, os.WriteFile(stale, []byte("CREATE TABLE dropped (id int);\n"), 0644))
+	readme := filepath.Join(dir, "README.md")
+	require.NoError(t, os.WriteFile(readme, []byte("# Schema\n"), 0644))
+
+	require.NoError(t, removeSQLFiles(dir))
+	require.NoFileExists(t, stale)
+	require.FileExists(t, readme)
+
+	paths, err := writeSchemaFiles(dir, []*v1pb.DatabaseSDLSchemaFiles_File{
+		{Path: "schemas/public/tables/users.sql", Content: "CREATE TABLE users (id int);\n"},
+		{Path: "schemas/public/sequences/order_seq.sql", Content: "CREATE SEQUENCE order_seq;\n"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		filepath.Join(dir, "schemas", "public", "tables", "users.sql"),
+		filepath.Join(dir, "schemas", "public", "sequences", "order_seq.sql"),
+	}, paths)
+	content, err := os.ReadFile(paths[0])
+	require.NoError(t, err)
+	require.Equal(t, "CREATE TABLE users (id int);\n", string(content))
+
+	_, err = writeSchemaFiles(dir, []*v1pb.DatabaseSDLSchemaFiles_File{
+		{Path: "../outside.sql", Content: "SELECT 1;\n"},
+	})
+	require.Error(t, err)
+}
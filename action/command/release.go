@@ -0,0 +1,186 @@
+package command
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/bytebase/bytebase/action/args"
+	"github.com/bytebase/bytebase/action/command/output"
+	"github.com/bytebase/bytebase/action/world"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+)
+
+func NewReleaseCommand(w *world.World) *cobra.Command {
+	// bytebase-action release flags
+	cmdRelease := &cobra.Command{
+		Use:               "release",
+		Short:             "Export and import signed release bundles to promote releases across projects and Bytebase workspaces",
+		Args:              cobra.NoArgs,
+		PersistentPreRunE: releasePreRun(w),
+	}
+	cmdRelease.PersistentFlags().StringVar(&w.Bundle, "bundle", "release-bundle.json", "The path of the release bundle file")
+
+	cmdExport := &cobra.Command{
+		Use:               "export",
+		Short:             "Export the release with its files and check results to a signed bundle",
+		Args:              cobra.NoArgs,
+		PersistentPreRunE: subcommandPreRun(),
+		RunE:              runReleaseExport(w),
+	}
+	cmdExport.Flags().StringVar(&w.Release, "release", "", "The release to export. Format: projects/{project}/releases/{release}")
+
+	cmdImport := &cobra.Command{
+		Use:               "import",
+		Short:             "Import the signed bundle as a new release in the project",
+		Args:              cobra.NoArgs,
+		PersistentPreRunE: subcommandPreRun(),
+		RunE:              runReleaseImport(w),
+	}
+	cmdImport.Flags().StringVar(&w.ReleaseIDTemplate, "release-id-template", "release_{date}-RC{iteration}", "Template for release ID. Available variables: {date}, {time}, {timestamp}, {iteration}")
+	cmdImport.Flags().StringVar(&w.ReleaseIDTimezone, "release-id-timezone", "UTC", "Timezone for {date} and {time} variables (e.g., 'UTC', 'America/Los_Angeles')")
+
+	cmdRelease.AddCommand(cmdExport)
+	cmdRelease.AddCommand(cmdImport)
+	return cmdRelease
+}
+
+func releasePreRun(w *world.World) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		if p := cmd.Parent(); p != nil {
+			if p.PersistentPreRunE != nil {
+				if err := p.PersistentPreRunE(cmd, args); err != nil {
+					return err
+				}
+			}
+		}
+		if w.Bundle == "" {
+			return errors.Errorf("bundle is required")
+		}
+		return nil
+	}
+}
+
+// subcommandPreRun runs the pre-run of the parent command, which in turn runs the root one.
+func subcommandPreRun() func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		if p := cmd.Parent(); p != nil {
+			if p.PersistentPreRunE != nil {
+				return p.PersistentPreRunE(p, args)
+			}
+		}
+		return nil
+	}
+}
+
+// runReleaseExport checks the release on the targets and exports it with the check results.
+func runReleaseExport(w *world.World) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, _ []string) error {
+		defer func() {
+			output.WriteOutput(w)
+		}()
+		ctx := cmd.Context()
+		if _, _, ok := strings.Cut(w.Release, "/releases/"); !ok {
+			return errors.Errorf("invalid release format, must be projects/{project}/releases/{release}")
+		}
+		client, err := newClientFromWorld(w)
+		if err != nil {
+			return errors.Wrapf(err, "failed to create client")
+		}
+		defer client.close()
+
+		// Check version compatibility
+		checkVersionCompatibility(w, client, args.Version)
+
+		// The server checks the release on the targets and signs the check results in the bundle.
+		bundle, err := client.exportRelease(ctx, &v1pb.ExportReleaseRequest{
+			Name:    w.Release,
+			Targets: w.Targets,
+		})
+		if err != nil {
+			return err
+		}
+		bundleContent := &v1pb.ReleaseBundle_Content{}
+		if err := proto.Unmarshal(bundle.Content, bundleContent); err != nil {
+			return errors.Wrapf(err, "failed to unmarshal release bundle content")
+		}
+		if checkResults := bundleContent.CheckResults; checkResults != nil {
+			w.OutputMap.CheckResults = checkResults
+			w.Logger.Info("release checked", "release", w.Release, "riskLevel", checkResults.RiskLevel.String(), "resultCount", len(checkResults.Results))
+		}
+		content, err := protojson.MarshalOptions{Indent: "  "}.Marshal(bundle)
+		if err != nil {
+			return errors.Wrapf(err, "failed to marshal release bundle")
+		}
+		if dir := filepath.Dir(w.Bundle); dir != "" {
+			if err := os.MkdirAll(dir, 0755); err != nil {
+				return errors.Wrapf(err, "failed to create directory for %q", w.Bundle)
+			}
+		}
+		if err := os.WriteFile(w.Bundle, content, 0644); err != nil {
+			return errors.Wrapf(err, "failed to write release bundle %q", w.Bundle)
+		}
+		w.Logger.Info("release exported", "release", w.Release, "bundle", w.Bundle, "publicKey", bundle.PublicKey)
+		return nil
+	}
+}
+
+// runReleaseImport imports the bundle as a new release in the project.
+// The server refuses the bundle if it's modified after export.
+func runReleaseImport(w *world.World) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, _ []string) error {
+		defer func() {
+			output.WriteOutput(w)
+		}()
+		bundle, err := readReleaseBundle(w.Bundle)
+		if err != nil {
+			return err
+		}
+		client, err := newClientFromWorld(w)
+		if err != nil {
+			return errors.Wrapf(err, "failed to create client")
+		}
+		defer client.close()
+
+		// Check version compatibility
+		checkVersionCompatibility(w, client, args.Version)
+
+		release, err := client.importRelease(cmd.Context(), &v1pb.ImportReleaseRequest{
+			Parent:            w.Project,
+			Bundle:            bundle,
+			ReleaseIdTemplate: w.ReleaseIDTemplate,
+			ReleaseIdTimezone: w.ReleaseIDTimezone,
+		})
+		if err != nil {
+			return err
+		}
+		w.OutputMap.Release = release.Name
+		// The content is verified by the server, so it's safe to report the source release and check results.
+		content := &v1pb.ReleaseBundle_Content{}
+		if err := proto.Unmarshal(bundle.Content, content); err == nil {
+			w.OutputMap.CheckResults = content.CheckResults
+			w.Logger.Info("release imported", "url", fmt.Sprintf("%s/%s", client.url, release.Name), "source", content.Release, "riskLevel", content.GetCheckResults().GetRiskLevel().String())
+		} else {
+			w.Logger.Info("release imported", "url", fmt.Sprintf("%s/%s", client.url, release.Name))
+		}
+		return nil
+	}
+}
+
+func readReleaseBundle(path string) (*v1pb.ReleaseBundle, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read release bundle %q", path)
+	}
+	bundle := &v1pb.ReleaseBundle{}
+	if err := protojson.Unmarshal(content, bundle); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal release bundle %q", path)
+	}
+	return bundle, nil
+}
@@ -0,0 +1,31 @@
+package command
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+)
+
+func TestReadReleaseBundle(t *testing.T) {
+	bundle := &v1pb.ReleaseBundle{
+		Content:   []byte{0x0a, 0x03, 'a', 'b', 'c'},
+		Signature: "deadbeef",
+	}
+	content, err := protojson.Marshal(bundle)
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "release-bundle.json")
+	require.NoError(t, os.WriteFile(path, content, 0644))
+
+	got, err := readReleaseBundle(path)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(bundle, got))
+
+	_, err = readReleaseBundle(filepath.Join(t.TempDir(), "missing.json"))
+	require.Error(t, err)
+}
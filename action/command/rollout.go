@@ -3,7 +3,6 @@ package command
 import (
 	"context"
 	"fmt"
-	"os"
 	"slices"
 	"strings"
 	"time"
@@ -32,6 +31,9 @@ func NewRolloutCommand(w *world.World) *cobra.Command {
 	cmdRollout.Flags().StringVar(&w.Plan, "plan", "", "The plan to rollout. Format: projects/{project}/plans/{plan}. Shadows file-pattern and targets.")
 	cmdRollout.Flags().StringVar(&w.ReleaseIDTemplate, "release-id-template", "release_{date}-RC{iteration}", "Template for release ID. Available variables: {date}, {time}, {timestamp}, {iteration}")
 	cmdRollout.Flags().StringVar(&w.ReleaseIDTimezone, "release-id-timezone", "UTC", "Timezone for {date} and {time} variables (e.g., 'UTC', 'America/Los_Angeles')")
+	cmdRollout.Flags().StringVar(&w.VCSRepository, "vcs-repository", "", "The repository URL of the release, e.g. https://github.com/bytebase/bytebase. Overrides the one detected from the CI platform")
+	cmdRollout.Flags().StringVar(&w.VCSCommit, "vcs-commit", "", "The commit SHA of the release. Overrides the one detected from the CI platform")
+	cmdRollout.Flags().StringVar(&w.VCSPullRequestURL, "vcs-pull-request-url", "", "The pull request URL of the release. Overrides the one detected from the CI platform")
 	return cmdRollout
 }
 
@@ -295,30 +297,3 @@ func cancelRollout(ctx context.Context, client *client, rolloutName string) erro
 	}
 	return errs
 }
-
-func getVCSSource(w *world.World) *v1pb.Release_VCSSource {
-	switch w.Platform {
-	case world.GitHub:
-		return &v1pb.Release_VCSSource{
-			VcsType: v1pb.VCSType_GITHUB,
-			Url:     os.Getenv("GITHUB_SERVER_URL") + "/" + os.Getenv("GITHUB_REPOSITORY") + "/commit/" + os.Getenv("GITHUB_SHA"),
-		}
-	case world.GitLab:
-		return &v1pb.Release_VCSSource{
-			VcsType: v1pb.VCSType_GITLAB,
-			Url:     os.Getenv("CI_PROJECT_URL") + "/-/commit/" + os.Getenv("CI_COMMIT_SHA"),
-		}
-	case world.Bitbucket:
-		return &v1pb.Release_VCSSource{
-			VcsType: v1pb.VCSType_BITBUCKET,
-			Url:     os.Getenv("BITBUCKET_GIT_HTTP_ORIGIN") + "/commits/" + os.Getenv("BITBUCKET_COMMIT"),
-		}
-	case world.AzureDevOps:
-		return &v1pb.Release_VCSSource{
-			VcsType: v1pb.VCSType_AZURE_DEVOPS,
-			Url:     os.Getenv("SYSTEM_COLLECTIONURI") + os.Getenv("SYSTEM_TEAMPROJECT") + "/_git/" + os.Getenv("BUILD_REPOSITORY_NAME") + "/commit/" + os.Getenv("BUILD_SOURCEVERSION"),
-		}
-	default:
-		return nil
-	}
-}
@@ -27,6 +27,8 @@ func NewRootCommand(w *world.World) *cobra.Command {
 	}
 	// bytebase-action flags
 	cmd.PersistentFlags().StringVar(&w.Output, "output", "", "Output file location. The output file is a JSON file with the created resource names")
+	cmd.PersistentFlags().StringVar(&w.SARIFOutput, "sarif-output", "", "SARIF file location of the check results, e.g. for GitHub code scanning")
+	cmd.PersistentFlags().StringVar(&w.JUnitOutput, "junit-output", "", "JUnit XML file location of the check results, with a test case for each rule of each file")
 	cmd.PersistentFlags().StringVar(&w.URL, "url", "https://demo.bytebase.com", "Bytebase URL")
 	cmd.PersistentFlags().DurationVar(&w.Timeout, "timeout", 120*time.Second, "HTTP timeout for API requests (e.g. 120s, 5m)")
 	cmd.PersistentFlags().StringVar(&w.ServiceAccount, "service-account", "", "Bytebase Service account")
@@ -40,6 +42,12 @@ func NewRootCommand(w *world.World) *cobra.Command {
 
 	cmd.AddCommand(NewCheckCommand(w))
 	cmd.AddCommand(NewRolloutCommand(w))
+	cmd.AddCommand(NewPlanCommand(w))
+	cmd.AddCommand(NewPullSchemaCommand(w))
+	cmd.AddCommand(NewReleaseCommand(w))
+	cmd.AddCommand(NewConfigCommand(w))
+	cmd.AddCommand(NewFormatCommand(w))
+	cmd.AddCommand(NewLintCommand(w))
 	return cmd
 }
 
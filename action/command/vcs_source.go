@@ -0,0 +1,183 @@
+package command
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/bytebase/bytebase/action/world"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+)
+
+// githubPullRequestRefRegex matches the GITHUB_REF of the pull request events, e.g. refs/pull/123/merge.
+var githubPullRequestRefRegex = regexp.MustCompile(`^refs/pull/(\d+)/`)
+
+// getVCSSource returns the VCS source of the release from the environment variables of the platform.
+// The repository, commit and pull request URL can be overridden by the vcs flags for the platforms
+// without such environment variables, e.g. Tekton.
+func getVCSSource(w *world.World) *v1pb.Release_VCSSource {
+	vcsSource := getPlatformVCSSource(w.Platform)
+	if w.VCSRepository != "" {
+		vcsSource.Repository = normalizeRepositoryURL(w.VCSRepository)
+		vcsSource.VcsType = getVCSType(vcsSource.Repository)
+	}
+	if w.VCSCommit != "" {
+		vcsSource.Commit = w.VCSCommit
+	}
+	if w.VCSPullRequestURL != "" {
+		vcsSource.PullRequestUrl = w.VCSPullRequestURL
+	}
+	if vcsSource.Repository == "" && vcsSource.Commit == "" && vcsSource.PullRequestUrl == "" {
+		return nil
+	}
+
+	// Link to the commit if possible, otherwise the pull request or the repository.
+	switch {
+	case vcsSource.Repository != "" && vcsSource.Commit != "" && vcsSource.VcsType != v1pb.VCSType_VCS_TYPE_UNSPECIFIED:
+		vcsSource.Url = getCommitURL(vcsSource.VcsType, vcsSource.Repository, vcsSource.Commit)
+	case vcsSource.PullRequestUrl != "":
+		vcsSource.Url = vcsSource.PullRequestUrl
+	default:
+		vcsSource.Url = vcsSource.Repository
+	}
+	return vcsSource
+}
+
+// getPlatformVCSSource returns the VCS source from the predefined environment variables of the platform, without the URL.
+func getPlatformVCSSource(platform world.JobPlatform) *v1pb.Release_VCSSource {
+	vcsSource := &v1pb.Release_VCSSource{}
+	var pullRequestNumber string
+	switch platform {
+	case world.GitHub:
+		vcsSource.VcsType = v1pb.VCSType_GITHUB
+		vcsSource.Repository = os.Getenv("GITHUB_SERVER_URL") + "/" + os.Getenv("GITHUB_REPOSITORY")
+		vcsSource.Commit = os.Getenv("GITHUB_SHA")
+		if matches := githubPullRequestRefRegex.FindStringSubmatch(os.Getenv("GITHUB_REF")); matches != nil {
+			pullRequestNumber = matches[1]
+		}
+	case world.GitLab:
+		vcsSource.VcsType = v1pb.VCSType_GITLAB
+		vcsSource.Repository = os.Getenv("CI_PROJECT_URL")
+		vcsSource.Commit = os.Getenv("CI_COMMIT_SHA")
+		pullRequestNumber = os.Getenv("CI_MERGE_REQUEST_IID")
+	case world.Bitbucket:
+		vcsSource.VcsType = v1pb.VCSType_BITBUCKET
+		vcsSource.Repository = os.Getenv("BITBUCKET_GIT_HTTP_ORIGIN")
+		vcsSource.Commit = os.Getenv("BITBUCKET_COMMIT")
+		pullRequestNumber = os.Getenv("BITBUCKET_PR_ID")
+	case world.AzureDevOps:
+		vcsSource.VcsType = v1pb.VCSType_AZURE_DEVOPS
+		vcsSource.Repository = os.Getenv("SYSTEM_COLLECTIONURI") + os.Getenv("SYSTEM_TEAMPROJECT") + "/_git/" + os.Getenv("BUILD_REPOSITORY_NAME")
+		vcsSource.Commit = os.Getenv("BUILD_SOURCEVERSION")
+		pullRequestNumber = os.Getenv("SYSTEM_PULLREQUEST_PULLREQUESTID")
+	case world.Jenkins:
+		// https://plugins.jenkins.io/git/#plugin-content-environment-variables
+		// CHANGE_URL is set by the multibranch pipelines for the pull requests.
+		vcsSource.Repository = normalizeRepositoryURL(os.Getenv("GIT_URL"))
+		vcsSource.Commit = os.Getenv("GIT_COMMIT")
+		vcsSource.PullRequestUrl = os.Getenv("CHANGE_URL")
+	case world.CircleCI:
+		vcsSource.Repository = normalizeRepositoryURL(os.Getenv("CIRCLE_REPOSITORY_URL"))
+		vcsSource.Commit = os.Getenv("CIRCLE_SHA1")
+		vcsSource.PullRequestUrl = os.Getenv("CIRCLE_PULL_REQUEST")
+	case world.Buildkite:
+		vcsSource.Repository = normalizeRepositoryURL(os.Getenv("BUILDKITE_REPO"))
+		vcsSource.Commit = os.Getenv("BUILDKITE_COMMIT")
+		// BUILDKITE_PULL_REQUEST is the pull request number, or "false" if the build is not for a pull request.
+		if number := os.Getenv("BUILDKITE_PULL_REQUEST"); number != "false" {
+			pullRequestNumber = number
+		}
+	default:
+		// Tekton and the local runs don't have the predefined environment variables, use the vcs flags instead.
+	}
+	if vcsSource.VcsType == v1pb.VCSType_VCS_TYPE_UNSPECIFIED {
+		vcsSource.VcsType = getVCSType(vcsSource.Repository)
+	}
+	if vcsSource.PullRequestUrl == "" && pullRequestNumber != "" {
+		vcsSource.PullRequestUrl = getPullRequestURL(vcsSource.VcsType, vcsSource.Repository, pullRequestNumber)
+	}
+	return vcsSource
+}
+
+// normalizeRepositoryURL converts the git remote URL to the web URL of the repository.
+// For example, git@github.com:bytebase/bytebase.git is converted to https://github.com/bytebase/bytebase.
+func normalizeRepositoryURL(repository string) string {
+	repository = strings.TrimSuffix(strings.TrimSuffix(strings.TrimSpace(repository), "/"), ".git")
+	if repository == "" {
+		return ""
+	}
+	// The scp-like syntax, e.g. git@github.com:bytebase/bytebase.
+	if !strings.Contains(repository, "://") {
+		if at := strings.Index(repository, "@"); at >= 0 {
+			repository = repository[at+1:]
+		}
+		host, path, ok := strings.Cut(repository, ":")
+		if !ok {
+			return repository
+		}
+		return "https://" + host + "/" + strings.TrimPrefix(path, "/")
+	}
+	u, err := url.Parse(repository)
+	if err != nil {
+		return repository
+	}
+	// Drop the credentials and the ssh port.
+	u.User = nil
+	if u.Scheme != "http" && u.Scheme != "https" {
+		u.Scheme = "https"
+		u.Host = u.Hostname()
+	}
+	return u.String()
+}
+
+// getVCSType returns the VCS type by the host of the repository URL.
+func getVCSType(repository string) v1pb.VCSType {
+	u, err := url.Parse(repository)
+	if err != nil {
+		return v1pb.VCSType_VCS_TYPE_UNSPECIFIED
+	}
+	host := strings.ToLower(u.Hostname())
+	switch {
+	case strings.Contains(host, "github"):
+		return v1pb.VCSType_GITHUB
+	case strings.Contains(host, "gitlab"):
+		return v1pb.VCSType_GITLAB
+	case strings.Contains(host, "bitbucket"):
+		return v1pb.VCSType_BITBUCKET
+	case host == "dev.azure.com" || strings.HasSuffix(host, ".visualstudio.com"):
+		return v1pb.VCSType_AZURE_DEVOPS
+	default:
+		return v1pb.VCSType_VCS_TYPE_UNSPECIFIED
+	}
+}
+
+func getCommitURL(vcsType v1pb.VCSType, repository, commit string) string {
+	switch vcsType {
+	case v1pb.VCSType_GITLAB:
+		return fmt.Sprintf("%s/-/commit/%s", repository, commit)
+	case v1pb.VCSType_BITBUCKET:
+		return fmt.Sprintf("%s/commits/%s", repository, commit)
+	default:
+		return fmt.Sprintf("%s/commit/%s", repository, commit)
+	}
+}
+
+func getPullRequestURL(vcsType v1pb.VCSType, repository, number string) string {
+	if repository == "" {
+		return ""
+	}
+	switch vcsType {
+	case v1pb.VCSType_GITHUB:
+		return fmt.Sprintf("%s/pull/%s", repository, number)
+	case v1pb.VCSType_GITLAB:
+		return fmt.Sprintf("%s/-/merge_requests/%s", repository, number)
+	case v1pb.VCSType_BITBUCKET:
+		return fmt.Sprintf("%s/pull-requests/%s", repository, number)
+	case v1pb.VCSType_AZURE_DEVOPS:
+		return fmt.Sprintf("%s/pullrequest/%s", repository, number)
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,133 @@
+//nolint:revive
+package common
+
+import (
+	"fmt"
+	"strings"
+
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+)
+
+const (
+	// CommentHeader is the marker to find the SQL review comment to update on the pull request.
+	CommentHeader = `<!--BYTEBASE_MARKER-DO_NOT_EDIT-->`
+	// MarkdownCommentHeader is the marker of the Markdown comment, which is an empty link reference definition
+	// for the platforms escaping the HTML comments.
+	MarkdownCommentHeader = `[//]: # (BYTEBASE_MARKER-DO_NOT_EDIT)`
+	// MaxCommentLength is the maximum length of the SQL review comment.
+	MaxCommentLength = 65536
+)
+
+// BuildCommentMessage builds the SQL review comment of the check results, with the detailed results in an HTML table.
+func BuildCommentMessage(resp *v1pb.CheckReleaseResponse) string {
+	var sb strings.Builder
+	_, _ = sb.WriteString(CommentHeader + "\n")
+	writeCommentSummary(&sb, resp)
+	_, _ = sb.WriteString(`
+<table>
+  <thead>
+    <tr>
+      <th>File</th>
+      <th>Target</th>
+      <th>Affected Rows</th>
+      <th>Risk Level</th>
+      <th>Advices</th>
+    </tr>
+  </thead>
+  <tbody>`)
+	for _, result := range resp.Results {
+		if sb.Len() > MaxCommentLength-1000 {
+			break
+		}
+		_, _ = fmt.Fprintf(&sb, `<tr>
+<td>%s</td>
+<td>%s</td>
+<td>%d</td>
+<td>%s</td>
+<td>%s</td>
+</tr>`, result.File, result.Target, result.AffectedRows, FormatRiskLevel(result.RiskLevel), formatAdviceCounts(result.Advices))
+	}
+	_, _ = sb.WriteString("</tbody></table>")
+	return sb.String()
+}
+
+// BuildMarkdownCommentMessage builds the SQL review comment of the check results, with the detailed results in a Markdown table.
+// It is used for the platforms which don't render HTML in comments.
+// The comment starts with MarkdownCommentHeader instead of CommentHeader.
+func BuildMarkdownCommentMessage(resp *v1pb.CheckReleaseResponse) string {
+	var sb strings.Builder
+	_, _ = sb.WriteString(MarkdownCommentHeader + "\n\n")
+	writeCommentSummary(&sb, resp)
+	_, _ = sb.WriteString("\n| File | Target | Affected Rows | Risk Level | Advices |\n")
+	_, _ = sb.WriteString("| --- | --- | --- | --- | --- |\n")
+	for _, result := range resp.Results {
+		if sb.Len() > MaxCommentLength-1000 {
+			break
+		}
+		_, _ = fmt.Fprintf(&sb, "| %s | %s | %d | %s | %s |\n", result.File, result.Target, result.AffectedRows, FormatRiskLevel(result.RiskLevel), formatAdviceCounts(result.Advices))
+	}
+	return sb.String()
+}
+
+func writeCommentSummary(sb *strings.Builder, resp *v1pb.CheckReleaseResponse) {
+	var errorCount, warningCount int
+	for _, result := range resp.Results {
+		e, w := countAdvices(result.Advices)
+		errorCount += e
+		warningCount += w
+	}
+
+	_, _ = sb.WriteString("## SQL Review Summary\n\n")
+	_, _ = fmt.Fprintf(sb, "* Total Affected Rows: **%d**\n", resp.AffectedRows)
+	_, _ = fmt.Fprintf(sb, "* Overall Risk Level: **%s**\n", FormatRiskLevel(resp.RiskLevel))
+	_, _ = fmt.Fprintf(sb, "* Advices Statistics: **%d Error(s), %d Warning(s)**\n", errorCount, warningCount)
+	_, _ = sb.WriteString("### Detailed Results\n")
+}
+
+func formatAdviceCounts(advices []*v1pb.Advice) string {
+	errorCount, warningCount := countAdvices(advices)
+	counts := []string{}
+	if errorCount > 0 {
+		counts = append(counts, fmt.Sprintf("%d Error(s)", errorCount))
+	}
+	if warningCount > 0 {
+		counts = append(counts, fmt.Sprintf("%d Warning(s)", warningCount))
+	}
+	if len(counts) == 0 {
+		return "-"
+	}
+	return strings.Join(counts, ", ")
+}
+
+func countAdvices(advices []*v1pb.Advice) (int, int) {
+	var errorCount, warningCount int
+	for _, advice := range advices {
+		switch advice.Status {
+		case v1pb.Advice_WARNING:
+			warningCount++
+		case v1pb.Advice_ERROR:
+			errorCount++
+		case v1pb.Advice_ADVICE_LEVEL_UNSPECIFIED, v1pb.Advice_SUCCESS:
+			// No action needed
+		default:
+			// Ignore unknown advice statuses
+		}
+	}
+	return errorCount, warningCount
+}
+
+// FormatRiskLevel formats the risk level with an emoji.
+func FormatRiskLevel(r v1pb.RiskLevel) string {
+	switch r {
+	case v1pb.RiskLevel_LOW:
+		return "🟢 Low"
+	case v1pb.RiskLevel_MODERATE:
+		return "🟡 Moderate"
+	case v1pb.RiskLevel_HIGH:
+		return "🔴 High"
+	case v1pb.RiskLevel_RISK_LEVEL_UNSPECIFIED:
+		return "⚪ None"
+	default:
+		return "⚪ None"
+	}
+}
@@ -0,0 +1,47 @@
+package common
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+)
+
+func TestBuildCommentMessage(t *testing.T) {
+	resp := &v1pb.CheckReleaseResponse{
+		AffectedRows: 10,
+		RiskLevel:    v1pb.RiskLevel_HIGH,
+		Results: []*v1pb.CheckReleaseResponse_CheckResult{
+			{
+				File:         "1.sql",
+				Target:       "instances/prod/databases/db",
+				AffectedRows: 10,
+				RiskLevel:    v1pb.RiskLevel_HIGH,
+				Advices: []*v1pb.Advice{
+					{Status: v1pb.Advice_ERROR},
+					{Status: v1pb.Advice_WARNING},
+					{Status: v1pb.Advice_WARNING},
+				},
+			},
+			{
+				File:   "2.sql",
+				Target: "instances/prod/databases/db",
+			},
+		},
+	}
+
+	html := BuildCommentMessage(resp)
+	require.True(t, strings.HasPrefix(html, CommentHeader+"\n## SQL Review Summary"))
+	require.Contains(t, html, "* Overall Risk Level: **🔴 High**")
+	require.Contains(t, html, "* Advices Statistics: **1 Error(s), 2 Warning(s)**")
+	require.Contains(t, html, "<td>1 Error(s), 2 Warning(s)</td>")
+
+	markdown := BuildMarkdownCommentMessage(resp)
+	require.True(t, strings.HasPrefix(markdown, MarkdownCommentHeader+"\n\n## SQL Review Summary"))
+	require.Contains(t, markdown, "* Total Affected Rows: **10**")
+	require.Contains(t, markdown, "| 1.sql | instances/prod/databases/db | 10 | 🔴 High | 1 Error(s), 2 Warning(s) |")
+	require.Contains(t, markdown, "| 2.sql | instances/prod/databases/db | 0 | ⚪ None | - |")
+	require.NotContains(t, markdown, "<table>")
+}
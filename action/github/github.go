@@ -14,8 +14,6 @@ import (
 	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
 )
 
-const maxCommentLength = 65536
-const commentHeader = `<!--BYTEBASE_MARKER-DO_NOT_EDIT-->`
 const githubActionUserID = 41898282
 
 type githubEnv struct {
@@ -83,9 +81,9 @@ func upsertComment(resp *v1pb.CheckReleaseResponse, ghe *githubEnv) error {
 		return errors.Wrapf(err, "failed to list comments")
 	}
 	for _, comment := range comments {
-		if comment.User.ID == githubActionUserID && strings.HasPrefix(comment.Body, commentHeader) {
+		if comment.User.ID == githubActionUserID && strings.HasPrefix(comment.Body, common.CommentHeader) {
 			// update the comment
-			if err := c.updateComment(ghe.Repo, comment.ID, buildCommentMessage(resp)); err != nil {
+			if err := c.updateComment(ghe.Repo, comment.ID, common.BuildCommentMessage(resp)); err != nil {
 				return errors.Wrapf(err, "failed to update comment")
 			}
 			return nil
@@ -93,89 +91,12 @@ func upsertComment(resp *v1pb.CheckReleaseResponse, ghe *githubEnv) error {
 	}
 
 	// create a new comment
-	if err := c.createComment(ghe.Repo, pr, buildCommentMessage(resp)); err != nil {
+	if err := c.createComment(ghe.Repo, pr, common.BuildCommentMessage(resp)); err != nil {
 		return errors.Wrapf(err, "failed to create comment")
 	}
 	return nil
 }
 
-func buildCommentMessage(resp *v1pb.CheckReleaseResponse) string {
-	var errorCount, warningCount int
-	for _, result := range resp.Results {
-		for _, advice := range result.Advices {
-			switch advice.Status {
-			case v1pb.Advice_WARNING:
-				warningCount++
-			case v1pb.Advice_ERROR:
-				errorCount++
-			case v1pb.Advice_ADVICE_LEVEL_UNSPECIFIED, v1pb.Advice_SUCCESS:
-				// No action needed
-			default:
-				// Ignore unknown advice statuses
-			}
-		}
-	}
-
-	var sb strings.Builder
-	_, _ = sb.WriteString(commentHeader + "\n")
-	_, _ = sb.WriteString("## SQL Review Summary\n\n")
-	_, _ = fmt.Fprintf(&sb, "* Total Affected Rows: **%d**\n", resp.AffectedRows)
-	_, _ = fmt.Fprintf(&sb, "* Overall Risk Level: **%s**\n", formatRiskLevel(resp.RiskLevel))
-	_, _ = fmt.Fprintf(&sb, "* Advices Statistics: **%d Error(s), %d Warning(s)**\n", errorCount, warningCount)
-	_, _ = sb.WriteString("### Detailed Results\n")
-	_, _ = sb.WriteString(`
-<table>
-  <thead>
-    <tr>
-      <th>File</th>
-      <th>Target</th>
-      <th>Affected Rows</th>
-      <th>Risk Level</th>
-      <th>Advices</th>
-    </tr>
-  </thead>
-  <tbody>`)
-	for _, result := range resp.Results {
-		if sb.Len() > maxCommentLength-1000 {
-			break
-		}
-		var errorCount, warningCount int
-		for _, advice := range result.Advices {
-			switch advice.Status {
-			case v1pb.Advice_WARNING:
-				warningCount++
-			case v1pb.Advice_ERROR:
-				errorCount++
-			case v1pb.Advice_ADVICE_LEVEL_UNSPECIFIED, v1pb.Advice_SUCCESS:
-				// No action needed
-			default:
-				// Ignore unknown advice statuses
-			}
-		}
-		counts := []string{}
-		if errorCount > 0 {
-			counts = append(counts, fmt.Sprintf("%d Error(s)", errorCount))
-		}
-		if warningCount > 0 {
-			counts = append(counts, fmt.Sprintf("%d Warning(s)", warningCount))
-		}
-		adviceCell := "-"
-		if len(counts) > 0 {
-			adviceCell = strings.Join(counts, ", ")
-		}
-
-		_, _ = fmt.Fprintf(&sb, `<tr>
-<td>%s</td>
-<td>%s</td>
-<td>%d</td>
-<td>%s</td>
-<td>%s</td>
-</tr>`, result.File, result.Target, result.AffectedRows, formatRiskLevel(result.RiskLevel), adviceCell)
-	}
-	_, _ = sb.WriteString("</tbody></table>")
-	return sb.String()
-}
-
 func writeAnnotations(resp *v1pb.CheckReleaseResponse) error {
 	// annotation template
 	// `::${advice.status} file=${file},line=${advice.line},col=${advice.column},title=${advice.title} (${advice.code})::${advice.content}. Targets: ${targets.join(', ')} https://docs.bytebase.com/sql-review/error-codes#${advice.code}`
@@ -212,18 +133,3 @@ func writeAnnotations(resp *v1pb.CheckReleaseResponse) error {
 	}
 	return nil
 }
-
-func formatRiskLevel(r v1pb.RiskLevel) string {
-	switch r {
-	case v1pb.RiskLevel_LOW:
-		return "🟢 Low"
-	case v1pb.RiskLevel_MODERATE:
-		return "🟡 Moderate"
-	case v1pb.RiskLevel_HIGH:
-		return "🔴 High"
-	case v1pb.RiskLevel_RISK_LEVEL_UNSPECIFIED:
-		return "⚪ None"
-	default:
-		return "⚪ None"
-	}
-}
@@ -0,0 +1,136 @@
+package gitlab
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/caarlos0/env/v11"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/action/common"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+)
+
+// mergeRequestEnv is the environment of a GitLab merge request pipeline.
+// https://docs.gitlab.com/ci/variables/predefined_variables/
+type mergeRequestEnv struct {
+	APIURL          string `env:"CI_API_V4_URL,required,notEmpty"`
+	ProjectID       string `env:"CI_PROJECT_ID,required,notEmpty"`
+	MergeRequestIID string `env:"CI_MERGE_REQUEST_IID"`
+	// The CI job token cannot create notes, so a project or personal access token with the api scope is required.
+	Token string `env:"GITLAB_TOKEN"`
+}
+
+type note struct {
+	ID     int64  `json:"id"`
+	Body   string `json:"body"`
+	System bool   `json:"system"`
+}
+
+// UpsertMergeRequestComment creates or updates the SQL review comment on the merge request.
+func UpsertMergeRequestComment(resp *v1pb.CheckReleaseResponse) error {
+	mre, err := env.ParseAs[mergeRequestEnv]()
+	if err != nil {
+		return errors.Wrap(err, "failed to parse GitLab environment variables")
+	}
+	if mre.MergeRequestIID == "" {
+		fmt.Println("not a merge request pipeline, will not create a comment.")
+		return nil
+	}
+	if mre.Token == "" {
+		fmt.Println("GITLAB_TOKEN is not set, will not create a comment.")
+		return nil
+	}
+	return upsertMergeRequestComment(resp, &mre)
+}
+
+func upsertMergeRequestComment(resp *v1pb.CheckReleaseResponse, mre *mergeRequestEnv) error {
+	c := &client{apiURL: strings.TrimSuffix(mre.APIURL, "/"), token: mre.Token, client: &http.Client{}}
+	notesURL := fmt.Sprintf("%s/projects/%s/merge_requests/%s/notes", c.apiURL, url.PathEscape(mre.ProjectID), mre.MergeRequestIID)
+	message := common.BuildCommentMessage(resp)
+
+	notes, err := c.listNotes(notesURL)
+	if err != nil {
+		return errors.Wrapf(err, "failed to list merge request notes")
+	}
+	for _, n := range notes {
+		if !n.System && strings.HasPrefix(n.Body, common.CommentHeader) {
+			if err := c.do(http.MethodPut, fmt.Sprintf("%s/%d", notesURL, n.ID), map[string]string{"body": message}, nil); err != nil {
+				return errors.Wrapf(err, "failed to update merge request note")
+			}
+			return nil
+		}
+	}
+	if err := c.do(http.MethodPost, notesURL, map[string]string{"body": message}, nil); err != nil {
+		return errors.Wrapf(err, "failed to create merge request note")
+	}
+	return nil
+}
+
+type client struct {
+	apiURL string
+	token  string
+	client *http.Client
+}
+
+// listNotes lists all the notes of the merge request page by page.
+func (c *client) listNotes(notesURL string) ([]note, error) {
+	var notes []note
+	page := "1"
+	for page != "" {
+		var pageNotes []note
+		header, err := c.doWithHeader(http.MethodGet, fmt.Sprintf("%s?per_page=100&page=%s", notesURL, page), nil, &pageNotes)
+		if err != nil {
+			return nil, err
+		}
+		notes = append(notes, pageNotes...)
+		page = header.Get("X-Next-Page")
+	}
+	return notes, nil
+}
+
+func (c *client) do(method, url string, body, out any) error {
+	_, err := c.doWithHeader(method, url, body, out)
+	return err
+}
+
+func (c *client) doWithHeader(method, url string, body, out any) (http.Header, error) {
+	var reader io.Reader
+	if body != nil {
+		bodyBytes, err := json.Marshal(body)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshal request body")
+		}
+		reader = bytes.NewReader(bodyBytes)
+	}
+	req, err := http.NewRequest(method, url, reader)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create request")
+	}
+	req.Header.Set("PRIVATE-TOKEN", c.token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to send request")
+	}
+	defer resp.Body.Close()
+	respBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read response body")
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, errors.Errorf("status code: %d, response body: %s", resp.StatusCode, string(respBytes))
+	}
+	if out != nil {
+		if err := json.Unmarshal(respBytes, out); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal response body")
+		}
+	}
+	return resp.Header, nil
+}
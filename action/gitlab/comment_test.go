@@ -0,0 +1,79 @@
+package gitlab
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/action/common"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+)
+
+func TestUpsertMergeRequestComment(t *testing.T) {
+	resp := &v1pb.CheckReleaseResponse{RiskLevel: v1pb.RiskLevel_HIGH}
+
+	for _, tc := range []struct {
+		name          string
+		existingNotes [][]note
+		wantMethod    string
+		wantPath      string
+	}{
+		{
+			name:          "create",
+			existingNotes: [][]note{{{ID: 1, Body: "LGTM"}}},
+			wantMethod:    http.MethodPost,
+			wantPath:      "/api/v4/projects/group%2Frepo/merge_requests/7/notes",
+		},
+		{
+			name: "update on the second page",
+			existingNotes: [][]note{
+				{{ID: 1, Body: "LGTM"}, {ID: 2, Body: common.CommentHeader + " by system", System: true}},
+				{{ID: 3, Body: common.CommentHeader + "\nold"}},
+			},
+			wantMethod: http.MethodPut,
+			wantPath:   "/api/v4/projects/group%2Frepo/merge_requests/7/notes/3",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var gotMethod, gotPath, gotBody string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "token", r.Header.Get("PRIVATE-TOKEN"))
+				if r.Method == http.MethodGet {
+					page := r.URL.Query().Get("page")
+					index := 0
+					if page == "2" {
+						index = 1
+					}
+					if index+1 < len(tc.existingNotes) {
+						w.Header().Set("X-Next-Page", "2")
+					}
+					require.NoError(t, json.NewEncoder(w).Encode(tc.existingNotes[index]))
+					return
+				}
+				gotMethod, gotPath = r.Method, r.URL.EscapedPath()
+				var body map[string]string
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				gotBody = body["body"]
+				w.WriteHeader(http.StatusCreated)
+				_, _ = w.Write([]byte("{}"))
+			}))
+			defer server.Close()
+
+			err := upsertMergeRequestComment(resp, &mergeRequestEnv{
+				APIURL:          server.URL + "/api/v4",
+				ProjectID:       "group/repo",
+				MergeRequestIID: "7",
+				Token:           "token",
+			})
+			require.NoError(t, err)
+			require.Equal(t, tc.wantMethod, gotMethod)
+			require.Equal(t, tc.wantPath, gotPath)
+			require.True(t, strings.HasPrefix(gotBody, common.CommentHeader))
+			require.Contains(t, gotBody, "🔴 High")
+		})
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"os/signal"
 	"syscall"
 
+	"github.com/pkg/errors"
+
 	"github.com/bytebase/bytebase/action/args"
 	"github.com/bytebase/bytebase/action/command"
 	"github.com/bytebase/bytebase/action/world"
@@ -32,6 +34,10 @@ func main() {
 
 	if err := cmd.ExecuteContext(ctx); err != nil {
 		slog.Error("failed to execute command", "error", fmt.Sprintf("%+v", err))
+		var exitCodeErr *command.ExitCodeError
+		if errors.As(err, &exitCodeErr) {
+			os.Exit(exitCodeErr.Code)
+		}
 		os.Exit(1)
 	}
 }
@@ -12,6 +12,10 @@ const (
 	GitLab
 	Bitbucket
 	AzureDevOps
+	Jenkins
+	CircleCI
+	Tekton
+	Buildkite
 )
 
 // String returns the string representation of the JobPlatform enum.
@@ -25,6 +29,14 @@ func (p JobPlatform) String() string {
 		return "Bitbucket"
 	case AzureDevOps:
 		return "Azure DevOps"
+	case Jenkins:
+		return "Jenkins"
+	case CircleCI:
+		return "CircleCI"
+	case Tekton:
+		return "Tekton"
+	case Buildkite:
+		return "Buildkite"
 	default:
 		return "Local"
 	}
@@ -48,5 +60,22 @@ func GetJobPlatform() JobPlatform {
 	if os.Getenv("SYSTEM_TEAMFOUNDATIONSERVERURI") != "" {
 		return AzureDevOps
 	}
+	// https://www.jenkins.io/doc/book/pipeline/jenkinsfile/#using-environment-variables
+	if os.Getenv("JENKINS_URL") != "" {
+		return Jenkins
+	}
+	// https://circleci.com/docs/variables/#built-in-environment-variables
+	if os.Getenv("CIRCLECI") == "true" {
+		return CircleCI
+	}
+	// https://buildkite.com/docs/pipelines/configure/environment-variables
+	if os.Getenv("BUILDKITE") == "true" {
+		return Buildkite
+	}
+	// Tekton doesn't set any environment variable, but mounts the /tekton directory into every step.
+	// https://tekton.dev/docs/pipelines/tasks/#reserved-directories
+	if _, err := os.Stat("/tekton/results"); err == nil {
+		return Tekton
+	}
 	return LocalPlatform
 }
@@ -19,6 +19,8 @@ type World struct {
 
 	// bytebase-action flags
 	Output               string
+	SARIFOutput          string // The SARIF file location of the check results.
+	JUnitOutput          string // The JUnit XML file location of the check results.
 	URL                  string
 	Timeout              time.Duration
 	ServiceAccount       string
@@ -42,6 +44,40 @@ type World struct {
 	// Custom linting rules in natural language for AI-powered validation.
 	CustomRules string
 
+	// bytebase-action format and lint flags
+	// The database engine of the files, e.g. POSTGRES.
+	Engine string
+	// Whether to only report the unformatted files instead of rewriting them.
+	FormatCheck bool
+
+	// bytebase-action lint flags
+	// The path of the SQL review config file in YAML or JSON.
+	ReviewConfig string
+	// The path of the database schema snapshot file in JSON.
+	SchemaSnapshot string
+
+	// bytebase-action pull-schema flags
+	// The database to pull the schema from.
+	// Format: instances/{instance}/databases/{database}
+	Database string
+	// The directory to write the schema files to.
+	OutputDir string
+	// Whether to remove the existing SQL files in the output directory before writing.
+	Clean bool
+
+	// bytebase-action release export and import flags
+	// The release to export.
+	// Format: projects/{project}/releases/{release}
+	Release string
+	// The path of the release bundle file.
+	Bundle string
+
+	// bytebase-action config flags
+	// The path of the config file in YAML.
+	ConfigFile string
+	// Whether to manage the workspace resources shared by all projects.
+	ConfigWorkspaceResources bool
+
 	// bytebase-action rollout flags
 	// Rollout up to the target-stage.
 	// Format: environments/{environment}
@@ -49,6 +85,10 @@ type World struct {
 	Plan              string
 	ReleaseIDTemplate string // Template for release ID
 	ReleaseIDTimezone string // Timezone for release ID variables
+	// The VCS source of the release, overriding the ones from the platform environment variables.
+	VCSRepository     string
+	VCSCommit         string
+	VCSPullRequestURL string
 
 	// Outputs
 	OutputMap struct {
@@ -56,10 +96,53 @@ type World struct {
 		Plan         string                     `json:"plan,omitempty"`
 		Rollout      string                     `json:"rollout,omitempty"`
 		CheckResults *v1pb.CheckReleaseResponse `json:"checkResults,omitempty"`
+		// Files that are not formatted, reported by format --check.
+		UnformattedFiles []string `json:"unformattedFiles,omitempty"`
+		// The preview of the rollout, reported by plan.
+		PlanPreview *PlanPreview `json:"planPreview,omitempty"`
+		// Files written by pull-schema.
+		PulledFiles []string `json:"pulledFiles,omitempty"`
+		// Resources that differ from the config file, reported by config diff and apply.
+		ChangedResources []string `json:"changedResources,omitempty"`
 	}
 	Rollout *v1pb.Rollout
 }
 
+// PlanPreview is the preview of the rollout impact of the release files.
+type PlanPreview struct {
+	// The title of the approval template required by the issue.
+	// Empty if no approval is required.
+	ApprovalTemplate string `json:"approvalTemplate,omitempty"`
+	// The roles to approve the issue in order.
+	ApprovalRoles []string `json:"approvalRoles,omitempty"`
+	// The risk level of the changes, e.g. HIGH.
+	RiskLevel string             `json:"riskLevel,omitempty"`
+	Databases []*DatabasePreview `json:"databases"`
+}
+
+// DatabasePreview is the preview of the changes to a database.
+type DatabasePreview struct {
+	// Format: instances/{instance}/databases/{database}
+	Database string `json:"database"`
+	// The release files to apply, in versioned mode.
+	PendingFiles []string `json:"pendingFiles,omitempty"`
+	// The release files already applied according to the revisions, in versioned mode.
+	AppliedFiles []string `json:"appliedFiles,omitempty"`
+	// The DDL generated from the schema files, in declarative mode.
+	Statement      string   `json:"statement,omitempty"`
+	StatementTypes []string `json:"statementTypes,omitempty"`
+	AffectedRows   int64    `json:"affectedRows"`
+	// The plan check advices with warning or error status.
+	Advices []*PlanCheckAdvice `json:"advices,omitempty"`
+}
+
+// PlanCheckAdvice is an advice of the plan checks.
+type PlanCheckAdvice struct {
+	Status  string `json:"status"`
+	Title   string `json:"title"`
+	Content string `json:"content"`
+}
+
 func NewWorld() *World {
 	return &World{
 		CurrentTime: time.Now(),
@@ -0,0 +1,69 @@
+package lsp
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	lsp "github.com/bytebase/lsp-protocol"
+	"github.com/sourcegraph/jsonrpc2"
+
+	parserbase "github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func (h *Handler) handleTextDocumentFormatting(ctx context.Context, params lsp.DocumentFormattingParams) ([]lsp.TextEdit, error) {
+	if !IsURI(params.TextDocument.URI) {
+		return nil, &jsonrpc2.Error{
+			Code:    jsonrpc2.CodeInvalidParams,
+			Message: fmt.Sprintf("textDocument/formatting not yet supported for out-of-workspace URI (%q)", params.TextDocument.URI),
+		}
+	}
+	content, err := h.readFile(ctx, params.TextDocument.URI)
+	if err != nil {
+		return nil, err
+	}
+	if len(content) > contentLengthLimit {
+		// We don't want to format a huge file.
+		return []lsp.TextEdit{}, nil
+	}
+
+	engine := h.getEngineType(ctx)
+	formatted, err := parserbase.Format(engine, string(content), parserbase.FormatOptions{
+		IndentSize: int(params.Options.TabSize),
+		UseTab:     !params.Options.InsertSpaces,
+	})
+	if err != nil {
+		slog.Debug("Failed to format document", slog.String("engine", engine.String()), slog.String("error", err.Error()))
+		return []lsp.TextEdit{}, nil
+	}
+	if formatted == string(content) {
+		return []lsp.TextEdit{}, nil
+	}
+	return []lsp.TextEdit{
+		{
+			Range: lsp.Range{
+				Start: lsp.Position{Line: 0, Character: 0},
+				End:   endPosition(content),
+			},
+			NewText: formatted,
+		},
+	}, nil
+}
+
+// endPosition returns the LSP position after the last character of the content.
+func endPosition(content []byte) lsp.Position {
+	var line, character uint32
+	for len(content) > 0 {
+		r, size := utf8.DecodeRune(content)
+		content = content[size:]
+		if r == '\n' {
+			line++
+			character = 0
+			continue
+		}
+		character += uint32(utf16.RuneLen(r))
+	}
+	return lsp.Position{Line: line, Character: character}
+}
@@ -0,0 +1,36 @@
+package lsp
+
+import (
+	"testing"
+
+	lsp "github.com/bytebase/lsp-protocol"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEndPosition(t *testing.T) {
+	testCases := []struct {
+		content  string
+		expected lsp.Position
+	}{
+		{
+			content:  "",
+			expected: lsp.Position{Line: 0, Character: 0},
+		},
+		{
+			content:  "SELECT 1;",
+			expected: lsp.Position{Line: 0, Character: 9},
+		},
+		{
+			content:  "SELECT 1;\n",
+			expected: lsp.Position{Line: 1, Character: 0},
+		},
+		{
+			content:  "SELECT '世界';\nSELECT '😀'",
+			expected: lsp.Position{Line: 1, Character: 11},
+		},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expected, endPosition([]byte(tc.content)), tc.content)
+	}
+}
@@ -34,6 +34,7 @@ const (
 	LSPMethodSetTrace       Method = "$/setTrace"
 	LSPMethodExecuteCommand Method = "workspace/executeCommand"
 	LSPMethodCompletion     Method = "textDocument/completion"
+	LSPMethodFormatting     Method = "textDocument/formatting"
 
 	LSPMethodTextDocumentDidOpen   Method = "textDocument/didOpen"
 	LSPMethodTextDocumentDidChange Method = "textDocument/didChange"
@@ -319,6 +320,7 @@ func (h *Handler) handle(ctx context.Context, conn *jsonrpc2.Conn, req *jsonrpc2
 				ExecuteCommandProvider: &lsp.ExecuteCommandOptions{
 					Commands: []string{string(CommandNameSetMetadata)},
 				},
+				DocumentFormattingProvider: &lsp.Or_ServerCapabilities_documentFormattingProvider{Value: true},
 			},
 		}, nil
 	case LSPMethodInitialized:
@@ -403,6 +405,15 @@ func (h *Handler) handle(ctx context.Context, conn *jsonrpc2.Conn, req *jsonrpc2
 			h.cancelF.Delete(req.ID)
 		}()
 		return h.handleTextDocumentCompletion(childCtx, conn, req, params)
+	case LSPMethodFormatting:
+		if req.Params == nil {
+			return nil, &jsonrpc2.Error{Code: jsonrpc2.CodeInvalidParams}
+		}
+		var params lsp.DocumentFormattingParams
+		if err := json.Unmarshal(*req.Params, &params); err != nil {
+			return nil, err
+		}
+		return h.handleTextDocumentFormatting(ctx, params)
 	default:
 		if isFileSystemRequest(req.Method) {
 			_, _, err := h.handleFileSystemRequest(ctx, conn, req)
@@ -345,13 +345,13 @@ func (s *Server) planCheckBudget() time.Duration {
 	return planCheckPollBudget
 }
 
-// runPlanChecks triggers plan checks and polls for results within the poll budget.
+// runPlanChecks triggers plan checks and waits for results within the poll budget.
 func (s *Server) runPlanChecks(ctx context.Context, planName string) *PlanCheckInfo {
 	checkRunName := planName + "/planCheckRun"
 
 	// Trigger plan checks. If this fails, still poll — CreatePlan already
 	// initializes plan checks server-side, so they may be running regardless.
-	_, _ = s.apiRequest(ctx, "/bytebase.v1.PlanService/RunPlanChecks", map[string]any{
+	runResp, runErr := s.apiRequest(ctx, "/bytebase.v1.PlanService/RunPlanChecks", map[string]any{
 		"name": planName,
 	})
 
@@ -360,6 +360,14 @@ func (s *Server) runPlanChecks(ctx context.Context, planName string) *PlanCheckI
 		PlanCheckRun: checkRunName,
 	}
 
+	// Wait on the operation tracking the plan check run. Fall back to polling
+	// the plan check run if there is no operation or the wait fails.
+	if runErr == nil && runResp.Status < 400 {
+		if info, ok := s.waitPlanCheckOperation(ctx, runResp.Body, pendingResult); ok {
+			return info
+		}
+	}
+
 	// Poll for results. Transient errors (404 before row visible, brief 500)
 	// are retried within the budget rather than bailing immediately.
 	deadline := time.Now().Add(s.planCheckBudget())
@@ -398,6 +406,53 @@ func (s *Server) runPlanChecks(ctx context.Context, planName string) *PlanCheckI
 	return pendingResult
 }
 
+// waitPlanCheckOperation waits on the operation returned by RunPlanChecks within the poll budget.
+// It returns false if the response has no operation or the wait fails.
+func (s *Server) waitPlanCheckOperation(ctx context.Context, runPlanChecksBody json.RawMessage, pendingResult *PlanCheckInfo) (*PlanCheckInfo, bool) {
+	var runResp struct {
+		Operation *operationResponse `json:"operation"`
+	}
+	if err := json.Unmarshal(runPlanChecksBody, &runResp); err != nil || runResp.Operation == nil || runResp.Operation.Name == "" {
+		return nil, false
+	}
+
+	waitResp, err := s.apiRequest(ctx, "/bytebase.v1.OperationService/WaitOperation", map[string]any{
+		"name":    runResp.Operation.Name,
+		"timeout": fmt.Sprintf("%gs", s.planCheckBudget().Seconds()),
+	})
+	if err != nil || waitResp.Status >= 400 {
+		return nil, false
+	}
+	var op operationResponse
+	if err := json.Unmarshal(waitResp.Body, &op); err != nil {
+		return nil, false
+	}
+
+	switch op.State {
+	case "SUCCEEDED":
+		// The response is the finished plan check run, or empty if there is nothing to check.
+		var checkRun planCheckRunResponse
+		if len(op.Response) > 0 {
+			if err := json.Unmarshal(op.Response, &checkRun); err != nil {
+				return nil, false
+			}
+		}
+		return buildPlanCheckInfo(checkRun), true
+	case "FAILED", "CANCELED":
+		return &PlanCheckInfo{Status: planCheckFailed}, true
+	default:
+		// Budget exhausted.
+		return pendingResult, true
+	}
+}
+
+// operationResponse mirrors the Operation proto response.
+type operationResponse struct {
+	Name     string          `json:"name"`
+	State    string          `json:"state"`
+	Response json.RawMessage `json:"response"`
+}
+
 // planCheckRunResponse mirrors the PlanCheckRun proto response.
 type planCheckRunResponse struct {
 	Status  string                   `json:"status"`
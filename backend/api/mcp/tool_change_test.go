@@ -25,6 +25,7 @@ type changeMock struct {
 	planResponse         map[string]any
 	planStatus           int
 	runPlanChecksStatus  int
+	operationResponse    map[string]any
 	planCheckRunResponse map[string]any
 	planCheckRunStatus   int
 	issueResponse        map[string]any
@@ -84,8 +85,15 @@ func (m *changeMock) handler() http.Handler {
 
 		case strings.Contains(r.URL.Path, "PlanService/RunPlanChecks"):
 			w.WriteHeader(m.runPlanChecksStatus)
+			if m.operationResponse != nil {
+				_ = json.NewEncoder(w).Encode(map[string]any{"operation": map[string]any{"name": "operations/5005", "state": "RUNNING"}})
+				return
+			}
 			_ = json.NewEncoder(w).Encode(map[string]any{})
 
+		case strings.Contains(r.URL.Path, "OperationService/WaitOperation"):
+			_ = json.NewEncoder(w).Encode(m.operationResponse)
+
 		case strings.Contains(r.URL.Path, "PlanService/GetPlanCheckRun"):
 			w.WriteHeader(m.planCheckRunStatus)
 			_ = json.NewEncoder(w).Encode(m.planCheckRunResponse)
@@ -498,6 +506,69 @@ func TestChange_PlanChecks_RunPlanChecksAPIFailure(t *testing.T) {
 	require.NotEmpty(t, output.Issue)
 }
 
+func TestChange_PlanChecks_Operation(t *testing.T) {
+	tests := []struct {
+		name              string
+		operationResponse map[string]any
+		wantStatus        string
+		wantErrors        int
+	}{
+		{
+			name: "succeeded",
+			operationResponse: map[string]any{
+				"name":  "operations/5005",
+				"state": "SUCCEEDED",
+				"response": map[string]any{
+					"@type":   "type.googleapis.com/bytebase.v1.PlanCheckRun",
+					"status":  "DONE",
+					"results": []any{map[string]any{"status": "ERROR", "title": "Syntax error near line 1"}},
+				},
+			},
+			wantStatus: "DONE",
+			wantErrors: 1,
+		},
+		{
+			name:              "nothing to check",
+			operationResponse: map[string]any{"name": "operations/5005", "state": "SUCCEEDED"},
+			wantStatus:        "DONE",
+		},
+		{
+			name:              "failed",
+			operationResponse: map[string]any{"name": "operations/5005", "state": "FAILED"},
+			wantStatus:        "FAILED",
+		},
+		{
+			name:              "still running",
+			operationResponse: map[string]any{"name": "operations/5005", "state": "RUNNING"},
+			wantStatus:        "RUNNING",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mock := newChangeMock(employeeDB())
+			mock.operationResponse = tc.operationResponse
+			// The plan check run is not polled when the operation is available.
+			mock.planCheckRunStatus = http.StatusInternalServerError
+			s := newChangeTestServer(t, mock)
+
+			_, structured, err := s.handleChange(testContext(), nil, ChangeInput{
+				Database: "employee_db",
+				SQL:      "ALTER TABLE x ADD COLUMN y INT",
+				Title:    "Test",
+			})
+			require.NoError(t, err)
+
+			output, ok := structured.(*ChangeOutput)
+			require.True(t, ok)
+			require.NotNil(t, output.PlanChecks)
+			require.Equal(t, tc.wantStatus, output.PlanChecks.Status)
+			if tc.wantStatus == "DONE" {
+				require.Equal(t, tc.wantErrors, output.PlanChecks.Summary.Error)
+			}
+		})
+	}
+}
+
 func TestChange_PlanChecks_PollFailure(t *testing.T) {
 	mock := newChangeMock(employeeDB())
 	mock.planCheckRunStatus = http.StatusInternalServerError
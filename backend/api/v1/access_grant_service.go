@@ -12,6 +12,7 @@ import (
 
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/component/bus"
+	"github.com/bytebase/bytebase/backend/component/iam"
 	"github.com/bytebase/bytebase/backend/component/webhook"
 	"github.com/bytebase/bytebase/backend/enterprise"
 	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
@@ -28,15 +29,17 @@ type AccessGrantService struct {
 	licenseService *enterprise.LicenseService
 	webhookManager *webhook.Manager
 	bus            *bus.Bus
+	iamManager     *iam.Manager
 }
 
 // NewAccessGrantService returns a new access grant service instance.
-func NewAccessGrantService(store *store.Store, licenseService *enterprise.LicenseService, webhookManager *webhook.Manager, bus *bus.Bus) *AccessGrantService {
+func NewAccessGrantService(store *store.Store, licenseService *enterprise.LicenseService, webhookManager *webhook.Manager, bus *bus.Bus, iamManager *iam.Manager) *AccessGrantService {
 	return &AccessGrantService{
 		store:          store,
 		licenseService: licenseService,
 		webhookManager: webhookManager,
 		bus:            bus,
+		iamManager:     iamManager,
 	}
 }
 
@@ -144,12 +147,22 @@ func (s *AccessGrantService) CreateAccessGrant(ctx context.Context, request *con
 	if len(ag.Targets) == 0 {
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("targets is required"))
 	}
-	if ag.Query == "" {
-		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("query is required"))
-	}
 	if ag.Reason == "" {
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("reason is required"))
 	}
+	if ag.BreakGlass {
+		grant, err := s.createBreakGlassAccessGrant(ctx, projectID, ag)
+		if err != nil {
+			return nil, err
+		}
+		return connect.NewResponse(convertToAccessGrant(grant)), nil
+	}
+	if ag.Admin {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("admin is only supported for break-glass access"))
+	}
+	if ag.Query == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("query is required"))
+	}
 
 	// Validate the query is a read-only statement (SELECT).
 	instanceID, _, err := common.GetInstanceDatabaseID(ag.Targets[0])
@@ -294,6 +307,11 @@ func activateAccessGrant(ctx context.Context, stores *store.Store, accessGrantNa
 	if grant == nil {
 		return nil, connect.NewError(connect.CodeNotFound, errors.Errorf("access grant %q not found", accessGrantName))
 	}
+	// Break-glass grants are activated at creation. Their issue is the post-hoc review,
+	// and approving it must not activate the grant again.
+	if grant.Payload.GetBreakGlass() {
+		return grant, nil
+	}
 
 	update := &store.UpdateAccessGrantMessage{
 		Status: new(storepb.AccessGrant_ACTIVE),
@@ -429,6 +447,8 @@ func convertToAccessGrant(msg *store.AccessGrantMessage) *v1pb.AccessGrant {
 		ag.Targets = p.Targets
 		ag.Query = p.Query
 		ag.Unmask = p.Unmask
+		ag.BreakGlass = p.BreakGlass
+		ag.Admin = p.Admin
 		if p.IssueId != 0 {
 			ag.Issue = common.FormatIssue(msg.ProjectID, p.IssueId)
 		}
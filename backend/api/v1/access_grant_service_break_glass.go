@@ -0,0 +1,245 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/common/permission"
+	"github.com/bytebase/bytebase/backend/component/webhook"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+const maximumBreakGlassDuration = 24 * time.Hour
+
+// validateBreakGlassAccess validates the break-glass access setting of a project.
+func validateBreakGlassAccess(breakGlass *v1pb.Project_BreakGlassAccess) error {
+	if breakGlass == nil || !breakGlass.Enabled {
+		return nil
+	}
+	if breakGlass.MaximumDuration == nil {
+		return errors.New("maximum duration is required")
+	}
+	if d := breakGlass.MaximumDuration.AsDuration(); d <= 0 || d > maximumBreakGlassDuration {
+		return errors.Errorf("maximum duration must be between 0 and %v", maximumBreakGlassDuration)
+	}
+	for _, contact := range breakGlass.SecurityContacts {
+		if _, err := common.GetUserEmail(contact); err != nil {
+			return errors.Wrapf(err, "invalid security contact %q", contact)
+		}
+	}
+	return nil
+}
+
+// createBreakGlassAccessGrant creates a break-glass access grant.
+// The grant is activated immediately without approval, and the security contacts of the project are paged.
+func (s *AccessGrantService) createBreakGlassAccessGrant(ctx context.Context, projectID string, ag *v1pb.AccessGrant) (*store.AccessGrantMessage, error) {
+	workspaceID := common.GetWorkspaceIDFromContext(ctx)
+	user, ok := GetUserFromContext(ctx)
+	if !ok || user == nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("user not found"))
+	}
+	ok, err := s.iamManager.CheckPermission(ctx, permission.AccessGrantsBreakGlass, user, workspaceID, projectID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to check permission with error: %v", err.Error()))
+	}
+	if !ok {
+		return nil, connect.NewError(connect.CodePermissionDenied, errors.Errorf("user does not have permission %q", permission.AccessGrantsBreakGlass))
+	}
+
+	project, err := s.store.GetProject(ctx, &store.FindProjectMessage{Workspace: workspaceID, ResourceID: &projectID})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get project %v", projectID))
+	}
+	if project == nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.Errorf("project %q not found", projectID))
+	}
+	setting := project.Setting.GetBreakGlassAccess()
+	if !setting.GetEnabled() {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.Errorf("break-glass access is not enabled in project %q", projectID))
+	}
+
+	creatorEmail, err := common.GetUserEmail(ag.Creator)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Wrapf(err, "invalid creator"))
+	}
+	if creatorEmail != user.Email {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("break-glass access can only be granted to the caller"))
+	}
+	if ag.Query != "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("query must be empty for break-glass access"))
+	}
+	for _, target := range ag.Targets {
+		instanceID, databaseName, err := common.GetInstanceDatabaseID(target)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.Wrapf(err, "invalid target %q", target))
+		}
+		database, err := s.store.GetDatabase(ctx, &store.FindDatabaseMessage{
+			Workspace:    workspaceID,
+			InstanceID:   &instanceID,
+			DatabaseName: &databaseName,
+		})
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get database %q", target))
+		}
+		if database == nil || database.ProjectID != projectID {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("database %q is not in project %q", target, projectID))
+		}
+	}
+
+	now := time.Now()
+	var expireTime time.Time
+	switch exp := ag.Expiration.(type) {
+	case *v1pb.AccessGrant_ExpireTime:
+		if exp.ExpireTime == nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("expire_time is required"))
+		}
+		expireTime = exp.ExpireTime.AsTime()
+	case *v1pb.AccessGrant_Ttl:
+		if exp.Ttl == nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("ttl is required"))
+		}
+		expireTime = now.Add(exp.Ttl.AsDuration())
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("expiration (expire_time or ttl) is required"))
+	}
+	if !expireTime.After(now) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("expiration must be in the future"))
+	}
+	if maximum := setting.GetMaximumDuration().AsDuration(); expireTime.Sub(now) > maximum {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("break-glass access cannot exceed %v", maximum))
+	}
+
+	grant, err := s.store.CreateAccessGrant(ctx, &store.AccessGrantMessage{
+		ProjectID:  projectID,
+		Creator:    user.Email,
+		Status:     storepb.AccessGrant_ACTIVE,
+		ExpireTime: &expireTime,
+		Payload: &storepb.AccessGrantPayload{
+			Targets:    ag.Targets,
+			Unmask:     ag.Unmask,
+			Reason:     ag.Reason,
+			BreakGlass: true,
+			Admin:      ag.Admin,
+		},
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to create access grant"))
+	}
+
+	s.webhookManager.CreateEvent(ctx, &webhook.Event{
+		Type:    storepb.Activity_ACCESS_GRANT_BREAK_GLASS,
+		Project: webhook.NewProject(project),
+		BreakGlass: &webhook.EventAccessGrantBreakGlass{
+			Creator: &webhook.User{
+				Name:  user.Name,
+				Email: user.Email,
+			},
+			Targets:          ag.Targets,
+			Reason:           ag.Reason,
+			ExpireTime:       expireTime,
+			SecurityContacts: s.getSecurityContacts(ctx, setting),
+		},
+	})
+
+	return grant, nil
+}
+
+func (s *AccessGrantService) getSecurityContacts(ctx context.Context, setting *storepb.Project_BreakGlassAccess) []webhook.User {
+	var contacts []webhook.User
+	for _, contact := range setting.GetSecurityContacts() {
+		email, err := common.GetUserEmail(contact)
+		if err != nil {
+			continue
+		}
+		account, err := s.store.GetAccountByEmail(ctx, email)
+		if err != nil {
+			slog.Warn("failed to get security contact", slog.String("contact", contact), log.BBError(err))
+			continue
+		}
+		if account == nil {
+			continue
+		}
+		contacts = append(contacts, webhook.User{
+			Name:  account.Name,
+			Email: account.Email,
+		})
+	}
+	return contacts
+}
+
+// findBreakGlassAccessGrant finds the active break-glass access grant of the user on the database.
+// If admin is true, only the grants allowing the admin mode are returned.
+func (s *SQLService) findBreakGlassAccessGrant(ctx context.Context, project *store.ProjectMessage, user *store.UserMessage, database *store.DatabaseMessage, admin bool) *store.AccessGrantMessage {
+	if !project.Setting.GetBreakGlassAccess().GetEnabled() {
+		return nil
+	}
+
+	filter := fmt.Sprintf(
+		`break_glass == true && status == "ACTIVE" && target == %q && expire_time > %q`,
+		common.FormatDatabase(database.InstanceID, database.DatabaseName),
+		time.Now().UTC().Format(time.RFC3339),
+	)
+	filterQ, err := store.GetListAccessGrantFilter(filter)
+	if err != nil {
+		slog.Warn("failed to build break-glass access grant filter", log.BBError(err))
+		return nil
+	}
+	grants, err := s.store.ListAccessGrants(ctx, &store.FindAccessGrantMessage{
+		Workspace: common.GetWorkspaceIDFromContext(ctx),
+		ProjectID: &database.ProjectID,
+		Creator:   &user.Email,
+		FilterQ:   filterQ,
+	})
+	if err != nil {
+		slog.Warn("failed to list break-glass access grants", log.BBError(err))
+		return nil
+	}
+
+	var found *store.AccessGrantMessage
+	for _, grant := range grants {
+		if admin && !grant.Payload.GetAdmin() {
+			continue
+		}
+		// Prefer the grant with unmask=true.
+		if grant.Payload.GetUnmask() {
+			return grant
+		}
+		if found == nil {
+			found = grant
+		}
+	}
+	return found
+}
+
+// checkAdminExecutePermission checks whether the user can run statements in the admin mode on the database.
+// Users without bb.sql.admin can use an active break-glass admin access grant, which is returned to tag the query history.
+func (s *SQLService) checkAdminExecutePermission(ctx context.Context, user *store.UserMessage, database *store.DatabaseMessage) (*store.AccessGrantMessage, error) {
+	workspaceID := common.GetWorkspaceIDFromContext(ctx)
+	ok, err := s.iamManager.CheckPermission(ctx, permission.SQLAdmin, user, workspaceID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to check permission with error: %v", err.Error()))
+	}
+	if ok {
+		return nil, nil
+	}
+
+	project, err := s.store.GetProject(ctx, &store.FindProjectMessage{Workspace: workspaceID, ResourceID: &database.ProjectID})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get project"))
+	}
+	if project != nil {
+		if grant := s.findBreakGlassAccessGrant(ctx, project, user, database, true /* admin */); grant != nil {
+			return grant, nil
+		}
+	}
+	return nil, connect.NewError(connect.CodePermissionDenied, errors.Errorf("user does not have permission %q", permission.SQLAdmin))
+}
@@ -0,0 +1,496 @@
+package v1
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/common/permission"
+	"github.com/bytebase/bytebase/backend/component/export"
+	"github.com/bytebase/bytebase/backend/component/iam"
+	"github.com/bytebase/bytebase/backend/enterprise"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+	"github.com/bytebase/bytebase/backend/generated-go/v1/v1connect"
+	"github.com/bytebase/bytebase/backend/runner/accessreview"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+// AccessReviewService implements the access review service.
+type AccessReviewService struct {
+	v1connect.UnimplementedAccessReviewServiceHandler
+	store          *store.Store
+	licenseService *enterprise.LicenseService
+	iamManager     *iam.Manager
+}
+
+// NewAccessReviewService returns a new access review service instance.
+func NewAccessReviewService(store *store.Store, licenseService *enterprise.LicenseService, iamManager *iam.Manager) *AccessReviewService {
+	return &AccessReviewService{
+		store:          store,
+		licenseService: licenseService,
+		iamManager:     iamManager,
+	}
+}
+
+// CreateAccessReview creates an access review campaign.
+func (s *AccessReviewService) CreateAccessReview(ctx context.Context, request *connect.Request[v1pb.CreateAccessReviewRequest]) (*connect.Response[v1pb.AccessReview], error) {
+	workspaceID := common.GetWorkspaceIDFromContext(ctx)
+	if err := s.licenseService.IsFeatureEnabled(ctx, workspaceID, v1pb.PlanFeature_FEATURE_AUDIT_LOG); err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+	user, ok := GetUserFromContext(ctx)
+	if !ok || user == nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("user not found"))
+	}
+	review := request.Msg.AccessReview
+	if review == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("access review is required"))
+	}
+	if strings.TrimSpace(review.Title) == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("title is required"))
+	}
+	if review.EndTime == nil || !review.EndTime.AsTime().After(time.Now()) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("end time must be in the future"))
+	}
+	for _, project := range review.Projects {
+		projectID, err := common.GetProjectID(project)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		p, err := s.store.GetProject(ctx, &store.FindProjectMessage{
+			Workspace:  workspaceID,
+			ResourceID: &projectID,
+		})
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get project"))
+		}
+		if p == nil || p.Deleted {
+			return nil, connect.NewError(connect.CodeNotFound, errors.Errorf("project %q not found", project))
+		}
+	}
+
+	create := &store.AccessReviewMessage{
+		Workspace: workspaceID,
+		Creator:   user.Email,
+		State:     storepb.AccessReview_ACTIVE,
+		EndTime:   review.EndTime.AsTime(),
+		Payload: &storepb.AccessReviewPayload{
+			Title:            review.Title,
+			Description:      review.Description,
+			Projects:         review.Projects,
+			IncludeWorkspace: review.IncludeWorkspace,
+		},
+	}
+	entries, err := accessreview.Snapshot(ctx, s.store, create)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to snapshot access"))
+	}
+	created, err := s.store.CreateAccessReview(ctx, create, entries)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to create access review"))
+	}
+	result, err := s.convertToAccessReview(ctx, created)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(result), nil
+}
+
+// GetAccessReview gets an access review campaign by name.
+func (s *AccessReviewService) GetAccessReview(ctx context.Context, request *connect.Request[v1pb.GetAccessReviewRequest]) (*connect.Response[v1pb.AccessReview], error) {
+	review, err := s.getAccessReview(ctx, request.Msg.Name)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.convertToAccessReview(ctx, review)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(result), nil
+}
+
+// ListAccessReviews lists access review campaigns.
+func (s *AccessReviewService) ListAccessReviews(ctx context.Context, request *connect.Request[v1pb.ListAccessReviewsRequest]) (*connect.Response[v1pb.ListAccessReviewsResponse], error) {
+	offset, err := parseLimitAndOffset(&pageSize{
+		token:   request.Msg.PageToken,
+		limit:   int(request.Msg.PageSize),
+		maximum: 1000,
+	})
+	if err != nil {
+		return nil, err
+	}
+	limitPlusOne := offset.limit + 1
+	reviews, err := s.store.ListAccessReviews(ctx, &store.FindAccessReviewMessage{
+		Workspace: common.GetWorkspaceIDFromContext(ctx),
+		Limit:     &limitPlusOne,
+		Offset:    &offset.offset,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to list access reviews"))
+	}
+
+	resp := &v1pb.ListAccessReviewsResponse{}
+	if len(reviews) == limitPlusOne {
+		nextPageToken, err := offset.getNextPageToken()
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get next page token"))
+		}
+		resp.NextPageToken = nextPageToken
+		reviews = reviews[:offset.limit]
+	}
+	for _, review := range reviews {
+		result, err := s.convertToAccessReview(ctx, review)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		resp.AccessReviews = append(resp.AccessReviews, result)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// ListAccessReviewEntries lists the entries of an access review campaign.
+// The callers without the permission to get the campaigns only see the entries they can review.
+func (s *AccessReviewService) ListAccessReviewEntries(ctx context.Context, request *connect.Request[v1pb.ListAccessReviewEntriesRequest]) (*connect.Response[v1pb.ListAccessReviewEntriesResponse], error) {
+	req := request.Msg
+	workspaceID := common.GetWorkspaceIDFromContext(ctx)
+	user, ok := GetUserFromContext(ctx)
+	if !ok || user == nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("user not found"))
+	}
+
+	find := &store.FindAccessReviewEntryMessage{Workspace: workspaceID}
+	if req.Parent != common.FormatAccessReview("-") {
+		review, err := s.getAccessReview(ctx, req.Parent)
+		if err != nil {
+			return nil, err
+		}
+		find.ReviewID = &review.ID
+	}
+	if req.Decision != v1pb.AccessReviewEntry_DECISION_UNSPECIFIED {
+		find.Decision = new(storepb.AccessReviewEntry_Decision(req.Decision))
+	}
+
+	offset, err := parseLimitAndOffset(&pageSize{
+		token:   req.PageToken,
+		limit:   int(req.PageSize),
+		maximum: 1000,
+	})
+	if err != nil {
+		return nil, err
+	}
+	limitPlusOne := offset.limit + 1
+
+	canGet, err := s.iamManager.CheckPermission(ctx, permission.AccessReviewsGet, user, workspaceID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to check permission"))
+	}
+	var entries []*store.AccessReviewEntryMessage
+	if canGet {
+		find.Limit = &limitPlusOne
+		find.Offset = &offset.offset
+		if entries, err = s.store.ListAccessReviewEntries(ctx, find); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to list access review entries"))
+		}
+	} else {
+		// The reviewers are resolved with the group membership, so the entries are filtered and paginated in memory.
+		all, err := s.store.ListAccessReviewEntries(ctx, find)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to list access review entries"))
+		}
+		var reviewable []*store.AccessReviewEntryMessage
+		for _, entry := range all {
+			if accessreview.CanReview(ctx, s.store, workspaceID, entry, user) {
+				reviewable = append(reviewable, entry)
+			}
+		}
+		if offset.offset < len(reviewable) {
+			entries = reviewable[offset.offset:min(len(reviewable), offset.offset+limitPlusOne)]
+		}
+	}
+
+	resp := &v1pb.ListAccessReviewEntriesResponse{}
+	if len(entries) == limitPlusOne {
+		nextPageToken, err := offset.getNextPageToken()
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get next page token"))
+		}
+		resp.NextPageToken = nextPageToken
+		entries = entries[:offset.limit]
+	}
+	for _, entry := range entries {
+		resp.Entries = append(resp.Entries, convertToAccessReviewEntry(entry))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// ReviewAccessReviewEntry keeps or revokes an entry of an active access review campaign.
+func (s *AccessReviewService) ReviewAccessReviewEntry(ctx context.Context, request *connect.Request[v1pb.ReviewAccessReviewEntryRequest]) (*connect.Response[v1pb.AccessReviewEntry], error) {
+	req := request.Msg
+	workspaceID := common.GetWorkspaceIDFromContext(ctx)
+	user, ok := GetUserFromContext(ctx)
+	if !ok || user == nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("user not found"))
+	}
+	if req.Decision != v1pb.AccessReviewEntry_KEEP && req.Decision != v1pb.AccessReviewEntry_REVOKE {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("decision must be KEEP or REVOKE, got %s", req.Decision))
+	}
+	if strings.TrimSpace(req.Justification) == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("justification is required"))
+	}
+	reviewID, entryID, err := common.GetAccessReviewIDEntryID(req.Name)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	review, err := s.getAccessReview(ctx, common.FormatAccessReview(reviewID))
+	if err != nil {
+		return nil, err
+	}
+	if review.State != storepb.AccessReview_ACTIVE || !time.Now().Before(review.EndTime) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.Errorf("access review %q has ended", review.Payload.Title))
+	}
+	entry, err := s.store.GetAccessReviewEntry(ctx, &store.FindAccessReviewEntryMessage{
+		Workspace: workspaceID,
+		ReviewID:  &reviewID,
+		ID:        &entryID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get access review entry"))
+	}
+	if entry == nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.Errorf("access review entry %q not found", req.Name))
+	}
+
+	// Nobody reviews their own access, including the users who can update any access review.
+	if accessreview.IsEntrySubject(ctx, s.store, workspaceID, entry, user) {
+		return nil, connect.NewError(connect.CodePermissionDenied, errors.Errorf("user %q cannot review their own access %q", user.Email, req.Name))
+	}
+	canUpdate, err := s.iamManager.CheckPermission(ctx, permission.AccessReviewsUpdate, user, workspaceID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to check permission"))
+	}
+	if !canUpdate && !accessreview.CanReview(ctx, s.store, workspaceID, entry, user) {
+		return nil, connect.NewError(connect.CodePermissionDenied, errors.Errorf("user %q is not a reviewer of %q", user.Email, req.Name))
+	}
+
+	entry.Decision = storepb.AccessReviewEntry_Decision(req.Decision)
+	entry.Payload.Reviewer = common.FormatUserEmail(user.Email)
+	entry.Payload.Justification = req.Justification
+	entry.Payload.ReviewTime = timestamppb.Now()
+	if err := s.store.UpdateAccessReviewEntry(ctx, entry.ID, &store.UpdateAccessReviewEntryMessage{
+		Decision: &entry.Decision,
+		Payload:  entry.Payload,
+	}); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to update access review entry"))
+	}
+	return connect.NewResponse(convertToAccessReviewEntry(entry)), nil
+}
+
+// CompleteAccessReview completes an active access review campaign before its end time.
+func (s *AccessReviewService) CompleteAccessReview(ctx context.Context, request *connect.Request[v1pb.CompleteAccessReviewRequest]) (*connect.Response[v1pb.AccessReview], error) {
+	user, ok := GetUserFromContext(ctx)
+	if !ok || user == nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("user not found"))
+	}
+	// Share the lock with the access review runner, so a campaign is not completed twice.
+	lock, acquired, err := store.TryAdvisoryLock(ctx, s.store.GetDB(), store.AdvisoryLockKeyAccessReview)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to acquire access review lock"))
+	}
+	if !acquired {
+		return nil, connect.NewError(connect.CodeAborted, errors.New("access reviews are being completed, please try again later"))
+	}
+	defer func() {
+		if err := lock.Release(); err != nil {
+			slog.Error("Failed to release access review advisory lock", log.BBError(err))
+		}
+	}()
+
+	review, err := s.getAccessReview(ctx, request.Msg.Name)
+	if err != nil {
+		return nil, err
+	}
+	if review.State != storepb.AccessReview_ACTIVE {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.Errorf("access review %q is already completed", request.Msg.Name))
+	}
+	if err := accessreview.Complete(ctx, s.store, review, common.FormatUserEmail(user.Email)); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to complete access review"))
+	}
+	review, err = s.getAccessReview(ctx, request.Msg.Name)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.convertToAccessReview(ctx, review)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(result), nil
+}
+
+// ExportAccessReview exports the evidence report of an access review campaign.
+func (s *AccessReviewService) ExportAccessReview(ctx context.Context, request *connect.Request[v1pb.ExportAccessReviewRequest]) (*connect.Response[v1pb.ExportAccessReviewResponse], error) {
+	review, err := s.getAccessReview(ctx, request.Msg.Name)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := s.store.ListAccessReviewEntries(ctx, &store.FindAccessReviewEntryMessage{
+		Workspace: review.Workspace,
+		ReviewID:  &review.ID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to list access review entries"))
+	}
+
+	result := buildAccessReviewReport(review, entries)
+	var content []byte
+	switch request.Msg.Format {
+	case v1pb.ExportFormat_CSV:
+		if content, err = export.CSV(result); err != nil {
+			return nil, err
+		}
+	case v1pb.ExportFormat_JSON:
+		if content, err = export.JSON(result); err != nil {
+			return nil, err
+		}
+	case v1pb.ExportFormat_XLSX:
+		if content, err = export.XLSX(result); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("unsupported export format: %s", request.Msg.Format.String()))
+	}
+	return connect.NewResponse(&v1pb.ExportAccessReviewResponse{Content: content}), nil
+}
+
+func (s *AccessReviewService) getAccessReview(ctx context.Context, name string) (*store.AccessReviewMessage, error) {
+	reviewID, err := common.GetAccessReviewID(name)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	review, err := s.store.GetAccessReview(ctx, &store.FindAccessReviewMessage{
+		Workspace: common.GetWorkspaceIDFromContext(ctx),
+		ID:        &reviewID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get access review"))
+	}
+	if review == nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.Errorf("access review %q not found", name))
+	}
+	return review, nil
+}
+
+// buildAccessReviewReport builds the evidence report with a row for each entry of the campaign.
+func buildAccessReviewReport(review *store.AccessReviewMessage, entries []*store.AccessReviewEntryMessage) *v1pb.QueryResult {
+	result := &v1pb.QueryResult{
+		ColumnNames: []string{"access_review", "title", "end_time", "entry", "project", "role", "member", "condition", "access_grant", "targets", "reviewers", "decision", "reviewer", "justification", "review_time", "auto_revoked", "revoke_error"},
+	}
+	stringValue := func(s string) *v1pb.RowValue {
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: s}}
+	}
+	for _, entry := range entries {
+		var project, role, member, condition, grant, targets, reviewTime string
+		if entry.ProjectID != "" {
+			project = common.FormatProject(entry.ProjectID)
+		}
+		if binding := entry.Payload.GetBinding(); binding != nil {
+			role, member, condition = binding.Role, binding.Member, binding.ConditionExpression
+		}
+		if accessGrant := entry.Payload.GetAccessGrant(); accessGrant != nil {
+			member, grant, targets = accessGrant.Creator, accessGrant.Name, strings.Join(accessGrant.Targets, ",")
+		}
+		if entry.Payload.ReviewTime != nil {
+			reviewTime = entry.Payload.ReviewTime.AsTime().Format(time.RFC3339)
+		}
+		result.Rows = append(result.Rows, &v1pb.QueryRow{Values: []*v1pb.RowValue{
+			stringValue(common.FormatAccessReview(review.ID)),
+			stringValue(review.Payload.Title),
+			stringValue(review.EndTime.Format(time.RFC3339)),
+			stringValue(common.FormatAccessReviewEntry(review.ID, entry.ID)),
+			stringValue(project),
+			stringValue(role),
+			stringValue(member),
+			stringValue(condition),
+			stringValue(grant),
+			stringValue(targets),
+			stringValue(strings.Join(entry.Payload.Reviewers, ",")),
+			stringValue(entry.Decision.String()),
+			stringValue(entry.Payload.Reviewer),
+			stringValue(entry.Payload.Justification),
+			stringValue(reviewTime),
+			{Kind: &v1pb.RowValue_BoolValue{BoolValue: entry.Payload.AutoRevoked}},
+			stringValue(entry.Payload.RevokeError),
+		}})
+	}
+	return result
+}
+
+func (s *AccessReviewService) convertToAccessReview(ctx context.Context, review *store.AccessReviewMessage) (*v1pb.AccessReview, error) {
+	counts, err := s.store.CountAccessReviewEntries(ctx, review.ID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to count access review entries")
+	}
+	stats := &v1pb.AccessReview_Stats{
+		PendingCount: int32(counts[storepb.AccessReviewEntry_PENDING]),
+		KeptCount:    int32(counts[storepb.AccessReviewEntry_KEEP]),
+		RevokedCount: int32(counts[storepb.AccessReviewEntry_REVOKE]),
+	}
+	stats.TotalCount = stats.PendingCount + stats.KeptCount + stats.RevokedCount
+	return &v1pb.AccessReview{
+		Name:             common.FormatAccessReview(review.ID),
+		Title:            review.Payload.Title,
+		Description:      review.Payload.Description,
+		Projects:         review.Payload.Projects,
+		IncludeWorkspace: review.Payload.IncludeWorkspace,
+		EndTime:          timestamppb.New(review.EndTime),
+		State:            v1pb.AccessReview_State(review.State),
+		Creator:          common.FormatUserEmail(review.Creator),
+		Completer:        review.Payload.Completer,
+		CreateTime:       timestamppb.New(review.CreatedAt),
+		UpdateTime:       timestamppb.New(review.UpdatedAt),
+		Stats:            stats,
+	}, nil
+}
+
+func convertToAccessReviewEntry(entry *store.AccessReviewEntryMessage) *v1pb.AccessReviewEntry {
+	result := &v1pb.AccessReviewEntry{
+		Name:          common.FormatAccessReviewEntry(entry.ReviewID, entry.ID),
+		Reviewers:     entry.Payload.Reviewers,
+		Decision:      v1pb.AccessReviewEntry_Decision(entry.Decision),
+		Reviewer:      entry.Payload.Reviewer,
+		Justification: entry.Payload.Justification,
+		ReviewTime:    entry.Payload.ReviewTime,
+		AutoRevoked:   entry.Payload.AutoRevoked,
+		RevokeError:   entry.Payload.RevokeError,
+	}
+	if entry.ProjectID != "" {
+		result.Project = common.FormatProject(entry.ProjectID)
+	}
+	switch target := entry.Payload.Target.(type) {
+	case *storepb.AccessReviewEntryPayload_Binding_:
+		result.Target = &v1pb.AccessReviewEntry_Binding_{Binding: &v1pb.AccessReviewEntry_Binding{
+			Role:                target.Binding.Role,
+			Member:              target.Binding.Member,
+			ConditionExpression: target.Binding.ConditionExpression,
+			ConditionTitle:      target.Binding.ConditionTitle,
+		}}
+	case *storepb.AccessReviewEntryPayload_AccessGrant_:
+		result.Target = &v1pb.AccessReviewEntry_AccessGrant_{AccessGrant: &v1pb.AccessReviewEntry_AccessGrant{
+			Name:       target.AccessGrant.Name,
+			Creator:    target.AccessGrant.Creator,
+			Targets:    target.AccessGrant.Targets,
+			Query:      target.AccessGrant.Query,
+			Unmask:     target.AccessGrant.Unmask,
+			ExpireTime: target.AccessGrant.ExpireTime,
+		}}
+	default:
+	}
+	return result
+}
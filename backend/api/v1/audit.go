@@ -432,6 +432,30 @@ func getRequestResource(request any) string {
 		return r.GetName()
 	case *v1pb.UpdateSettingRequest:
 		return r.GetSetting().GetName()
+	case *v1pb.UpdateProjectRequest:
+		return r.GetProject().GetName()
+	case *v1pb.CreateReviewConfigRequest:
+		return r.GetReviewConfig().GetName()
+	case *v1pb.UpdateReviewConfigRequest:
+		return r.GetReviewConfig().GetName()
+	case *v1pb.DeleteReviewConfigRequest:
+		return r.GetName()
+	case *v1pb.CreatePolicyRequest:
+		return r.GetParent()
+	case *v1pb.UpdatePolicyRequest:
+		return r.GetPolicy().GetName()
+	case *v1pb.DeletePolicyRequest:
+		return r.GetName()
+	case *v1pb.CreateDatabaseGroupRequest:
+		return r.GetParent()
+	case *v1pb.UpdateDatabaseGroupRequest:
+		return r.GetDatabaseGroup().GetName()
+	case *v1pb.DeleteDatabaseGroupRequest:
+		return r.GetName()
+	case *v1pb.BatchApplyRequest:
+		return r.GetName()
+	case *v1pb.CancelOperationRequest:
+		return r.GetName()
 	default:
 	}
 	return ""
@@ -461,6 +485,13 @@ func getRequestString(request any) (string, error) {
 		case *v1pb.UpdateInstanceRequest:
 			r.Instance = redactInstance(r.Instance)
 			return r
+		case *v1pb.BatchApplyRequest:
+			for _, resource := range r.Resources {
+				if instance := resource.GetInstance(); instance != nil {
+					resource.Resource = &v1pb.BatchApplyRequest_Resource_Instance{Instance: redactInstance(instance)}
+				}
+			}
+			return r
 		case *v1pb.AddDataSourceRequest:
 			r.DataSource = redactDataSource(r.DataSource)
 			return r
@@ -508,6 +539,8 @@ func getResponseString(response any) (string, error) {
 			return redactUser(r)
 		case *v1pb.Instance:
 			return redactInstance(r)
+		case *v1pb.Operation:
+			return redactOperation(r)
 		default:
 			if p, ok := r.(protoreflect.ProtoMessage); ok {
 				return p
@@ -636,6 +669,16 @@ func redactUser(r *v1pb.User) *v1pb.User {
 	}
 }
 
+func redactOperation(o *v1pb.Operation) *v1pb.Operation {
+	if o == nil {
+		return nil
+	}
+	// The response may contain the exported data.
+	cloned := proto.CloneOf(o)
+	cloned.Response = nil
+	return cloned
+}
+
 func redactInstance(i *v1pb.Instance) *v1pb.Instance {
 	if i == nil {
 		return nil
@@ -9,6 +9,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/component/iam"
 	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
 	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
 	"github.com/bytebase/bytebase/backend/generated-go/v1/v1connect"
@@ -18,13 +19,15 @@ import (
 // DatabaseCatalogService implements the database catalog service.
 type DatabaseCatalogService struct {
 	v1connect.UnimplementedDatabaseCatalogServiceHandler
-	store *store.Store
+	store      *store.Store
+	iamManager *iam.Manager
 }
 
 // NewDatabaseCatalogService creates a new DatabaseCatalogService.
-func NewDatabaseCatalogService(store *store.Store) *DatabaseCatalogService {
+func NewDatabaseCatalogService(store *store.Store, iamManager *iam.Manager) *DatabaseCatalogService {
 	return &DatabaseCatalogService{
-		store: store,
+		store:      store,
+		iamManager: iamManager,
 	}
 }
 
@@ -0,0 +1,380 @@
+package v1
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/permission"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+const (
+	defaultColumnLineageDepth = 5
+	maximumColumnLineageDepth = 20
+	// maximumColumnLineageNodes caps the size of a lineage graph.
+	maximumColumnLineageNodes = 1000
+)
+
+// GetColumnLineage gets the column-level lineage graph of a column.
+// The columns of the databases in other projects are left out unless the user has bb.databaseCatalogs.get on the project.
+func (s *DatabaseCatalogService) GetColumnLineage(ctx context.Context, req *connect.Request[v1pb.GetColumnLineageRequest]) (*connect.Response[v1pb.ColumnLineage], error) {
+	user, ok := GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeInternal, errors.Errorf("user not found"))
+	}
+	database, err := s.getCatalogDatabase(ctx, req.Msg.Name)
+	if err != nil {
+		return nil, err
+	}
+	depth, err := getColumnLineageDepth(req.Msg.Depth)
+	if err != nil {
+		return nil, err
+	}
+	start, err := getColumnLineageStart(database, req.Msg.Schema, req.Msg.Table, req.Msg.Column)
+	if err != nil {
+		return nil, err
+	}
+
+	list := func(find *store.FindColumnLineageMessage) ([]*store.ColumnLineageMessage, error) {
+		return s.store.ListColumnLineages(ctx, find)
+	}
+	graph := newColumnLineageGraph(start)
+	graph.visible = s.newColumnLineageVisibility(ctx, user, database)
+	if req.Msg.Direction != v1pb.GetColumnLineageRequest_DOWNSTREAM {
+		if err := graph.traverse(database.InstanceID, depth, true /* upstream */, list); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to list column lineage"))
+		}
+	}
+	if req.Msg.Direction != v1pb.GetColumnLineageRequest_UPSTREAM {
+		if err := graph.traverse(database.InstanceID, depth, false /* upstream */, list); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to list column lineage"))
+		}
+	}
+
+	configs := make(map[string]*storepb.DatabaseConfig)
+	response := &v1pb.ColumnLineage{}
+	for _, node := range graph.nodes {
+		config, err := s.getColumnLineageDatabaseConfig(ctx, database.InstanceID, node.DatabaseName, configs)
+		if err != nil {
+			return nil, err
+		}
+		response.Nodes = append(response.Nodes, convertToV1ColumnLineageNode(database.InstanceID, node, findColumnCatalog(config, node)))
+	}
+	for _, edge := range graph.edges {
+		response.Edges = append(response.Edges, convertToV1ColumnLineageEdge(edge, graph.index))
+	}
+	return connect.NewResponse(response), nil
+}
+
+// PropagateColumnClassification propagates the classification of a column to the downstream columns.
+func (s *DatabaseCatalogService) PropagateColumnClassification(ctx context.Context, req *connect.Request[v1pb.PropagateColumnClassificationRequest]) (*connect.Response[v1pb.PropagateColumnClassificationResponse], error) {
+	user, ok := GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeInternal, errors.Errorf("user not found"))
+	}
+	database, err := s.getCatalogDatabase(ctx, req.Msg.Name)
+	if err != nil {
+		return nil, err
+	}
+	depth, err := getColumnLineageDepth(req.Msg.Depth)
+	if err != nil {
+		return nil, err
+	}
+	start, err := getColumnLineageStart(database, req.Msg.Schema, req.Msg.Table, req.Msg.Column)
+	if err != nil {
+		return nil, err
+	}
+
+	configs := make(map[string]*storepb.DatabaseConfig)
+	config, err := s.getColumnLineageDatabaseConfig(ctx, database.InstanceID, database.DatabaseName, configs)
+	if err != nil {
+		return nil, err
+	}
+	classification := findColumnCatalog(config, start).GetClassification()
+	if classification == "" {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.Errorf("column %q has no classification", req.Msg.Column))
+	}
+
+	graph := newColumnLineageGraph(start)
+	if err := graph.traverse(database.InstanceID, depth, false /* upstream */, func(find *store.FindColumnLineageMessage) ([]*store.ColumnLineageMessage, error) {
+		return s.store.ListColumnLineages(ctx, find)
+	}); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to list column lineage"))
+	}
+
+	// Group the downstream columns by database, keeping the traversal order.
+	var databaseNames []string
+	columnsByDatabase := make(map[string][]store.ColumnLineageColumn)
+	for _, node := range graph.nodes[1:] {
+		if _, ok := columnsByDatabase[node.DatabaseName]; !ok {
+			databaseNames = append(databaseNames, node.DatabaseName)
+		}
+		columnsByDatabase[node.DatabaseName] = append(columnsByDatabase[node.DatabaseName], node)
+	}
+
+	response := &v1pb.PropagateColumnClassificationResponse{Classification: classification}
+	for _, databaseName := range databaseNames {
+		target, err := s.store.GetDatabase(ctx, &store.FindDatabaseMessage{
+			Workspace:    common.GetWorkspaceIDFromContext(ctx),
+			InstanceID:   &database.InstanceID,
+			DatabaseName: &databaseName,
+		})
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get database %q", databaseName))
+		}
+		if target == nil {
+			continue
+		}
+		if target.ProjectID != database.ProjectID {
+			ok, err := s.iamManager.CheckPermission(ctx, permission.DatabaseCatalogsUpdate, user, common.GetWorkspaceIDFromContext(ctx), target.ProjectID)
+			if err != nil {
+				return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to check permission"))
+			}
+			if !ok {
+				return nil, connect.NewError(connect.CodePermissionDenied, errors.Errorf("user does not have permission %q on database %q", permission.DatabaseCatalogsUpdate, common.FormatDatabase(target.InstanceID, target.DatabaseName)))
+			}
+		}
+
+		dbMetadata, err := s.store.GetDBSchema(ctx, &store.FindDBSchemaMessage{
+			Workspace:    common.GetWorkspaceIDFromContext(ctx),
+			InstanceID:   target.InstanceID,
+			DatabaseName: target.DatabaseName,
+		})
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		if dbMetadata == nil {
+			continue
+		}
+		config, ok := proto.Clone(dbMetadata.GetConfig()).(*storepb.DatabaseConfig)
+		if !ok || config == nil {
+			config = &storepb.DatabaseConfig{}
+		}
+		updated := applyColumnClassification(config, columnsByDatabase[databaseName], classification, req.Msg.Overwrite)
+		if len(updated) == 0 {
+			continue
+		}
+		if !req.Msg.ValidateOnly {
+			if err := s.store.UpdateDBSchema(ctx, target.InstanceID, target.DatabaseName, &store.UpdateDBSchemaMessage{Config: config}); err != nil {
+				return nil, connect.NewError(connect.CodeInternal, err)
+			}
+		}
+		for _, column := range updated {
+			response.UpdatedColumns = append(response.UpdatedColumns, convertToV1ColumnLineageNode(target.InstanceID, column, findColumnCatalog(config, column)))
+		}
+	}
+	return connect.NewResponse(response), nil
+}
+
+// newColumnLineageVisibility returns the function reporting whether the user can get the catalog of a database on the instance.
+func (s *DatabaseCatalogService) newColumnLineageVisibility(ctx context.Context, user *store.UserMessage, database *store.DatabaseMessage) func(string) (bool, error) {
+	visible := map[string]bool{database.DatabaseName: true}
+	return func(databaseName string) (bool, error) {
+		if ok, cached := visible[databaseName]; cached {
+			return ok, nil
+		}
+		target, err := s.store.GetDatabase(ctx, &store.FindDatabaseMessage{
+			Workspace:    common.GetWorkspaceIDFromContext(ctx),
+			InstanceID:   &database.InstanceID,
+			DatabaseName: &databaseName,
+		})
+		if err != nil {
+			return false, errors.Wrapf(err, "failed to get database %q", databaseName)
+		}
+		ok := false
+		switch {
+		case target == nil:
+		case target.ProjectID == database.ProjectID:
+			ok = true
+		default:
+			if ok, err = s.iamManager.CheckPermission(ctx, permission.DatabaseCatalogsGet, user, common.GetWorkspaceIDFromContext(ctx), target.ProjectID); err != nil {
+				return false, errors.Wrapf(err, "failed to check permission")
+			}
+		}
+		visible[databaseName] = ok
+		return ok, nil
+	}
+}
+
+func (s *DatabaseCatalogService) getColumnLineageDatabaseConfig(ctx context.Context, instanceID, databaseName string, configs map[string]*storepb.DatabaseConfig) (*storepb.DatabaseConfig, error) {
+	if config, ok := configs[databaseName]; ok {
+		return config, nil
+	}
+	dbMetadata, err := s.store.GetDBSchema(ctx, &store.FindDBSchemaMessage{
+		Workspace:    common.GetWorkspaceIDFromContext(ctx),
+		InstanceID:   instanceID,
+		DatabaseName: databaseName,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get database schema %q", databaseName))
+	}
+	var config *storepb.DatabaseConfig
+	if dbMetadata != nil {
+		config = dbMetadata.GetConfig()
+	}
+	configs[databaseName] = config
+	return config, nil
+}
+
+func getColumnLineageDepth(depth int32) (int, error) {
+	if depth == 0 {
+		return defaultColumnLineageDepth, nil
+	}
+	if depth < 0 || depth > maximumColumnLineageDepth {
+		return 0, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("depth must be in [1, %d], got %d", maximumColumnLineageDepth, depth))
+	}
+	return int(depth), nil
+}
+
+func getColumnLineageStart(database *store.DatabaseMessage, schema, table, column string) (store.ColumnLineageColumn, error) {
+	if table == "" || column == "" {
+		return store.ColumnLineageColumn{}, connect.NewError(connect.CodeInvalidArgument, errors.New("table and column are required"))
+	}
+	return store.ColumnLineageColumn{
+		DatabaseName: database.DatabaseName,
+		Schema:       schema,
+		Table:        table,
+		Column:       column,
+	}, nil
+}
+
+// columnLineageGraph is the lineage graph traversed from a column.
+type columnLineageGraph struct {
+	// nodes are the columns in the traversal order, starting with the requested column.
+	nodes []store.ColumnLineageColumn
+	index map[store.ColumnLineageColumn]int
+	edges []*store.ColumnLineageMessage
+	// edgeSet dedups the edges found in both directions.
+	edgeSet map[[2]store.ColumnLineageColumn]bool
+	// visible reports whether the columns of a database can be in the graph, nil if all databases are visible.
+	// The traversal does not continue through the columns of invisible databases.
+	visible func(databaseName string) (bool, error)
+}
+
+func newColumnLineageGraph(start store.ColumnLineageColumn) *columnLineageGraph {
+	return &columnLineageGraph{
+		nodes:   []store.ColumnLineageColumn{start},
+		index:   map[store.ColumnLineageColumn]int{start: 0},
+		edgeSet: make(map[[2]store.ColumnLineageColumn]bool),
+	}
+}
+
+// traverse walks the lineage from the requested column breadth first, up to depth hops or the maximum number of nodes.
+func (g *columnLineageGraph) traverse(instanceID string, depth int, upstream bool, list func(*store.FindColumnLineageMessage) ([]*store.ColumnLineageMessage, error)) error {
+	frontier := []store.ColumnLineageColumn{g.nodes[0]}
+	for range depth {
+		if len(frontier) == 0 {
+			return nil
+		}
+		find := &store.FindColumnLineageMessage{InstanceID: instanceID}
+		if upstream {
+			find.Targets = frontier
+		} else {
+			find.Sources = frontier
+		}
+		edges, err := list(find)
+		if err != nil {
+			return err
+		}
+		var next []store.ColumnLineageColumn
+		for _, edge := range edges {
+			column := edge.Target
+			if upstream {
+				column = edge.Source
+			}
+			if _, ok := g.index[column]; !ok {
+				if g.visible != nil {
+					visible, err := g.visible(column.DatabaseName)
+					if err != nil {
+						return err
+					}
+					if !visible {
+						continue
+					}
+				}
+				if len(g.nodes) >= maximumColumnLineageNodes {
+					return nil
+				}
+				g.index[column] = len(g.nodes)
+				g.nodes = append(g.nodes, column)
+				next = append(next, column)
+			}
+			key := [2]store.ColumnLineageColumn{edge.Source, edge.Target}
+			if !g.edgeSet[key] {
+				g.edgeSet[key] = true
+				g.edges = append(g.edges, edge)
+			}
+		}
+		frontier = next
+	}
+	return nil
+}
+
+func findColumnCatalog(config *storepb.DatabaseConfig, column store.ColumnLineageColumn) *storepb.ColumnCatalog {
+	for _, schema := range config.GetSchemas() {
+		if schema.Name != column.Schema {
+			continue
+		}
+		for _, table := range schema.Tables {
+			if table.Name != column.Table {
+				continue
+			}
+			for _, c := range table.Columns {
+				if c.Name == column.Column {
+					return c
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// applyColumnClassification sets the classification of the columns in the config and returns the updated columns.
+// The existing classifications are kept unless overwrite is set.
+func applyColumnClassification(config *storepb.DatabaseConfig, columns []store.ColumnLineageColumn, classification string, overwrite bool) []store.ColumnLineageColumn {
+	var updated []store.ColumnLineageColumn
+	for _, column := range columns {
+		existing := findColumnCatalog(config, column).GetClassification()
+		if existing == classification || (existing != "" && !overwrite) {
+			continue
+		}
+		getOrCreateColumnCatalog(config, column.Schema, column.Table, column.Column).Classification = classification
+		updated = append(updated, column)
+	}
+	return updated
+}
+
+func convertToV1ColumnLineageNode(instanceID string, column store.ColumnLineageColumn, catalog *storepb.ColumnCatalog) *v1pb.ColumnLineageNode {
+	return &v1pb.ColumnLineageNode{
+		Database:       common.FormatDatabase(instanceID, column.DatabaseName),
+		Schema:         column.Schema,
+		Table:          column.Table,
+		Column:         column.Column,
+		Classification: catalog.GetClassification(),
+		SemanticType:   catalog.GetSemanticType(),
+	}
+}
+
+func convertToV1ColumnLineageEdge(edge *store.ColumnLineageMessage, index map[store.ColumnLineageColumn]int) *v1pb.ColumnLineageEdge {
+	e := &v1pb.ColumnLineageEdge{
+		Source:     int32(index[edge.Source]),
+		Target:     int32(index[edge.Target]),
+		Changelog:  edge.Payload.GetChangelog(),
+		UpdateTime: timestamppb.New(edge.UpdatedAt),
+	}
+	switch edge.Origin {
+	case store.ColumnLineageOriginChange:
+		e.Origin = v1pb.ColumnLineageEdge_CHANGE
+	case store.ColumnLineageOriginView:
+		e.Origin = v1pb.ColumnLineageEdge_VIEW
+	default:
+	}
+	return e
+}
@@ -0,0 +1,95 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+func TestColumnLineageGraphTraverse(t *testing.T) {
+	a := require.New(t)
+	column := func(table, name string) store.ColumnLineageColumn {
+		return store.ColumnLineageColumn{DatabaseName: "db", Schema: "public", Table: table, Column: name}
+	}
+	// users.email -> contacts.email -> report.email, and report.email -> contacts.email forms a cycle.
+	edges := []*store.ColumnLineageMessage{
+		{Source: column("users", "email"), Target: column("contacts", "email")},
+		{Source: column("contacts", "email"), Target: column("report", "email")},
+		{Source: column("report", "email"), Target: column("contacts", "email")},
+		{Source: column("raw", "email"), Target: column("users", "email")},
+	}
+	list := func(find *store.FindColumnLineageMessage) ([]*store.ColumnLineageMessage, error) {
+		var result []*store.ColumnLineageMessage
+		for _, edge := range edges {
+			for _, target := range find.Targets {
+				if edge.Target == target {
+					result = append(result, edge)
+				}
+			}
+			for _, source := range find.Sources {
+				if edge.Source == source {
+					result = append(result, edge)
+				}
+			}
+		}
+		return result, nil
+	}
+
+	graph := newColumnLineageGraph(column("users", "email"))
+	a.NoError(graph.traverse("instance", 5, false /* upstream */, list))
+	a.Equal([]store.ColumnLineageColumn{column("users", "email"), column("contacts", "email"), column("report", "email")}, graph.nodes)
+	a.Len(graph.edges, 3)
+
+	a.NoError(graph.traverse("instance", 5, true /* upstream */, list))
+	a.Equal(column("raw", "email"), graph.nodes[3])
+	a.Len(graph.edges, 4)
+
+	shallow := newColumnLineageGraph(column("users", "email"))
+	a.NoError(shallow.traverse("instance", 1, false /* upstream */, list))
+	a.Len(shallow.nodes, 2)
+
+	// The traversal stops at the databases the user cannot see.
+	other := store.ColumnLineageColumn{DatabaseName: "other", Schema: "public", Table: "copy", Column: "email"}
+	edges = append(edges,
+		&store.ColumnLineageMessage{Source: column("users", "email"), Target: other},
+		&store.ColumnLineageMessage{Source: other, Target: column("audit", "email")},
+	)
+	hidden := newColumnLineageGraph(column("users", "email"))
+	hidden.visible = func(databaseName string) (bool, error) {
+		return databaseName == "db", nil
+	}
+	a.NoError(hidden.traverse("instance", 5, false /* upstream */, list))
+	a.NotContains(hidden.nodes, other)
+	a.NotContains(hidden.nodes, column("audit", "email"))
+	a.Len(hidden.edges, 3)
+}
+
+func TestApplyColumnClassification(t *testing.T) {
+	a := require.New(t)
+	config := &storepb.DatabaseConfig{
+		Schemas: []*storepb.SchemaCatalog{{
+			Name: "public",
+			Tables: []*storepb.TableCatalog{{
+				Name:    "contacts",
+				Columns: []*storepb.ColumnCatalog{{Name: "email", Classification: "1-1"}, {Name: "phone", Classification: "2-1"}},
+			}},
+		}},
+	}
+	columns := []store.ColumnLineageColumn{
+		{Schema: "public", Table: "contacts", Column: "email"},
+		{Schema: "public", Table: "contacts", Column: "phone"},
+		{Schema: "public", Table: "report", Column: "email"},
+	}
+
+	updated := applyColumnClassification(config, columns, "2-1", false)
+	a.Equal([]store.ColumnLineageColumn{columns[2]}, updated)
+	a.Equal("1-1", findColumnCatalog(config, columns[0]).GetClassification())
+	a.Equal("2-1", findColumnCatalog(config, columns[2]).GetClassification())
+
+	updated = applyColumnClassification(config, columns, "2-1", true)
+	a.Equal([]store.ColumnLineageColumn{columns[0]}, updated)
+	a.Equal("2-1", findColumnCatalog(config, columns[0]).GetClassification())
+}
@@ -0,0 +1,276 @@
+package v1
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+// ListClassificationSuggestions lists the column classifications proposed by sensitive data discovery.
+func (s *DatabaseCatalogService) ListClassificationSuggestions(ctx context.Context, req *connect.Request[v1pb.ListClassificationSuggestionsRequest]) (*connect.Response[v1pb.ListClassificationSuggestionsResponse], error) {
+	database, err := s.getCatalogDatabase(ctx, req.Msg.Parent)
+	if err != nil {
+		return nil, err
+	}
+
+	offset, err := parseLimitAndOffset(&pageSize{
+		token:   req.Msg.PageToken,
+		limit:   int(req.Msg.PageSize),
+		maximum: 1000,
+	})
+	if err != nil {
+		return nil, err
+	}
+	limitPlusOne := offset.limit + 1
+
+	find := &store.FindClassificationSuggestionMessage{
+		InstanceID:   database.InstanceID,
+		DatabaseName: database.DatabaseName,
+		Limit:        &limitPlusOne,
+		Offset:       &offset.offset,
+	}
+	if req.Msg.State != v1pb.ClassificationSuggestion_STATE_UNSPECIFIED {
+		state, err := convertV1ClassificationSuggestionState(req.Msg.State)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		find.State = &state
+	}
+	suggestions, err := s.store.ListClassificationSuggestions(ctx, find)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to list classification suggestions"))
+	}
+
+	nextPageToken := ""
+	if len(suggestions) == limitPlusOne {
+		if nextPageToken, err = offset.getNextPageToken(); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get next page token"))
+		}
+		suggestions = suggestions[:offset.limit]
+	}
+
+	response := &v1pb.ListClassificationSuggestionsResponse{NextPageToken: nextPageToken}
+	for _, suggestion := range suggestions {
+		response.Suggestions = append(response.Suggestions, convertToV1ClassificationSuggestion(suggestion))
+	}
+	return connect.NewResponse(response), nil
+}
+
+// BatchReviewClassificationSuggestions accepts or rejects classification suggestions.
+func (s *DatabaseCatalogService) BatchReviewClassificationSuggestions(ctx context.Context, req *connect.Request[v1pb.BatchReviewClassificationSuggestionsRequest]) (*connect.Response[v1pb.BatchReviewClassificationSuggestionsResponse], error) {
+	database, err := s.getCatalogDatabase(ctx, req.Msg.Parent)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.Msg.Names) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("names are required"))
+	}
+	var resourceIDs []string
+	for _, name := range req.Msg.Names {
+		instanceID, databaseName, resourceID, err := common.GetInstanceDatabaseClassificationSuggestionID(name)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.Wrapf(err, "failed to parse %q", name))
+		}
+		if instanceID != database.InstanceID || databaseName != database.DatabaseName {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("suggestion %q does not belong to %q", name, req.Msg.Parent))
+		}
+		resourceIDs = append(resourceIDs, resourceID)
+	}
+
+	var state store.ClassificationSuggestionState
+	switch req.Msg.Decision {
+	case v1pb.BatchReviewClassificationSuggestionsRequest_ACCEPT:
+		state = store.ClassificationSuggestionStateAccepted
+		if err := s.acceptClassificationSuggestions(ctx, database, resourceIDs); err != nil {
+			return nil, err
+		}
+	case v1pb.BatchReviewClassificationSuggestionsRequest_REJECT:
+		state = store.ClassificationSuggestionStateRejected
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("unsupported decision %v", req.Msg.Decision))
+	}
+
+	suggestions, err := s.store.ReviewClassificationSuggestions(ctx, database.InstanceID, database.DatabaseName, resourceIDs, state)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to review classification suggestions"))
+	}
+	response := &v1pb.BatchReviewClassificationSuggestionsResponse{}
+	for _, suggestion := range suggestions {
+		response.Suggestions = append(response.Suggestions, convertToV1ClassificationSuggestion(suggestion))
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (s *DatabaseCatalogService) getCatalogDatabase(ctx context.Context, parent string) (*store.DatabaseMessage, error) {
+	instanceID, databaseName, err := common.GetInstanceDatabaseID(parent)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Wrapf(err, "failed to parse %q", parent))
+	}
+	database, err := s.store.GetDatabase(ctx, &store.FindDatabaseMessage{
+		Workspace:    common.GetWorkspaceIDFromContext(ctx),
+		InstanceID:   &instanceID,
+		DatabaseName: &databaseName,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get database"))
+	}
+	if database == nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.Errorf("database %q not found", parent))
+	}
+	return database, nil
+}
+
+// acceptClassificationSuggestions writes the pending suggestions to the database catalog.
+func (s *DatabaseCatalogService) acceptClassificationSuggestions(ctx context.Context, database *store.DatabaseMessage, resourceIDs []string) error {
+	pending := store.ClassificationSuggestionStatePending
+	suggestions, err := s.store.ListClassificationSuggestions(ctx, &store.FindClassificationSuggestionMessage{
+		InstanceID:   database.InstanceID,
+		DatabaseName: database.DatabaseName,
+		ResourceIDs:  resourceIDs,
+		State:        &pending,
+	})
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to list classification suggestions"))
+	}
+	if len(suggestions) == 0 {
+		return nil
+	}
+
+	semanticTypesSetting, err := s.store.GetSemanticTypesSetting(ctx, common.GetWorkspaceIDFromContext(ctx))
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, errors.Wrap(err, "failed to get semantic types setting"))
+	}
+	validSemanticTypeIDs := make(map[string]bool)
+	for _, semanticType := range semanticTypesSetting.GetTypes() {
+		validSemanticTypeIDs[semanticType.Id] = true
+	}
+	for _, suggestion := range suggestions {
+		if err := validateSemanticTypeID(suggestion.SemanticType, validSemanticTypeIDs); err != nil {
+			return connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+	}
+
+	dbMetadata, err := s.store.GetDBSchema(ctx, &store.FindDBSchemaMessage{
+		Workspace:    common.GetWorkspaceIDFromContext(ctx),
+		InstanceID:   database.InstanceID,
+		DatabaseName: database.DatabaseName,
+	})
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	if dbMetadata == nil {
+		return connect.NewError(connect.CodeFailedPrecondition, errors.New("database schema metadata not found"))
+	}
+	config, ok := proto.Clone(dbMetadata.GetConfig()).(*storepb.DatabaseConfig)
+	if !ok || config == nil {
+		config = &storepb.DatabaseConfig{}
+	}
+	applyClassificationSuggestions(config, suggestions)
+	if err := s.store.UpdateDBSchema(ctx, database.InstanceID, database.DatabaseName, &store.UpdateDBSchemaMessage{Config: config}); err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	return nil
+}
+
+// applyClassificationSuggestions sets the suggested classification and semantic type of the columns in the config.
+func applyClassificationSuggestions(config *storepb.DatabaseConfig, suggestions []*store.ClassificationSuggestionMessage) {
+	for _, suggestion := range suggestions {
+		column := getOrCreateColumnCatalog(config, suggestion.Schema, suggestion.Table, suggestion.Column)
+		if suggestion.Classification != "" {
+			column.Classification = suggestion.Classification
+		}
+		if suggestion.SemanticType != "" {
+			column.SemanticType = suggestion.SemanticType
+		}
+	}
+}
+
+// getOrCreateColumnCatalog returns the catalog of the column in the config, adding it if missing.
+func getOrCreateColumnCatalog(config *storepb.DatabaseConfig, schemaName, tableName, columnName string) *storepb.ColumnCatalog {
+	var schema *storepb.SchemaCatalog
+	for _, sc := range config.Schemas {
+		if sc.Name == schemaName {
+			schema = sc
+			break
+		}
+	}
+	if schema == nil {
+		schema = &storepb.SchemaCatalog{Name: schemaName}
+		config.Schemas = append(config.Schemas, schema)
+	}
+	var table *storepb.TableCatalog
+	for _, tc := range schema.Tables {
+		if tc.Name == tableName {
+			table = tc
+			break
+		}
+	}
+	if table == nil {
+		table = &storepb.TableCatalog{Name: tableName}
+		schema.Tables = append(schema.Tables, table)
+	}
+	for _, cc := range table.Columns {
+		if cc.Name == columnName {
+			return cc
+		}
+	}
+	column := &storepb.ColumnCatalog{Name: columnName}
+	table.Columns = append(table.Columns, column)
+	return column
+}
+
+func convertToV1ClassificationSuggestion(suggestion *store.ClassificationSuggestionMessage) *v1pb.ClassificationSuggestion {
+	s := &v1pb.ClassificationSuggestion{
+		Name:           common.FormatDatabase(suggestion.InstanceID, suggestion.DatabaseName) + "/" + common.SuggestionPrefix + suggestion.ResourceID,
+		Schema:         suggestion.Schema,
+		Table:          suggestion.Table,
+		Column:         suggestion.Column,
+		Classification: suggestion.Classification,
+		SemanticType:   suggestion.SemanticType,
+		Detector:       suggestion.Payload.GetDetectorId(),
+		MatchRatio:     suggestion.Payload.GetMatchRatio(),
+		SampleCount:    suggestion.Payload.GetSampleCount(),
+		CreateTime:     timestamppb.New(suggestion.CreatedAt),
+		UpdateTime:     timestamppb.New(suggestion.UpdatedAt),
+	}
+	switch suggestion.Payload.GetMatchSource() {
+	case storepb.ClassificationSuggestionPayload_COLUMN_NAME:
+		s.MatchSource = v1pb.ClassificationSuggestion_COLUMN_NAME
+	case storepb.ClassificationSuggestionPayload_COLUMN_COMMENT:
+		s.MatchSource = v1pb.ClassificationSuggestion_COLUMN_COMMENT
+	case storepb.ClassificationSuggestionPayload_VALUE:
+		s.MatchSource = v1pb.ClassificationSuggestion_VALUE
+	default:
+	}
+	switch suggestion.State {
+	case store.ClassificationSuggestionStatePending:
+		s.State = v1pb.ClassificationSuggestion_PENDING
+	case store.ClassificationSuggestionStateAccepted:
+		s.State = v1pb.ClassificationSuggestion_ACCEPTED
+	case store.ClassificationSuggestionStateRejected:
+		s.State = v1pb.ClassificationSuggestion_REJECTED
+	default:
+	}
+	return s
+}
+
+func convertV1ClassificationSuggestionState(state v1pb.ClassificationSuggestion_State) (store.ClassificationSuggestionState, error) {
+	switch state {
+	case v1pb.ClassificationSuggestion_PENDING:
+		return store.ClassificationSuggestionStatePending, nil
+	case v1pb.ClassificationSuggestion_ACCEPTED:
+		return store.ClassificationSuggestionStateAccepted, nil
+	case v1pb.ClassificationSuggestion_REJECTED:
+		return store.ClassificationSuggestionStateRejected, nil
+	default:
+		return "", errors.Errorf("unsupported state %v", state)
+	}
+}
@@ -0,0 +1,46 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+func TestApplyClassificationSuggestions(t *testing.T) {
+	a := require.New(t)
+	config := &storepb.DatabaseConfig{
+		Schemas: []*storepb.SchemaCatalog{
+			{
+				Name: "public",
+				Tables: []*storepb.TableCatalog{
+					{
+						Name: "users",
+						Columns: []*storepb.ColumnCatalog{
+							{Name: "email", SemanticType: "default", Labels: map[string]string{"k": "v"}},
+						},
+					},
+				},
+			},
+		},
+	}
+	applyClassificationSuggestions(config, []*store.ClassificationSuggestionMessage{
+		{Schema: "public", Table: "users", Column: "email", Classification: "1-1"},
+		{Schema: "public", Table: "users", Column: "card", Classification: "1-2", SemanticType: "card"},
+		{Schema: "billing", Table: "accounts", Column: "iban", SemanticType: "iban"},
+	})
+
+	a.Len(config.Schemas, 2)
+	users := config.Schemas[0].Tables[0]
+	a.Len(users.Columns, 2)
+	// Existing semantic types and labels are kept if the suggestion does not set them.
+	a.Equal("1-1", users.Columns[0].Classification)
+	a.Equal("default", users.Columns[0].SemanticType)
+	a.Equal(map[string]string{"k": "v"}, users.Columns[0].Labels)
+	a.Equal("card", users.Columns[1].SemanticType)
+	a.Equal("1-2", users.Columns[1].Classification)
+	a.Equal("billing", config.Schemas[1].Name)
+	a.Equal("iban", config.Schemas[1].Tables[0].Columns[0].SemanticType)
+}
@@ -5,6 +5,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 
 	"connectrpc.com/connect"
@@ -13,11 +14,14 @@ import (
 	celoperators "github.com/google/cel-go/common/operators"
 	celoverloads "github.com/google/cel-go/common/overloads"
 	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/permission"
 	"github.com/bytebase/bytebase/backend/component/config"
+	"github.com/bytebase/bytebase/backend/component/dbfactory"
 	"github.com/bytebase/bytebase/backend/component/iam"
+	"github.com/bytebase/bytebase/backend/component/operation"
 	"github.com/bytebase/bytebase/backend/enterprise"
 	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
 	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
@@ -31,21 +35,25 @@ import (
 // DatabaseService implements the database service.
 type DatabaseService struct {
 	v1connect.UnimplementedDatabaseServiceHandler
-	store          *store.Store
-	schemaSyncer   *schemasync.Syncer
-	profile        *config.Profile
-	iamManager     *iam.Manager
-	licenseService *enterprise.LicenseService
+	store            *store.Store
+	schemaSyncer     *schemasync.Syncer
+	profile          *config.Profile
+	iamManager       *iam.Manager
+	licenseService   *enterprise.LicenseService
+	dbFactory        *dbfactory.DBFactory
+	operationManager *operation.Manager
 }
 
 // NewDatabaseService creates a new DatabaseService.
-func NewDatabaseService(store *store.Store, schemaSyncer *schemasync.Syncer, profile *config.Profile, iamManager *iam.Manager, licenseService *enterprise.LicenseService) *DatabaseService {
+func NewDatabaseService(store *store.Store, schemaSyncer *schemasync.Syncer, profile *config.Profile, iamManager *iam.Manager, licenseService *enterprise.LicenseService, dbFactory *dbfactory.DBFactory, operationManager *operation.Manager) *DatabaseService {
 	return &DatabaseService{
-		store:          store,
-		schemaSyncer:   schemaSyncer,
-		profile:        profile,
-		iamManager:     iamManager,
-		licenseService: licenseService,
+		store:            store,
+		schemaSyncer:     schemaSyncer,
+		profile:          profile,
+		iamManager:       iamManager,
+		licenseService:   licenseService,
+		dbFactory:        dbFactory,
+		operationManager: operationManager,
 	}
 }
 
@@ -395,7 +403,9 @@ func (s *DatabaseService) SyncDatabase(ctx context.Context, req *connect.Request
 }
 
 // BatchSyncDatabases sync multiply database asynchronously.
+// The returned operation tracks the sync of the databases.
 func (s *DatabaseService) BatchSyncDatabases(ctx context.Context, req *connect.Request[v1pb.BatchSyncDatabasesRequest]) (*connect.Response[v1pb.BatchSyncDatabasesResponse], error) {
+	var databases []*store.DatabaseMessage
 	for _, name := range req.Msg.Names {
 		instanceID, databaseName, err := common.GetInstanceDatabaseID(name)
 		if err != nil {
@@ -409,12 +419,25 @@ func (s *DatabaseService) BatchSyncDatabases(ctx context.Context, req *connect.R
 		if err != nil {
 			return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get database"))
 		}
-		if databaseMessage == nil {
+		if databaseMessage == nil || databaseMessage.Deleted {
 			continue
 		}
-		s.schemaSyncer.SyncDatabaseAsync(databaseMessage)
+		databases = append(databases, databaseMessage)
 	}
-	return connect.NewResponse(&v1pb.BatchSyncDatabasesResponse{}), nil
+
+	op, err := startOperation(ctx, s.operationManager, storepb.Operation_SYNC_DATABASES, req.Msg.Parent, len(databases),
+		func(ctx context.Context, progress operation.ProgressFunc) (proto.Message, error) {
+			if err := s.schemaSyncer.SyncDatabases(ctx, databases, func(completed int) {
+				progress(int32(completed), int32(len(databases)))
+			}); err != nil {
+				return nil, err
+			}
+			return &v1pb.BatchSyncDatabasesResponse{}, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&v1pb.BatchSyncDatabasesResponse{Operation: op}), nil
 }
 
 // BatchUpdateDatabases updates databases in batch.
@@ -617,9 +640,61 @@ func (s *DatabaseService) GetDatabaseSchema(ctx context.Context, req *connect.Re
 
 // GetDatabaseSDLSchema gets the SDL schema of a database.
 func (s *DatabaseService) GetDatabaseSDLSchema(ctx context.Context, req *connect.Request[v1pb.GetDatabaseSDLSchemaRequest]) (*connect.Response[v1pb.DatabaseSDLSchema], error) {
-	instanceID, databaseName, err := common.TrimSuffixAndGetInstanceDatabaseID(req.Msg.Name, common.SDLSchemaSuffix)
+	database, metadata, err := s.getSDLSchemaMetadata(ctx, req.Msg.Name)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("%v", err.Error()))
+		return nil, err
+	}
+
+	format := req.Msg.Format
+	if format == v1pb.GetDatabaseSDLSchemaRequest_SDL_FORMAT_UNSPECIFIED {
+		format = v1pb.GetDatabaseSDLSchemaRequest_SINGLE_FILE
+	}
+
+	switch format {
+	case v1pb.GetDatabaseSDLSchemaRequest_SINGLE_FILE:
+		return s.getSingleFileSDL(database.Engine, metadata)
+	case v1pb.GetDatabaseSDLSchemaRequest_MULTI_FILE:
+		return s.getMultiFileSDL(database.Engine, metadata)
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("unsupported SDL format: %v", format))
+	}
+}
+
+// GetDatabaseSDLSchemaFiles gets the SDL schema of a database with one file per schema object.
+func (s *DatabaseService) GetDatabaseSDLSchemaFiles(ctx context.Context, req *connect.Request[v1pb.GetDatabaseSDLSchemaFilesRequest]) (*connect.Response[v1pb.DatabaseSDLSchemaFiles], error) {
+	database, metadata, err := s.getSDLSchemaMetadata(ctx, req.Msg.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := schema.GetMultiFileDatabaseDefinition(database.Engine, schema.GetDefinitionContext{
+		SkipBackupSchema: true,
+		SDLFormat:        true,
+		MultiFileFormat:  true,
+		ObjectPerFile:    true,
+	}, metadata)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to generate SDL schema files: %v", err))
+	}
+
+	response := &v1pb.DatabaseSDLSchemaFiles{}
+	for _, file := range result.Files {
+		response.Files = append(response.Files, &v1pb.DatabaseSDLSchemaFiles_File{
+			Path:    file.Name,
+			Content: file.Content,
+		})
+	}
+	slices.SortFunc(response.Files, func(a, b *v1pb.DatabaseSDLSchemaFiles_File) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+	return connect.NewResponse(response), nil
+}
+
+// getSDLSchemaMetadata gets the database and its schema metadata for the SDL schema name, syncing the schema if it's missing.
+func (s *DatabaseService) getSDLSchemaMetadata(ctx context.Context, name string) (*store.DatabaseMessage, *storepb.DatabaseSchemaMetadata, error) {
+	instanceID, databaseName, err := common.TrimSuffixAndGetInstanceDatabaseID(name, common.SDLSchemaSuffix)
+	if err != nil {
+		return nil, nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("%v", err.Error()))
 	}
 
 	database, err := s.store.GetDatabase(ctx, &store.FindDatabaseMessage{
@@ -629,10 +704,10 @@ func (s *DatabaseService) GetDatabaseSDLSchema(ctx context.Context, req *connect
 		ShowDeleted:  true,
 	})
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.Errorf("%v", err.Error()))
+		return nil, nil, connect.NewError(connect.CodeInternal, errors.Errorf("%v", err.Error()))
 	}
 	if database == nil {
-		return nil, connect.NewError(connect.CodeNotFound, errors.Errorf("database %q not found", databaseName))
+		return nil, nil, connect.NewError(connect.CodeNotFound, errors.Errorf("database %q not found", databaseName))
 	}
 	dbMetadata, err := s.store.GetDBSchema(ctx, &store.FindDBSchemaMessage{
 		Workspace:    common.GetWorkspaceIDFromContext(ctx),
@@ -640,11 +715,11 @@ func (s *DatabaseService) GetDatabaseSDLSchema(ctx context.Context, req *connect
 		DatabaseName: databaseName,
 	})
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.Errorf("%v", err.Error()))
+		return nil, nil, connect.NewError(connect.CodeInternal, errors.Errorf("%v", err.Error()))
 	}
 	if dbMetadata == nil {
 		if err := s.schemaSyncer.SyncDatabaseSchema(ctx, database); err != nil {
-			return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to sync database schema for database %q, error %v", databaseName, err))
+			return nil, nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to sync database schema for database %q, error %v", databaseName, err))
 		}
 		newDBSchema, err := s.store.GetDBSchema(ctx, &store.FindDBSchemaMessage{
 			Workspace:    common.GetWorkspaceIDFromContext(ctx),
@@ -652,32 +727,19 @@ func (s *DatabaseService) GetDatabaseSDLSchema(ctx context.Context, req *connect
 			DatabaseName: database.DatabaseName,
 		})
 		if err != nil {
-			return nil, connect.NewError(connect.CodeInternal, errors.Errorf("%v", err.Error()))
+			return nil, nil, connect.NewError(connect.CodeInternal, errors.Errorf("%v", err.Error()))
 		}
 		if newDBSchema == nil {
-			return nil, connect.NewError(connect.CodeNotFound, errors.Errorf("database schema %q not found", databaseName))
+			return nil, nil, connect.NewError(connect.CodeNotFound, errors.Errorf("database schema %q not found", databaseName))
 		}
 		dbMetadata = newDBSchema
 	}
 
 	metadata := dbMetadata.GetProto()
 	if metadata == nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.Errorf("database metadata not found for database %q", databaseName))
-	}
-
-	format := req.Msg.Format
-	if format == v1pb.GetDatabaseSDLSchemaRequest_SDL_FORMAT_UNSPECIFIED {
-		format = v1pb.GetDatabaseSDLSchemaRequest_SINGLE_FILE
-	}
-
-	switch format {
-	case v1pb.GetDatabaseSDLSchemaRequest_SINGLE_FILE:
-		return s.getSingleFileSDL(database.Engine, metadata)
-	case v1pb.GetDatabaseSDLSchemaRequest_MULTI_FILE:
-		return s.getMultiFileSDL(database.Engine, metadata)
-	default:
-		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("unsupported SDL format: %v", format))
+		return nil, nil, connect.NewError(connect.CodeInternal, errors.Errorf("database metadata not found for database %q", databaseName))
 	}
+	return database, metadata, nil
 }
 
 // DiffSchema diff the database schema.
@@ -0,0 +1,234 @@
+package v1
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/permission"
+	"github.com/bytebase/bytebase/backend/component/synthetic"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
+)
+
+const (
+	defaultSyntheticRowCount = 10
+	maximumSyntheticRowCount = 10000
+)
+
+// GenerateSyntheticData generates synthetic test data from the schema metadata of a database,
+// and optionally loads it into a target database.
+func (s *DatabaseService) GenerateSyntheticData(ctx context.Context, req *connect.Request[v1pb.GenerateSyntheticDataRequest]) (*connect.Response[v1pb.GenerateSyntheticDataResponse], error) {
+	rowCount := int(req.Msg.RowCount)
+	if rowCount == 0 {
+		rowCount = defaultSyntheticRowCount
+	}
+	if rowCount < 0 || rowCount > maximumSyntheticRowCount {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("row count must be in [1, %d], got %d", maximumSyntheticRowCount, rowCount))
+	}
+
+	database, err := s.getSyntheticDatabase(ctx, req.Msg.Name)
+	if err != nil {
+		return nil, err
+	}
+	instance, err := s.store.GetInstance(ctx, &store.FindInstanceMessage{
+		Workspace:  common.GetWorkspaceIDFromContext(ctx),
+		ResourceID: &database.InstanceID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get instance %q", database.InstanceID))
+	}
+	if instance == nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.Errorf("instance %q not found", database.InstanceID))
+	}
+	engine := instance.Metadata.GetEngine()
+
+	dbMetadata, err := s.store.GetDBSchema(ctx, &store.FindDBSchemaMessage{
+		Workspace:    common.GetWorkspaceIDFromContext(ctx),
+		InstanceID:   database.InstanceID,
+		DatabaseName: database.DatabaseName,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get database schema"))
+	}
+	if dbMetadata == nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.Errorf("database schema %q not found", req.Msg.Name))
+	}
+
+	// Semantic types of the columns hint the generated values.
+	semanticTypesSetting, err := s.store.GetSemanticTypesSetting(ctx, common.GetWorkspaceIDFromContext(ctx))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get semantic types setting"))
+	}
+	semanticTypeTitles := map[string]string{}
+	for _, semanticType := range semanticTypesSetting.GetTypes() {
+		semanticTypeTitles[semanticType.GetId()] = semanticType.GetTitle()
+	}
+	semanticTypes := map[string]string{}
+	for _, schema := range dbMetadata.GetProto().GetSchemas() {
+		schemaMetadata := dbMetadata.GetSchemaMetadata(schema.Name)
+		if schemaMetadata == nil {
+			continue
+		}
+		for _, table := range schema.GetTables() {
+			tableMetadata := schemaMetadata.GetTable(table.Name)
+			if tableMetadata == nil {
+				continue
+			}
+			for _, column := range table.GetColumns() {
+				columnMetadata := tableMetadata.GetColumn(column.Name)
+				if columnMetadata == nil {
+					continue
+				}
+				if id := columnMetadata.GetCatalog().GetSemanticType(); id != "" {
+					key := table.Name + "." + column.Name
+					if schema.Name != "" {
+						key = schema.Name + "." + key
+					}
+					semanticTypes[key] = strings.TrimSpace(semanticTypeTitles[id] + " " + id)
+				}
+			}
+		}
+	}
+
+	tables, err := synthetic.Generate(dbMetadata.GetProto(), synthetic.Options{
+		Engine:        engine,
+		RowCount:      rowCount,
+		Seed:          req.Msg.Seed,
+		Tables:        req.Msg.Tables,
+		SemanticTypes: semanticTypes,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Wrapf(err, "failed to generate synthetic data"))
+	}
+	var statements []string
+	var rows int64
+	for _, table := range tables {
+		statement, err := table.Statement(engine)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.Wrapf(err, "failed to build statement for table %q", table.Table))
+		}
+		if statement != "" {
+			statements = append(statements, statement)
+		}
+		rows += table.Result.RowsCount
+	}
+	response := &v1pb.GenerateSyntheticDataResponse{
+		Statement: strings.Join(statements, "\n\n"),
+	}
+
+	if req.Msg.Target != "" {
+		if err := s.loadSyntheticData(ctx, req.Msg.Target, engine, response.Statement); err != nil {
+			return nil, err
+		}
+		response.LoadedRows = rows
+	}
+	return connect.NewResponse(response), nil
+}
+
+// loadSyntheticData runs the statement on the target database with the same checks as running DML in the SQL editor,
+// i.e. the environment conditions of bb.sql.dml, the admin data source restriction and the timeout of the query data policy.
+func (s *DatabaseService) loadSyntheticData(ctx context.Context, target string, engine storepb.Engine, statement string) error {
+	user, ok := GetUserFromContext(ctx)
+	if !ok {
+		return connect.NewError(connect.CodeInternal, errors.Errorf("user not found"))
+	}
+	database, err := s.getSyntheticDatabase(ctx, target)
+	if err != nil {
+		return err
+	}
+	instance, err := s.store.GetInstance(ctx, &store.FindInstanceMessage{
+		Workspace:  common.GetWorkspaceIDFromContext(ctx),
+		ResourceID: &database.InstanceID,
+	})
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get instance %q", database.InstanceID))
+	}
+	if instance == nil {
+		return connect.NewError(connect.CodeNotFound, errors.Errorf("instance %q not found", database.InstanceID))
+	}
+	if instance.Metadata.GetEngine() != engine {
+		return connect.NewError(connect.CodeInvalidArgument, errors.Errorf("target engine %s does not match source engine %s", instance.Metadata.GetEngine(), engine))
+	}
+
+	workspacePolicy, err := s.store.GetWorkspaceIamPolicy(ctx, common.GetWorkspaceIDFromContext(ctx))
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get workspace iam policy"))
+	}
+	projectPolicy, err := s.store.GetProjectIamPolicy(ctx, common.GetWorkspaceIDFromContext(ctx), database.ProjectID)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get project iam policy"))
+	}
+	environmentID := ""
+	if database.EffectiveEnvironmentID != nil {
+		environmentID = *database.EffectiveEnvironmentID
+	}
+	ok, err = hasDatabaseAccessRights(ctx, s.store, s.iamManager, user, permission.SQLDml, map[string]any{
+		common.CELAttributeRequestTime:           time.Now(),
+		common.CELAttributeResourceDatabase:      target,
+		common.CELAttributeResourceEnvironmentID: environmentID,
+	}, workspacePolicy.Policy, projectPolicy.Policy)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to check permission"))
+	}
+	if !ok {
+		return connect.NewError(connect.CodePermissionDenied, errors.Errorf("user does not have permission %q on database %q", permission.SQLDml, target))
+	}
+
+	// DML needs the admin data source, which the query data policy may disallow.
+	adminDataSource := utils.DataSourceFromInstanceWithType(instance, storepb.DataSourceType_ADMIN)
+	if adminDataSource == nil {
+		return connect.NewError(connect.CodeFailedPrecondition, errors.Errorf("instance %q has no admin data source", instance.ResourceID))
+	}
+	dataSource, err := checkAndGetDataSourceQueriable(ctx, s.store, s.licenseService, database, adminDataSource.GetId())
+	if err != nil {
+		return err
+	}
+	if statement == "" {
+		return nil
+	}
+
+	queryRestriction := getEffectiveQueryDataPolicy(ctx, s.store, s.licenseService, 0, database.ProjectID)
+	if queryRestriction.MaxQueryTimeoutInSeconds > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(queryRestriction.MaxQueryTimeoutInSeconds)*time.Second)
+		defer cancel()
+	}
+	driver, err := s.dbFactory.GetDataSourceDriver(ctx, instance, dataSource, db.ConnectionContext{
+		DatabaseName: database.DatabaseName,
+	})
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get database driver"))
+	}
+	defer driver.Close(ctx)
+	if _, err := driver.Execute(ctx, statement, db.ExecuteOptions{}); err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, errors.Wrapf(err, "failed to load synthetic data into %q", target))
+	}
+	return nil
+}
+
+func (s *DatabaseService) getSyntheticDatabase(ctx context.Context, name string) (*store.DatabaseMessage, error) {
+	instanceID, databaseName, err := common.GetInstanceDatabaseID(name)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Wrapf(err, "failed to parse %q", name))
+	}
+	database, err := s.store.GetDatabase(ctx, &store.FindDatabaseMessage{
+		Workspace:    common.GetWorkspaceIDFromContext(ctx),
+		InstanceID:   &instanceID,
+		DatabaseName: &databaseName,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get database"))
+	}
+	if database == nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.Errorf("database %q not found", name))
+	}
+	return database, nil
+}
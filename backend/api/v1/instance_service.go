@@ -10,6 +10,7 @@ import (
 	"encoding/pem"
 	"log/slog"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"connectrpc.com/connect"
@@ -21,7 +22,7 @@ import (
 	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/component/config"
 	"github.com/bytebase/bytebase/backend/component/dbfactory"
-
+	"github.com/bytebase/bytebase/backend/component/operation"
 	"github.com/bytebase/bytebase/backend/component/sampleinstance"
 	"github.com/bytebase/bytebase/backend/enterprise"
 	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
@@ -41,10 +42,11 @@ type InstanceService struct {
 	dbFactory             *dbfactory.DBFactory
 	schemaSyncer          *schemasync.Syncer
 	sampleInstanceManager *sampleinstance.Manager
+	operationManager      *operation.Manager
 }
 
 // NewInstanceService creates a new InstanceService.
-func NewInstanceService(store *store.Store, profile *config.Profile, licenseService *enterprise.LicenseService, dbFactory *dbfactory.DBFactory, schemaSyncer *schemasync.Syncer, sampleInstanceManager *sampleinstance.Manager) *InstanceService {
+func NewInstanceService(store *store.Store, profile *config.Profile, licenseService *enterprise.LicenseService, dbFactory *dbfactory.DBFactory, schemaSyncer *schemasync.Syncer, sampleInstanceManager *sampleinstance.Manager, operationManager *operation.Manager) *InstanceService {
 	return &InstanceService{
 		store:                 store,
 		profile:               profile,
@@ -52,6 +54,7 @@ func NewInstanceService(store *store.Store, profile *config.Profile, licenseServ
 		dbFactory:             dbFactory,
 		schemaSyncer:          schemaSyncer,
 		sampleInstanceManager: sampleInstanceManager,
+		operationManager:      operationManager,
 	}
 }
 
@@ -706,17 +709,39 @@ func (s *InstanceService) SyncInstance(ctx context.Context, req *connect.Request
 	if err != nil {
 		return nil, err
 	}
+	databases := newDatabases
 	if req.Msg.EnableFullSync {
-		// Sync all databases in the instance asynchronously.
-		s.schemaSyncer.SyncAllDatabases(ctx, updatedInstance)
-	} else {
-		s.schemaSyncer.SyncDatabasesAsync(newDatabases)
+		// Sync all databases in the instance.
+		databases, err = s.store.ListDatabases(ctx, &store.FindDatabaseMessage{
+			Workspace:  common.GetWorkspaceIDFromContext(ctx),
+			InstanceID: &updatedInstance.ResourceID,
+		})
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to list databases"))
+		}
+		databases = slices.DeleteFunc(databases, func(database *store.DatabaseMessage) bool {
+			return database.Deleted
+		})
 	}
 
 	response := &v1pb.SyncInstanceResponse{}
 	for _, database := range allDatabases {
 		response.Databases = append(response.Databases, database.Name)
 	}
+	// The databases are synced in the operation, which returns the same database list.
+	op, err := startOperation(ctx, s.operationManager, storepb.Operation_SYNC_INSTANCE, common.FormatInstance(updatedInstance.ResourceID), len(databases),
+		func(ctx context.Context, progress operation.ProgressFunc) (proto.Message, error) {
+			if err := s.schemaSyncer.SyncDatabases(ctx, databases, func(completed int) {
+				progress(int32(completed), int32(len(databases)))
+			}); err != nil {
+				return nil, err
+			}
+			return &v1pb.SyncInstanceResponse{Databases: response.Databases}, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+	response.Operation = op
 	return connect.NewResponse(response), nil
 }
 
@@ -282,6 +282,12 @@ func getAlgorithmNameFromSemanticType(semanticType *storepb.SemanticTypeSetting_
 		return "Hash (MD5)"
 	case *storepb.Algorithm_InnerOuterMask_:
 		return "Inner/Outer mask"
+	case *storepb.Algorithm_TokenizationMask_:
+		return "Tokenization"
+	case *storepb.Algorithm_DateShiftMask_:
+		return "Date shift"
+	case *storepb.Algorithm_NumericNoiseMask_:
+		return "Numeric noise"
 	default:
 		return "Unknown"
 	}
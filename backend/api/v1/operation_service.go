@@ -0,0 +1,207 @@
+package v1
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/component/operation"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+	"github.com/bytebase/bytebase/backend/generated-go/v1/v1connect"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+const (
+	// maxOperationWaitTimeout is the default and maximum timeout of WaitOperation.
+	maxOperationWaitTimeout = time.Minute
+	// operationWaitInterval is the interval to check the operation state in WaitOperation.
+	operationWaitInterval = time.Second
+)
+
+// OperationService implements the operation service.
+type OperationService struct {
+	v1connect.UnimplementedOperationServiceHandler
+	store            *store.Store
+	operationManager *operation.Manager
+}
+
+// NewOperationService returns a new operation service instance.
+func NewOperationService(store *store.Store, operationManager *operation.Manager) *OperationService {
+	return &OperationService{
+		store:            store,
+		operationManager: operationManager,
+	}
+}
+
+// GetOperation gets an operation.
+func (s *OperationService) GetOperation(ctx context.Context, request *connect.Request[v1pb.GetOperationRequest]) (*connect.Response[v1pb.Operation], error) {
+	op, err := s.getOperation(ctx, request.Msg.Name)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(convertToV1Operation(op)), nil
+}
+
+// ListOperations lists the operations created by the caller.
+func (s *OperationService) ListOperations(ctx context.Context, request *connect.Request[v1pb.ListOperationsRequest]) (*connect.Response[v1pb.ListOperationsResponse], error) {
+	user, ok := GetUserFromContext(ctx)
+	if !ok || user == nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("user not found"))
+	}
+	offset, err := parseLimitAndOffset(&pageSize{
+		token:   request.Msg.PageToken,
+		limit:   int(request.Msg.PageSize),
+		maximum: 1000,
+	})
+	if err != nil {
+		return nil, err
+	}
+	limitPlusOne := offset.limit + 1
+	operations, err := s.store.ListOperations(ctx, &store.FindOperationMessage{
+		Workspace: common.GetWorkspaceIDFromContext(ctx),
+		Creator:   &user.Email,
+		Limit:     &limitPlusOne,
+		Offset:    &offset.offset,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to list operations"))
+	}
+
+	resp := &v1pb.ListOperationsResponse{}
+	if len(operations) == limitPlusOne {
+		nextPageToken, err := offset.getNextPageToken()
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get next page token"))
+		}
+		resp.NextPageToken = nextPageToken
+		operations = operations[:offset.limit]
+	}
+	for _, op := range operations {
+		resp.Operations = append(resp.Operations, convertToV1Operation(op))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// CancelOperation cancels a running operation.
+func (s *OperationService) CancelOperation(ctx context.Context, request *connect.Request[v1pb.CancelOperationRequest]) (*connect.Response[v1pb.Operation], error) {
+	op, err := s.getOperation(ctx, request.Msg.Name)
+	if err != nil {
+		return nil, err
+	}
+	if op.State == storepb.Operation_RUNNING {
+		if err := s.operationManager.Cancel(ctx, op); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		op, err = s.getOperation(ctx, request.Msg.Name)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return connect.NewResponse(convertToV1Operation(op)), nil
+}
+
+// WaitOperation waits until the operation is done or the timeout is reached.
+func (s *OperationService) WaitOperation(ctx context.Context, request *connect.Request[v1pb.WaitOperationRequest]) (*connect.Response[v1pb.Operation], error) {
+	req := request.Msg
+	timeout := maxOperationWaitTimeout
+	if req.Timeout != nil {
+		if err := req.Timeout.CheckValid(); err != nil || req.Timeout.AsDuration() < 0 {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("invalid timeout %v", req.Timeout))
+		}
+		timeout = min(req.Timeout.AsDuration(), maxOperationWaitTimeout)
+	}
+	deadline := time.After(timeout)
+	ticker := time.NewTicker(operationWaitInterval)
+	defer ticker.Stop()
+
+	for {
+		op, err := s.getOperation(ctx, req.Name)
+		if err != nil {
+			return nil, err
+		}
+		if op.State != storepb.Operation_RUNNING {
+			return connect.NewResponse(convertToV1Operation(op)), nil
+		}
+		select {
+		case <-ticker.C:
+		case <-deadline:
+			return connect.NewResponse(convertToV1Operation(op)), nil
+		case <-ctx.Done():
+			return nil, connect.NewError(connect.CodeCanceled, ctx.Err())
+		}
+	}
+}
+
+// getOperation gets an operation created by the caller.
+func (s *OperationService) getOperation(ctx context.Context, name string) (*store.OperationMessage, error) {
+	user, ok := GetUserFromContext(ctx)
+	if !ok || user == nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("user not found"))
+	}
+	id, err := common.GetOperationID(name)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	op, err := s.store.GetOperation(ctx, &store.FindOperationMessage{
+		Workspace: common.GetWorkspaceIDFromContext(ctx),
+		ID:        &id,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get operation"))
+	}
+	// The operations of other users are not visible to the caller.
+	if op == nil || op.Creator != user.Email {
+		return nil, connect.NewError(connect.CodeNotFound, errors.Errorf("operation %q not found", name))
+	}
+	return op, nil
+}
+
+func convertToV1Operation(op *store.OperationMessage) *v1pb.Operation {
+	result := &v1pb.Operation{
+		Name:           common.FormatOperation(op.ID),
+		Type:           v1pb.Operation_Type(op.Payload.GetType()),
+		Resource:       op.Payload.GetResource(),
+		State:          v1pb.Operation_State(op.State),
+		Done:           op.State != storepb.Operation_RUNNING,
+		Creator:        common.FormatUserEmail(op.Creator),
+		CompletedCount: op.Payload.GetCompletedCount(),
+		TotalCount:     op.Payload.GetTotalCount(),
+		Response:       op.Payload.GetResponse(),
+		CreateTime:     timestamppb.New(op.CreatedAt),
+		UpdateTime:     timestamppb.New(op.UpdatedAt),
+	}
+	if op.State == storepb.Operation_FAILED || op.State == storepb.Operation_CANCELED {
+		result.Error = &spb.Status{
+			Code:    op.Payload.GetErrorCode(),
+			Message: op.Payload.GetError(),
+		}
+	}
+	return result
+}
+
+// startOperation starts an operation created by the caller, and returns the operation to track it.
+func startOperation(ctx context.Context, operationManager *operation.Manager, operationType storepb.Operation_Type, resource string, totalCount int, f operation.Func) (*v1pb.Operation, error) {
+	user, ok := GetUserFromContext(ctx)
+	if !ok || user == nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("user not found"))
+	}
+	op, err := operationManager.Start(ctx, &store.OperationMessage{
+		Workspace: common.GetWorkspaceIDFromContext(ctx),
+		Creator:   user.Email,
+		Payload: &storepb.OperationPayload{
+			Type:       operationType,
+			Resource:   resource,
+			TotalCount: int32(totalCount),
+		},
+	}, f)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return convertToV1Operation(op), nil
+}
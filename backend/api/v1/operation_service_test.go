@@ -0,0 +1,61 @@
+package v1
+
+import (
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+func TestConvertToV1Operation(t *testing.T) {
+	response, err := anypb.New(&v1pb.ExportResponse{ExportArchive: "exportArchives/abc"})
+	require.NoError(t, err)
+	now := time.Now()
+	op := &store.OperationMessage{
+		ID:      7,
+		Creator: "alice@example.com",
+		State:   storepb.Operation_SUCCEEDED,
+		Payload: &storepb.OperationPayload{
+			Type:           storepb.Operation_EXPORT,
+			Resource:       "instances/prod/databases/hr",
+			CompletedCount: 1,
+			TotalCount:     1,
+			Response:       response,
+		},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	got := convertToV1Operation(op)
+	require.Equal(t, "operations/7", got.Name)
+	require.Equal(t, v1pb.Operation_EXPORT, got.Type)
+	require.Equal(t, v1pb.Operation_SUCCEEDED, got.State)
+	require.True(t, got.Done)
+	require.Equal(t, "users/alice@example.com", got.Creator)
+	require.Nil(t, got.Error)
+	exportResponse := &v1pb.ExportResponse{}
+	require.NoError(t, got.Response.UnmarshalTo(exportResponse))
+	require.Equal(t, "exportArchives/abc", exportResponse.ExportArchive)
+	// The export response is not written to the audit logs.
+	require.Nil(t, redactOperation(got).Response)
+	require.NotNil(t, got.Response)
+
+	op.State = storepb.Operation_FAILED
+	op.Payload.Response = nil
+	op.Payload.ErrorCode = int32(connect.CodeNotFound)
+	op.Payload.Error = "database not found"
+	got = convertToV1Operation(op)
+	require.True(t, got.Done)
+	require.Equal(t, int32(connect.CodeNotFound), got.Error.GetCode())
+	require.Equal(t, "database not found", got.Error.GetMessage())
+
+	op.State = storepb.Operation_RUNNING
+	got = convertToV1Operation(op)
+	require.False(t, got.Done)
+	require.Nil(t, got.Error)
+}
@@ -29,6 +29,7 @@ var (
 		storepb.Policy_QUERY_DATA:        {storepb.Policy_WORKSPACE, storepb.Policy_PROJECT},
 		storepb.Policy_MASKING_RULE:      {storepb.Policy_WORKSPACE},
 		storepb.Policy_MASKING_EXEMPTION: {storepb.Policy_PROJECT},
+		storepb.Policy_ROW_FILTER:        {storepb.Policy_PROJECT},
 	}
 )
 
@@ -207,16 +208,36 @@ func (s *OrgPolicyService) UpdatePolicy(ctx context.Context, req *connect.Reques
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("update_mask must be set"))
 	}
 
+	patch, err := s.buildUpdatePolicyPatch(ctx, policy, req.Msg.Policy, req.Msg.UpdateMask.Paths)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := s.store.UpdatePolicy(ctx, patch)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	response, err := convertToPolicy(p)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(response), nil
+}
+
+// buildUpdatePolicyPatch builds the patch to update the policy with the desired fields in paths.
+func (s *OrgPolicyService) buildUpdatePolicyPatch(ctx context.Context, policy *store.PolicyMessage, desired *v1pb.Policy, paths []string) (*store.UpdatePolicyMessage, error) {
 	patch := &store.UpdatePolicyMessage{
 		ResourceType: policy.ResourceType,
 		Type:         policy.Type,
 		Resource:     policy.Resource,
 		Workspace:    policy.Workspace,
 	}
-	for _, path := range req.Msg.UpdateMask.Paths {
+	for _, path := range paths {
 		switch path {
 		case "inherit_from_parent":
-			patch.InheritFromParent = &req.Msg.Policy.InheritFromParent
+			patch.InheritFromParent = &desired.InheritFromParent
 		case
 			"rollout_policy",
 			"disable_copy_data_policy",
@@ -226,36 +247,26 @@ func (s *OrgPolicyService) UpdatePolicy(ctx context.Context, req *connect.Reques
 			"tag_policy",
 			"data_source_query_policy",
 			"export_data_policy",
-			"query_data_policy":
+			"query_data_policy",
+			"row_filter_policy":
 			if !pathMatchType(path, policy.Type) {
 				return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("invalid path %s for policy type %s", path, policy.Type.String()))
 			}
-			if err := validatePolicyPayload(policy.Type, req.Msg.Policy); err != nil {
+			if err := validatePolicyPayload(policy.Type, desired); err != nil {
 				return nil, connect.NewError(connect.CodeInvalidArgument, errors.Wrap(err, "invalid policy"))
 			}
-			payloadStr, err := s.convertPolicyPayloadToString(ctx, req.Msg.Policy)
+			payloadStr, err := s.convertPolicyPayloadToString(ctx, desired)
 			if err != nil {
 				return nil, err
 			}
 			patch.Payload = &payloadStr
 		case "enforce":
-			patch.Enforce = &req.Msg.Policy.Enforce
+			patch.Enforce = &desired.Enforce
 		default:
 			return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("unexpected path %s", path))
 		}
 	}
-
-	p, err := s.store.UpdatePolicy(ctx, patch)
-	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
-	}
-
-	response, err := convertToPolicy(p)
-	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
-	}
-
-	return connect.NewResponse(response), nil
+	return patch, nil
 }
 
 func pathMatchType(path string, policyType storepb.Policy_Type) bool {
@@ -270,6 +281,8 @@ func pathMatchType(path string, policyType storepb.Policy_Type) bool {
 		return path == "tag_policy"
 	case storepb.Policy_QUERY_DATA:
 		return path == "query_data_policy"
+	case storepb.Policy_ROW_FILTER:
+		return path == "row_filter_policy"
 	default:
 		return false
 	}
@@ -392,9 +405,39 @@ func (s *OrgPolicyService) findPolicyMessage(ctx context.Context, policyName str
 }
 
 func (s *OrgPolicyService) createPolicyMessage(ctx context.Context, req *connect.Request[v1pb.CreatePolicyRequest]) (*v1pb.Policy, error) {
-	parent := req.Msg.Parent
-	policy := req.Msg.Policy
+	create, err := s.buildCreatePolicyMessage(ctx, req.Msg.Parent, req.Msg.Policy)
+	if err != nil {
+		return nil, err
+	}
+
+	var perm permission.Permission
+	switch create.Type {
+	case storepb.Policy_MASKING_EXEMPTION:
+		perm = permission.PoliciesCreateMaskingExemptionPolicy
+	case storepb.Policy_MASKING_RULE:
+		perm = permission.PoliciesCreateMaskingRulePolicy
+	default:
+		perm = permission.PoliciesCreate
+	}
+	if err := s.checkPolicyPermission(ctx, req, perm, create); err != nil {
+		return nil, err
+	}
+
+	p, err := s.store.CreatePolicy(ctx, create)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
 
+	response, err := convertToPolicy(p)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return response, nil
+}
+
+// buildCreatePolicyMessage builds the policy to create in the parent.
+func (s *OrgPolicyService) buildCreatePolicyMessage(ctx context.Context, parent string, policy *v1pb.Policy) (*store.PolicyMessage, error) {
 	resourceType, _, err := common.GetPolicyResourceTypeAndResource(parent)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
@@ -428,31 +471,7 @@ func (s *OrgPolicyService) createPolicyMessage(ctx context.Context, req *connect
 		// Enforce cannot be false while creating a policy.
 		Enforce: true,
 	}
-
-	var perm permission.Permission
-	switch create.Type {
-	case storepb.Policy_MASKING_EXEMPTION:
-		perm = permission.PoliciesCreateMaskingExemptionPolicy
-	case storepb.Policy_MASKING_RULE:
-		perm = permission.PoliciesCreateMaskingRulePolicy
-	default:
-		perm = permission.PoliciesCreate
-	}
-	if err := s.checkPolicyPermission(ctx, req, perm, create); err != nil {
-		return nil, err
-	}
-
-	p, err := s.store.CreatePolicy(ctx, create)
-	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
-	}
-
-	response, err := convertToPolicy(p)
-	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
-	}
-
-	return response, nil
+	return create, nil
 }
 
 func (s *OrgPolicyService) checkPolicyPermission(ctx context.Context, req connect.AnyRequest, perm permission.Permission, policy *store.PolicyMessage) error {
@@ -543,6 +562,43 @@ func validatePolicyPayload(policyType storepb.Policy_Type, policy *v1pb.Policy)
 				}
 			}
 		}
+	case storepb.Policy_ROW_FILTER:
+		rowFilterPolicy, ok := policy.Policy.(*v1pb.Policy_RowFilterPolicy)
+		if !ok {
+			return connect.NewError(connect.CodeInvalidArgument, errors.Errorf("unmatched policy type %v and policy %v", policyType, policy.Policy))
+		}
+		if rowFilterPolicy.RowFilterPolicy == nil {
+			return connect.NewError(connect.CodeInvalidArgument, errors.New("row filter policy must be set"))
+		}
+		ids := make(map[string]bool)
+		for _, filter := range rowFilterPolicy.RowFilterPolicy.Filters {
+			if filter.Id == "" {
+				return connect.NewError(connect.CodeInvalidArgument, errors.New("row filter must have ID set"))
+			}
+			if ids[filter.Id] {
+				return connect.NewError(connect.CodeInvalidArgument, errors.Errorf("duplicate row filter ID %q", filter.Id))
+			}
+			ids[filter.Id] = true
+			if len(filter.Members) == 0 && len(filter.Roles) == 0 {
+				return connect.NewError(connect.CodeInvalidArgument, errors.Errorf("row filter %q must have members or roles", filter.Id))
+			}
+			for _, member := range filter.Members {
+				if err := validateMember(member); err != nil {
+					return err
+				}
+			}
+			for _, role := range filter.Roles {
+				if !strings.HasPrefix(role, common.RolePrefix) {
+					return connect.NewError(connect.CodeInvalidArgument, errors.Errorf("invalid role %q of row filter %q", role, filter.Id))
+				}
+			}
+			if _, err := common.ValidateRowFilterConditionCELExpr(filter.Condition); err != nil {
+				return connect.NewError(connect.CodeInvalidArgument, errors.Errorf("invalid condition of row filter %q: %v", filter.Id, err))
+			}
+			if err := validateRowFilterExpression(filter.GetExpression().GetExpression()); err != nil {
+				return connect.NewError(connect.CodeInvalidArgument, errors.Errorf("invalid expression of row filter %q: %v", filter.Id, err))
+			}
+		}
 	default:
 	}
 	return nil
@@ -605,6 +661,19 @@ func (s *OrgPolicyService) convertPolicyPayloadToString(ctx context.Context, pol
 			return "", errors.Wrap(err, "failed to marshal masking exemption policy")
 		}
 		return string(payloadBytes), nil
+	case v1pb.PolicyType_ROW_FILTER:
+		if err := s.licenseService.IsFeatureEnabled(ctx, common.GetWorkspaceIDFromContext(ctx), v1pb.PlanFeature_FEATURE_DATA_MASKING); err != nil {
+			return "", connect.NewError(connect.CodePermissionDenied, err)
+		}
+		payload, err := convertToStorePBRowFilterPolicy(policy.GetRowFilterPolicy())
+		if err != nil {
+			return "", connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		payloadBytes, err := protojson.Marshal(payload)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to marshal row filter policy")
+		}
+		return string(payloadBytes), nil
 	default:
 	}
 
@@ -668,6 +737,14 @@ func convertToPolicy(policyMessage *store.PolicyMessage) (*v1pb.Policy, error) {
 		policy.Policy = &v1pb.Policy_MaskingExemptionPolicy{
 			MaskingExemptionPolicy: payload,
 		}
+	case storepb.Policy_ROW_FILTER:
+		rowFilterPolicy := &storepb.RowFilterPolicy{}
+		if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(policyMessage.Payload), rowFilterPolicy); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal row filter policy")
+		}
+		policy.Policy = &v1pb.Policy_RowFilterPolicy{
+			RowFilterPolicy: convertToV1PBRowFilterPolicy(rowFilterPolicy),
+		}
 	default:
 	}
 
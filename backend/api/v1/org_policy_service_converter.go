@@ -170,20 +170,22 @@ func convertToV1PBQueryDataPolicy(payloadStr string) (*v1pb.Policy_QueryDataPoli
 	}
 	return &v1pb.Policy_QueryDataPolicy{
 		QueryDataPolicy: &v1pb.QueryDataPolicy{
-			DisableExport:        payload.DisableExport,
-			MaximumResultRows:    payload.MaximumResultRows,
-			DisableCopyData:      payload.DisableCopyData,
-			AllowAdminDataSource: payload.AllowAdminDataSource,
+			DisableExport:                    payload.DisableExport,
+			MaximumResultRows:                payload.MaximumResultRows,
+			DisableCopyData:                  payload.DisableCopyData,
+			AllowAdminDataSource:             payload.AllowAdminDataSource,
+			JustificationClassificationLevel: payload.JustificationClassificationLevel,
 		},
 	}, nil
 }
 
 func convertToQueryDataPolicyPayload(policy *v1pb.QueryDataPolicy) *storepb.QueryDataPolicy {
 	return &storepb.QueryDataPolicy{
-		DisableExport:        policy.DisableExport,
-		MaximumResultRows:    policy.MaximumResultRows,
-		DisableCopyData:      policy.DisableCopyData,
-		AllowAdminDataSource: policy.AllowAdminDataSource,
+		DisableExport:                    policy.DisableExport,
+		MaximumResultRows:                policy.MaximumResultRows,
+		DisableCopyData:                  policy.DisableCopyData,
+		AllowAdminDataSource:             policy.AllowAdminDataSource,
+		JustificationClassificationLevel: policy.JustificationClassificationLevel,
 	}
 }
 
@@ -286,6 +288,58 @@ func convertToV1PBMaskingExemptionPolicyPayload(policy *storepb.MaskingExemption
 	}
 }
 
+func convertToStorePBRowFilterPolicy(policy *v1pb.RowFilterPolicy) (*storepb.RowFilterPolicy, error) {
+	var filters []*storepb.RowFilterPolicy_RowFilter
+	for _, filter := range policy.Filters {
+		var members []string
+		for _, v1Member := range filter.Members {
+			member, err := convertToStoreIamPolicyMember(v1Member)
+			if err != nil {
+				return nil, err
+			}
+			members = append(members, member)
+		}
+		filters = append(filters, &storepb.RowFilterPolicy_RowFilter{
+			Id:         filter.Id,
+			Title:      filter.Title,
+			Members:    members,
+			Roles:      filter.Roles,
+			Condition:  filter.Condition,
+			Expression: filter.Expression,
+		})
+	}
+
+	return &storepb.RowFilterPolicy{
+		Filters: filters,
+	}, nil
+}
+
+func convertToV1PBRowFilterPolicy(policy *storepb.RowFilterPolicy) *v1pb.RowFilterPolicy {
+	var filters []*v1pb.RowFilterPolicy_RowFilter
+	for _, filter := range policy.Filters {
+		var members []string
+		for _, storeMember := range filter.Members {
+			memberInBinding := convertToV1MemberInBinding(storeMember)
+			if memberInBinding == "" {
+				continue
+			}
+			members = append(members, memberInBinding)
+		}
+		filters = append(filters, &v1pb.RowFilterPolicy_RowFilter{
+			Id:         filter.Id,
+			Title:      filter.Title,
+			Members:    members,
+			Roles:      filter.Roles,
+			Condition:  filter.Condition,
+			Expression: filter.Expression,
+		})
+	}
+
+	return &v1pb.RowFilterPolicy{
+		Filters: filters,
+	}
+}
+
 func convertV1PBToStorePBPolicyType(pType v1pb.PolicyType) (storepb.Policy_Type, error) {
 	switch pType {
 	case v1pb.PolicyType_ROLLOUT_POLICY:
@@ -298,6 +352,8 @@ func convertV1PBToStorePBPolicyType(pType v1pb.PolicyType) (storepb.Policy_Type,
 		return storepb.Policy_MASKING_EXEMPTION, nil
 	case v1pb.PolicyType_DATA_QUERY:
 		return storepb.Policy_QUERY_DATA, nil
+	case v1pb.PolicyType_ROW_FILTER:
+		return storepb.Policy_ROW_FILTER, nil
 	default:
 	}
 	return storepb.Policy_TYPE_UNSPECIFIED, errors.Errorf("invalid policy type %v", pType)
@@ -315,6 +371,8 @@ func convertStorePBToV1PBPolicyType(pType storepb.Policy_Type) v1pb.PolicyType {
 		return v1pb.PolicyType_MASKING_EXEMPTION
 	case storepb.Policy_QUERY_DATA:
 		return v1pb.PolicyType_DATA_QUERY
+	case storepb.Policy_ROW_FILTER:
+		return v1pb.PolicyType_ROW_FILTER
 	default:
 	}
 	return v1pb.PolicyType_POLICY_TYPE_UNSPECIFIED
@@ -3,8 +3,10 @@ package v1
 import (
 	"context"
 	"log/slog"
+	"path"
 	"slices"
 	"strings"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/pkg/errors"
@@ -19,6 +21,7 @@ import (
 	"github.com/bytebase/bytebase/backend/common/permission"
 	"github.com/bytebase/bytebase/backend/component/bus"
 	"github.com/bytebase/bytebase/backend/component/iam"
+	"github.com/bytebase/bytebase/backend/component/operation"
 	"github.com/bytebase/bytebase/backend/component/webhook"
 	"github.com/bytebase/bytebase/backend/enterprise"
 	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
@@ -27,24 +30,29 @@ import (
 	"github.com/bytebase/bytebase/backend/store"
 )
 
+// planCheckRunWaitInterval is the interval to check the plan check run in the operation of RunPlanChecks.
+const planCheckRunWaitInterval = time.Second
+
 // PlanService represents a service for managing plan.
 type PlanService struct {
 	v1connect.UnimplementedPlanServiceHandler
-	store          *store.Store
-	bus            *bus.Bus
-	iamManager     *iam.Manager
-	webhookManager *webhook.Manager
-	licenseService *enterprise.LicenseService
+	store            *store.Store
+	bus              *bus.Bus
+	iamManager       *iam.Manager
+	webhookManager   *webhook.Manager
+	licenseService   *enterprise.LicenseService
+	operationManager *operation.Manager
 }
 
 // NewPlanService returns a plan service instance.
-func NewPlanService(store *store.Store, bus *bus.Bus, iamManager *iam.Manager, webhookManager *webhook.Manager, licenseService *enterprise.LicenseService) *PlanService {
+func NewPlanService(store *store.Store, bus *bus.Bus, iamManager *iam.Manager, webhookManager *webhook.Manager, licenseService *enterprise.LicenseService, operationManager *operation.Manager) *PlanService {
 	return &PlanService{
-		store:          store,
-		bus:            bus,
-		iamManager:     iamManager,
-		webhookManager: webhookManager,
-		licenseService: licenseService,
+		store:            store,
+		bus:              bus,
+		iamManager:       iamManager,
+		webhookManager:   webhookManager,
+		licenseService:   licenseService,
+		operationManager: operationManager,
 	}
 }
 
@@ -487,7 +495,56 @@ func (s *PlanService) RunPlanChecks(ctx context.Context, request *connect.Reques
 	// Tickle plan check scheduler.
 	s.bus.PlanCheckTickleChan <- 0
 
-	return connect.NewResponse(&v1pb.RunPlanChecksResponse{}), nil
+	op, err := startOperation(ctx, s.operationManager, storepb.Operation_RUN_PLAN_CHECKS, common.FormatPlan(projectID, planID), 0,
+		func(ctx context.Context, _ operation.ProgressFunc) (proto.Message, error) {
+			planCheckRun, err := s.waitPlanCheckRun(ctx, projectID, planID)
+			if err != nil || planCheckRun == nil {
+				return nil, err
+			}
+			return planCheckRun, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&v1pb.RunPlanChecksResponse{Operation: op}), nil
+}
+
+// waitPlanCheckRun waits until the plan check run of the plan is done, and cancels the run if the context is canceled.
+func (s *PlanService) waitPlanCheckRun(ctx context.Context, projectID string, planUID int64) (*v1pb.PlanCheckRun, error) {
+	ticker := time.NewTicker(planCheckRunWaitInterval)
+	defer ticker.Stop()
+	for {
+		planCheckRun, err := s.store.GetPlanCheckRun(ctx, projectID, planUID)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get plan check run"))
+		}
+		// No plan check run is created if the plan has nothing to check.
+		if planCheckRun == nil {
+			return nil, nil
+		}
+		switch planCheckRun.Status {
+		case store.PlanCheckRunStatusDone:
+			return convertToPlanCheckRun(projectID, planUID, planCheckRun), nil
+		case store.PlanCheckRunStatusFailed:
+			return nil, connect.NewError(connect.CodeInternal, errors.Errorf("plan check run failed: %s", planCheckRun.Result.GetError()))
+		case store.PlanCheckRunStatusCanceled:
+			return nil, connect.NewError(connect.CodeAborted, errors.New("plan check run was canceled"))
+		default:
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			// The operation is canceled, so is the plan check run.
+			if err := s.cancelPlanCheckRun(context.WithoutCancel(ctx), projectID, planCheckRun.UID); err != nil {
+				slog.Warn("failed to cancel plan check run", log.BBError(err))
+			}
+			return nil, ctx.Err()
+		}
+	}
 }
 
 // CancelPlanCheckRun cancels the plan check run for a plan.
@@ -521,22 +578,72 @@ func (s *PlanService) CancelPlanCheckRun(ctx context.Context, request *connect.R
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("plan check run is not running or available"))
 	}
 
+	if err := s.cancelPlanCheckRun(ctx, projectID, planCheckRun.UID); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&v1pb.CancelPlanCheckRunResponse{}), nil
+}
+
+func (s *PlanService) cancelPlanCheckRun(ctx context.Context, projectID string, planCheckRunUID int64) error {
 	// Cancel in-flight plan check run if running.
-	if cancelFunc, ok := s.bus.RunningPlanCheckRunsCancelFunc.Load(bus.PlanCheckRunRef{ProjectID: projectID, UID: planCheckRun.UID}); ok {
+	if cancelFunc, ok := s.bus.RunningPlanCheckRunsCancelFunc.Load(bus.PlanCheckRunRef{ProjectID: projectID, UID: planCheckRunUID}); ok {
 		cancelFunc.(context.CancelFunc)()
 	}
 
 	// Broadcast cancel signal to all replicas for HA.
-	if err := s.store.SendSignal(ctx, storepb.Signal_CANCEL_PLAN_CHECK_RUN, projectID, planCheckRun.UID); err != nil {
+	if err := s.store.SendSignal(ctx, storepb.Signal_CANCEL_PLAN_CHECK_RUN, projectID, planCheckRunUID); err != nil {
 		slog.Warn("failed to send cancel signal", log.BBError(err))
 	}
 
 	// Update the status to canceled.
-	if err := s.store.BatchCancelPlanCheckRuns(ctx, projectID, []int64{planCheckRun.UID}); err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to cancel plan check run"))
+	if err := s.store.BatchCancelPlanCheckRuns(ctx, projectID, []int64{planCheckRunUID}); err != nil {
+		return errors.Wrapf(err, "failed to cancel plan check run")
 	}
+	return nil
+}
 
-	return connect.NewResponse(&v1pb.CancelPlanCheckRunResponse{}), nil
+// PreviewPlanApproval previews the approval template for the issue of a plan.
+func (s *PlanService) PreviewPlanApproval(ctx context.Context, request *connect.Request[v1pb.PreviewPlanApprovalRequest]) (*connect.Response[v1pb.PreviewPlanApprovalResponse], error) {
+	req := request.Msg
+	projectID, planID, err := common.GetProjectIDPlanID(req.Name)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	project, err := s.store.GetProject(ctx, &store.FindProjectMessage{
+		Workspace:  common.GetWorkspaceIDFromContext(ctx),
+		ResourceID: &projectID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get project"))
+	}
+	if project == nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.Errorf("project not found for id: %v", projectID))
+	}
+	plan, err := s.store.GetPlan(ctx, &store.FindPlanMessage{
+		Workspace: common.GetWorkspaceIDFromContext(ctx),
+		UID:       &planID,
+		ProjectID: projectID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get plan"))
+	}
+	if plan == nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.Errorf("plan %d not found in project %s", planID, projectID))
+	}
+
+	approvalTemplate, riskLevel, done, err := approval.PreviewApprovalTemplateForPlan(ctx, s.store, s.licenseService, project, plan)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to preview approval template"))
+	}
+	response := &v1pb.PreviewPlanApprovalResponse{
+		ApprovalFindingDone: done,
+		RiskLevel:           convertToIssueRiskLevel(riskLevel),
+	}
+	if approvalTemplate != nil {
+		response.ApprovalTemplate = convertToApprovalTemplate(approvalTemplate)
+	}
+	return connect.NewResponse(response), nil
 }
 
 func validateSpecs(ctx context.Context, s *store.Store, projectID string, specs []*v1pb.Plan_Spec) (*v1pb.DatabaseGroup, error) {
@@ -619,6 +726,26 @@ func validateSpecs(ctx context.Context, s *store.Store, projectID string, specs
 					sheetSha256s = append(sheetSha256s, sha)
 				}
 			}
+		case *v1pb.Plan_Spec_CloneDatabaseConfig:
+			configTypeCount["clone_database"]++
+			c := config.CloneDatabaseConfig
+			for _, name := range []string{c.Source, c.Target} {
+				if _, _, err := common.GetInstanceDatabaseID(name); err != nil {
+					return nil, errors.Errorf("invalid database %q", name)
+				}
+				databaseNames = append(databaseNames, name)
+			}
+			if c.Source == c.Target {
+				return nil, errors.Errorf("source and target database cannot be the same")
+			}
+			for _, pattern := range slices.Concat(c.IncludeTables, c.ExcludeTables) {
+				if _, err := path.Match(pattern, ""); err != nil {
+					return nil, errors.Errorf("invalid table pattern %q", pattern)
+				}
+			}
+			if c.SamplePercent < 0 || c.SamplePercent > 100 {
+				return nil, errors.Errorf("sample percent must be in [0, 100], got %d", c.SamplePercent)
+			}
 		default:
 			return nil, errors.Errorf("invalid spec type")
 		}
@@ -1027,6 +1154,8 @@ func convertToPlanSpec(projectID string, spec *storepb.PlanConfig_Spec) *v1pb.Pl
 		v1Spec.Config = convertToPlanSpecChangeDatabaseConfig(projectID, v)
 	case *storepb.PlanConfig_Spec_ExportDataConfig:
 		v1Spec.Config = convertToPlanSpecExportDataConfig(projectID, v)
+	case *storepb.PlanConfig_Spec_CloneDatabaseConfig:
+		v1Spec.Config = convertToPlanSpecCloneDatabaseConfig(v)
 	default:
 	}
 
@@ -1080,6 +1209,19 @@ func convertToPlanSpecExportDataConfig(projectID string, config *storepb.PlanCon
 	}
 }
 
+func convertToPlanSpecCloneDatabaseConfig(config *storepb.PlanConfig_Spec_CloneDatabaseConfig) *v1pb.Plan_Spec_CloneDatabaseConfig {
+	c := config.CloneDatabaseConfig
+	return &v1pb.Plan_Spec_CloneDatabaseConfig{
+		CloneDatabaseConfig: &v1pb.Plan_CloneDatabaseConfig{
+			Source:        c.Source,
+			Target:        c.Target,
+			IncludeTables: c.IncludeTables,
+			ExcludeTables: c.ExcludeTables,
+			SamplePercent: c.SamplePercent,
+		},
+	}
+}
+
 func convertPlanSpecs(specs []*v1pb.Plan_Spec) []*storepb.PlanConfig_Spec {
 	storeSpecs := make([]*storepb.PlanConfig_Spec, len(specs))
 	for i := range specs {
@@ -1100,6 +1242,8 @@ func convertPlanSpec(spec *v1pb.Plan_Spec) *storepb.PlanConfig_Spec {
 		storeSpec.Config = convertPlanSpecChangeDatabaseConfig(v)
 	case *v1pb.Plan_Spec_ExportDataConfig:
 		storeSpec.Config = convertPlanSpecExportDataConfig(v)
+	case *v1pb.Plan_Spec_CloneDatabaseConfig:
+		storeSpec.Config = convertPlanSpecCloneDatabaseConfig(v)
 	default:
 	}
 	return storeSpec
@@ -1169,6 +1313,19 @@ func convertPlanSpecExportDataConfig(config *v1pb.Plan_Spec_ExportDataConfig) *s
 	}
 }
 
+func convertPlanSpecCloneDatabaseConfig(config *v1pb.Plan_Spec_CloneDatabaseConfig) *storepb.PlanConfig_Spec_CloneDatabaseConfig {
+	c := config.CloneDatabaseConfig
+	return &storepb.PlanConfig_Spec_CloneDatabaseConfig{
+		CloneDatabaseConfig: &storepb.PlanConfig_CloneDatabaseConfig{
+			Source:        c.Source,
+			Target:        c.Target,
+			IncludeTables: c.IncludeTables,
+			ExcludeTables: c.ExcludeTables,
+			SamplePercent: c.SamplePercent,
+		},
+	}
+}
+
 func convertToPlanCheckRunStatus(status store.PlanCheckRunStatus) v1pb.PlanCheckRun_Status {
 	switch status {
 	case store.PlanCheckRunStatusCanceled:
@@ -282,16 +282,32 @@ func (s *ProjectService) UpdateProject(ctx context.Context, req *connect.Request
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("default project cannot be updated"))
 	}
 
+	patch, err := s.buildUpdateProjectPatch(ctx, project, req.Msg.Project, req.Msg.UpdateMask.Paths)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.store.UpdateProjects(ctx, patch); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	project, err = s.store.GetProject(ctx, &store.FindProjectMessage{Workspace: common.GetWorkspaceIDFromContext(ctx), ResourceID: &patch.ResourceID})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(convertToProject(project)), nil
+}
+
+// buildUpdateProjectPatch builds the patch to update the project with the desired fields in paths.
+func (s *ProjectService) buildUpdateProjectPatch(ctx context.Context, project *store.ProjectMessage, desired *v1pb.Project, paths []string) (*store.UpdateProjectMessage, error) {
 	patch := &store.UpdateProjectMessage{
 		ResourceID: project.ResourceID,
 		Workspace:  project.Workspace,
 	}
 
 	projectSettings := proto.CloneOf(project.Setting)
-	for _, path := range req.Msg.UpdateMask.Paths {
+	for _, path := range paths {
 		switch path {
 		case "title":
-			patch.Title = &req.Msg.Project.Title
+			patch.Title = &desired.Title
 		case "data_classification_config_id":
 			setting, err := s.store.GetDataClassificationSetting(ctx, common.GetWorkspaceIDFromContext(ctx))
 			if err != nil {
@@ -299,19 +315,19 @@ func (s *ProjectService) UpdateProject(ctx context.Context, req *connect.Request
 			}
 			existConfig := false
 			for _, config := range setting.Configs {
-				if config.Id == req.Msg.Project.DataClassificationConfigId {
+				if config.Id == desired.DataClassificationConfigId {
 					existConfig = true
 					break
 				}
 			}
 			if !existConfig {
-				return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("data classification %s not exists", req.Msg.Project.DataClassificationConfigId))
+				return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("data classification %s not exists", desired.DataClassificationConfigId))
 			}
-			projectSettings.DataClassificationConfigId = req.Msg.Project.DataClassificationConfigId
+			projectSettings.DataClassificationConfigId = desired.DataClassificationConfigId
 			patch.Setting = projectSettings
 		case "issue_labels":
 			var issueLabels []*storepb.Label
-			for _, label := range req.Msg.Project.IssueLabels {
+			for _, label := range desired.IssueLabels {
 				issueLabels = append(issueLabels, &storepb.Label{
 					Value: label.Value,
 					Color: label.Color,
@@ -321,60 +337,65 @@ func (s *ProjectService) UpdateProject(ctx context.Context, req *connect.Request
 			projectSettings.IssueLabels = issueLabels
 			patch.Setting = projectSettings
 		case "force_issue_labels":
-			projectSettings.ForceIssueLabels = req.Msg.Project.ForceIssueLabels
+			projectSettings.ForceIssueLabels = desired.ForceIssueLabels
 			patch.Setting = projectSettings
 		case "enforce_issue_title":
-			projectSettings.EnforceIssueTitle = req.Msg.Project.EnforceIssueTitle
+			projectSettings.EnforceIssueTitle = desired.EnforceIssueTitle
 			patch.Setting = projectSettings
 		case "enforce_sql_review":
-			projectSettings.EnforceSqlReview = req.Msg.Project.EnforceSqlReview
+			projectSettings.EnforceSqlReview = desired.EnforceSqlReview
 			patch.Setting = projectSettings
 		case "postgres_database_tenant_mode":
-			projectSettings.PostgresDatabaseTenantMode = req.Msg.Project.PostgresDatabaseTenantMode
+			projectSettings.PostgresDatabaseTenantMode = desired.PostgresDatabaseTenantMode
 			patch.Setting = projectSettings
 		case "allow_self_approval":
-			projectSettings.AllowSelfApproval = req.Msg.Project.AllowSelfApproval
+			projectSettings.AllowSelfApproval = desired.AllowSelfApproval
 			patch.Setting = projectSettings
 		case "execution_retry_policy":
-			projectSettings.ExecutionRetryPolicy = convertToStoreExecutionRetryPolicy(req.Msg.Project.ExecutionRetryPolicy)
+			projectSettings.ExecutionRetryPolicy = convertToStoreExecutionRetryPolicy(desired.ExecutionRetryPolicy)
 			patch.Setting = projectSettings
 		case "ci_sampling_size":
-			projectSettings.CiSamplingSize = req.Msg.Project.CiSamplingSize
+			projectSettings.CiSamplingSize = desired.CiSamplingSize
 			patch.Setting = projectSettings
 		case "parallel_tasks_per_rollout":
-			projectSettings.ParallelTasksPerRollout = req.Msg.Project.ParallelTasksPerRollout
+			projectSettings.ParallelTasksPerRollout = desired.ParallelTasksPerRollout
 			patch.Setting = projectSettings
 		case "require_issue_approval":
-			projectSettings.RequireIssueApproval = req.Msg.Project.RequireIssueApproval
+			projectSettings.RequireIssueApproval = desired.RequireIssueApproval
 			patch.Setting = projectSettings
 		case "require_plan_check_no_error":
-			projectSettings.RequirePlanCheckNoError = req.Msg.Project.RequirePlanCheckNoError
+			projectSettings.RequirePlanCheckNoError = desired.RequirePlanCheckNoError
 			patch.Setting = projectSettings
 		case "allow_request_role":
-			projectSettings.AllowRequestRole = req.Msg.Project.AllowRequestRole
+			projectSettings.AllowRequestRole = desired.AllowRequestRole
 			patch.Setting = projectSettings
 		case "allow_just_in_time_access":
-			projectSettings.AllowJustInTimeAccess = req.Msg.Project.AllowJustInTimeAccess
+			projectSettings.AllowJustInTimeAccess = desired.AllowJustInTimeAccess
+			patch.Setting = projectSettings
+		case "query_result_cache":
+			if err := validateQueryResultCache(desired.QueryResultCache); err != nil {
+				return nil, connect.NewError(connect.CodeInvalidArgument, err)
+			}
+			projectSettings.QueryResultCache = convertToStoreQueryResultCache(desired.QueryResultCache)
+			patch.Setting = projectSettings
+		case "break_glass_access":
+			if err := validateBreakGlassAccess(desired.BreakGlassAccess); err != nil {
+				return nil, connect.NewError(connect.CodeInvalidArgument, err)
+			}
+			projectSettings.BreakGlassAccess = convertToStoreBreakGlassAccess(desired.BreakGlassAccess)
 			patch.Setting = projectSettings
 		case "labels":
-			if err := validateLabels(req.Msg.Project.Labels); err != nil {
+			if err := validateLabels(desired.Labels); err != nil {
 				return nil, connect.NewError(connect.CodeInvalidArgument, err)
 			}
-			projectSettings.Labels = req.Msg.Project.Labels
+			projectSettings.Labels = desired.Labels
 			patch.Setting = projectSettings
 		default:
 			return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf(`unsupport update_mask "%s"`, path))
 		}
 	}
 
-	if err := s.store.UpdateProjects(ctx, patch); err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
-	}
-	project, err = s.store.GetProject(ctx, &store.FindProjectMessage{Workspace: common.GetWorkspaceIDFromContext(ctx), ResourceID: &patch.ResourceID})
-	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
-	}
-	return connect.NewResponse(convertToProject(project)), nil
+	return patch, nil
 }
 
 // DeleteProject deletes a project.
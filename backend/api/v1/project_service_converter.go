@@ -68,6 +68,8 @@ func convertToStoreActivityTypes(types []v1pb.Activity_Type) ([]storepb.Activity
 			result = append(result, storepb.Activity_PIPELINE_FAILED)
 		case v1pb.Activity_PIPELINE_COMPLETED:
 			result = append(result, storepb.Activity_PIPELINE_COMPLETED)
+		case v1pb.Activity_ACCESS_GRANT_BREAK_GLASS:
+			result = append(result, storepb.Activity_ACCESS_GRANT_BREAK_GLASS)
 		default:
 			return nil, common.Errorf(common.Invalid, "unsupported activity type: %v", tp)
 		}
@@ -91,6 +93,8 @@ func convertToV1ActivityTypes(types []storepb.Activity_Type) []v1pb.Activity_Typ
 			result = append(result, v1pb.Activity_PIPELINE_FAILED)
 		case storepb.Activity_PIPELINE_COMPLETED:
 			result = append(result, v1pb.Activity_PIPELINE_COMPLETED)
+		case storepb.Activity_ACCESS_GRANT_BREAK_GLASS:
+			result = append(result, v1pb.Activity_ACCESS_GRANT_BREAK_GLASS)
 		default:
 			result = append(result, v1pb.Activity_TYPE_UNSPECIFIED)
 		}
@@ -296,6 +300,52 @@ func convertToProject(projectMessage *store.ProjectMessage) *v1pb.Project {
 		RequirePlanCheckNoError:    projectMessage.Setting.RequirePlanCheckNoError,
 		AllowRequestRole:           projectMessage.Setting.AllowRequestRole,
 		AllowJustInTimeAccess:      projectMessage.Setting.AllowJustInTimeAccess,
+		QueryResultCache:           convertToV1QueryResultCache(projectMessage.Setting.QueryResultCache),
+		BreakGlassAccess:           convertToV1BreakGlassAccess(projectMessage.Setting.BreakGlassAccess),
+	}
+}
+
+func convertToV1QueryResultCache(cache *storepb.Project_QueryResultCache) *v1pb.Project_QueryResultCache {
+	if cache == nil {
+		return nil
+	}
+	return &v1pb.Project_QueryResultCache{
+		Enabled:     cache.Enabled,
+		Ttl:         cache.Ttl,
+		MaximumSize: cache.MaximumSize,
+	}
+}
+
+func convertToStoreQueryResultCache(cache *v1pb.Project_QueryResultCache) *storepb.Project_QueryResultCache {
+	if cache == nil {
+		return nil
+	}
+	return &storepb.Project_QueryResultCache{
+		Enabled:     cache.Enabled,
+		Ttl:         cache.Ttl,
+		MaximumSize: cache.MaximumSize,
+	}
+}
+
+func convertToV1BreakGlassAccess(breakGlass *storepb.Project_BreakGlassAccess) *v1pb.Project_BreakGlassAccess {
+	if breakGlass == nil {
+		return nil
+	}
+	return &v1pb.Project_BreakGlassAccess{
+		Enabled:          breakGlass.Enabled,
+		MaximumDuration:  breakGlass.MaximumDuration,
+		SecurityContacts: breakGlass.SecurityContacts,
+	}
+}
+
+func convertToStoreBreakGlassAccess(breakGlass *v1pb.Project_BreakGlassAccess) *storepb.Project_BreakGlassAccess {
+	if breakGlass == nil {
+		return nil
+	}
+	return &storepb.Project_BreakGlassAccess{
+		Enabled:          breakGlass.Enabled,
+		MaximumDuration:  breakGlass.MaximumDuration,
+		SecurityContacts: breakGlass.SecurityContacts,
 	}
 }
 
@@ -2,12 +2,14 @@ package v1
 
 import (
 	"context"
+	"slices"
 	"strings"
 
 	"github.com/pkg/errors"
 
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/component/masker"
+	"github.com/bytebase/bytebase/backend/component/secret"
 	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
 	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
 	parserbase "github.com/bytebase/bytebase/backend/plugin/parser/base"
@@ -143,6 +145,12 @@ func getAlgorithmName(m masker.Masker) string {
 	case *masker.InnerOuterMasker:
 		// Check the actual type by examining the mask result
 		return "Inner/Outer mask"
+	case *masker.TokenizationMasker:
+		return "Tokenization"
+	case *masker.DateShiftMasker:
+		return "Date shift"
+	case *masker.NumericNoiseMasker:
+		return "Numeric noise"
 	default:
 		return "Unknown"
 	}
@@ -162,7 +170,7 @@ func buildSemanticTypeToMaskerMap(ctx context.Context, stores *store.Store) (map
 			// Skip the built-in default semantic types.
 			continue
 		}
-		m, err := getMaskerByMaskingAlgorithmAndLevel(semanticType.GetAlgorithm())
+		m, err := getMaskerByMaskingAlgorithmAndLevel(ctx, semanticType.GetAlgorithm())
 		if err != nil {
 			return nil, err
 		}
@@ -480,7 +488,7 @@ func (s *QueryResultMasker) getMaskerForColumnResource(
 	return result, evaluation, nil
 }
 
-func getMaskerByMaskingAlgorithmAndLevel(algorithm *storepb.Algorithm) (masker.Masker, error) {
+func getMaskerByMaskingAlgorithmAndLevel(ctx context.Context, algorithm *storepb.Algorithm) (masker.Masker, error) {
 	if algorithm == nil {
 		return masker.NewNoneMasker(), nil
 	}
@@ -494,10 +502,40 @@ func getMaskerByMaskingAlgorithmAndLevel(algorithm *storepb.Algorithm) (masker.M
 		return masker.NewMD5Masker(m.Md5Mask.Salt), nil
 	case *storepb.Algorithm_InnerOuterMask_:
 		return masker.NewInnerOuterMasker(m.InnerOuterMask.Type, m.InnerOuterMask.PrefixLen, m.InnerOuterMask.SuffixLen, m.InnerOuterMask.Substitution)
+	case *storepb.Algorithm_TokenizationMask_:
+		key, err := getMaskingKey(ctx, m.TokenizationMask.Key, m.TokenizationMask.ExternalKey)
+		if err != nil {
+			return nil, err
+		}
+		return masker.NewTokenizationMasker(key, m.TokenizationMask.Format), nil
+	case *storepb.Algorithm_DateShiftMask_:
+		key, err := getMaskingKey(ctx, m.DateShiftMask.Key, m.DateShiftMask.ExternalKey)
+		if err != nil {
+			return nil, err
+		}
+		return masker.NewDateShiftMasker(key, m.DateShiftMask.MaxShiftDays, m.DateShiftMask.SubjectColumn), nil
+	case *storepb.Algorithm_NumericNoiseMask_:
+		key, err := getMaskingKey(ctx, m.NumericNoiseMask.Key, m.NumericNoiseMask.ExternalKey)
+		if err != nil {
+			return nil, err
+		}
+		return masker.NewNumericNoiseMasker(key, m.NumericNoiseMask.NoiseRatio), nil
 	}
 	return masker.NewNoneMasker(), nil
 }
 
+// getMaskingKey returns the key of a keyed masking algorithm, reading it from the external secret manager if configured.
+func getMaskingKey(ctx context.Context, key string, externalKey *storepb.DataSourceExternalSecret) ([]byte, error) {
+	k, err := secret.ReplaceExternalSecret(ctx, key, externalKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get masking key")
+	}
+	if k == "" {
+		return nil, errors.New("masking key is empty")
+	}
+	return []byte(k), nil
+}
+
 func convertRangeMaskSlices(slices []*storepb.Algorithm_RangeMask_Slice) []*masker.MaskRangeSlice {
 	var result []*masker.MaskRangeSlice
 	for _, slice := range slices {
@@ -523,16 +561,35 @@ func doMaskResult(maskers []masker.Masker, reasons []*v1pb.MaskingReason, result
 		}
 	}
 
+	// Maskers such as DateShiftMasker read the unmasked value of a subject column.
+	subjects := make([]int, len(maskers))
+	for i, m := range maskers {
+		subjects[i] = -1
+		if sm, ok := m.(interface{ SubjectColumn() string }); ok && sm.SubjectColumn() != "" {
+			for j, name := range result.ColumnNames {
+				if strings.EqualFold(name, sm.SubjectColumn()) {
+					subjects[i] = j
+					break
+				}
+			}
+		}
+	}
+
 	for i, row := range result.Rows {
+		original := slices.Clone(row.Values)
 		for j, value := range row.Values {
 			if value == nil {
 				continue
 			}
 			maskedValue := row.Values[j]
 			if j < len(maskers) && maskers[j] != nil {
-				maskedValue = maskers[j].Mask(&masker.MaskData{
+				data := &masker.MaskData{
 					Data: row.Values[j],
-				})
+				}
+				if k := subjects[j]; k >= 0 && k < len(original) {
+					data.Subject = original[k]
+				}
+				maskedValue = maskers[j].Mask(data)
 			}
 			result.Rows[i].Values[j] = maskedValue
 		}
@@ -18,6 +18,7 @@ import (
 
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/component/dbfactory"
+	"github.com/bytebase/bytebase/backend/component/iam"
 	"github.com/bytebase/bytebase/backend/component/sheet"
 
 	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
@@ -32,17 +33,23 @@ type ReleaseService struct {
 	store        *store.Store
 	sheetManager *sheet.Manager
 	dbFactory    *dbfactory.DBFactory
+	iamManager   *iam.Manager
+	secret       string
 }
 
 func NewReleaseService(
 	store *store.Store,
 	sheetManager *sheet.Manager,
 	dbFactory *dbfactory.DBFactory,
+	iamManager *iam.Manager,
+	secret string,
 ) *ReleaseService {
 	return &ReleaseService{
 		store:        store,
 		sheetManager: sheetManager,
 		dbFactory:    dbFactory,
+		iamManager:   iamManager,
+		secret:       secret,
 	}
 }
 
@@ -68,98 +75,11 @@ func (s *ReleaseService) CreateRelease(ctx context.Context, req *connect.Request
 		return nil, connect.NewError(connect.CodeNotFound, errors.Errorf("project %s not found", projectID))
 	}
 
-	sanitizedFiles, err := validateAndSanitizeReleaseFiles(ctx, s.store, req.Msg.Release.Files, req.Msg.Release.Type)
+	release, err := s.createRelease(ctx, user, project, req.Msg.Release, nil /* importSource */, req.Msg.ReleaseIdTemplate, req.Msg.ReleaseIdTimezone)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Wrapf(err, "invalid release files"))
-	}
-	sheetsToCreate := []*store.SheetMessage{}
-	var filesWithoutSheet []*v1pb.Release_File
-	// Prepare sheets to create for files with missing sheets.
-	// Check versions.
-	for _, file := range sanitizedFiles {
-		if file.Sheet == "" {
-			// statement must be present due to validation in validateAndSanitizeReleaseFiles
-			sheet := &store.SheetMessage{
-				Statement: string(file.Statement),
-			}
-			sheetsToCreate = append(sheetsToCreate, sheet)
-			filesWithoutSheet = append(filesWithoutSheet, file)
-		}
-	}
-
-	// Batch create sheets if needed.
-	if len(sheetsToCreate) > 0 {
-		createdSheets, err := s.store.CreateSheets(ctx, sheetsToCreate...)
-		if err != nil {
-			return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to create sheets"))
-		}
-		if len(createdSheets) != len(sheetsToCreate) {
-			return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to create all sheets, expected %d but got %d", len(sheetsToCreate), len(createdSheets)))
-		}
-
-		// Map created sheets back to files.
-		for i, sheet := range createdSheets {
-			filesWithoutSheet[i].Sheet = common.FormatSheet(project.ResourceID, sheet.Sha256)
-		}
-	}
-
-	// Set defaults for release ID template and timezone if not provided.
-	releaseIDTemplate := req.Msg.ReleaseIdTemplate
-	if releaseIDTemplate == "" {
-		releaseIDTemplate = "release_{date}-RC{iteration}"
-	}
-	releaseIDTimezone := req.Msg.ReleaseIdTimezone
-	if releaseIDTimezone == "" {
-		releaseIDTimezone = "UTC"
-	}
-
-	// Compute train from template and timezone.
-	train, err := renderTrain(releaseIDTemplate, releaseIDTimezone, time.Now())
-	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Wrapf(err, "failed to render train"))
-	}
-
-	releaseMessage := &store.ReleaseMessage{
-		ProjectID: project.ResourceID,
-		Train:     train,
-		Category:  req.Msg.Release.Category,
-		Payload: &storepb.ReleasePayload{
-			VcsSource: convertReleaseVcsSource(req.Msg.Release.VcsSource),
-			Type:      storepb.SchemaChangeType(req.Msg.Release.Type),
-		},
-	}
-
-	var sheetSha256s []string
-	for _, f := range sanitizedFiles {
-		_, sheetSha256, err := common.GetProjectResourceIDSheetSha256(f.Sheet)
-		if err != nil {
-			return nil, errors.Wrapf(err, "failed to get sheetSha256 from %q", f.Sheet)
-		}
-		sheetSha256s = append(sheetSha256s, sheetSha256)
-	}
-	exist, err := s.store.HasSheets(ctx, sheetSha256s...)
-	if err != nil {
-		return nil, errors.Wrapf(err, "failed to check sheets")
-	}
-	if !exist {
-		return nil, errors.Errorf("some sheets are not found")
-	}
-
-	for i, f := range sanitizedFiles {
-		releaseMessage.Payload.Files = append(releaseMessage.Payload.Files, &storepb.ReleasePayload_File{
-			Path:        f.Path,
-			SheetSha256: sheetSha256s[i],
-			Version:     f.Version,
-		})
-	}
-
-	release, err := s.store.CreateRelease(ctx, releaseMessage, user.Email)
-	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to create release"))
+		return nil, err
 	}
-
-	converted := convertToRelease(release)
-	return connect.NewResponse(converted), nil
+	return connect.NewResponse(convertToRelease(release)), nil
 }
 
 func (s *ReleaseService) GetRelease(ctx context.Context, req *connect.Request[v1pb.GetReleaseRequest]) (*connect.Response[v1pb.Release], error) {
@@ -305,6 +225,99 @@ func (s *ReleaseService) UndeleteRelease(ctx context.Context, req *connect.Reque
 	return connect.NewResponse(releaseConverted), nil
 }
 
+// createRelease creates the release in the project, creating the sheets for the files with statements.
+func (s *ReleaseService) createRelease(ctx context.Context, user *store.UserMessage, project *store.ProjectMessage, release *v1pb.Release, importSource *storepb.ReleasePayload_ImportSource, releaseIDTemplate, releaseIDTimezone string) (*store.ReleaseMessage, error) {
+	sanitizedFiles, err := validateAndSanitizeReleaseFiles(ctx, s.store, release.Files, release.Type)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Wrapf(err, "invalid release files"))
+	}
+	sheetsToCreate := []*store.SheetMessage{}
+	var filesWithoutSheet []*v1pb.Release_File
+	// Prepare sheets to create for files with missing sheets.
+	// Check versions.
+	for _, file := range sanitizedFiles {
+		if file.Sheet == "" {
+			// statement must be present due to validation in validateAndSanitizeReleaseFiles
+			sheet := &store.SheetMessage{
+				Statement: string(file.Statement),
+			}
+			sheetsToCreate = append(sheetsToCreate, sheet)
+			filesWithoutSheet = append(filesWithoutSheet, file)
+		}
+	}
+
+	// Batch create sheets if needed.
+	if len(sheetsToCreate) > 0 {
+		createdSheets, err := s.store.CreateSheets(ctx, sheetsToCreate...)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to create sheets"))
+		}
+		if len(createdSheets) != len(sheetsToCreate) {
+			return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to create all sheets, expected %d but got %d", len(sheetsToCreate), len(createdSheets)))
+		}
+
+		// Map created sheets back to files.
+		for i, sheet := range createdSheets {
+			filesWithoutSheet[i].Sheet = common.FormatSheet(project.ResourceID, sheet.Sha256)
+		}
+	}
+
+	// Set defaults for release ID template and timezone if not provided.
+	if releaseIDTemplate == "" {
+		releaseIDTemplate = "release_{date}-RC{iteration}"
+	}
+	if releaseIDTimezone == "" {
+		releaseIDTimezone = "UTC"
+	}
+
+	// Compute train from template and timezone.
+	train, err := renderTrain(releaseIDTemplate, releaseIDTimezone, time.Now())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Wrapf(err, "failed to render train"))
+	}
+
+	releaseMessage := &store.ReleaseMessage{
+		ProjectID: project.ResourceID,
+		Train:     train,
+		Category:  release.Category,
+		Payload: &storepb.ReleasePayload{
+			VcsSource:    convertReleaseVcsSource(release.VcsSource),
+			Type:         storepb.SchemaChangeType(release.Type),
+			ImportSource: importSource,
+		},
+	}
+
+	var sheetSha256s []string
+	for _, f := range sanitizedFiles {
+		_, sheetSha256, err := common.GetProjectResourceIDSheetSha256(f.Sheet)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get sheetSha256 from %q", f.Sheet)
+		}
+		sheetSha256s = append(sheetSha256s, sheetSha256)
+	}
+	exist, err := s.store.HasSheets(ctx, sheetSha256s...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to check sheets")
+	}
+	if !exist {
+		return nil, errors.Errorf("some sheets are not found")
+	}
+
+	for i, f := range sanitizedFiles {
+		releaseMessage.Payload.Files = append(releaseMessage.Payload.Files, &storepb.ReleasePayload_File{
+			Path:        f.Path,
+			SheetSha256: sheetSha256s[i],
+			Version:     f.Version,
+		})
+	}
+
+	created, err := s.store.CreateRelease(ctx, releaseMessage, user.Email)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to create release"))
+	}
+	return created, nil
+}
+
 func convertToReleases(releases []*store.ReleaseMessage) []*v1pb.Release {
 	var rs []*v1pb.Release
 	for _, release := range releases {
@@ -323,6 +336,13 @@ func convertToRelease(release *store.ReleaseMessage) *v1pb.Release {
 		State:      convertDeletedToState(release.Deleted),
 		Type:       v1pb.Release_Type(release.Payload.Type),
 	}
+	if source := release.Payload.ImportSource; source != nil {
+		r.ImportSource = &v1pb.Release_ImportSource{
+			Release:      source.Release,
+			ExportTime:   source.ExportTime,
+			CheckResults: convertToCheckReleaseResponse(source.CheckResults),
+		}
+	}
 
 	for _, f := range release.Payload.Files {
 		// Sheets are now project-agnostic, no need to check projectID
@@ -341,8 +361,11 @@ func convertToReleaseVcsSource(vs *storepb.ReleasePayload_VCSSource) *v1pb.Relea
 		return nil
 	}
 	return &v1pb.Release_VCSSource{
-		VcsType: v1pb.VCSType(vs.VcsType),
-		Url:     vs.Url,
+		VcsType:        v1pb.VCSType(vs.VcsType),
+		Url:            vs.Url,
+		Commit:         vs.Commit,
+		PullRequestUrl: vs.PullRequestUrl,
+		Repository:     vs.Repository,
 	}
 }
 
@@ -351,8 +374,11 @@ func convertReleaseVcsSource(vs *v1pb.Release_VCSSource) *storepb.ReleasePayload
 		return nil
 	}
 	return &storepb.ReleasePayload_VCSSource{
-		VcsType: storepb.VCSType(vs.VcsType),
-		Url:     vs.Url,
+		VcsType:        storepb.VCSType(vs.VcsType),
+		Url:            vs.Url,
+		Commit:         vs.Commit,
+		PullRequestUrl: vs.PullRequestUrl,
+		Repository:     vs.Repository,
 	}
 }
 
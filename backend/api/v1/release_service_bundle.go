@@ -0,0 +1,311 @@
+package v1
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/hkdf"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"slices"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/permission"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+// ExportRelease exports the release with the file contents as a bundle signed by the signing key of the workspace.
+// The release is checked on the targets by the server, so that the check results in the bundle can be trusted by the importer.
+func (s *ReleaseService) ExportRelease(ctx context.Context, req *connect.Request[v1pb.ExportReleaseRequest]) (*connect.Response[v1pb.ReleaseBundle], error) {
+	projectID, releaseID, err := common.GetProjectReleaseID(req.Msg.Name)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Wrapf(err, "failed to get release id"))
+	}
+	release, err := s.store.GetRelease(ctx, &store.FindReleaseMessage{
+		ProjectID: &projectID,
+		ReleaseID: &releaseID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get release"))
+	}
+	if release == nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.Errorf("release %s not found in project %s", releaseID, projectID))
+	}
+
+	content := &v1pb.ReleaseBundle_Content{
+		Release:    common.FormatReleaseName(release.ProjectID, release.ReleaseID),
+		Category:   release.Category,
+		Type:       v1pb.Release_Type(release.Payload.Type),
+		VcsSource:  convertToReleaseVcsSource(release.Payload.VcsSource),
+		ExportTime: timestamppb.Now(),
+	}
+	releaseToCheck := &v1pb.Release{Type: content.Type}
+	for _, f := range release.Payload.Files {
+		sheet, err := s.store.GetSheetFull(ctx, f.SheetSha256)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get sheet of file %q", f.Path))
+		}
+		if sheet == nil {
+			return nil, connect.NewError(connect.CodeInternal, errors.Errorf("sheet of file %q not found", f.Path))
+		}
+		content.Files = append(content.Files, &v1pb.ReleaseBundle_File{
+			Path:      f.Path,
+			Version:   f.Version,
+			Statement: []byte(sheet.Statement),
+			Sha256:    f.SheetSha256,
+		})
+		releaseToCheck.Files = append(releaseToCheck.Files, &v1pb.Release_File{
+			Path:      f.Path,
+			Version:   f.Version,
+			Statement: []byte(sheet.Statement),
+		})
+	}
+
+	if len(req.Msg.Targets) > 0 {
+		user, ok := GetUserFromContext(ctx)
+		if !ok || user == nil {
+			return nil, connect.NewError(connect.CodeUnauthenticated, errors.Errorf("user not found"))
+		}
+		ok, err := s.iamManager.CheckPermission(ctx, permission.ReleasesCheck, user, common.GetWorkspaceIDFromContext(ctx), projectID)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to check permission"))
+		}
+		if !ok {
+			return nil, connect.NewError(connect.CodePermissionDenied, errors.Errorf("user %q does not have permission %q in project %q", user.Email, permission.ReleasesCheck, projectID))
+		}
+		checkResults, err := s.CheckRelease(ctx, connect.NewRequest(&v1pb.CheckReleaseRequest{
+			Parent:  common.FormatProject(projectID),
+			Release: releaseToCheck,
+			Targets: req.Msg.Targets,
+		}))
+		if err != nil {
+			return nil, err
+		}
+		content.CheckResults = checkResults.Msg
+	}
+
+	signingKey, err := deriveReleaseSigningKey(s.secret, common.GetWorkspaceIDFromContext(ctx))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	bundle, err := signReleaseBundle(content, signingKey)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to sign release bundle"))
+	}
+	return connect.NewResponse(bundle), nil
+}
+
+// ImportRelease verifies the release bundle against the trusted public keys of the workspace
+// and creates a new release with its files in the project.
+func (s *ReleaseService) ImportRelease(ctx context.Context, req *connect.Request[v1pb.ImportReleaseRequest]) (*connect.Response[v1pb.Release], error) {
+	if req.Msg.Bundle == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("bundle cannot be nil"))
+	}
+	profile, err := s.store.GetWorkspaceProfileSetting(ctx, common.GetWorkspaceIDFromContext(ctx))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get workspace profile setting"))
+	}
+	if len(profile.TrustedReleasePublicKeys) == 0 {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.Errorf("no trusted release public keys are configured in the workspace profile"))
+	}
+	content, err := verifyReleaseBundle(req.Msg.Bundle, profile.TrustedReleasePublicKeys)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Wrapf(err, "invalid release bundle"))
+	}
+
+	user, ok := GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeInternal, errors.Errorf("user not found"))
+	}
+	projectID, err := common.GetProjectID(req.Msg.Parent)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Wrapf(err, "failed to get project id"))
+	}
+	project, err := s.store.GetProject(ctx, &store.FindProjectMessage{Workspace: common.GetWorkspaceIDFromContext(ctx), ResourceID: &projectID})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to find project"))
+	}
+	if project == nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.Errorf("project %s not found", projectID))
+	}
+
+	releaseToCreate := &v1pb.Release{
+		Category:  content.Category,
+		Type:      content.Type,
+		VcsSource: content.VcsSource,
+	}
+	for _, f := range content.Files {
+		releaseToCreate.Files = append(releaseToCreate.Files, &v1pb.Release_File{
+			Path:      f.Path,
+			Version:   f.Version,
+			Statement: f.Statement,
+		})
+	}
+	// Keep the source and the check results of the bundle, which are verified by the signature.
+	importSource := &storepb.ReleasePayload_ImportSource{
+		Release:      content.Release,
+		ExportTime:   content.ExportTime,
+		CheckResults: convertCheckReleaseResponse(content.CheckResults),
+	}
+	release, err := s.createRelease(ctx, user, project, releaseToCreate, importSource, req.Msg.ReleaseIdTemplate, req.Msg.ReleaseIdTimezone)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(convertToRelease(release)), nil
+}
+
+// deriveReleaseSigningKey derives the Ed25519 signing key of the workspace from the server secret,
+// so that the importing servers only need to hold the public key.
+func deriveReleaseSigningKey(secret, workspace string) (ed25519.PrivateKey, error) {
+	seed, err := hkdf.Key(sha256.New, []byte(secret), nil, "bytebase-release-bundle/"+workspace, ed25519.SeedSize)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to derive release signing key")
+	}
+	return ed25519.NewKeyFromSeed(seed), nil
+}
+
+// validateReleasePublicKeys validates the base64-encoded Ed25519 public keys.
+func validateReleasePublicKeys(publicKeys []string) error {
+	for _, publicKey := range publicKeys {
+		key, err := base64.StdEncoding.DecodeString(publicKey)
+		if err != nil {
+			return errors.Wrapf(err, "invalid release public key %q", publicKey)
+		}
+		if len(key) != ed25519.PublicKeySize {
+			return errors.Errorf("invalid release public key %q, expect %d bytes but got %d", publicKey, ed25519.PublicKeySize, len(key))
+		}
+	}
+	return nil
+}
+
+// signReleaseBundle serializes the content and signs it with Ed25519.
+func signReleaseBundle(content *v1pb.ReleaseBundle_Content, signingKey ed25519.PrivateKey) (*v1pb.ReleaseBundle, error) {
+	contentBytes, err := proto.Marshal(content)
+	if err != nil {
+		return nil, err
+	}
+	publicKey, ok := signingKey.Public().(ed25519.PublicKey)
+	if !ok {
+		return nil, errors.Errorf("invalid release signing key")
+	}
+	return &v1pb.ReleaseBundle{
+		Content:   contentBytes,
+		Signature: base64.StdEncoding.EncodeToString(ed25519.Sign(signingKey, contentBytes)),
+		PublicKey: base64.StdEncoding.EncodeToString(publicKey),
+	}, nil
+}
+
+// verifyReleaseBundle verifies the signature of the bundle with the trusted public keys and the hashes of the files, and returns the content.
+func verifyReleaseBundle(bundle *v1pb.ReleaseBundle, trustedPublicKeys []string) (*v1pb.ReleaseBundle_Content, error) {
+	if !slices.Contains(trustedPublicKeys, bundle.PublicKey) {
+		return nil, errors.Errorf("the bundle is signed by the untrusted public key %q", bundle.PublicKey)
+	}
+	publicKey, err := base64.StdEncoding.DecodeString(bundle.PublicKey)
+	if err != nil || len(publicKey) != ed25519.PublicKeySize {
+		return nil, errors.Errorf("invalid public key %q", bundle.PublicKey)
+	}
+	signature, err := base64.StdEncoding.DecodeString(bundle.Signature)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid signature")
+	}
+	if !ed25519.Verify(publicKey, bundle.Content, signature) {
+		return nil, errors.Errorf("signature mismatch, the bundle is modified after export")
+	}
+	content := &v1pb.ReleaseBundle_Content{}
+	if err := proto.Unmarshal(bundle.Content, content); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal bundle content")
+	}
+	if len(content.Files) == 0 {
+		return nil, errors.Errorf("bundle has no files")
+	}
+	for _, f := range content.Files {
+		h := sha256.Sum256(f.Statement)
+		if hex.EncodeToString(h[:]) != f.Sha256 {
+			return nil, errors.Errorf("sha256 mismatch for file %q", f.Path)
+		}
+	}
+	return content, nil
+}
+
+// convertCheckReleaseResponse converts the check results in a release bundle to be stored in the imported release.
+func convertCheckReleaseResponse(response *v1pb.CheckReleaseResponse) *storepb.ReleasePayload_CheckResults {
+	if response == nil {
+		return nil
+	}
+	// The unspecified risk level is kept as is.
+	riskLevel, _ := convertToAPIRiskLevel(response.RiskLevel)
+	results := &storepb.ReleasePayload_CheckResults{
+		AffectedRows: response.AffectedRows,
+		RiskLevel:    riskLevel,
+	}
+	for _, r := range response.Results {
+		resultRiskLevel, _ := convertToAPIRiskLevel(r.RiskLevel)
+		result := &storepb.ReleasePayload_CheckResults_Result{
+			File:         r.File,
+			Target:       r.Target,
+			AffectedRows: r.AffectedRows,
+			RiskLevel:    resultRiskLevel,
+		}
+		for _, advice := range r.Advices {
+			result.Advices = append(result.Advices, convertToStoreAdvice(advice))
+		}
+		results.Results = append(results.Results, result)
+	}
+	return results
+}
+
+func convertToCheckReleaseResponse(results *storepb.ReleasePayload_CheckResults) *v1pb.CheckReleaseResponse {
+	if results == nil {
+		return nil
+	}
+	response := &v1pb.CheckReleaseResponse{
+		AffectedRows: results.AffectedRows,
+		RiskLevel:    convertToIssueRiskLevel(results.RiskLevel),
+	}
+	for _, r := range results.Results {
+		result := &v1pb.CheckReleaseResponse_CheckResult{
+			File:         r.File,
+			Target:       r.Target,
+			AffectedRows: r.AffectedRows,
+			RiskLevel:    convertToIssueRiskLevel(r.RiskLevel),
+		}
+		for _, advice := range r.Advices {
+			result.Advices = append(result.Advices, convertToV1Advice(advice))
+		}
+		response.Results = append(response.Results, result)
+	}
+	return response
+}
+
+func convertToStoreAdvice(advice *v1pb.Advice) *storepb.Advice {
+	status := storepb.Advice_STATUS_UNSPECIFIED
+	switch advice.Status {
+	case v1pb.Advice_SUCCESS:
+		status = storepb.Advice_SUCCESS
+	case v1pb.Advice_WARNING:
+		status = storepb.Advice_WARNING
+	case v1pb.Advice_ERROR:
+		status = storepb.Advice_ERROR
+	default:
+	}
+	result := &storepb.Advice{
+		Status:  status,
+		Code:    advice.Code,
+		Title:   advice.Title,
+		Content: advice.Content,
+	}
+	if p := advice.StartPosition; p != nil {
+		result.StartPosition = &storepb.Position{Line: p.Line, Column: p.Column}
+	}
+	if p := advice.EndPosition; p != nil {
+		result.EndPosition = &storepb.Position{Line: p.Line, Column: p.Column}
+	}
+	return result
+}
@@ -0,0 +1,96 @@
+package v1
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+)
+
+func TestReleaseBundleSignature(t *testing.T) {
+	statement := []byte("CREATE TABLE t (id INT);")
+	h := sha256.Sum256(statement)
+	content := &v1pb.ReleaseBundle_Content{
+		Release:  "projects/hr/releases/release_20260101-RC00",
+		Category: "webapp",
+		Type:     v1pb.Release_VERSIONED,
+		VcsSource: &v1pb.Release_VCSSource{
+			VcsType: v1pb.VCSType_GITHUB,
+			Commit:  "abc123",
+		},
+		Files: []*v1pb.ReleaseBundle_File{
+			{Path: "1.0_init.sql", Version: "1.0", Statement: statement, Sha256: hex.EncodeToString(h[:])},
+		},
+		CheckResults: &v1pb.CheckReleaseResponse{RiskLevel: v1pb.RiskLevel_LOW},
+	}
+
+	signingKey, err := deriveReleaseSigningKey("secret", "workspace")
+	require.NoError(t, err)
+	bundle, err := signReleaseBundle(content, signingKey)
+	require.NoError(t, err)
+	require.NoError(t, validateReleasePublicKeys([]string{bundle.PublicKey}))
+	trusted := []string{bundle.PublicKey}
+	got, err := verifyReleaseBundle(bundle, trusted)
+	require.NoError(t, err)
+	require.Empty(t, cmp.Diff(content, got, protocmp.Transform()))
+
+	// The key of another workspace is not trusted.
+	anotherKey, err := deriveReleaseSigningKey("secret", "another-workspace")
+	require.NoError(t, err)
+	anotherBundle, err := signReleaseBundle(content, anotherKey)
+	require.NoError(t, err)
+	require.NotEqual(t, bundle.PublicKey, anotherBundle.PublicKey)
+	_, err = verifyReleaseBundle(anotherBundle, trusted)
+	require.ErrorContains(t, err, "untrusted public key")
+
+	// The bundle is re-signed by another key but claims the trusted public key.
+	_, err = verifyReleaseBundle(&v1pb.ReleaseBundle{Content: bundle.Content, Signature: anotherBundle.Signature, PublicKey: bundle.PublicKey}, trusted)
+	require.ErrorContains(t, err, "signature mismatch")
+
+	// The content is modified after signing.
+	tampered := proto.Clone(content).(*v1pb.ReleaseBundle_Content)
+	tampered.Files[0].Statement = []byte("DROP TABLE t;")
+	tamperedBytes, err := proto.Marshal(tampered)
+	require.NoError(t, err)
+	_, err = verifyReleaseBundle(&v1pb.ReleaseBundle{Content: tamperedBytes, Signature: bundle.Signature, PublicKey: bundle.PublicKey}, trusted)
+	require.ErrorContains(t, err, "signature mismatch")
+
+	// The file hash doesn't match the statement even if the bundle is re-signed.
+	tamperedBundle, err := signReleaseBundle(tampered, signingKey)
+	require.NoError(t, err)
+	_, err = verifyReleaseBundle(tamperedBundle, trusted)
+	require.ErrorContains(t, err, `sha256 mismatch for file "1.0_init.sql"`)
+}
+
+func TestValidateReleasePublicKeys(t *testing.T) {
+	require.ErrorContains(t, validateReleasePublicKeys([]string{"not base64"}), "invalid release public key")
+	require.ErrorContains(t, validateReleasePublicKeys([]string{"c2hvcnQ="}), "expect 32 bytes but got 5")
+}
+
+func TestConvertCheckReleaseResponse(t *testing.T) {
+	response := &v1pb.CheckReleaseResponse{
+		AffectedRows: 10,
+		RiskLevel:    v1pb.RiskLevel_HIGH,
+		Results: []*v1pb.CheckReleaseResponse_CheckResult{
+			{
+				File:         "1.0_init.sql",
+				Target:       "instances/staging/databases/hr",
+				AffectedRows: 10,
+				RiskLevel:    v1pb.RiskLevel_HIGH,
+				Advices: []*v1pb.Advice{
+					{Status: v1pb.Advice_WARNING, Code: 401, Title: "column.no-null", Content: "Column is nullable", StartPosition: &v1pb.Position{Line: 1, Column: 2}},
+				},
+			},
+			{File: "1.1_index.sql", Target: "instances/staging/databases/hr"},
+		},
+	}
+	got := convertToCheckReleaseResponse(convertCheckReleaseResponse(response))
+	require.Empty(t, cmp.Diff(response, got, protocmp.Transform()))
+	require.Nil(t, convertCheckReleaseResponse(nil))
+}
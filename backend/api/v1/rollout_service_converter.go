@@ -233,6 +233,8 @@ func convertToTask(project *store.ProjectMessage, task *store.TaskMessage) (*v1p
 		return convertToTaskFromSchemaUpdate(project, task)
 	case storepb.Task_DATABASE_EXPORT:
 		return convertToTaskFromDatabaseDataExport(project, task)
+	case storepb.Task_DATABASE_CLONE:
+		return convertToTaskFromDatabaseClone(project, task)
 	case storepb.Task_TASK_TYPE_UNSPECIFIED:
 		return nil, errors.Errorf("task type %v is not supported", task.Type)
 	default:
@@ -335,6 +337,29 @@ func convertToTaskFromDatabaseDataExport(project *store.ProjectMessage, task *st
 	return v1pbTask, nil
 }
 
+func convertToTaskFromDatabaseClone(project *store.ProjectMessage, task *store.TaskMessage) (*v1pb.Task, error) {
+	if task.DatabaseName == nil {
+		return nil, errors.Errorf("database clone task database is nil")
+	}
+
+	stageID := common.FormatStageID(task.Environment)
+	v1pbTask := &v1pb.Task{
+		Name:          common.FormatTask(project.ResourceID, task.PlanID, stageID, task.ID),
+		SpecId:        task.Payload.GetSpecId(),
+		Type:          convertToTaskType(task),
+		Status:        convertToTaskStatus(task.LatestTaskRunStatus, task.Payload.GetSkipped()),
+		SkippedReason: task.Payload.GetSkippedReason(),
+		Target:        common.FormatDatabase(task.InstanceID, *task.DatabaseName),
+	}
+	if task.UpdatedAt != nil {
+		v1pbTask.UpdateTime = timestamppb.New(*task.UpdatedAt)
+	}
+	if task.RunAt != nil {
+		v1pbTask.RunTime = timestamppb.New(*task.RunAt)
+	}
+	return v1pbTask, nil
+}
+
 func convertToTaskStatus(latestTaskRunStatus storepb.TaskRun_Status, skipped bool) v1pb.Task_Status {
 	if skipped {
 		return v1pb.Task_SKIPPED
@@ -366,6 +391,8 @@ func convertToTaskType(task *store.TaskMessage) v1pb.Task_Type {
 		return v1pb.Task_DATABASE_MIGRATE
 	case storepb.Task_DATABASE_EXPORT:
 		return v1pb.Task_DATABASE_EXPORT
+	case storepb.Task_DATABASE_CLONE:
+		return v1pb.Task_DATABASE_CLONE
 	case storepb.Task_TASK_TYPE_UNSPECIFIED:
 		return v1pb.Task_TYPE_UNSPECIFIED
 	default:
@@ -529,6 +556,30 @@ func convertToTaskRunLogEntries(logs []*store.TaskRunLog) []*v1pb.TaskRunLogEntr
 					FilePath: l.Payload.ReleaseFileExecute.FilePath,
 				},
 			})
+
+		case storepb.TaskRunLog_TABLE_COPY:
+			c := l.Payload.TableCopy
+			// Progress logs of the same table collapse into a single entry.
+			if len(entries) > 0 {
+				prev := entries[len(entries)-1]
+				if prev != nil && prev.Type == v1pb.TaskRunLogEntry_TABLE_COPY && prev.TableCopy.Schema == c.Schema && prev.TableCopy.Table == c.Table {
+					prev.LogTime = timestamppb.New(l.T)
+					prev.TableCopy.CopiedRows = c.CopiedRows
+					prev.TableCopy.Error = c.Error
+					continue
+				}
+			}
+			entries = append(entries, &v1pb.TaskRunLogEntry{
+				Type:      v1pb.TaskRunLogEntry_TABLE_COPY,
+				LogTime:   timestamppb.New(l.T),
+				ReplicaId: l.Payload.ReplicaId,
+				TableCopy: &v1pb.TaskRunLogEntry_TableCopy{
+					Schema:     c.Schema,
+					Table:      c.Table,
+					CopiedRows: c.CopiedRows,
+					Error:      c.Error,
+				},
+			})
 		default:
 		}
 	}
@@ -69,6 +69,8 @@ func getTaskCreatesFromSpec(ctx context.Context, s *store.Store, spec *storepb.P
 		return getTaskCreatesFromChangeDatabaseConfig(ctx, s, spec, config.ChangeDatabaseConfig)
 	case *storepb.PlanConfig_Spec_ExportDataConfig:
 		return getTaskCreatesFromExportDataConfig(ctx, s, spec, config.ExportDataConfig)
+	case *storepb.PlanConfig_Spec_CloneDatabaseConfig:
+		return getTaskCreatesFromCloneDatabaseConfig(ctx, s, spec, config.CloneDatabaseConfig)
 	}
 
 	return nil, errors.Errorf("invalid spec config type %T", spec.Config)
@@ -332,6 +334,50 @@ func getTaskCreatesFromExportDataConfig(
 	return tasks, nil
 }
 
+func getTaskCreatesFromCloneDatabaseConfig(
+	ctx context.Context,
+	s *store.Store,
+	spec *storepb.PlanConfig_Spec,
+	c *storepb.PlanConfig_CloneDatabaseConfig,
+) ([]*store.TaskMessage, error) {
+	databases, err := getDatabaseMessagesByTargets(ctx, s, []string{c.Source, c.Target})
+	if err != nil {
+		return nil, err
+	}
+	source, target := databases[0], databases[1]
+	sourceInstance, err := getInstanceMessage(ctx, s, common.FormatInstance(source.InstanceID))
+	if err != nil {
+		return nil, err
+	}
+	targetInstance, err := getInstanceMessage(ctx, s, common.FormatInstance(target.InstanceID))
+	if err != nil {
+		return nil, err
+	}
+	engine := sourceInstance.Metadata.GetEngine()
+	if engine != targetInstance.Metadata.GetEngine() {
+		return nil, errors.Errorf("source engine %s does not match target engine %s", engine, targetInstance.Metadata.GetEngine())
+	}
+	if engine != storepb.Engine_POSTGRES && engine != storepb.Engine_MYSQL {
+		return nil, errors.Errorf("cloning %s database is not supported", engine)
+	}
+
+	env := ""
+	if target.EffectiveEnvironmentID != nil {
+		env = *target.EffectiveEnvironmentID
+	}
+	return []*store.TaskMessage{
+		{
+			InstanceID:   target.InstanceID,
+			DatabaseName: &target.DatabaseName,
+			Environment:  env,
+			Type:         storepb.Task_DATABASE_CLONE,
+			Payload: &storepb.Task{
+				SpecId: spec.Id,
+			},
+		},
+	}, nil
+}
+
 // checkCharacterSetCollationOwner checks if the character set, collation and owner are legal according to the dbType.
 func checkCharacterSetCollationOwner(dbType storepb.Engine, characterSet, collation, owner string) error {
 	switch dbType {
@@ -30,6 +30,7 @@ import (
 	"github.com/bytebase/bytebase/backend/plugin/webhook/slack"
 	"github.com/bytebase/bytebase/backend/plugin/webhook/teams"
 	"github.com/bytebase/bytebase/backend/plugin/webhook/wecom"
+	"github.com/bytebase/bytebase/backend/runner/discovery"
 	"github.com/bytebase/bytebase/backend/store"
 )
 
@@ -360,6 +361,11 @@ func (s *SettingService) UpdateSetting(ctx context.Context, request *connect.Req
 				oldSetting.SqlResultSize = payload.SqlResultSize
 			case "value.workspace_profile.query_timeout":
 				oldSetting.QueryTimeout = payload.QueryTimeout
+			case "value.workspace_profile.trusted_release_public_keys":
+				if err := validateReleasePublicKeys(payload.TrustedReleasePublicKeys); err != nil {
+					return nil, connect.NewError(connect.CodeInvalidArgument, err)
+				}
+				oldSetting.TrustedReleasePublicKeys = payload.TrustedReleasePublicKeys
 			default:
 				return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("invalid update mask path %v", path))
 			}
@@ -374,38 +380,9 @@ func (s *SettingService) UpdateSetting(ctx context.Context, request *connect.Req
 			return nil, connect.NewError(connect.CodePermissionDenied, err)
 		}
 
-		payload := &storepb.WorkspaceApprovalSetting{}
-		for _, rule := range request.Msg.Setting.Value.GetWorkspaceApproval().Rules {
-			// Validate the condition.
-			if _, err := common.ConvertUnparsedApproval(rule.Condition); err != nil {
-				return nil, err
-			}
-
-			// For SOURCE_UNSPECIFIED (fallback) rules, validate that only project_id is used
-			if rule.Source == v1pb.WorkspaceApprovalSetting_Rule_SOURCE_UNSPECIFIED {
-				conditionExpr := ""
-				if rule.Condition != nil {
-					conditionExpr = rule.Condition.Expression
-				}
-				if err := common.ValidateFallbackApprovalExpr(conditionExpr); err != nil {
-					return nil, err
-				}
-			}
-
-			if err := validateApprovalTemplate(rule.Template); err != nil {
-				return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("invalid approval template: %v, err: %v", rule.Template, err))
-			}
-
-			flow := convertApprovalFlow(rule.Template.Flow)
-			payload.Rules = append(payload.Rules, &storepb.WorkspaceApprovalSetting_Rule{
-				Condition: rule.Condition,
-				Source:    storepb.WorkspaceApprovalSetting_Rule_Source(rule.Source),
-				Template: &storepb.ApprovalTemplate{
-					Flow:        flow,
-					Title:       rule.Template.Title,
-					Description: rule.Template.Description,
-				},
-			})
+		payload, err := convertWorkspaceApprovalSetting(request.Msg.Setting.Value.GetWorkspaceApproval())
+		if err != nil {
+			return nil, err
 		}
 		storeSettingValue = payload
 	case storepb.SettingName_APP_IM:
@@ -523,11 +500,25 @@ func (s *SettingService) UpdateSetting(ctx context.Context, request *connect.Req
 					return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("classification %q references invalid level %d", id, *c.Level))
 				}
 			}
+			if err := s.validateClassificationDetectors(ctx, workspaceID, config); err != nil {
+				return nil, connect.NewError(connect.CodeInvalidArgument, err)
+			}
 		}
 		resetClassification = true
 		storeSettingValue = payload
 	case storepb.SettingName_SEMANTIC_TYPES:
-		storeSemanticTypeSetting := convertSemanticTypeSetting(request.Msg.Setting.Value.GetSemanticType())
+		storeSemanticTypeSetting, err := convertSemanticTypeSetting(request.Msg.Setting.Value.GetSemanticType())
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		oldSemanticTypeSetting, err := s.store.GetSemanticTypesSetting(ctx, workspaceID)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get semantic types setting"))
+		}
+		oldAlgorithms := make(map[string]*storepb.Algorithm)
+		for _, tp := range oldSemanticTypeSetting.GetTypes() {
+			oldAlgorithms[tp.Id] = tp.GetAlgorithm()
+		}
 		idMap := make(map[string]bool)
 		for _, tp := range storeSemanticTypeSetting.Types {
 			if tp.Title == "" {
@@ -542,6 +533,9 @@ func (s *SettingService) UpdateSetting(ctx context.Context, request *connect.Req
 					return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("inner outer mask type has to be specified"))
 				}
 			}
+			if err := validateKeyedMaskingAlgorithm(tp.GetAlgorithm(), oldAlgorithms[tp.Id]); err != nil {
+				return nil, connect.NewError(connect.CodeInvalidArgument, errors.Wrapf(err, "invalid algorithm of semantic type %q", tp.Id))
+			}
 			idMap[tp.Id] = true
 		}
 		storeSettingValue = storeSemanticTypeSetting
@@ -847,6 +841,113 @@ func (*SettingService) isSettingDisallowed(name storepb.SettingName) bool {
 	}
 }
 
+// validateClassificationDetectors validates the sensitive data detectors of the classification config.
+func (s *SettingService) validateClassificationDetectors(ctx context.Context, workspaceID string, config *storepb.DataClassificationSetting_DataClassificationConfig) error {
+	if _, err := discovery.NewDetectors(config); err != nil {
+		return err
+	}
+	if len(config.Detectors) == 0 {
+		return nil
+	}
+	semanticTypes, err := s.store.GetSemanticTypesSetting(ctx, workspaceID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get semantic types setting")
+	}
+	validSemanticTypeIDs := make(map[string]bool)
+	for _, semanticType := range semanticTypes.GetTypes() {
+		validSemanticTypeIDs[semanticType.Id] = true
+	}
+	for _, detector := range config.Detectors {
+		if err := validateSemanticTypeID(detector.SemanticTypeId, validSemanticTypeIDs); err != nil {
+			return errors.Wrapf(err, "invalid detector %q", detector.Id)
+		}
+	}
+	return nil
+}
+
+// validateKeyedMaskingAlgorithm validates the tokenization, date shift and numeric noise algorithms.
+// Masking keys are never returned to clients, so an empty key keeps the key of the same algorithm in the old setting.
+func validateKeyedMaskingAlgorithm(algorithm, oldAlgorithm *storepb.Algorithm) error {
+	switch m := algorithm.GetMask().(type) {
+	case *storepb.Algorithm_TokenizationMask_:
+		if m.TokenizationMask == nil {
+			return errors.New("tokenization mask is required")
+		}
+		if m.TokenizationMask.Key == "" && m.TokenizationMask.ExternalKey == nil {
+			m.TokenizationMask.Key = oldAlgorithm.GetTokenizationMask().GetKey()
+		}
+		if m.TokenizationMask.Key == "" && m.TokenizationMask.ExternalKey == nil {
+			return errors.New("tokenization mask requires a key or an external key")
+		}
+	case *storepb.Algorithm_DateShiftMask_:
+		if m.DateShiftMask == nil {
+			return errors.New("date shift mask is required")
+		}
+		if m.DateShiftMask.Key == "" && m.DateShiftMask.ExternalKey == nil {
+			m.DateShiftMask.Key = oldAlgorithm.GetDateShiftMask().GetKey()
+		}
+		if m.DateShiftMask.Key == "" && m.DateShiftMask.ExternalKey == nil {
+			return errors.New("date shift mask requires a key or an external key")
+		}
+		if m.DateShiftMask.MaxShiftDays <= 0 {
+			return errors.Errorf("max shift days must be positive, got %d", m.DateShiftMask.MaxShiftDays)
+		}
+	case *storepb.Algorithm_NumericNoiseMask_:
+		if m.NumericNoiseMask == nil {
+			return errors.New("numeric noise mask is required")
+		}
+		if m.NumericNoiseMask.Key == "" && m.NumericNoiseMask.ExternalKey == nil {
+			m.NumericNoiseMask.Key = oldAlgorithm.GetNumericNoiseMask().GetKey()
+		}
+		if m.NumericNoiseMask.Key == "" && m.NumericNoiseMask.ExternalKey == nil {
+			return errors.New("numeric noise mask requires a key or an external key")
+		}
+		if m.NumericNoiseMask.NoiseRatio <= 0 || m.NumericNoiseMask.NoiseRatio > 1 {
+			return errors.Errorf("noise ratio must be in (0, 1], got %v", m.NumericNoiseMask.NoiseRatio)
+		}
+	default:
+	}
+	return nil
+}
+
+// convertWorkspaceApprovalSetting validates the approval rules and converts them to the store setting.
+func convertWorkspaceApprovalSetting(setting *v1pb.WorkspaceApprovalSetting) (*storepb.WorkspaceApprovalSetting, error) {
+	payload := &storepb.WorkspaceApprovalSetting{}
+	for _, rule := range setting.GetRules() {
+		// Validate the condition.
+		if _, err := common.ConvertUnparsedApproval(rule.Condition); err != nil {
+			return nil, err
+		}
+
+		// For SOURCE_UNSPECIFIED (fallback) rules, validate that only project_id is used
+		if rule.Source == v1pb.WorkspaceApprovalSetting_Rule_SOURCE_UNSPECIFIED {
+			conditionExpr := ""
+			if rule.Condition != nil {
+				conditionExpr = rule.Condition.Expression
+			}
+			if err := common.ValidateFallbackApprovalExpr(conditionExpr); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := validateApprovalTemplate(rule.Template); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("invalid approval template: %v, err: %v", rule.Template, err))
+		}
+
+		flow := convertApprovalFlow(rule.Template.Flow)
+		payload.Rules = append(payload.Rules, &storepb.WorkspaceApprovalSetting_Rule{
+			Condition: rule.Condition,
+			Source:    storepb.WorkspaceApprovalSetting_Rule_Source(rule.Source),
+			Template: &storepb.ApprovalTemplate{
+				Flow:        flow,
+				Title:       rule.Template.Title,
+				Description: rule.Template.Description,
+			},
+		})
+	}
+	return payload, nil
+}
+
 func validateApprovalTemplate(template *v1pb.ApprovalTemplate) error {
 	if template.Flow == nil {
 		return errors.Errorf("approval template cannot be nil")
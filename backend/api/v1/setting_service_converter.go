@@ -251,26 +251,27 @@ func convertWorkspaceProfileSetting(v1Setting *v1pb.WorkspaceProfileSetting) *st
 	}
 
 	storeSetting := &storepb.WorkspaceProfileSetting{
-		ExternalUrl:            v1Setting.ExternalUrl,
-		DisallowSignup:         v1Setting.DisallowSignup,
-		Require_2Fa:            v1Setting.RequireMfa,
-		RefreshTokenDuration:   v1Setting.RefreshTokenDuration,
-		AccessTokenDuration:    v1Setting.AccessTokenDuration,
-		InactiveSessionTimeout: v1Setting.InactiveSessionTimeout,
-		MaximumRoleExpiration:  v1Setting.MaximumRoleExpiration,
-		Domains:                v1Setting.Domains,
-		EnforceIdentityDomain:  v1Setting.EnforceIdentityDomain,
-		DatabaseChangeMode:     storepb.WorkspaceProfileSetting_DatabaseChangeMode(v1Setting.DatabaseChangeMode),
-		DisallowPasswordSignin: v1Setting.DisallowPasswordSignin,
-		AllowEmailCodeSignin:   v1Setting.AllowEmailCodeSignin,
-		EnableMetricCollection: v1Setting.EnableMetricCollection,
-		EnableAuditLogStdout:   v1Setting.EnableAuditLogStdout,
-		Watermark:              v1Setting.Watermark,
-		DirectorySyncToken:     v1Setting.DirectorySyncToken,
-		PasswordRestriction:    convertToStorePasswordRestriction(v1Setting.PasswordRestriction),
-		EnableDebug:            v1Setting.EnableDebug,
-		SqlResultSize:          v1Setting.SqlResultSize,
-		QueryTimeout:           v1Setting.QueryTimeout,
+		ExternalUrl:              v1Setting.ExternalUrl,
+		DisallowSignup:           v1Setting.DisallowSignup,
+		Require_2Fa:              v1Setting.RequireMfa,
+		RefreshTokenDuration:     v1Setting.RefreshTokenDuration,
+		AccessTokenDuration:      v1Setting.AccessTokenDuration,
+		InactiveSessionTimeout:   v1Setting.InactiveSessionTimeout,
+		MaximumRoleExpiration:    v1Setting.MaximumRoleExpiration,
+		Domains:                  v1Setting.Domains,
+		EnforceIdentityDomain:    v1Setting.EnforceIdentityDomain,
+		DatabaseChangeMode:       storepb.WorkspaceProfileSetting_DatabaseChangeMode(v1Setting.DatabaseChangeMode),
+		DisallowPasswordSignin:   v1Setting.DisallowPasswordSignin,
+		AllowEmailCodeSignin:     v1Setting.AllowEmailCodeSignin,
+		EnableMetricCollection:   v1Setting.EnableMetricCollection,
+		EnableAuditLogStdout:     v1Setting.EnableAuditLogStdout,
+		Watermark:                v1Setting.Watermark,
+		DirectorySyncToken:       v1Setting.DirectorySyncToken,
+		PasswordRestriction:      convertToStorePasswordRestriction(v1Setting.PasswordRestriction),
+		EnableDebug:              v1Setting.EnableDebug,
+		SqlResultSize:            v1Setting.SqlResultSize,
+		QueryTimeout:             v1Setting.QueryTimeout,
+		TrustedReleasePublicKeys: v1Setting.TrustedReleasePublicKeys,
 	}
 
 	// Convert announcement if present
@@ -324,27 +325,28 @@ func convertToWorkspaceProfileSetting(storeSetting *storepb.WorkspaceProfileSett
 	}
 
 	return &v1pb.WorkspaceProfileSetting{
-		ExternalUrl:            storeSetting.ExternalUrl,
-		DisallowSignup:         storeSetting.DisallowSignup,
-		RequireMfa:             storeSetting.Require_2Fa,
-		RefreshTokenDuration:   storeSetting.RefreshTokenDuration,
-		AccessTokenDuration:    storeSetting.AccessTokenDuration,
-		InactiveSessionTimeout: storeSetting.InactiveSessionTimeout,
-		MaximumRoleExpiration:  storeSetting.MaximumRoleExpiration,
-		Domains:                storeSetting.Domains,
-		EnforceIdentityDomain:  storeSetting.EnforceIdentityDomain,
-		DatabaseChangeMode:     v1pb.DatabaseChangeMode(storeSetting.DatabaseChangeMode),
-		DisallowPasswordSignin: storeSetting.DisallowPasswordSignin,
-		AllowEmailCodeSignin:   storeSetting.AllowEmailCodeSignin,
-		EnableMetricCollection: storeSetting.EnableMetricCollection,
-		EnableAuditLogStdout:   storeSetting.EnableAuditLogStdout,
-		Watermark:              storeSetting.Watermark,
-		DirectorySyncToken:     storeSetting.DirectorySyncToken,
-		PasswordRestriction:    convertToPasswordRestrictionSetting(storeSetting.PasswordRestriction),
-		Announcement:           convertToV1Announcement(storeSetting.Announcement),
-		EnableDebug:            storeSetting.EnableDebug,
-		SqlResultSize:          storeSetting.SqlResultSize,
-		QueryTimeout:           storeSetting.QueryTimeout,
+		ExternalUrl:              storeSetting.ExternalUrl,
+		DisallowSignup:           storeSetting.DisallowSignup,
+		RequireMfa:               storeSetting.Require_2Fa,
+		RefreshTokenDuration:     storeSetting.RefreshTokenDuration,
+		AccessTokenDuration:      storeSetting.AccessTokenDuration,
+		InactiveSessionTimeout:   storeSetting.InactiveSessionTimeout,
+		MaximumRoleExpiration:    storeSetting.MaximumRoleExpiration,
+		Domains:                  storeSetting.Domains,
+		EnforceIdentityDomain:    storeSetting.EnforceIdentityDomain,
+		DatabaseChangeMode:       v1pb.DatabaseChangeMode(storeSetting.DatabaseChangeMode),
+		DisallowPasswordSignin:   storeSetting.DisallowPasswordSignin,
+		AllowEmailCodeSignin:     storeSetting.AllowEmailCodeSignin,
+		EnableMetricCollection:   storeSetting.EnableMetricCollection,
+		EnableAuditLogStdout:     storeSetting.EnableAuditLogStdout,
+		Watermark:                storeSetting.Watermark,
+		DirectorySyncToken:       storeSetting.DirectorySyncToken,
+		PasswordRestriction:      convertToPasswordRestrictionSetting(storeSetting.PasswordRestriction),
+		Announcement:             convertToV1Announcement(storeSetting.Announcement),
+		EnableDebug:              storeSetting.EnableDebug,
+		SqlResultSize:            storeSetting.SqlResultSize,
+		QueryTimeout:             storeSetting.QueryTimeout,
+		TrustedReleasePublicKeys: storeSetting.TrustedReleasePublicKeys,
 	}
 }
 
@@ -496,7 +498,27 @@ func convertDataClassificationSettingConfig(c *v1pb.DataClassificationSetting_Da
 		Title:          c.Title,
 		Levels:         convertDataClassificationSettingLevels(c.Levels),
 		Classification: convertDataClassificationSettingClassification(c.Classification),
+		Detectors:      convertDataClassificationSettingDetectors(c.Detectors),
+	}
+}
+
+func convertDataClassificationSettingDetectors(detectors []*v1pb.DataClassificationSetting_DataClassificationConfig_Detector) []*storepb.DataClassificationSetting_DataClassificationConfig_Detector {
+	var storeDetectors []*storepb.DataClassificationSetting_DataClassificationConfig_Detector
+	for _, d := range detectors {
+		storeDetectors = append(storeDetectors, &storepb.DataClassificationSetting_DataClassificationConfig_Detector{
+			Id:                d.Id,
+			Title:             d.Title,
+			ColumnNamePattern: d.ColumnNamePattern,
+			CommentPattern:    d.CommentPattern,
+			ValuePattern:      d.ValuePattern,
+			Checksum:          storepb.DataClassificationSetting_DataClassificationConfig_Detector_Checksum(d.Checksum),
+			Dictionary:        d.Dictionary,
+			MinMatchRatio:     d.MinMatchRatio,
+			ClassificationId:  d.ClassificationId,
+			SemanticTypeId:    d.SemanticTypeId,
+		})
 	}
+	return storeDetectors
 }
 
 func convertDataClassificationSettingLevels(levels []*v1pb.DataClassificationSetting_DataClassificationConfig_Level) []*storepb.DataClassificationSetting_DataClassificationConfig_Level {
@@ -553,7 +575,27 @@ func convertToDataClassificationSettingConfig(c *storepb.DataClassificationSetti
 		Title:          c.Title,
 		Levels:         convertToDataClassificationSettingLevels(c.Levels),
 		Classification: convertToDataClassificationSettingClassification(c.Classification),
+		Detectors:      convertToDataClassificationSettingDetectors(c.Detectors),
+	}
+}
+
+func convertToDataClassificationSettingDetectors(detectors []*storepb.DataClassificationSetting_DataClassificationConfig_Detector) []*v1pb.DataClassificationSetting_DataClassificationConfig_Detector {
+	var v1Detectors []*v1pb.DataClassificationSetting_DataClassificationConfig_Detector
+	for _, d := range detectors {
+		v1Detectors = append(v1Detectors, &v1pb.DataClassificationSetting_DataClassificationConfig_Detector{
+			Id:                d.Id,
+			Title:             d.Title,
+			ColumnNamePattern: d.ColumnNamePattern,
+			CommentPattern:    d.CommentPattern,
+			ValuePattern:      d.ValuePattern,
+			Checksum:          v1pb.DataClassificationSetting_DataClassificationConfig_Detector_Checksum(d.Checksum),
+			Dictionary:        d.Dictionary,
+			MinMatchRatio:     d.MinMatchRatio,
+			ClassificationId:  d.ClassificationId,
+			SemanticTypeId:    d.SemanticTypeId,
+		})
 	}
+	return v1Detectors
 }
 
 func convertToDataClassificationSettingLevels(levels []*storepb.DataClassificationSetting_DataClassificationConfig_Level) []*v1pb.DataClassificationSetting_DataClassificationConfig_Level {
@@ -587,23 +629,27 @@ func convertToDataClassificationSettingClassification(classification map[string]
 	return v1Classification
 }
 
-func convertSemanticTypeSetting(v1Setting *v1pb.SemanticTypeSetting) *storepb.SemanticTypeSetting {
+func convertSemanticTypeSetting(v1Setting *v1pb.SemanticTypeSetting) (*storepb.SemanticTypeSetting, error) {
 	if v1Setting == nil {
-		return nil
+		return nil, nil
 	}
 
 	storeSetting := &storepb.SemanticTypeSetting{}
 	for _, v1Type := range v1Setting.Types {
+		algorithm, err := convertAlgorithm(v1Type.Algorithm)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid algorithm of semantic type %q", v1Type.Id)
+		}
 		storeType := &storepb.SemanticTypeSetting_SemanticType{
 			Id:          v1Type.Id,
 			Title:       v1Type.Title,
 			Description: v1Type.Description,
-			Algorithm:   convertAlgorithm(v1Type.Algorithm),
+			Algorithm:   algorithm,
 			Icon:        v1Type.Icon,
 		}
 		storeSetting.Types = append(storeSetting.Types, storeType)
 	}
-	return storeSetting
+	return storeSetting, nil
 }
 
 func convertToSemanticTypeSetting(storeSetting *storepb.SemanticTypeSetting) *v1pb.SemanticTypeSetting {
@@ -625,9 +671,9 @@ func convertToSemanticTypeSetting(storeSetting *storepb.SemanticTypeSetting) *v1
 	return v1Setting
 }
 
-func convertAlgorithm(v1Algo *v1pb.Algorithm) *storepb.Algorithm {
+func convertAlgorithm(v1Algo *v1pb.Algorithm) (*storepb.Algorithm, error) {
 	if v1Algo == nil {
-		return nil
+		return nil, nil
 	}
 
 	storeAlgo := &storepb.Algorithm{}
@@ -659,9 +705,46 @@ func convertAlgorithm(v1Algo *v1pb.Algorithm) *storepb.Algorithm {
 				Substitution: mask.InnerOuterMask.Substitution,
 			},
 		}
+	case *v1pb.Algorithm_TokenizationMask_:
+		externalKey, err := convertV1DataSourceExternalSecret(mask.TokenizationMask.ExternalKey)
+		if err != nil {
+			return nil, err
+		}
+		storeAlgo.Mask = &storepb.Algorithm_TokenizationMask_{
+			TokenizationMask: &storepb.Algorithm_TokenizationMask{
+				Key:         mask.TokenizationMask.Key,
+				ExternalKey: externalKey,
+				Format:      storepb.Algorithm_TokenizationMask_Format(mask.TokenizationMask.Format),
+			},
+		}
+	case *v1pb.Algorithm_DateShiftMask_:
+		externalKey, err := convertV1DataSourceExternalSecret(mask.DateShiftMask.ExternalKey)
+		if err != nil {
+			return nil, err
+		}
+		storeAlgo.Mask = &storepb.Algorithm_DateShiftMask_{
+			DateShiftMask: &storepb.Algorithm_DateShiftMask{
+				Key:           mask.DateShiftMask.Key,
+				ExternalKey:   externalKey,
+				MaxShiftDays:  mask.DateShiftMask.MaxShiftDays,
+				SubjectColumn: mask.DateShiftMask.SubjectColumn,
+			},
+		}
+	case *v1pb.Algorithm_NumericNoiseMask_:
+		externalKey, err := convertV1DataSourceExternalSecret(mask.NumericNoiseMask.ExternalKey)
+		if err != nil {
+			return nil, err
+		}
+		storeAlgo.Mask = &storepb.Algorithm_NumericNoiseMask_{
+			NumericNoiseMask: &storepb.Algorithm_NumericNoiseMask{
+				Key:         mask.NumericNoiseMask.Key,
+				ExternalKey: externalKey,
+				NoiseRatio:  mask.NumericNoiseMask.NoiseRatio,
+			},
+		}
 	default:
 	}
-	return storeAlgo
+	return storeAlgo, nil
 }
 
 func convertToAlgorithm(storeAlgo *storepb.Algorithm) *v1pb.Algorithm {
@@ -698,6 +781,29 @@ func convertToAlgorithm(storeAlgo *storepb.Algorithm) *v1pb.Algorithm {
 				Substitution: mask.InnerOuterMask.Substitution,
 			},
 		}
+	// Masking keys are input only and never returned.
+	case *storepb.Algorithm_TokenizationMask_:
+		v1Algo.Mask = &v1pb.Algorithm_TokenizationMask_{
+			TokenizationMask: &v1pb.Algorithm_TokenizationMask{
+				ExternalKey: convertDataSourceExternalSecret(mask.TokenizationMask.ExternalKey),
+				Format:      v1pb.Algorithm_TokenizationMask_Format(mask.TokenizationMask.Format),
+			},
+		}
+	case *storepb.Algorithm_DateShiftMask_:
+		v1Algo.Mask = &v1pb.Algorithm_DateShiftMask_{
+			DateShiftMask: &v1pb.Algorithm_DateShiftMask{
+				ExternalKey:   convertDataSourceExternalSecret(mask.DateShiftMask.ExternalKey),
+				MaxShiftDays:  mask.DateShiftMask.MaxShiftDays,
+				SubjectColumn: mask.DateShiftMask.SubjectColumn,
+			},
+		}
+	case *storepb.Algorithm_NumericNoiseMask_:
+		v1Algo.Mask = &v1pb.Algorithm_NumericNoiseMask_{
+			NumericNoiseMask: &v1pb.Algorithm_NumericNoiseMask{
+				ExternalKey: convertDataSourceExternalSecret(mask.NumericNoiseMask.ExternalKey),
+				NoiseRatio:  mask.NumericNoiseMask.NoiseRatio,
+			},
+		}
 	default:
 	}
 	return v1Algo
@@ -3,7 +3,9 @@ package v1
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"database/sql"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"math"
@@ -16,7 +18,9 @@ import (
 	"connectrpc.com/connect"
 	"github.com/alexmullins/zip"
 	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
@@ -24,6 +28,7 @@ import (
 	"github.com/bytebase/bytebase/backend/component/dbfactory"
 	"github.com/bytebase/bytebase/backend/component/export"
 	"github.com/bytebase/bytebase/backend/component/iam"
+	"github.com/bytebase/bytebase/backend/component/operation"
 	"github.com/bytebase/bytebase/backend/enterprise"
 	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
 	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
@@ -41,11 +46,14 @@ import (
 // SQLService is the service for SQL.
 type SQLService struct {
 	v1connect.UnimplementedSQLServiceHandler
-	store          *store.Store
-	schemaSyncer   *schemasync.Syncer
-	dbFactory      *dbfactory.DBFactory
-	licenseService *enterprise.LicenseService
-	iamManager     *iam.Manager
+	store            *store.Store
+	schemaSyncer     *schemasync.Syncer
+	dbFactory        *dbfactory.DBFactory
+	licenseService   *enterprise.LicenseService
+	iamManager       *iam.Manager
+	secret           string
+	resultCache      *queryResultCache
+	operationManager *operation.Manager
 }
 
 // NewSQLService creates a SQLService.
@@ -55,13 +63,18 @@ func NewSQLService(
 	dbFactory *dbfactory.DBFactory,
 	licenseService *enterprise.LicenseService,
 	iamManager *iam.Manager,
+	secret string,
+	operationManager *operation.Manager,
 ) *SQLService {
 	return &SQLService{
-		store:          store,
-		schemaSyncer:   schemaSyncer,
-		dbFactory:      dbFactory,
-		licenseService: licenseService,
-		iamManager:     iamManager,
+		store:            store,
+		schemaSyncer:     schemaSyncer,
+		dbFactory:        dbFactory,
+		licenseService:   licenseService,
+		iamManager:       iamManager,
+		secret:           secret,
+		resultCache:      newQueryResultCache(),
+		operationManager: operationManager,
 	}
 }
 
@@ -98,6 +111,13 @@ func (s *SQLService) AdminExecute(ctx context.Context, stream *connect.BidiStrea
 		if err != nil {
 			return err
 		}
+		accessGrant, err := s.checkAdminExecutePermission(ctx, user, database)
+		if err != nil {
+			return err
+		}
+		if err := s.checkQueryJustification(ctx, database, request.Justification); err != nil {
+			return err
+		}
 
 		// We only need to get the driver and connection once.
 		if driver == nil || connectionName != request.Name {
@@ -144,7 +164,7 @@ func (s *SQLService) AdminExecute(ctx context.Context, stream *connect.BidiStrea
 			queryContext,
 		)
 
-		s.createQueryHistory(database, store.QueryHistoryTypeQuery, request.Statement, user.Email, duration, queryErr)
+		s.createQueryHistory(database, store.QueryHistoryTypeQuery, request.Statement, user.Email, duration, queryErr, accessGrant, request.Justification)
 		response := &v1pb.AdminExecuteResponse{}
 		if queryErr != nil {
 			response.Results = []*v1pb.QueryResult{
@@ -163,7 +183,8 @@ func (s *SQLService) AdminExecute(ctx context.Context, stream *connect.BidiStrea
 }
 
 // preCheckAccess finds and returns the best matching active access grant for the query.
-// It lists access grants filtered by project, creator, status, statement, target database,
+// An active break-glass access grant on the target database matches any statement.
+// Otherwise, it lists access grants filtered by project, creator, status, statement, target database,
 // and expiry, then prefers the grant with unmask=true if available.
 func (s *SQLService) preCheckAccess(ctx context.Context, request *v1pb.QueryRequest, database *store.DatabaseMessage) *store.AccessGrantMessage {
 	project, err := s.store.GetProject(ctx, &store.FindProjectMessage{
@@ -178,15 +199,19 @@ func (s *SQLService) preCheckAccess(ctx context.Context, request *v1pb.QueryRequ
 		slog.Warn("project not found", slog.String("project_id", database.ProjectID))
 		return nil
 	}
-	if !project.Setting.AllowJustInTimeAccess {
-		slog.Debug("JIT is not enabled in the project", slog.String("project_id", database.ProjectID))
-		return nil
-	}
 
 	user, ok := GetUserFromContext(ctx)
 	if !ok || user == nil {
 		return nil
 	}
+	if grant := s.findBreakGlassAccessGrant(ctx, project, user, database, false /* admin */); grant != nil {
+		return grant
+	}
+
+	if !project.Setting.AllowJustInTimeAccess {
+		slog.Debug("JIT is not enabled in the project", slog.String("project_id", database.ProjectID))
+		return nil
+	}
 
 	databaseFullName := common.FormatDatabase(database.InstanceID, database.DatabaseName)
 	now := time.Now().UTC().Format(time.RFC3339)
@@ -227,13 +252,31 @@ func (s *SQLService) preCheckAccess(ctx context.Context, request *v1pb.QueryRequ
 }
 
 func (s *SQLService) Query(ctx context.Context, req *connect.Request[v1pb.QueryRequest]) (*connect.Response[v1pb.QueryResponse], error) {
-	request := req.Msg
+	response, err := s.doQuery(ctx, req.Msg, nil)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(response), nil
+}
+
+// doQuery executes the query request.
+// If recorder is not nil, it records the results before masking.
+func (s *SQLService) doQuery(ctx context.Context, request *v1pb.QueryRequest, recorder *queryResultRecorder) (*v1pb.QueryResponse, error) {
 	// Prepare related message.
 	user, instance, database, err := s.prepareRelatedMessage(ctx, request.Name)
 	if err != nil {
 		return nil, err
 	}
+	if request.Federated {
+		if recorder != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("cannot share federated query results"))
+		}
+		return s.doFederatedQuery(ctx, request, user, database)
+	}
 
+	if err := s.checkQueryJustification(ctx, database, request.Justification); err != nil {
+		return nil, err
+	}
 	accessGrant := s.preCheckAccess(ctx, request, database)
 
 	statement := request.Statement
@@ -242,6 +285,14 @@ func (s *SQLService) Query(ctx context.Context, req *connect.Request[v1pb.QueryR
 		statement = strings.ReplaceAll(statement, fmt.Sprintf("%s.", database.DatabaseName), "")
 	}
 
+	var parameters []any
+	if request.Worksheet != "" || len(request.Parameters) > 0 {
+		statement, parameters, err = s.bindWorksheetParameters(ctx, request, instance.Metadata.GetEngine(), statement)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Validate the request.
 	// New query ACL experience.
 	if !request.Explain && !common.EngineSupportQueryNewACL(instance.Metadata.GetEngine()) {
@@ -295,6 +346,7 @@ func (s *SQLService) Query(ctx context.Context, req *connect.Request[v1pb.QueryR
 		Container:            request.GetContainer(),
 		MaximumSQLResultSize: queryRestriction.MaximumResultSize,
 		SkipMasking:          accessGrant != nil && accessGrant.Payload.Unmask,
+		Parameters:           parameters,
 	}
 	if request.Schema != nil {
 		queryContext.Schema = *request.Schema
@@ -307,14 +359,39 @@ func (s *SQLService) Query(ctx context.Context, req *connect.Request[v1pb.QueryR
 	if accessGrant == nil {
 		optionalAccessCheck = s.accessCheck
 	}
+	project, err := s.store.GetProject(ctx, &store.FindProjectMessage{Workspace: common.GetWorkspaceIDFromContext(ctx), ResourceID: &database.ProjectID})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get project"))
+	}
+	if project == nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.Errorf("project %q not found", database.ProjectID))
+	}
+	execute := s.resultCache.withQueryResultCache(
+		newQueryExecuteFunc(driver, conn),
+		project.Setting.GetQueryResultCache(),
+		project,
+		database,
+		instance.Metadata.GetEngine(),
+		dataSource.GetId(),
+		request.SkipCache,
+	)
+	rowFilters, err := newRowFilterApplier(ctx, s.store, user, instance, database)
+	if err != nil {
+		return nil, err
+	}
+	execute = rowFilters.wrap(execute)
+	// The recorder wraps the row filters, so the statements are recorded as the replay receives them.
+	if recorder != nil {
+		recorder.statement = statement
+		execute = recorder.wrap(execute)
+	}
 	results, _, duration, queryErr := queryRetryStopOnError(
 		ctx,
 		s.store,
 		user,
 		instance,
 		database,
-		driver,
-		conn,
+		execute,
 		statement,
 		queryContext,
 		s.licenseService,
@@ -329,7 +406,7 @@ func (s *SQLService) Query(ctx context.Context, req *connect.Request[v1pb.QueryR
 	)
 
 	// Update activity.
-	s.createQueryHistory(database, store.QueryHistoryTypeQuery, statement, user.Email, duration, queryErr)
+	s.createQueryHistory(database, store.QueryHistoryTypeQuery, statement, user.Email, duration, queryErr, accessGrant, request.Justification)
 
 	if queryErr != nil {
 		if len(results) == 0 {
@@ -377,7 +454,7 @@ func (s *SQLService) Query(ctx context.Context, req *connect.Request[v1pb.QueryR
 		Results: results,
 	}
 
-	return connect.NewResponse(response), nil
+	return response, nil
 }
 
 func getEffectiveQueryDataPolicy(
@@ -539,8 +616,7 @@ func queryRetry(
 	user *store.UserMessage,
 	instance *store.InstanceMessage,
 	database *store.DatabaseMessage,
-	driver db.Driver,
-	conn *sql.Conn,
+	execute queryExecuteFunc,
 	statements []parserbase.Statement,
 	originalStatement string,
 	queryContext db.QueryContext,
@@ -601,13 +677,7 @@ func queryRetry(
 	}
 
 	slog.Debug("start execute with timeout", slog.String("instance", instance.ResourceID), slog.String("database", database.DatabaseName), slog.String("statement", originalStatement))
-	results, duration, queryErr := executeWithTimeout(
-		ctx,
-		driver,
-		conn,
-		originalStatement,
-		queryContext,
-	)
+	results, duration, queryErr := execute(ctx, originalStatement, spans, queryContext)
 	if queryErr != nil {
 		return nil, nil, duration, queryErr
 	}
@@ -749,8 +819,7 @@ func queryRetryStopOnError(
 	user *store.UserMessage,
 	instance *store.InstanceMessage,
 	database *store.DatabaseMessage,
-	driver db.Driver,
-	conn *sql.Conn,
+	execute queryExecuteFunc,
 	statement string,
 	queryContext db.QueryContext,
 	licenseService *enterprise.LicenseService,
@@ -766,7 +835,7 @@ func queryRetryStopOnError(
 		if err != nil {
 			return nil, nil, 0, err
 		}
-		return queryRetry(ctx, stores, user, instance, database, driver, conn, statements, statement, queryContext, licenseService, optionalAccessCheck, schemaSyncer)
+		return queryRetry(ctx, stores, user, instance, database, execute, statements, statement, queryContext, licenseService, optionalAccessCheck, schemaSyncer)
 	}
 
 	// Split the statement into individual SQLs
@@ -775,7 +844,7 @@ func queryRetryStopOnError(
 		// Engines without splitter support (MongoDB, Redis, Elasticsearch) fall back to
 		// treating the entire statement as a single unit. These engines also don't have
 		// GetQuerySpan support, so queryRetry will return nil spans (old behavior).
-		return queryRetry(ctx, stores, user, instance, database, driver, conn, []parserbase.Statement{{Text: statement}}, statement, queryContext, licenseService, optionalAccessCheck, schemaSyncer)
+		return queryRetry(ctx, stores, user, instance, database, execute, []parserbase.Statement{{Text: statement}}, statement, queryContext, licenseService, optionalAccessCheck, schemaSyncer)
 	}
 
 	var allResults []*v1pb.QueryResult
@@ -788,7 +857,7 @@ func queryRetryStopOnError(
 			continue
 		}
 
-		results, spans, duration, err := queryRetry(ctx, stores, user, instance, database, driver, conn, []parserbase.Statement{stmt}, stmt.Text, queryContext, licenseService, optionalAccessCheck, schemaSyncer)
+		results, spans, duration, err := queryRetry(ctx, stores, user, instance, database, execute, []parserbase.Statement{stmt}, stmt.Text, queryContext, licenseService, optionalAccessCheck, schemaSyncer)
 		totalDuration += duration
 
 		if err != nil {
@@ -849,8 +918,16 @@ func executeWithTimeout(
 // Export exports the SQL query result.
 func (s *SQLService) Export(ctx context.Context, req *connect.Request[v1pb.ExportRequest]) (*connect.Response[v1pb.ExportResponse], error) {
 	request := req.Msg
+	if request.ExportArchive != "" {
+		return s.getExportArchive(ctx, request)
+	}
 	// Prehandle export from issue.
 	if strings.HasPrefix(request.Name, common.ProjectNamePrefix) {
+		if request.Async {
+			return s.startExport(ctx, request, func(ctx context.Context) (*v1pb.ExportResponse, error) {
+				return s.doExportFromIssue(ctx, request.Name)
+			})
+		}
 		response, err := s.doExportFromIssue(ctx, request.Name)
 		if err != nil {
 			return nil, err
@@ -863,6 +940,9 @@ func (s *SQLService) Export(ctx context.Context, req *connect.Request[v1pb.Expor
 	if err != nil {
 		return nil, err
 	}
+	if err := s.checkQueryJustification(ctx, database, request.Justification); err != nil {
+		return nil, err
+	}
 
 	statement := request.Statement
 	// In Redshift datashare, Rewrite query used for parser.
@@ -887,17 +967,102 @@ func (s *SQLService) Export(ctx context.Context, req *connect.Request[v1pb.Expor
 	if err != nil {
 		return nil, err
 	}
-	bytes, duration, exportErr := doExport(ctx, s.store, s.dbFactory, s.licenseService, request, user, instance, database, s.accessCheck, s.schemaSyncer, dataSource)
+	exportFunc := func(ctx context.Context) (*v1pb.ExportResponse, error) {
+		bytes, duration, exportErr := doExport(ctx, s.store, s.dbFactory, s.licenseService, request, user, instance, database, s.accessCheck, s.schemaSyncer, dataSource, s.secret)
 
-	s.createQueryHistory(database, store.QueryHistoryTypeExport, statement, user.Email, duration, exportErr)
+		s.createQueryHistory(database, store.QueryHistoryTypeExport, statement, user.Email, duration, exportErr, nil /* accessGrant */, request.Justification)
 
-	if exportErr != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.New(exportErr.Error()))
+		if exportErr != nil {
+			return nil, connect.NewError(connect.CodeInternal, errors.New(exportErr.Error()))
+		}
+		return &v1pb.ExportResponse{
+			Content: bytes,
+		}, nil
+	}
+	if request.Async {
+		return s.startExport(ctx, request, exportFunc)
 	}
+	response, err := exportFunc(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(response), nil
+}
 
-	return connect.NewResponse(&v1pb.ExportResponse{
-		Content: bytes,
-	}), nil
+// startExport runs the export in an operation.
+// The content is stored in an export archive, and the response of the operation is the ExportResponse referring to it.
+func (s *SQLService) startExport(ctx context.Context, request *v1pb.ExportRequest, exportFunc func(context.Context) (*v1pb.ExportResponse, error)) (*connect.Response[v1pb.ExportResponse], error) {
+	user, ok := GetUserFromContext(ctx)
+	if !ok || user == nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("user not found"))
+	}
+	workspaceID := common.GetWorkspaceIDFromContext(ctx)
+	op, err := startOperation(ctx, s.operationManager, storepb.Operation_EXPORT, request.Name, 0,
+		func(ctx context.Context, _ operation.ProgressFunc) (proto.Message, error) {
+			response, err := exportFunc(ctx)
+			if err != nil {
+				return nil, err
+			}
+			exportArchive, err := s.store.CreateExportArchive(ctx, &store.ExportArchiveMessage{
+				Workspace: workspaceID,
+				Bytes:     response.Content,
+				Payload: &storepb.ExportArchivePayload{
+					FileFormat: convertToExportFormat(request.Format),
+					Creator:    common.FormatUserEmail(user.Email),
+					Source:     request.Name,
+				},
+			})
+			if err != nil {
+				return nil, connect.NewError(connect.CodeInternal, errors.Wrap(err, "failed to create export archive"))
+			}
+			return &v1pb.ExportResponse{
+				ExportArchive: common.FormatExportArchive(exportArchive.ResourceID),
+			}, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&v1pb.ExportResponse{Operation: op}), nil
+}
+
+// getExportArchive returns the content of the export archive stored by startExport.
+func (s *SQLService) getExportArchive(ctx context.Context, request *v1pb.ExportRequest) (*connect.Response[v1pb.ExportResponse], error) {
+	exportArchiveID, err := common.GetExportArchiveID(request.ExportArchive)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	user, ok := GetUserFromContext(ctx)
+	if !ok || user == nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("user not found"))
+	}
+	exportArchive, err := s.store.GetExportArchive(ctx, common.GetWorkspaceIDFromContext(ctx), exportArchiveID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to get export archive: %v", err))
+	}
+	// Only the user who runs the export downloads the archive, from the resource that the export runs against.
+	if exportArchive == nil || exportArchive.Payload.GetCreator() != common.FormatUserEmail(user.Email) || exportArchive.Payload.GetSource() != request.Name {
+		return nil, connect.NewError(connect.CodeNotFound, errors.Errorf("export archive %q not found or expired, please request a new export", request.ExportArchive))
+	}
+	return connect.NewResponse(&v1pb.ExportResponse{Content: exportArchive.Bytes}), nil
+}
+
+// VerifyExportWatermark verifies the watermark of an export archive and identifies the exporter.
+func (s *SQLService) VerifyExportWatermark(ctx context.Context, req *connect.Request[v1pb.VerifyExportWatermarkRequest]) (*connect.Response[v1pb.ExportWatermark], error) {
+	if len(req.Msg.Content) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("content is required"))
+	}
+	workspaceID := common.GetWorkspaceIDFromContext(ctx)
+	watermark, err := export.VerifyWatermark(s.secret, workspaceID, req.Msg.Content, req.Msg.Password, func(id string) (*v1pb.ExportWatermark, error) {
+		recorded, err := s.store.GetExportWatermark(ctx, workspaceID, id)
+		if err != nil || recorded == nil {
+			return nil, err
+		}
+		return convertToExportWatermark(recorded), nil
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return connect.NewResponse(watermark), nil
 }
 
 func (s *SQLService) doExportFromIssue(ctx context.Context, requestName string) (*v1pb.ExportResponse, error) {
@@ -984,6 +1149,10 @@ func (s *SQLService) doExportFromIssue(ctx context.Context, requestName string)
 		}
 
 		for _, file := range zipReader.File {
+			// Watermarks are written when the archive is downloaded.
+			if file.Name == export.WatermarkFilename {
+				continue
+			}
 			rc, err := file.Open()
 			if err != nil {
 				return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to open file %s in archive: %v", file.Name, err))
@@ -1002,10 +1171,26 @@ func (s *SQLService) doExportFromIssue(ctx context.Context, requestName string)
 		}
 	}
 
-	encryptedBytes, err := doEncrypt(pendingEncrypts, passwordStr)
+	user, ok := GetUserFromContext(ctx)
+	if !ok || user == nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("user not found"))
+	}
+	// The exports of data export issues are not masked, because the approval of the issue authorizes access to the data.
+	watermarker, err := export.NewWatermarker(s.secret, common.GetWorkspaceIDFromContext(ctx), &v1pb.ExportWatermark{
+		Exporter:   common.FormatUserEmail(user.Email),
+		ExportTime: timestamppb.Now(),
+		Source:     requestName,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	encryptedBytes, watermark, err := doEncrypt(pendingEncrypts, passwordStr, watermarker)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to encrypt data: %v", err))
 	}
+	if err := createExportWatermark(ctx, s.store, watermark); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
 
 	return &v1pb.ExportResponse{
 		Content: encryptedBytes,
@@ -1027,6 +1212,7 @@ func doExport(
 	optionalAccessCheck accessCheckFunc,
 	schemaSyncer *schemasync.Syncer,
 	dataSource *storepb.DataSource,
+	secret string,
 ) ([]byte, time.Duration, error) {
 	if dataSource == nil {
 		return nil, 0, connect.NewError(connect.CodeNotFound, errors.Errorf("cannot found valid data source"))
@@ -1076,14 +1262,17 @@ func doExport(
 		statements = []parserbase.Statement{{Text: request.Statement}}
 	}
 
+	rowFilters, err := newRowFilterApplier(ctx, stores, user, instance, database)
+	if err != nil {
+		return nil, 0, err
+	}
 	results, spans, duration, queryErr := queryRetry(
 		ctx,
 		stores,
 		user,
 		instance,
 		database,
-		driver,
-		conn,
+		rowFilters.wrap(newQueryExecuteFunc(driver, conn)),
 		statements,
 		request.Statement,
 		queryContext,
@@ -1095,7 +1284,8 @@ func doExport(
 		return nil, duration, queryErr
 	}
 
-	if licenseService.IsFeatureEnabledForInstance(ctx, common.GetWorkspaceIDFromContext(ctx), v1pb.PlanFeature_FEATURE_DATA_MASKING, instance) == nil {
+	masked := licenseService.IsFeatureEnabledForInstance(ctx, common.GetWorkspaceIDFromContext(ctx), v1pb.PlanFeature_FEATURE_DATA_MASKING, instance) == nil
+	if masked {
 		masker := NewQueryResultMasker(stores)
 		if err := masker.MaskResults(ctx, spans, results, instance, user); err != nil {
 			return nil, duration, err
@@ -1104,6 +1294,17 @@ func doExport(
 
 	var buf bytes.Buffer
 	zipw := zip.NewWriter(&buf)
+	statementSHA256 := sha256.Sum256([]byte(request.Statement))
+	watermarker, err := export.NewWatermarker(secret, common.GetWorkspaceIDFromContext(ctx), &v1pb.ExportWatermark{
+		Exporter:        common.FormatUserEmail(user.Email),
+		ExportTime:      timestamppb.Now(),
+		Source:          request.Name,
+		StatementSha256: hex.EncodeToString(statementSHA256[:]),
+		Masked:          masked,
+	})
+	if err != nil {
+		return nil, duration, err
+	}
 
 	exportCount := 0
 	for i, result := range results {
@@ -1111,7 +1312,7 @@ func doExport(
 			return nil, duration, errors.Errorf("failed to exec the SQL with error: %v", result.GetError())
 		}
 
-		if err := exportResultToZip(ctx, zipw, stores, instance, database, result, request, i+1); err != nil {
+		if err := exportResultToZip(ctx, zipw, watermarker, stores, instance, database, result, request, i+1); err != nil {
 			return nil, duration, errors.Errorf("failed to export result to zip with error: %v", result.GetError())
 		}
 
@@ -1124,9 +1325,16 @@ func doExport(
 		return nil, duration, errors.Errorf("empty export data for database %s", database.DatabaseName)
 	}
 
+	watermark, err := watermarker.WriteWatermark(zipw)
+	if err != nil {
+		return nil, duration, errors.Wrap(err, "failed to write watermark")
+	}
 	if err := zipw.Close(); err != nil {
 		return nil, duration, errors.Wrap(err, "failed to close zip writer")
 	}
+	if err := createExportWatermark(ctx, stores, watermark); err != nil {
+		return nil, duration, err
+	}
 
 	return buf.Bytes(), duration, nil
 }
@@ -1136,6 +1344,7 @@ func doExport(
 func exportResultToZip(
 	ctx context.Context,
 	zipw *zip.Writer,
+	watermarker *export.Watermarker,
 	stores *store.Store,
 	instance *store.InstanceMessage,
 	database *store.DatabaseMessage,
@@ -1147,14 +1356,14 @@ func exportResultToZip(
 
 	// Write statement file
 	statementFilename := fmt.Sprintf("%s.sql", baseFilename)
-	if err := export.WriteZipEntry(zipw, statementFilename, []byte(result.Statement), request.GetPassword()); err != nil {
+	if err := watermarker.WriteZipEntry(zipw, statementFilename, []byte(result.Statement), request.GetPassword()); err != nil {
 		return errors.Wrap(err, "failed to write statement")
 	}
 
 	// Write result file by streaming directly to ZIP
 	resultExt := strings.ToLower(request.Format.String())
 	resultFilename := fmt.Sprintf("%s.result.%s", baseFilename, resultExt)
-	if err := formatExportToZip(ctx, zipw, resultFilename, stores, instance, database, result, request); err != nil {
+	if err := formatExportToZip(ctx, zipw, watermarker, resultFilename, stores, instance, database, result, request); err != nil {
 		return errors.Wrap(err, "failed to write formatted result")
 	}
 
@@ -1166,6 +1375,7 @@ func exportResultToZip(
 func formatExportToZip(
 	ctx context.Context,
 	zipw *zip.Writer,
+	watermarker *export.Watermarker,
 	filename string,
 	stores *store.Store,
 	instance *store.InstanceMessage,
@@ -1173,7 +1383,15 @@ func formatExportToZip(
 	result *v1pb.QueryResult,
 	request *v1pb.ExportRequest,
 ) error {
-	writer, err := export.CreateZipWriter(zipw, filename, request.GetPassword())
+	// The XLSX file is written as a whole, so that the watermark can be embedded in its document properties.
+	if request.Format == v1pb.ExportFormat_XLSX {
+		content, err := export.XLSX(result)
+		if err != nil {
+			return err
+		}
+		return watermarker.WriteZipEntry(zipw, filename, content, request.GetPassword())
+	}
+	writer, err := watermarker.CreateZipWriter(zipw, filename, request.GetPassword())
 	if err != nil {
 		return err
 	}
@@ -1185,8 +1403,6 @@ func formatExportToZip(
 		return export.JSONToWriter(writer, result)
 	case v1pb.ExportFormat_SQL:
 		return exportSQLWithContext(ctx, writer, stores, instance, database, result, request)
-	case v1pb.ExportFormat_XLSX:
-		return export.XLSXToWriter(writer, result)
 	default:
 		return errors.Errorf("unsupported export format: %s", request.Format.String())
 	}
@@ -1228,7 +1444,7 @@ type encryptContent struct {
 	Content []byte
 }
 
-func doEncrypt(exports []*encryptContent, password string) ([]byte, error) {
+func doEncrypt(exports []*encryptContent, password string, watermarker *export.Watermarker) ([]byte, *v1pb.ExportWatermark, error) {
 	var b bytes.Buffer
 	fzip := io.Writer(&b)
 
@@ -1236,19 +1452,67 @@ func doEncrypt(exports []*encryptContent, password string) ([]byte, error) {
 	defer zipw.Close()
 
 	for _, exportContent := range exports {
-		if err := export.WriteZipEntry(zipw, exportContent.Name, exportContent.Content, password); err != nil {
-			return nil, err
+		if err := watermarker.WriteZipEntry(zipw, exportContent.Name, exportContent.Content, password); err != nil {
+			return nil, nil, err
 		}
 	}
+	watermark, err := watermarker.WriteWatermark(zipw)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to write watermark")
+	}
 
 	if err := zipw.Close(); err != nil {
-		return nil, errors.Wrap(err, "failed to close zip writer")
+		return nil, nil, errors.Wrap(err, "failed to close zip writer")
+	}
+
+	return b.Bytes(), watermark, nil
+}
+
+// createExportWatermark records the watermark of an export, so that the export archive is verified against the recorded digests.
+func createExportWatermark(ctx context.Context, stores *store.Store, watermark *v1pb.ExportWatermark) error {
+	payload := &storepb.ExportWatermarkPayload{
+		Exporter:        watermark.Exporter,
+		Source:          watermark.Source,
+		StatementSha256: watermark.StatementSha256,
+		Masked:          watermark.Masked,
+	}
+	for _, file := range watermark.Files {
+		payload.Files = append(payload.Files, &storepb.ExportWatermarkPayload_File{
+			Name:   file.Name,
+			Sha256: file.Sha256,
+			Marked: file.Marked,
+		})
+	}
+	if _, err := stores.CreateExportWatermark(ctx, &store.ExportWatermarkMessage{
+		ID:        watermark.Id,
+		Workspace: common.GetWorkspaceIDFromContext(ctx),
+		Payload:   payload,
+	}); err != nil {
+		return errors.Wrap(err, "failed to record export watermark")
 	}
+	return nil
+}
 
-	return b.Bytes(), nil
+func convertToExportWatermark(watermark *store.ExportWatermarkMessage) *v1pb.ExportWatermark {
+	result := &v1pb.ExportWatermark{
+		Id:              watermark.ID,
+		Exporter:        watermark.Payload.Exporter,
+		ExportTime:      timestamppb.New(watermark.CreatedAt),
+		Source:          watermark.Payload.Source,
+		StatementSha256: watermark.Payload.StatementSha256,
+		Masked:          watermark.Payload.Masked,
+	}
+	for _, file := range watermark.Payload.Files {
+		result.Files = append(result.Files, &v1pb.ExportWatermark_File{
+			Name:   file.Name,
+			Sha256: file.Sha256,
+			Marked: file.Marked,
+		})
+	}
+	return result
 }
 
-func (s *SQLService) createQueryHistory(database *store.DatabaseMessage, queryType store.QueryHistoryType, statement string, userEmail string, duration time.Duration, queryErr error) {
+func (s *SQLService) createQueryHistory(database *store.DatabaseMessage, queryType store.QueryHistoryType, statement string, userEmail string, duration time.Duration, queryErr error, accessGrant *store.AccessGrantMessage, justification *v1pb.QueryJustification) {
 	qh := &store.QueryHistoryMessage{
 		Creator:   userEmail,
 		Project:   database.ProjectID,
@@ -1263,6 +1527,14 @@ func (s *SQLService) createQueryHistory(database *store.DatabaseMessage, queryTy
 	if queryErr != nil {
 		qh.Payload.Error = new(queryErr.Error())
 	}
+	// Tag the query with the access grant that authorized it, so the queries run in a session can be reviewed.
+	if accessGrant != nil {
+		qh.Payload.AccessGrantId = accessGrant.ID
+	}
+	if justification != nil {
+		qh.Payload.Justification = justification.Reason
+		qh.Payload.Ticket = justification.Ticket
+	}
 
 	// Use a fresh context with timeout for creating query history
 	// to avoid being affected by request cancellation
@@ -1496,8 +1768,10 @@ func (s *SQLService) accessCheck(
 		}
 		attributes[common.CELAttributeResourceEnvironmentID] = env
 
-		ok, err := s.hasDatabaseAccessRights(
+		ok, err := hasDatabaseAccessRights(
 			ctx,
+			s.store,
+			s.iamManager,
 			user,
 			perm,
 			attributes,
@@ -1575,8 +1849,10 @@ func (s *SQLService) accessCheck(
 				common.CELAttributeResourceSchemaName: column.Schema,
 				common.CELAttributeResourceTableName:  column.Table,
 			}
-			ok, err := s.hasDatabaseAccessRights(
+			ok, err := hasDatabaseAccessRights(
 				ctx,
+				s.store,
+				s.iamManager,
 				user,
 				perm,
 				attributes,
@@ -1697,16 +1973,18 @@ func validateQueryRequest(instance *store.InstanceMessage, statement string) err
 	return nil
 }
 
-func (s *SQLService) hasDatabaseAccessRights(
+func hasDatabaseAccessRights(
 	ctx context.Context,
+	stores *store.Store,
+	iamManager *iam.Manager,
 	user *store.UserMessage,
 	perm permission.Permission,
 	attributes map[string]any,
 	iamPolicies ...*storepb.IamPolicy,
 ) (bool, error) {
-	bindings := utils.GetUserIAMPolicyBindings(ctx, s.store, common.GetWorkspaceIDFromContext(ctx), user, iamPolicies...)
+	bindings := utils.GetUserIAMPolicyBindings(ctx, stores, common.GetWorkspaceIDFromContext(ctx), user, iamPolicies...)
 	for _, binding := range bindings {
-		permissions, err := s.iamManager.GetPermissions(ctx, common.GetWorkspaceIDFromContext(ctx), binding.Role)
+		permissions, err := iamManager.GetPermissions(ctx, common.GetWorkspaceIDFromContext(ctx), binding.Role)
 		if err != nil {
 			return false, errors.Wrapf(err, "failed to get permissions")
 		}
@@ -1785,6 +2063,24 @@ func (*SQLService) DiffMetadata(_ context.Context, req *connect.Request[v1pb.Dif
 	}), nil
 }
 
+// FormatStatement formats SQL statements in the dialect of the engine.
+func (*SQLService) FormatStatement(_ context.Context, req *connect.Request[v1pb.FormatStatementRequest]) (*connect.Response[v1pb.FormatStatementResponse], error) {
+	request := req.Msg
+	if request.IndentSize < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("indent_size must not be negative"))
+	}
+	formatted, err := parserbase.Format(storepb.Engine(request.Engine), request.Statement, parserbase.FormatOptions{
+		IndentSize: int(request.IndentSize),
+		UseTab:     request.UseTab,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Wrapf(err, "failed to format statement"))
+	}
+	return connect.NewResponse(&v1pb.FormatStatementResponse{
+		Statement: formatted,
+	}), nil
+}
+
 func resolveDataSourceID(instance *store.InstanceMessage, dataSourceID string) (string, error) {
 	if dataSourceID != "" {
 		return dataSourceID, nil
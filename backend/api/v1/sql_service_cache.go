@@ -0,0 +1,244 @@
+package v1
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	parserbase "github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+const (
+	defaultQueryResultCacheSize = 64 * 1024 * 1024
+	maximumQueryResultCacheSize = 1024 * 1024 * 1024
+	maximumQueryResultCacheTTL  = 24 * time.Hour
+)
+
+// validateQueryResultCache validates the query result cache setting of a project.
+func validateQueryResultCache(cache *v1pb.Project_QueryResultCache) error {
+	if cache == nil {
+		return nil
+	}
+	if cache.MaximumSize < 0 || cache.MaximumSize > maximumQueryResultCacheSize {
+		return errors.Errorf("maximum size must be between 0 and %d bytes", maximumQueryResultCacheSize)
+	}
+	if cache.Ttl != nil {
+		if err := cache.Ttl.CheckValid(); err != nil {
+			return errors.Wrapf(err, "invalid ttl")
+		}
+		if ttl := cache.Ttl.AsDuration(); ttl < 0 || ttl > maximumQueryResultCacheTTL {
+			return errors.Errorf("ttl must be between 0 and %v", maximumQueryResultCacheTTL)
+		}
+	}
+	if cache.Enabled && cache.Ttl.AsDuration() <= 0 {
+		return errors.New("ttl is required to enable the query result cache")
+	}
+	return nil
+}
+
+// queryResultCache is the in-memory query result cache of the projects.
+// It holds the results before masking, so the access check and masking of queryRetry are applied to every caller.
+type queryResultCache struct {
+	mu       sync.Mutex
+	projects map[string]*projectQueryResultCache
+}
+
+// projectQueryResultCache is the LRU cache of a project, bounded by the total size of the results.
+type projectQueryResultCache struct {
+	entries map[string]*list.Element
+	lru     *list.List
+	size    int64
+}
+
+type queryResultCacheEntry struct {
+	key        string
+	results    []*v1pb.QueryResult
+	size       int64
+	expireTime time.Time
+}
+
+func newQueryResultCache() *queryResultCache {
+	return &queryResultCache{
+		projects: make(map[string]*projectQueryResultCache),
+	}
+}
+
+// get returns a copy of the unexpired results of the key.
+func (c *queryResultCache) get(project, key string, now time.Time) ([]*v1pb.QueryResult, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	p, ok := c.projects[project]
+	if !ok {
+		return nil, false
+	}
+	element, ok := p.entries[key]
+	if !ok {
+		return nil, false
+	}
+	entry := element.Value.(*queryResultCacheEntry)
+	if !now.Before(entry.expireTime) {
+		p.remove(element)
+		return nil, false
+	}
+	p.lru.MoveToFront(element)
+	return cloneQueryResults(entry.results), true
+}
+
+// put stores a copy of the results, and evicts the least recently used results exceeding the maximum size.
+func (c *queryResultCache) put(project, key string, results []*v1pb.QueryResult, expireTime time.Time, maximumSize int64) {
+	var size int64
+	for _, result := range results {
+		size += int64(proto.Size(result))
+	}
+	if size > maximumSize {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	p, ok := c.projects[project]
+	if !ok {
+		p = &projectQueryResultCache{
+			entries: make(map[string]*list.Element),
+			lru:     list.New(),
+		}
+		c.projects[project] = p
+	}
+	if element, ok := p.entries[key]; ok {
+		p.remove(element)
+	}
+	p.entries[key] = p.lru.PushFront(&queryResultCacheEntry{
+		key:        key,
+		results:    cloneQueryResults(results),
+		size:       size,
+		expireTime: expireTime,
+	})
+	p.size += size
+	for p.size > maximumSize {
+		p.remove(p.lru.Back())
+	}
+}
+
+func (p *projectQueryResultCache) remove(element *list.Element) {
+	entry := element.Value.(*queryResultCacheEntry)
+	p.lru.Remove(element)
+	delete(p.entries, entry.key)
+	p.size -= entry.size
+}
+
+func cloneQueryResults(results []*v1pb.QueryResult) []*v1pb.QueryResult {
+	var cloned []*v1pb.QueryResult
+	for _, result := range results {
+		cloned = append(cloned, proto.CloneOf(result))
+	}
+	return cloned
+}
+
+// queryExecuteFunc executes the statement whose query spans are given.
+type queryExecuteFunc func(ctx context.Context, statement string, spans []*parserbase.QuerySpan, queryContext db.QueryContext) ([]*v1pb.QueryResult, time.Duration, error)
+
+func newQueryExecuteFunc(driver db.Driver, conn *sql.Conn) queryExecuteFunc {
+	return func(ctx context.Context, statement string, _ []*parserbase.QuerySpan, queryContext db.QueryContext) ([]*v1pb.QueryResult, time.Duration, error) {
+		return executeWithTimeout(ctx, driver, conn, statement, queryContext)
+	}
+}
+
+// withQueryResultCache wraps the execute function with the query result cache of the project.
+// Only successful read-only queries are cached.
+func (c *queryResultCache) withQueryResultCache(
+	execute queryExecuteFunc,
+	setting *storepb.Project_QueryResultCache,
+	project *store.ProjectMessage,
+	database *store.DatabaseMessage,
+	engine storepb.Engine,
+	dataSourceID string,
+	skipCache bool,
+) queryExecuteFunc {
+	if !setting.GetEnabled() || setting.GetTtl().AsDuration() <= 0 {
+		return execute
+	}
+	maximumSize := setting.GetMaximumSize()
+	if maximumSize <= 0 {
+		maximumSize = defaultQueryResultCacheSize
+	}
+	projectKey := fmt.Sprintf("%s/%s", project.Workspace, project.ResourceID)
+	return func(ctx context.Context, statement string, spans []*parserbase.QuerySpan, queryContext db.QueryContext) ([]*v1pb.QueryResult, time.Duration, error) {
+		if !isQueryResultCacheable(spans, queryContext) {
+			return execute(ctx, statement, spans, queryContext)
+		}
+		key := getQueryResultCacheKey(database, engine, dataSourceID, statement, queryContext)
+		if !skipCache {
+			if results, ok := c.get(projectKey, key, time.Now()); ok {
+				for _, result := range results {
+					result.Cached = true
+				}
+				return results, 0, nil
+			}
+		}
+		results, duration, err := execute(ctx, statement, spans, queryContext)
+		if err != nil {
+			return results, duration, err
+		}
+		for _, result := range results {
+			if result.Error != "" {
+				return results, duration, nil
+			}
+		}
+		c.put(projectKey, key, results, time.Now().Add(setting.GetTtl().AsDuration()), maximumSize)
+		return results, duration, nil
+	}
+}
+
+func isQueryResultCacheable(spans []*parserbase.QuerySpan, queryContext db.QueryContext) bool {
+	if queryContext.Explain || len(spans) == 0 {
+		return false
+	}
+	for _, span := range spans {
+		if span == nil || span.Type != parserbase.Select {
+			return false
+		}
+	}
+	return true
+}
+
+// getQueryResultCacheKey returns the cache key of the statement.
+// The masking context is not part of the key because the cache holds the results before masking.
+func getQueryResultCacheKey(database *store.DatabaseMessage, engine storepb.Engine, dataSourceID string, statement string, queryContext db.QueryContext) string {
+	normalized, err := parserbase.Format(engine, statement, parserbase.FormatOptions{})
+	if err != nil {
+		normalized = strings.TrimSpace(statement)
+	}
+	var option []byte
+	if queryContext.Option != nil {
+		option, _ = proto.MarshalOptions{Deterministic: true}.Marshal(queryContext.Option)
+	}
+	h := sha256.New()
+	for _, part := range []string{
+		common.FormatDatabase(database.InstanceID, database.DatabaseName),
+		dataSourceID,
+		queryContext.Schema,
+		queryContext.Container,
+		fmt.Sprintf("%d/%d", queryContext.Limit, queryContext.MaximumSQLResultSize),
+		string(option),
+		fmt.Sprintf("%#v", queryContext.Parameters),
+		normalized,
+	} {
+		_, _ = h.Write([]byte(part))
+		_, _ = h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
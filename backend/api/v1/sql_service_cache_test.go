@@ -0,0 +1,175 @@
+package v1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/type/expr"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	parserbase "github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+func TestQueryResultCache(t *testing.T) {
+	a := require.New(t)
+	cache := newQueryResultCache()
+	now := time.Now()
+	results := []*v1pb.QueryResult{{ColumnNames: []string{"a"}, Rows: []*v1pb.QueryRow{{}}}}
+
+	cache.put("p", "k1", results, now.Add(time.Minute), 1024)
+	got, ok := cache.get("p", "k1", now)
+	a.True(ok)
+	a.Equal([]string{"a"}, got[0].ColumnNames)
+
+	// The cached results are not affected by masking the returned copy.
+	got[0].ColumnNames[0] = "masked"
+	got, ok = cache.get("p", "k1", now)
+	a.True(ok)
+	a.Equal([]string{"a"}, got[0].ColumnNames)
+
+	// Other projects do not share the cache.
+	_, ok = cache.get("q", "k1", now)
+	a.False(ok)
+
+	// Expired.
+	_, ok = cache.get("p", "k1", now.Add(time.Minute))
+	a.False(ok)
+
+	// The least recently used results are evicted beyond the maximum size.
+	size := int64(150)
+	big := []*v1pb.QueryResult{{Statement: string(make([]byte, 90))}}
+	cache.put("p", "k1", big, now.Add(time.Minute), size)
+	cache.put("p", "k2", big, now.Add(time.Minute), size)
+	_, ok = cache.get("p", "k1", now)
+	a.False(ok)
+	_, ok = cache.get("p", "k2", now)
+	a.True(ok)
+
+	// Results larger than the maximum size are not cached.
+	cache.put("p", "k3", big, now.Add(time.Minute), 10)
+	_, ok = cache.get("p", "k3", now)
+	a.False(ok)
+}
+
+func TestWithQueryResultCache(t *testing.T) {
+	a := require.New(t)
+	cache := newQueryResultCache()
+	executed := 0
+	execute := func(context.Context, string, []*parserbase.QuerySpan, db.QueryContext) ([]*v1pb.QueryResult, time.Duration, error) {
+		executed++
+		return []*v1pb.QueryResult{{ColumnNames: []string{"a"}}}, time.Second, nil
+	}
+	setting := &storepb.Project_QueryResultCache{Enabled: true, Ttl: durationpb.New(time.Minute)}
+	project := &store.ProjectMessage{Workspace: "w", ResourceID: "p"}
+	database := &store.DatabaseMessage{InstanceID: "i", DatabaseName: "d"}
+	selectSpans := []*parserbase.QuerySpan{{Type: parserbase.Select}}
+
+	cached := cache.withQueryResultCache(execute, setting, project, database, storepb.Engine_POSTGRES, "ds", false)
+	_, _, err := cached(context.Background(), "SELECT 1", selectSpans, db.QueryContext{})
+	a.NoError(err)
+	results, _, err := cached(context.Background(), "select   1", selectSpans, db.QueryContext{})
+	a.NoError(err)
+	a.Equal(1, executed)
+	a.True(results[0].Cached)
+
+	// Different limits are cached separately.
+	_, _, err = cached(context.Background(), "SELECT 1", selectSpans, db.QueryContext{Limit: 10})
+	a.NoError(err)
+	a.Equal(2, executed)
+
+	// Skip cache executes the query.
+	refresh := cache.withQueryResultCache(execute, setting, project, database, storepb.Engine_POSTGRES, "ds", true)
+	_, _, err = refresh(context.Background(), "SELECT 1", selectSpans, db.QueryContext{})
+	a.NoError(err)
+	a.Equal(3, executed)
+
+	// Non read-only and explain queries are not cached.
+	for range 2 {
+		_, _, err = cached(context.Background(), "DELETE FROM t", []*parserbase.QuerySpan{{Type: parserbase.DML}}, db.QueryContext{})
+		a.NoError(err)
+		_, _, err = cached(context.Background(), "SELECT 1", nil, db.QueryContext{Explain: true})
+		a.NoError(err)
+	}
+	a.Equal(7, executed)
+
+	// Disabled cache.
+	disabled := cache.withQueryResultCache(execute, &storepb.Project_QueryResultCache{Ttl: durationpb.New(time.Minute)}, project, database, storepb.Engine_POSTGRES, "ds", false)
+	_, _, err = disabled(context.Background(), "SELECT 1", selectSpans, db.QueryContext{})
+	a.NoError(err)
+	a.Equal(8, executed)
+}
+
+func TestValidateQueryResultCache(t *testing.T) {
+	a := require.New(t)
+	a.NoError(validateQueryResultCache(nil))
+	a.NoError(validateQueryResultCache(&v1pb.Project_QueryResultCache{Enabled: true, Ttl: durationpb.New(time.Minute)}))
+	a.Error(validateQueryResultCache(&v1pb.Project_QueryResultCache{Enabled: true}))
+	a.Error(validateQueryResultCache(&v1pb.Project_QueryResultCache{Enabled: true, Ttl: durationpb.New(48 * time.Hour)}))
+	a.Error(validateQueryResultCache(&v1pb.Project_QueryResultCache{MaximumSize: -1}))
+}
+
+func TestQueryResultReplay(t *testing.T) {
+	a := require.New(t)
+	payload := &storepb.QueryResultSharePayload{
+		Batches: []*storepb.QueryResultSharePayload_Batch{
+			{Statement: "SELECT 1", ResultCount: 1},
+			{Statement: "SELECT 2", ResultCount: 1},
+		},
+	}
+	results := []*v1pb.QueryResult{
+		{Statement: "SELECT 1", Rows: []*v1pb.QueryRow{{}, {}, {}}, RowsCount: 3},
+		{Statement: "SELECT 2"},
+	}
+	replay := newQueryResultReplayFunc(payload, results)
+	got, _, err := replay(context.Background(), "SELECT 1", nil, db.QueryContext{Limit: 2})
+	a.NoError(err)
+	a.Len(got, 1)
+	a.Len(got[0].Rows, 2)
+	a.Equal(int64(2), got[0].RowsCount)
+	_, _, err = replay(context.Background(), "SELECT 3", nil, db.QueryContext{})
+	a.Error(err)
+}
+
+func TestQueryResultShareRoundTripWithRowFilters(t *testing.T) {
+	a := require.New(t)
+	condition, err := common.ValidateRowFilterConditionCELExpr(&expr.Expr{Expression: `resource.table_name == "orders"`})
+	a.NoError(err)
+	applier := &rowFilterApplier{
+		instance: &store.InstanceMessage{ResourceID: "i", Metadata: &storepb.Instance{Engine: storepb.Engine_POSTGRES}},
+		database: &store.DatabaseMessage{DatabaseName: "db"},
+		filters: []*rowFilter{{
+			policy:    &storepb.RowFilterPolicy_RowFilter{Id: "emea"},
+			condition: condition,
+			predicate: `"region" = 'emea'`,
+		}},
+	}
+	spans := []*parserbase.QuerySpan{{
+		Type:          parserbase.Select,
+		SourceColumns: parserbase.SourceColumnSet{{Database: "db", Schema: "public", Table: "orders", Column: "id"}: true},
+	}}
+	var executed string
+	execute := func(_ context.Context, statement string, _ []*parserbase.QuerySpan, _ db.QueryContext) ([]*v1pb.QueryResult, time.Duration, error) {
+		executed = statement
+		return []*v1pb.QueryResult{{Statement: statement, Rows: []*v1pb.QueryRow{{}}, RowsCount: 1}}, 0, nil
+	}
+
+	// Record in the same order as doQuery.
+	recorder := &queryResultRecorder{}
+	_, _, err = recorder.wrap(applier.wrap(execute))(context.Background(), "SELECT * FROM orders", spans, db.QueryContext{})
+	a.NoError(err)
+	a.Equal(`SELECT * FROM (SELECT * FROM "public"."orders" WHERE ("region" = 'emea')) AS "orders"`, executed)
+
+	payload := &storepb.QueryResultSharePayload{Batches: recorder.batches}
+	got, _, err := newQueryResultReplayFunc(payload, recorder.results)(context.Background(), "SELECT * FROM orders", spans, db.QueryContext{})
+	a.NoError(err)
+	a.Len(got, 1)
+	a.Equal("SELECT * FROM orders", got[0].Statement)
+	a.Len(got[0].AppliedRowFilters, 1)
+}
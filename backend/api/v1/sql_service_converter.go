@@ -55,7 +55,7 @@ func (s *SQLService) convertToV1QueryHistory(ctx context.Context, history *store
 	default:
 	}
 
-	return &v1pb.QueryHistory{
+	queryHistory := &v1pb.QueryHistory{
 		Name:       fmt.Sprintf("%s/queryHistories/%s", common.FormatProject(history.Project), history.ResourceID),
 		Statement:  history.Statement,
 		Error:      history.Payload.Error,
@@ -64,5 +64,15 @@ func (s *SQLService) convertToV1QueryHistory(ctx context.Context, history *store
 		CreateTime: timestamppb.New(history.CreatedAt),
 		Duration:   history.Payload.Duration,
 		Type:       historyType,
-	}, nil
+	}
+	if history.Payload.AccessGrantId != "" {
+		queryHistory.AccessGrant = common.FormatAccessGrant(history.Project, history.Payload.AccessGrantId)
+	}
+	if history.Payload.Justification != "" || history.Payload.Ticket != "" {
+		queryHistory.Justification = &v1pb.QueryJustification{
+			Reason: history.Payload.Justification,
+			Ticket: history.Payload.Ticket,
+		}
+	}
+	return queryHistory, nil
 }
@@ -0,0 +1,608 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"math"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/mattn/go-sqlite3"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/db/util"
+	parserbase "github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/store/model"
+)
+
+const (
+	// federatedMaximumRows is the maximum number of rows read from each referenced table.
+	federatedMaximumRows = 100000
+	// federatedMaximumSize is the maximum total size in bytes of the referenced tables.
+	federatedMaximumSize = 256 * 1024 * 1024
+)
+
+// federatedTable is a table referenced by a federated statement, read from its database.
+type federatedTable struct {
+	reference parserbase.FederatedReference
+	// name is the table name in the embedded engine.
+	name     string
+	instance *store.InstanceMessage
+	database *store.DatabaseMessage
+	// metadata is the synced metadata of the table, nil if the table is not synced.
+	metadata *storepb.TableMetadata
+	// references are the indexes of the references to the table in the statement.
+	references []int
+	// columns are the columns read from the table, all columns if empty.
+	columns []string
+	// predicates are the conditions pushed down to the database of the table.
+	predicates []parserbase.FederatedPredicate
+	result     *v1pb.QueryResult
+}
+
+// doFederatedQuery executes a federated statement.
+// Each referenced table is read from its database through queryRetry, so the access check and masking of
+// the table's database are applied to the user. The statement is then executed by an in-memory SQLite
+// over the masked tables.
+func (s *SQLService) doFederatedQuery(ctx context.Context, request *v1pb.QueryRequest, user *store.UserMessage, database *store.DatabaseMessage) (*v1pb.QueryResponse, error) {
+	if request.Explain || request.Worksheet != "" || len(request.Parameters) > 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("federated query does not support explain or parameters"))
+	}
+	startTime := time.Now()
+	tables, statement, err := s.readFederatedTables(ctx, user, request.Statement, request.Justification)
+	if err != nil {
+		s.createQueryHistory(database, store.QueryHistoryTypeQuery, request.Statement, user.Email, time.Since(startTime), err, nil /* accessGrant */, request.Justification)
+		return nil, err
+	}
+
+	queryRestriction := getEffectiveQueryDataPolicy(ctx, s.store, s.licenseService, request.Limit, database.ProjectID)
+	queryCtx := ctx
+	if queryRestriction.MaxQueryTimeoutInSeconds > 0 {
+		var cancel context.CancelFunc
+		queryCtx, cancel = context.WithTimeout(ctx, time.Duration(queryRestriction.MaxQueryTimeoutInSeconds)*time.Second)
+		defer cancel()
+	}
+	result, err := executeFederatedStatement(queryCtx, tables, statement, int(queryRestriction.MaximumResultRows), queryRestriction.MaximumResultSize)
+	duration := time.Since(startTime)
+	s.createQueryHistory(database, store.QueryHistoryTypeQuery, request.Statement, user.Email, duration, err, nil /* accessGrant */, request.Justification)
+	if err != nil {
+		return &v1pb.QueryResponse{
+			Results: []*v1pb.QueryResult{{Error: err.Error(), Statement: request.Statement}},
+		}, nil
+	}
+	result.Statement = request.Statement
+	result.Latency = durationpb.New(duration)
+	for _, table := range tables {
+		result.AppliedRowFilters = append(result.AppliedRowFilters, table.result.AppliedRowFilters...)
+	}
+	return &v1pb.QueryResponse{Results: []*v1pb.QueryResult{result}}, nil
+}
+
+// readFederatedTables reads the tables referenced by the statement, and returns the statement referencing
+// the tables by their names in the embedded engine.
+// The references are located by the lexer, since no engine grammar accepts instance.database.schema.table names.
+// The columns read from each table are then derived from the query span of the rewritten statement, and the
+// top-level conditions on a single table are pushed down to its database.
+func (s *SQLService) readFederatedTables(ctx context.Context, user *store.UserMessage, statement string, justification *v1pb.QueryJustification) ([]*federatedTable, string, error) {
+	references, err := parserbase.ExtractFederatedReferences(statement)
+	if err != nil {
+		return nil, "", connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	var tables []*federatedTable
+	tableByName := make(map[string]*federatedTable)
+	var buf strings.Builder
+	last := 0
+	for i, reference := range references {
+		name := federatedTableName(reference)
+		table, ok := tableByName[name]
+		if !ok {
+			instance, database, err := s.getFederatedDatabase(ctx, reference)
+			if err != nil {
+				return nil, "", err
+			}
+			// Names not resolving to a database are left to the embedded engine, e.g. schema.table.column.
+			if database == nil {
+				continue
+			}
+			if err := s.checkQueryJustification(ctx, database, justification); err != nil {
+				return nil, "", err
+			}
+			metadata, err := s.getFederatedTableMetadata(ctx, database, reference)
+			if err != nil {
+				return nil, "", err
+			}
+			table = &federatedTable{reference: reference, name: name, instance: instance, database: database, metadata: metadata}
+			tables = append(tables, table)
+			tableByName[name] = table
+		}
+		table.references = append(table.references, i)
+		buf.WriteString(statement[last:reference.Start])
+		buf.WriteString(quoteSQLiteIdentifier(table.name))
+		last = reference.End
+	}
+	if len(tables) == 0 {
+		return nil, "", connect.NewError(connect.CodeInvalidArgument, errors.New("federated query must reference tables as instance.database.table or instance.database.schema.table"))
+	}
+	buf.WriteString(statement[last:])
+	rewritten := buf.String()
+
+	setFederatedColumns(ctx, tables, rewritten)
+	setFederatedPredicates(tables, parserbase.ExtractFederatedPredicates(statement, references))
+
+	var totalSize int64
+	for _, table := range tables {
+		result, err := s.readFederatedTable(ctx, user, table)
+		if err != nil {
+			return nil, "", err
+		}
+		totalSize += int64(proto.Size(result))
+		if totalSize > federatedMaximumSize {
+			return nil, "", connect.NewError(connect.CodeResourceExhausted, errors.Errorf("federated tables exceed the maximum size of %d bytes", federatedMaximumSize))
+		}
+		table.result = result
+	}
+	return tables, rewritten, nil
+}
+
+func (s *SQLService) getFederatedTableMetadata(ctx context.Context, database *store.DatabaseMessage, reference parserbase.FederatedReference) (*storepb.TableMetadata, error) {
+	dbMetadata, err := s.store.GetDBSchema(ctx, &store.FindDBSchemaMessage{
+		Workspace:    common.GetWorkspaceIDFromContext(ctx),
+		InstanceID:   database.InstanceID,
+		DatabaseName: database.DatabaseName,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get database schema"))
+	}
+	if dbMetadata == nil {
+		return nil, nil
+	}
+	return dbMetadata.GetSchemaMetadata(reference.Schema).GetTable(reference.Table).GetProto(), nil
+}
+
+func (s *SQLService) getFederatedDatabase(ctx context.Context, reference parserbase.FederatedReference) (*store.InstanceMessage, *store.DatabaseMessage, error) {
+	workspaceID := common.GetWorkspaceIDFromContext(ctx)
+	instance, err := s.store.GetInstance(ctx, &store.FindInstanceMessage{Workspace: workspaceID, ResourceID: &reference.Instance})
+	if err != nil {
+		return nil, nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get instance"))
+	}
+	if instance == nil {
+		return nil, nil, nil
+	}
+	database, err := s.store.GetDatabase(ctx, &store.FindDatabaseMessage{Workspace: workspaceID, InstanceID: &reference.Instance, DatabaseName: &reference.Database})
+	if err != nil {
+		return nil, nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get database"))
+	}
+	if database == nil {
+		return nil, nil, nil
+	}
+	return instance, database, nil
+}
+
+// readFederatedTable reads the table from its database with the access check and masking applied.
+// The pushed-down predicates only reduce the rows read. If the database rejects them, e.g. for conditions
+// on masked columns, the table is read without them.
+func (s *SQLService) readFederatedTable(ctx context.Context, user *store.UserMessage, table *federatedTable) (*v1pb.QueryResult, error) {
+	result, err := s.queryFederatedTable(ctx, user, table, table.predicates)
+	if connect.CodeOf(err) == connect.CodeFailedPrecondition && len(table.predicates) > 0 {
+		slog.Debug("failed to read federated table with predicates", slog.String("table", table.name), log.BBError(err))
+		result, err = s.queryFederatedTable(ctx, user, table, nil)
+	}
+	return result, err
+}
+
+func (s *SQLService) queryFederatedTable(ctx context.Context, user *store.UserMessage, table *federatedTable, predicates []parserbase.FederatedPredicate) (*v1pb.QueryResult, error) {
+	instance, database, reference := table.instance, table.database, table.reference
+	statement, err := getFederatedTableStatement(instance.Metadata.GetEngine(), reference, table.columns, predicates)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	resolvedDataSourceID, err := resolveDataSourceID(instance, "")
+	if err != nil {
+		return nil, err
+	}
+	dataSource, err := checkAndGetDataSourceQueriable(ctx, s.store, s.licenseService, database, resolvedDataSourceID)
+	if err != nil {
+		return nil, err
+	}
+	driver, err := s.dbFactory.GetDataSourceDriver(ctx, instance, dataSource, db.ConnectionContext{
+		DatabaseName: database.DatabaseName,
+		DataShare:    database.Metadata.GetDatashare(),
+		ReadOnly:     dataSource.GetType() == storepb.DataSourceType_READ_ONLY,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to get database driver: %v", err))
+	}
+	defer driver.Close(ctx)
+	var conn *sql.Conn
+	if sqlDB := driver.GetDB(); sqlDB != nil {
+		conn, err = sqlDB.Conn(ctx)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to get database connection: %v", err))
+		}
+		defer conn.Close()
+	}
+
+	// Read one more row than the maximum so that truncated tables are rejected instead of joined partially.
+	queryRestriction := getEffectiveQueryDataPolicy(ctx, s.store, s.licenseService, 0, database.ProjectID)
+	maximumRows := federatedMaximumRows
+	if queryRestriction.MaximumResultRows > 0 {
+		maximumRows = min(maximumRows, int(queryRestriction.MaximumResultRows))
+	}
+	queryContext := db.QueryContext{
+		Limit:                maximumRows + 1,
+		OperatorEmail:        user.Email,
+		MaximumSQLResultSize: queryRestriction.MaximumResultSize,
+	}
+	if queryRestriction.MaxQueryTimeoutInSeconds > 0 {
+		queryContext.Timeout = &durationpb.Duration{Seconds: queryRestriction.MaxQueryTimeoutInSeconds}
+	}
+	rowFilters, err := newRowFilterApplier(ctx, s.store, user, instance, database)
+	if err != nil {
+		return nil, err
+	}
+	results, _, _, err := queryRetryStopOnError(
+		ctx,
+		s.store,
+		user,
+		instance,
+		database,
+		rowFilters.wrap(newQueryExecuteFunc(driver, conn)),
+		statement,
+		queryContext,
+		s.licenseService,
+		s.accessCheck,
+		s.schemaSyncer,
+	)
+	if err != nil {
+		if _, ok := err.(*connect.Error); ok {
+			return nil, err
+		}
+		var qe *queryError
+		if errors.As(err, &qe) {
+			return nil, connect.NewError(connect.CodePermissionDenied, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to read %s", federatedTableName(reference)))
+	}
+	if len(results) != 1 {
+		return nil, connect.NewError(connect.CodeInternal, errors.Errorf("expect one result for %s, got %d", federatedTableName(reference), len(results)))
+	}
+	if results[0].Error != "" {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.Errorf("failed to read %s: %s", federatedTableName(reference), results[0].Error))
+	}
+	if len(results[0].Rows) > maximumRows {
+		return nil, connect.NewError(connect.CodeResourceExhausted, errors.Errorf("%s exceeds the maximum of %d rows, filter it by its columns in the WHERE clause to read fewer rows", federatedTableName(reference), maximumRows))
+	}
+	return results[0], nil
+}
+
+// getFederatedTableStatement returns the statement reading the columns of the referenced table in its database.
+func getFederatedTableStatement(engine storepb.Engine, reference parserbase.FederatedReference, columns []string, predicates []parserbase.FederatedPredicate) (string, error) {
+	var quote func(string) string
+	switch engine {
+	case storepb.Engine_MYSQL, storepb.Engine_TIDB:
+		quote = func(s string) string { return fmt.Sprintf("`%s`", strings.ReplaceAll(s, "`", "``")) }
+	case storepb.Engine_POSTGRES, storepb.Engine_ORACLE, storepb.Engine_SNOWFLAKE:
+		quote = func(s string) string { return fmt.Sprintf(`"%s"`, strings.ReplaceAll(s, `"`, `""`)) }
+	case storepb.Engine_MSSQL:
+		quote = func(s string) string { return fmt.Sprintf("[%s]", strings.ReplaceAll(s, "]", "]]")) }
+	default:
+		return "", errors.Errorf("engine %s is not supported in federated query", engine)
+	}
+	table := quote(reference.Table)
+	if reference.Schema != "" {
+		table = fmt.Sprintf("%s.%s", quote(reference.Schema), table)
+	}
+	selectList := "*"
+	if len(columns) > 0 {
+		var quotedColumns []string
+		for _, column := range columns {
+			quotedColumns = append(quotedColumns, quote(column))
+		}
+		selectList = strings.Join(quotedColumns, ", ")
+	}
+	statement := fmt.Sprintf("SELECT %s FROM %s", selectList, table)
+	var conditions []string
+	for _, predicate := range predicates {
+		if predicate.Operator == "IN" {
+			conditions = append(conditions, fmt.Sprintf("%s IN (%s)", quote(predicate.Column), strings.Join(predicate.Values, ", ")))
+		} else {
+			conditions = append(conditions, fmt.Sprintf("%s %s %s", quote(predicate.Column), predicate.Operator, predicate.Values[0]))
+		}
+	}
+	if len(conditions) > 0 {
+		statement += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	return statement, nil
+}
+
+// federatedDatabaseName and federatedSchemaName name the embedded engine in the query span of the federated statement.
+const (
+	federatedDatabaseName = "federated"
+	federatedSchemaName   = "public"
+)
+
+// setFederatedColumns sets the columns read from the tables to the source columns of the statement's query span.
+// The statement is analyzed as Postgres, whose syntax SQLite mostly shares. All columns are read if the span
+// cannot be derived, e.g. some tables are not synced or the statement uses SQLite-only syntax.
+// The span does not cover every clause, e.g. join conditions, so the columns named in the statement are read too.
+func setFederatedColumns(ctx context.Context, tables []*federatedTable, statement string) {
+	schema := &storepb.SchemaMetadata{Name: federatedSchemaName}
+	for _, table := range tables {
+		if len(table.metadata.GetColumns()) == 0 {
+			return
+		}
+		schema.Tables = append(schema.Tables, &storepb.TableMetadata{Name: table.name, Columns: table.metadata.GetColumns()})
+	}
+	dbMetadata := model.NewDatabaseMetadata(&storepb.DatabaseSchemaMetadata{Name: federatedDatabaseName, Schemas: []*storepb.SchemaMetadata{schema}}, nil, nil, storepb.Engine_POSTGRES, false)
+	statements, err := parserbase.SplitMultiSQL(storepb.Engine_POSTGRES, statement)
+	if err != nil {
+		slog.Debug("failed to split federated statement", log.BBError(err))
+		return
+	}
+	spans, err := parserbase.GetQuerySpan(
+		ctx,
+		parserbase.GetQuerySpanContext{
+			GetDatabaseMetadataFunc: func(context.Context, string, string) (string, *model.DatabaseMetadata, error) {
+				return federatedDatabaseName, dbMetadata, nil
+			},
+			ListDatabaseNamesFunc: func(context.Context, string) ([]string, error) {
+				return []string{federatedDatabaseName}, nil
+			},
+		},
+		storepb.Engine_POSTGRES,
+		statements,
+		federatedDatabaseName,
+		federatedSchemaName,
+		true,
+	)
+	if err != nil || len(spans) != 1 || spans[0].NotFoundError != nil || spans[0].FunctionNotSupportedError != nil {
+		slog.Debug("failed to get federated query span", log.BBError(err))
+		return
+	}
+
+	sourceColumns, _ := parserbase.MergeSourceColumnSet(spans[0].SourceColumns, spans[0].PredicateColumns)
+	for _, result := range spans[0].Results {
+		sourceColumns, _ = parserbase.MergeSourceColumnSet(sourceColumns, result.SourceColumns)
+	}
+	identifiers := make(map[string]bool)
+	for _, identifier := range parserbase.ExtractFederatedIdentifiers(statement) {
+		identifiers[strings.ToLower(identifier)] = true
+	}
+	for _, table := range tables {
+		for _, column := range table.metadata.GetColumns() {
+			read := identifiers[strings.ToLower(column.Name)]
+			for sourceColumn := range sourceColumns {
+				if read {
+					break
+				}
+				read = strings.EqualFold(sourceColumn.Table, table.name) && strings.EqualFold(sourceColumn.Column, column.Name)
+			}
+			if read {
+				table.columns = append(table.columns, column.Name)
+			}
+		}
+		// The rows are still needed if no column is, e.g. for COUNT(*).
+		if len(table.columns) == 0 {
+			table.columns = []string{table.metadata.GetColumns()[0].Name}
+		}
+	}
+}
+
+// setFederatedPredicates sets the predicates pushed down to the tables.
+// Only the tables referenced once are filtered, and only by the columns of their synced metadata,
+// since SQLite also resolves the names of the result columns in the WHERE clause.
+func setFederatedPredicates(tables []*federatedTable, predicates []parserbase.FederatedPredicate) {
+	for _, table := range tables {
+		if len(table.references) != 1 {
+			continue
+		}
+		for _, predicate := range predicates {
+			if predicate.Reference != table.references[0] {
+				continue
+			}
+			for _, column := range table.metadata.GetColumns() {
+				if strings.EqualFold(column.Name, predicate.Column) {
+					predicate.Column = column.Name
+					table.predicates = append(table.predicates, predicate)
+					break
+				}
+			}
+		}
+	}
+}
+
+func federatedTableName(reference parserbase.FederatedReference) string {
+	parts := []string{reference.Instance, reference.Database}
+	if reference.Schema != "" {
+		parts = append(parts, reference.Schema)
+	}
+	return strings.Join(append(parts, reference.Table), ".")
+}
+
+func quoteSQLiteIdentifier(name string) string {
+	return fmt.Sprintf(`"%s"`, strings.ReplaceAll(name, `"`, `""`))
+}
+
+// executeFederatedStatement loads the tables into an in-memory SQLite and executes the statement.
+func executeFederatedStatement(ctx context.Context, tables []*federatedTable, statement string, limit int, maximumSize int64) (*v1pb.QueryResult, error) {
+	sqliteDB, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open embedded engine")
+	}
+	defer sqliteDB.Close()
+	// Each connection has its own in-memory database.
+	sqliteDB.SetMaxOpenConns(1)
+	conn, err := sqliteDB.Conn(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open embedded engine")
+	}
+	defer conn.Close()
+
+	for _, table := range tables {
+		if err := loadFederatedTable(ctx, conn, table); err != nil {
+			return nil, err
+		}
+	}
+	// Only allow reading the loaded tables, so that the statement cannot modify the tables, attach files or load extensions.
+	if err := conn.Raw(func(driverConn any) error {
+		sqliteConn, ok := driverConn.(*sqlite3.SQLiteConn)
+		if !ok {
+			return errors.Errorf("unexpected embedded engine connection %T", driverConn)
+		}
+		sqliteConn.RegisterAuthorizer(authorizeFederatedStatement)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	rows, err := conn.QueryContext(ctx, statement)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	result, err := util.RowsToQueryResult(rows, makeFederatedValue, convertFederatedValue, maximumSize)
+	if err != nil {
+		return nil, err
+	}
+	if limit > 0 && len(result.Rows) > limit {
+		result.Rows = result.Rows[:limit]
+		result.RowsCount = int64(limit)
+	}
+	return result, nil
+}
+
+// sqliteRecursive is the SQLITE_RECURSIVE authorizer action code for recursive common table expressions.
+const sqliteRecursive = 33
+
+func authorizeFederatedStatement(action int, _, arg2, _ string) int {
+	switch action {
+	case sqlite3.SQLITE_SELECT, sqlite3.SQLITE_READ, sqliteRecursive:
+		return sqlite3.SQLITE_OK
+	case sqlite3.SQLITE_FUNCTION:
+		// arg2 is the function name.
+		if strings.EqualFold(arg2, "load_extension") {
+			return sqlite3.SQLITE_DENY
+		}
+		return sqlite3.SQLITE_OK
+	default:
+		return sqlite3.SQLITE_DENY
+	}
+}
+
+func loadFederatedTable(ctx context.Context, conn *sql.Conn, table *federatedTable) error {
+	columns := table.result.ColumnNames
+	if len(columns) == 0 {
+		return errors.Errorf("%s has no columns", table.name)
+	}
+	var quotedColumns, placeholders []string
+	for _, column := range columns {
+		quotedColumns = append(quotedColumns, quoteSQLiteIdentifier(column))
+		placeholders = append(placeholders, "?")
+	}
+	if _, err := conn.ExecContext(ctx, fmt.Sprintf("CREATE TABLE %s (%s)", quoteSQLiteIdentifier(table.name), strings.Join(quotedColumns, ", "))); err != nil {
+		return errors.Wrapf(err, "failed to create table %s", table.name)
+	}
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	stmt, err := tx.PrepareContext(ctx, fmt.Sprintf("INSERT INTO %s VALUES (%s)", quoteSQLiteIdentifier(table.name), strings.Join(placeholders, ", ")))
+	if err != nil {
+		return errors.Wrapf(err, "failed to prepare insert into %s", table.name)
+	}
+	defer stmt.Close()
+	for _, row := range table.result.Rows {
+		args := make([]any, len(columns))
+		for i := range columns {
+			if i < len(row.Values) {
+				args[i] = convertRowValueToFederatedArg(row.Values[i])
+			}
+		}
+		if _, err := stmt.ExecContext(ctx, args...); err != nil {
+			return errors.Wrapf(err, "failed to load table %s", table.name)
+		}
+	}
+	return tx.Commit()
+}
+
+// convertRowValueToFederatedArg converts the row value to the SQLite argument.
+func convertRowValueToFederatedArg(value *v1pb.RowValue) any {
+	switch v := value.GetKind().(type) {
+	case *v1pb.RowValue_BoolValue:
+		return v.BoolValue
+	case *v1pb.RowValue_BytesValue:
+		return v.BytesValue
+	case *v1pb.RowValue_DoubleValue:
+		return v.DoubleValue
+	case *v1pb.RowValue_FloatValue:
+		return float64(v.FloatValue)
+	case *v1pb.RowValue_Int32Value:
+		return int64(v.Int32Value)
+	case *v1pb.RowValue_Int64Value:
+		return v.Int64Value
+	case *v1pb.RowValue_StringValue:
+		return v.StringValue
+	case *v1pb.RowValue_Uint32Value:
+		return int64(v.Uint32Value)
+	case *v1pb.RowValue_Uint64Value:
+		if v.Uint64Value > math.MaxInt64 {
+			return fmt.Sprintf("%d", v.Uint64Value)
+		}
+		return int64(v.Uint64Value)
+	case *v1pb.RowValue_ValueValue:
+		b, err := protojson.Marshal(v.ValueValue)
+		if err != nil {
+			return nil
+		}
+		return string(b)
+	case *v1pb.RowValue_TimestampValue:
+		return v.TimestampValue.GetGoogleTimestamp().AsTime().Format("2006-01-02 15:04:05.999999999")
+	case *v1pb.RowValue_TimestampTzValue:
+		t := v.TimestampTzValue.GetGoogleTimestamp().AsTime()
+		return t.In(time.FixedZone(v.TimestampTzValue.GetZone(), int(v.TimestampTzValue.GetOffset()))).Format(time.RFC3339Nano)
+	default:
+		return nil
+	}
+}
+
+// makeFederatedValue scans any value since SQLite columns are dynamically typed.
+func makeFederatedValue(string, *sql.ColumnType) any {
+	return new(any)
+}
+
+func convertFederatedValue(_ string, _ *sql.ColumnType, value any) *v1pb.RowValue {
+	raw, ok := value.(*any)
+	if !ok {
+		return util.NullRowValue
+	}
+	switch v := (*raw).(type) {
+	case int64:
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_Int64Value{Int64Value: v}}
+	case float64:
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_DoubleValue{DoubleValue: v}}
+	case bool:
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_BoolValue{BoolValue: v}}
+	case string:
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: v}}
+	case []byte:
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_BytesValue{BytesValue: v}}
+	case time.Time:
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: v.Format(time.RFC3339Nano)}}
+	default:
+		return util.NullRowValue
+	}
+}
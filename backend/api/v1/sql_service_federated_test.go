@@ -0,0 +1,138 @@
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+	parserbase "github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func TestExecuteFederatedStatement(t *testing.T) {
+	a := require.New(t)
+	stringValue := func(s string) *v1pb.RowValue {
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: s}}
+	}
+	int64Value := func(i int64) *v1pb.RowValue {
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_Int64Value{Int64Value: i}}
+	}
+	tables := []*federatedTable{
+		{
+			name: "pg.shop.public.orders",
+			result: &v1pb.QueryResult{
+				ColumnNames: []string{"id", "customer_id"},
+				Rows: []*v1pb.QueryRow{
+					{Values: []*v1pb.RowValue{int64Value(1), int64Value(10)}},
+					{Values: []*v1pb.RowValue{int64Value(2), int64Value(20)}},
+					{Values: []*v1pb.RowValue{int64Value(3), int64Value(10)}},
+				},
+			},
+		},
+		{
+			name: "mysql.crm.customers",
+			result: &v1pb.QueryResult{
+				ColumnNames: []string{"id", "email"},
+				Rows: []*v1pb.QueryRow{
+					{Values: []*v1pb.RowValue{int64Value(10), stringValue("******")}},
+					{Values: []*v1pb.RowValue{int64Value(20), stringValue("b@example.com")}},
+				},
+			},
+		},
+	}
+
+	statement := `SELECT o.id, c.email FROM "pg.shop.public.orders" o JOIN "mysql.crm.customers" c ON o.customer_id = c.id ORDER BY o.id`
+	result, err := executeFederatedStatement(context.Background(), tables, statement, 2, 1024*1024)
+	a.NoError(err)
+	a.Equal([]string{"id", "email"}, result.ColumnNames)
+	a.Len(result.Rows, 2)
+	a.Equal(int64(1), result.Rows[0].Values[0].GetInt64Value())
+	a.Equal("******", result.Rows[0].Values[1].GetStringValue())
+	a.Equal("b@example.com", result.Rows[1].Values[1].GetStringValue())
+
+	// The statement can only read the loaded tables.
+	for _, statement := range []string{
+		`INSERT INTO "mysql.crm.customers" VALUES (30, 'c@example.com')`,
+		`ATTACH DATABASE 'file.db' AS f`,
+		`SELECT load_extension('x')`,
+		`PRAGMA table_info("mysql.crm.customers")`,
+	} {
+		_, err := executeFederatedStatement(context.Background(), tables, statement, 0, 1024*1024)
+		a.Error(err, statement)
+	}
+}
+
+func TestGetFederatedTableStatement(t *testing.T) {
+	a := require.New(t)
+	statement, err := getFederatedTableStatement(storepb.Engine_POSTGRES, parserbase.FederatedReference{Schema: "public", Table: `a"b`}, nil, nil)
+	a.NoError(err)
+	a.Equal(`SELECT * FROM "public"."a""b"`, statement)
+	statement, err = getFederatedTableStatement(storepb.Engine_MYSQL, parserbase.FederatedReference{Table: "t"}, []string{"id", "name"}, []parserbase.FederatedPredicate{
+		{Column: "id", Operator: ">=", Values: []string{"10"}},
+		{Column: "name", Operator: "IN", Values: []string{"'a'", "'b'"}},
+	})
+	a.NoError(err)
+	a.Equal("SELECT `id`, `name` FROM `t` WHERE `id` >= 10 AND `name` IN ('a', 'b')", statement)
+	_, err = getFederatedTableStatement(storepb.Engine_REDIS, parserbase.FederatedReference{Table: "t"}, nil, nil)
+	a.Error(err)
+}
+
+func TestSetFederatedColumns(t *testing.T) {
+	a := require.New(t)
+	columns := func(names ...string) []*storepb.ColumnMetadata {
+		var result []*storepb.ColumnMetadata
+		for _, name := range names {
+			result = append(result, &storepb.ColumnMetadata{Name: name, Type: "text"})
+		}
+		return result
+	}
+	newTables := func() []*federatedTable {
+		return []*federatedTable{
+			{name: "pg.shop.public.orders", metadata: &storepb.TableMetadata{Name: "orders", Columns: columns("id", "customer_id", "amount", "note")}},
+			{name: "mysql.crm.customers", metadata: &storepb.TableMetadata{Name: "customers", Columns: columns("id", "Email", "phone")}},
+		}
+	}
+
+	tables := newTables()
+	setFederatedColumns(context.Background(), tables, `SELECT o.id, c.email FROM "pg.shop.public.orders" o JOIN "mysql.crm.customers" c ON o.customer_id = c.id WHERE o.amount > 10 ORDER BY o.id`)
+	a.Equal([]string{"id", "customer_id", "amount"}, tables[0].columns)
+	a.Equal([]string{"id", "Email"}, tables[1].columns)
+
+	// The columns expanded from the asterisk are read.
+	tables = newTables()
+	setFederatedColumns(context.Background(), tables, `SELECT c.* FROM "pg.shop.public.orders" o JOIN "mysql.crm.customers" c ON o.customer_id = c.id`)
+	a.Equal([]string{"id", "customer_id"}, tables[0].columns)
+	a.Equal([]string{"id", "Email", "phone"}, tables[1].columns)
+
+	// The rows are read for counting even if no column is.
+	tables = newTables()
+	setFederatedColumns(context.Background(), tables, `SELECT count(*) FROM "pg.shop.public.orders" o, "mysql.crm.customers" c WHERE c.phone = '1'`)
+	a.Equal([]string{"id"}, tables[0].columns)
+	a.Equal([]string{"phone"}, tables[1].columns)
+
+	// All columns are read if the table is not synced.
+	tables = newTables()
+	tables[1].metadata = nil
+	setFederatedColumns(context.Background(), tables, `SELECT o.id FROM "pg.shop.public.orders" o JOIN "mysql.crm.customers" c ON o.customer_id = c.id`)
+	a.Empty(tables[0].columns)
+	a.Empty(tables[1].columns)
+}
+
+func TestSetFederatedPredicates(t *testing.T) {
+	a := require.New(t)
+	tables := []*federatedTable{
+		{references: []int{0}, metadata: &storepb.TableMetadata{Columns: []*storepb.ColumnMetadata{{Name: "Amount"}}}},
+		{references: []int{1, 2}, metadata: &storepb.TableMetadata{Columns: []*storepb.ColumnMetadata{{Name: "id"}}}},
+	}
+	setFederatedPredicates(tables, []parserbase.FederatedPredicate{
+		{Reference: 0, Column: "amount", Operator: ">", Values: []string{"1"}},
+		// Result column names are not pushed down.
+		{Reference: 0, Column: "total", Operator: ">", Values: []string{"1"}},
+		// Tables referenced more than once are not filtered.
+		{Reference: 1, Column: "id", Operator: "=", Values: []string{"1"}},
+	})
+	a.Equal([]parserbase.FederatedPredicate{{Reference: 0, Column: "Amount", Operator: ">", Values: []string{"1"}}}, tables[0].predicates)
+	a.Empty(tables[1].predicates)
+}
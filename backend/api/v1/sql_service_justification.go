@@ -0,0 +1,90 @@
+package v1
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+// checkQueryJustification checks that the justification is provided if the database contains data
+// at or above the justification classification level of the query data policy.
+func (s *SQLService) checkQueryJustification(ctx context.Context, database *store.DatabaseMessage, justification *v1pb.QueryJustification) error {
+	queryRestriction := getEffectiveQueryDataPolicy(ctx, s.store, s.licenseService, 0, database.ProjectID)
+	if queryRestriction.JustificationClassificationLevel <= 0 {
+		return nil
+	}
+	if justification.GetReason() != "" && justification.GetTicket() != "" {
+		return nil
+	}
+
+	level, err := getDatabaseClassificationLevel(ctx, s.store, database)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get the classification level of database %q", database.DatabaseName))
+	}
+	if level < queryRestriction.JustificationClassificationLevel {
+		return nil
+	}
+	return connect.NewError(connect.CodeInvalidArgument, errors.Errorf(
+		"justification reason and ticket are required to query database %q, which contains data of classification level %d",
+		database.DatabaseName,
+		level,
+	))
+}
+
+// getDatabaseClassificationLevel returns the highest classification level of the tables and columns in the database.
+func getDatabaseClassificationLevel(ctx context.Context, stores *store.Store, database *store.DatabaseMessage) (int32, error) {
+	workspaceID := common.GetWorkspaceIDFromContext(ctx)
+	project, err := stores.GetProject(ctx, &store.FindProjectMessage{Workspace: workspaceID, ResourceID: &database.ProjectID})
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to get project %q", database.ProjectID)
+	}
+	if project == nil {
+		return 0, errors.Errorf("project %q not found", database.ProjectID)
+	}
+	setting, err := stores.GetDataClassificationSetting(ctx, workspaceID)
+	if err != nil {
+		return 0, err
+	}
+	var classificationConfig *storepb.DataClassificationSetting_DataClassificationConfig
+	for _, config := range setting.GetConfigs() {
+		if config.Id == project.Setting.GetDataClassificationConfigId() {
+			classificationConfig = config
+			break
+		}
+	}
+	if classificationConfig == nil {
+		return 0, nil
+	}
+
+	dbSchema, err := stores.GetDBSchema(ctx, &store.FindDBSchemaMessage{
+		Workspace:    workspaceID,
+		InstanceID:   database.InstanceID,
+		DatabaseName: database.DatabaseName,
+	})
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to get database schema")
+	}
+	if dbSchema == nil {
+		return 0, nil
+	}
+	return getMaximumClassificationLevel(dbSchema.GetConfig(), classificationConfig), nil
+}
+
+func getMaximumClassificationLevel(config *storepb.DatabaseConfig, classificationConfig *storepb.DataClassificationSetting_DataClassificationConfig) int32 {
+	var level int32
+	for _, schema := range config.GetSchemas() {
+		for _, table := range schema.GetTables() {
+			level = max(level, getClassificationLevelOfColumn(table.GetClassification(), classificationConfig))
+			for _, column := range table.GetColumns() {
+				level = max(level, getClassificationLevelOfColumn(column.GetClassification(), classificationConfig))
+			}
+		}
+	}
+	return level
+}
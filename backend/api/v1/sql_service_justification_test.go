@@ -0,0 +1,51 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+func TestGetMaximumClassificationLevel(t *testing.T) {
+	a := require.New(t)
+	classificationConfig := &storepb.DataClassificationSetting_DataClassificationConfig{
+		Classification: map[string]*storepb.DataClassificationSetting_DataClassificationConfig_DataClassification{
+			"1":     {Id: "1", Title: "personal"},
+			"1-1":   {Id: "1-1", Title: "contact", Level: new(int32(1))},
+			"1-1-1": {Id: "1-1-1", Title: "address", Level: new(int32(3))},
+			"1-2":   {Id: "1-2", Title: "identity", Level: new(int32(2))},
+		},
+	}
+
+	a.Equal(int32(0), getMaximumClassificationLevel(nil, classificationConfig))
+
+	config := &storepb.DatabaseConfig{
+		Schemas: []*storepb.SchemaCatalog{
+			{
+				Tables: []*storepb.TableCatalog{
+					{
+						Name:           "users",
+						Classification: "1-2",
+						Columns: []*storepb.ColumnCatalog{
+							{Name: "id"},
+							{Name: "email", Classification: "1-1"},
+						},
+					},
+				},
+			},
+		},
+	}
+	a.Equal(int32(2), getMaximumClassificationLevel(config, classificationConfig))
+
+	config.Schemas[0].Tables = append(config.Schemas[0].Tables, &storepb.TableCatalog{
+		Name: "addresses",
+		Columns: []*storepb.ColumnCatalog{
+			{Name: "street", Classification: "1-1-1"},
+			{Name: "unknown", Classification: "9-9"},
+		},
+	})
+	a.Equal(int32(3), getMaximumClassificationLevel(config, classificationConfig))
+	a.Equal(int32(0), getMaximumClassificationLevel(config, nil))
+}
@@ -0,0 +1,121 @@
+package v1
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+	parserbase "github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+// bindWorksheetParameters replaces the worksheet parameter references in the statement with the driver placeholders,
+// and returns the bound statement with the typed parameter values.
+// The values are never concatenated into the statement, so the access check and masking see the statement as is.
+func (s *SQLService) bindWorksheetParameters(ctx context.Context, request *v1pb.QueryRequest, engine storepb.Engine, statement string) (string, []any, error) {
+	if request.Worksheet == "" {
+		return "", nil, connect.NewError(connect.CodeInvalidArgument, errors.New("worksheet is required for parameters"))
+	}
+	projectID, worksheetID, err := common.GetProjectIDWorksheetID(request.Worksheet)
+	if err != nil {
+		return "", nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	user, ok := GetUserFromContext(ctx)
+	if !ok {
+		return "", nil, connect.NewError(connect.CodeInternal, errors.New("user not found"))
+	}
+	worksheet, err := s.store.GetWorkSheet(ctx, &store.FindWorkSheetMessage{
+		ProjectIDs:     []string{projectID},
+		ResourceID:     &worksheetID,
+		PrincipalEmail: user.Email,
+	})
+	if err != nil {
+		return "", nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get worksheet"))
+	}
+	if worksheet == nil {
+		return "", nil, connect.NewError(connect.CodeNotFound, errors.Errorf("worksheet %q not found", request.Worksheet))
+	}
+	ok, err = canReadWorksheet(ctx, s.store, s.iamManager, worksheet)
+	if err != nil {
+		return "", nil, err
+	}
+	if !ok {
+		return "", nil, connect.NewError(connect.CodePermissionDenied, errors.Errorf("cannot access worksheet %s", worksheet.Title))
+	}
+
+	statements, err := parserbase.SplitMultiSQL(engine, statement)
+	if err != nil {
+		return "", nil, connect.NewError(connect.CodeInvalidArgument, errors.Wrapf(err, "failed to split statement"))
+	}
+	if len(parserbase.FilterEmptyStatements(statements)) != 1 {
+		return "", nil, connect.NewError(connect.CodeInvalidArgument, errors.New("parameterized query must be a single statement"))
+	}
+	bound, names, err := parserbase.BindParameters(engine, statement)
+	if err != nil {
+		return "", nil, connect.NewError(connect.CodeInvalidArgument, errors.Wrapf(err, "failed to bind parameters"))
+	}
+
+	definitions := make(map[string]*storepb.WorksheetParameter)
+	for _, parameter := range worksheet.Payload.GetParameters() {
+		definitions[parameter.Name] = parameter
+	}
+	for name := range request.Parameters {
+		if definitions[name] == nil {
+			return "", nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("parameter %q is not defined in worksheet", name))
+		}
+	}
+	var values []any
+	for _, name := range names {
+		definition := definitions[name]
+		if definition == nil {
+			return "", nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("parameter %q is not defined in worksheet", name))
+		}
+		value, ok := request.Parameters[name]
+		if !ok {
+			if definition.DefaultValue == "" {
+				return "", nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("parameter %q is required", name))
+			}
+			value = definition.DefaultValue
+		}
+		v, err := convertWorksheetParameterValue(definition, value)
+		if err != nil {
+			return "", nil, connect.NewError(connect.CodeInvalidArgument, errors.Wrapf(err, "invalid value for parameter %q", name))
+		}
+		values = append(values, v)
+	}
+	return bound, values, nil
+}
+
+// convertWorksheetParameterValue converts the value to the driver argument of the parameter type.
+func convertWorksheetParameterValue(parameter *storepb.WorksheetParameter, value string) (any, error) {
+	switch parameter.Type {
+	case storepb.WorksheetParameter_STRING:
+		return value, nil
+	case storepb.WorksheetParameter_INT:
+		v, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, errors.Errorf("%q is not an integer", value)
+		}
+		return v, nil
+	case storepb.WorksheetParameter_DATE:
+		v, err := time.Parse(time.DateOnly, value)
+		if err != nil {
+			return nil, errors.Errorf("%q is not a date in YYYY-MM-DD format", value)
+		}
+		return v, nil
+	case storepb.WorksheetParameter_ENUM:
+		if !slices.Contains(parameter.AllowedValues, value) {
+			return nil, errors.Errorf("%q is not one of the allowed values", value)
+		}
+		return value, nil
+	default:
+		return nil, errors.Errorf("unsupported parameter type %q", parameter.Type)
+	}
+}
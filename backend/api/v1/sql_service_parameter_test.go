@@ -0,0 +1,114 @@
+package v1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+)
+
+func TestConvertWorksheetParameterValue(t *testing.T) {
+	tests := []struct {
+		parameter *storepb.WorksheetParameter
+		value     string
+		want      any
+		wantErr   bool
+	}{
+		{
+			parameter: &storepb.WorksheetParameter{Type: storepb.WorksheetParameter_STRING},
+			value:     "'; DROP TABLE t; --",
+			want:      "'; DROP TABLE t; --",
+		},
+		{
+			parameter: &storepb.WorksheetParameter{Type: storepb.WorksheetParameter_INT},
+			value:     "42",
+			want:      int64(42),
+		},
+		{
+			parameter: &storepb.WorksheetParameter{Type: storepb.WorksheetParameter_INT},
+			value:     "1 OR 1=1",
+			wantErr:   true,
+		},
+		{
+			parameter: &storepb.WorksheetParameter{Type: storepb.WorksheetParameter_DATE},
+			value:     "2024-02-29",
+			want:      time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			parameter: &storepb.WorksheetParameter{Type: storepb.WorksheetParameter_DATE},
+			value:     "02/29/2024",
+			wantErr:   true,
+		},
+		{
+			parameter: &storepb.WorksheetParameter{Type: storepb.WorksheetParameter_ENUM, AllowedValues: []string{"open", "closed"}},
+			value:     "open",
+			want:      "open",
+		},
+		{
+			parameter: &storepb.WorksheetParameter{Type: storepb.WorksheetParameter_ENUM, AllowedValues: []string{"open", "closed"}},
+			value:     "pending",
+			wantErr:   true,
+		},
+	}
+
+	a := require.New(t)
+	for _, tc := range tests {
+		got, err := convertWorksheetParameterValue(tc.parameter, tc.value)
+		if tc.wantErr {
+			a.Error(err, tc.value)
+			continue
+		}
+		a.NoError(err, tc.value)
+		a.Equal(tc.want, got)
+	}
+}
+
+func TestValidateWorksheetParameters(t *testing.T) {
+	tests := []struct {
+		parameters []*v1pb.WorksheetParameter
+		wantErr    bool
+	}{
+		{
+			parameters: []*v1pb.WorksheetParameter{
+				{Name: "id", Type: v1pb.WorksheetParameter_INT},
+				{Name: "status", Type: v1pb.WorksheetParameter_ENUM, AllowedValues: []string{"open", "closed"}, DefaultValue: "open"},
+			},
+		},
+		{
+			parameters: []*v1pb.WorksheetParameter{{Name: "1id", Type: v1pb.WorksheetParameter_INT}},
+			wantErr:    true,
+		},
+		{
+			parameters: []*v1pb.WorksheetParameter{
+				{Name: "id", Type: v1pb.WorksheetParameter_INT},
+				{Name: "id", Type: v1pb.WorksheetParameter_STRING},
+			},
+			wantErr: true,
+		},
+		{
+			parameters: []*v1pb.WorksheetParameter{{Name: "id"}},
+			wantErr:    true,
+		},
+		{
+			parameters: []*v1pb.WorksheetParameter{{Name: "status", Type: v1pb.WorksheetParameter_ENUM}},
+			wantErr:    true,
+		},
+		{
+			parameters: []*v1pb.WorksheetParameter{{Name: "id", Type: v1pb.WorksheetParameter_INT, DefaultValue: "abc"}},
+			wantErr:    true,
+		},
+	}
+
+	a := require.New(t)
+	for i, tc := range tests {
+		err := validateWorksheetParameters(tc.parameters)
+		if tc.wantErr {
+			a.Error(err, "test case %d", i)
+		} else {
+			a.NoError(err, "test case %d", i)
+		}
+	}
+}
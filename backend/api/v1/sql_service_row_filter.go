@@ -0,0 +1,586 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/cel-go/cel"
+	celast "github.com/google/cel-go/common/ast"
+	celtypes "github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/google/cel-go/parser"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	parserbase "github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/store/model"
+	"github.com/bytebase/bytebase/backend/utils"
+)
+
+// rowFilterComparisonOperators maps the CEL comparison functions to SQL operators.
+var rowFilterComparisonOperators = map[string]string{
+	"_==_": "=",
+	"_!=_": "<>",
+	"_<_":  "<",
+	"_<=_": "<=",
+	"_>_":  ">",
+	"_>=_": ">=",
+}
+
+// rowFilter is a row filter of the policy that applies to the user.
+type rowFilter struct {
+	policy *storepb.RowFilterPolicy_RowFilter
+	// condition is nil if the filter applies to all tables with the columns.
+	condition cel.Program
+	// columns are the row columns referenced by the expression.
+	columns []string
+	// predicate is the SQL predicate translated from the expression for the user.
+	predicate string
+}
+
+// rowFilterApplier applies the row filter policy of the project to the queries of a user.
+type rowFilterApplier struct {
+	stores   *store.Store
+	instance *store.InstanceMessage
+	database *store.DatabaseMessage
+	filters  []*rowFilter
+}
+
+// newRowFilterApplier returns the row filter applier for the user on the database.
+// It returns nil if no row filter applies to the user.
+func newRowFilterApplier(ctx context.Context, stores *store.Store, user *store.UserMessage, instance *store.InstanceMessage, database *store.DatabaseMessage) (*rowFilterApplier, error) {
+	workspaceID := common.GetWorkspaceIDFromContext(ctx)
+	policy, err := stores.GetRowFilterPolicyByProject(ctx, workspaceID, database.ProjectID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get row filter policy"))
+	}
+	if len(policy.Filters) == 0 {
+		return nil, nil
+	}
+
+	projectPolicy, err := stores.GetProjectIamPolicy(ctx, workspaceID, database.ProjectID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get project iam policy"))
+	}
+	workspacePolicy, err := stores.GetWorkspaceIamPolicy(ctx, workspaceID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get workspace iam policy"))
+	}
+	roles := utils.GetUserRolesInIamPolicy(ctx, stores, workspaceID, user, projectPolicy.Policy, workspacePolicy.Policy)
+	groups, err := stores.GetUserGroupsSnapshot(ctx, workspaceID, common.FormatUserEmail(user.Email))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get user groups"))
+	}
+	var memberGroups []string
+	for _, group := range groups {
+		memberGroups = append(memberGroups, convertToV1MemberInBinding(group))
+	}
+	activation := map[string]any{
+		common.CELAttributeUserEmail:  user.Email,
+		common.CELAttributeUserName:   user.Name,
+		common.CELAttributeUserGroups: memberGroups,
+		common.CELAttributeUserRoles:  roles,
+	}
+
+	applier := &rowFilterApplier{
+		stores:   stores,
+		instance: instance,
+		database: database,
+	}
+	for _, filter := range policy.Filters {
+		if !rowFilterAppliesToUser(ctx, stores, workspaceID, filter, user, roles) {
+			continue
+		}
+		condition, err := common.ValidateRowFilterConditionCELExpr(filter.Condition)
+		if err != nil {
+			return nil, connect.NewError(connect.CodePermissionDenied, errors.Wrapf(err, "invalid condition of row filter %q", filter.Id))
+		}
+		predicate, columns, err := translateRowFilterExpression(filter.GetExpression().GetExpression(), instance.Metadata.GetEngine(), activation)
+		if err != nil {
+			return nil, connect.NewError(connect.CodePermissionDenied, errors.Wrapf(err, "invalid expression of row filter %q", filter.Id))
+		}
+		applier.filters = append(applier.filters, &rowFilter{
+			policy:    filter,
+			condition: condition,
+			columns:   columns,
+			predicate: predicate,
+		})
+	}
+	if len(applier.filters) == 0 {
+		return nil, nil
+	}
+	return applier, nil
+}
+
+func rowFilterAppliesToUser(ctx context.Context, stores *store.Store, workspaceID string, filter *storepb.RowFilterPolicy_RowFilter, user *store.UserMessage, roles []string) bool {
+	for _, member := range filter.Members {
+		if utils.MemberContainsUser(ctx, stores, workspaceID, member, user) {
+			return true
+		}
+	}
+	for _, role := range filter.Roles {
+		if slices.Contains(roles, role) {
+			return true
+		}
+	}
+	return false
+}
+
+// wrap rewrites the statement with the row filters of the tables accessed by the statement.
+// The query is denied if a filtered table cannot be rewritten.
+func (a *rowFilterApplier) wrap(execute queryExecuteFunc) queryExecuteFunc {
+	if a == nil {
+		return execute
+	}
+	return func(ctx context.Context, statement string, spans []*parserbase.QuerySpan, queryContext db.QueryContext) ([]*v1pb.QueryResult, time.Duration, error) {
+		if queryContext.Explain {
+			return execute(ctx, statement, spans, queryContext)
+		}
+		filters, err := a.getTableRowFilters(ctx, spans)
+		if err != nil {
+			return nil, 0, err
+		}
+		if len(filters) == 0 {
+			return execute(ctx, statement, spans, queryContext)
+		}
+
+		engine := a.instance.Metadata.GetEngine()
+		if !parserbase.SupportRowFilters(engine) {
+			return nil, 0, connect.NewError(connect.CodePermissionDenied, errors.Errorf("row filters are not supported for engine %s", engine))
+		}
+		for _, span := range spans {
+			if span == nil {
+				continue
+			}
+			if span.FunctionNotSupportedError != nil {
+				return nil, 0, connect.NewError(connect.CodePermissionDenied, errors.Wrap(span.FunctionNotSupportedError, "cannot apply row filters"))
+			}
+			if span.NotFoundError != nil {
+				return nil, 0, connect.NewError(connect.CodePermissionDenied, errors.Wrap(span.NotFoundError, "cannot apply row filters"))
+			}
+		}
+		rewrite, err := parserbase.RewriteRowFilters(engine, statement, a.database.DatabaseName, queryContext.Schema, filters)
+		if err != nil {
+			return nil, 0, connect.NewError(connect.CodePermissionDenied, errors.Wrap(err, "cannot apply row filters"))
+		}
+		// The filtered tables read through views or functions are not rewritten.
+		for _, filter := range filters {
+			if !slices.Contains(rewrite.Applied, filter) {
+				return nil, 0, connect.NewError(connect.CodePermissionDenied, errors.Errorf("cannot apply row filter %q to table %q, the table must be referenced directly", filter.ID, formatRowFilterTable(filter)))
+			}
+		}
+
+		results, duration, err := execute(ctx, rewrite.Statement, spans, queryContext)
+		if err != nil {
+			return results, duration, err
+		}
+		applied := convertToAppliedRowFilters(a.filters, filters)
+		for _, result := range results {
+			result.Statement = statement
+			result.AppliedRowFilters = applied
+		}
+		return results, duration, nil
+	}
+}
+
+// getTableRowFilters returns the row filters of the tables accessed by the spans.
+func (a *rowFilterApplier) getTableRowFilters(ctx context.Context, spans []*parserbase.QuerySpan) ([]parserbase.RowFilter, error) {
+	tables := make(map[parserbase.ColumnResource]bool)
+	collect := func(columns parserbase.SourceColumnSet) {
+		for column := range columns {
+			tables[parserbase.ColumnResource{Server: column.Server, Database: column.Database, Schema: column.Schema, Table: column.Table}] = true
+		}
+	}
+	for _, span := range spans {
+		if span == nil {
+			continue
+		}
+		collect(span.SourceColumns)
+		collect(span.PredicateColumns)
+		for _, result := range span.Results {
+			collect(result.SourceColumns)
+		}
+	}
+	sortedTables := make([]parserbase.ColumnResource, 0, len(tables))
+	for table := range tables {
+		if table.Table != "" {
+			sortedTables = append(sortedTables, table)
+		}
+	}
+	slices.SortFunc(sortedTables, func(x, y parserbase.ColumnResource) int {
+		return strings.Compare(x.String(), y.String())
+	})
+
+	metadata := make(map[string]*model.DatabaseMetadata)
+	var filters []parserbase.RowFilter
+	for _, table := range sortedTables {
+		for _, filter := range a.filters {
+			ok, err := a.matchTable(ctx, filter, table, metadata)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				continue
+			}
+			filters = append(filters, parserbase.RowFilter{
+				ID:        filter.policy.Id,
+				Database:  table.Database,
+				Schema:    table.Schema,
+				Table:     table.Table,
+				Predicate: filter.predicate,
+			})
+		}
+	}
+	return filters, nil
+}
+
+// matchTable returns true if the row filter applies to the table.
+// A filter without condition applies to the tables with all the columns referenced by the expression.
+func (a *rowFilterApplier) matchTable(ctx context.Context, filter *rowFilter, table parserbase.ColumnResource, metadata map[string]*model.DatabaseMetadata) (bool, error) {
+	if filter.condition != nil {
+		out, _, err := filter.condition.Eval(map[string]any{
+			common.CELAttributeResourceInstanceID:   a.instance.ResourceID,
+			common.CELAttributeResourceDatabaseName: table.Database,
+			common.CELAttributeResourceSchemaName:   table.Schema,
+			common.CELAttributeResourceTableName:    table.Table,
+		})
+		if err != nil {
+			return false, connect.NewError(connect.CodePermissionDenied, errors.Wrapf(err, "failed to evaluate the condition of row filter %q", filter.policy.Id))
+		}
+		matched, ok := out.Value().(bool)
+		if !ok {
+			return false, connect.NewError(connect.CodePermissionDenied, errors.Errorf("the condition of row filter %q must be a boolean", filter.policy.Id))
+		}
+		return matched, nil
+	}
+
+	dbMetadata, ok := metadata[table.Database]
+	if !ok {
+		m, err := a.stores.GetDBSchema(ctx, &store.FindDBSchemaMessage{
+			Workspace:    common.GetWorkspaceIDFromContext(ctx),
+			InstanceID:   a.instance.ResourceID,
+			DatabaseName: table.Database,
+		})
+		if err != nil {
+			return false, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get database schema %q", table.Database))
+		}
+		metadata[table.Database] = m
+		dbMetadata = m
+	}
+	if dbMetadata == nil {
+		return false, connect.NewError(connect.CodePermissionDenied, errors.Errorf("cannot apply row filter %q, database schema %q not found", filter.policy.Id, table.Database))
+	}
+	schema := dbMetadata.GetSchemaMetadata(table.Schema)
+	if schema == nil {
+		return false, nil
+	}
+	tableMetadata := schema.GetTable(table.Table)
+	if tableMetadata == nil {
+		return false, nil
+	}
+	for _, column := range filter.columns {
+		if tableMetadata.GetColumn(column) == nil {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// convertToAppliedRowFilters returns the notices of the row filters applied to the tables.
+func convertToAppliedRowFilters(rowFilters []*rowFilter, filters []parserbase.RowFilter) []*v1pb.AppliedRowFilter {
+	titles := make(map[string]string)
+	for _, filter := range rowFilters {
+		titles[filter.policy.Id] = filter.policy.Title
+	}
+	var applied []*v1pb.AppliedRowFilter
+	for _, filter := range filters {
+		applied = append(applied, &v1pb.AppliedRowFilter{
+			Id:    filter.ID,
+			Title: titles[filter.ID],
+			Table: formatRowFilterTable(filter),
+		})
+	}
+	return applied
+}
+
+func formatRowFilterTable(filter parserbase.RowFilter) string {
+	if filter.Schema != "" {
+		return fmt.Sprintf("%s.%s", filter.Schema, filter.Table)
+	}
+	return fmt.Sprintf("%s.%s", filter.Database, filter.Table)
+}
+
+// validateRowFilterExpression validates that the row filter expression can be translated into SQL.
+func validateRowFilterExpression(expression string) error {
+	_, _, err := translateRowFilterExpression(expression, storepb.Engine_POSTGRES, map[string]any{
+		common.CELAttributeUserEmail:  "user@example.com",
+		common.CELAttributeUserName:   "user",
+		common.CELAttributeUserGroups: []string{},
+		common.CELAttributeUserRoles:  []string{},
+	})
+	return err
+}
+
+// translateRowFilterExpression translates the CEL expression of a row filter into a SQL predicate of the engine.
+// The sub-expressions without row columns are evaluated with the activation and rendered as SQL literals.
+// It returns the predicate and the row columns referenced by the expression.
+func translateRowFilterExpression(expression string, engine storepb.Engine, activation map[string]any) (string, []string, error) {
+	if expression == "" {
+		return "", nil, errors.New("expression is required")
+	}
+	// Macro calls are tracked so the sub-expressions with macros can be unparsed for evaluation.
+	env, err := cel.NewEnv(append(slices.Clone(common.RowFilterExpressionCELAttributes), cel.EnableMacroCallTracking())...)
+	if err != nil {
+		return "", nil, err
+	}
+	ast, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return "", nil, issues.Err()
+	}
+	if !ast.OutputType().IsExactType(celtypes.BoolType) && !ast.OutputType().IsExactType(celtypes.DynType) {
+		return "", nil, errors.Errorf("expression must be a boolean, got %s", ast.OutputType())
+	}
+	t := &rowFilterTranslator{
+		env:        env,
+		info:       ast.NativeRep().SourceInfo(),
+		engine:     engine,
+		activation: activation,
+	}
+	predicate, err := t.predicate(ast.NativeRep().Expr())
+	if err != nil {
+		return "", nil, err
+	}
+	return predicate, t.columns, nil
+}
+
+type rowFilterTranslator struct {
+	env        *cel.Env
+	info       *celast.SourceInfo
+	engine     storepb.Engine
+	activation map[string]any
+	columns    []string
+}
+
+func (t *rowFilterTranslator) predicate(e celast.Expr) (string, error) {
+	if !t.usesRow(e) {
+		val, err := t.eval(e)
+		if err != nil {
+			return "", err
+		}
+		b, ok := val.Value().(bool)
+		if !ok {
+			return "", errors.Errorf("expect boolean, got %s", val.Type())
+		}
+		if b {
+			return "TRUE", nil
+		}
+		return "FALSE", nil
+	}
+	if column, ok := t.column(e); ok {
+		return column, nil
+	}
+	if e.Kind() != celast.CallKind {
+		return "", errors.Errorf("unsupported expression kind %v", e.Kind())
+	}
+	call := e.AsCall()
+	args := call.Args()
+	switch fn := call.FunctionName(); fn {
+	case "_&&_", "_||_":
+		operator := " AND "
+		if fn == "_||_" {
+			operator = " OR "
+		}
+		var parts []string
+		for _, arg := range args {
+			part, err := t.predicate(arg)
+			if err != nil {
+				return "", err
+			}
+			parts = append(parts, part)
+		}
+		return "(" + strings.Join(parts, operator) + ")", nil
+	case "!_":
+		part, err := t.predicate(args[0])
+		if err != nil {
+			return "", err
+		}
+		return "(NOT " + part + ")", nil
+	case "@in":
+		left, err := t.operand(args[0])
+		if err != nil {
+			return "", err
+		}
+		if t.usesRow(args[1]) {
+			return "", errors.New(`the right operand of "in" cannot use row columns`)
+		}
+		val, err := t.eval(args[1])
+		if err != nil {
+			return "", err
+		}
+		values, err := val.ConvertToNative(reflect.TypeOf([]any{}))
+		if err != nil {
+			return "", errors.Errorf(`the right operand of "in" must be a list, got %s`, val.Type())
+		}
+		var items []string
+		for _, v := range values.([]any) {
+			item, err := t.literal(v)
+			if err != nil {
+				return "", err
+			}
+			items = append(items, item)
+		}
+		if len(items) == 0 {
+			return "FALSE", nil
+		}
+		return "(" + left + " IN (" + strings.Join(items, ", ") + "))", nil
+	default:
+		operator, ok := rowFilterComparisonOperators[fn]
+		if !ok {
+			return "", errors.Errorf("unsupported function %q on row columns", strings.Trim(fn, "_"))
+		}
+		left, err := t.operand(args[0])
+		if err != nil {
+			return "", err
+		}
+		right, err := t.operand(args[1])
+		if err != nil {
+			return "", err
+		}
+		if operator == "=" || operator == "<>" {
+			isNull := "IS NULL"
+			if operator == "<>" {
+				isNull = "IS NOT NULL"
+			}
+			switch {
+			case right == "NULL":
+				return "(" + left + " " + isNull + ")", nil
+			case left == "NULL":
+				return "(" + right + " " + isNull + ")", nil
+			default:
+			}
+		}
+		return "(" + left + " " + operator + " " + right + ")", nil
+	}
+}
+
+func (t *rowFilterTranslator) operand(e celast.Expr) (string, error) {
+	if column, ok := t.column(e); ok {
+		return column, nil
+	}
+	if t.usesRow(e) {
+		return "", errors.New("row columns can only be compared with values")
+	}
+	val, err := t.eval(e)
+	if err != nil {
+		return "", err
+	}
+	return t.literal(val.Value())
+}
+
+// column returns the quoted column of row.{column} or row["{column}"].
+func (t *rowFilterTranslator) column(e celast.Expr) (string, bool) {
+	var name string
+	switch e.Kind() {
+	case celast.SelectKind:
+		sel := e.AsSelect()
+		if sel.Operand().Kind() != celast.IdentKind || sel.Operand().AsIdent() != common.CELAttributeRow || sel.IsTestOnly() {
+			return "", false
+		}
+		name = sel.FieldName()
+	case celast.CallKind:
+		call := e.AsCall()
+		if call.FunctionName() != "_[_]" || len(call.Args()) != 2 {
+			return "", false
+		}
+		operand, key := call.Args()[0], call.Args()[1]
+		if operand.Kind() != celast.IdentKind || operand.AsIdent() != common.CELAttributeRow || key.Kind() != celast.LiteralKind {
+			return "", false
+		}
+		s, ok := key.AsLiteral().Value().(string)
+		if !ok {
+			return "", false
+		}
+		name = s
+	default:
+		return "", false
+	}
+	if !slices.Contains(t.columns, name) {
+		t.columns = append(t.columns, name)
+	}
+	if t.engine == storepb.Engine_MYSQL {
+		return "`" + strings.ReplaceAll(name, "`", "``") + "`", true
+	}
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`, true
+}
+
+func (*rowFilterTranslator) usesRow(e celast.Expr) bool {
+	found := false
+	celast.PreOrderVisit(e, celast.NewExprVisitor(func(e celast.Expr) {
+		if e.Kind() == celast.IdentKind && e.AsIdent() == common.CELAttributeRow {
+			found = true
+		}
+	}))
+	return found
+}
+
+func (t *rowFilterTranslator) eval(e celast.Expr) (ref.Val, error) {
+	text, err := parser.Unparse(e, t.info)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to unparse expression")
+	}
+	ast, issues := t.env.Compile(text)
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+	prog, err := t.env.Program(ast)
+	if err != nil {
+		return nil, err
+	}
+	val, _, err := prog.Eval(t.activation)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to evaluate %q", text)
+	}
+	return val, nil
+}
+
+func (t *rowFilterTranslator) literal(v any) (string, error) {
+	switch v := v.(type) {
+	case ref.Val:
+		return t.literal(v.Value())
+	case structpb.NullValue:
+		return "NULL", nil
+	case nil:
+		return "NULL", nil
+	case bool:
+		if v {
+			return "TRUE", nil
+		}
+		return "FALSE", nil
+	case int64:
+		return strconv.FormatInt(v, 10), nil
+	case uint64:
+		return strconv.FormatUint(v, 10), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case string:
+		// Backslashes are escaped for the default settings of standard_conforming_strings and NO_BACKSLASH_ESCAPES.
+		escaped := strings.ReplaceAll(strings.ReplaceAll(v, `\`, `\\`), `'`, `''`)
+		if t.engine == storepb.Engine_MYSQL {
+			return "'" + escaped + "'", nil
+		}
+		return "E'" + escaped + "'", nil
+	default:
+		return "", errors.Errorf("unsupported value type %T", v)
+	}
+}
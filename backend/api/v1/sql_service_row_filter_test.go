@@ -0,0 +1,106 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+func TestTranslateRowFilterExpression(t *testing.T) {
+	activation := map[string]any{
+		common.CELAttributeUserEmail:  "o'brien@example.com",
+		common.CELAttributeUserName:   "O'Brien",
+		common.CELAttributeUserGroups: []string{"group:emea@example.com"},
+		common.CELAttributeUserRoles:  []string{"roles/sqlEditorUser"},
+	}
+	tests := []struct {
+		expression string
+		engine     storepb.Engine
+		want       string
+		columns    []string
+		wantErr    bool
+	}{
+		{
+			expression: `row.region == "emea"`,
+			engine:     storepb.Engine_POSTGRES,
+			want:       `("region" = E'emea')`,
+			columns:    []string{"region"},
+		},
+		{
+			expression: `row.owner_email == user.email || "roles/projectOwner" in user.roles`,
+			engine:     storepb.Engine_POSTGRES,
+			want:       `(("owner_email" = E'o''brien@example.com') OR FALSE)`,
+			columns:    []string{"owner_email"},
+		},
+		{
+			expression: `row["owner"] == user.email && row.amount <= 1000`,
+			engine:     storepb.Engine_MYSQL,
+			want:       "((`owner` = 'o''brien@example.com') AND (`amount` <= 1000))",
+			columns:    []string{"owner", "amount"},
+		},
+		{
+			expression: `row.team in ["a\\", "b"] && !(row.deleted_at != null)`,
+			engine:     storepb.Engine_MYSQL,
+			want:       "((`team` IN ('a\\\\', 'b')) AND (NOT (`deleted_at` IS NOT NULL)))",
+			columns:    []string{"team", "deleted_at"},
+		},
+		{
+			expression: `row.team in user.groups.filter(g, g.startsWith("group:emea"))`,
+			engine:     storepb.Engine_POSTGRES,
+			want:       `("team" IN (E'group:emea@example.com'))`,
+			columns:    []string{"team"},
+		},
+		{
+			expression: `row.team in []`,
+			engine:     storepb.Engine_POSTGRES,
+			want:       `FALSE`,
+			columns:    []string{"team"},
+		},
+		{
+			expression: `"roles/sqlEditorUser" in user.roles`,
+			engine:     storepb.Engine_POSTGRES,
+			want:       `TRUE`,
+		},
+		{
+			expression: `row.name.startsWith("a")`,
+			engine:     storepb.Engine_POSTGRES,
+			wantErr:    true,
+		},
+		{
+			expression: `user.email in row.owners`,
+			engine:     storepb.Engine_POSTGRES,
+			wantErr:    true,
+		},
+		{
+			expression: `row.region`,
+			engine:     storepb.Engine_POSTGRES,
+			want:       `"region"`,
+			columns:    []string{"region"},
+		},
+		{
+			expression: `user.email`,
+			engine:     storepb.Engine_POSTGRES,
+			wantErr:    true,
+		},
+		{
+			expression: ``,
+			engine:     storepb.Engine_POSTGRES,
+			wantErr:    true,
+		},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		got, columns, err := translateRowFilterExpression(test.expression, test.engine, activation)
+		if test.wantErr {
+			a.Error(err, test.expression)
+			continue
+		}
+		a.NoError(err, test.expression)
+		a.Equal(test.want, got, test.expression)
+		a.Equal(test.columns, columns, test.expression)
+	}
+}
@@ -0,0 +1,221 @@
+package v1
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	parserbase "github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+const (
+	defaultQueryResultShareTTL = 7 * 24 * time.Hour
+	maximumQueryResultShareTTL = 30 * 24 * time.Hour
+)
+
+// queryResultRecorder records the results before masking of each executed statement.
+type queryResultRecorder struct {
+	statement string
+	batches   []*storepb.QueryResultSharePayload_Batch
+	results   []*v1pb.QueryResult
+}
+
+func (r *queryResultRecorder) wrap(execute queryExecuteFunc) queryExecuteFunc {
+	return func(ctx context.Context, statement string, spans []*parserbase.QuerySpan, queryContext db.QueryContext) ([]*v1pb.QueryResult, time.Duration, error) {
+		results, duration, err := execute(ctx, statement, spans, queryContext)
+		if err != nil {
+			return results, duration, err
+		}
+		r.batches = append(r.batches, &storepb.QueryResultSharePayload_Batch{
+			Statement:   statement,
+			ResultCount: int32(len(results)),
+		})
+		for _, result := range cloneQueryResults(results) {
+			result.Cached = false
+			r.results = append(r.results, result)
+		}
+		return results, duration, nil
+	}
+}
+
+// newQueryResultReplayFunc returns the execute function replaying the recorded results in order.
+// The rows are truncated to the limit of the viewer.
+func newQueryResultReplayFunc(payload *storepb.QueryResultSharePayload, results []*v1pb.QueryResult) queryExecuteFunc {
+	next, offset := 0, 0
+	return func(_ context.Context, statement string, _ []*parserbase.QuerySpan, queryContext db.QueryContext) ([]*v1pb.QueryResult, time.Duration, error) {
+		if next >= len(payload.Batches) || payload.Batches[next].Statement != statement {
+			return nil, 0, errors.Errorf("statement %q is not found in the snapshot", statement)
+		}
+		count := int(payload.Batches[next].ResultCount)
+		if offset+count > len(results) {
+			return nil, 0, errors.New("snapshot is corrupted")
+		}
+		batch := results[offset : offset+count]
+		next++
+		offset += count
+		for _, result := range batch {
+			if queryContext.Limit > 0 && len(result.Rows) > queryContext.Limit {
+				result.Rows = result.Rows[:queryContext.Limit]
+				result.RowsCount = int64(queryContext.Limit)
+			}
+		}
+		return batch, 0, nil
+	}
+}
+
+// CreateQueryResultShare creates a shareable snapshot of a query result.
+func (s *SQLService) CreateQueryResultShare(ctx context.Context, req *connect.Request[v1pb.CreateQueryResultShareRequest]) (*connect.Response[v1pb.QueryResultShare], error) {
+	request := req.Msg
+	projectID, err := common.GetProjectID(request.Parent)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if request.Query == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("query is required"))
+	}
+	if request.Query.Explain {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("cannot share explain results"))
+	}
+	ttl := defaultQueryResultShareTTL
+	if request.Ttl != nil {
+		ttl = request.Ttl.AsDuration()
+		if ttl <= 0 || ttl > maximumQueryResultShareTTL {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("ttl must be between 0 and %v", maximumQueryResultShareTTL))
+		}
+	}
+	user, _, database, err := s.prepareRelatedMessage(ctx, request.Query.Name)
+	if err != nil {
+		return nil, err
+	}
+	if database.ProjectID != projectID {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("database %q does not belong to project %q", request.Query.Name, projectID))
+	}
+
+	recorder := &queryResultRecorder{}
+	response, err := s.doQuery(ctx, request.Query, recorder)
+	if err != nil {
+		return nil, err
+	}
+	for _, result := range response.Results {
+		if result.Error != "" {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, errors.Errorf("cannot share a failed query: %s", result.Error))
+		}
+	}
+	bytes, err := proto.Marshal(&v1pb.QueryResponse{Results: recorder.results})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to marshal results"))
+	}
+
+	share, err := s.store.CreateQueryResultShare(ctx, &store.QueryResultShareMessage{
+		ProjectID:  projectID,
+		Creator:    user.Email,
+		ExpireTime: time.Now().Add(ttl),
+		Database:   common.FormatDatabase(database.InstanceID, database.DatabaseName),
+		Statement:  recorder.statement,
+		Bytes:      bytes,
+		Payload: &storepb.QueryResultSharePayload{
+			DataSourceId: request.Query.DataSourceId,
+			Schema:       request.Query.GetSchema(),
+			Batches:      recorder.batches,
+		},
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to create query result share"))
+	}
+	return connect.NewResponse(convertToV1QueryResultShare(share)), nil
+}
+
+// GetQueryResultShare gets a shared query result.
+// The access check and masking are applied to the viewer instead of the creator of the share.
+func (s *SQLService) GetQueryResultShare(ctx context.Context, req *connect.Request[v1pb.GetQueryResultShareRequest]) (*connect.Response[v1pb.QueryResultShare], error) {
+	projectID, shareID, err := common.GetProjectIDQueryResultShareID(req.Msg.Name)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	share, err := s.store.GetQueryResultShare(ctx, &store.FindQueryResultShareMessage{
+		Workspace:  common.GetWorkspaceIDFromContext(ctx),
+		ProjectID:  projectID,
+		ResourceID: shareID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get query result share"))
+	}
+	if share == nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.Errorf("query result share %q not found", req.Msg.Name))
+	}
+	user, instance, database, err := s.prepareRelatedMessage(ctx, share.Database)
+	if err != nil {
+		return nil, err
+	}
+	if database.ProjectID != projectID {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.Errorf("database %q is no longer in project %q", share.Database, projectID))
+	}
+
+	// The snapshot cannot be filtered again, so members with row filters must run the query themselves.
+	rowFilters, err := newRowFilterApplier(ctx, s.store, user, instance, database)
+	if err != nil {
+		return nil, err
+	}
+	if rowFilters != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, errors.New("query result shares are not available to members with row filters"))
+	}
+
+	var snapshot v1pb.QueryResponse
+	if err := proto.Unmarshal(share.Bytes, &snapshot); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to unmarshal results"))
+	}
+	queryRestriction := getEffectiveQueryDataPolicy(ctx, s.store, s.licenseService, 0, database.ProjectID)
+	queryContext := db.QueryContext{
+		Limit:                int(queryRestriction.MaximumResultRows),
+		OperatorEmail:        user.Email,
+		Schema:               share.Payload.GetSchema(),
+		MaximumSQLResultSize: queryRestriction.MaximumResultSize,
+	}
+	results, _, _, err := queryRetryStopOnError(
+		ctx,
+		s.store,
+		user,
+		instance,
+		database,
+		newQueryResultReplayFunc(share.Payload, snapshot.Results),
+		share.Statement,
+		queryContext,
+		s.licenseService,
+		s.accessCheck,
+		s.schemaSyncer,
+	)
+	if err != nil {
+		if _, ok := err.(*connect.Error); ok {
+			return nil, err
+		}
+		var qe *queryError
+		if errors.As(err, &qe) {
+			return nil, connect.NewError(connect.CodePermissionDenied, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, errors.New(err.Error()))
+	}
+
+	v1Share := convertToV1QueryResultShare(share)
+	v1Share.Results = results
+	return connect.NewResponse(v1Share), nil
+}
+
+func convertToV1QueryResultShare(share *store.QueryResultShareMessage) *v1pb.QueryResultShare {
+	return &v1pb.QueryResultShare{
+		Name:       common.FormatQueryResultShare(share.ProjectID, share.ResourceID),
+		Database:   share.Database,
+		Statement:  share.Statement,
+		Creator:    common.FormatUserEmail(share.Creator),
+		CreateTime: timestamppb.New(share.CreatedAt),
+		ExpireTime: timestamppb.New(share.ExpireTime),
+	}
+}
@@ -0,0 +1,125 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/component/masker"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/store/model"
+)
+
+// TableMasker masks table data of a database with the same masking rules and semantic types
+// used for SQL Editor results. No masking exemption applies because the data leaves the
+// database in bulk, e.g. when cloning the database into a lower environment.
+type TableMasker struct {
+	database             *store.DatabaseMessage
+	project              *store.ProjectMessage
+	schema               *model.DatabaseMetadata
+	evaluator            *maskingLevelEvaluator
+	semanticTypeToMasker map[string]masker.Masker
+	// maskers caches the column maskers by schema and table.
+	maskers map[[2]string][]masker.Masker
+}
+
+// NewTableMasker creates a TableMasker for the database.
+func NewTableMasker(ctx context.Context, stores *store.Store, database *store.DatabaseMessage) (*TableMasker, error) {
+	workspaceID := common.GetWorkspaceIDFromContext(ctx)
+	project, err := stores.GetProject(ctx, &store.FindProjectMessage{
+		Workspace:  workspaceID,
+		ResourceID: &database.ProjectID,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get project %q", database.ProjectID)
+	}
+	if project == nil {
+		return nil, errors.Errorf("project %q not found", database.ProjectID)
+	}
+	schema, err := stores.GetDBSchema(ctx, &store.FindDBSchemaMessage{
+		Workspace:    workspaceID,
+		InstanceID:   database.InstanceID,
+		DatabaseName: database.DatabaseName,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get db schema for %q", database.DatabaseName)
+	}
+	if schema == nil {
+		return nil, errors.Errorf("db schema for %q not found", database.DatabaseName)
+	}
+	classificationSetting, err := stores.GetDataClassificationSetting(ctx, workspaceID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to find classification setting")
+	}
+	maskingRulePolicy, err := stores.GetMaskingRulePolicy(ctx, workspaceID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to find masking rule policy")
+	}
+	semanticTypesSetting, err := stores.GetSemanticTypesSetting(ctx, workspaceID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to find semantic types setting")
+	}
+	semanticTypeToMasker, err := buildSemanticTypeToMaskerMap(ctx, stores)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TableMasker{
+		database: database,
+		project:  project,
+		schema:   schema,
+		evaluator: newEmptyMaskingLevelEvaluator().
+			withMaskingRulePolicy(maskingRulePolicy).
+			withDataClassificationSetting(classificationSetting).
+			withSemanticTypeSetting(semanticTypesSetting),
+		semanticTypeToMasker: semanticTypeToMasker,
+		maskers:              make(map[[2]string][]masker.Masker),
+	}, nil
+}
+
+// MaskResult masks the rows of the result in-place. The result columns must be columns of the table.
+// It fails if the table or any of the columns is not in the synced schema, so the data is never passed through unmasked.
+func (m *TableMasker) MaskResult(schemaName, tableName string, result *v1pb.QueryResult) error {
+	key := [2]string{schemaName, tableName}
+	maskers, ok := m.maskers[key]
+	if !ok {
+		var err error
+		if maskers, err = m.getColumnMaskers(schemaName, tableName, result.ColumnNames); err != nil {
+			return err
+		}
+		m.maskers[key] = maskers
+	}
+	doMaskResult(maskers, nil, result)
+	return nil
+}
+
+func (m *TableMasker) getColumnMaskers(schemaName, tableName string, columnNames []string) ([]masker.Masker, error) {
+	maskers := make([]masker.Masker, len(columnNames))
+	var table *model.TableMetadata
+	if schema := m.schema.GetSchemaMetadata(schemaName); schema != nil {
+		table = schema.GetTable(tableName)
+	}
+	if table == nil {
+		return nil, errors.Errorf("schema %q, table %q is not found in the synced schema to evaluate masking", schemaName, tableName)
+	}
+	for i, columnName := range columnNames {
+		maskers[i] = masker.NewNoneMasker()
+		column := table.GetColumn(columnName)
+		if column == nil {
+			return nil, errors.Errorf("schema %q, table %q, column %q is not found in the synced schema to evaluate masking", schemaName, tableName, columnName)
+		}
+		evaluation, err := m.evaluator.evaluateSemanticTypeOfColumn(m.database, schemaName, tableName, columnName, m.project.Setting.DataClassificationConfigId, column.GetCatalog(), nil)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to evaluate masking level of schema %q, table %q, column %q", schemaName, tableName, columnName)
+		}
+		if evaluation == nil || evaluation.SemanticTypeID == "" {
+			continue
+		}
+		if mk, ok := m.semanticTypeToMasker[evaluation.SemanticTypeID]; ok {
+			maskers[i] = mk
+		}
+	}
+	return maskers, nil
+}
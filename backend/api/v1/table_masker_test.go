@@ -0,0 +1,35 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/component/masker"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+	"github.com/bytebase/bytebase/backend/store/model"
+)
+
+func TestTableMaskerUnknownColumn(t *testing.T) {
+	a := require.New(t)
+	m := &TableMasker{
+		schema: model.NewDatabaseMetadata(&storepb.DatabaseSchemaMetadata{
+			Schemas: []*storepb.SchemaMetadata{
+				{
+					Name: "public",
+					Tables: []*storepb.TableMetadata{
+						{Name: "users", Columns: []*storepb.ColumnMetadata{{Name: "id"}}},
+					},
+				},
+			},
+		}, nil, nil, storepb.Engine_POSTGRES, true),
+		maskers: make(map[[2]string][]masker.Masker),
+	}
+
+	// The table or column missing from the synced schema is not copied unmasked.
+	err := m.MaskResult("public", "orders", &v1pb.QueryResult{ColumnNames: []string{"id"}})
+	a.ErrorContains(err, `table "orders" is not found`)
+	err = m.MaskResult("public", "users", &v1pb.QueryResult{ColumnNames: []string{"email", "id"}})
+	a.ErrorContains(err, `column "email" is not found`)
+}
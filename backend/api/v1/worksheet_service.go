@@ -7,15 +7,19 @@ import (
 
 	"connectrpc.com/connect"
 	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/permission"
 	"github.com/bytebase/bytebase/backend/component/iam"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
 	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
 	"github.com/bytebase/bytebase/backend/generated-go/v1/v1connect"
+	parserbase "github.com/bytebase/bytebase/backend/plugin/parser/base"
 	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
 )
 
 // WorksheetService implements the worksheet service.
@@ -115,7 +119,7 @@ func (s *WorksheetService) GetWorksheet(
 		return nil, err
 	}
 
-	ok, err := s.canReadWorksheet(ctx, worksheet)
+	ok, err := canReadWorksheet(ctx, s.store, s.iamManager, worksheet)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to check access with error: %v", err))
 	}
@@ -161,7 +165,7 @@ func (s *WorksheetService) SearchWorksheets(
 
 	var v1pbWorksheets []*v1pb.Worksheet
 	for _, worksheet := range worksheetList {
-		ok, err := s.canReadWorksheet(ctx, worksheet)
+		ok, err := canReadWorksheet(ctx, s.store, s.iamManager, worksheet)
 		if err != nil {
 			return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to check access with error: %v", err))
 		}
@@ -258,6 +262,18 @@ func (s *WorksheetService) UpdateWorksheet(
 				worksheetPatch.InstanceID = &emptyStr
 				worksheetPatch.DatabaseName = &emptyStr
 			}
+		case "parameters":
+			if err := validateWorksheetParameters(request.Worksheet.Parameters); err != nil {
+				return nil, connect.NewError(connect.CodeInvalidArgument, err)
+			}
+			payload := getWorksheetPatchPayload(worksheetPatch, worksheet)
+			payload.Parameters = convertToStoreWorksheetParameters(request.Worksheet.Parameters)
+		case "runbook_role":
+			if err := validateWorksheetRunbookRole(request.Worksheet.RunbookRole); err != nil {
+				return nil, connect.NewError(connect.CodeInvalidArgument, err)
+			}
+			payload := getWorksheetPatchPayload(worksheetPatch, worksheet)
+			payload.RunbookRole = request.Worksheet.RunbookRole
 		default:
 			return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("invalid update mask path %q", path))
 		}
@@ -326,7 +342,7 @@ func (s *WorksheetService) UpdateWorksheetOrganizer(
 		return nil, err
 	}
 
-	ok, err := s.canReadWorksheet(ctx, worksheet)
+	ok, err := canReadWorksheet(ctx, s.store, s.iamManager, worksheet)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, errors.Errorf("failed to check access with error: %v", err))
 	}
@@ -428,10 +444,10 @@ func (s *WorksheetService) canWriteWorksheet(ctx context.Context, worksheet *sto
 		return false, nil
 	case store.ProjectReadWorkSheet:
 		// For READ visibility, check the "bb.worksheets.manage" permission in the project.
-		return s.checkWorksheetPermission(ctx, worksheet.ProjectID, user, permission.WorksheetsManage)
+		return checkWorksheetPermission(ctx, s.store, s.iamManager, worksheet.ProjectID, user, permission.WorksheetsManage)
 	case store.ProjectWriteWorkSheet:
 		// For READ visibility, needs "bb.worksheets.get" permission in the project.
-		return s.checkWorksheetPermission(ctx, worksheet.ProjectID, user, permission.WorksheetsGet)
+		return checkWorksheetPermission(ctx, s.store, s.iamManager, worksheet.ProjectID, user, permission.WorksheetsGet)
 	default:
 		return false, nil
 	}
@@ -442,7 +458,8 @@ func (s *WorksheetService) canWriteWorksheet(ctx context.Context, worksheet *sto
 // PRIVATE: the creator only.
 // PROJECT_WRITE: all members with bb.projects.get permission in the project.
 // PROJECT_READ: all members with bb.projects.get permission in the project.
-func (s *WorksheetService) canReadWorksheet(ctx context.Context, worksheet *store.WorkSheetMessage) (bool, error) {
+// Besides, the runbook worksheet is readable by the project members with the runbook role.
+func canReadWorksheet(ctx context.Context, stores *store.Store, iamManager *iam.Manager, worksheet *store.WorkSheetMessage) (bool, error) {
 	user, ok := GetUserFromContext(ctx)
 	if !ok {
 		return false, connect.NewError(connect.CodeInternal, errors.Errorf("user not found"))
@@ -452,7 +469,7 @@ func (s *WorksheetService) canReadWorksheet(ctx context.Context, worksheet *stor
 	if worksheet.Creator == user.Email {
 		return true, nil
 	}
-	ok, err := s.iamManager.CheckPermission(ctx, permission.WorksheetsManage, user, common.GetWorkspaceIDFromContext(ctx))
+	ok, err := iamManager.CheckPermission(ctx, permission.WorksheetsManage, user, common.GetWorkspaceIDFromContext(ctx))
 	if err != nil {
 		return false, connect.NewError(connect.CodeInternal, errors.Errorf("failed to check permission with error: %v", err.Error()))
 	}
@@ -460,32 +477,45 @@ func (s *WorksheetService) canReadWorksheet(ctx context.Context, worksheet *stor
 		return true, nil
 	}
 
+	if role := worksheet.Payload.GetRunbookRole(); role != "" {
+		workspaceID := common.GetWorkspaceIDFromContext(ctx)
+		policy, err := stores.GetProjectIamPolicy(ctx, workspaceID, worksheet.ProjectID)
+		if err != nil {
+			return false, connect.NewError(connect.CodeInternal, errors.Errorf("failed to get project iam policy with error: %v", err.Error()))
+		}
+		if utils.GetUserFormattedRolesMap(ctx, stores, workspaceID, user, policy.Policy)[role] {
+			return true, nil
+		}
+	}
+
 	switch worksheet.Visibility {
 	case store.PrivateWorkSheet:
 		return false, nil
 	case store.ProjectReadWorkSheet, store.ProjectWriteWorkSheet:
 		// Check the "bb.worksheets.get" permission in the project.
-		return s.checkWorksheetPermission(ctx, worksheet.ProjectID, user, permission.WorksheetsGet)
+		return checkWorksheetPermission(ctx, stores, iamManager, worksheet.ProjectID, user, permission.WorksheetsGet)
 	default:
 		return false, nil
 	}
 }
 
-func (s *WorksheetService) checkWorksheetPermission(
+func checkWorksheetPermission(
 	ctx context.Context,
+	stores *store.Store,
+	iamManager *iam.Manager,
 	projectID string,
 	user *store.UserMessage,
 	permission permission.Permission,
 ) (bool, error) {
 	workspaceID := common.GetWorkspaceIDFromContext(ctx)
-	project, err := s.store.GetProject(ctx, &store.FindProjectMessage{
+	project, err := stores.GetProject(ctx, &store.FindProjectMessage{
 		Workspace:  workspaceID,
 		ResourceID: &projectID,
 	})
 	if err != nil {
 		return false, err
 	}
-	ok, err := s.iamManager.CheckPermission(ctx, permission, user, workspaceID, project.ResourceID)
+	ok, err := iamManager.CheckPermission(ctx, permission, user, workspaceID, project.ResourceID)
 	if err != nil {
 		return false, connect.NewError(connect.CodeInternal, errors.Errorf("failed to check permission with error: %v", err.Error()))
 	}
@@ -522,6 +552,8 @@ func convertToAPIWorksheetMessage(worksheet *store.WorkSheetMessage) *v1pb.Works
 		Visibility:  visibility,
 		Starred:     worksheet.Starred,
 		Folders:     worksheet.Folders,
+		Parameters:  convertToAPIWorksheetParameters(worksheet.Payload.GetParameters()),
+		RunbookRole: worksheet.Payload.GetRunbookRole(),
 	}
 }
 
@@ -530,6 +562,12 @@ func convertToStoreWorksheetMessage(project *store.ProjectMessage, database *sto
 	if err != nil {
 		return nil, err
 	}
+	if err := validateWorksheetParameters(worksheet.Parameters); err != nil {
+		return nil, err
+	}
+	if err := validateWorksheetRunbookRole(worksheet.RunbookRole); err != nil {
+		return nil, err
+	}
 
 	worksheetMessage := &store.WorkSheetMessage{
 		ProjectID:  project.ResourceID,
@@ -537,6 +575,10 @@ func convertToStoreWorksheetMessage(project *store.ProjectMessage, database *sto
 		Title:      worksheet.Title,
 		Statement:  string(worksheet.Content),
 		Visibility: visibility,
+		Payload: &storepb.WorksheetPayload{
+			Parameters:  convertToStoreWorksheetParameters(worksheet.Parameters),
+			RunbookRole: worksheet.RunbookRole,
+		},
 	}
 	if database != nil {
 		worksheetMessage.InstanceID = &database.InstanceID
@@ -560,3 +602,87 @@ func convertToStoreWorksheetVisibility(visibility v1pb.Worksheet_Visibility) (st
 		return store.WorkSheetVisibility(""), connect.NewError(connect.CodeInvalidArgument, errors.Errorf("invalid visibility %q", visibility))
 	}
 }
+
+// getWorksheetPatchPayload returns the payload to patch, initialized from the current worksheet payload.
+func getWorksheetPatchPayload(patch *store.PatchWorkSheetMessage, worksheet *store.WorkSheetMessage) *storepb.WorksheetPayload {
+	if patch.Payload == nil {
+		patch.Payload = &storepb.WorksheetPayload{}
+		if worksheet.Payload != nil {
+			patch.Payload = proto.CloneOf(worksheet.Payload)
+		}
+	}
+	return patch.Payload
+}
+
+func validateWorksheetParameters(parameters []*v1pb.WorksheetParameter) error {
+	names := make(map[string]bool)
+	for _, parameter := range parameters {
+		if !parserbase.IsValidParameterName(parameter.Name) {
+			return errors.Errorf("invalid parameter name %q", parameter.Name)
+		}
+		if names[parameter.Name] {
+			return errors.Errorf("duplicate parameter %q", parameter.Name)
+		}
+		names[parameter.Name] = true
+		switch parameter.Type {
+		case v1pb.WorksheetParameter_STRING, v1pb.WorksheetParameter_INT, v1pb.WorksheetParameter_DATE:
+			if len(parameter.AllowedValues) > 0 {
+				return errors.Errorf("allowed values are only supported for ENUM parameter %q", parameter.Name)
+			}
+		case v1pb.WorksheetParameter_ENUM:
+			if len(parameter.AllowedValues) == 0 {
+				return errors.Errorf("allowed values are required for ENUM parameter %q", parameter.Name)
+			}
+		default:
+			return errors.Errorf("invalid type %q for parameter %q", parameter.Type, parameter.Name)
+		}
+		if parameter.DefaultValue != "" {
+			if _, err := convertWorksheetParameterValue(convertToStoreWorksheetParameter(parameter), parameter.DefaultValue); err != nil {
+				return errors.Wrapf(err, "invalid default value for parameter %q", parameter.Name)
+			}
+		}
+	}
+	return nil
+}
+
+func validateWorksheetRunbookRole(role string) error {
+	if role == "" {
+		return nil
+	}
+	if _, err := common.GetRoleID(role); err != nil {
+		return errors.Wrapf(err, "invalid runbook role %q", role)
+	}
+	return nil
+}
+
+func convertToStoreWorksheetParameter(parameter *v1pb.WorksheetParameter) *storepb.WorksheetParameter {
+	return &storepb.WorksheetParameter{
+		Name:          parameter.Name,
+		Type:          storepb.WorksheetParameter_Type(parameter.Type),
+		Description:   parameter.Description,
+		DefaultValue:  parameter.DefaultValue,
+		AllowedValues: parameter.AllowedValues,
+	}
+}
+
+func convertToStoreWorksheetParameters(parameters []*v1pb.WorksheetParameter) []*storepb.WorksheetParameter {
+	var result []*storepb.WorksheetParameter
+	for _, parameter := range parameters {
+		result = append(result, convertToStoreWorksheetParameter(parameter))
+	}
+	return result
+}
+
+func convertToAPIWorksheetParameters(parameters []*storepb.WorksheetParameter) []*v1pb.WorksheetParameter {
+	var result []*v1pb.WorksheetParameter
+	for _, parameter := range parameters {
+		result = append(result, &v1pb.WorksheetParameter{
+			Name:          parameter.Name,
+			Type:          v1pb.WorksheetParameter_Type(parameter.Type),
+			Description:   parameter.Description,
+			DefaultValue:  parameter.DefaultValue,
+			AllowedValues: parameter.AllowedValues,
+		})
+	}
+	return result
+}
@@ -31,6 +31,12 @@ type WorkspaceService struct {
 	licenseService *enterprise.LicenseService
 	iamManager     *iam.Manager
 	profile        *config.Profile
+
+	instanceService     *InstanceService
+	projectService      *ProjectService
+	orgPolicyService    *OrgPolicyService
+	settingService      *SettingService
+	reviewConfigService *ReviewConfigService
 }
 
 // NewWorkspaceService creates a new WorkspaceService.
@@ -39,12 +45,22 @@ func NewWorkspaceService(
 	iamManager *iam.Manager,
 	profile *config.Profile,
 	licenseService *enterprise.LicenseService,
+	instanceService *InstanceService,
+	projectService *ProjectService,
+	orgPolicyService *OrgPolicyService,
+	settingService *SettingService,
+	reviewConfigService *ReviewConfigService,
 ) *WorkspaceService {
 	return &WorkspaceService{
-		store:          store,
-		iamManager:     iamManager,
-		profile:        profile,
-		licenseService: licenseService,
+		store:               store,
+		iamManager:          iamManager,
+		profile:             profile,
+		licenseService:      licenseService,
+		instanceService:     instanceService,
+		projectService:      projectService,
+		orgPolicyService:    orgPolicyService,
+		settingService:      settingService,
+		reviewConfigService: reviewConfigService,
 	}
 }
 
@@ -221,33 +237,8 @@ func (s *WorkspaceService) SetIamPolicy(ctx context.Context, req *connect.Reques
 		return nil, err
 	}
 
-	users := utils.GetUsersByRoleInIAMPolicy(
-		ctx,
-		s.store,
-		common.GetWorkspaceIDFromContext(ctx),
-		store.WorkspaceAdminRole,
-		!s.profile.SaaS,
-		iamPolicy,
-	)
-	if !containsActiveEndUser(users) {
-		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("workspace must have at least one admin"))
-	}
-
-	// Guard: count members in the new policy BEFORE saving.
-	// Allow over-limit workspaces to reduce seats incrementally (e.g. after license downgrade).
-	userLimit := s.licenseService.GetUserLimit(ctx, workspaceID)
-	newCount, err := countUsersInIamPolicy(ctx, s.store, workspaceID, iamPolicy, s.profile.SaaS)
-	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, errors.Wrap(err, "failed to count users in IAM policy"))
-	}
-	if newCount > userLimit {
-		oldCount, err := countUsersInIamPolicy(ctx, s.store, workspaceID, policyMessage.Policy, s.profile.SaaS)
-		if err != nil {
-			return nil, connect.NewError(connect.CodeInternal, errors.Wrap(err, "failed to count users in current IAM policy"))
-		}
-		if newCount >= oldCount {
-			return nil, connect.NewError(connect.CodeResourceExhausted, errors.Errorf("workspace has %d users, exceeding the limit of %d", newCount, userLimit))
-		}
+	if err := s.validateWorkspaceIamPolicy(ctx, workspaceID, policyMessage.Policy, iamPolicy); err != nil {
+		return nil, err
 	}
 
 	payloadBytes, err := protojson.Marshal(iamPolicy)
@@ -296,6 +287,39 @@ func (s *WorkspaceService) SetIamPolicy(ctx context.Context, req *connect.Reques
 	return connect.NewResponse(v1Policy), nil
 }
 
+// validateWorkspaceIamPolicy checks that the new workspace IAM policy keeps an admin and fits the user limit.
+func (s *WorkspaceService) validateWorkspaceIamPolicy(ctx context.Context, workspaceID string, oldPolicy, newPolicy *storepb.IamPolicy) error {
+	users := utils.GetUsersByRoleInIAMPolicy(
+		ctx,
+		s.store,
+		workspaceID,
+		store.WorkspaceAdminRole,
+		!s.profile.SaaS,
+		newPolicy,
+	)
+	if !containsActiveEndUser(users) {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("workspace must have at least one admin"))
+	}
+
+	// Guard: count members in the new policy BEFORE saving.
+	// Allow over-limit workspaces to reduce seats incrementally (e.g. after license downgrade).
+	userLimit := s.licenseService.GetUserLimit(ctx, workspaceID)
+	newCount, err := countUsersInIamPolicy(ctx, s.store, workspaceID, newPolicy, s.profile.SaaS)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, errors.Wrap(err, "failed to count users in IAM policy"))
+	}
+	if newCount > userLimit {
+		oldCount, err := countUsersInIamPolicy(ctx, s.store, workspaceID, oldPolicy, s.profile.SaaS)
+		if err != nil {
+			return connect.NewError(connect.CodeInternal, errors.Wrap(err, "failed to count users in current IAM policy"))
+		}
+		if newCount >= oldCount {
+			return connect.NewError(connect.CodeResourceExhausted, errors.Errorf("workspace has %d users, exceeding the limit of %d", newCount, userLimit))
+		}
+	}
+	return nil
+}
+
 // sendInviteEmails sends invite emails to newly added members. Errors are logged, never returned.
 func (s *WorkspaceService) sendInviteEmails(ctx context.Context, workspaceID string, oldPolicy *storepb.IamPolicy, deltas []*v1pb.BindingDelta) {
 	emailSetting, err := resolvePreLoginEmailSetting(ctx, s.store, workspaceID)
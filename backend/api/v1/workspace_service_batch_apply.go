@@ -0,0 +1,1285 @@
+package v1
+
+import (
+	"cmp"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/common/permission"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+// batchApplyInstanceFields are the instance fields managed by BatchApply by default.
+// The data sources are only used to create the instance because the current
+// state does not carry the passwords to compare with.
+var batchApplyInstanceFields = []string{
+	"title",
+	"environment",
+	"external_link",
+	"activation",
+	"sync_interval",
+	"sync_databases",
+	"labels",
+}
+
+// batchApplyProjectFields are the project fields managed by BatchApply.
+var batchApplyProjectFields = []string{
+	"title",
+	"data_classification_config_id",
+	"issue_labels",
+	"force_issue_labels",
+	"enforce_issue_title",
+	"enforce_sql_review",
+	"postgres_database_tenant_mode",
+	"allow_self_approval",
+	"execution_retry_policy",
+	"ci_sampling_size",
+	"parallel_tasks_per_rollout",
+	"require_issue_approval",
+	"require_plan_check_no_error",
+	"allow_request_role",
+	"allow_just_in_time_access",
+	"query_result_cache",
+	"break_glass_access",
+	"labels",
+}
+
+// batchApplyPolicyFields are the policy fields managed by BatchApply.
+// Only the payload field matching the policy type is used.
+var batchApplyPolicyFields = []string{
+	"inherit_from_parent",
+	"enforce",
+	"rollout_policy",
+	"masking_rule_policy",
+	"masking_exemption_policy",
+	"tag_policy",
+	"query_data_policy",
+	"row_filter_policy",
+}
+
+// batchApplySettingFields are the setting fields managed by BatchApply.
+var batchApplySettingFields = []string{"value"}
+
+// batchApplyReviewConfigFields are the review config fields managed by BatchApply.
+// The resources of a review config are set by the tag policies.
+var batchApplyReviewConfigFields = []string{
+	"title",
+	"enabled",
+	"rules",
+}
+
+// batchApplyDatabaseGroupsFields are the fields of the database groups of a project managed by BatchApply.
+var batchApplyDatabaseGroupsFields = []string{"database_groups"}
+
+// batchApplyItem is a resource of a BatchApply request.
+type batchApplyItem struct {
+	name    string
+	desired proto.Message
+	// mask is the list of fields managed by the request.
+	mask []string
+	etag string
+
+	// current is the state before the apply, nil if the resource does not exist.
+	current proto.Message
+	// paths are the managed fields that differ from the current state.
+	paths  []string
+	result *v1pb.BatchApplyResponse_Result
+
+	get func(ctx context.Context) (proto.Message, error)
+	// create, update and remove write the resource through its service before
+	// the BatchApply transaction. They are set for the instances and projects.
+	create func(ctx context.Context) error
+	update func(ctx context.Context, m proto.Message, paths []string) error
+	remove func(ctx context.Context) error
+	// write adds the store writes of the resource to the BatchApply transaction.
+	// It is set for all the resources but the instances.
+	write func(ctx context.Context, apply *store.BatchApplyMessage) error
+	// written is called after the BatchApply transaction commits.
+	written func(ctx context.Context)
+}
+
+// BatchApply declaratively applies instances, projects, IAM policies, policies,
+// settings, review configs and database groups.
+//
+// The project updates, IAM policies, policies, settings, review configs and
+// database groups are written in a single transaction, and each write only succeeds if the resource has not changed
+// since the validation. The instances and new projects cannot join it: the
+// instances are connected and synced by the instance service, and a new project
+// is created with its IAM policy and default resources by the project service.
+// They are written before the transaction after checking their etags again,
+// and reverted on a best-effort basis if a later write fails.
+func (s *WorkspaceService) BatchApply(ctx context.Context, req *connect.Request[v1pb.BatchApplyRequest]) (*connect.Response[v1pb.BatchApplyResponse], error) {
+	workspaceID := common.GetWorkspaceIDFromContext(ctx)
+	if req.Msg.Name != common.FormatWorkspace(workspaceID) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("workspace %q does not match the current workspace", req.Msg.Name))
+	}
+	user, ok := GetUserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeInternal, errors.Errorf("user not found"))
+	}
+
+	items := make([]*batchApplyItem, len(req.Msg.Resources))
+	for i, resource := range req.Msg.Resources {
+		items[i] = s.newBatchApplyItem(resource)
+	}
+	response := &v1pb.BatchApplyResponse{}
+	for _, item := range items {
+		response.Results = append(response.Results, item.result)
+	}
+
+	// Validate all resources before writing any of them.
+	createdProjects := map[string]bool{}
+	for _, item := range items {
+		if project, ok := item.desired.(*v1pb.Project); ok && item.result.Status != v1pb.BatchApplyResponse_Result_FAILED {
+			if current, err := item.get(ctx); err == nil && current == nil {
+				createdProjects[project.Name] = true
+			}
+		}
+	}
+	seen := map[string]bool{}
+	failed := false
+	for _, item := range items {
+		if item.result.Status == v1pb.BatchApplyResponse_Result_FAILED {
+			failed = true
+			continue
+		}
+		key := fmt.Sprintf("%T/%s", item.desired, item.name)
+		if seen[key] {
+			item.fail(connect.NewError(connect.CodeInvalidArgument, errors.Errorf("resource %q is duplicated", item.name)))
+			failed = true
+			continue
+		}
+		seen[key] = true
+		if err := s.validateBatchApplyItem(ctx, req, user, item, createdProjects); err != nil {
+			item.fail(err)
+			failed = true
+		}
+	}
+	if failed {
+		markBatchApplyNotApplied(items)
+		return connect.NewResponse(response), nil
+	}
+	if req.Msg.ValidateOnly {
+		return connect.NewResponse(response), nil
+	}
+
+	// Apply the resources in the dependency order, and restore the applied
+	// resources in the reverse order if any of them fails.
+	ordered := slices.Clone(items)
+	slices.SortStableFunc(ordered, func(a, b *batchApplyItem) int {
+		return cmp.Compare(getBatchApplyOrder(a.desired), getBatchApplyOrder(b.desired))
+	})
+	var applied, written []*batchApplyItem
+	restore := func() {
+		markBatchApplyNotApplied(items)
+		for _, item := range slices.Backward(applied) {
+			item.restore(ctx)
+		}
+	}
+	for _, item := range ordered {
+		if item.result.Status == v1pb.BatchApplyResponse_Result_UNCHANGED {
+			continue
+		}
+		if item.write == nil || (item.current == nil && item.create != nil) {
+			if err := item.apply(ctx); err != nil {
+				item.fail(err)
+				restore()
+				return connect.NewResponse(response), nil
+			}
+			applied = append(applied, item)
+		}
+	}
+	apply := &store.BatchApplyMessage{Workspace: workspaceID}
+	for _, item := range ordered {
+		if item.result.Status == v1pb.BatchApplyResponse_Result_UNCHANGED || item.write == nil {
+			continue
+		}
+		if err := item.write(ctx, apply); err != nil {
+			item.fail(err)
+			restore()
+			return connect.NewResponse(response), nil
+		}
+		written = append(written, item)
+	}
+	conflict, err := s.store.BatchApply(ctx, apply)
+	if err != nil || conflict != "" {
+		restore()
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to apply the resources"))
+		}
+		return nil, connect.NewError(connect.CodeAborted, errors.Errorf("there is concurrent update to %q, please refresh and try again", conflict))
+	}
+	for _, item := range written {
+		if item.written != nil {
+			item.written(ctx)
+		}
+	}
+
+	for _, item := range append(applied, written...) {
+		current, err := item.get(ctx)
+		if err != nil {
+			slog.Warn("failed to get the applied resource", slog.String("resource", item.name), log.BBError(err))
+			continue
+		}
+		if current != nil {
+			item.result.Etag = getBatchApplyEtag(current)
+		}
+	}
+	return connect.NewResponse(response), nil
+}
+
+// markBatchApplyNotApplied marks the resources to create or update as not applied.
+func markBatchApplyNotApplied(items []*batchApplyItem) {
+	for _, item := range items {
+		if item.result.Status == v1pb.BatchApplyResponse_Result_CREATED || item.result.Status == v1pb.BatchApplyResponse_Result_UPDATED {
+			item.result.Status = v1pb.BatchApplyResponse_Result_NOT_APPLIED
+		}
+	}
+}
+
+func (item *batchApplyItem) fail(err error) {
+	item.result.Status = v1pb.BatchApplyResponse_Result_FAILED
+	item.result.Error = err.Error()
+}
+
+// apply writes the desired state of the resource through its service.
+// The fields of a new project left over by the creation are written in the
+// BatchApply transaction.
+func (item *batchApplyItem) apply(ctx context.Context) error {
+	if item.current != nil {
+		// Detect the changes made since the validation.
+		current, err := item.get(ctx)
+		if err != nil {
+			return err
+		}
+		if current == nil {
+			return connect.NewError(connect.CodeAborted, errors.Errorf("resource %q has been deleted since the validation", item.name))
+		}
+		if getBatchApplyEtag(current) != item.result.Etag {
+			return connect.NewError(connect.CodeAborted, errors.Errorf("there is concurrent update to %q, please refresh and try again", item.name))
+		}
+		return item.update(ctx, item.desired, item.paths)
+	}
+	if err := item.create(ctx); err != nil {
+		return err
+	}
+	if item.write != nil {
+		return nil
+	}
+	// The create methods may ignore some fields, so update the ones left over.
+	created, err := item.get(ctx)
+	if err != nil {
+		return err
+	}
+	if created == nil {
+		return connect.NewError(connect.CodeInternal, errors.Errorf("resource %q not found after creation", item.name))
+	}
+	if paths := getBatchApplyChangedFields(created, item.desired, item.mask); len(paths) > 0 {
+		return item.update(ctx, item.desired, paths)
+	}
+	return nil
+}
+
+// restore reverts the resource written through its service to the state before the apply.
+// The resource keeps its status if it fails to be restored.
+func (item *batchApplyItem) restore(ctx context.Context) {
+	var err error
+	if item.current == nil {
+		err = item.remove(ctx)
+	} else {
+		err = item.update(ctx, item.current, item.paths)
+	}
+	if err != nil {
+		slog.Error("failed to restore the resource", slog.String("resource", item.name), log.BBError(err))
+		item.result.Error = fmt.Sprintf("failed to restore: %v", err)
+		if item.current == nil {
+			item.result.Status = v1pb.BatchApplyResponse_Result_CREATED
+		} else {
+			item.result.Status = v1pb.BatchApplyResponse_Result_UPDATED
+		}
+	}
+}
+
+func (s *WorkspaceService) newBatchApplyItem(resource *v1pb.BatchApplyRequest_Resource) *batchApplyItem {
+	item := &batchApplyItem{
+		etag:   resource.GetEtag(),
+		result: &v1pb.BatchApplyResponse_Result{Status: v1pb.BatchApplyResponse_Result_UNCHANGED},
+	}
+	var allowedFields, defaultFields []string
+	switch r := resource.GetResource().(type) {
+	case *v1pb.BatchApplyRequest_Resource_Instance:
+		item.name, item.desired = r.Instance.GetName(), r.Instance
+		allowedFields, defaultFields = batchApplyInstanceFields, batchApplyInstanceFields
+		s.setBatchApplyInstanceFuncs(item, r.Instance)
+	case *v1pb.BatchApplyRequest_Resource_Project:
+		item.name, item.desired = r.Project.GetName(), r.Project
+		allowedFields, defaultFields = batchApplyProjectFields, batchApplyProjectFields
+		s.setBatchApplyProjectFuncs(item, r.Project)
+	case *v1pb.BatchApplyRequest_Resource_IamPolicy:
+		item.name, item.desired = r.IamPolicy.GetResource(), r.IamPolicy.GetPolicy()
+		allowedFields, defaultFields = []string{"bindings"}, []string{"bindings"}
+		s.setBatchApplyIamPolicyFuncs(item, r.IamPolicy)
+	case *v1pb.BatchApplyRequest_Resource_Policy:
+		item.name, item.desired = r.Policy.GetName(), r.Policy
+		allowedFields = batchApplyPolicyFields
+		defaultFields = []string{"inherit_from_parent", "enforce"}
+		if policyType, err := convertV1PBToStorePBPolicyType(r.Policy.GetType()); err == nil {
+			for _, field := range batchApplyPolicyFields {
+				if pathMatchType(field, policyType) {
+					defaultFields = append(defaultFields, field)
+				}
+			}
+		}
+		s.setBatchApplyPolicyFuncs(item, r.Policy)
+	case *v1pb.BatchApplyRequest_Resource_Setting:
+		item.name, item.desired = r.Setting.GetName(), r.Setting
+		allowedFields, defaultFields = batchApplySettingFields, batchApplySettingFields
+		s.setBatchApplySettingFuncs(item, r.Setting)
+	case *v1pb.BatchApplyRequest_Resource_ReviewConfig:
+		item.name, item.desired = r.ReviewConfig.GetName(), r.ReviewConfig
+		allowedFields, defaultFields = batchApplyReviewConfigFields, batchApplyReviewConfigFields
+		s.setBatchApplyReviewConfigFuncs(item, r.ReviewConfig)
+	case *v1pb.BatchApplyRequest_Resource_DatabaseGroups:
+		databaseGroups := normalizeBatchApplyDatabaseGroups(r.DatabaseGroups)
+		item.name, item.desired = r.DatabaseGroups.GetParent(), databaseGroups
+		allowedFields, defaultFields = batchApplyDatabaseGroupsFields, batchApplyDatabaseGroupsFields
+		s.setBatchApplyDatabaseGroupsFuncs(item, databaseGroups)
+	default:
+		item.fail(connect.NewError(connect.CodeInvalidArgument, errors.New("resource must be set")))
+		return item
+	}
+	item.result.Name = item.name
+	if item.name == "" || item.desired == nil || !item.desired.ProtoReflect().IsValid() {
+		item.fail(connect.NewError(connect.CodeInvalidArgument, errors.New("resource name must be set")))
+		return item
+	}
+
+	item.mask = defaultFields
+	if resource.GetUpdateMask() != nil {
+		item.mask = resource.GetUpdateMask().GetPaths()
+		for _, path := range item.mask {
+			if !slices.Contains(allowedFields, path) {
+				item.fail(connect.NewError(connect.CodeInvalidArgument, errors.Errorf("unsupported update_mask %q", path)))
+				return item
+			}
+		}
+	}
+	return item
+}
+
+func (s *WorkspaceService) validateBatchApplyItem(ctx context.Context, req connect.AnyRequest, user *store.UserMessage, item *batchApplyItem, createdProjects map[string]bool) error {
+	current, err := item.get(ctx)
+	if err != nil {
+		return err
+	}
+	item.current = current
+	if item.etag != "" {
+		if current == nil {
+			return connect.NewError(connect.CodeAborted, errors.Errorf("resource %q has been deleted since the etag was returned", item.name))
+		}
+		if getBatchApplyEtag(current) != item.etag {
+			return connect.NewError(connect.CodeAborted, errors.Errorf("there is concurrent update to %q, please refresh and try again", item.name))
+		}
+	}
+	if current != nil {
+		item.paths = getBatchApplyChangedFields(current, item.desired, item.mask)
+		item.result.Status = v1pb.BatchApplyResponse_Result_UPDATED
+		if len(item.paths) == 0 {
+			item.result.Status = v1pb.BatchApplyResponse_Result_UNCHANGED
+		}
+	} else {
+		item.result.Status = v1pb.BatchApplyResponse_Result_CREATED
+	}
+
+	workspaceID := common.GetWorkspaceIDFromContext(ctx)
+	switch desired := item.desired.(type) {
+	case *v1pb.Instance:
+		instanceID, err := common.GetInstanceID(desired.Name)
+		if err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		if current != nil && current.(*v1pb.Instance).State == v1pb.State_DELETED {
+			return connect.NewError(connect.CodeFailedPrecondition, errors.Errorf("instance %q has been deleted", desired.Name))
+		}
+		perm := permission.InstancesUpdate
+		if current == nil {
+			perm = permission.InstancesCreate
+		}
+		if err := s.checkBatchApplyPermission(ctx, req, user, perm); err != nil {
+			return err
+		}
+		if current == nil {
+			if !isValidResourceID(instanceID) {
+				return connect.NewError(connect.CodeInvalidArgument, errors.Errorf("invalid instance ID %v", instanceID))
+			}
+			if _, err := convertToStoreInstance(instanceID, desired); err != nil {
+				return connect.NewError(connect.CodeInvalidArgument, err)
+			}
+		}
+		if err := validateLabels(desired.Labels); err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		if desired.GetEnvironment() != "" && (current == nil || slices.Contains(item.paths, "environment")) {
+			environmentID, err := common.GetEnvironmentID(desired.GetEnvironment())
+			if err != nil {
+				return connect.NewError(connect.CodeInvalidArgument, err)
+			}
+			environment, err := s.store.GetEnvironmentByID(ctx, workspaceID, environmentID)
+			if err != nil {
+				return connect.NewError(connect.CodeInternal, err)
+			}
+			if environment == nil {
+				return connect.NewError(connect.CodeNotFound, errors.Errorf("environment %q not found", environmentID))
+			}
+		}
+	case *v1pb.Project:
+		projectID, err := common.GetProjectID(desired.Name)
+		if err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		if current != nil && current.(*v1pb.Project).State == v1pb.State_DELETED {
+			return connect.NewError(connect.CodeFailedPrecondition, errors.Errorf("project %q has been deleted", desired.Name))
+		}
+		if common.IsDefaultProject(workspaceID, projectID) {
+			return connect.NewError(connect.CodeInvalidArgument, errors.Errorf("default project cannot be updated"))
+		}
+		if current == nil {
+			if err := s.checkBatchApplyPermission(ctx, req, user, permission.ProjectsCreate); err != nil {
+				return err
+			}
+			if !isValidResourceID(projectID) || projectID == "default" || strings.HasPrefix(projectID, "default-") {
+				return connect.NewError(connect.CodeInvalidArgument, errors.Errorf("invalid project ID %v", projectID))
+			}
+		} else if err := s.checkBatchApplyPermission(ctx, req, user, permission.ProjectsUpdate, projectID); err != nil {
+			return err
+		}
+		if err := validateLabels(desired.Labels); err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		if err := validateQueryResultCache(desired.QueryResultCache); err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		if err := validateBreakGlassAccess(desired.BreakGlassAccess); err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	case *v1pb.IamPolicy:
+		setRequest := &v1pb.SetIamPolicyRequest{Resource: item.name, Policy: desired}
+		var oldPolicy *store.IamPolicyMessage
+		if strings.HasPrefix(item.name, common.WorkspacePrefix) {
+			if item.name != common.FormatWorkspace(workspaceID) {
+				return connect.NewError(connect.CodeInvalidArgument, errors.Errorf("workspace %q does not match the current workspace", item.name))
+			}
+			if err := s.checkBatchApplyPermission(ctx, req, user, permission.WorkspacesSetIamPolicy); err != nil {
+				return err
+			}
+			if oldPolicy, err = s.store.GetWorkspaceIamPolicy(ctx, workspaceID); err != nil {
+				return connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to find workspace iam policy"))
+			}
+		} else {
+			projectID, err := common.GetProjectID(item.name)
+			if err != nil {
+				return connect.NewError(connect.CodeInvalidArgument, err)
+			}
+			// The creator of a project created in the same request owns its IAM policy.
+			if current == nil && !createdProjects[item.name] {
+				return connect.NewError(connect.CodeNotFound, errors.Errorf("project %q not found", item.name))
+			}
+			if current == nil {
+				oldPolicy = &store.IamPolicyMessage{Policy: &storepb.IamPolicy{}}
+			} else {
+				if err := s.checkBatchApplyPermission(ctx, req, user, permission.ProjectsSetIAMPolicy, projectID); err != nil {
+					return err
+				}
+				if oldPolicy, err = s.store.GetProjectIamPolicy(ctx, workspaceID, projectID); err != nil {
+					return connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to find project iam policy"))
+				}
+			}
+		}
+		if err := validateIAMPolicy(ctx, s.store, !s.profile.SaaS, setRequest, oldPolicy); err != nil {
+			return err
+		}
+		if strings.HasPrefix(item.name, common.WorkspacePrefix) {
+			newPolicy, err := convertToStoreIamPolicy(desired)
+			if err != nil {
+				return err
+			}
+			if err := s.validateWorkspaceIamPolicy(ctx, workspaceID, oldPolicy.Policy, newPolicy); err != nil {
+				return err
+			}
+		}
+	case *v1pb.Policy:
+		policyType, err := extractPolicyTypeFromName(desired.Name)
+		if err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		if desiredType, err := convertV1PBToStorePBPolicyType(desired.Type); err != nil || desiredType != policyType {
+			return connect.NewError(connect.CodeInvalidArgument, errors.Errorf("policy type %v does not match the policy name %q", desired.Type, desired.Name))
+		}
+		parent := strings.TrimSuffix(strings.Split(desired.Name, common.PolicyNamePrefix)[0], "/")
+		resourceType, _, err := common.GetPolicyResourceTypeAndResource(parent)
+		if err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		if err := validatePolicyType(policyType, resourceType); err != nil {
+			return err
+		}
+		for _, path := range item.mask {
+			if path != "inherit_from_parent" && path != "enforce" && !pathMatchType(path, policyType) {
+				return connect.NewError(connect.CodeInvalidArgument, errors.Errorf("invalid path %s for policy type %s", path, policyType.String()))
+			}
+		}
+		if err := s.orgPolicyService.checkPolicyFeatureGuard(ctx, desired.Type); err != nil {
+			return err
+		}
+		if err := validatePolicyPayload(policyType, desired); err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, errors.Wrap(err, "invalid policy"))
+		}
+		// The creator of a project created in the same request owns its policies.
+		if resourceType != storepb.Policy_PROJECT || !createdProjects[parent] {
+			if err := s.orgPolicyService.checkPolicyPermission(ctx, req, getBatchApplyPolicyPermission(policyType, current == nil), &store.PolicyMessage{
+				ResourceType: resourceType,
+				Resource:     parent,
+				Type:         policyType,
+			}); err != nil {
+				return err
+			}
+		}
+	case *v1pb.Setting:
+		settingName, err := getBatchApplySettingName(desired.Name)
+		if err != nil {
+			return err
+		}
+		if settingName == storepb.SettingName_ENVIRONMENT {
+			if err := s.checkBatchApplyPermission(ctx, req, user, permission.EnvironmentSettingsSet); err != nil {
+				return err
+			}
+			if desired.Value.GetEnvironment() == nil {
+				return connect.NewError(connect.CodeInvalidArgument, errors.Errorf("setting %q requires the environment value", desired.Name))
+			}
+			if err := s.settingService.validateEnvironments(ctx, workspaceID, desired.Value.GetEnvironment().GetEnvironments()); err != nil {
+				return err
+			}
+		} else {
+			if err := s.checkBatchApplyPermission(ctx, req, user, permission.SettingsSet); err != nil {
+				return err
+			}
+			if err := s.licenseService.IsFeatureEnabled(ctx, workspaceID, v1pb.PlanFeature_FEATURE_APPROVAL_WORKFLOW); err != nil {
+				return connect.NewError(connect.CodePermissionDenied, err)
+			}
+			if desired.Value.GetWorkspaceApproval() == nil {
+				return connect.NewError(connect.CodeInvalidArgument, errors.Errorf("setting %q requires the workspace approval value", desired.Name))
+			}
+			if _, err := convertWorkspaceApprovalSetting(desired.Value.GetWorkspaceApproval()); err != nil {
+				return err
+			}
+		}
+	case *v1pb.ReviewConfig:
+		perm := permission.ReviewConfigsUpdate
+		if current == nil {
+			perm = permission.ReviewConfigsCreate
+		}
+		if err := s.checkBatchApplyPermission(ctx, req, user, perm); err != nil {
+			return err
+		}
+		if current == nil || slices.Contains(item.paths, "rules") {
+			if err := validateSQLReviewRules(desired.Rules); err != nil {
+				return connect.NewError(connect.CodeInvalidArgument, err)
+			}
+		}
+		if current == nil {
+			if _, err := convertToReviewConfigMessage(desired); err != nil {
+				return err
+			}
+		}
+	case *v1pb.BatchApplyRequest_DatabaseGroupsResource:
+		projectID, err := common.GetProjectID(desired.Parent)
+		if err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		// The database groups of a project created in the same request are empty.
+		if current == nil && !createdProjects[desired.Parent] {
+			return connect.NewError(connect.CodeNotFound, errors.Errorf("project %q not found", desired.Parent))
+		}
+		if len(desired.DatabaseGroups) > 0 {
+			if err := s.licenseService.IsFeatureEnabled(ctx, workspaceID, v1pb.PlanFeature_FEATURE_DATABASE_GROUPS); err != nil {
+				return connect.NewError(connect.CodePermissionDenied, err)
+			}
+		}
+		currentGroups := map[string]*v1pb.DatabaseGroup{}
+		if current != nil {
+			for _, group := range current.(*v1pb.BatchApplyRequest_DatabaseGroupsResource).DatabaseGroups {
+				currentGroups[group.Name] = group
+			}
+		}
+		var perms []permission.Permission
+		desiredGroups := map[string]bool{}
+		for _, group := range desired.DatabaseGroups {
+			groupProjectID, groupID, err := common.GetProjectIDDatabaseGroupID(group.Name)
+			if err != nil {
+				return connect.NewError(connect.CodeInvalidArgument, err)
+			}
+			if groupProjectID != projectID {
+				return connect.NewError(connect.CodeInvalidArgument, errors.Errorf("database group %q is not in project %q", group.Name, desired.Parent))
+			}
+			if desiredGroups[group.Name] {
+				return connect.NewError(connect.CodeInvalidArgument, errors.Errorf("database group %q is duplicated", group.Name))
+			}
+			desiredGroups[group.Name] = true
+			if !isValidResourceID(groupID) {
+				return connect.NewError(connect.CodeInvalidArgument, errors.Errorf("invalid database group id %q", groupID))
+			}
+			if group.Title == "" {
+				return connect.NewError(connect.CodeInvalidArgument, errors.Errorf("database group %q title is required", group.Name))
+			}
+			if group.DatabaseExpr == nil || group.DatabaseExpr.Expression == "" {
+				return connect.NewError(connect.CodeInvalidArgument, errors.Errorf("database group %q database expression is required", group.Name))
+			}
+			if _, err := common.ValidateGroupCELExpr(group.DatabaseExpr.Expression); err != nil {
+				return connect.NewError(connect.CodeInvalidArgument, errors.Wrapf(err, "invalid database group %q expression", group.Name))
+			}
+			if currentGroup, ok := currentGroups[group.Name]; !ok {
+				perms = append(perms, permission.DatabaseGroupsCreate)
+			} else if !proto.Equal(currentGroup, group) {
+				perms = append(perms, permission.DatabaseGroupsUpdate)
+			}
+		}
+		for name := range currentGroups {
+			if !desiredGroups[name] {
+				perms = append(perms, permission.DatabaseGroupsDelete)
+			}
+		}
+		// The creator of a project created in the same request owns its database groups.
+		if !createdProjects[desired.Parent] {
+			slices.Sort(perms)
+			for _, perm := range slices.Compact(perms) {
+				if err := s.checkBatchApplyPermission(ctx, req, user, perm, projectID); err != nil {
+					return err
+				}
+			}
+		}
+	default:
+	}
+	// Only return the etag to the callers allowed to apply the resource.
+	if current != nil {
+		item.result.Etag = getBatchApplyEtag(current)
+	}
+	return nil
+}
+
+func (s *WorkspaceService) setBatchApplyInstanceFuncs(item *batchApplyItem, instance *v1pb.Instance) {
+	item.get = func(ctx context.Context) (proto.Message, error) {
+		response, err := s.instanceService.GetInstance(ctx, connect.NewRequest(&v1pb.GetInstanceRequest{Name: instance.Name}))
+		if err != nil {
+			if connect.CodeOf(err) == connect.CodeNotFound {
+				return nil, nil
+			}
+			return nil, err
+		}
+		return response.Msg, nil
+	}
+	item.create = func(ctx context.Context) error {
+		instanceID, err := common.GetInstanceID(instance.Name)
+		if err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		_, err = s.instanceService.CreateInstance(ctx, connect.NewRequest(&v1pb.CreateInstanceRequest{
+			InstanceId: instanceID,
+			Instance:   instance,
+		}))
+		return err
+	}
+	item.update = func(ctx context.Context, m proto.Message, paths []string) error {
+		_, err := s.instanceService.UpdateInstance(ctx, connect.NewRequest(&v1pb.UpdateInstanceRequest{
+			Instance:   m.(*v1pb.Instance),
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: paths},
+		}))
+		return err
+	}
+	item.remove = func(ctx context.Context) error {
+		if _, err := s.instanceService.DeleteInstance(ctx, connect.NewRequest(&v1pb.DeleteInstanceRequest{Name: instance.Name, Force: true})); err != nil {
+			return err
+		}
+		_, err := s.instanceService.DeleteInstance(ctx, connect.NewRequest(&v1pb.DeleteInstanceRequest{Name: instance.Name, Purge: true}))
+		return err
+	}
+}
+
+func (s *WorkspaceService) setBatchApplyProjectFuncs(item *batchApplyItem, project *v1pb.Project) {
+	item.get = func(ctx context.Context) (proto.Message, error) {
+		response, err := s.projectService.GetProject(ctx, connect.NewRequest(&v1pb.GetProjectRequest{Name: project.Name}))
+		if err != nil {
+			if connect.CodeOf(err) == connect.CodeNotFound {
+				return nil, nil
+			}
+			return nil, err
+		}
+		return response.Msg, nil
+	}
+	item.create = func(ctx context.Context) error {
+		projectID, err := common.GetProjectID(project.Name)
+		if err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		_, err = s.projectService.CreateProject(ctx, connect.NewRequest(&v1pb.CreateProjectRequest{
+			ProjectId: projectID,
+			Project:   project,
+		}))
+		return err
+	}
+	item.write = func(ctx context.Context, apply *store.BatchApplyMessage) error {
+		projectID, err := common.GetProjectID(project.Name)
+		if err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		base, err := s.store.GetProject(ctx, &store.FindProjectMessage{Workspace: apply.Workspace, ResourceID: &projectID})
+		if err != nil {
+			return connect.NewError(connect.CodeInternal, err)
+		}
+		if base == nil {
+			return connect.NewError(connect.CodeAborted, errors.Errorf("project %q has been deleted since the validation", project.Name))
+		}
+		paths := item.paths
+		if item.current == nil {
+			// The project is created in the same request, so update the fields left over by the creation.
+			paths = getBatchApplyChangedFields(convertToProject(base), project, item.mask)
+		} else if getBatchApplyEtag(convertToProject(base)) != item.result.Etag {
+			return connect.NewError(connect.CodeAborted, errors.Errorf("there is concurrent update to %q, please refresh and try again", project.Name))
+		}
+		if len(paths) == 0 {
+			return nil
+		}
+		patch, err := s.projectService.buildUpdateProjectPatch(ctx, base, project, paths)
+		if err != nil {
+			return err
+		}
+		apply.Projects = append(apply.Projects, &store.BatchApplyProjectMessage{Patch: patch, Base: base})
+		return nil
+	}
+	item.remove = func(ctx context.Context) error {
+		_, err := s.projectService.DeleteProject(ctx, connect.NewRequest(&v1pb.DeleteProjectRequest{Name: project.Name, Purge: true}))
+		return err
+	}
+}
+
+func (s *WorkspaceService) setBatchApplyIamPolicyFuncs(item *batchApplyItem, iamPolicy *v1pb.BatchApplyRequest_IamPolicyResource) {
+	isWorkspace := strings.HasPrefix(iamPolicy.GetResource(), common.WorkspacePrefix)
+	item.get = func(ctx context.Context) (proto.Message, error) {
+		request := connect.NewRequest(&v1pb.GetIamPolicyRequest{Resource: iamPolicy.Resource})
+		if isWorkspace {
+			response, err := s.GetIamPolicy(ctx, request)
+			if err != nil {
+				return nil, err
+			}
+			return response.Msg, nil
+		}
+		response, err := s.projectService.GetIamPolicy(ctx, request)
+		if err != nil {
+			if connect.CodeOf(err) == connect.CodeNotFound {
+				return nil, nil
+			}
+			return nil, err
+		}
+		return response.Msg, nil
+	}
+	item.write = func(ctx context.Context, apply *store.BatchApplyMessage) error {
+		resourceType, getPolicy := storepb.Policy_WORKSPACE, s.store.GetWorkspaceIamPolicy
+		if !isWorkspace {
+			projectID, err := common.GetProjectID(iamPolicy.Resource)
+			if err != nil {
+				return connect.NewError(connect.CodeInvalidArgument, err)
+			}
+			resourceType = storepb.Policy_PROJECT
+			getPolicy = func(ctx context.Context, workspaceID string) (*store.IamPolicyMessage, error) {
+				return s.store.GetProjectIamPolicy(ctx, workspaceID, projectID)
+			}
+		}
+		oldPolicy, err := getPolicy(ctx, apply.Workspace)
+		if err != nil {
+			return connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to find iam policy"))
+		}
+		// The IAM policy of a project created in the same request is created with the project.
+		if item.current != nil && oldPolicy.Etag != item.result.Etag {
+			return connect.NewError(connect.CodeAborted, errors.Errorf("there is concurrent update to the iam policy of %q, please refresh and try again", iamPolicy.Resource))
+		}
+		policy, err := convertToStoreIamPolicy(iamPolicy.Policy)
+		if err != nil {
+			return err
+		}
+		payload, err := protojson.Marshal(policy)
+		if err != nil {
+			return connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to marshal iam policy"))
+		}
+		if oldPolicy.Etag == "" {
+			apply.CreatePolicies = append(apply.CreatePolicies, &store.PolicyMessage{
+				Workspace:    apply.Workspace,
+				ResourceType: resourceType,
+				Resource:     iamPolicy.Resource,
+				Payload:      string(payload),
+				Type:         storepb.Policy_IAM,
+				Enforce:      true,
+			})
+		} else {
+			apply.UpdatePolicies = append(apply.UpdatePolicies, &store.UpdatePolicyMessage{
+				ResourceType: resourceType,
+				Resource:     iamPolicy.Resource,
+				Type:         storepb.Policy_IAM,
+				Workspace:    apply.Workspace,
+				Payload:      new(string(payload)),
+				Etag:         &oldPolicy.Etag,
+			})
+		}
+		item.written = func(ctx context.Context) {
+			if err := s.iamManager.ReloadCache(ctx); err != nil {
+				slog.Error("failed to reload iam cache", log.BBError(err))
+			}
+			if !isWorkspace {
+				return
+			}
+			newPolicy, err := s.store.GetWorkspaceIamPolicy(ctx, apply.Workspace)
+			if err != nil {
+				slog.Error("failed to find workspace iam policy", log.BBError(err))
+				return
+			}
+			deltas := findIamPolicyDeltas(oldPolicy.Policy, newPolicy.Policy)
+			// send invite emails to newly added members.
+			go s.sendInviteEmails(context.WithoutCancel(ctx), apply.Workspace, oldPolicy.Policy, deltas)
+		}
+		return nil
+	}
+}
+
+func (s *WorkspaceService) setBatchApplyPolicyFuncs(item *batchApplyItem, policy *v1pb.Policy) {
+	item.get = func(ctx context.Context) (proto.Message, error) {
+		policyMessage, _, err := s.orgPolicyService.findPolicyMessage(ctx, policy.Name)
+		if err != nil {
+			if connect.CodeOf(err) == connect.CodeNotFound {
+				return nil, nil
+			}
+			return nil, err
+		}
+		response, err := convertToPolicy(policyMessage)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		return response, nil
+	}
+	item.write = func(ctx context.Context, apply *store.BatchApplyMessage) error {
+		policyMessage, parent, err := s.orgPolicyService.findPolicyMessage(ctx, policy.Name)
+		if err != nil && connect.CodeOf(err) != connect.CodeNotFound {
+			return err
+		}
+		if item.current == nil {
+			if policyMessage != nil {
+				return connect.NewError(connect.CodeAborted, errors.Errorf("policy %q has been created since the validation", policy.Name))
+			}
+			create, err := s.orgPolicyService.buildCreatePolicyMessage(ctx, parent, policy)
+			if err != nil {
+				return err
+			}
+			if slices.Contains(item.mask, "inherit_from_parent") {
+				create.InheritFromParent = policy.InheritFromParent
+			}
+			if slices.Contains(item.mask, "enforce") {
+				create.Enforce = policy.Enforce
+			}
+			apply.CreatePolicies = append(apply.CreatePolicies, create)
+			return nil
+		}
+		if policyMessage == nil {
+			return connect.NewError(connect.CodeAborted, errors.Errorf("policy %q has been deleted since the validation", policy.Name))
+		}
+		current, err := convertToPolicy(policyMessage)
+		if err != nil {
+			return connect.NewError(connect.CodeInternal, err)
+		}
+		if getBatchApplyEtag(current) != item.result.Etag {
+			return connect.NewError(connect.CodeAborted, errors.Errorf("there is concurrent update to %q, please refresh and try again", policy.Name))
+		}
+		patch, err := s.orgPolicyService.buildUpdatePolicyPatch(ctx, policyMessage, policy, item.paths)
+		if err != nil {
+			return err
+		}
+		patch.Etag = new(policyMessage.GetEtag())
+		apply.UpdatePolicies = append(apply.UpdatePolicies, patch)
+		return nil
+	}
+}
+
+func (s *WorkspaceService) setBatchApplySettingFuncs(item *batchApplyItem, setting *v1pb.Setting) {
+	item.get = func(ctx context.Context) (proto.Message, error) {
+		settingName, err := getBatchApplySettingName(setting.Name)
+		if err != nil {
+			return nil, err
+		}
+		settingMessage, err := s.store.GetSetting(ctx, common.GetWorkspaceIDFromContext(ctx), settingName)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get setting %q", setting.Name))
+		}
+		if settingMessage == nil {
+			return nil, nil
+		}
+		response, err := convertToSettingMessage(settingMessage)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		return response, nil
+	}
+	item.write = func(ctx context.Context, apply *store.BatchApplyMessage) error {
+		settingName, err := getBatchApplySettingName(setting.Name)
+		if err != nil {
+			return err
+		}
+		base, err := s.store.GetSetting(ctx, apply.Workspace, settingName)
+		if err != nil {
+			return connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get setting %q", setting.Name))
+		}
+		if item.current == nil {
+			if base != nil {
+				return connect.NewError(connect.CodeAborted, errors.Errorf("setting %q has been created since the validation", setting.Name))
+			}
+		} else {
+			if base == nil {
+				return connect.NewError(connect.CodeAborted, errors.Errorf("setting %q has been deleted since the validation", setting.Name))
+			}
+			current, err := convertToSettingMessage(base)
+			if err != nil {
+				return connect.NewError(connect.CodeInternal, err)
+			}
+			if getBatchApplyEtag(current) != item.result.Etag {
+				return connect.NewError(connect.CodeAborted, errors.Errorf("there is concurrent update to %q, please refresh and try again", setting.Name))
+			}
+		}
+
+		update := &store.SettingMessage{Name: settingName, Workspace: apply.Workspace}
+		if settingName == storepb.SettingName_ENVIRONMENT {
+			environmentSetting := convertEnvironmentSetting(setting.Value.GetEnvironment())
+			update.Value = environmentSetting
+			// Unset the removed environments from the instances and databases.
+			if base != nil {
+				environmentIDs := map[string]bool{}
+				for _, environment := range environmentSetting.Environments {
+					environmentIDs[environment.Id] = true
+				}
+				for _, environment := range base.Value.(*storepb.EnvironmentSetting).Environments {
+					if !environmentIDs[environment.Id] {
+						apply.RemovedEnvironments = append(apply.RemovedEnvironments, environment.Id)
+					}
+				}
+			}
+		} else {
+			payload, err := convertWorkspaceApprovalSetting(setting.Value.GetWorkspaceApproval())
+			if err != nil {
+				return err
+			}
+			update.Value = payload
+		}
+		apply.Settings = append(apply.Settings, &store.BatchApplySettingMessage{Setting: update, Base: base})
+		return nil
+	}
+}
+
+func (s *WorkspaceService) setBatchApplyReviewConfigFuncs(item *batchApplyItem, reviewConfig *v1pb.ReviewConfig) {
+	item.get = func(ctx context.Context) (proto.Message, error) {
+		id, err := common.GetReviewConfigID(reviewConfig.Name)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		message, err := s.store.GetReviewConfig(ctx, common.GetWorkspaceIDFromContext(ctx), id)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get review config %q", id))
+		}
+		if message == nil {
+			return nil, nil
+		}
+		return s.reviewConfigService.convertToV1ReviewConfig(ctx, message)
+	}
+	item.write = func(ctx context.Context, apply *store.BatchApplyMessage) error {
+		id, err := common.GetReviewConfigID(reviewConfig.Name)
+		if err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		base, err := s.store.GetReviewConfig(ctx, apply.Workspace, id)
+		if err != nil {
+			return connect.NewError(connect.CodeInternal, errors.Wrapf(err, "failed to get review config %q", id))
+		}
+		if item.current == nil {
+			if base != nil {
+				return connect.NewError(connect.CodeAborted, errors.Errorf("review config %q has been created since the validation", reviewConfig.Name))
+			}
+			create, err := convertToReviewConfigMessage(reviewConfig)
+			if err != nil {
+				return err
+			}
+			apply.ReviewConfigs = append(apply.ReviewConfigs, &store.BatchApplyReviewConfigMessage{ReviewConfig: create})
+			return nil
+		}
+		if base == nil {
+			return connect.NewError(connect.CodeAborted, errors.Errorf("review config %q has been deleted since the validation", reviewConfig.Name))
+		}
+		current, err := s.reviewConfigService.convertToV1ReviewConfig(ctx, base)
+		if err != nil {
+			return err
+		}
+		if getBatchApplyEtag(current) != item.result.Etag {
+			return connect.NewError(connect.CodeAborted, errors.Errorf("there is concurrent update to %q, please refresh and try again", reviewConfig.Name))
+		}
+		update := &store.ReviewConfigMessage{
+			ID:      base.ID,
+			Name:    base.Name,
+			Enforce: base.Enforce,
+			Payload: base.Payload,
+		}
+		for _, path := range item.paths {
+			switch path {
+			case "title":
+				update.Name = reviewConfig.Title
+			case "enabled":
+				update.Enforce = reviewConfig.Enabled
+			case "rules":
+				ruleList, err := ConvertToSQLReviewRules(reviewConfig.Rules)
+				if err != nil {
+					return connect.NewError(connect.CodeInternal, errors.Wrap(err, "failed to convert rules"))
+				}
+				update.Payload = &storepb.ReviewConfigPayload{SqlReviewRules: ruleList}
+			default:
+			}
+		}
+		apply.ReviewConfigs = append(apply.ReviewConfigs, &store.BatchApplyReviewConfigMessage{ReviewConfig: update, Base: base})
+		return nil
+	}
+}
+
+func (s *WorkspaceService) setBatchApplyDatabaseGroupsFuncs(item *batchApplyItem, databaseGroups *v1pb.BatchApplyRequest_DatabaseGroupsResource) {
+	item.get = func(ctx context.Context) (proto.Message, error) {
+		projectID, err := common.GetProjectID(databaseGroups.GetParent())
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		project, err := s.store.GetProject(ctx, &store.FindProjectMessage{Workspace: common.GetWorkspaceIDFromContext(ctx), ResourceID: &projectID})
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		if project == nil || project.Deleted {
+			return nil, nil
+		}
+		groups, err := s.store.ListDatabaseGroups(ctx, &store.FindDatabaseGroupMessage{ProjectID: &projectID})
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		return convertToBatchApplyDatabaseGroups(projectID, groups), nil
+	}
+	item.write = func(ctx context.Context, apply *store.BatchApplyMessage) error {
+		projectID, err := common.GetProjectID(databaseGroups.Parent)
+		if err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		base, err := s.store.ListDatabaseGroups(ctx, &store.FindDatabaseGroupMessage{ProjectID: &projectID})
+		if err != nil {
+			return connect.NewError(connect.CodeInternal, err)
+		}
+		// The project created in the same request has no database groups.
+		if item.current != nil && getBatchApplyEtag(convertToBatchApplyDatabaseGroups(projectID, base)) != item.result.Etag {
+			return connect.NewError(connect.CodeAborted, errors.Errorf("there is concurrent update to the database groups of %q, please refresh and try again", databaseGroups.Parent))
+		}
+		replace := &store.BatchApplyDatabaseGroupsMessage{ProjectID: projectID, Base: base}
+		for _, group := range databaseGroups.DatabaseGroups {
+			_, groupID, err := common.GetProjectIDDatabaseGroupID(group.Name)
+			if err != nil {
+				return connect.NewError(connect.CodeInvalidArgument, err)
+			}
+			replace.DatabaseGroups = append(replace.DatabaseGroups, &store.DatabaseGroupMessage{
+				ProjectID:  projectID,
+				ResourceID: groupID,
+				Title:      group.Title,
+				Expression: group.DatabaseExpr,
+			})
+		}
+		apply.DatabaseGroups = append(apply.DatabaseGroups, replace)
+		return nil
+	}
+}
+
+// getBatchApplySettingName returns the name of a setting supported by BatchApply.
+func getBatchApplySettingName(name string) (storepb.SettingName, error) {
+	settingName, err := common.GetSettingName(name)
+	if err != nil {
+		return storepb.SettingName_SETTING_NAME_UNSPECIFIED, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	storeSettingName, err := convertStringToSettingName(settingName)
+	if err != nil {
+		return storepb.SettingName_SETTING_NAME_UNSPECIFIED, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("invalid setting name: %v", err))
+	}
+	switch storeSettingName {
+	case storepb.SettingName_ENVIRONMENT, storepb.SettingName_WORKSPACE_APPROVAL:
+		return storeSettingName, nil
+	default:
+		return storepb.SettingName_SETTING_NAME_UNSPECIFIED, connect.NewError(connect.CodeInvalidArgument, errors.Errorf("setting %q is not supported", name))
+	}
+}
+
+// convertToBatchApplyDatabaseGroups converts the database groups of a project to a BatchApply resource.
+func convertToBatchApplyDatabaseGroups(projectID string, groups []*store.DatabaseGroupMessage) *v1pb.BatchApplyRequest_DatabaseGroupsResource {
+	databaseGroups := &v1pb.BatchApplyRequest_DatabaseGroupsResource{Parent: common.FormatProject(projectID)}
+	for _, group := range groups {
+		databaseGroups.DatabaseGroups = append(databaseGroups.DatabaseGroups, &v1pb.DatabaseGroup{
+			Name:         fmt.Sprintf("%s/%s%s", common.FormatProject(projectID), common.DatabaseGroupNamePrefix, group.ResourceID),
+			Title:        group.Title,
+			DatabaseExpr: group.Expression,
+		})
+	}
+	return normalizeBatchApplyDatabaseGroups(databaseGroups)
+}
+
+// normalizeBatchApplyDatabaseGroups keeps the applied fields of the database groups and sorts them by name.
+func normalizeBatchApplyDatabaseGroups(databaseGroups *v1pb.BatchApplyRequest_DatabaseGroupsResource) *v1pb.BatchApplyRequest_DatabaseGroupsResource {
+	if databaseGroups == nil {
+		return nil
+	}
+	normalized := &v1pb.BatchApplyRequest_DatabaseGroupsResource{Parent: databaseGroups.Parent}
+	for _, group := range databaseGroups.DatabaseGroups {
+		normalized.DatabaseGroups = append(normalized.DatabaseGroups, &v1pb.DatabaseGroup{
+			Name:         group.GetName(),
+			Title:        group.GetTitle(),
+			DatabaseExpr: group.GetDatabaseExpr(),
+		})
+	}
+	slices.SortStableFunc(normalized.DatabaseGroups, func(a, b *v1pb.DatabaseGroup) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+	return normalized
+}
+
+func (s *WorkspaceService) checkBatchApplyPermission(ctx context.Context, req connect.AnyRequest, user *store.UserMessage, perm permission.Permission, projectIDs ...string) error {
+	ok, err := s.iamManager.CheckPermission(ctx, perm, user, common.GetWorkspaceIDFromContext(ctx), projectIDs...)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, errors.Errorf("failed to check permission with error: %v", err.Error()))
+	}
+	if !ok {
+		err := connect.NewError(connect.CodePermissionDenied, errors.Errorf("user does not have permission %q", perm))
+		if detail, detailErr := connect.NewErrorDetail(&v1pb.PermissionDeniedDetail{
+			Method:              req.Spec().Procedure,
+			RequiredPermissions: []string{string(perm)},
+		}); detailErr == nil {
+			err.AddDetail(detail)
+		}
+		return err
+	}
+	return nil
+}
+
+func getBatchApplyPolicyPermission(policyType storepb.Policy_Type, create bool) permission.Permission {
+	switch policyType {
+	case storepb.Policy_MASKING_EXEMPTION:
+		if create {
+			return permission.PoliciesCreateMaskingExemptionPolicy
+		}
+		return permission.PoliciesUpdateMaskingExemptionPolicy
+	case storepb.Policy_MASKING_RULE:
+		if create {
+			return permission.PoliciesCreateMaskingRulePolicy
+		}
+		return permission.PoliciesUpdateMaskingRulePolicy
+	default:
+		if create {
+			return permission.PoliciesCreate
+		}
+		return permission.PoliciesUpdate
+	}
+}
+
+// getBatchApplyOrder returns the apply order of the resource so that a
+// resource is applied after the ones it may refer to.
+func getBatchApplyOrder(m proto.Message) int {
+	switch m.(type) {
+	case *v1pb.Instance:
+		return 0
+	case *v1pb.Project:
+		return 1
+	case *v1pb.IamPolicy:
+		return 2
+	default:
+		return 3
+	}
+}
+
+// getBatchApplyEtag returns the etag of the resource.
+// The IAM policies have their own etags, and the etags of the other resources
+// are the digests of the fields managed by BatchApply.
+func getBatchApplyEtag(m proto.Message) string {
+	var fields []string
+	switch r := m.(type) {
+	case *v1pb.IamPolicy:
+		return r.Etag
+	case *v1pb.Instance:
+		fields = batchApplyInstanceFields
+	case *v1pb.Project:
+		fields = batchApplyProjectFields
+	case *v1pb.Setting:
+		fields = batchApplySettingFields
+	case *v1pb.ReviewConfig:
+		fields = batchApplyReviewConfigFields
+	case *v1pb.BatchApplyRequest_DatabaseGroupsResource:
+		fields = batchApplyDatabaseGroupsFields
+	default:
+		fields = batchApplyPolicyFields
+	}
+	// Deterministic marshaling sorts the map entries.
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(keepBatchApplyFields(m, fields))
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+// getBatchApplyChangedFields returns the fields in paths that differ between current and desired.
+func getBatchApplyChangedFields(current, desired proto.Message, paths []string) []string {
+	if policy, ok := desired.(*v1pb.IamPolicy); ok {
+		// Compare the bindings in the form the server stores them.
+		if storePolicy, err := convertToStoreIamPolicy(policy); err == nil {
+			if normalized, err := convertToV1IamPolicy(&store.IamPolicyMessage{Policy: storePolicy}); err == nil {
+				desired = normalized
+			}
+		}
+	}
+	var changed []string
+	for _, path := range paths {
+		if !proto.Equal(keepBatchApplyFields(current, []string{path}), keepBatchApplyFields(desired, []string{path})) {
+			changed = append(changed, path)
+		}
+	}
+	return changed
+}
+
+// keepBatchApplyFields returns a copy of m with only the given fields.
+func keepBatchApplyFields(m proto.Message, fields []string) proto.Message {
+	clone := proto.Clone(m)
+	r := clone.ProtoReflect()
+	var cleared []protoreflect.FieldDescriptor
+	r.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		if !slices.Contains(fields, string(fd.Name())) {
+			cleared = append(cleared, fd)
+		}
+		return true
+	})
+	for _, fd := range cleared {
+		r.Clear(fd)
+	}
+	return clone
+}
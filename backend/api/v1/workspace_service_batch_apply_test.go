@@ -0,0 +1,112 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/type/expr"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+func TestGetBatchApplyChangedFields(t *testing.T) {
+	current := &v1pb.Instance{
+		Name:         "instances/prod",
+		Title:        "Prod",
+		Engine:       v1pb.Engine_POSTGRES,
+		Environment:  new("environments/prod"),
+		Activation:   true,
+		SyncInterval: durationpb.New(3600_000_000_000),
+		Labels:       map[string]string{"team": "hr"},
+	}
+
+	desired := proto.CloneOf(current)
+	// The fields not managed by the request are ignored.
+	desired.EngineVersion = "16.0"
+	require.Empty(t, getBatchApplyChangedFields(current, desired, batchApplyInstanceFields))
+
+	desired.Title = "Production"
+	desired.Environment = nil
+	desired.Labels = map[string]string{"team": "people"}
+	require.Equal(t, []string{"title", "environment", "labels"}, getBatchApplyChangedFields(current, desired, batchApplyInstanceFields))
+	require.Equal(t, []string{"labels"}, getBatchApplyChangedFields(current, desired, []string{"activation", "labels"}))
+
+	policy := &v1pb.Policy{
+		Name:    "projects/hr/policies/masking_exemption",
+		Type:    v1pb.PolicyType_MASKING_EXEMPTION,
+		Enforce: true,
+		Policy:  &v1pb.Policy_MaskingExemptionPolicy{MaskingExemptionPolicy: &v1pb.MaskingExemptionPolicy{}},
+	}
+	desiredPolicy := proto.CloneOf(policy)
+	desiredPolicy.Enforce = false
+	desiredPolicy.Policy = &v1pb.Policy_MaskingExemptionPolicy{MaskingExemptionPolicy: &v1pb.MaskingExemptionPolicy{
+		Exemptions: []*v1pb.MaskingExemptionPolicy_Exemption{{Members: []string{"user:alice@example.com"}}},
+	}}
+	require.Equal(t, []string{"enforce", "masking_exemption_policy"}, getBatchApplyChangedFields(policy, desiredPolicy, batchApplyPolicyFields))
+}
+
+func TestGetBatchApplyEtag(t *testing.T) {
+	project := &v1pb.Project{
+		Name:   "projects/hr",
+		Title:  "HR",
+		Labels: map[string]string{"a": "1", "b": "2", "c": "3"},
+	}
+	etag := getBatchApplyEtag(project)
+	require.NotEmpty(t, etag)
+	for range 10 {
+		require.Equal(t, etag, getBatchApplyEtag(proto.CloneOf(project)))
+	}
+
+	// The etag only covers the fields managed by BatchApply.
+	withWebhook := proto.CloneOf(project)
+	withWebhook.Webhooks = []*v1pb.Webhook{{Title: "Slack"}}
+	require.Equal(t, etag, getBatchApplyEtag(withWebhook))
+
+	renamed := proto.CloneOf(project)
+	renamed.Title = "Human Resources"
+	require.NotEqual(t, etag, getBatchApplyEtag(renamed))
+
+	// The IAM policies use their own etags.
+	require.Equal(t, "etag", getBatchApplyEtag(&v1pb.IamPolicy{Etag: "etag"}))
+}
+
+func TestBatchApplyDatabaseGroups(t *testing.T) {
+	current := convertToBatchApplyDatabaseGroups("hr", []*store.DatabaseGroupMessage{
+		{ProjectID: "hr", ResourceID: "prod", Title: "Prod", Expression: &expr.Expr{Expression: `resource.environment_name == "prod"`}},
+		{ProjectID: "hr", ResourceID: "all", Title: "All", Expression: &expr.Expr{Expression: "true"}},
+	})
+	require.Equal(t, "projects/hr", current.Parent)
+	require.Equal(t, "projects/hr/databaseGroups/all", current.DatabaseGroups[0].Name)
+
+	// The desired database groups are compared regardless of their order and matched databases.
+	desired := normalizeBatchApplyDatabaseGroups(&v1pb.BatchApplyRequest_DatabaseGroupsResource{
+		Parent: "projects/hr",
+		DatabaseGroups: []*v1pb.DatabaseGroup{
+			{
+				Name:             "projects/hr/databaseGroups/prod",
+				Title:            "Prod",
+				DatabaseExpr:     &expr.Expr{Expression: `resource.environment_name == "prod"`},
+				MatchedDatabases: []*v1pb.DatabaseGroup_Database{{Name: "instances/prod/databases/hr"}},
+			},
+			{Name: "projects/hr/databaseGroups/all", Title: "All", DatabaseExpr: &expr.Expr{Expression: "true"}},
+		},
+	})
+	require.Empty(t, getBatchApplyChangedFields(current, desired, batchApplyDatabaseGroupsFields))
+	require.Equal(t, getBatchApplyEtag(current), getBatchApplyEtag(desired))
+
+	desired.DatabaseGroups = desired.DatabaseGroups[1:]
+	require.Equal(t, []string{"database_groups"}, getBatchApplyChangedFields(current, desired, batchApplyDatabaseGroupsFields))
+	require.NotEqual(t, getBatchApplyEtag(current), getBatchApplyEtag(desired))
+}
+
+func TestGetBatchApplySettingName(t *testing.T) {
+	_, err := getBatchApplySettingName("settings/ENVIRONMENT")
+	require.NoError(t, err)
+	_, err = getBatchApplySettingName("settings/WORKSPACE_APPROVAL")
+	require.NoError(t, err)
+	_, err = getBatchApplySettingName("settings/WORKSPACE_PROFILE")
+	require.Error(t, err)
+}
@@ -104,6 +104,25 @@ var MaskingExemptionPolicyCELAttributes = []cel.EnvOption{
 	cel.ParserExpressionSizeLimit(celLimit),
 }
 
+// RowFilterConditionCELAttributes are the variables when evaluating the tables of a row filter.
+var RowFilterConditionCELAttributes = []cel.EnvOption{
+	cel.Variable(CELAttributeResourceInstanceID, cel.StringType),
+	cel.Variable(CELAttributeResourceDatabaseName, cel.StringType),
+	cel.Variable(CELAttributeResourceSchemaName, cel.StringType),
+	cel.Variable(CELAttributeResourceTableName, cel.StringType),
+	cel.ParserExpressionSizeLimit(celLimit),
+}
+
+// RowFilterExpressionCELAttributes are the variables of the row filter expression.
+var RowFilterExpressionCELAttributes = []cel.EnvOption{
+	cel.Variable(CELAttributeRow, cel.MapType(cel.StringType, cel.DynType)),
+	cel.Variable(CELAttributeUserEmail, cel.StringType),
+	cel.Variable(CELAttributeUserName, cel.StringType),
+	cel.Variable(CELAttributeUserGroups, cel.ListType(cel.StringType)),
+	cel.Variable(CELAttributeUserRoles, cel.ListType(cel.StringType)),
+	cel.ParserExpressionSizeLimit(celLimit),
+}
+
 // DatabaseGroupCELAttributes are the variables when evaluating database group conditions.
 var DatabaseGroupCELAttributes = []cel.EnvOption{
 	cel.Variable(CELAttributeResourceEnvironmentID, cel.StringType),
@@ -175,6 +194,11 @@ func ValidateMaskingExemptionCELExpr(expression *expr.Expr) (cel.Program, error)
 	return validateCELExpr(expression, MaskingExemptionPolicyCELAttributes)
 }
 
+// ValidateRowFilterConditionCELExpr validates the condition of a row filter.
+func ValidateRowFilterConditionCELExpr(expression *expr.Expr) (cel.Program, error) {
+	return validateCELExpr(expression, RowFilterConditionCELAttributes)
+}
+
 func ValidateProjectMemberCELExpr(expression *expr.Expr) (cel.Program, error) {
 	return validateCELExpr(expression, IAMPolicyConditionCELAttributes)
 }
@@ -51,6 +51,20 @@ const (
 	CELAttributeRequestTime = "request.time"
 )
 
+// CEL attribute names for row filter expressions.
+const (
+	// CELAttributeRow is the row of the table, e.g. row.region.
+	CELAttributeRow = "row"
+	// CELAttributeUserEmail is the email of the user.
+	CELAttributeUserEmail = "user.email"
+	// CELAttributeUserName is the name of the user.
+	CELAttributeUserName = "user.name"
+	// CELAttributeUserGroups is the groups of the user in group:{email} format.
+	CELAttributeUserGroups = "user.groups"
+	// CELAttributeUserRoles is the roles of the user in roles/{role} format.
+	CELAttributeUserRoles = "user.roles"
+)
+
 // CEL attribute names for risk scope.
 const (
 	// CELAttributeRiskLevel is the risk level of the issue (LOW, MODERATE, HIGH).
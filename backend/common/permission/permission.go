@@ -13,10 +13,16 @@ type Permission = string
 
 const (
 	AccessGrantsActivate                 Permission = "bb.accessGrants.activate"
+	AccessGrantsBreakGlass               Permission = "bb.accessGrants.breakGlass"
 	AccessGrantsCreate                   Permission = "bb.accessGrants.create"
 	AccessGrantsGet                      Permission = "bb.accessGrants.get"
 	AccessGrantsList                     Permission = "bb.accessGrants.list"
 	AccessGrantsRevoke                   Permission = "bb.accessGrants.revoke"
+	AccessReviewsCreate                  Permission = "bb.accessReviews.create"
+	AccessReviewsExport                  Permission = "bb.accessReviews.export"
+	AccessReviewsGet                     Permission = "bb.accessReviews.get"
+	AccessReviewsList                    Permission = "bb.accessReviews.list"
+	AccessReviewsUpdate                  Permission = "bb.accessReviews.update"
 	AuditLogsExport                      Permission = "bb.auditLogs.export"
 	AuditLogsSearch                      Permission = "bb.auditLogs.search"
 	ChangelogsGet                        Permission = "bb.changelogs.get"
@@ -52,8 +52,14 @@ const (
 	FileNamePrefix             = "files/"
 	RevisionNamePrefix         = "revisions/"
 	AccessGrantNamePrefix      = "accessGrants/"
+	AccessReviewNamePrefix     = "accessReviews/"
+	AccessReviewEntryPrefix    = "entries/"
+	QueryResultSharePrefix     = "queryResultShares/"
+	SuggestionPrefix           = "classificationSuggestions/"
 	ServiceAccountNamePrefix   = "serviceAccounts/"
 	WorkloadIdentityNamePrefix = "workloadIdentities/"
+	OperationNamePrefix        = "operations/"
+	ExportArchiveNamePrefix    = "exportArchives/"
 
 	SchemaSuffix       = "/schema"
 	SDLSchemaSuffix    = "/sdlSchema"
@@ -108,6 +114,94 @@ func FormatAccessGrant(projectID string, id string) string {
 	return fmt.Sprintf("%s/%s%s", FormatProject(projectID), AccessGrantNamePrefix, id)
 }
 
+// GetAccessReviewID returns the access review ID from a resource name.
+func GetAccessReviewID(name string) (string, error) {
+	tokens, err := GetNameParentTokens(name, AccessReviewNamePrefix)
+	if err != nil {
+		return "", err
+	}
+	return tokens[0], nil
+}
+
+// GetAccessReviewIDEntryID returns the access review ID and entry ID from a resource name.
+func GetAccessReviewIDEntryID(name string) (string, int64, error) {
+	tokens, err := GetNameParentTokens(name, AccessReviewNamePrefix, AccessReviewEntryPrefix)
+	if err != nil {
+		return "", 0, err
+	}
+	entryID, err := strconv.ParseInt(tokens[1], 10, 64)
+	if err != nil {
+		return "", 0, errors.Errorf("invalid access review entry ID %q", tokens[1])
+	}
+	return tokens[0], entryID, nil
+}
+
+// FormatAccessReview returns the resource name for an access review.
+func FormatAccessReview(id string) string {
+	return fmt.Sprintf("%s%s", AccessReviewNamePrefix, id)
+}
+
+// GetOperationID returns the operation ID from a resource name.
+func GetOperationID(name string) (int64, error) {
+	tokens, err := GetNameParentTokens(name, OperationNamePrefix)
+	if err != nil {
+		return 0, err
+	}
+	id, err := strconv.ParseInt(tokens[0], 10, 64)
+	if err != nil {
+		return 0, errors.Errorf("invalid operation ID %q", tokens[0])
+	}
+	return id, nil
+}
+
+// FormatOperation returns the resource name for an operation.
+func FormatOperation(id int64) string {
+	return fmt.Sprintf("%s%d", OperationNamePrefix, id)
+}
+
+// GetExportArchiveID returns the export archive ID from a resource name.
+func GetExportArchiveID(name string) (string, error) {
+	tokens, err := GetNameParentTokens(name, ExportArchiveNamePrefix)
+	if err != nil {
+		return "", err
+	}
+	return tokens[0], nil
+}
+
+// FormatExportArchive returns the resource name for an export archive.
+func FormatExportArchive(id string) string {
+	return fmt.Sprintf("%s%s", ExportArchiveNamePrefix, id)
+}
+
+// FormatAccessReviewEntry returns the resource name for an access review entry.
+func FormatAccessReviewEntry(reviewID string, entryID int64) string {
+	return fmt.Sprintf("%s/%s%d", FormatAccessReview(reviewID), AccessReviewEntryPrefix, entryID)
+}
+
+// GetProjectIDQueryResultShareID returns the project ID and share ID from a query result share resource name.
+func GetProjectIDQueryResultShareID(name string) (string, string, error) {
+	tokens, err := GetNameParentTokens(name, ProjectNamePrefix, QueryResultSharePrefix)
+	if err != nil {
+		return "", "", err
+	}
+	return tokens[0], tokens[1], nil
+}
+
+// FormatQueryResultShare returns the resource name for a query result share.
+func FormatQueryResultShare(projectID string, id string) string {
+	return fmt.Sprintf("%s/%s%s", FormatProject(projectID), QueryResultSharePrefix, id)
+}
+
+// GetInstanceDatabaseClassificationSuggestionID returns the instance ID, database ID, and suggestion ID from a resource name.
+func GetInstanceDatabaseClassificationSuggestionID(name string) (string, string, string, error) {
+	// the name should be instances/{instance-id}/databases/{database-id}/classificationSuggestions/{suggestion-id}
+	tokens, err := GetNameParentTokens(name, InstanceNamePrefix, DatabaseIDPrefix, SuggestionPrefix)
+	if err != nil {
+		return "", "", "", err
+	}
+	return tokens[0], tokens[1], tokens[2], nil
+}
+
 // TrimSuffixAndGetInstanceDatabaseID trims the suffix from the name and returns the instance ID and database ID.
 func TrimSuffixAndGetInstanceDatabaseID(name string, suffix string) (string, string, error) {
 	trimmed, err := TrimSuffix(name, suffix)
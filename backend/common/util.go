@@ -6,6 +6,7 @@ import (
 	"encoding/base64"
 	"fmt"
 	"math/big"
+	"path"
 	"reflect"
 	"regexp"
 	"strconv"
@@ -258,3 +259,18 @@ func Uniq[T comparable](array []T) []T {
 
 	return res
 }
+
+// MatchTablePatterns reports whether the table matches any of the glob patterns.
+// Patterns containing a dot match "schema.table", otherwise they match the table name.
+func MatchTablePatterns(schema, table string, patterns []string) bool {
+	for _, pattern := range patterns {
+		name := table
+		if strings.Contains(pattern, ".") {
+			name = schema + "." + table
+		}
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
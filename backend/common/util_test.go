@@ -251,3 +251,12 @@ func TestValidatePhone(t *testing.T) {
 		}
 	}
 }
+
+func TestMatchTablePatterns(t *testing.T) {
+	a := require.New(t)
+	a.False(MatchTablePatterns("public", "orders", nil))
+	a.True(MatchTablePatterns("public", "orders", []string{"orders"}))
+	a.True(MatchTablePatterns("public", "orders", []string{"users", "public.order*"}))
+	a.False(MatchTablePatterns("sales", "orders", []string{"public.*"}))
+	a.True(MatchTablePatterns("", "audit_log", []string{"audit_*"}))
+}
@@ -41,6 +41,9 @@ type Bus struct {
 	// RunningPlanCheckRunsCancelFunc is the cancelFunc of running plan checks.
 	RunningPlanCheckRunsCancelFunc sync.Map // map[PlanCheckRunRef]context.CancelFunc
 
+	// RunningOperationsCancelFunc is the cancelFunc of running operations.
+	RunningOperationsCancelFunc sync.Map // map[int64]context.CancelFunc
+
 	// PlanCheckTickleChan is the tickler for plan check scheduler.
 	PlanCheckTickleChan chan int
 	// TaskRunTickleChan is the tickler for task run scheduler.
@@ -0,0 +1,348 @@
+package export
+
+import (
+	"bytes"
+	"crypto/hkdf"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"hash"
+	"io"
+	"strings"
+
+	"github.com/alexmullins/zip"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/xuri/excelize/v2"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/bytebase/bytebase/backend/common"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+)
+
+// WatermarkFilename is the name of the watermark file in the export archive.
+const WatermarkFilename = "bytebase-watermark.json"
+
+// watermarkMarkPrefix is the prefix of the mark embedded in the exported files.
+// The mark is "bytebase-export-watermark:{id}:{signature}".
+const watermarkMarkPrefix = "bytebase-export-watermark:"
+
+// signedWatermark is the content of the watermark file.
+type signedWatermark struct {
+	// Watermark is the marshalled v1pb.ExportWatermark.
+	Watermark json.RawMessage `json:"watermark"`
+	// Signature is the hex-encoded HMAC-SHA256 of the compacted watermark.
+	Signature string `json:"signature"`
+}
+
+// GetRecordedWatermarkFunc gets the watermark recorded by the server at the export, or nil if the export is not recorded.
+type GetRecordedWatermarkFunc func(id string) (*v1pb.ExportWatermark, error)
+
+// Watermarker records the SHA-256 digests of the files written to an export archive,
+// embeds the mark of the export in the files whose format allows it,
+// and writes a signed watermark identifying the exporter.
+type Watermarker struct {
+	key       []byte
+	watermark *v1pb.ExportWatermark
+	names     []string
+	hashes    map[string]hash.Hash
+	marked    map[string]bool
+}
+
+// NewWatermarker creates a watermarker signing the watermark with a key derived from the secret for the workspace.
+// The ID of the watermark is generated, and the files of the watermark are filled from the written files.
+func NewWatermarker(secret, workspace string, watermark *v1pb.ExportWatermark) (*Watermarker, error) {
+	key, err := deriveWatermarkKey(secret, workspace)
+	if err != nil {
+		return nil, err
+	}
+	watermark.Id = uuid.NewString()
+	return &Watermarker{
+		key:       key,
+		watermark: watermark,
+		hashes:    make(map[string]hash.Hash),
+		marked:    make(map[string]bool),
+	}, nil
+}
+
+// WriteZipEntry writes content to a zip file entry and records its digest.
+// The mark is embedded in SQL and XLSX files.
+func (w *Watermarker) WriteZipEntry(zipw *zip.Writer, filename string, content []byte, password string) error {
+	if isXLSXFile(filename) {
+		marked, err := embedXLSXMark(content, w.mark())
+		if err != nil {
+			return errors.Wrapf(err, "failed to embed watermark in %s", filename)
+		}
+		content = marked
+		w.marked[filename] = true
+	}
+	writer, err := w.CreateZipWriter(zipw, filename, password)
+	if err != nil {
+		return err
+	}
+	if _, err := writer.Write(content); err != nil {
+		return errors.Wrapf(err, "failed to write zip entry for %s", filename)
+	}
+	return nil
+}
+
+// CreateZipWriter creates a writer for the zip file entry which records the digest of the written content.
+// The mark is written as a leading comment of SQL files.
+// XLSX files must be written with WriteZipEntry to carry the mark.
+func (w *Watermarker) CreateZipWriter(zipw *zip.Writer, filename string, password string) (io.Writer, error) {
+	writer, err := CreateZipWriter(zipw, filename, password)
+	if err != nil {
+		return nil, err
+	}
+	h, ok := w.hashes[filename]
+	if !ok {
+		h = sha256.New()
+		w.hashes[filename] = h
+		w.names = append(w.names, filename)
+	}
+	writer = io.MultiWriter(writer, h)
+	if isSQLFile(filename) {
+		if _, err := writer.Write([]byte("-- " + w.mark() + "\n")); err != nil {
+			return nil, errors.Wrapf(err, "failed to write watermark in %s", filename)
+		}
+		w.marked[filename] = true
+	}
+	return writer, nil
+}
+
+// WriteWatermark writes the signed watermark to the archive and returns the watermark to be recorded by the server.
+// The watermark is not encrypted, so that the exporter can be identified without the password.
+func (w *Watermarker) WriteWatermark(zipw *zip.Writer) (*v1pb.ExportWatermark, error) {
+	w.watermark.Files = nil
+	for _, name := range w.names {
+		w.watermark.Files = append(w.watermark.Files, &v1pb.ExportWatermark_File{
+			Name:   name,
+			Sha256: hex.EncodeToString(w.hashes[name].Sum(nil)),
+			Marked: w.marked[name],
+		})
+	}
+	watermark, err := protojson.Marshal(w.watermark)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal watermark")
+	}
+	signature, err := signWatermark(w.key, watermark)
+	if err != nil {
+		return nil, err
+	}
+	content, err := json.MarshalIndent(&signedWatermark{
+		Watermark: watermark,
+		Signature: signature,
+	}, "", "  ")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal signed watermark")
+	}
+	if err := WriteZipEntry(zipw, WatermarkFilename, content, ""); err != nil {
+		return nil, err
+	}
+	return w.watermark, nil
+}
+
+func (w *Watermarker) mark() string {
+	return watermarkMarkPrefix + w.watermark.Id + ":" + signMark(w.key, w.watermark.Id)
+}
+
+// VerifyWatermark verifies the watermark of an export archive, or of a SQL or XLSX file extracted from it,
+// and returns the watermark recorded by the server.
+// The watermark must be signed for the workspace, and the digests of the files must match the recorded ones.
+// The password is required to verify the files of a password-protected archive.
+func VerifyWatermark(secret, workspace string, content []byte, password string, getRecorded GetRecordedWatermarkFunc) (*v1pb.ExportWatermark, error) {
+	key, err := deriveWatermarkKey(secret, workspace)
+	if err != nil {
+		return nil, err
+	}
+	zipReader, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	if err == nil {
+		for _, file := range zipReader.File {
+			if file.Name == WatermarkFilename {
+				return verifyArchive(key, zipReader, password, getRecorded)
+			}
+		}
+	}
+	return verifyFile(key, content, getRecorded)
+}
+
+func verifyArchive(key []byte, zipReader *zip.Reader, password string, getRecorded GetRecordedWatermarkFunc) (*v1pb.ExportWatermark, error) {
+	files := make(map[string]*zip.File)
+	for _, file := range zipReader.File {
+		files[file.Name] = file
+	}
+
+	content, err := readZipFile(files[WatermarkFilename], "")
+	if err != nil {
+		return nil, err
+	}
+	var signed signedWatermark
+	if err := json.Unmarshal(content, &signed); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal watermark")
+	}
+	signature, err := signWatermark(key, signed.Watermark)
+	if err != nil {
+		return nil, err
+	}
+	if !hmac.Equal([]byte(signed.Signature), []byte(signature)) {
+		return nil, errors.New("invalid watermark signature, or the archive is not exported from this workspace")
+	}
+	watermark := &v1pb.ExportWatermark{}
+	if err := common.ProtojsonUnmarshaler.Unmarshal(signed.Watermark, watermark); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal watermark")
+	}
+	recorded, err := getRecordedWatermark(watermark.Id, getRecorded)
+	if err != nil {
+		return nil, err
+	}
+
+	// The files are verified against the digests recorded by the server, not the ones in the archive.
+	if len(files) != len(recorded.Files)+1 {
+		return nil, errors.New("export archive files do not match the watermark")
+	}
+	for _, recordedFile := range recorded.Files {
+		file, ok := files[recordedFile.Name]
+		if !ok {
+			return nil, errors.Errorf("file %q is missing from the export archive", recordedFile.Name)
+		}
+		if file.IsEncrypted() && password == "" {
+			return nil, errors.New("password is required to verify the files of the export archive")
+		}
+		content, err := readZipFile(file, password)
+		if err != nil {
+			return nil, err
+		}
+		digest := sha256.Sum256(content)
+		if hex.EncodeToString(digest[:]) != recordedFile.Sha256 {
+			return nil, errors.Errorf("file %q was modified after the export", recordedFile.Name)
+		}
+	}
+	return recorded, nil
+}
+
+// verifyFile verifies the mark embedded in a file extracted from the export archive.
+// The returned watermark only contains the verified file.
+func verifyFile(key []byte, content []byte, getRecorded GetRecordedWatermarkFunc) (*v1pb.ExportWatermark, error) {
+	mark := extractMark(content)
+	if mark == "" {
+		return nil, errors.New("content has no watermark")
+	}
+	id, signature, ok := strings.Cut(strings.TrimPrefix(mark, watermarkMarkPrefix), ":")
+	if !ok || !hmac.Equal([]byte(signature), []byte(signMark(key, id))) {
+		return nil, errors.New("invalid watermark signature, or the file is not exported from this workspace")
+	}
+	recorded, err := getRecordedWatermark(id, getRecorded)
+	if err != nil {
+		return nil, err
+	}
+	digest := sha256.Sum256(content)
+	for _, file := range recorded.Files {
+		if file.Sha256 == hex.EncodeToString(digest[:]) {
+			recorded.Files = []*v1pb.ExportWatermark_File{file}
+			return recorded, nil
+		}
+	}
+	return nil, errors.New("file was modified after the export")
+}
+
+func getRecordedWatermark(id string, getRecorded GetRecordedWatermarkFunc) (*v1pb.ExportWatermark, error) {
+	recorded, err := getRecorded(id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get recorded watermark %q", id)
+	}
+	if recorded == nil {
+		return nil, errors.Errorf("watermark %q is not recorded in this workspace", id)
+	}
+	return recorded, nil
+}
+
+// extractMark returns the mark in the leading comment of a SQL file, or in the document description of a XLSX file.
+func extractMark(content []byte) string {
+	if line, _, _ := bytes.Cut(content, []byte("\n")); bytes.HasPrefix(line, []byte("-- "+watermarkMarkPrefix)) {
+		return strings.TrimPrefix(string(line), "-- ")
+	}
+	f, err := excelize.OpenReader(bytes.NewReader(content))
+	if err != nil {
+		return ""
+	}
+	defer f.Close()
+	props, err := f.GetDocProps()
+	if err != nil || !strings.HasPrefix(props.Description, watermarkMarkPrefix) {
+		return ""
+	}
+	return props.Description
+}
+
+func embedXLSXMark(content []byte, mark string) ([]byte, error) {
+	f, err := excelize.OpenReader(bytes.NewReader(content))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	props, err := f.GetDocProps()
+	if err != nil {
+		return nil, err
+	}
+	props.Description = mark
+	if err := f.SetDocProps(props); err != nil {
+		return nil, err
+	}
+	buf, err := f.WriteToBuffer()
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func isSQLFile(filename string) bool {
+	return strings.HasSuffix(strings.ToLower(filename), ".sql")
+}
+
+func isXLSXFile(filename string) bool {
+	return strings.HasSuffix(strings.ToLower(filename), ".xlsx")
+}
+
+// deriveWatermarkKey derives the signing key of the workspace from the server secret,
+// so that the secret isn't used directly and a watermark only verifies in the workspace it was exported from.
+func deriveWatermarkKey(secret, workspace string) ([]byte, error) {
+	key, err := hkdf.Key(sha256.New, []byte(secret), nil, "bytebase-export-watermark/"+workspace, sha256.Size)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to derive watermark key")
+	}
+	return key, nil
+}
+
+// signWatermark signs the compacted watermark, so that the signature doesn't depend on the indentation of the watermark file.
+func signWatermark(key []byte, watermark []byte) (string, error) {
+	var compacted bytes.Buffer
+	if err := json.Compact(&compacted, watermark); err != nil {
+		return "", errors.Wrap(err, "failed to compact watermark")
+	}
+	mac := hmac.New(sha256.New, key)
+	mac.Write(compacted.Bytes())
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
+
+// signMark signs the ID of the mark embedded in the files.
+func signMark(key []byte, id string) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(watermarkMarkPrefix + id))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func readZipFile(file *zip.File, password string) ([]byte, error) {
+	if file.IsEncrypted() {
+		file.SetPassword(password)
+	}
+	rc, err := file.Open()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open file %s in archive", file.Name)
+	}
+	defer rc.Close()
+	content, err := io.ReadAll(rc)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read file %s in archive", file.Name)
+	}
+	return content, nil
+}
@@ -0,0 +1,142 @@
+package export
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/alexmullins/zip"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+)
+
+// writeWatermarkedArchive writes an archive and returns it with the recorded watermark lookup.
+func writeWatermarkedArchive(t *testing.T, secret, password string, files map[string][]byte, names []string) ([]byte, GetRecordedWatermarkFunc) {
+	t.Helper()
+	var buf bytes.Buffer
+	zipw := zip.NewWriter(&buf)
+	watermarker, err := NewWatermarker(secret, "ws", &v1pb.ExportWatermark{
+		Exporter: "users/dev@example.com",
+		Source:   "instances/prod/databases/orders",
+		Masked:   true,
+	})
+	require.NoError(t, err)
+	for _, name := range names {
+		require.NoError(t, watermarker.WriteZipEntry(zipw, name, files[name], password))
+	}
+	watermark, err := watermarker.WriteWatermark(zipw)
+	require.NoError(t, err)
+	require.NoError(t, zipw.Close())
+	recorded := proto.CloneOf(watermark)
+	return buf.Bytes(), func(id string) (*v1pb.ExportWatermark, error) {
+		if id != recorded.Id {
+			return nil, nil
+		}
+		return proto.CloneOf(recorded), nil
+	}
+}
+
+func TestVerifyWatermark(t *testing.T) {
+	a := require.New(t)
+	files := map[string][]byte{
+		"prod/orders/statement-1.sql":        []byte("SELECT * FROM orders"),
+		"prod/orders/statement-1.result.csv": []byte("id,amount\n1,100\n"),
+	}
+	names := []string{"prod/orders/statement-1.sql", "prod/orders/statement-1.result.csv"}
+
+	archive, getRecorded := writeWatermarkedArchive(t, "secret", "", files, names)
+	watermark, err := VerifyWatermark("secret", "ws", archive, "", getRecorded)
+	a.NoError(err)
+	a.NotEmpty(watermark.Id)
+	a.Equal("users/dev@example.com", watermark.Exporter)
+	a.Equal("instances/prod/databases/orders", watermark.Source)
+	a.True(watermark.Masked)
+	a.Len(watermark.Files, 2)
+	a.Equal(names[0], watermark.Files[0].Name)
+	a.True(watermark.Files[0].Marked)
+	a.False(watermark.Files[1].Marked)
+
+	// The watermark signed with another secret or for another workspace is rejected.
+	_, err = VerifyWatermark("another", "ws", archive, "", getRecorded)
+	a.ErrorContains(err, "invalid watermark signature")
+	_, err = VerifyWatermark("secret", "another", archive, "", getRecorded)
+	a.ErrorContains(err, "invalid watermark signature")
+
+	// The watermark not recorded by the server is rejected.
+	_, err = VerifyWatermark("secret", "ws", archive, "", func(string) (*v1pb.ExportWatermark, error) { return nil, nil })
+	a.ErrorContains(err, "is not recorded")
+
+	// The encrypted files are verified with the password.
+	encrypted, getRecorded := writeWatermarkedArchive(t, "secret", "pass", files, names)
+	_, err = VerifyWatermark("secret", "ws", encrypted, "", getRecorded)
+	a.ErrorContains(err, "password is required")
+	watermark, err = VerifyWatermark("secret", "ws", encrypted, "pass", getRecorded)
+	a.NoError(err)
+	a.Equal("users/dev@example.com", watermark.Exporter)
+}
+
+func TestVerifyWatermarkModified(t *testing.T) {
+	a := require.New(t)
+	archive, getRecorded := writeWatermarkedArchive(t, "secret", "", map[string][]byte{"a.csv": []byte("1")}, []string{"a.csv"})
+
+	// Rewrite the archive with the same watermark but modified content.
+	zipReader, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	a.NoError(err)
+	var buf bytes.Buffer
+	zipw := zip.NewWriter(&buf)
+	for _, file := range zipReader.File {
+		content, err := readZipFile(file, "")
+		a.NoError(err)
+		if file.Name == "a.csv" {
+			content = []byte("2")
+		}
+		a.NoError(WriteZipEntry(zipw, file.Name, content, ""))
+	}
+	a.NoError(zipw.Close())
+
+	_, err = VerifyWatermark("secret", "ws", buf.Bytes(), "", getRecorded)
+	a.ErrorContains(err, `file "a.csv" was modified`)
+
+	_, err = VerifyWatermark("secret", "ws", []byte("not a zip"), "", getRecorded)
+	a.ErrorContains(err, "has no watermark")
+}
+
+func TestVerifyWatermarkExtractedFile(t *testing.T) {
+	a := require.New(t)
+	xlsx, err := XLSX(&v1pb.QueryResult{
+		ColumnNames: []string{"id"},
+		Rows:        []*v1pb.QueryRow{{Values: []*v1pb.RowValue{{Kind: &v1pb.RowValue_Int64Value{Int64Value: 1}}}}},
+	})
+	a.NoError(err)
+	files := map[string][]byte{
+		"statement-1.sql":         []byte("SELECT * FROM orders"),
+		"statement-1.result.xlsx": xlsx,
+	}
+	names := []string{"statement-1.sql", "statement-1.result.xlsx"}
+	archive, getRecorded := writeWatermarkedArchive(t, "secret", "", files, names)
+
+	zipReader, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	a.NoError(err)
+	for _, file := range zipReader.File {
+		if file.Name == WatermarkFilename {
+			continue
+		}
+		content, err := readZipFile(file, "")
+		a.NoError(err)
+		watermark, err := VerifyWatermark("secret", "ws", content, "", getRecorded)
+		a.NoError(err, file.Name)
+		a.Len(watermark.Files, 1)
+		a.Equal(file.Name, watermark.Files[0].Name)
+		a.True(watermark.Files[0].Marked)
+
+		_, err = VerifyWatermark("secret", "another", content, "", getRecorded)
+		a.ErrorContains(err, "invalid watermark signature", file.Name)
+	}
+
+	// The mark is kept but the statement is modified.
+	content, err := readZipFile(zipReader.File[0], "")
+	a.NoError(err)
+	_, err = VerifyWatermark("secret", "ws", append(content, []byte(" WHERE 1 = 1")...), "", getRecorded)
+	a.ErrorContains(err, "was modified")
+}
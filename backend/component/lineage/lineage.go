@@ -0,0 +1,190 @@
+// Package lineage records the column lineage from executed changes and synced view definitions.
+package lineage
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/store/model"
+)
+
+// RecordChange records the column lineage of the statements executed on the database.
+// The statements that cannot be analyzed are skipped.
+func RecordChange(ctx context.Context, stores *store.Store, instance *store.InstanceMessage, database *store.DatabaseMessage, statement string, changelog string) error {
+	engine := instance.Metadata.GetEngine()
+	if !base.SupportColumnLineage(engine) {
+		return nil
+	}
+	statements, err := base.SplitMultiSQL(engine, statement)
+	if err != nil {
+		return errors.Wrapf(err, "failed to split statement")
+	}
+	gCtx := newQuerySpanContext(stores, instance)
+
+	var lineages []*base.ColumnLineage
+	for _, stmt := range statements {
+		// Only the statements with queries can write lineage.
+		if stmt.Empty || !strings.Contains(strings.ToUpper(stmt.Text), "SELECT") {
+			continue
+		}
+		results, err := base.ExtractColumnLineage(ctx, gCtx, engine, []base.Statement{stmt}, database.DatabaseName, "")
+		if err != nil {
+			slog.Debug("failed to extract column lineage",
+				slog.String("instance", database.InstanceID),
+				slog.String("database", database.DatabaseName),
+				log.BBError(err))
+			continue
+		}
+		lineages = append(lineages, results...)
+	}
+
+	messages, err := convertColumnLineages(ctx, stores, instance, lineages, store.ColumnLineageOriginChange, &storepb.ColumnLineagePayload{Changelog: changelog})
+	if err != nil {
+		return err
+	}
+	return stores.UpsertColumnLineages(ctx, messages)
+}
+
+// SyncViews replaces the view lineage of the database with the lineage of the view definitions in the synced metadata.
+// The lineage to the tables that no longer exist is removed.
+func SyncViews(ctx context.Context, stores *store.Store, instance *store.InstanceMessage, database *store.DatabaseMessage, metadata *storepb.DatabaseSchemaMetadata) error {
+	engine := instance.Metadata.GetEngine()
+	if !base.SupportColumnLineage(engine) {
+		return nil
+	}
+	gCtx := newQuerySpanContext(stores, instance)
+
+	var tables []string
+	var lineages []*base.ColumnLineage
+	addView := func(schema, name, definition string, columns []*storepb.ColumnMetadata) {
+		if definition == "" {
+			return
+		}
+		spans, err := base.GetQuerySpan(ctx, gCtx, engine, []base.Statement{{Text: definition}}, database.DatabaseName, schema, !store.IsObjectCaseSensitive(instance))
+		if err != nil || len(spans) != 1 {
+			slog.Debug("failed to get query span of view",
+				slog.String("instance", database.InstanceID),
+				slog.String("database", database.DatabaseName),
+				slog.String("view", schema+"."+name),
+				log.BBError(err))
+			return
+		}
+		var columnNames []string
+		if len(columns) == len(spans[0].Results) {
+			for _, column := range columns {
+				columnNames = append(columnNames, column.Name)
+			}
+		}
+		results, err := base.GetColumnLineageFromQuerySpan(base.ColumnResource{
+			Database: database.DatabaseName,
+			Schema:   schema,
+			Table:    name,
+		}, columnNames, spans[0])
+		if err != nil {
+			return
+		}
+		lineages = append(lineages, results...)
+	}
+	for _, schema := range metadata.GetSchemas() {
+		for _, table := range schema.GetTables() {
+			tables = append(tables, schema.Name+"."+table.Name)
+		}
+		for _, view := range schema.GetViews() {
+			addView(schema.Name, view.Name, view.Definition, view.Columns)
+		}
+		for _, view := range schema.GetMaterializedViews() {
+			addView(schema.Name, view.Name, view.Definition, nil)
+		}
+	}
+
+	messages, err := convertColumnLineages(ctx, stores, instance, lineages, store.ColumnLineageOriginView, &storepb.ColumnLineagePayload{})
+	if err != nil {
+		return err
+	}
+	return stores.ReplaceViewColumnLineages(ctx, database.InstanceID, database.DatabaseName, tables, messages)
+}
+
+// convertColumnLineages converts the lineages to edges.
+// The lineages to the databases that are not managed are skipped.
+func convertColumnLineages(ctx context.Context, stores *store.Store, instance *store.InstanceMessage, lineages []*base.ColumnLineage, origin store.ColumnLineageOrigin, payload *storepb.ColumnLineagePayload) ([]*store.ColumnLineageMessage, error) {
+	managed := make(map[string]bool)
+	var messages []*store.ColumnLineageMessage
+	for _, lineage := range lineages {
+		databaseName := lineage.Target.Database
+		if _, ok := managed[databaseName]; !ok {
+			database, err := stores.GetDatabase(ctx, &store.FindDatabaseMessage{
+				Workspace:    instance.Workspace,
+				InstanceID:   &instance.ResourceID,
+				DatabaseName: &databaseName,
+			})
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to get database %q", databaseName)
+			}
+			managed[databaseName] = database != nil
+		}
+		if !managed[databaseName] {
+			continue
+		}
+		for source := range lineage.Sources {
+			messages = append(messages, &store.ColumnLineageMessage{
+				InstanceID: instance.ResourceID,
+				Target: store.ColumnLineageColumn{
+					DatabaseName: lineage.Target.Database,
+					Schema:       lineage.Target.Schema,
+					Table:        lineage.Target.Table,
+					Column:       lineage.Target.Column,
+				},
+				Source: store.ColumnLineageColumn{
+					DatabaseName: source.Database,
+					Schema:       source.Schema,
+					Table:        source.Table,
+					Column:       source.Column,
+				},
+				Origin:  origin,
+				Payload: payload,
+			})
+		}
+	}
+	return messages, nil
+}
+
+func newQuerySpanContext(stores *store.Store, instance *store.InstanceMessage) base.GetQuerySpanContext {
+	return base.GetQuerySpanContext{
+		InstanceID: instance.ResourceID,
+		GetDatabaseMetadataFunc: func(ctx context.Context, instanceID, databaseName string) (string, *model.DatabaseMetadata, error) {
+			dbMetadata, err := stores.GetDBSchema(ctx, &store.FindDBSchemaMessage{
+				Workspace:    instance.Workspace,
+				InstanceID:   instanceID,
+				DatabaseName: databaseName,
+			})
+			if err != nil {
+				return "", nil, err
+			}
+			if dbMetadata == nil {
+				return "", nil, nil
+			}
+			return databaseName, dbMetadata, nil
+		},
+		ListDatabaseNamesFunc: func(ctx context.Context, instanceID string) ([]string, error) {
+			databases, err := stores.ListDatabases(ctx, &store.FindDatabaseMessage{
+				Workspace:  instance.Workspace,
+				InstanceID: &instanceID,
+			})
+			if err != nil {
+				return nil, err
+			}
+			names := make([]string, 0, len(databases))
+			for _, database := range databases {
+				names = append(names, database.DatabaseName)
+			}
+			return names, nil
+		},
+	}
+}
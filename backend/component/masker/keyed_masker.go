@@ -0,0 +1,396 @@
+package masker
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+)
+
+// keyedStream returns n pseudo-random bytes derived from the key and the input.
+func keyedStream(key []byte, input string, n int) []byte {
+	var stream []byte
+	for counter := uint32(0); len(stream) < n; counter++ {
+		h := hmac.New(sha256.New, key)
+		_ = binary.Write(h, binary.BigEndian, counter)
+		_, _ = h.Write([]byte(input))
+		stream = h.Sum(stream)
+	}
+	return stream[:n]
+}
+
+// keyedUint64 returns a pseudo-random number derived from the key and the input.
+func keyedUint64(key []byte, input string) uint64 {
+	return binary.BigEndian.Uint64(keyedStream(key, input, 8))
+}
+
+// maskedString is the value returned for data that a keyed masker cannot keep the shape of.
+func maskedString() *v1pb.RowValue {
+	return &v1pb.RowValue{
+		Kind: &v1pb.RowValue_StringValue{
+			StringValue: "******",
+		},
+	}
+}
+
+// TokenizationMasker is the masker that replaces the data with a deterministic token of the same shape.
+type TokenizationMasker struct {
+	key    []byte
+	format storepb.Algorithm_TokenizationMask_Format
+}
+
+// NewTokenizationMasker returns a new TokenizationMasker.
+func NewTokenizationMasker(key []byte, format storepb.Algorithm_TokenizationMask_Format) *TokenizationMasker {
+	return &TokenizationMasker{
+		key:    key,
+		format: format,
+	}
+}
+
+// Mask implements Masker.Mask.
+func (m *TokenizationMasker) Mask(data *MaskData) *v1pb.RowValue {
+	switch kind := data.Data.Kind.(type) {
+	case *v1pb.RowValue_NullValue:
+		return data.Data
+	case *v1pb.RowValue_StringValue:
+		return &v1pb.RowValue{
+			Kind: &v1pb.RowValue_StringValue{
+				StringValue: m.tokenize(kind.StringValue),
+			},
+		}
+	case *v1pb.RowValue_BytesValue:
+		return &v1pb.RowValue{
+			Kind: &v1pb.RowValue_BytesValue{
+				BytesValue: []byte(m.tokenize(string(kind.BytesValue))),
+			},
+		}
+	case *v1pb.RowValue_Int32Value:
+		if v, err := strconv.ParseInt(m.tokenizeNumber(strconv.FormatInt(int64(kind.Int32Value), 10)), 10, 32); err == nil {
+			return &v1pb.RowValue{Kind: &v1pb.RowValue_Int32Value{Int32Value: int32(v)}}
+		}
+	case *v1pb.RowValue_Int64Value:
+		if v, err := strconv.ParseInt(m.tokenizeNumber(strconv.FormatInt(kind.Int64Value, 10)), 10, 64); err == nil {
+			return &v1pb.RowValue{Kind: &v1pb.RowValue_Int64Value{Int64Value: v}}
+		}
+	case *v1pb.RowValue_Uint32Value:
+		if v, err := strconv.ParseUint(m.tokenizeNumber(strconv.FormatUint(uint64(kind.Uint32Value), 10)), 10, 32); err == nil {
+			return &v1pb.RowValue{Kind: &v1pb.RowValue_Uint32Value{Uint32Value: uint32(v)}}
+		}
+	case *v1pb.RowValue_Uint64Value:
+		if v, err := strconv.ParseUint(m.tokenizeNumber(strconv.FormatUint(kind.Uint64Value, 10)), 10, 64); err == nil {
+			return &v1pb.RowValue{Kind: &v1pb.RowValue_Uint64Value{Uint64Value: v}}
+		}
+	case *v1pb.RowValue_DoubleValue:
+		if v, err := strconv.ParseFloat(m.tokenizeNumber(strconv.FormatFloat(kind.DoubleValue, 'f', -1, 64)), 64); err == nil {
+			return &v1pb.RowValue{Kind: &v1pb.RowValue_DoubleValue{DoubleValue: v}}
+		}
+	case *v1pb.RowValue_FloatValue:
+		if v, err := strconv.ParseFloat(m.tokenizeNumber(strconv.FormatFloat(float64(kind.FloatValue), 'f', -1, 32)), 32); err == nil {
+			return &v1pb.RowValue{Kind: &v1pb.RowValue_FloatValue{FloatValue: float32(v)}}
+		}
+	case *v1pb.RowValue_ValueValue:
+		return &v1pb.RowValue{
+			Kind: &v1pb.RowValue_ValueValue{
+				ValueValue: maskProtoValue(m, kind.ValueValue),
+			},
+		}
+	default:
+	}
+	return maskedString()
+}
+
+func (m *TokenizationMasker) tokenize(s string) string {
+	switch m.format {
+	case storepb.Algorithm_TokenizationMask_EMAIL:
+		if at := strings.LastIndex(s, "@"); at > 0 {
+			return tokenizeCharacters(m.key, s[:at], false) + s[at:]
+		}
+	case storepb.Algorithm_TokenizationMask_PAYMENT_CARD:
+		return tokenizePaymentCard(m.key, s)
+	default:
+	}
+	return tokenizeCharacters(m.key, s, false)
+}
+
+// tokenizeNumber tokenizes the digits of a formatted number and keeps the number of digits.
+func (m *TokenizationMasker) tokenizeNumber(s string) string {
+	if m.format == storepb.Algorithm_TokenizationMask_PAYMENT_CARD {
+		return tokenizePaymentCard(m.key, s)
+	}
+	return tokenizeCharacters(m.key, s, true)
+}
+
+// tokenizeCharacters replaces digits with digits and ASCII letters with letters of the same case.
+// Other characters are kept. If nonZeroLeading is set, the leading digit is never zero so numbers
+// keep their number of digits.
+func tokenizeCharacters(key []byte, s string, nonZeroLeading bool) string {
+	runes := []rune(s)
+	stream := keyedStream(key, s, len(runes))
+	leading := true
+	for i, r := range runes {
+		b := int(stream[i])
+		switch {
+		case r >= '0' && r <= '9':
+			if nonZeroLeading && leading && len(runes) > i+1 && runes[i+1] >= '0' && runes[i+1] <= '9' {
+				runes[i] = rune('1' + b%9)
+			} else {
+				runes[i] = rune('0' + b%10)
+			}
+			leading = false
+		case r >= 'a' && r <= 'z':
+			runes[i] = rune('a' + b%26)
+		case r >= 'A' && r <= 'Z':
+			runes[i] = rune('A' + b%26)
+		default:
+		}
+	}
+	return string(runes)
+}
+
+// tokenizePaymentCard tokenizes the digits of a card number and recomputes the Luhn check digit,
+// so the token keeps its length and separators and passes the Luhn check.
+func tokenizePaymentCard(key []byte, s string) string {
+	runes := []rune(s)
+	var digits []int
+	for i, r := range runes {
+		if r >= '0' && r <= '9' {
+			digits = append(digits, i)
+		}
+	}
+	if len(digits) < 2 {
+		return tokenizeCharacters(key, s, false)
+	}
+	stream := keyedStream(key, s, len(digits))
+	for i, index := range digits[:len(digits)-1] {
+		d := int(stream[i]) % 10
+		if i == 0 && d == 0 {
+			d = 1 + int(stream[i])%9
+		}
+		runes[index] = rune('0' + d)
+	}
+	sum := 0
+	// The check digit is the rightmost digit, so the digits to its left are doubled alternately starting from the nearest one.
+	for i := len(digits) - 2; i >= 0; i-- {
+		d := int(runes[digits[i]] - '0')
+		if (len(digits)-2-i)%2 == 0 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	runes[digits[len(digits)-1]] = rune('0' + (10-sum%10)%10)
+	return string(runes)
+}
+
+// Equal implements Masker.Equal.
+func (m *TokenizationMasker) Equal(other Masker) bool {
+	if o, ok := other.(*TokenizationMasker); ok {
+		return bytes.Equal(m.key, o.key) && m.format == o.format
+	}
+	return false
+}
+
+// DateShiftMasker is the masker that shifts dates by a deterministic number of days.
+// Dates of the same subject are shifted by the same number of days, so intervals are kept.
+type DateShiftMasker struct {
+	key           []byte
+	maxShiftDays  int32
+	subjectColumn string
+}
+
+// NewDateShiftMasker returns a new DateShiftMasker.
+func NewDateShiftMasker(key []byte, maxShiftDays int32, subjectColumn string) *DateShiftMasker {
+	return &DateShiftMasker{
+		key:           key,
+		maxShiftDays:  maxShiftDays,
+		subjectColumn: subjectColumn,
+	}
+}
+
+// SubjectColumn returns the name of the column identifying the subject.
+func (m *DateShiftMasker) SubjectColumn() string {
+	return m.subjectColumn
+}
+
+func (m *DateShiftMasker) shiftDays(subject *v1pb.RowValue) int {
+	if m.maxShiftDays <= 0 {
+		return 0
+	}
+	s, _ := formatRowValue(subject)
+	span := uint64(m.maxShiftDays)*2 + 1
+	return int(keyedUint64(m.key, s)%span) - int(m.maxShiftDays)
+}
+
+// Mask implements Masker.Mask.
+func (m *DateShiftMasker) Mask(data *MaskData) *v1pb.RowValue {
+	days := m.shiftDays(data.Subject)
+	switch kind := data.Data.Kind.(type) {
+	case *v1pb.RowValue_NullValue:
+		return data.Data
+	case *v1pb.RowValue_TimestampValue:
+		timestamp := proto.CloneOf(kind.TimestampValue)
+		timestamp.GoogleTimestamp = timestamppb.New(kind.TimestampValue.GetGoogleTimestamp().AsTime().AddDate(0, 0, days))
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_TimestampValue{TimestampValue: timestamp}}
+	case *v1pb.RowValue_TimestampTzValue:
+		timestamp := proto.CloneOf(kind.TimestampTzValue)
+		timestamp.GoogleTimestamp = timestamppb.New(kind.TimestampTzValue.GetGoogleTimestamp().AsTime().AddDate(0, 0, days))
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_TimestampTzValue{TimestampTzValue: timestamp}}
+	case *v1pb.RowValue_StringValue:
+		if s, ok := shiftDateString(kind.StringValue, days); ok {
+			return &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: s}}
+		}
+	case *v1pb.RowValue_ValueValue:
+		return &v1pb.RowValue{
+			Kind: &v1pb.RowValue_ValueValue{
+				ValueValue: maskProtoValue(m, kind.ValueValue),
+			},
+		}
+	default:
+	}
+	return maskedString()
+}
+
+// shiftDateString shifts the leading YYYY-MM-DD date of the string and keeps the rest.
+func shiftDateString(s string, days int) (string, bool) {
+	const layout = "2006-01-02"
+	if len(s) < len(layout) {
+		return "", false
+	}
+	t, err := time.Parse(layout, s[:len(layout)])
+	if err != nil {
+		return "", false
+	}
+	return t.AddDate(0, 0, days).Format(layout) + s[len(layout):], true
+}
+
+// Equal implements Masker.Equal.
+func (m *DateShiftMasker) Equal(other Masker) bool {
+	if o, ok := other.(*DateShiftMasker); ok {
+		return bytes.Equal(m.key, o.key) && m.maxShiftDays == o.maxShiftDays && m.subjectColumn == o.subjectColumn
+	}
+	return false
+}
+
+// NumericNoiseMasker is the masker that adds deterministic relative noise to numbers.
+type NumericNoiseMasker struct {
+	key        []byte
+	noiseRatio float64
+}
+
+// NewNumericNoiseMasker returns a new NumericNoiseMasker.
+func NewNumericNoiseMasker(key []byte, noiseRatio float64) *NumericNoiseMasker {
+	return &NumericNoiseMasker{
+		key:        key,
+		noiseRatio: noiseRatio,
+	}
+}
+
+// factor returns the noise factor in [1-noiseRatio, 1+noiseRatio] of the value.
+func (m *NumericNoiseMasker) factor(value string) float64 {
+	u := float64(keyedUint64(m.key, value)>>11) / float64(1<<53)
+	return 1 + m.noiseRatio*(2*u-1)
+}
+
+// Mask implements Masker.Mask.
+func (m *NumericNoiseMasker) Mask(data *MaskData) *v1pb.RowValue {
+	switch kind := data.Data.Kind.(type) {
+	case *v1pb.RowValue_NullValue:
+		return data.Data
+	case *v1pb.RowValue_Int32Value:
+		v := math.Round(float64(kind.Int32Value) * m.factor(strconv.FormatInt(int64(kind.Int32Value), 10)))
+		if v >= math.MinInt32 && v <= math.MaxInt32 {
+			return &v1pb.RowValue{Kind: &v1pb.RowValue_Int32Value{Int32Value: int32(v)}}
+		}
+	case *v1pb.RowValue_Int64Value:
+		v := math.Round(float64(kind.Int64Value) * m.factor(strconv.FormatInt(kind.Int64Value, 10)))
+		if v >= math.MinInt64 && v < math.MaxInt64 {
+			return &v1pb.RowValue{Kind: &v1pb.RowValue_Int64Value{Int64Value: int64(v)}}
+		}
+	case *v1pb.RowValue_Uint32Value:
+		v := math.Round(float64(kind.Uint32Value) * m.factor(strconv.FormatUint(uint64(kind.Uint32Value), 10)))
+		if v <= math.MaxUint32 {
+			return &v1pb.RowValue{Kind: &v1pb.RowValue_Uint32Value{Uint32Value: uint32(v)}}
+		}
+	case *v1pb.RowValue_Uint64Value:
+		v := math.Round(float64(kind.Uint64Value) * m.factor(strconv.FormatUint(kind.Uint64Value, 10)))
+		if v < math.MaxUint64 {
+			return &v1pb.RowValue{Kind: &v1pb.RowValue_Uint64Value{Uint64Value: uint64(v)}}
+		}
+	case *v1pb.RowValue_DoubleValue:
+		v := kind.DoubleValue * m.factor(strconv.FormatFloat(kind.DoubleValue, 'f', -1, 64))
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_DoubleValue{DoubleValue: v}}
+	case *v1pb.RowValue_FloatValue:
+		v := float64(kind.FloatValue) * m.factor(strconv.FormatFloat(float64(kind.FloatValue), 'f', -1, 32))
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_FloatValue{FloatValue: float32(v)}}
+	case *v1pb.RowValue_StringValue:
+		// Numeric and decimal values are returned as strings by some drivers.
+		s := strings.TrimSpace(kind.StringValue)
+		if v, err := strconv.ParseFloat(s, 64); err == nil && !math.IsInf(v, 0) && !math.IsNaN(v) {
+			decimals := 0
+			if dot := strings.Index(s, "."); dot >= 0 {
+				decimals = len(s) - dot - 1
+			}
+			return &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: strconv.FormatFloat(v*m.factor(s), 'f', decimals, 64)}}
+		}
+	case *v1pb.RowValue_ValueValue:
+		return &v1pb.RowValue{
+			Kind: &v1pb.RowValue_ValueValue{
+				ValueValue: maskProtoValue(m, kind.ValueValue),
+			},
+		}
+	default:
+	}
+	return maskedString()
+}
+
+// Equal implements Masker.Equal.
+func (m *NumericNoiseMasker) Equal(other Masker) bool {
+	if o, ok := other.(*NumericNoiseMasker); ok {
+		return bytes.Equal(m.key, o.key) && m.noiseRatio == o.noiseRatio
+	}
+	return false
+}
+
+// formatRowValue formats a scalar row value as a string.
+func formatRowValue(value *v1pb.RowValue) (string, bool) {
+	switch kind := value.GetKind().(type) {
+	case *v1pb.RowValue_StringValue:
+		return kind.StringValue, true
+	case *v1pb.RowValue_BytesValue:
+		return string(kind.BytesValue), true
+	case *v1pb.RowValue_Int32Value:
+		return strconv.FormatInt(int64(kind.Int32Value), 10), true
+	case *v1pb.RowValue_Int64Value:
+		return strconv.FormatInt(kind.Int64Value, 10), true
+	case *v1pb.RowValue_Uint32Value:
+		return strconv.FormatUint(uint64(kind.Uint32Value), 10), true
+	case *v1pb.RowValue_Uint64Value:
+		return strconv.FormatUint(kind.Uint64Value, 10), true
+	case *v1pb.RowValue_DoubleValue:
+		return strconv.FormatFloat(kind.DoubleValue, 'f', -1, 64), true
+	case *v1pb.RowValue_FloatValue:
+		return strconv.FormatFloat(float64(kind.FloatValue), 'f', -1, 32), true
+	case *v1pb.RowValue_BoolValue:
+		return strconv.FormatBool(kind.BoolValue), true
+	default:
+		return "", false
+	}
+}
+
+var (
+	_ Masker = (*TokenizationMasker)(nil)
+	_ Masker = (*DateShiftMasker)(nil)
+	_ Masker = (*NumericNoiseMasker)(nil)
+)
@@ -0,0 +1,133 @@
+package masker
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+)
+
+func stringValue(s string) *v1pb.RowValue {
+	return &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: s}}
+}
+
+func TestTokenizationMasker(t *testing.T) {
+	a := require.New(t)
+	key := []byte("secret")
+
+	testCases := []struct {
+		format storepb.Algorithm_TokenizationMask_Format
+		input  string
+		check  func(got string)
+	}{
+		{
+			format: storepb.Algorithm_TokenizationMask_FORMAT_UNSPECIFIED,
+			input:  "Ab-12 x",
+			check: func(got string) {
+				a.Len(got, 7)
+				a.True(got[0] >= 'A' && got[0] <= 'Z')
+				a.True(got[1] >= 'a' && got[1] <= 'z')
+				a.Equal(byte('-'), got[2])
+				a.True(got[3] >= '0' && got[3] <= '9')
+				a.Equal(byte(' '), got[5])
+			},
+		},
+		{
+			format: storepb.Algorithm_TokenizationMask_EMAIL,
+			input:  "alice@example.com",
+			check: func(got string) {
+				a.True(strings.HasSuffix(got, "@example.com"))
+				a.Len(got, len("alice@example.com"))
+			},
+		},
+		{
+			format: storepb.Algorithm_TokenizationMask_PAYMENT_CARD,
+			input:  "4111 1111 1111 1111",
+			check: func(got string) {
+				a.Len(got, 19)
+				a.Equal(byte(' '), got[4])
+				a.True(luhnValid(got))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		m := NewTokenizationMasker(key, tc.format)
+		got := m.Mask(&MaskData{Data: stringValue(tc.input)}).GetStringValue()
+		a.NotEqual(tc.input, got)
+		tc.check(got)
+		// Tokens are deterministic so joins and group by still work.
+		a.Equal(got, m.Mask(&MaskData{Data: stringValue(tc.input)}).GetStringValue())
+		// Different keys produce different tokens.
+		a.NotEqual(got, NewTokenizationMasker([]byte("other"), tc.format).Mask(&MaskData{Data: stringValue(tc.input)}).GetStringValue())
+	}
+
+	m := NewTokenizationMasker(key, storepb.Algorithm_TokenizationMask_FORMAT_UNSPECIFIED)
+	got := m.Mask(&MaskData{Data: &v1pb.RowValue{Kind: &v1pb.RowValue_Int64Value{Int64Value: 123456}}})
+	a.GreaterOrEqual(got.GetInt64Value(), int64(100000))
+	a.LessOrEqual(got.GetInt64Value(), int64(999999))
+	null := &v1pb.RowValue{Kind: &v1pb.RowValue_NullValue{}}
+	a.Equal(null, m.Mask(&MaskData{Data: null}))
+}
+
+func TestDateShiftMasker(t *testing.T) {
+	a := require.New(t)
+	m := NewDateShiftMasker([]byte("secret"), 30, "patient_id")
+	subject := &v1pb.RowValue{Kind: &v1pb.RowValue_Int64Value{Int64Value: 42}}
+
+	admitted := time.Date(2024, 1, 10, 8, 0, 0, 0, time.UTC)
+	discharged := time.Date(2024, 1, 15, 8, 0, 0, 0, time.UTC)
+	timestampValue := func(t time.Time) *v1pb.RowValue {
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_TimestampValue{TimestampValue: &v1pb.RowValue_Timestamp{GoogleTimestamp: timestamppb.New(t)}}}
+	}
+	gotAdmitted := m.Mask(&MaskData{Data: timestampValue(admitted), Subject: subject}).GetTimestampValue().GetGoogleTimestamp().AsTime()
+	gotDischarged := m.Mask(&MaskData{Data: timestampValue(discharged), Subject: subject}).GetTimestampValue().GetGoogleTimestamp().AsTime()
+	// Dates of the same subject keep their intervals.
+	a.Equal(discharged.Sub(admitted), gotDischarged.Sub(gotAdmitted))
+	shift := gotAdmitted.Sub(admitted)
+	a.LessOrEqual(shift.Abs(), 30*24*time.Hour)
+
+	got := m.Mask(&MaskData{Data: stringValue("2024-01-10 08:00:00"), Subject: subject}).GetStringValue()
+	a.Equal(admitted.Add(shift).Format("2006-01-02")+" 08:00:00", got)
+	a.Equal("******", m.Mask(&MaskData{Data: stringValue("not a date")}).GetStringValue())
+}
+
+func TestNumericNoiseMasker(t *testing.T) {
+	a := require.New(t)
+	m := NewNumericNoiseMasker([]byte("secret"), 0.1)
+
+	got := m.Mask(&MaskData{Data: &v1pb.RowValue{Kind: &v1pb.RowValue_DoubleValue{DoubleValue: 1000}}}).GetDoubleValue()
+	a.InDelta(1000, got, 100)
+	a.Equal(got, m.Mask(&MaskData{Data: &v1pb.RowValue{Kind: &v1pb.RowValue_DoubleValue{DoubleValue: 1000}}}).GetDoubleValue())
+
+	gotInt := m.Mask(&MaskData{Data: &v1pb.RowValue{Kind: &v1pb.RowValue_Int32Value{Int32Value: 500}}}).GetInt32Value()
+	a.InDelta(500, gotInt, 50)
+
+	gotDecimal := m.Mask(&MaskData{Data: stringValue("1234.50")}).GetStringValue()
+	a.Regexp(`^\d+\.\d{2}$`, gotDecimal)
+	a.Equal("******", m.Mask(&MaskData{Data: stringValue("abc")}).GetStringValue())
+}
+
+func luhnValid(s string) bool {
+	sum, i := 0, 0
+	for j := len(s) - 1; j >= 0; j-- {
+		if s[j] < '0' || s[j] > '9' {
+			continue
+		}
+		d := int(s[j] - '0')
+		if i%2 == 1 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		i++
+	}
+	return sum%10 == 0
+}
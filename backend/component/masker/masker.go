@@ -27,6 +27,10 @@ type MaskData struct {
 
 	// Data is the data to be masked.
 	Data *v1pb.RowValue
+
+	// Subject is the unmasked value of the subject column for maskers that
+	// keep values of the same subject consistent, e.g. DateShiftMasker.
+	Subject *v1pb.RowValue
 }
 
 // Masker is the interface that masks the data.
@@ -0,0 +1,158 @@
+// Package operation runs the long-running operations and records their progress and results.
+package operation
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/bus"
+	"github.com/bytebase/bytebase/backend/component/config"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+// canceledMessage is the error message of a canceled operation.
+const canceledMessage = "operation canceled"
+
+// ProgressFunc reports the completed and total steps of an operation.
+type ProgressFunc func(completed, total int32)
+
+// Func runs an operation and returns its response.
+// The context is canceled when the operation is canceled.
+type Func func(ctx context.Context, progress ProgressFunc) (proto.Message, error)
+
+// Manager is the operation manager.
+type Manager struct {
+	store   *store.Store
+	bus     *bus.Bus
+	profile *config.Profile
+}
+
+// NewManager creates an operation manager.
+func NewManager(store *store.Store, bus *bus.Bus, profile *config.Profile) *Manager {
+	return &Manager{
+		store:   store,
+		bus:     bus,
+		profile: profile,
+	}
+}
+
+// Start creates a running operation and runs f in the background.
+// f keeps running after the request is done, until it returns or the operation is canceled.
+func (m *Manager) Start(ctx context.Context, create *store.OperationMessage, f Func) (*store.OperationMessage, error) {
+	create.ReplicaID = m.profile.ReplicaID
+	operation, err := m.store.CreateOperation(ctx, create)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create operation")
+	}
+
+	runCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	m.bus.RunningOperationsCancelFunc.Store(operation.ID, cancel)
+	go m.run(runCtx, cancel, operation.ID, proto.CloneOf(operation.Payload), f)
+	return operation, nil
+}
+
+// Cancel cancels a running operation on any replica.
+// It's a no-op if the operation is done.
+func (m *Manager) Cancel(ctx context.Context, operation *store.OperationMessage) error {
+	payload := proto.CloneOf(operation.Payload)
+	payload.ErrorCode = int32(connect.CodeCanceled)
+	payload.Error = canceledMessage
+	state := storepb.Operation_CANCELED
+	updated, err := m.store.UpdateOperation(ctx, operation.ID, &store.UpdateOperationMessage{
+		State:   &state,
+		Payload: payload,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to cancel operation")
+	}
+	if !updated {
+		return nil
+	}
+
+	if cancel, ok := m.bus.RunningOperationsCancelFunc.Load(operation.ID); ok {
+		cancel.(context.CancelFunc)()
+	}
+	// Broadcast cancel signal to all replicas for HA.
+	if err := m.store.SendSignal(ctx, storepb.Signal_CANCEL_OPERATION, "", operation.ID); err != nil {
+		slog.Warn("failed to send cancel signal", log.BBError(err))
+	}
+	return nil
+}
+
+func (m *Manager) run(ctx context.Context, cancel context.CancelFunc, id int64, payload *storepb.OperationPayload, f Func) {
+	defer func() {
+		m.bus.RunningOperationsCancelFunc.Delete(id)
+		cancel()
+	}()
+	// The updates are not canceled with the operation, so the final state is always recorded.
+	updateCtx := context.WithoutCancel(ctx)
+
+	var mu sync.Mutex
+	progress := func(completed, total int32) {
+		mu.Lock()
+		defer mu.Unlock()
+		payload.CompletedCount = completed
+		payload.TotalCount = total
+		if _, err := m.store.UpdateOperation(updateCtx, id, &store.UpdateOperationMessage{Payload: payload}); err != nil {
+			slog.Warn("failed to update operation progress", slog.Int64("id", id), log.BBError(err))
+		}
+	}
+
+	response, err := runOnce(ctx, f, progress)
+
+	mu.Lock()
+	defer mu.Unlock()
+	state := storepb.Operation_SUCCEEDED
+	switch {
+	case ctx.Err() != nil:
+		state = storepb.Operation_CANCELED
+		payload.ErrorCode = int32(connect.CodeCanceled)
+		payload.Error = canceledMessage
+	case err != nil:
+		state = storepb.Operation_FAILED
+		payload.ErrorCode = int32(connect.CodeUnknown)
+		payload.Error = err.Error()
+		var connectErr *connect.Error
+		if errors.As(err, &connectErr) {
+			payload.ErrorCode = int32(connectErr.Code())
+			payload.Error = connectErr.Message()
+		}
+	case response != nil:
+		packed, err := anypb.New(response)
+		if err != nil {
+			state = storepb.Operation_FAILED
+			payload.ErrorCode = int32(connect.CodeInternal)
+			payload.Error = errors.Wrapf(err, "failed to pack operation response").Error()
+			break
+		}
+		payload.Response = packed
+	}
+	if _, err := m.store.UpdateOperation(updateCtx, id, &store.UpdateOperationMessage{
+		State:   &state,
+		Payload: payload,
+	}); err != nil {
+		slog.Error("failed to finish operation", slog.Int64("id", id), log.BBError(err))
+	}
+}
+
+func runOnce(ctx context.Context, f Func, progress ProgressFunc) (response proto.Message, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			panicErr, ok := r.(error)
+			if !ok {
+				panicErr = errors.Errorf("%v", r)
+			}
+			slog.Error("operation PANIC RECOVER", log.BBError(panicErr), log.BBStack("panic-stack"))
+			err = connect.NewError(connect.CodeInternal, errors.Errorf("operation panicked: %v", panicErr))
+		}
+	}()
+	return f(ctx, progress)
+}
@@ -0,0 +1,661 @@
+// Package synthetic generates synthetic test data from database schema metadata.
+package synthetic
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math"
+	"math/rand/v2"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/component/export"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+// Options is the options for generating synthetic data.
+type Options struct {
+	// Engine is the engine of the database. It decides how types are interpreted.
+	Engine storepb.Engine
+	// RowCount is the number of rows generated per table.
+	RowCount int
+	// Seed makes the generated data reproducible.
+	Seed int64
+	// Tables limits the generation to the tables matching the patterns, in the form of "schema.table" or "table".
+	// Tables referenced by foreign keys are always included. Empty means all tables.
+	Tables []string
+	// SemanticTypes maps "schema.table.column" to the semantic type of the column,
+	// e.g. "Email" or "Phone number". It hints the generated values.
+	SemanticTypes map[string]string
+}
+
+// TableData is the generated data of a table.
+type TableData struct {
+	Schema string
+	Table  string
+	Result *v1pb.QueryResult
+	// overriding is true if the rows provide values for GENERATED ALWAYS identity columns.
+	overriding bool
+}
+
+// Statement returns the INSERT statements of the table data.
+func (t *TableData) Statement(engine storepb.Engine) (string, error) {
+	if len(t.Result.Rows) == 0 {
+		return "", nil
+	}
+	prefix, err := export.SQLStatementPrefix(engine, []base.SchemaResource{{Schema: t.Schema, Table: t.Table}}, t.Result.ColumnNames)
+	if err != nil {
+		return "", err
+	}
+	if t.overriding {
+		prefix = strings.Replace(prefix, ") VALUES (", ") OVERRIDING SYSTEM VALUE VALUES (", 1)
+	}
+	var buf strings.Builder
+	if err := export.SQLToWriter(&buf, engine, prefix, t.Result); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// Generate generates rows for the tables of the database.
+// The tables are ordered so that tables referenced by foreign keys come first.
+func Generate(metadata *storepb.DatabaseSchemaMetadata, opts Options) ([]*TableData, error) {
+	if opts.RowCount <= 0 {
+		return nil, errors.Errorf("row count must be positive, got %d", opts.RowCount)
+	}
+	tables, err := sortTables(metadata, opts.Tables)
+	if err != nil {
+		return nil, err
+	}
+
+	g := &generator{
+		opts:     opts,
+		enums:    map[string][]string{},
+		values:   map[string]map[string][]*v1pb.RowValue{},
+		rowCount: map[string]int{},
+	}
+	for _, schema := range metadata.GetSchemas() {
+		for _, enum := range schema.GetEnumTypes() {
+			g.enums[enum.Name] = enum.Values
+			g.enums[schema.Name+"."+enum.Name] = enum.Values
+		}
+	}
+
+	var result []*TableData
+	for _, t := range tables {
+		data, err := g.generateTable(t.schema, t.table)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to generate data for table %q", t.key())
+		}
+		result = append(result, data)
+	}
+	return result, nil
+}
+
+type tableRef struct {
+	schema string
+	table  *storepb.TableMetadata
+}
+
+func (t tableRef) key() string {
+	return tableKey(t.schema, t.table.Name)
+}
+
+func tableKey(schema, table string) string {
+	if schema == "" {
+		return table
+	}
+	return schema + "." + table
+}
+
+// sortTables selects the tables to generate and orders them by foreign key dependencies.
+func sortTables(metadata *storepb.DatabaseSchemaMetadata, patterns []string) ([]tableRef, error) {
+	var all []tableRef
+	tableMap := map[string]tableRef{}
+	for _, schema := range metadata.GetSchemas() {
+		for _, table := range schema.GetTables() {
+			t := tableRef{schema: schema.Name, table: table}
+			all = append(all, t)
+			tableMap[t.key()] = t
+		}
+	}
+
+	selected := map[string]bool{}
+	var queue []string
+	for _, t := range all {
+		if len(patterns) == 0 || common.MatchTablePatterns(t.schema, t.table.Name, patterns) {
+			selected[t.key()] = true
+			queue = append(queue, t.key())
+		}
+	}
+	// Include the tables referenced by foreign keys.
+	for len(queue) > 0 {
+		t := tableMap[queue[0]]
+		queue = queue[1:]
+		for _, fk := range t.table.GetForeignKeys() {
+			referenced := tableKey(fk.ReferencedSchema, fk.ReferencedTable)
+			if _, ok := tableMap[referenced]; !ok {
+				return nil, errors.Errorf("table %q references unknown table %q", t.key(), referenced)
+			}
+			if !selected[referenced] {
+				selected[referenced] = true
+				queue = append(queue, referenced)
+			}
+		}
+	}
+
+	// Topological sort. Tables in a reference cycle are emitted in schema order,
+	// their foreign keys to tables not generated yet are filled with NULL.
+	var sorted []tableRef
+	done := map[string]bool{}
+	for len(sorted) < len(selected) {
+		progress := false
+		for _, t := range all {
+			if !selected[t.key()] || done[t.key()] {
+				continue
+			}
+			ready := true
+			for _, fk := range t.table.GetForeignKeys() {
+				referenced := tableKey(fk.ReferencedSchema, fk.ReferencedTable)
+				if referenced != t.key() && !done[referenced] {
+					ready = false
+					break
+				}
+			}
+			if ready {
+				sorted = append(sorted, t)
+				done[t.key()] = true
+				progress = true
+			}
+		}
+		if !progress {
+			for _, t := range all {
+				if selected[t.key()] && !done[t.key()] {
+					sorted = append(sorted, t)
+					done[t.key()] = true
+					break
+				}
+			}
+		}
+	}
+	return sorted, nil
+}
+
+type generator struct {
+	opts Options
+	// enums maps the enum type name to its values.
+	enums map[string][]string
+	// values maps the table key to the generated values of each column, used by foreign keys.
+	values   map[string]map[string][]*v1pb.RowValue
+	rowCount map[string]int
+}
+
+type columnKind int
+
+const (
+	kindString columnKind = iota
+	kindInt
+	kindDecimal
+	kindFloat
+	kindBool
+	kindDate
+	kindTime
+	kindTimestamp
+	kindUUID
+	kindJSON
+	kindBytes
+	kindEnum
+)
+
+// columnSpec describes how values of a column are generated.
+type columnSpec struct {
+	name       string
+	kind       columnKind
+	length     int
+	scale      int
+	nullable   bool
+	unique     bool
+	enumValues []string
+	min, max   float64
+	hint       string
+	// fk is the index of the foreign key filling the column, -1 if none.
+	fk int
+	// fkColumn is the referenced column.
+	fkColumn string
+}
+
+func (g *generator) generateTable(schema string, table *storepb.TableMetadata) (*TableData, error) {
+	key := tableKey(schema, table.Name)
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(key))
+	rng := rand.New(rand.NewPCG(uint64(g.opts.Seed), h.Sum64()))
+
+	checks := parseCheckConstraints(table.GetCheckConstraints())
+	data := &TableData{Schema: schema, Table: table.Name, Result: &v1pb.QueryResult{}}
+	var specs []*columnSpec
+	for _, column := range table.GetColumns() {
+		// Generated columns are computed by the database.
+		if column.Generation != nil {
+			continue
+		}
+		if column.IsIdentity && column.IdentityGeneration == storepb.ColumnMetadata_ALWAYS {
+			data.overriding = true
+		}
+		spec := g.newColumnSpec(schema, table.Name, column)
+		if c, ok := checks[strings.ToLower(column.Name)]; ok {
+			if !math.IsInf(c.min, -1) {
+				spec.min = c.min
+			}
+			spec.max = max(min(spec.max, c.max), spec.min)
+			if len(c.values) > 0 {
+				spec.kind = kindEnum
+				spec.enumValues = c.values
+			}
+		}
+		specs = append(specs, spec)
+		data.Result.ColumnNames = append(data.Result.ColumnNames, column.Name)
+	}
+	specMap := map[string]*columnSpec{}
+	for _, spec := range specs {
+		specMap[spec.name] = spec
+	}
+
+	// Foreign key values are picked from the rows of the referenced tables.
+	type foreignKey struct {
+		values map[string][]*v1pb.RowValue
+		rows   int
+		self   bool
+	}
+	var fks []*foreignKey
+	for i, fk := range table.GetForeignKeys() {
+		referenced := tableKey(fk.ReferencedSchema, fk.ReferencedTable)
+		f := &foreignKey{values: g.values[referenced], rows: g.rowCount[referenced], self: referenced == key}
+		fks = append(fks, f)
+		for j, column := range fk.Columns {
+			spec, ok := specMap[column]
+			if !ok || j >= len(fk.ReferencedColumns) {
+				continue
+			}
+			if !f.self && f.values == nil && !spec.nullable {
+				return nil, errors.Errorf("foreign key %q references table %q in a cycle through non-nullable column %q", fk.Name, referenced, column)
+			}
+			spec.fk = i
+			spec.fkColumn = fk.ReferencedColumns[j]
+		}
+	}
+
+	for _, index := range table.GetIndexes() {
+		if !index.Primary && !index.Unique {
+			continue
+		}
+		var columns []*columnSpec
+		for _, expression := range index.Expressions {
+			if spec, ok := specMap[strings.Trim(expression, "\"`")]; ok {
+				columns = append(columns, spec)
+			}
+		}
+		// Making one non foreign key column unique makes the whole key unique.
+		// Keys consisting of foreign keys only are unique by the way foreign key values are picked.
+		for _, spec := range columns {
+			if spec.fk < 0 {
+				spec.unique = true
+				break
+			}
+		}
+	}
+
+	for _, spec := range specs {
+		if n := spec.distinctValues(); spec.unique && n >= 0 && n < g.opts.RowCount {
+			return nil, errors.Errorf("unique column %q of table %q has %d distinct values, fewer than %d rows", spec.name, key, n, g.opts.RowCount)
+		}
+	}
+
+	columnValues := map[string][]*v1pb.RowValue{}
+	for row := range g.opts.RowCount {
+		values := make([]*v1pb.RowValue, len(specs))
+		for i, spec := range specs {
+			if spec.fk >= 0 {
+				continue
+			}
+			values[i] = g.value(spec, row, rng)
+		}
+		// Each foreign key picks the referenced row by a mixed radix decomposition of the row number,
+		// so that composite keys made of several foreign keys stay unique.
+		stride := 1
+		for k, f := range fks {
+			for i, spec := range specs {
+				if spec.fk != k {
+					continue
+				}
+				switch {
+				case f.self:
+					// Rows reference themselves, or nothing if the column is nullable.
+					values[i] = nullValue
+					if !spec.nullable {
+						if j := slices.Index(data.Result.ColumnNames, spec.fkColumn); j >= 0 {
+							values[i] = values[j]
+						}
+					}
+				case f.values == nil || f.rows == 0:
+					values[i] = nullValue
+				default:
+					values[i] = f.values[spec.fkColumn][(row/stride)%f.rows]
+				}
+			}
+			if f.rows > 0 {
+				stride *= f.rows
+			}
+		}
+		for i, spec := range specs {
+			columnValues[spec.name] = append(columnValues[spec.name], values[i])
+		}
+		data.Result.Rows = append(data.Result.Rows, &v1pb.QueryRow{Values: values})
+	}
+	data.Result.RowsCount = int64(len(data.Result.Rows))
+	g.values[key] = columnValues
+	g.rowCount[key] = g.opts.RowCount
+	return data, nil
+}
+
+// distinctValues returns the number of distinct values the column can take, -1 if unbounded.
+func (spec *columnSpec) distinctValues() int {
+	switch spec.kind {
+	case kindBool:
+		return 2
+	case kindEnum:
+		return len(spec.enumValues)
+	case kindInt:
+		return int(spec.max-spec.min) + 1
+	default:
+		return -1
+	}
+}
+
+var (
+	typeArgsRegex = regexp.MustCompile(`\((\d+)(?:\s*,\s*(\d+))?\)`)
+	quotedRegex   = regexp.MustCompile(`'((?:[^']|'')*)'`)
+)
+
+func (g *generator) newColumnSpec(schema, table string, column *storepb.ColumnMetadata) *columnSpec {
+	spec := &columnSpec{
+		name:     column.Name,
+		nullable: column.Nullable,
+		fk:       -1,
+		min:      1,
+		max:      10000,
+		length:   -1,
+	}
+	if hint, ok := g.opts.SemanticTypes[tableKey(schema, table)+"."+column.Name]; ok {
+		spec.hint = strings.ToLower(hint)
+	}
+
+	t := strings.ToLower(strings.TrimSpace(column.Type))
+	var args []int
+	if m := typeArgsRegex.FindStringSubmatch(t); m != nil {
+		for _, s := range m[1:] {
+			if n, err := strconv.Atoi(s); err == nil {
+				args = append(args, n)
+			}
+		}
+	}
+	typeName := strings.TrimSpace(strings.Split(t, "(")[0])
+	enumName := strings.ReplaceAll(strings.TrimSuffix(column.Type, "[]"), `"`, "")
+
+	switch {
+	case strings.HasPrefix(t, "enum(") || strings.HasPrefix(t, "set("):
+		spec.kind = kindEnum
+		for _, m := range quotedRegex.FindAllStringSubmatch(column.Type, -1) {
+			spec.enumValues = append(spec.enumValues, strings.ReplaceAll(m[1], "''", "'"))
+		}
+	case g.enums[enumName] != nil:
+		spec.kind = kindEnum
+		spec.enumValues = g.enums[enumName]
+	case strings.HasPrefix(t, "bool") || (g.opts.Engine == storepb.Engine_MYSQL && strings.HasPrefix(t, "tinyint(1)")):
+		spec.kind = kindBool
+	case strings.Contains(typeName, "int") || strings.Contains(typeName, "serial") || typeName == "year":
+		spec.kind = kindInt
+		switch {
+		case strings.HasPrefix(typeName, "tinyint"):
+			spec.max = 127
+		case strings.HasPrefix(typeName, "smallint") || typeName == "int2" || typeName == "smallserial":
+			spec.max = 32767
+		case typeName == "year":
+			spec.min, spec.max = 1970, 2030
+		default:
+		}
+	case typeName == "numeric" || typeName == "decimal":
+		spec.kind = kindDecimal
+		if len(args) > 0 {
+			precision, scale := args[0], 0
+			if len(args) > 1 {
+				scale = args[1]
+			}
+			spec.scale = scale
+			spec.max = min(spec.max, math.Pow10(precision-scale)-1)
+		}
+	case strings.HasPrefix(typeName, "float") || strings.HasPrefix(typeName, "double") || typeName == "real" || typeName == "money":
+		spec.kind = kindFloat
+		spec.scale = 2
+	case strings.HasPrefix(typeName, "timestamp") || typeName == "datetime":
+		spec.kind = kindTimestamp
+	case typeName == "date":
+		spec.kind = kindDate
+	case strings.HasPrefix(typeName, "time"):
+		spec.kind = kindTime
+	case typeName == "uuid":
+		spec.kind = kindUUID
+	case strings.HasPrefix(typeName, "json"):
+		spec.kind = kindJSON
+	case typeName == "bytea" || strings.HasSuffix(typeName, "blob") || strings.HasSuffix(typeName, "binary"):
+		spec.kind = kindBytes
+	default:
+		spec.kind = kindString
+		if len(args) > 0 && strings.Contains(typeName, "char") {
+			spec.length = args[0]
+		}
+	}
+	return spec
+}
+
+var nullValue = &v1pb.RowValue{Kind: &v1pb.RowValue_NullValue{}}
+
+var baseTime = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func (g *generator) value(spec *columnSpec, row int, rng *rand.Rand) *v1pb.RowValue {
+	if spec.nullable && !spec.unique && rng.IntN(10) == 0 {
+		return nullValue
+	}
+	switch spec.kind {
+	case kindInt:
+		n := int64(spec.min) + int64(row)
+		if !spec.unique {
+			n = int64(spec.min) + rng.Int64N(int64(spec.max-spec.min)+1)
+		}
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_Int64Value{Int64Value: n}}
+	case kindDecimal, kindFloat:
+		f := spec.min + float64(row)
+		if !spec.unique {
+			f = spec.min + rng.Float64()*(spec.max-spec.min)
+		}
+		p := math.Pow10(spec.scale)
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_DoubleValue{DoubleValue: math.Round(f*p) / p}}
+	case kindBool:
+		if spec.unique {
+			return &v1pb.RowValue{Kind: &v1pb.RowValue_BoolValue{BoolValue: row%2 == 0}}
+		}
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_BoolValue{BoolValue: rng.IntN(2) == 0}}
+	case kindDate:
+		return stringValue(randomTime(spec, row, rng).Format(time.DateOnly))
+	case kindTime:
+		return stringValue(randomTime(spec, row, rng).Format(time.TimeOnly))
+	case kindTimestamp:
+		return stringValue(randomTime(spec, row, rng).Format(time.DateTime))
+	case kindUUID:
+		return stringValue(fmt.Sprintf("%08x-%04x-4%03x-8%03x-%012x", rng.Uint32(), rng.Uint32()&0xffff, rng.Uint32()&0xfff, rng.Uint32()&0xfff, rng.Uint64()&0xffffffffffff))
+	case kindJSON:
+		return stringValue(fmt.Sprintf(`{"id": %d}`, row+1))
+	case kindBytes:
+		b := make([]byte, 8)
+		for i := range b {
+			b[i] = byte(rng.UintN(256))
+		}
+		if g.opts.Engine == storepb.Engine_POSTGRES {
+			return stringValue(fmt.Sprintf(`\x%x`, b))
+		}
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_BytesValue{BytesValue: b}}
+	case kindEnum:
+		if len(spec.enumValues) == 0 {
+			return nullValue
+		}
+		if spec.unique {
+			return stringValue(spec.enumValues[row%len(spec.enumValues)])
+		}
+		return stringValue(spec.enumValues[rng.IntN(len(spec.enumValues))])
+	default:
+		s := semanticValue(spec, row, rng)
+		if spec.length > 0 && len([]rune(s)) > spec.length {
+			s = string([]rune(s)[:spec.length])
+		}
+		return stringValue(s)
+	}
+}
+
+func stringValue(s string) *v1pb.RowValue {
+	return &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: s}}
+}
+
+func randomTime(spec *columnSpec, row int, rng *rand.Rand) time.Time {
+	if spec.unique {
+		return baseTime.Add(time.Duration(row) * time.Minute)
+	}
+	return baseTime.Add(time.Duration(rng.Int64N(int64(365*24*time.Hour/time.Second))) * time.Second)
+}
+
+var (
+	firstNames = []string{"Alice", "Bob", "Carol", "David", "Emma", "Frank", "Grace", "Henry", "Ivy", "Jack"}
+	lastNames  = []string{"Smith", "Johnson", "Brown", "Taylor", "Miller", "Wilson", "Moore", "Clark", "Lewis", "Young"}
+	cities     = []string{"Springfield", "Riverside", "Franklin", "Greenville", "Fairview", "Madison", "Georgetown", "Salem"}
+	countries  = []string{"United States", "Canada", "Germany", "France", "Japan", "Australia", "Brazil", "India"}
+	streets    = []string{"Main St", "Oak Ave", "Pine Rd", "Maple Dr", "Cedar Ln", "Elm St"}
+)
+
+// semanticValue generates a string value following the semantic type of the column,
+// or the column name if the column has no semantic type.
+// The keywords match whole words, so "zip" does not match "ip".
+func semanticValue(spec *columnSpec, row int, rng *rand.Rand) string {
+	source := spec.hint
+	if source == "" {
+		source = strings.ToLower(spec.name)
+	}
+	words := strings.FieldsFunc(source, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	has := func(keywords ...string) bool {
+		for _, keyword := range keywords {
+			if slices.Contains(words, keyword) {
+				return true
+			}
+		}
+		return false
+	}
+	suffix := ""
+	if spec.unique {
+		suffix = fmt.Sprintf("-%d", row+1)
+	}
+	pick := func(values []string) string {
+		return values[rng.IntN(len(values))]
+	}
+
+	switch {
+	case has("email", "mail"):
+		return fmt.Sprintf("user%d@example.com", row+1)
+	case has("phone", "mobile"):
+		return fmt.Sprintf("+1-555-%03d-%04d", row/10000%1000, row%10000)
+	case has("first"):
+		return pick(firstNames) + suffix
+	case has("last", "surname"):
+		return pick(lastNames) + suffix
+	case has("name"):
+		return pick(firstNames) + " " + pick(lastNames) + suffix
+	case has("ip"):
+		return fmt.Sprintf("10.%d.%d.%d", row/65536%256, row/256%256, row%256)
+	case has("address", "street"):
+		return fmt.Sprintf("%d %s", row+1, pick(streets))
+	case has("city"):
+		return pick(cities) + suffix
+	case has("country"):
+		return pick(countries) + suffix
+	case has("url", "website"):
+		return fmt.Sprintf("https://example.com/%d", row+1)
+	case has("card"):
+		return fmt.Sprintf("4111%012d", row)
+	case has("ssn"):
+		return fmt.Sprintf("900-%02d-%04d", row/10000%100, row%10000)
+	case has("zip", "postal"):
+		return fmt.Sprintf("%05d", (row*7919)%100000)
+	default:
+		return fmt.Sprintf("%s-%d", spec.name, row+1)
+	}
+}
+
+type checkConstraint struct {
+	min, max float64
+	values   []string
+}
+
+var (
+	checkRangeRegex = regexp.MustCompile("[\"`(]?(\\w+)[\"`)]?(?:::[\\w ]+)?\\s*(>=|>|<=|<)\\s*\\(?(-?\\d+(?:\\.\\d+)?)")
+	checkInRegex    = regexp.MustCompile("(?i)[\"`(]?(\\w+)[\"`)]?(?:::[\\w ]+)?\\s*(?:=\\s*ANY|IN)\\s*\\((.*)\\)")
+)
+
+// parseCheckConstraints extracts simple ranges and value lists of columns from check constraints.
+// Other check constraints are ignored.
+func parseCheckConstraints(checks []*storepb.CheckConstraintMetadata) map[string]*checkConstraint {
+	result := map[string]*checkConstraint{}
+	get := func(column string) *checkConstraint {
+		column = strings.ToLower(column)
+		if result[column] == nil {
+			result[column] = &checkConstraint{min: math.Inf(-1), max: math.Inf(1)}
+		}
+		return result[column]
+	}
+	for _, check := range checks {
+		for _, m := range checkRangeRegex.FindAllStringSubmatch(check.Expression, -1) {
+			n, err := strconv.ParseFloat(m[3], 64)
+			if err != nil {
+				continue
+			}
+			c := get(m[1])
+			switch m[2] {
+			case ">":
+				c.min = max(c.min, math.Floor(n)+1)
+			case ">=":
+				c.min = max(c.min, n)
+			case "<":
+				c.max = min(c.max, math.Ceil(n)-1)
+			case "<=":
+				c.max = min(c.max, n)
+			default:
+			}
+		}
+		if m := checkInRegex.FindStringSubmatch(check.Expression); m != nil {
+			var values []string
+			for _, v := range quotedRegex.FindAllStringSubmatch(m[2], -1) {
+				values = append(values, strings.ReplaceAll(v[1], "''", "'"))
+			}
+			if len(values) > 0 {
+				get(m[1]).values = values
+			}
+		}
+	}
+	return result
+}
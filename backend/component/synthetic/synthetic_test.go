@@ -0,0 +1,166 @@
+package synthetic
+
+import (
+	"math/rand/v2"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+)
+
+func TestGenerate(t *testing.T) {
+	a := require.New(t)
+	metadata := &storepb.DatabaseSchemaMetadata{
+		Schemas: []*storepb.SchemaMetadata{
+			{
+				Name:      "public",
+				EnumTypes: []*storepb.EnumTypeMetadata{{Name: "mood", Values: []string{"happy", "sad"}}},
+				Tables: []*storepb.TableMetadata{
+					{
+						Name: "orders",
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "id", Type: "integer"},
+							{Name: "customer_id", Type: "integer"},
+							{Name: "status", Type: "character varying(10)"},
+							{Name: "amount", Type: "numeric(8,2)"},
+						},
+						Indexes: []*storepb.IndexMetadata{{Name: "orders_pkey", Expressions: []string{"id"}, Primary: true}},
+						ForeignKeys: []*storepb.ForeignKeyMetadata{
+							{Name: "orders_customer_fk", Columns: []string{"customer_id"}, ReferencedSchema: "public", ReferencedTable: "customers", ReferencedColumns: []string{"id"}},
+						},
+						CheckConstraints: []*storepb.CheckConstraintMetadata{
+							{Name: "orders_status_check", Expression: "((status)::text = ANY ((ARRAY['new'::character varying, 'paid'::character varying])::text[]))"},
+							{Name: "orders_amount_check", Expression: "((amount > (100)::numeric) AND (amount <= (200)::numeric))"},
+						},
+					},
+					{
+						Name: "customers",
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "id", Type: "bigint"},
+							{Name: "contact", Type: "text"},
+							{Name: "mood", Type: "mood"},
+							{Name: "created_at", Type: "timestamp without time zone", Nullable: true},
+							{Name: "total", Type: "integer", Generation: &storepb.GenerationMetadata{Expression: "1"}},
+						},
+						Indexes: []*storepb.IndexMetadata{{Name: "customers_pkey", Expressions: []string{"id"}, Primary: true}},
+					},
+					{
+						Name:    "audit_log",
+						Columns: []*storepb.ColumnMetadata{{Name: "id", Type: "integer"}},
+					},
+				},
+			},
+		},
+	}
+
+	tables, err := Generate(metadata, Options{
+		Engine:        storepb.Engine_POSTGRES,
+		RowCount:      5,
+		Seed:          42,
+		Tables:        []string{"orders"},
+		SemanticTypes: map[string]string{"public.customers.contact": "Email address"},
+	})
+	a.NoError(err)
+	// The referenced customers table is included and generated first.
+	a.Len(tables, 2)
+	a.Equal("customers", tables[0].Table)
+	a.Equal("orders", tables[1].Table)
+
+	customers := tables[0].Result
+	a.Equal([]string{"id", "contact", "mood", "created_at"}, customers.ColumnNames)
+	customerIDs := map[int64]bool{}
+	for _, row := range customers.Rows {
+		customerIDs[row.Values[0].GetInt64Value()] = true
+		a.True(strings.HasSuffix(row.Values[1].GetStringValue(), "@example.com"))
+		a.Contains([]string{"happy", "sad"}, row.Values[2].GetStringValue())
+	}
+	a.Len(customerIDs, 5)
+
+	orderIDs := map[int64]bool{}
+	for _, row := range tables[1].Result.Rows {
+		orderIDs[row.Values[0].GetInt64Value()] = true
+		a.True(customerIDs[row.Values[1].GetInt64Value()])
+		a.Contains([]string{"new", "paid"}, row.Values[2].GetStringValue())
+		amount := row.Values[3].GetDoubleValue()
+		a.Greater(amount, 100.0)
+		a.LessOrEqual(amount, 200.0)
+	}
+	a.Len(orderIDs, 5)
+
+	// The same seed generates the same data.
+	again, err := Generate(metadata, Options{Engine: storepb.Engine_POSTGRES, RowCount: 5, Seed: 42, Tables: []string{"orders"}})
+	a.NoError(err)
+	a.Equal(statements(t, tables[1]), statements(t, again[1]))
+}
+
+func TestGenerateCycle(t *testing.T) {
+	a := require.New(t)
+	table := func(name, referenced string, nullable bool) *storepb.TableMetadata {
+		return &storepb.TableMetadata{
+			Name: name,
+			Columns: []*storepb.ColumnMetadata{
+				{Name: "id", Type: "int"},
+				{Name: "ref_id", Type: "int", Nullable: nullable},
+			},
+			ForeignKeys: []*storepb.ForeignKeyMetadata{
+				{Name: name + "_fk", Columns: []string{"ref_id"}, ReferencedTable: referenced, ReferencedColumns: []string{"id"}},
+			},
+		}
+	}
+
+	tables, err := Generate(&storepb.DatabaseSchemaMetadata{
+		Schemas: []*storepb.SchemaMetadata{{Tables: []*storepb.TableMetadata{table("a", "b", true), table("b", "a", false)}}},
+	}, Options{Engine: storepb.Engine_MYSQL, RowCount: 2})
+	a.NoError(err)
+	a.Equal("a", tables[0].Table)
+	for _, row := range tables[0].Result.Rows {
+		_, isNull := row.Values[1].Kind.(*v1pb.RowValue_NullValue)
+		a.True(isNull)
+	}
+
+	_, err = Generate(&storepb.DatabaseSchemaMetadata{
+		Schemas: []*storepb.SchemaMetadata{{Tables: []*storepb.TableMetadata{table("a", "b", false), table("b", "a", false)}}},
+	}, Options{Engine: storepb.Engine_MYSQL, RowCount: 2})
+	a.Error(err)
+}
+
+func TestGenerateUniqueDistinctValues(t *testing.T) {
+	a := require.New(t)
+	metadata := &storepb.DatabaseSchemaMetadata{
+		Schemas: []*storepb.SchemaMetadata{{Tables: []*storepb.TableMetadata{{
+			Name: "flags",
+			Columns: []*storepb.ColumnMetadata{
+				{Name: "status", Type: "enum('on','off')"},
+			},
+			Indexes: []*storepb.IndexMetadata{
+				{Name: "uk_status", Unique: true, Expressions: []string{"status"}},
+			},
+		}}}},
+	}
+	_, err := Generate(metadata, Options{Engine: storepb.Engine_MYSQL, RowCount: 2})
+	a.NoError(err)
+	_, err = Generate(metadata, Options{Engine: storepb.Engine_MYSQL, RowCount: 3})
+	a.Error(err)
+
+	metadata.Schemas[0].Tables[0].Columns[0].Type = "boolean"
+	_, err = Generate(metadata, Options{Engine: storepb.Engine_POSTGRES, RowCount: 3})
+	a.Error(err)
+}
+
+func TestSemanticValue(t *testing.T) {
+	a := require.New(t)
+	rng := rand.New(rand.NewPCG(1, 1))
+	a.Equal("10.0.0.1", semanticValue(&columnSpec{name: "client_ip"}, 1, rng))
+	a.Equal("07919", semanticValue(&columnSpec{name: "zip"}, 1, rng))
+	a.Equal("07919", semanticValue(&columnSpec{name: "code", hint: "zip code"}, 1, rng))
+	a.Equal("10.0.0.1", semanticValue(&columnSpec{name: "addr", hint: "ip address"}, 1, rng))
+}
+
+func statements(t *testing.T, data *TableData) string {
+	s, err := data.Statement(storepb.Engine_POSTGRES)
+	require.NoError(t, err)
+	return s
+}
@@ -1,6 +1,8 @@
 package webhook
 
 import (
+	"time"
+
 	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
 	"github.com/bytebase/bytebase/backend/store"
 )
@@ -16,6 +18,7 @@ type Event struct {
 	SentBack          *EventIssueSentBack
 	RolloutFailed     *EventRolloutFailed
 	RolloutCompleted  *EventRolloutCompleted
+	BreakGlass        *EventAccessGrantBreakGlass
 }
 
 func NewIssue(i *store.IssueMessage) *Issue {
@@ -103,6 +106,14 @@ type EventRolloutCompleted struct {
 	Environment string
 }
 
+type EventAccessGrantBreakGlass struct {
+	Creator          *User
+	Targets          []string
+	Reason           string
+	ExpireTime       time.Time
+	SecurityContacts []User
+}
+
 type User struct {
 	Name  string
 	Email string
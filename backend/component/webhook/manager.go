@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"strings"
 	"time"
 
 	"github.com/bytebase/bytebase/backend/common"
@@ -165,6 +166,29 @@ func (m *Manager) getWebhookContextFromEvent(ctx context.Context, e *Event, even
 			environment = e.RolloutCompleted.Environment
 		}
 
+	case storepb.Activity_ACCESS_GRANT_BREAK_GLASS:
+		level = webhook.WebhookError
+		title = "Break-glass access activated"
+		titleZh = "紧急访问已激活"
+		if e.BreakGlass != nil {
+			actor = e.BreakGlass.Creator
+			link = fmt.Sprintf("%s/projects/%s/access-grants", externalURL, e.Project.ResourceID)
+			description = fmt.Sprintf("%s activated break-glass access to %s until %s: %s",
+				actor.Name,
+				strings.Join(e.BreakGlass.Targets, ", "),
+				e.BreakGlass.ExpireTime.UTC().Format(time.RFC3339),
+				e.BreakGlass.Reason,
+			)
+			mentionUsers = make([]*store.UserMessage, 0, len(e.BreakGlass.SecurityContacts))
+			for _, user := range e.BreakGlass.SecurityContacts {
+				mentionUsers = append(mentionUsers, &store.UserMessage{
+					Name:  user.Name,
+					Email: user.Email,
+					Type:  storepb.PrincipalType_END_USER,
+				})
+			}
+		}
+
 	default:
 		// Unsupported event type
 		return nil, errors.Errorf("unsupported activity type %q for generating webhook context", e.Type)
@@ -3,6 +3,7 @@ package webhook
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -184,6 +185,37 @@ func TestGetWebhookContext_RolloutCompleted_WithData(t *testing.T) {
 	a.NotNil(webhookCtx.Rollout)
 }
 
+func TestGetWebhookContext_BreakGlass_WithData(t *testing.T) {
+	a := require.New(t)
+	m := newTestManager()
+	ctx := context.Background()
+
+	// ACCESS_GRANT_BREAK_GLASS mentions the security contacts — no store call needed since there is no issue.
+	e := &Event{
+		Type:    storepb.Activity_ACCESS_GRANT_BREAK_GLASS,
+		Project: &Project{ResourceID: "proj-1", Workspace: "ws-1", Title: "Test Project"},
+		BreakGlass: &EventAccessGrantBreakGlass{
+			Creator:    &User{Name: "Oncall", Email: "oncall@example.com"},
+			Targets:    []string{"instances/prod/databases/db1", "instances/prod/databases/db2"},
+			Reason:     "INC-42",
+			ExpireTime: time.Date(2026, 1, 1, 1, 0, 0, 0, time.UTC),
+			SecurityContacts: []User{
+				{Name: "Security", Email: "security@example.com"},
+			},
+		},
+	}
+	webhookCtx, err := m.getWebhookContextFromEvent(ctx, e, e.Type)
+	a.NoError(err)
+	a.Equal(webhook.WebhookError, webhookCtx.Level)
+	a.Equal("Break-glass access activated", webhookCtx.Title)
+	a.Equal("Oncall activated break-glass access to instances/prod/databases/db1, instances/prod/databases/db2 until 2026-01-01T01:00:00Z: INC-42", webhookCtx.Description)
+	a.Equal("https://bb.example.com/projects/proj-1/access-grants", webhookCtx.Link)
+	a.Equal("oncall@example.com", webhookCtx.ActorEmail)
+	a.Len(webhookCtx.MentionEndUsers, 1)
+	a.Equal("security@example.com", webhookCtx.MentionEndUsers[0].Email)
+	a.Nil(webhookCtx.Issue)
+}
+
 func TestGetWebhookContext_ProjectAlwaysSet(t *testing.T) {
 	a := require.New(t)
 	m := newTestManager()
@@ -215,6 +247,7 @@ func TestGetWebhookContext_AllEventLevels(t *testing.T) {
 		{"ISSUE_SENT_BACK is WARN", storepb.Activity_ISSUE_SENT_BACK, webhook.WebhookWarn},
 		{"PIPELINE_FAILED is ERROR", storepb.Activity_PIPELINE_FAILED, webhook.WebhookError},
 		{"PIPELINE_COMPLETED is SUCCESS", storepb.Activity_PIPELINE_COMPLETED, webhook.WebhookSuccess},
+		{"ACCESS_GRANT_BREAK_GLASS is ERROR", storepb.Activity_ACCESS_GRANT_BREAK_GLASS, webhook.WebhookError},
 	}
 
 	for _, tt := range tests {
@@ -43,5 +43,11 @@ func (x *AccessGrantPayload) Equal(y *AccessGrantPayload) bool {
 	if p, q := x.RequestedDuration, y.RequestedDuration; (p == nil && q != nil) || (p != nil && (q == nil || p.Seconds != q.Seconds || p.Nanos != q.Nanos)) {
 		return false
 	}
+	if x.BreakGlass != y.BreakGlass {
+		return false
+	}
+	if x.Admin != y.Admin {
+		return false
+	}
 	return true
 }
@@ -24,7 +24,13 @@ const (
 type ExportArchivePayload struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The exported file format. e.g. JSON, CSV, SQL
-	FileFormat    ExportFormat `protobuf:"varint,1,opt,name=file_format,json=fileFormat,proto3,enum=bytebase.store.ExportFormat" json:"file_format,omitempty"`
+	FileFormat ExportFormat `protobuf:"varint,1,opt,name=file_format,json=fileFormat,proto3,enum=bytebase.store.ExportFormat" json:"file_format,omitempty"`
+	// The user who runs the export in the background.
+	// Format: users/{email}
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	// The resource that the export in the background runs against.
+	// Empty for the exports of data export issues.
+	Source        string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -66,14 +72,30 @@ func (x *ExportArchivePayload) GetFileFormat() ExportFormat {
 	return ExportFormat_FORMAT_UNSPECIFIED
 }
 
+func (x *ExportArchivePayload) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *ExportArchivePayload) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
 var File_store_export_archive_proto protoreflect.FileDescriptor
 
 const file_store_export_archive_proto_rawDesc = "" +
 	"\n" +
-	"\x1astore/export_archive.proto\x12\x0ebytebase.store\x1a\x12store/common.proto\"U\n" +
+	"\x1astore/export_archive.proto\x12\x0ebytebase.store\x1a\x12store/common.proto\"\x87\x01\n" +
 	"\x14ExportArchivePayload\x12=\n" +
 	"\vfile_format\x18\x01 \x01(\x0e2\x1c.bytebase.store.ExportFormatR\n" +
-	"fileFormatB\x95\x01\n" +
+	"fileFormat\x12\x18\n" +
+	"\acreator\x18\x02 \x01(\tR\acreator\x12\x16\n" +
+	"\x06source\x18\x03 \x01(\tR\x06sourceB\x95\x01\n" +
 	"\x12com.bytebase.storeB\x12ExportArchiveProtoP\x01Z\x12generated-go/store\xa2\x02\x03BSX\xaa\x02\x0eBytebase.Store\xca\x02\x0eBytebase\\Store\xe2\x02\x1aBytebase\\Store\\GPBMetadata\xea\x02\x0fBytebase::Storeb\x06proto3"
 
 var (
@@ -13,5 +13,11 @@ func (x *ExportArchivePayload) Equal(y *ExportArchivePayload) bool {
 	if x.FileFormat != y.FileFormat {
 		return false
 	}
+	if x.Creator != y.Creator {
+		return false
+	}
+	if x.Source != y.Source {
+		return false
+	}
 	return true
 }
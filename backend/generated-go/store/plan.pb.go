@@ -84,6 +84,7 @@ type PlanConfig_Spec struct {
 	//	*PlanConfig_Spec_CreateDatabaseConfig
 	//	*PlanConfig_Spec_ChangeDatabaseConfig
 	//	*PlanConfig_Spec_ExportDataConfig
+	//	*PlanConfig_Spec_CloneDatabaseConfig
 	Config        isPlanConfig_Spec_Config `protobuf_oneof:"config"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -160,6 +161,15 @@ func (x *PlanConfig_Spec) GetExportDataConfig() *PlanConfig_ExportDataConfig {
 	return nil
 }
 
+func (x *PlanConfig_Spec) GetCloneDatabaseConfig() *PlanConfig_CloneDatabaseConfig {
+	if x != nil {
+		if x, ok := x.Config.(*PlanConfig_Spec_CloneDatabaseConfig); ok {
+			return x.CloneDatabaseConfig
+		}
+	}
+	return nil
+}
+
 type isPlanConfig_Spec_Config interface {
 	isPlanConfig_Spec_Config()
 }
@@ -176,12 +186,18 @@ type PlanConfig_Spec_ExportDataConfig struct {
 	ExportDataConfig *PlanConfig_ExportDataConfig `protobuf:"bytes,7,opt,name=export_data_config,json=exportDataConfig,proto3,oneof"`
 }
 
+type PlanConfig_Spec_CloneDatabaseConfig struct {
+	CloneDatabaseConfig *PlanConfig_CloneDatabaseConfig `protobuf:"bytes,8,opt,name=clone_database_config,json=cloneDatabaseConfig,proto3,oneof"`
+}
+
 func (*PlanConfig_Spec_CreateDatabaseConfig) isPlanConfig_Spec_Config() {}
 
 func (*PlanConfig_Spec_ChangeDatabaseConfig) isPlanConfig_Spec_Config() {}
 
 func (*PlanConfig_Spec_ExportDataConfig) isPlanConfig_Spec_Config() {}
 
+func (*PlanConfig_Spec_CloneDatabaseConfig) isPlanConfig_Spec_Config() {}
+
 type PlanConfig_CreateDatabaseConfig struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The resource name of the instance on which the database is created.
@@ -443,21 +459,108 @@ func (x *PlanConfig_ExportDataConfig) GetPassword() string {
 	return ""
 }
 
+type PlanConfig_CloneDatabaseConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The source database to copy from.
+	// Format: instances/{instance}/databases/{database}
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// The target database to copy into. It must already exist.
+	// Format: instances/{instance}/databases/{database}
+	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// Table patterns to copy, in the form of "schema.table" or "table".
+	// Glob wildcards are supported. Empty means all tables.
+	IncludeTables []string `protobuf:"bytes,3,rep,name=include_tables,json=includeTables,proto3" json:"include_tables,omitempty"`
+	// Table patterns to skip, in the same form as include_tables.
+	ExcludeTables []string `protobuf:"bytes,4,rep,name=exclude_tables,json=excludeTables,proto3" json:"exclude_tables,omitempty"`
+	// The percentage of rows to copy from each table, in [1, 100].
+	// 0 means all rows.
+	SamplePercent int32 `protobuf:"varint,5,opt,name=sample_percent,json=samplePercent,proto3" json:"sample_percent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanConfig_CloneDatabaseConfig) Reset() {
+	*x = PlanConfig_CloneDatabaseConfig{}
+	mi := &file_store_plan_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanConfig_CloneDatabaseConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanConfig_CloneDatabaseConfig) ProtoMessage() {}
+
+func (x *PlanConfig_CloneDatabaseConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_store_plan_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanConfig_CloneDatabaseConfig.ProtoReflect.Descriptor instead.
+func (*PlanConfig_CloneDatabaseConfig) Descriptor() ([]byte, []int) {
+	return file_store_plan_proto_rawDescGZIP(), []int{0, 4}
+}
+
+func (x *PlanConfig_CloneDatabaseConfig) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *PlanConfig_CloneDatabaseConfig) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *PlanConfig_CloneDatabaseConfig) GetIncludeTables() []string {
+	if x != nil {
+		return x.IncludeTables
+	}
+	return nil
+}
+
+func (x *PlanConfig_CloneDatabaseConfig) GetExcludeTables() []string {
+	if x != nil {
+		return x.ExcludeTables
+	}
+	return nil
+}
+
+func (x *PlanConfig_CloneDatabaseConfig) GetSamplePercent() int32 {
+	if x != nil {
+		return x.SamplePercent
+	}
+	return 0
+}
+
 var File_store_plan_proto protoreflect.FileDescriptor
 
 const file_store_plan_proto_rawDesc = "" +
 	"\n" +
-	"\x10store/plan.proto\x12\x0ebytebase.store\x1a\x1fgoogle/api/field_behavior.proto\x1a\x12store/common.proto\"\xac\b\n" +
+	"\x10store/plan.proto\x12\x0ebytebase.store\x1a\x1fgoogle/api/field_behavior.proto\x1a\x12store/common.proto\"\xcf\n" +
+	"\n" +
 	"\n" +
 	"PlanConfig\x125\n" +
 	"\x05specs\x18\x01 \x03(\v2\x1f.bytebase.store.PlanConfig.SpecR\x05specs\x12\x1f\n" +
 	"\vhas_rollout\x18\x02 \x01(\bR\n" +
-	"hasRollout\x1a\xcf\x02\n" +
+	"hasRollout\x1a\xb5\x03\n" +
 	"\x04Spec\x12\x0e\n" +
 	"\x02id\x18\x05 \x01(\tR\x02id\x12g\n" +
 	"\x16create_database_config\x18\x01 \x01(\v2/.bytebase.store.PlanConfig.CreateDatabaseConfigH\x00R\x14createDatabaseConfig\x12g\n" +
 	"\x16change_database_config\x18\x02 \x01(\v2/.bytebase.store.PlanConfig.ChangeDatabaseConfigH\x00R\x14changeDatabaseConfig\x12[\n" +
-	"\x12export_data_config\x18\a \x01(\v2+.bytebase.store.PlanConfig.ExportDataConfigH\x00R\x10exportDataConfigB\b\n" +
+	"\x12export_data_config\x18\a \x01(\v2+.bytebase.store.PlanConfig.ExportDataConfigH\x00R\x10exportDataConfig\x12d\n" +
+	"\x15clone_database_config\x18\b \x01(\v2..bytebase.store.PlanConfig.CloneDatabaseConfigH\x00R\x13cloneDatabaseConfigB\b\n" +
 	"\x06config\x1a\x9d\x02\n" +
 	"\x14CreateDatabaseConfig\x12\x1b\n" +
 	"\x06target\x18\x01 \x01(\tB\x03\xe0A\x02R\x06target\x12\x1f\n" +
@@ -479,7 +582,13 @@ const file_store_plan_proto_rawDesc = "" +
 	"\fsheet_sha256\x18\x02 \x01(\tR\vsheetSha256\x124\n" +
 	"\x06format\x18\x03 \x01(\x0e2\x1c.bytebase.store.ExportFormatR\x06format\x12\x1f\n" +
 	"\bpassword\x18\x04 \x01(\tH\x00R\bpassword\x88\x01\x01B\v\n" +
-	"\t_passwordB\x8c\x01\n" +
+	"\t_password\x1a\xba\x01\n" +
+	"\x13CloneDatabaseConfig\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\tR\x06target\x12%\n" +
+	"\x0einclude_tables\x18\x03 \x03(\tR\rincludeTables\x12%\n" +
+	"\x0eexclude_tables\x18\x04 \x03(\tR\rexcludeTables\x12%\n" +
+	"\x0esample_percent\x18\x05 \x01(\x05R\rsamplePercentB\x8c\x01\n" +
 	"\x12com.bytebase.storeB\tPlanProtoP\x01Z\x12generated-go/store\xa2\x02\x03BSX\xaa\x02\x0eBytebase.Store\xca\x02\x0eBytebase\\Store\xe2\x02\x1aBytebase\\Store\\GPBMetadata\xea\x02\x0fBytebase::Storeb\x06proto3"
 
 var (
@@ -494,26 +603,28 @@ func file_store_plan_proto_rawDescGZIP() []byte {
 	return file_store_plan_proto_rawDescData
 }
 
-var file_store_plan_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_store_plan_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_store_plan_proto_goTypes = []any{
 	(*PlanConfig)(nil),                      // 0: bytebase.store.PlanConfig
 	(*PlanConfig_Spec)(nil),                 // 1: bytebase.store.PlanConfig.Spec
 	(*PlanConfig_CreateDatabaseConfig)(nil), // 2: bytebase.store.PlanConfig.CreateDatabaseConfig
 	(*PlanConfig_ChangeDatabaseConfig)(nil), // 3: bytebase.store.PlanConfig.ChangeDatabaseConfig
 	(*PlanConfig_ExportDataConfig)(nil),     // 4: bytebase.store.PlanConfig.ExportDataConfig
-	(ExportFormat)(0),                       // 5: bytebase.store.ExportFormat
+	(*PlanConfig_CloneDatabaseConfig)(nil),  // 5: bytebase.store.PlanConfig.CloneDatabaseConfig
+	(ExportFormat)(0),                       // 6: bytebase.store.ExportFormat
 }
 var file_store_plan_proto_depIdxs = []int32{
 	1, // 0: bytebase.store.PlanConfig.specs:type_name -> bytebase.store.PlanConfig.Spec
 	2, // 1: bytebase.store.PlanConfig.Spec.create_database_config:type_name -> bytebase.store.PlanConfig.CreateDatabaseConfig
 	3, // 2: bytebase.store.PlanConfig.Spec.change_database_config:type_name -> bytebase.store.PlanConfig.ChangeDatabaseConfig
 	4, // 3: bytebase.store.PlanConfig.Spec.export_data_config:type_name -> bytebase.store.PlanConfig.ExportDataConfig
-	5, // 4: bytebase.store.PlanConfig.ExportDataConfig.format:type_name -> bytebase.store.ExportFormat
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	5, // 4: bytebase.store.PlanConfig.Spec.clone_database_config:type_name -> bytebase.store.PlanConfig.CloneDatabaseConfig
+	6, // 5: bytebase.store.PlanConfig.ExportDataConfig.format:type_name -> bytebase.store.ExportFormat
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_store_plan_proto_init() }
@@ -526,6 +637,7 @@ func file_store_plan_proto_init() {
 		(*PlanConfig_Spec_CreateDatabaseConfig)(nil),
 		(*PlanConfig_Spec_ChangeDatabaseConfig)(nil),
 		(*PlanConfig_Spec_ExportDataConfig)(nil),
+		(*PlanConfig_Spec_CloneDatabaseConfig)(nil),
 	}
 	file_store_plan_proto_msgTypes[4].OneofWrappers = []any{}
 	type x struct{}
@@ -534,7 +646,7 @@ func file_store_plan_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_plan_proto_rawDesc), len(file_store_plan_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
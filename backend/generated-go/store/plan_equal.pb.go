@@ -22,6 +22,9 @@ func (x *PlanConfig_Spec) Equal(y *PlanConfig_Spec) bool {
 	if !x.GetExportDataConfig().Equal(y.GetExportDataConfig()) {
 		return false
 	}
+	if !x.GetCloneDatabaseConfig().Equal(y.GetCloneDatabaseConfig()) {
+		return false
+	}
 	return true
 }
 
@@ -113,6 +116,41 @@ func (x *PlanConfig_ExportDataConfig) Equal(y *PlanConfig_ExportDataConfig) bool
 	return true
 }
 
+func (x *PlanConfig_CloneDatabaseConfig) Equal(y *PlanConfig_CloneDatabaseConfig) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Source != y.Source {
+		return false
+	}
+	if x.Target != y.Target {
+		return false
+	}
+	if len(x.IncludeTables) != len(y.IncludeTables) {
+		return false
+	}
+	for i := 0; i < len(x.IncludeTables); i++ {
+		if x.IncludeTables[i] != y.IncludeTables[i] {
+			return false
+		}
+	}
+	if len(x.ExcludeTables) != len(y.ExcludeTables) {
+		return false
+	}
+	for i := 0; i < len(x.ExcludeTables); i++ {
+		if x.ExcludeTables[i] != y.ExcludeTables[i] {
+			return false
+		}
+	}
+	if x.SamplePercent != y.SamplePercent {
+		return false
+	}
+	return true
+}
+
 func (x *PlanConfig) Equal(y *PlanConfig) bool {
 	if x == y {
 		return true
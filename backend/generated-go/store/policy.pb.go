@@ -32,6 +32,7 @@ const (
 	Policy_MASKING_RULE      Policy_Type = 4
 	Policy_IAM               Policy_Type = 5
 	Policy_TAG               Policy_Type = 6
+	Policy_ROW_FILTER        Policy_Type = 7
 )
 
 // Enum value maps for Policy_Type.
@@ -44,6 +45,7 @@ var (
 		4: "MASKING_RULE",
 		5: "IAM",
 		6: "TAG",
+		7: "ROW_FILTER",
 	}
 	Policy_Type_value = map[string]int32{
 		"TYPE_UNSPECIFIED":  0,
@@ -53,6 +55,7 @@ var (
 		"MASKING_RULE":      4,
 		"IAM":               5,
 		"TAG":               6,
+		"ROW_FILTER":        7,
 	}
 )
 
@@ -312,6 +315,51 @@ func (x *MaskingRulePolicy) GetRules() []*MaskingRulePolicy_MaskingRule {
 	return nil
 }
 
+// RowFilterPolicy restricts the rows that members can read in the SQL Editor.
+type RowFilterPolicy struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Filters       []*RowFilterPolicy_RowFilter `protobuf:"bytes,1,rep,name=filters,proto3" json:"filters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RowFilterPolicy) Reset() {
+	*x = RowFilterPolicy{}
+	mi := &file_store_policy_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RowFilterPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RowFilterPolicy) ProtoMessage() {}
+
+func (x *RowFilterPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_store_policy_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RowFilterPolicy.ProtoReflect.Descriptor instead.
+func (*RowFilterPolicy) Descriptor() ([]byte, []int) {
+	return file_store_policy_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RowFilterPolicy) GetFilters() []*RowFilterPolicy_RowFilter {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
 type TagPolicy struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// tags is the key-value map for resources.
@@ -323,7 +371,7 @@ type TagPolicy struct {
 
 func (x *TagPolicy) Reset() {
 	*x = TagPolicy{}
-	mi := &file_store_policy_proto_msgTypes[4]
+	mi := &file_store_policy_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -335,7 +383,7 @@ func (x *TagPolicy) String() string {
 func (*TagPolicy) ProtoMessage() {}
 
 func (x *TagPolicy) ProtoReflect() protoreflect.Message {
-	mi := &file_store_policy_proto_msgTypes[4]
+	mi := &file_store_policy_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -348,7 +396,7 @@ func (x *TagPolicy) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TagPolicy.ProtoReflect.Descriptor instead.
 func (*TagPolicy) Descriptor() ([]byte, []int) {
-	return file_store_policy_proto_rawDescGZIP(), []int{4}
+	return file_store_policy_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *TagPolicy) GetTags() map[string]string {
@@ -377,7 +425,7 @@ type Binding struct {
 
 func (x *Binding) Reset() {
 	*x = Binding{}
-	mi := &file_store_policy_proto_msgTypes[5]
+	mi := &file_store_policy_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -389,7 +437,7 @@ func (x *Binding) String() string {
 func (*Binding) ProtoMessage() {}
 
 func (x *Binding) ProtoReflect() protoreflect.Message {
-	mi := &file_store_policy_proto_msgTypes[5]
+	mi := &file_store_policy_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -402,7 +450,7 @@ func (x *Binding) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Binding.ProtoReflect.Descriptor instead.
 func (*Binding) Descriptor() ([]byte, []int) {
-	return file_store_policy_proto_rawDescGZIP(), []int{5}
+	return file_store_policy_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *Binding) GetRole() string {
@@ -437,7 +485,7 @@ type IamPolicy struct {
 
 func (x *IamPolicy) Reset() {
 	*x = IamPolicy{}
-	mi := &file_store_policy_proto_msgTypes[6]
+	mi := &file_store_policy_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -449,7 +497,7 @@ func (x *IamPolicy) String() string {
 func (*IamPolicy) ProtoMessage() {}
 
 func (x *IamPolicy) ProtoReflect() protoreflect.Message {
-	mi := &file_store_policy_proto_msgTypes[6]
+	mi := &file_store_policy_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -462,7 +510,7 @@ func (x *IamPolicy) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IamPolicy.ProtoReflect.Descriptor instead.
 func (*IamPolicy) Descriptor() ([]byte, []int) {
-	return file_store_policy_proto_rawDescGZIP(), []int{6}
+	return file_store_policy_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *IamPolicy) GetBindings() []*Binding {
@@ -488,6 +536,11 @@ type QueryDataPolicy struct {
 	// workspace-level policy
 	// Allow using the admin data source to query in the SQL editor.
 	AllowAdminDataSource bool `protobuf:"varint,9,opt,name=allow_admin_data_source,json=allowAdminDataSource,proto3" json:"allow_admin_data_source,omitempty"`
+	// Support both project-level and workspace-level.
+	// Queries and exports against databases containing data at or above this
+	// classification level require a justification.
+	// The default value <= 0, means no justification is required.
+	JustificationClassificationLevel int32 `protobuf:"varint,10,opt,name=justification_classification_level,json=justificationClassificationLevel,proto3" json:"justification_classification_level,omitempty"`
 	// ================
 	// Deprecate following fields.
 	// Disallow running DDL statements in the SQL editor.
@@ -500,7 +553,7 @@ type QueryDataPolicy struct {
 
 func (x *QueryDataPolicy) Reset() {
 	*x = QueryDataPolicy{}
-	mi := &file_store_policy_proto_msgTypes[7]
+	mi := &file_store_policy_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -512,7 +565,7 @@ func (x *QueryDataPolicy) String() string {
 func (*QueryDataPolicy) ProtoMessage() {}
 
 func (x *QueryDataPolicy) ProtoReflect() protoreflect.Message {
-	mi := &file_store_policy_proto_msgTypes[7]
+	mi := &file_store_policy_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -525,7 +578,7 @@ func (x *QueryDataPolicy) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryDataPolicy.ProtoReflect.Descriptor instead.
 func (*QueryDataPolicy) Descriptor() ([]byte, []int) {
-	return file_store_policy_proto_rawDescGZIP(), []int{7}
+	return file_store_policy_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *QueryDataPolicy) GetDisableExport() bool {
@@ -556,6 +609,13 @@ func (x *QueryDataPolicy) GetAllowAdminDataSource() bool {
 	return false
 }
 
+func (x *QueryDataPolicy) GetJustificationClassificationLevel() int32 {
+	if x != nil {
+		return x.JustificationClassificationLevel
+	}
+	return 0
+}
+
 func (x *QueryDataPolicy) GetDisallowDdl() bool {
 	if x != nil {
 		return x.DisallowDdl
@@ -599,7 +659,7 @@ type MaskingExemptionPolicy_Exemption struct {
 
 func (x *MaskingExemptionPolicy_Exemption) Reset() {
 	*x = MaskingExemptionPolicy_Exemption{}
-	mi := &file_store_policy_proto_msgTypes[8]
+	mi := &file_store_policy_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -611,7 +671,7 @@ func (x *MaskingExemptionPolicy_Exemption) String() string {
 func (*MaskingExemptionPolicy_Exemption) ProtoMessage() {}
 
 func (x *MaskingExemptionPolicy_Exemption) ProtoReflect() protoreflect.Message {
-	mi := &file_store_policy_proto_msgTypes[8]
+	mi := &file_store_policy_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -653,7 +713,7 @@ type MaskingRulePolicy_MaskingRule struct {
 
 func (x *MaskingRulePolicy_MaskingRule) Reset() {
 	*x = MaskingRulePolicy_MaskingRule{}
-	mi := &file_store_policy_proto_msgTypes[9]
+	mi := &file_store_policy_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -665,7 +725,7 @@ func (x *MaskingRulePolicy_MaskingRule) String() string {
 func (*MaskingRulePolicy_MaskingRule) ProtoMessage() {}
 
 func (x *MaskingRulePolicy_MaskingRule) ProtoReflect() protoreflect.Message {
-	mi := &file_store_policy_proto_msgTypes[9]
+	mi := &file_store_policy_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -702,12 +762,106 @@ func (x *MaskingRulePolicy_MaskingRule) GetSemanticType() string {
 	return ""
 }
 
+type RowFilterPolicy_RowFilter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// A unique identifier of the filter.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The title shown to users when the filter is applied.
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// The members the filter applies to.
+	// Format: users/{email} or groups/{group email}
+	Members []string `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`
+	// The project roles the filter applies to.
+	// Format: roles/{role}
+	Roles []string `protobuf:"bytes,4,rep,name=roles,proto3" json:"roles,omitempty"`
+	// The tables the filter applies to.
+	// Support variables: resource.instance_id, resource.database_name, resource.schema_name, resource.table_name.
+	Condition *expr.Expr `protobuf:"bytes,5,opt,name=condition,proto3" json:"condition,omitempty"`
+	// The rows that the members can read.
+	// Support variables: row.{column}, user.email, user.name, user.groups, user.roles.
+	Expression    *expr.Expr `protobuf:"bytes,6,opt,name=expression,proto3" json:"expression,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RowFilterPolicy_RowFilter) Reset() {
+	*x = RowFilterPolicy_RowFilter{}
+	mi := &file_store_policy_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RowFilterPolicy_RowFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RowFilterPolicy_RowFilter) ProtoMessage() {}
+
+func (x *RowFilterPolicy_RowFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_store_policy_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RowFilterPolicy_RowFilter.ProtoReflect.Descriptor instead.
+func (*RowFilterPolicy_RowFilter) Descriptor() ([]byte, []int) {
+	return file_store_policy_proto_rawDescGZIP(), []int{4, 0}
+}
+
+func (x *RowFilterPolicy_RowFilter) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RowFilterPolicy_RowFilter) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *RowFilterPolicy_RowFilter) GetMembers() []string {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *RowFilterPolicy_RowFilter) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *RowFilterPolicy_RowFilter) GetCondition() *expr.Expr {
+	if x != nil {
+		return x.Condition
+	}
+	return nil
+}
+
+func (x *RowFilterPolicy_RowFilter) GetExpression() *expr.Expr {
+	if x != nil {
+		return x.Expression
+	}
+	return nil
+}
+
 var File_store_policy_proto protoreflect.FileDescriptor
 
 const file_store_policy_proto_rawDesc = "" +
 	"\n" +
-	"\x12store/policy.proto\x12\x0ebytebase.store\x1a\x16google/type/expr.proto\"\xd1\x01\n" +
-	"\x06Policy\"t\n" +
+	"\x12store/policy.proto\x12\x0ebytebase.store\x1a\x16google/type/expr.proto\"\xe2\x01\n" +
+	"\x06Policy\"\x84\x01\n" +
 	"\x04Type\x12\x14\n" +
 	"\x10TYPE_UNSPECIFIED\x10\x00\x12\v\n" +
 	"\aROLLOUT\x10\x01\x12\x15\n" +
@@ -716,7 +870,9 @@ const file_store_policy_proto_rawDesc = "" +
 	"QUERY_DATA\x10\x03\x12\x10\n" +
 	"\fMASKING_RULE\x10\x04\x12\a\n" +
 	"\x03IAM\x10\x05\x12\a\n" +
-	"\x03TAG\x10\x06\"Q\n" +
+	"\x03TAG\x10\x06\x12\x0e\n" +
+	"\n" +
+	"ROW_FILTER\x10\a\"Q\n" +
 	"\bResource\x12\x18\n" +
 	"\x14RESOURCE_UNSPECIFIED\x10\x00\x12\r\n" +
 	"\tWORKSPACE\x10\x01\x12\x0f\n" +
@@ -737,7 +893,18 @@ const file_store_policy_proto_rawDesc = "" +
 	"\vMaskingRule\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12/\n" +
 	"\tcondition\x18\x02 \x01(\v2\x11.google.type.ExprR\tcondition\x12#\n" +
-	"\rsemantic_type\x18\x03 \x01(\tR\fsemanticType\"}\n" +
+	"\rsemantic_type\x18\x03 \x01(\tR\fsemanticType\"\x9e\x02\n" +
+	"\x0fRowFilterPolicy\x12C\n" +
+	"\afilters\x18\x01 \x03(\v2).bytebase.store.RowFilterPolicy.RowFilterR\afilters\x1a\xc5\x01\n" +
+	"\tRowFilter\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
+	"\amembers\x18\x03 \x03(\tR\amembers\x12\x14\n" +
+	"\x05roles\x18\x04 \x03(\tR\x05roles\x12/\n" +
+	"\tcondition\x18\x05 \x01(\v2\x11.google.type.ExprR\tcondition\x121\n" +
+	"\n" +
+	"expression\x18\x06 \x01(\v2\x11.google.type.ExprR\n" +
+	"expression\"}\n" +
 	"\tTagPolicy\x127\n" +
 	"\x04tags\x18\x01 \x03(\v2#.bytebase.store.TagPolicy.TagsEntryR\x04tags\x1a7\n" +
 	"\tTagsEntry\x12\x10\n" +
@@ -748,12 +915,14 @@ const file_store_policy_proto_rawDesc = "" +
 	"\amembers\x18\x02 \x03(\tR\amembers\x12/\n" +
 	"\tcondition\x18\x03 \x01(\v2\x11.google.type.ExprR\tcondition\"@\n" +
 	"\tIamPolicy\x123\n" +
-	"\bbindings\x18\x01 \x03(\v2\x17.bytebase.store.BindingR\bbindings\"\x9d\x02\n" +
+	"\bbindings\x18\x01 \x03(\v2\x17.bytebase.store.BindingR\bbindings\"\xeb\x02\n" +
 	"\x0fQueryDataPolicy\x12%\n" +
 	"\x0edisable_export\x18\x02 \x01(\bR\rdisableExport\x12.\n" +
 	"\x13maximum_result_rows\x18\x04 \x01(\x05R\x11maximumResultRows\x12*\n" +
 	"\x11disable_copy_data\x18\x05 \x01(\bR\x0fdisableCopyData\x125\n" +
-	"\x17allow_admin_data_source\x18\t \x01(\bR\x14allowAdminDataSource\x12!\n" +
+	"\x17allow_admin_data_source\x18\t \x01(\bR\x14allowAdminDataSource\x12L\n" +
+	"\"justification_classification_level\x18\n" +
+	" \x01(\x05R justificationClassificationLevel\x12!\n" +
 	"\fdisallow_ddl\x18\a \x01(\bR\vdisallowDdl\x12!\n" +
 	"\fdisallow_dml\x18\b \x01(\bR\vdisallowDmlJ\x04\b\x01\x10\x02J\x04\b\x03\x10\x04B\x8e\x01\n" +
 	"\x12com.bytebase.storeB\vPolicyProtoP\x01Z\x12generated-go/store\xa2\x02\x03BSX\xaa\x02\x0eBytebase.Store\xca\x02\x0eBytebase\\Store\xe2\x02\x1aBytebase\\Store\\GPBMetadata\xea\x02\x0fBytebase::Storeb\x06proto3"
@@ -771,7 +940,7 @@ func file_store_policy_proto_rawDescGZIP() []byte {
 }
 
 var file_store_policy_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_store_policy_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_store_policy_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_store_policy_proto_goTypes = []any{
 	(Policy_Type)(0),                         // 0: bytebase.store.Policy.Type
 	(Policy_Resource)(0),                     // 1: bytebase.store.Policy.Resource
@@ -779,28 +948,33 @@ var file_store_policy_proto_goTypes = []any{
 	(*RolloutPolicy)(nil),                    // 3: bytebase.store.RolloutPolicy
 	(*MaskingExemptionPolicy)(nil),           // 4: bytebase.store.MaskingExemptionPolicy
 	(*MaskingRulePolicy)(nil),                // 5: bytebase.store.MaskingRulePolicy
-	(*TagPolicy)(nil),                        // 6: bytebase.store.TagPolicy
-	(*Binding)(nil),                          // 7: bytebase.store.Binding
-	(*IamPolicy)(nil),                        // 8: bytebase.store.IamPolicy
-	(*QueryDataPolicy)(nil),                  // 9: bytebase.store.QueryDataPolicy
-	(*MaskingExemptionPolicy_Exemption)(nil), // 10: bytebase.store.MaskingExemptionPolicy.Exemption
-	(*MaskingRulePolicy_MaskingRule)(nil),    // 11: bytebase.store.MaskingRulePolicy.MaskingRule
-	nil,                                      // 12: bytebase.store.TagPolicy.TagsEntry
-	(*expr.Expr)(nil),                        // 13: google.type.Expr
+	(*RowFilterPolicy)(nil),                  // 6: bytebase.store.RowFilterPolicy
+	(*TagPolicy)(nil),                        // 7: bytebase.store.TagPolicy
+	(*Binding)(nil),                          // 8: bytebase.store.Binding
+	(*IamPolicy)(nil),                        // 9: bytebase.store.IamPolicy
+	(*QueryDataPolicy)(nil),                  // 10: bytebase.store.QueryDataPolicy
+	(*MaskingExemptionPolicy_Exemption)(nil), // 11: bytebase.store.MaskingExemptionPolicy.Exemption
+	(*MaskingRulePolicy_MaskingRule)(nil),    // 12: bytebase.store.MaskingRulePolicy.MaskingRule
+	(*RowFilterPolicy_RowFilter)(nil),        // 13: bytebase.store.RowFilterPolicy.RowFilter
+	nil,                                      // 14: bytebase.store.TagPolicy.TagsEntry
+	(*expr.Expr)(nil),                        // 15: google.type.Expr
 }
 var file_store_policy_proto_depIdxs = []int32{
-	10, // 0: bytebase.store.MaskingExemptionPolicy.exemptions:type_name -> bytebase.store.MaskingExemptionPolicy.Exemption
-	11, // 1: bytebase.store.MaskingRulePolicy.rules:type_name -> bytebase.store.MaskingRulePolicy.MaskingRule
-	12, // 2: bytebase.store.TagPolicy.tags:type_name -> bytebase.store.TagPolicy.TagsEntry
-	13, // 3: bytebase.store.Binding.condition:type_name -> google.type.Expr
-	7,  // 4: bytebase.store.IamPolicy.bindings:type_name -> bytebase.store.Binding
-	13, // 5: bytebase.store.MaskingExemptionPolicy.Exemption.condition:type_name -> google.type.Expr
-	13, // 6: bytebase.store.MaskingRulePolicy.MaskingRule.condition:type_name -> google.type.Expr
-	7,  // [7:7] is the sub-list for method output_type
-	7,  // [7:7] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	11, // 0: bytebase.store.MaskingExemptionPolicy.exemptions:type_name -> bytebase.store.MaskingExemptionPolicy.Exemption
+	12, // 1: bytebase.store.MaskingRulePolicy.rules:type_name -> bytebase.store.MaskingRulePolicy.MaskingRule
+	13, // 2: bytebase.store.RowFilterPolicy.filters:type_name -> bytebase.store.RowFilterPolicy.RowFilter
+	14, // 3: bytebase.store.TagPolicy.tags:type_name -> bytebase.store.TagPolicy.TagsEntry
+	15, // 4: bytebase.store.Binding.condition:type_name -> google.type.Expr
+	8,  // 5: bytebase.store.IamPolicy.bindings:type_name -> bytebase.store.Binding
+	15, // 6: bytebase.store.MaskingExemptionPolicy.Exemption.condition:type_name -> google.type.Expr
+	15, // 7: bytebase.store.MaskingRulePolicy.MaskingRule.condition:type_name -> google.type.Expr
+	15, // 8: bytebase.store.RowFilterPolicy.RowFilter.condition:type_name -> google.type.Expr
+	15, // 9: bytebase.store.RowFilterPolicy.RowFilter.expression:type_name -> google.type.Expr
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_store_policy_proto_init() }
@@ -814,7 +988,7 @@ func file_store_policy_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_policy_proto_rawDesc), len(file_store_policy_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   11,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -119,6 +119,66 @@ func (x *MaskingRulePolicy) Equal(y *MaskingRulePolicy) bool {
 	return true
 }
 
+func (x *RowFilterPolicy_RowFilter) Equal(y *RowFilterPolicy_RowFilter) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Id != y.Id {
+		return false
+	}
+	if x.Title != y.Title {
+		return false
+	}
+	if len(x.Members) != len(y.Members) {
+		return false
+	}
+	for i := 0; i < len(x.Members); i++ {
+		if x.Members[i] != y.Members[i] {
+			return false
+		}
+	}
+	if len(x.Roles) != len(y.Roles) {
+		return false
+	}
+	for i := 0; i < len(x.Roles); i++ {
+		if x.Roles[i] != y.Roles[i] {
+			return false
+		}
+	}
+	if equal, ok := interface{}(x.Condition).(interface{ Equal(*expr.Expr) bool }); !ok || !equal.Equal(y.Condition) {
+		return false
+	} else if !proto.Equal(x.Condition, y.Condition) {
+		return false
+	}
+	if equal, ok := interface{}(x.Expression).(interface{ Equal(*expr.Expr) bool }); !ok || !equal.Equal(y.Expression) {
+		return false
+	} else if !proto.Equal(x.Expression, y.Expression) {
+		return false
+	}
+	return true
+}
+
+func (x *RowFilterPolicy) Equal(y *RowFilterPolicy) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if len(x.Filters) != len(y.Filters) {
+		return false
+	}
+	for i := 0; i < len(x.Filters); i++ {
+		if !x.Filters[i].Equal(y.Filters[i]) {
+			return false
+		}
+	}
+	return true
+}
+
 func (x *TagPolicy) Equal(y *TagPolicy) bool {
 	if x == y {
 		return true
@@ -204,6 +264,9 @@ func (x *QueryDataPolicy) Equal(y *QueryDataPolicy) bool {
 	if x.AllowAdminDataSource != y.AllowAdminDataSource {
 		return false
 	}
+	if x.JustificationClassificationLevel != y.JustificationClassificationLevel {
+		return false
+	}
 	if x.DisallowDdl != y.DisallowDdl {
 		return false
 	}
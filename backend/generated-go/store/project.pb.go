@@ -9,6 +9,7 @@ package store
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -121,8 +122,12 @@ type Project struct {
 	DataClassificationConfigId string `protobuf:"bytes,18,opt,name=data_classification_config_id,json=dataClassificationConfigId,proto3" json:"data_classification_config_id,omitempty"`
 	// Once enabled, users can request and use the just-in-time access in the SQL Editor.
 	AllowJustInTimeAccess bool `protobuf:"varint,19,opt,name=allow_just_in_time_access,json=allowJustInTimeAccess,proto3" json:"allow_just_in_time_access,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+	// The SQL Editor query result cache of the project.
+	QueryResultCache *Project_QueryResultCache `protobuf:"bytes,20,opt,name=query_result_cache,json=queryResultCache,proto3" json:"query_result_cache,omitempty"`
+	// The break-glass access setting of the project.
+	BreakGlassAccess *Project_BreakGlassAccess `protobuf:"bytes,21,opt,name=break_glass_access,json=breakGlassAccess,proto3" json:"break_glass_access,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *Project) Reset() {
@@ -260,6 +265,20 @@ func (x *Project) GetAllowJustInTimeAccess() bool {
 	return false
 }
 
+func (x *Project) GetQueryResultCache() *Project_QueryResultCache {
+	if x != nil {
+		return x.QueryResultCache
+	}
+	return nil
+}
+
+func (x *Project) GetBreakGlassAccess() *Project_BreakGlassAccess {
+	if x != nil {
+		return x.BreakGlassAccess
+	}
+	return nil
+}
+
 // ExecutionRetryPolicy defines retry behavior for failed task executions.
 type Project_ExecutionRetryPolicy struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -306,15 +325,136 @@ func (x *Project_ExecutionRetryPolicy) GetMaximumRetries() int32 {
 	return 0
 }
 
+type Project_QueryResultCache struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Ttl           *durationpb.Duration   `protobuf:"bytes,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	MaximumSize   int64                  `protobuf:"varint,3,opt,name=maximum_size,json=maximumSize,proto3" json:"maximum_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Project_QueryResultCache) Reset() {
+	*x = Project_QueryResultCache{}
+	mi := &file_store_project_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Project_QueryResultCache) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Project_QueryResultCache) ProtoMessage() {}
+
+func (x *Project_QueryResultCache) ProtoReflect() protoreflect.Message {
+	mi := &file_store_project_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Project_QueryResultCache.ProtoReflect.Descriptor instead.
+func (*Project_QueryResultCache) Descriptor() ([]byte, []int) {
+	return file_store_project_proto_rawDescGZIP(), []int{1, 2}
+}
+
+func (x *Project_QueryResultCache) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Project_QueryResultCache) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+func (x *Project_QueryResultCache) GetMaximumSize() int64 {
+	if x != nil {
+		return x.MaximumSize
+	}
+	return 0
+}
+
+type Project_BreakGlassAccess struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Enabled         bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	MaximumDuration *durationpb.Duration   `protobuf:"bytes,2,opt,name=maximum_duration,json=maximumDuration,proto3" json:"maximum_duration,omitempty"`
+	// Format: users/{email}
+	SecurityContacts []string `protobuf:"bytes,3,rep,name=security_contacts,json=securityContacts,proto3" json:"security_contacts,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Project_BreakGlassAccess) Reset() {
+	*x = Project_BreakGlassAccess{}
+	mi := &file_store_project_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Project_BreakGlassAccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Project_BreakGlassAccess) ProtoMessage() {}
+
+func (x *Project_BreakGlassAccess) ProtoReflect() protoreflect.Message {
+	mi := &file_store_project_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Project_BreakGlassAccess.ProtoReflect.Descriptor instead.
+func (*Project_BreakGlassAccess) Descriptor() ([]byte, []int) {
+	return file_store_project_proto_rawDescGZIP(), []int{1, 3}
+}
+
+func (x *Project_BreakGlassAccess) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Project_BreakGlassAccess) GetMaximumDuration() *durationpb.Duration {
+	if x != nil {
+		return x.MaximumDuration
+	}
+	return nil
+}
+
+func (x *Project_BreakGlassAccess) GetSecurityContacts() []string {
+	if x != nil {
+		return x.SecurityContacts
+	}
+	return nil
+}
+
 var File_store_project_proto protoreflect.FileDescriptor
 
 const file_store_project_proto_rawDesc = "" +
 	"\n" +
-	"\x13store/project.proto\x12\x0ebytebase.store\"I\n" +
+	"\x13store/project.proto\x12\x0ebytebase.store\x1a\x1egoogle/protobuf/duration.proto\"I\n" +
 	"\x05Label\x12\x14\n" +
 	"\x05value\x18\x01 \x01(\tR\x05value\x12\x14\n" +
 	"\x05color\x18\x02 \x01(\tR\x05color\x12\x14\n" +
-	"\x05group\x18\x03 \x01(\tR\x05group\"\xe5\a\n" +
+	"\x05group\x18\x03 \x01(\tR\x05group\"\xb5\v\n" +
 	"\aProject\x128\n" +
 	"\fissue_labels\x18\x01 \x03(\v2\x15.bytebase.store.LabelR\vissueLabels\x12,\n" +
 	"\x12force_issue_labels\x18\x02 \x01(\bR\x10forceIssueLabels\x12.\n" +
@@ -331,12 +471,22 @@ const file_store_project_proto_rawDesc = "" +
 	"\x1brequire_plan_check_no_error\x18\x10 \x01(\bR\x17requirePlanCheckNoError\x12,\n" +
 	"\x12allow_request_role\x18\x11 \x01(\bR\x10allowRequestRole\x12A\n" +
 	"\x1ddata_classification_config_id\x18\x12 \x01(\tR\x1adataClassificationConfigId\x128\n" +
-	"\x19allow_just_in_time_access\x18\x13 \x01(\bR\x15allowJustInTimeAccess\x1a?\n" +
+	"\x19allow_just_in_time_access\x18\x13 \x01(\bR\x15allowJustInTimeAccess\x12V\n" +
+	"\x12query_result_cache\x18\x14 \x01(\v2(.bytebase.store.Project.QueryResultCacheR\x10queryResultCache\x12V\n" +
+	"\x12break_glass_access\x18\x15 \x01(\v2(.bytebase.store.Project.BreakGlassAccessR\x10breakGlassAccess\x1a?\n" +
 	"\x14ExecutionRetryPolicy\x12'\n" +
 	"\x0fmaximum_retries\x18\x01 \x01(\x05R\x0emaximumRetries\x1a9\n" +
 	"\vLabelsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x8f\x01\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a|\n" +
+	"\x10QueryResultCache\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12+\n" +
+	"\x03ttl\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x03ttl\x12!\n" +
+	"\fmaximum_size\x18\x03 \x01(\x03R\vmaximumSize\x1a\x9f\x01\n" +
+	"\x10BreakGlassAccess\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12D\n" +
+	"\x10maximum_duration\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x0fmaximumDuration\x12+\n" +
+	"\x11security_contacts\x18\x03 \x03(\tR\x10securityContactsB\x8f\x01\n" +
 	"\x12com.bytebase.storeB\fProjectProtoP\x01Z\x12generated-go/store\xa2\x02\x03BSX\xaa\x02\x0eBytebase.Store\xca\x02\x0eBytebase\\Store\xe2\x02\x1aBytebase\\Store\\GPBMetadata\xea\x02\x0fBytebase::Storeb\x06proto3"
 
 var (
@@ -351,22 +501,29 @@ func file_store_project_proto_rawDescGZIP() []byte {
 	return file_store_project_proto_rawDescData
 }
 
-var file_store_project_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_store_project_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_store_project_proto_goTypes = []any{
 	(*Label)(nil),                        // 0: bytebase.store.Label
 	(*Project)(nil),                      // 1: bytebase.store.Project
 	(*Project_ExecutionRetryPolicy)(nil), // 2: bytebase.store.Project.ExecutionRetryPolicy
 	nil,                                  // 3: bytebase.store.Project.LabelsEntry
+	(*Project_QueryResultCache)(nil),     // 4: bytebase.store.Project.QueryResultCache
+	(*Project_BreakGlassAccess)(nil),     // 5: bytebase.store.Project.BreakGlassAccess
+	(*durationpb.Duration)(nil),          // 6: google.protobuf.Duration
 }
 var file_store_project_proto_depIdxs = []int32{
 	0, // 0: bytebase.store.Project.issue_labels:type_name -> bytebase.store.Label
 	2, // 1: bytebase.store.Project.execution_retry_policy:type_name -> bytebase.store.Project.ExecutionRetryPolicy
 	3, // 2: bytebase.store.Project.labels:type_name -> bytebase.store.Project.LabelsEntry
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	4, // 3: bytebase.store.Project.query_result_cache:type_name -> bytebase.store.Project.QueryResultCache
+	5, // 4: bytebase.store.Project.break_glass_access:type_name -> bytebase.store.Project.BreakGlassAccess
+	6, // 5: bytebase.store.Project.QueryResultCache.ttl:type_name -> google.protobuf.Duration
+	6, // 6: bytebase.store.Project.BreakGlassAccess.maximum_duration:type_name -> google.protobuf.Duration
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_store_project_proto_init() }
@@ -380,7 +537,7 @@ func file_store_project_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_project_proto_rawDesc), len(file_store_project_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -23,9 +23,16 @@ const (
 )
 
 type QueryHistoryPayload struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Error         *string                `protobuf:"bytes,1,opt,name=error,proto3,oneof" json:"error,omitempty"`
-	Duration      *durationpb.Duration   `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Error    *string                `protobuf:"bytes,1,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	Duration *durationpb.Duration   `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	// The ID of the access grant that authorized the query.
+	// It tags the queries run in a break-glass session.
+	AccessGrantId string `protobuf:"bytes,3,opt,name=access_grant_id,json=accessGrantId,proto3" json:"access_grant_id,omitempty"`
+	// The justification reason of the query.
+	Justification string `protobuf:"bytes,4,opt,name=justification,proto3" json:"justification,omitempty"`
+	// The external ticket ID referenced by the query.
+	Ticket        string `protobuf:"bytes,5,opt,name=ticket,proto3" json:"ticket,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -74,14 +81,38 @@ func (x *QueryHistoryPayload) GetDuration() *durationpb.Duration {
 	return nil
 }
 
+func (x *QueryHistoryPayload) GetAccessGrantId() string {
+	if x != nil {
+		return x.AccessGrantId
+	}
+	return ""
+}
+
+func (x *QueryHistoryPayload) GetJustification() string {
+	if x != nil {
+		return x.Justification
+	}
+	return ""
+}
+
+func (x *QueryHistoryPayload) GetTicket() string {
+	if x != nil {
+		return x.Ticket
+	}
+	return ""
+}
+
 var File_store_query_history_proto protoreflect.FileDescriptor
 
 const file_store_query_history_proto_rawDesc = "" +
 	"\n" +
-	"\x19store/query_history.proto\x12\x0ebytebase.store\x1a\x1egoogle/protobuf/duration.proto\"q\n" +
+	"\x19store/query_history.proto\x12\x0ebytebase.store\x1a\x1egoogle/protobuf/duration.proto\"\xd7\x01\n" +
 	"\x13QueryHistoryPayload\x12\x19\n" +
 	"\x05error\x18\x01 \x01(\tH\x00R\x05error\x88\x01\x01\x125\n" +
-	"\bduration\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\bdurationB\b\n" +
+	"\bduration\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\bduration\x12&\n" +
+	"\x0faccess_grant_id\x18\x03 \x01(\tR\raccessGrantId\x12$\n" +
+	"\rjustification\x18\x04 \x01(\tR\rjustification\x12\x16\n" +
+	"\x06ticket\x18\x05 \x01(\tR\x06ticketB\b\n" +
 	"\x06_errorB\x94\x01\n" +
 	"\x12com.bytebase.storeB\x11QueryHistoryProtoP\x01Z\x12generated-go/store\xa2\x02\x03BSX\xaa\x02\x0eBytebase.Store\xca\x02\x0eBytebase\\Store\xe2\x02\x1aBytebase\\Store\\GPBMetadata\xea\x02\x0fBytebase::Storeb\x06proto3"
 
@@ -16,5 +16,14 @@ func (x *QueryHistoryPayload) Equal(y *QueryHistoryPayload) bool {
 	if p, q := x.Duration, y.Duration; (p == nil && q != nil) || (p != nil && (q == nil || p.Seconds != q.Seconds || p.Nanos != q.Nanos)) {
 		return false
 	}
+	if x.AccessGrantId != y.AccessGrantId {
+		return false
+	}
+	if x.Justification != y.Justification {
+		return false
+	}
+	if x.Ticket != y.Ticket {
+		return false
+	}
 	return true
 }
@@ -9,6 +9,7 @@ package store
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -22,10 +23,12 @@ const (
 )
 
 type ReleasePayload struct {
-	state         protoimpl.MessageState    `protogen:"open.v1"`
-	Files         []*ReleasePayload_File    `protobuf:"bytes,2,rep,name=files,proto3" json:"files,omitempty"`
-	VcsSource     *ReleasePayload_VCSSource `protobuf:"bytes,3,opt,name=vcs_source,json=vcsSource,proto3" json:"vcs_source,omitempty"`
-	Type          SchemaChangeType          `protobuf:"varint,4,opt,name=type,proto3,enum=bytebase.store.SchemaChangeType" json:"type,omitempty"`
+	state     protoimpl.MessageState    `protogen:"open.v1"`
+	Files     []*ReleasePayload_File    `protobuf:"bytes,2,rep,name=files,proto3" json:"files,omitempty"`
+	VcsSource *ReleasePayload_VCSSource `protobuf:"bytes,3,opt,name=vcs_source,json=vcsSource,proto3" json:"vcs_source,omitempty"`
+	Type      SchemaChangeType          `protobuf:"varint,4,opt,name=type,proto3,enum=bytebase.store.SchemaChangeType" json:"type,omitempty"`
+	// The source of the release imported from a release bundle.
+	ImportSource  *ReleasePayload_ImportSource `protobuf:"bytes,5,opt,name=import_source,json=importSource,proto3" json:"import_source,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -81,6 +84,13 @@ func (x *ReleasePayload) GetType() SchemaChangeType {
 	return SchemaChangeType_SCHEMA_CHANGE_TYPE_UNSPECIFIED
 }
 
+func (x *ReleasePayload) GetImportSource() *ReleasePayload_ImportSource {
+	if x != nil {
+		return x.ImportSource
+	}
+	return nil
+}
+
 type ReleasePayload_File struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The path of the file, e.g., `2.2/V0001_create_table.sql`.
@@ -143,17 +153,144 @@ func (x *ReleasePayload_File) GetVersion() string {
 	return ""
 }
 
-type ReleasePayload_VCSSource struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	VcsType       VCSType                `protobuf:"varint,1,opt,name=vcs_type,json=vcsType,proto3,enum=bytebase.store.VCSType" json:"vcs_type,omitempty"`
-	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+type ReleasePayload_ImportSource struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the exported release.
+	// Format: projects/{project}/releases/{release}
+	Release string `protobuf:"bytes,1,opt,name=release,proto3" json:"release,omitempty"`
+	// The time the bundle is exported.
+	ExportTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=export_time,json=exportTime,proto3" json:"export_time,omitempty"`
+	// The check results of the release run by the exporting server.
+	CheckResults  *ReleasePayload_CheckResults `protobuf:"bytes,3,opt,name=check_results,json=checkResults,proto3" json:"check_results,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *ReleasePayload_ImportSource) Reset() {
+	*x = ReleasePayload_ImportSource{}
+	mi := &file_store_release_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleasePayload_ImportSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleasePayload_ImportSource) ProtoMessage() {}
+
+func (x *ReleasePayload_ImportSource) ProtoReflect() protoreflect.Message {
+	mi := &file_store_release_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleasePayload_ImportSource.ProtoReflect.Descriptor instead.
+func (*ReleasePayload_ImportSource) Descriptor() ([]byte, []int) {
+	return file_store_release_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *ReleasePayload_ImportSource) GetRelease() string {
+	if x != nil {
+		return x.Release
+	}
+	return ""
+}
+
+func (x *ReleasePayload_ImportSource) GetExportTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExportTime
+	}
+	return nil
+}
+
+func (x *ReleasePayload_ImportSource) GetCheckResults() *ReleasePayload_CheckResults {
+	if x != nil {
+		return x.CheckResults
+	}
+	return nil
+}
+
+type ReleasePayload_CheckResults struct {
+	state         protoimpl.MessageState                `protogen:"open.v1"`
+	Results       []*ReleasePayload_CheckResults_Result `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	AffectedRows  int64                                 `protobuf:"varint,2,opt,name=affected_rows,json=affectedRows,proto3" json:"affected_rows,omitempty"`
+	RiskLevel     RiskLevel                             `protobuf:"varint,3,opt,name=risk_level,json=riskLevel,proto3,enum=bytebase.store.RiskLevel" json:"risk_level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleasePayload_CheckResults) Reset() {
+	*x = ReleasePayload_CheckResults{}
+	mi := &file_store_release_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleasePayload_CheckResults) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleasePayload_CheckResults) ProtoMessage() {}
+
+func (x *ReleasePayload_CheckResults) ProtoReflect() protoreflect.Message {
+	mi := &file_store_release_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleasePayload_CheckResults.ProtoReflect.Descriptor instead.
+func (*ReleasePayload_CheckResults) Descriptor() ([]byte, []int) {
+	return file_store_release_proto_rawDescGZIP(), []int{0, 2}
+}
+
+func (x *ReleasePayload_CheckResults) GetResults() []*ReleasePayload_CheckResults_Result {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *ReleasePayload_CheckResults) GetAffectedRows() int64 {
+	if x != nil {
+		return x.AffectedRows
+	}
+	return 0
+}
+
+func (x *ReleasePayload_CheckResults) GetRiskLevel() RiskLevel {
+	if x != nil {
+		return x.RiskLevel
+	}
+	return RiskLevel_RISK_LEVEL_UNSPECIFIED
+}
+
+type ReleasePayload_VCSSource struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	VcsType        VCSType                `protobuf:"varint,1,opt,name=vcs_type,json=vcsType,proto3,enum=bytebase.store.VCSType" json:"vcs_type,omitempty"`
+	Url            string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Commit         string                 `protobuf:"bytes,3,opt,name=commit,proto3" json:"commit,omitempty"`
+	PullRequestUrl string                 `protobuf:"bytes,4,opt,name=pull_request_url,json=pullRequestUrl,proto3" json:"pull_request_url,omitempty"`
+	Repository     string                 `protobuf:"bytes,5,opt,name=repository,proto3" json:"repository,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
 func (x *ReleasePayload_VCSSource) Reset() {
 	*x = ReleasePayload_VCSSource{}
-	mi := &file_store_release_proto_msgTypes[2]
+	mi := &file_store_release_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -165,7 +302,7 @@ func (x *ReleasePayload_VCSSource) String() string {
 func (*ReleasePayload_VCSSource) ProtoMessage() {}
 
 func (x *ReleasePayload_VCSSource) ProtoReflect() protoreflect.Message {
-	mi := &file_store_release_proto_msgTypes[2]
+	mi := &file_store_release_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -178,7 +315,7 @@ func (x *ReleasePayload_VCSSource) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReleasePayload_VCSSource.ProtoReflect.Descriptor instead.
 func (*ReleasePayload_VCSSource) Descriptor() ([]byte, []int) {
-	return file_store_release_proto_rawDescGZIP(), []int{0, 1}
+	return file_store_release_proto_rawDescGZIP(), []int{0, 3}
 }
 
 func (x *ReleasePayload_VCSSource) GetVcsType() VCSType {
@@ -195,23 +332,144 @@ func (x *ReleasePayload_VCSSource) GetUrl() string {
 	return ""
 }
 
+func (x *ReleasePayload_VCSSource) GetCommit() string {
+	if x != nil {
+		return x.Commit
+	}
+	return ""
+}
+
+func (x *ReleasePayload_VCSSource) GetPullRequestUrl() string {
+	if x != nil {
+		return x.PullRequestUrl
+	}
+	return ""
+}
+
+func (x *ReleasePayload_VCSSource) GetRepository() string {
+	if x != nil {
+		return x.Repository
+	}
+	return ""
+}
+
+type ReleasePayload_CheckResults_Result struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	File  string                 `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	// Format: instances/{instance}/databases/{database}
+	Target        string    `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	Advices       []*Advice `protobuf:"bytes,3,rep,name=advices,proto3" json:"advices,omitempty"`
+	AffectedRows  int64     `protobuf:"varint,4,opt,name=affected_rows,json=affectedRows,proto3" json:"affected_rows,omitempty"`
+	RiskLevel     RiskLevel `protobuf:"varint,5,opt,name=risk_level,json=riskLevel,proto3,enum=bytebase.store.RiskLevel" json:"risk_level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleasePayload_CheckResults_Result) Reset() {
+	*x = ReleasePayload_CheckResults_Result{}
+	mi := &file_store_release_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleasePayload_CheckResults_Result) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleasePayload_CheckResults_Result) ProtoMessage() {}
+
+func (x *ReleasePayload_CheckResults_Result) ProtoReflect() protoreflect.Message {
+	mi := &file_store_release_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleasePayload_CheckResults_Result.ProtoReflect.Descriptor instead.
+func (*ReleasePayload_CheckResults_Result) Descriptor() ([]byte, []int) {
+	return file_store_release_proto_rawDescGZIP(), []int{0, 2, 0}
+}
+
+func (x *ReleasePayload_CheckResults_Result) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *ReleasePayload_CheckResults_Result) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *ReleasePayload_CheckResults_Result) GetAdvices() []*Advice {
+	if x != nil {
+		return x.Advices
+	}
+	return nil
+}
+
+func (x *ReleasePayload_CheckResults_Result) GetAffectedRows() int64 {
+	if x != nil {
+		return x.AffectedRows
+	}
+	return 0
+}
+
+func (x *ReleasePayload_CheckResults_Result) GetRiskLevel() RiskLevel {
+	if x != nil {
+		return x.RiskLevel
+	}
+	return RiskLevel_RISK_LEVEL_UNSPECIFIED
+}
+
 var File_store_release_proto protoreflect.FileDescriptor
 
 const file_store_release_proto_rawDesc = "" +
 	"\n" +
-	"\x13store/release.proto\x12\x0ebytebase.store\x1a\x12store/common.proto\"\xf6\x02\n" +
+	"\x13store/release.proto\x12\x0ebytebase.store\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x12store/advice.proto\x1a\x12store/common.proto\"\xeb\b\n" +
 	"\x0eReleasePayload\x129\n" +
 	"\x05files\x18\x02 \x03(\v2#.bytebase.store.ReleasePayload.FileR\x05files\x12G\n" +
 	"\n" +
 	"vcs_source\x18\x03 \x01(\v2(.bytebase.store.ReleasePayload.VCSSourceR\tvcsSource\x124\n" +
-	"\x04type\x18\x04 \x01(\x0e2 .bytebase.store.SchemaChangeTypeR\x04type\x1aW\n" +
+	"\x04type\x18\x04 \x01(\x0e2 .bytebase.store.SchemaChangeTypeR\x04type\x12P\n" +
+	"\rimport_source\x18\x05 \x01(\v2+.bytebase.store.ReleasePayload.ImportSourceR\fimportSource\x1aW\n" +
 	"\x04File\x12\x12\n" +
 	"\x04path\x18\x02 \x01(\tR\x04path\x12!\n" +
 	"\fsheet_sha256\x18\x04 \x01(\tR\vsheetSha256\x12\x18\n" +
-	"\aversion\x18\x06 \x01(\tR\aversion\x1aQ\n" +
+	"\aversion\x18\x06 \x01(\tR\aversion\x1a\xb7\x01\n" +
+	"\fImportSource\x12\x18\n" +
+	"\arelease\x18\x01 \x01(\tR\arelease\x12;\n" +
+	"\vexport_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"exportTime\x12P\n" +
+	"\rcheck_results\x18\x03 \x01(\v2+.bytebase.store.ReleasePayload.CheckResultsR\fcheckResults\x1a\x83\x03\n" +
+	"\fCheckResults\x12L\n" +
+	"\aresults\x18\x01 \x03(\v22.bytebase.store.ReleasePayload.CheckResults.ResultR\aresults\x12#\n" +
+	"\raffected_rows\x18\x02 \x01(\x03R\faffectedRows\x128\n" +
+	"\n" +
+	"risk_level\x18\x03 \x01(\x0e2\x19.bytebase.store.RiskLevelR\triskLevel\x1a\xc5\x01\n" +
+	"\x06Result\x12\x12\n" +
+	"\x04file\x18\x01 \x01(\tR\x04file\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\tR\x06target\x120\n" +
+	"\aadvices\x18\x03 \x03(\v2\x16.bytebase.store.AdviceR\aadvices\x12#\n" +
+	"\raffected_rows\x18\x04 \x01(\x03R\faffectedRows\x128\n" +
+	"\n" +
+	"risk_level\x18\x05 \x01(\x0e2\x19.bytebase.store.RiskLevelR\triskLevel\x1a\xb3\x01\n" +
 	"\tVCSSource\x122\n" +
 	"\bvcs_type\x18\x01 \x01(\x0e2\x17.bytebase.store.VCSTypeR\avcsType\x12\x10\n" +
-	"\x03url\x18\x02 \x01(\tR\x03urlB\x8f\x01\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x16\n" +
+	"\x06commit\x18\x03 \x01(\tR\x06commit\x12(\n" +
+	"\x10pull_request_url\x18\x04 \x01(\tR\x0epullRequestUrl\x12\x1e\n" +
+	"\n" +
+	"repository\x18\x05 \x01(\tR\n" +
+	"repositoryB\x8f\x01\n" +
 	"\x12com.bytebase.storeB\fReleaseProtoP\x01Z\x12generated-go/store\xa2\x02\x03BSX\xaa\x02\x0eBytebase.Store\xca\x02\x0eBytebase\\Store\xe2\x02\x1aBytebase\\Store\\GPBMetadata\xea\x02\x0fBytebase::Storeb\x06proto3"
 
 var (
@@ -226,24 +484,37 @@ func file_store_release_proto_rawDescGZIP() []byte {
 	return file_store_release_proto_rawDescData
 }
 
-var file_store_release_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_store_release_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_store_release_proto_goTypes = []any{
-	(*ReleasePayload)(nil),           // 0: bytebase.store.ReleasePayload
-	(*ReleasePayload_File)(nil),      // 1: bytebase.store.ReleasePayload.File
-	(*ReleasePayload_VCSSource)(nil), // 2: bytebase.store.ReleasePayload.VCSSource
-	(SchemaChangeType)(0),            // 3: bytebase.store.SchemaChangeType
-	(VCSType)(0),                     // 4: bytebase.store.VCSType
+	(*ReleasePayload)(nil),                     // 0: bytebase.store.ReleasePayload
+	(*ReleasePayload_File)(nil),                // 1: bytebase.store.ReleasePayload.File
+	(*ReleasePayload_ImportSource)(nil),        // 2: bytebase.store.ReleasePayload.ImportSource
+	(*ReleasePayload_CheckResults)(nil),        // 3: bytebase.store.ReleasePayload.CheckResults
+	(*ReleasePayload_VCSSource)(nil),           // 4: bytebase.store.ReleasePayload.VCSSource
+	(*ReleasePayload_CheckResults_Result)(nil), // 5: bytebase.store.ReleasePayload.CheckResults.Result
+	(SchemaChangeType)(0),                      // 6: bytebase.store.SchemaChangeType
+	(*timestamppb.Timestamp)(nil),              // 7: google.protobuf.Timestamp
+	(RiskLevel)(0),                             // 8: bytebase.store.RiskLevel
+	(VCSType)(0),                               // 9: bytebase.store.VCSType
+	(*Advice)(nil),                             // 10: bytebase.store.Advice
 }
 var file_store_release_proto_depIdxs = []int32{
-	1, // 0: bytebase.store.ReleasePayload.files:type_name -> bytebase.store.ReleasePayload.File
-	2, // 1: bytebase.store.ReleasePayload.vcs_source:type_name -> bytebase.store.ReleasePayload.VCSSource
-	3, // 2: bytebase.store.ReleasePayload.type:type_name -> bytebase.store.SchemaChangeType
-	4, // 3: bytebase.store.ReleasePayload.VCSSource.vcs_type:type_name -> bytebase.store.VCSType
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	1,  // 0: bytebase.store.ReleasePayload.files:type_name -> bytebase.store.ReleasePayload.File
+	4,  // 1: bytebase.store.ReleasePayload.vcs_source:type_name -> bytebase.store.ReleasePayload.VCSSource
+	6,  // 2: bytebase.store.ReleasePayload.type:type_name -> bytebase.store.SchemaChangeType
+	2,  // 3: bytebase.store.ReleasePayload.import_source:type_name -> bytebase.store.ReleasePayload.ImportSource
+	7,  // 4: bytebase.store.ReleasePayload.ImportSource.export_time:type_name -> google.protobuf.Timestamp
+	3,  // 5: bytebase.store.ReleasePayload.ImportSource.check_results:type_name -> bytebase.store.ReleasePayload.CheckResults
+	5,  // 6: bytebase.store.ReleasePayload.CheckResults.results:type_name -> bytebase.store.ReleasePayload.CheckResults.Result
+	8,  // 7: bytebase.store.ReleasePayload.CheckResults.risk_level:type_name -> bytebase.store.RiskLevel
+	9,  // 8: bytebase.store.ReleasePayload.VCSSource.vcs_type:type_name -> bytebase.store.VCSType
+	10, // 9: bytebase.store.ReleasePayload.CheckResults.Result.advices:type_name -> bytebase.store.Advice
+	8,  // 10: bytebase.store.ReleasePayload.CheckResults.Result.risk_level:type_name -> bytebase.store.RiskLevel
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_store_release_proto_init() }
@@ -251,6 +522,7 @@ func file_store_release_proto_init() {
 	if File_store_release_proto != nil {
 		return
 	}
+	file_store_advice_proto_init()
 	file_store_common_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -258,7 +530,7 @@ func file_store_release_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_release_proto_rawDesc), len(file_store_release_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -22,6 +22,79 @@ func (x *ReleasePayload_File) Equal(y *ReleasePayload_File) bool {
 	return true
 }
 
+func (x *ReleasePayload_ImportSource) Equal(y *ReleasePayload_ImportSource) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Release != y.Release {
+		return false
+	}
+	if p, q := x.ExportTime, y.ExportTime; (p == nil && q != nil) || (p != nil && (q == nil || p.Seconds != q.Seconds || p.Nanos != q.Nanos)) {
+		return false
+	}
+	if !x.CheckResults.Equal(y.CheckResults) {
+		return false
+	}
+	return true
+}
+
+func (x *ReleasePayload_CheckResults_Result) Equal(y *ReleasePayload_CheckResults_Result) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.File != y.File {
+		return false
+	}
+	if x.Target != y.Target {
+		return false
+	}
+	if len(x.Advices) != len(y.Advices) {
+		return false
+	}
+	for i := 0; i < len(x.Advices); i++ {
+		if !x.Advices[i].Equal(y.Advices[i]) {
+			return false
+		}
+	}
+	if x.AffectedRows != y.AffectedRows {
+		return false
+	}
+	if x.RiskLevel != y.RiskLevel {
+		return false
+	}
+	return true
+}
+
+func (x *ReleasePayload_CheckResults) Equal(y *ReleasePayload_CheckResults) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if len(x.Results) != len(y.Results) {
+		return false
+	}
+	for i := 0; i < len(x.Results); i++ {
+		if !x.Results[i].Equal(y.Results[i]) {
+			return false
+		}
+	}
+	if x.AffectedRows != y.AffectedRows {
+		return false
+	}
+	if x.RiskLevel != y.RiskLevel {
+		return false
+	}
+	return true
+}
+
 func (x *ReleasePayload_VCSSource) Equal(y *ReleasePayload_VCSSource) bool {
 	if x == y {
 		return true
@@ -35,6 +108,15 @@ func (x *ReleasePayload_VCSSource) Equal(y *ReleasePayload_VCSSource) bool {
 	if x.Url != y.Url {
 		return false
 	}
+	if x.Commit != y.Commit {
+		return false
+	}
+	if x.PullRequestUrl != y.PullRequestUrl {
+		return false
+	}
+	if x.Repository != y.Repository {
+		return false
+	}
 	return true
 }
 
@@ -59,5 +141,8 @@ func (x *ReleasePayload) Equal(y *ReleasePayload) bool {
 	if x.Type != y.Type {
 		return false
 	}
+	if !x.ImportSource.Equal(y.ImportSource) {
+		return false
+	}
 	return true
 }
@@ -257,6 +257,57 @@ func (WorkspaceApprovalSetting_Rule_Source) EnumDescriptor() ([]byte, []int) {
 	return file_store_setting_proto_rawDescGZIP(), []int{2, 0, 0}
 }
 
+type DataClassificationSetting_DataClassificationConfig_Detector_Checksum int32
+
+const (
+	DataClassificationSetting_DataClassificationConfig_Detector_CHECKSUM_UNSPECIFIED DataClassificationSetting_DataClassificationConfig_Detector_Checksum = 0
+	// Luhn checksum used by payment card numbers.
+	DataClassificationSetting_DataClassificationConfig_Detector_LUHN DataClassificationSetting_DataClassificationConfig_Detector_Checksum = 1
+	// ISO 13616 IBAN checksum.
+	DataClassificationSetting_DataClassificationConfig_Detector_IBAN DataClassificationSetting_DataClassificationConfig_Detector_Checksum = 2
+)
+
+// Enum value maps for DataClassificationSetting_DataClassificationConfig_Detector_Checksum.
+var (
+	DataClassificationSetting_DataClassificationConfig_Detector_Checksum_name = map[int32]string{
+		0: "CHECKSUM_UNSPECIFIED",
+		1: "LUHN",
+		2: "IBAN",
+	}
+	DataClassificationSetting_DataClassificationConfig_Detector_Checksum_value = map[string]int32{
+		"CHECKSUM_UNSPECIFIED": 0,
+		"LUHN":                 1,
+		"IBAN":                 2,
+	}
+)
+
+func (x DataClassificationSetting_DataClassificationConfig_Detector_Checksum) Enum() *DataClassificationSetting_DataClassificationConfig_Detector_Checksum {
+	p := new(DataClassificationSetting_DataClassificationConfig_Detector_Checksum)
+	*p = x
+	return p
+}
+
+func (x DataClassificationSetting_DataClassificationConfig_Detector_Checksum) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DataClassificationSetting_DataClassificationConfig_Detector_Checksum) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_setting_proto_enumTypes[4].Descriptor()
+}
+
+func (DataClassificationSetting_DataClassificationConfig_Detector_Checksum) Type() protoreflect.EnumType {
+	return &file_store_setting_proto_enumTypes[4]
+}
+
+func (x DataClassificationSetting_DataClassificationConfig_Detector_Checksum) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DataClassificationSetting_DataClassificationConfig_Detector_Checksum.Descriptor instead.
+func (DataClassificationSetting_DataClassificationConfig_Detector_Checksum) EnumDescriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{3, 0, 3, 0}
+}
+
 type Algorithm_InnerOuterMask_MaskType int32
 
 const (
@@ -290,11 +341,11 @@ func (x Algorithm_InnerOuterMask_MaskType) String() string {
 }
 
 func (Algorithm_InnerOuterMask_MaskType) Descriptor() protoreflect.EnumDescriptor {
-	return file_store_setting_proto_enumTypes[4].Descriptor()
+	return file_store_setting_proto_enumTypes[5].Descriptor()
 }
 
 func (Algorithm_InnerOuterMask_MaskType) Type() protoreflect.EnumType {
-	return &file_store_setting_proto_enumTypes[4]
+	return &file_store_setting_proto_enumTypes[5]
 }
 
 func (x Algorithm_InnerOuterMask_MaskType) Number() protoreflect.EnumNumber {
@@ -306,6 +357,58 @@ func (Algorithm_InnerOuterMask_MaskType) EnumDescriptor() ([]byte, []int) {
 	return file_store_setting_proto_rawDescGZIP(), []int{4, 3, 0}
 }
 
+type Algorithm_TokenizationMask_Format int32
+
+const (
+	// Preserve the character classes of the whole value.
+	Algorithm_TokenizationMask_FORMAT_UNSPECIFIED Algorithm_TokenizationMask_Format = 0
+	// Tokenize the local part of the email and keep the domain.
+	Algorithm_TokenizationMask_EMAIL Algorithm_TokenizationMask_Format = 1
+	// Tokenize the digits and recompute the Luhn check digit.
+	Algorithm_TokenizationMask_PAYMENT_CARD Algorithm_TokenizationMask_Format = 2
+)
+
+// Enum value maps for Algorithm_TokenizationMask_Format.
+var (
+	Algorithm_TokenizationMask_Format_name = map[int32]string{
+		0: "FORMAT_UNSPECIFIED",
+		1: "EMAIL",
+		2: "PAYMENT_CARD",
+	}
+	Algorithm_TokenizationMask_Format_value = map[string]int32{
+		"FORMAT_UNSPECIFIED": 0,
+		"EMAIL":              1,
+		"PAYMENT_CARD":       2,
+	}
+)
+
+func (x Algorithm_TokenizationMask_Format) Enum() *Algorithm_TokenizationMask_Format {
+	p := new(Algorithm_TokenizationMask_Format)
+	*p = x
+	return p
+}
+
+func (x Algorithm_TokenizationMask_Format) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Algorithm_TokenizationMask_Format) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_setting_proto_enumTypes[6].Descriptor()
+}
+
+func (Algorithm_TokenizationMask_Format) Type() protoreflect.EnumType {
+	return &file_store_setting_proto_enumTypes[6]
+}
+
+func (x Algorithm_TokenizationMask_Format) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Algorithm_TokenizationMask_Format.Descriptor instead.
+func (Algorithm_TokenizationMask_Format) EnumDescriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{4, 4, 0}
+}
+
 type AISetting_Provider int32
 
 const (
@@ -345,11 +448,11 @@ func (x AISetting_Provider) String() string {
 }
 
 func (AISetting_Provider) Descriptor() protoreflect.EnumDescriptor {
-	return file_store_setting_proto_enumTypes[5].Descriptor()
+	return file_store_setting_proto_enumTypes[7].Descriptor()
 }
 
 func (AISetting_Provider) Type() protoreflect.EnumType {
-	return &file_store_setting_proto_enumTypes[5]
+	return &file_store_setting_proto_enumTypes[7]
 }
 
 func (x AISetting_Provider) Number() protoreflect.EnumNumber {
@@ -391,11 +494,11 @@ func (x EmailSetting_Type) String() string {
 }
 
 func (EmailSetting_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_store_setting_proto_enumTypes[6].Descriptor()
+	return file_store_setting_proto_enumTypes[8].Descriptor()
 }
 
 func (EmailSetting_Type) Type() protoreflect.EnumType {
-	return &file_store_setting_proto_enumTypes[6]
+	return &file_store_setting_proto_enumTypes[8]
 }
 
 func (x EmailSetting_Type) Number() protoreflect.EnumNumber {
@@ -443,11 +546,11 @@ func (x EmailSetting_SMTPConfig_Encryption) String() string {
 }
 
 func (EmailSetting_SMTPConfig_Encryption) Descriptor() protoreflect.EnumDescriptor {
-	return file_store_setting_proto_enumTypes[7].Descriptor()
+	return file_store_setting_proto_enumTypes[9].Descriptor()
 }
 
 func (EmailSetting_SMTPConfig_Encryption) Type() protoreflect.EnumType {
-	return &file_store_setting_proto_enumTypes[7]
+	return &file_store_setting_proto_enumTypes[9]
 }
 
 func (x EmailSetting_SMTPConfig_Encryption) Number() protoreflect.EnumNumber {
@@ -498,11 +601,11 @@ func (x EmailSetting_SMTPConfig_Authentication) String() string {
 }
 
 func (EmailSetting_SMTPConfig_Authentication) Descriptor() protoreflect.EnumDescriptor {
-	return file_store_setting_proto_enumTypes[8].Descriptor()
+	return file_store_setting_proto_enumTypes[10].Descriptor()
 }
 
 func (EmailSetting_SMTPConfig_Authentication) Type() protoreflect.EnumType {
-	return &file_store_setting_proto_enumTypes[8]
+	return &file_store_setting_proto_enumTypes[10]
 }
 
 func (x EmailSetting_SMTPConfig_Authentication) Number() protoreflect.EnumNumber {
@@ -607,8 +710,11 @@ type WorkspaceProfileSetting struct {
 	// Allow signin/signup using email + a 6-digit one-time verification code.
 	// Requires the EMAIL setting to be configured on the workspace.
 	AllowEmailCodeSignin bool `protobuf:"varint,22,opt,name=allow_email_code_signin,json=allowEmailCodeSignin,proto3" json:"allow_email_code_signin,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+	// The base64-encoded Ed25519 public keys trusted to verify the imported release bundles.
+	// The public key of a workspace is included in the bundles it exports.
+	TrustedReleasePublicKeys []string `protobuf:"bytes,23,rep,name=trusted_release_public_keys,json=trustedReleasePublicKeys,proto3" json:"trusted_release_public_keys,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
 }
 
 func (x *WorkspaceProfileSetting) Reset() {
@@ -788,6 +894,13 @@ func (x *WorkspaceProfileSetting) GetAllowEmailCodeSignin() bool {
 	return false
 }
 
+func (x *WorkspaceProfileSetting) GetTrustedReleasePublicKeys() []string {
+	if x != nil {
+		return x.TrustedReleasePublicKeys
+	}
+	return nil
+}
+
 type WorkspaceApprovalSetting struct {
 	state         protoimpl.MessageState           `protogen:"open.v1"`
 	Rules         []*WorkspaceApprovalSetting_Rule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
@@ -884,6 +997,9 @@ type Algorithm struct {
 	//	*Algorithm_RangeMask_
 	//	*Algorithm_Md5Mask
 	//	*Algorithm_InnerOuterMask_
+	//	*Algorithm_TokenizationMask_
+	//	*Algorithm_DateShiftMask_
+	//	*Algorithm_NumericNoiseMask_
 	Mask          isAlgorithm_Mask `protobuf_oneof:"mask"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -962,6 +1078,33 @@ func (x *Algorithm) GetInnerOuterMask() *Algorithm_InnerOuterMask {
 	return nil
 }
 
+func (x *Algorithm) GetTokenizationMask() *Algorithm_TokenizationMask {
+	if x != nil {
+		if x, ok := x.Mask.(*Algorithm_TokenizationMask_); ok {
+			return x.TokenizationMask
+		}
+	}
+	return nil
+}
+
+func (x *Algorithm) GetDateShiftMask() *Algorithm_DateShiftMask {
+	if x != nil {
+		if x, ok := x.Mask.(*Algorithm_DateShiftMask_); ok {
+			return x.DateShiftMask
+		}
+	}
+	return nil
+}
+
+func (x *Algorithm) GetNumericNoiseMask() *Algorithm_NumericNoiseMask {
+	if x != nil {
+		if x, ok := x.Mask.(*Algorithm_NumericNoiseMask_); ok {
+			return x.NumericNoiseMask
+		}
+	}
+	return nil
+}
+
 type isAlgorithm_Mask interface {
 	isAlgorithm_Mask()
 }
@@ -982,6 +1125,18 @@ type Algorithm_InnerOuterMask_ struct {
 	InnerOuterMask *Algorithm_InnerOuterMask `protobuf:"bytes,4,opt,name=inner_outer_mask,json=innerOuterMask,proto3,oneof"`
 }
 
+type Algorithm_TokenizationMask_ struct {
+	TokenizationMask *Algorithm_TokenizationMask `protobuf:"bytes,5,opt,name=tokenization_mask,json=tokenizationMask,proto3,oneof"`
+}
+
+type Algorithm_DateShiftMask_ struct {
+	DateShiftMask *Algorithm_DateShiftMask `protobuf:"bytes,6,opt,name=date_shift_mask,json=dateShiftMask,proto3,oneof"`
+}
+
+type Algorithm_NumericNoiseMask_ struct {
+	NumericNoiseMask *Algorithm_NumericNoiseMask `protobuf:"bytes,7,opt,name=numeric_noise_mask,json=numericNoiseMask,proto3,oneof"`
+}
+
 func (*Algorithm_FullMask_) isAlgorithm_Mask() {}
 
 func (*Algorithm_RangeMask_) isAlgorithm_Mask() {}
@@ -990,6 +1145,12 @@ func (*Algorithm_Md5Mask) isAlgorithm_Mask() {}
 
 func (*Algorithm_InnerOuterMask_) isAlgorithm_Mask() {}
 
+func (*Algorithm_TokenizationMask_) isAlgorithm_Mask() {}
+
+func (*Algorithm_DateShiftMask_) isAlgorithm_Mask() {}
+
+func (*Algorithm_NumericNoiseMask_) isAlgorithm_Mask() {}
+
 type SemanticTypeSetting struct {
 	state         protoimpl.MessageState              `protogen:"open.v1"`
 	Types         []*SemanticTypeSetting_SemanticType `protobuf:"bytes,1,rep,name=types,proto3" json:"types,omitempty"`
@@ -1529,8 +1690,10 @@ type DataClassificationSetting_DataClassificationConfig struct {
 	// classification is the id - DataClassification map.
 	// The id should in [0-9]+-[0-9]+-[0-9]+ format.
 	Classification map[string]*DataClassificationSetting_DataClassificationConfig_DataClassification `protobuf:"bytes,4,rep,name=classification,proto3" json:"classification,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// detectors are used by sensitive data discovery to propose column classifications.
+	Detectors     []*DataClassificationSetting_DataClassificationConfig_Detector `protobuf:"bytes,5,rep,name=detectors,proto3" json:"detectors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DataClassificationSetting_DataClassificationConfig) Reset() {
@@ -1591,6 +1754,13 @@ func (x *DataClassificationSetting_DataClassificationConfig) GetClassification()
 	return nil
 }
 
+func (x *DataClassificationSetting_DataClassificationConfig) GetDetectors() []*DataClassificationSetting_DataClassificationConfig_Detector {
+	if x != nil {
+		return x.Detectors
+	}
+	return nil
+}
+
 type DataClassificationSetting_DataClassificationConfig_Level struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	Title string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
@@ -1706,6 +1876,135 @@ func (x *DataClassificationSetting_DataClassificationConfig_DataClassification)
 	return 0
 }
 
+// Detector proposes a classification and semantic type for columns it matches.
+// A column matches if its name or comment matches the patterns, or if enough of
+// its sampled values match the value rules.
+type DataClassificationSetting_DataClassificationConfig_Detector struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique id of the detector in the config.
+	Id    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// column_name_pattern is a RE2 regex matched against the column name.
+	ColumnNamePattern string `protobuf:"bytes,3,opt,name=column_name_pattern,json=columnNamePattern,proto3" json:"column_name_pattern,omitempty"`
+	// comment_pattern is a RE2 regex matched against the column comment.
+	CommentPattern string `protobuf:"bytes,4,opt,name=comment_pattern,json=commentPattern,proto3" json:"comment_pattern,omitempty"`
+	// value_pattern is a RE2 regex matched against the whole sampled value.
+	ValuePattern string `protobuf:"bytes,5,opt,name=value_pattern,json=valuePattern,proto3" json:"value_pattern,omitempty"`
+	// checksum is validated against the sampled values.
+	Checksum DataClassificationSetting_DataClassificationConfig_Detector_Checksum `protobuf:"varint,6,opt,name=checksum,proto3,enum=bytebase.store.DataClassificationSetting_DataClassificationConfig_Detector_Checksum" json:"checksum,omitempty"`
+	// dictionary is the list of words matched case-insensitively against the sampled values.
+	Dictionary []string `protobuf:"bytes,7,rep,name=dictionary,proto3" json:"dictionary,omitempty"`
+	// min_match_ratio is the ratio of non-empty sampled values that must match the value rules.
+	// Defaults to 0.8.
+	MinMatchRatio float64 `protobuf:"fixed64,8,opt,name=min_match_ratio,json=minMatchRatio,proto3" json:"min_match_ratio,omitempty"`
+	// classification_id is the id in the classification map proposed for matched columns.
+	ClassificationId string `protobuf:"bytes,9,opt,name=classification_id,json=classificationId,proto3" json:"classification_id,omitempty"`
+	// semantic_type_id is the id of the semantic type proposed for matched columns.
+	SemanticTypeId string `protobuf:"bytes,10,opt,name=semantic_type_id,json=semanticTypeId,proto3" json:"semantic_type_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DataClassificationSetting_DataClassificationConfig_Detector) Reset() {
+	*x = DataClassificationSetting_DataClassificationConfig_Detector{}
+	mi := &file_store_setting_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DataClassificationSetting_DataClassificationConfig_Detector) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataClassificationSetting_DataClassificationConfig_Detector) ProtoMessage() {}
+
+func (x *DataClassificationSetting_DataClassificationConfig_Detector) ProtoReflect() protoreflect.Message {
+	mi := &file_store_setting_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataClassificationSetting_DataClassificationConfig_Detector.ProtoReflect.Descriptor instead.
+func (*DataClassificationSetting_DataClassificationConfig_Detector) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{3, 0, 3}
+}
+
+func (x *DataClassificationSetting_DataClassificationConfig_Detector) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DataClassificationSetting_DataClassificationConfig_Detector) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *DataClassificationSetting_DataClassificationConfig_Detector) GetColumnNamePattern() string {
+	if x != nil {
+		return x.ColumnNamePattern
+	}
+	return ""
+}
+
+func (x *DataClassificationSetting_DataClassificationConfig_Detector) GetCommentPattern() string {
+	if x != nil {
+		return x.CommentPattern
+	}
+	return ""
+}
+
+func (x *DataClassificationSetting_DataClassificationConfig_Detector) GetValuePattern() string {
+	if x != nil {
+		return x.ValuePattern
+	}
+	return ""
+}
+
+func (x *DataClassificationSetting_DataClassificationConfig_Detector) GetChecksum() DataClassificationSetting_DataClassificationConfig_Detector_Checksum {
+	if x != nil {
+		return x.Checksum
+	}
+	return DataClassificationSetting_DataClassificationConfig_Detector_CHECKSUM_UNSPECIFIED
+}
+
+func (x *DataClassificationSetting_DataClassificationConfig_Detector) GetDictionary() []string {
+	if x != nil {
+		return x.Dictionary
+	}
+	return nil
+}
+
+func (x *DataClassificationSetting_DataClassificationConfig_Detector) GetMinMatchRatio() float64 {
+	if x != nil {
+		return x.MinMatchRatio
+	}
+	return 0
+}
+
+func (x *DataClassificationSetting_DataClassificationConfig_Detector) GetClassificationId() string {
+	if x != nil {
+		return x.ClassificationId
+	}
+	return ""
+}
+
+func (x *DataClassificationSetting_DataClassificationConfig_Detector) GetSemanticTypeId() string {
+	if x != nil {
+		return x.SemanticTypeId
+	}
+	return ""
+}
+
 type Algorithm_FullMask struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// substitution is the string used to replace the original value, the
@@ -1717,7 +2016,7 @@ type Algorithm_FullMask struct {
 
 func (x *Algorithm_FullMask) Reset() {
 	*x = Algorithm_FullMask{}
-	mi := &file_store_setting_proto_msgTypes[17]
+	mi := &file_store_setting_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1729,7 +2028,7 @@ func (x *Algorithm_FullMask) String() string {
 func (*Algorithm_FullMask) ProtoMessage() {}
 
 func (x *Algorithm_FullMask) ProtoReflect() protoreflect.Message {
-	mi := &file_store_setting_proto_msgTypes[17]
+	mi := &file_store_setting_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1763,7 +2062,7 @@ type Algorithm_RangeMask struct {
 
 func (x *Algorithm_RangeMask) Reset() {
 	*x = Algorithm_RangeMask{}
-	mi := &file_store_setting_proto_msgTypes[18]
+	mi := &file_store_setting_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1775,7 +2074,7 @@ func (x *Algorithm_RangeMask) String() string {
 func (*Algorithm_RangeMask) ProtoMessage() {}
 
 func (x *Algorithm_RangeMask) ProtoReflect() protoreflect.Message {
-	mi := &file_store_setting_proto_msgTypes[18]
+	mi := &file_store_setting_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1808,7 +2107,7 @@ type Algorithm_MD5Mask struct {
 
 func (x *Algorithm_MD5Mask) Reset() {
 	*x = Algorithm_MD5Mask{}
-	mi := &file_store_setting_proto_msgTypes[19]
+	mi := &file_store_setting_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1820,7 +2119,7 @@ func (x *Algorithm_MD5Mask) String() string {
 func (*Algorithm_MD5Mask) ProtoMessage() {}
 
 func (x *Algorithm_MD5Mask) ProtoReflect() protoreflect.Message {
-	mi := &file_store_setting_proto_msgTypes[19]
+	mi := &file_store_setting_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1855,7 +2154,7 @@ type Algorithm_InnerOuterMask struct {
 
 func (x *Algorithm_InnerOuterMask) Reset() {
 	*x = Algorithm_InnerOuterMask{}
-	mi := &file_store_setting_proto_msgTypes[20]
+	mi := &file_store_setting_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1867,7 +2166,7 @@ func (x *Algorithm_InnerOuterMask) String() string {
 func (*Algorithm_InnerOuterMask) ProtoMessage() {}
 
 func (x *Algorithm_InnerOuterMask) ProtoReflect() protoreflect.Message {
-	mi := &file_store_setting_proto_msgTypes[20]
+	mi := &file_store_setting_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1911,6 +2210,210 @@ func (x *Algorithm_InnerOuterMask) GetSubstitution() string {
 	return ""
 }
 
+// TokenizationMask replaces the value with a deterministic token of the same shape.
+// Digits are replaced with digits and letters with letters of the same case, so the
+// same value always gets the same token with the same key.
+type Algorithm_TokenizationMask struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// key is the tokenization key. It is ignored if external_key is set.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// external_key reads the key from an external secret manager.
+	ExternalKey   *DataSourceExternalSecret         `protobuf:"bytes,2,opt,name=external_key,json=externalKey,proto3" json:"external_key,omitempty"`
+	Format        Algorithm_TokenizationMask_Format `protobuf:"varint,3,opt,name=format,proto3,enum=bytebase.store.Algorithm_TokenizationMask_Format" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Algorithm_TokenizationMask) Reset() {
+	*x = Algorithm_TokenizationMask{}
+	mi := &file_store_setting_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Algorithm_TokenizationMask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Algorithm_TokenizationMask) ProtoMessage() {}
+
+func (x *Algorithm_TokenizationMask) ProtoReflect() protoreflect.Message {
+	mi := &file_store_setting_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Algorithm_TokenizationMask.ProtoReflect.Descriptor instead.
+func (*Algorithm_TokenizationMask) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{4, 4}
+}
+
+func (x *Algorithm_TokenizationMask) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Algorithm_TokenizationMask) GetExternalKey() *DataSourceExternalSecret {
+	if x != nil {
+		return x.ExternalKey
+	}
+	return nil
+}
+
+func (x *Algorithm_TokenizationMask) GetFormat() Algorithm_TokenizationMask_Format {
+	if x != nil {
+		return x.Format
+	}
+	return Algorithm_TokenizationMask_FORMAT_UNSPECIFIED
+}
+
+// DateShiftMask shifts dates and timestamps by a deterministic number of days.
+type Algorithm_DateShiftMask struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// key is the shift key. It is ignored if external_key is set.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// external_key reads the key from an external secret manager.
+	ExternalKey *DataSourceExternalSecret `protobuf:"bytes,2,opt,name=external_key,json=externalKey,proto3" json:"external_key,omitempty"`
+	// max_shift_days is the maximum number of days shifted in either direction.
+	MaxShiftDays int32 `protobuf:"varint,3,opt,name=max_shift_days,json=maxShiftDays,proto3" json:"max_shift_days,omitempty"`
+	// subject_column is the result column identifying the subject, such as a patient id.
+	// All dates of the same subject are shifted by the same number of days.
+	// If empty or not in the result, all dates are shifted by the same number of days.
+	SubjectColumn string `protobuf:"bytes,4,opt,name=subject_column,json=subjectColumn,proto3" json:"subject_column,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Algorithm_DateShiftMask) Reset() {
+	*x = Algorithm_DateShiftMask{}
+	mi := &file_store_setting_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Algorithm_DateShiftMask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Algorithm_DateShiftMask) ProtoMessage() {}
+
+func (x *Algorithm_DateShiftMask) ProtoReflect() protoreflect.Message {
+	mi := &file_store_setting_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Algorithm_DateShiftMask.ProtoReflect.Descriptor instead.
+func (*Algorithm_DateShiftMask) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{4, 5}
+}
+
+func (x *Algorithm_DateShiftMask) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Algorithm_DateShiftMask) GetExternalKey() *DataSourceExternalSecret {
+	if x != nil {
+		return x.ExternalKey
+	}
+	return nil
+}
+
+func (x *Algorithm_DateShiftMask) GetMaxShiftDays() int32 {
+	if x != nil {
+		return x.MaxShiftDays
+	}
+	return 0
+}
+
+func (x *Algorithm_DateShiftMask) GetSubjectColumn() string {
+	if x != nil {
+		return x.SubjectColumn
+	}
+	return ""
+}
+
+// NumericNoiseMask adds deterministic relative noise to numbers.
+type Algorithm_NumericNoiseMask struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// key is the noise key. It is ignored if external_key is set.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// external_key reads the key from an external secret manager.
+	ExternalKey *DataSourceExternalSecret `protobuf:"bytes,2,opt,name=external_key,json=externalKey,proto3" json:"external_key,omitempty"`
+	// noise_ratio is the maximum relative noise in (0, 1], for example 0.1 for ±10%.
+	NoiseRatio    float64 `protobuf:"fixed64,3,opt,name=noise_ratio,json=noiseRatio,proto3" json:"noise_ratio,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Algorithm_NumericNoiseMask) Reset() {
+	*x = Algorithm_NumericNoiseMask{}
+	mi := &file_store_setting_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Algorithm_NumericNoiseMask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Algorithm_NumericNoiseMask) ProtoMessage() {}
+
+func (x *Algorithm_NumericNoiseMask) ProtoReflect() protoreflect.Message {
+	mi := &file_store_setting_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Algorithm_NumericNoiseMask.ProtoReflect.Descriptor instead.
+func (*Algorithm_NumericNoiseMask) Descriptor() ([]byte, []int) {
+	return file_store_setting_proto_rawDescGZIP(), []int{4, 6}
+}
+
+func (x *Algorithm_NumericNoiseMask) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Algorithm_NumericNoiseMask) GetExternalKey() *DataSourceExternalSecret {
+	if x != nil {
+		return x.ExternalKey
+	}
+	return nil
+}
+
+func (x *Algorithm_NumericNoiseMask) GetNoiseRatio() float64 {
+	if x != nil {
+		return x.NoiseRatio
+	}
+	return 0
+}
+
 type Algorithm_RangeMask_Slice struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// start is the start character index (0-based) of the original value, should be less than end.
@@ -1928,7 +2431,7 @@ type Algorithm_RangeMask_Slice struct {
 
 func (x *Algorithm_RangeMask_Slice) Reset() {
 	*x = Algorithm_RangeMask_Slice{}
-	mi := &file_store_setting_proto_msgTypes[21]
+	mi := &file_store_setting_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1940,7 +2443,7 @@ func (x *Algorithm_RangeMask_Slice) String() string {
 func (*Algorithm_RangeMask_Slice) ProtoMessage() {}
 
 func (x *Algorithm_RangeMask_Slice) ProtoReflect() protoreflect.Message {
-	mi := &file_store_setting_proto_msgTypes[21]
+	mi := &file_store_setting_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1994,7 +2497,7 @@ type SemanticTypeSetting_SemanticType struct {
 
 func (x *SemanticTypeSetting_SemanticType) Reset() {
 	*x = SemanticTypeSetting_SemanticType{}
-	mi := &file_store_setting_proto_msgTypes[22]
+	mi := &file_store_setting_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2006,7 +2509,7 @@ func (x *SemanticTypeSetting_SemanticType) String() string {
 func (*SemanticTypeSetting_SemanticType) ProtoMessage() {}
 
 func (x *SemanticTypeSetting_SemanticType) ProtoReflect() protoreflect.Message {
-	mi := &file_store_setting_proto_msgTypes[22]
+	mi := &file_store_setting_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2066,7 +2569,7 @@ type AppIMSetting_Slack struct {
 
 func (x *AppIMSetting_Slack) Reset() {
 	*x = AppIMSetting_Slack{}
-	mi := &file_store_setting_proto_msgTypes[23]
+	mi := &file_store_setting_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2078,7 +2581,7 @@ func (x *AppIMSetting_Slack) String() string {
 func (*AppIMSetting_Slack) ProtoMessage() {}
 
 func (x *AppIMSetting_Slack) ProtoReflect() protoreflect.Message {
-	mi := &file_store_setting_proto_msgTypes[23]
+	mi := &file_store_setting_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2111,7 +2614,7 @@ type AppIMSetting_Feishu struct {
 
 func (x *AppIMSetting_Feishu) Reset() {
 	*x = AppIMSetting_Feishu{}
-	mi := &file_store_setting_proto_msgTypes[24]
+	mi := &file_store_setting_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2123,7 +2626,7 @@ func (x *AppIMSetting_Feishu) String() string {
 func (*AppIMSetting_Feishu) ProtoMessage() {}
 
 func (x *AppIMSetting_Feishu) ProtoReflect() protoreflect.Message {
-	mi := &file_store_setting_proto_msgTypes[24]
+	mi := &file_store_setting_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2164,7 +2667,7 @@ type AppIMSetting_Wecom struct {
 
 func (x *AppIMSetting_Wecom) Reset() {
 	*x = AppIMSetting_Wecom{}
-	mi := &file_store_setting_proto_msgTypes[25]
+	mi := &file_store_setting_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2176,7 +2679,7 @@ func (x *AppIMSetting_Wecom) String() string {
 func (*AppIMSetting_Wecom) ProtoMessage() {}
 
 func (x *AppIMSetting_Wecom) ProtoReflect() protoreflect.Message {
-	mi := &file_store_setting_proto_msgTypes[25]
+	mi := &file_store_setting_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2223,7 +2726,7 @@ type AppIMSetting_Lark struct {
 
 func (x *AppIMSetting_Lark) Reset() {
 	*x = AppIMSetting_Lark{}
-	mi := &file_store_setting_proto_msgTypes[26]
+	mi := &file_store_setting_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2235,7 +2738,7 @@ func (x *AppIMSetting_Lark) String() string {
 func (*AppIMSetting_Lark) ProtoMessage() {}
 
 func (x *AppIMSetting_Lark) ProtoReflect() protoreflect.Message {
-	mi := &file_store_setting_proto_msgTypes[26]
+	mi := &file_store_setting_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2276,7 +2779,7 @@ type AppIMSetting_DingTalk struct {
 
 func (x *AppIMSetting_DingTalk) Reset() {
 	*x = AppIMSetting_DingTalk{}
-	mi := &file_store_setting_proto_msgTypes[27]
+	mi := &file_store_setting_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2288,7 +2791,7 @@ func (x *AppIMSetting_DingTalk) String() string {
 func (*AppIMSetting_DingTalk) ProtoMessage() {}
 
 func (x *AppIMSetting_DingTalk) ProtoReflect() protoreflect.Message {
-	mi := &file_store_setting_proto_msgTypes[27]
+	mi := &file_store_setting_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2339,7 +2842,7 @@ type AppIMSetting_Teams struct {
 
 func (x *AppIMSetting_Teams) Reset() {
 	*x = AppIMSetting_Teams{}
-	mi := &file_store_setting_proto_msgTypes[28]
+	mi := &file_store_setting_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2351,7 +2854,7 @@ func (x *AppIMSetting_Teams) String() string {
 func (*AppIMSetting_Teams) ProtoMessage() {}
 
 func (x *AppIMSetting_Teams) ProtoReflect() protoreflect.Message {
-	mi := &file_store_setting_proto_msgTypes[28]
+	mi := &file_store_setting_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2406,7 +2909,7 @@ type AppIMSetting_IMSetting struct {
 
 func (x *AppIMSetting_IMSetting) Reset() {
 	*x = AppIMSetting_IMSetting{}
-	mi := &file_store_setting_proto_msgTypes[29]
+	mi := &file_store_setting_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2418,7 +2921,7 @@ func (x *AppIMSetting_IMSetting) String() string {
 func (*AppIMSetting_IMSetting) ProtoMessage() {}
 
 func (x *AppIMSetting_IMSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_store_setting_proto_msgTypes[29]
+	mi := &file_store_setting_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2559,7 +3062,7 @@ type EnvironmentSetting_Environment struct {
 
 func (x *EnvironmentSetting_Environment) Reset() {
 	*x = EnvironmentSetting_Environment{}
-	mi := &file_store_setting_proto_msgTypes[30]
+	mi := &file_store_setting_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2571,7 +3074,7 @@ func (x *EnvironmentSetting_Environment) String() string {
 func (*EnvironmentSetting_Environment) ProtoMessage() {}
 
 func (x *EnvironmentSetting_Environment) ProtoReflect() protoreflect.Message {
-	mi := &file_store_setting_proto_msgTypes[30]
+	mi := &file_store_setting_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2636,7 +3139,7 @@ type EmailSetting_SMTPConfig struct {
 
 func (x *EmailSetting_SMTPConfig) Reset() {
 	*x = EmailSetting_SMTPConfig{}
-	mi := &file_store_setting_proto_msgTypes[32]
+	mi := &file_store_setting_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2648,7 +3151,7 @@ func (x *EmailSetting_SMTPConfig) String() string {
 func (*EmailSetting_SMTPConfig) ProtoMessage() {}
 
 func (x *EmailSetting_SMTPConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_store_setting_proto_msgTypes[32]
+	mi := &file_store_setting_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2710,9 +3213,9 @@ var File_store_setting_proto protoreflect.FileDescriptor
 
 const file_store_setting_proto_rawDesc = "" +
 	"\n" +
-	"\x13store/setting.proto\x12\x0ebytebase.store\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x16google/type/expr.proto\x1a\x14store/approval.proto\x1a\x12store/common.proto\"P\n" +
+	"\x13store/setting.proto\x12\x0ebytebase.store\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x16google/type/expr.proto\x1a\x14store/approval.proto\x1a\x12store/common.proto\x1a\x14store/instance.proto\"P\n" +
 	"\rSystemSetting\x12\x18\n" +
-	"\alicense\x18\x03 \x01(\tR\alicenseJ\x04\b\x01\x10\x02J\x04\b\x02\x10\x03R\vauth_secretR\fworkspace_id\"\xe7\x0f\n" +
+	"\alicense\x18\x03 \x01(\tR\alicenseJ\x04\b\x01\x10\x02J\x04\b\x02\x10\x03R\vauth_secretR\fworkspace_id\"\xa6\x10\n" +
 	"\x17WorkspaceProfileSetting\x12!\n" +
 	"\fexternal_url\x18\x01 \x01(\tR\vexternalUrl\x12'\n" +
 	"\x0fdisallow_signup\x18\x02 \x01(\bR\x0edisallowSignup\x12\x1f\n" +
@@ -2736,7 +3239,8 @@ const file_store_setting_proto_rawDesc = "" +
 	"\fenable_debug\x18\x13 \x01(\bR\venableDebug\x12&\n" +
 	"\x0fsql_result_size\x18\x14 \x01(\x03R\rsqlResultSize\x12>\n" +
 	"\rquery_timeout\x18\x15 \x01(\v2\x19.google.protobuf.DurationR\fqueryTimeout\x125\n" +
-	"\x17allow_email_code_signin\x18\x16 \x01(\bR\x14allowEmailCodeSignin\x1a\xdd\x01\n" +
+	"\x17allow_email_code_signin\x18\x16 \x01(\bR\x14allowEmailCodeSignin\x12=\n" +
+	"\x1btrusted_release_public_keys\x18\x17 \x03(\tR\x18trustedReleasePublicKeys\x1a\xdd\x01\n" +
 	"\fAnnouncement\x12U\n" +
 	"\x05level\x18\x01 \x01(\x0e2?.bytebase.store.WorkspaceProfileSetting.Announcement.AlertLevelR\x05level\x12\x12\n" +
 	"\x04text\x18\x02 \x01(\tR\x04text\x12\x12\n" +
@@ -2773,14 +3277,16 @@ const file_store_setting_proto_rawDesc = "" +
 	"\x0fCREATE_DATABASE\x10\x02\x12\x0f\n" +
 	"\vEXPORT_DATA\x10\x03\x12\x10\n" +
 	"\fREQUEST_ROLE\x10\x04\x12\x12\n" +
-	"\x0eREQUEST_ACCESS\x10\x05\"\xcf\x05\n" +
+	"\x0eREQUEST_ACCESS\x10\x05\"\xb6\n" +
+	"\n" +
 	"\x19DataClassificationSetting\x12\\\n" +
-	"\aconfigs\x18\x01 \x03(\v2B.bytebase.store.DataClassificationSetting.DataClassificationConfigR\aconfigs\x1a\xd3\x04\n" +
+	"\aconfigs\x18\x01 \x03(\v2B.bytebase.store.DataClassificationSetting.DataClassificationConfigR\aconfigs\x1a\xba\t\n" +
 	"\x18DataClassificationConfig\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12`\n" +
 	"\x06levels\x18\x03 \x03(\v2H.bytebase.store.DataClassificationSetting.DataClassificationConfig.LevelR\x06levels\x12~\n" +
-	"\x0eclassification\x18\x04 \x03(\v2V.bytebase.store.DataClassificationSetting.DataClassificationConfig.ClassificationEntryR\x0eclassification\x1a3\n" +
+	"\x0eclassification\x18\x04 \x03(\v2V.bytebase.store.DataClassificationSetting.DataClassificationConfig.ClassificationEntryR\x0eclassification\x12i\n" +
+	"\tdetectors\x18\x05 \x03(\v2K.bytebase.store.DataClassificationSetting.DataClassificationConfig.DetectorR\tdetectors\x1a3\n" +
 	"\x05Level\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x14\n" +
 	"\x05level\x18\x04 \x01(\x05R\x05level\x1a_\n" +
@@ -2791,13 +3297,34 @@ const file_store_setting_proto_rawDesc = "" +
 	"\x06_level\x1a\x98\x01\n" +
 	"\x13ClassificationEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12k\n" +
-	"\x05value\x18\x02 \x01(\v2U.bytebase.store.DataClassificationSetting.DataClassificationConfig.DataClassificationR\x05value:\x028\x01\"\xa0\x06\n" +
+	"\x05value\x18\x02 \x01(\v2U.bytebase.store.DataClassificationSetting.DataClassificationConfig.DataClassificationR\x05value:\x028\x01\x1a\xf9\x03\n" +
+	"\bDetector\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12.\n" +
+	"\x13column_name_pattern\x18\x03 \x01(\tR\x11columnNamePattern\x12'\n" +
+	"\x0fcomment_pattern\x18\x04 \x01(\tR\x0ecommentPattern\x12#\n" +
+	"\rvalue_pattern\x18\x05 \x01(\tR\fvaluePattern\x12p\n" +
+	"\bchecksum\x18\x06 \x01(\x0e2T.bytebase.store.DataClassificationSetting.DataClassificationConfig.Detector.ChecksumR\bchecksum\x12\x1e\n" +
+	"\n" +
+	"dictionary\x18\a \x03(\tR\n" +
+	"dictionary\x12&\n" +
+	"\x0fmin_match_ratio\x18\b \x01(\x01R\rminMatchRatio\x12+\n" +
+	"\x11classification_id\x18\t \x01(\tR\x10classificationId\x12(\n" +
+	"\x10semantic_type_id\x18\n" +
+	" \x01(\tR\x0esemanticTypeId\"8\n" +
+	"\bChecksum\x12\x18\n" +
+	"\x14CHECKSUM_UNSPECIFIED\x10\x00\x12\b\n" +
+	"\x04LUHN\x10\x01\x12\b\n" +
+	"\x04IBAN\x10\x02\"\xfb\f\n" +
 	"\tAlgorithm\x12A\n" +
 	"\tfull_mask\x18\x01 \x01(\v2\".bytebase.store.Algorithm.FullMaskH\x00R\bfullMask\x12D\n" +
 	"\n" +
 	"range_mask\x18\x02 \x01(\v2#.bytebase.store.Algorithm.RangeMaskH\x00R\trangeMask\x12>\n" +
 	"\bmd5_mask\x18\x03 \x01(\v2!.bytebase.store.Algorithm.MD5MaskH\x00R\amd5Mask\x12T\n" +
-	"\x10inner_outer_mask\x18\x04 \x01(\v2(.bytebase.store.Algorithm.InnerOuterMaskH\x00R\x0einnerOuterMask\x1a.\n" +
+	"\x10inner_outer_mask\x18\x04 \x01(\v2(.bytebase.store.Algorithm.InnerOuterMaskH\x00R\x0einnerOuterMask\x12Y\n" +
+	"\x11tokenization_mask\x18\x05 \x01(\v2*.bytebase.store.Algorithm.TokenizationMaskH\x00R\x10tokenizationMask\x12Q\n" +
+	"\x0fdate_shift_mask\x18\x06 \x01(\v2'.bytebase.store.Algorithm.DateShiftMaskH\x00R\rdateShiftMask\x12Z\n" +
+	"\x12numeric_noise_mask\x18\a \x01(\v2*.bytebase.store.Algorithm.NumericNoiseMaskH\x00R\x10numericNoiseMask\x1a.\n" +
 	"\bFullMask\x12\"\n" +
 	"\fsubstitution\x18\x01 \x01(\tR\fsubstitution\x1a\xa3\x01\n" +
 	"\tRangeMask\x12A\n" +
@@ -2818,7 +3345,25 @@ const file_store_setting_proto_rawDesc = "" +
 	"\bMaskType\x12\x19\n" +
 	"\x15MASK_TYPE_UNSPECIFIED\x10\x00\x12\t\n" +
 	"\x05INNER\x10\x01\x12\t\n" +
-	"\x05OUTER\x10\x02B\x06\n" +
+	"\x05OUTER\x10\x02\x1a\xfb\x01\n" +
+	"\x10TokenizationMask\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12K\n" +
+	"\fexternal_key\x18\x02 \x01(\v2(.bytebase.store.DataSourceExternalSecretR\vexternalKey\x12I\n" +
+	"\x06format\x18\x03 \x01(\x0e21.bytebase.store.Algorithm.TokenizationMask.FormatR\x06format\"=\n" +
+	"\x06Format\x12\x16\n" +
+	"\x12FORMAT_UNSPECIFIED\x10\x00\x12\t\n" +
+	"\x05EMAIL\x10\x01\x12\x10\n" +
+	"\fPAYMENT_CARD\x10\x02\x1a\xbb\x01\n" +
+	"\rDateShiftMask\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12K\n" +
+	"\fexternal_key\x18\x02 \x01(\v2(.bytebase.store.DataSourceExternalSecretR\vexternalKey\x12$\n" +
+	"\x0emax_shift_days\x18\x03 \x01(\x05R\fmaxShiftDays\x12%\n" +
+	"\x0esubject_column\x18\x04 \x01(\tR\rsubjectColumn\x1a\x92\x01\n" +
+	"\x10NumericNoiseMask\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12K\n" +
+	"\fexternal_key\x18\x02 \x01(\v2(.bytebase.store.DataSourceExternalSecretR\vexternalKey\x12\x1f\n" +
+	"\vnoise_ratio\x18\x03 \x01(\x01R\n" +
+	"noiseRatioB\x06\n" +
 	"\x04mask\"\x83\x02\n" +
 	"\x13SemanticTypeSetting\x12F\n" +
 	"\x05types\x18\x01 \x03(\v20.bytebase.store.SemanticTypeSetting.SemanticTypeR\x05types\x1a\xa3\x01\n" +
@@ -2946,103 +3491,119 @@ func file_store_setting_proto_rawDescGZIP() []byte {
 	return file_store_setting_proto_rawDescData
 }
 
-var file_store_setting_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
-var file_store_setting_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
+var file_store_setting_proto_enumTypes = make([]protoimpl.EnumInfo, 11)
+var file_store_setting_proto_msgTypes = make([]protoimpl.MessageInfo, 37)
 var file_store_setting_proto_goTypes = []any{
 	(SettingName)(0), // 0: bytebase.store.SettingName
 	(WorkspaceProfileSetting_DatabaseChangeMode)(0),                               // 1: bytebase.store.WorkspaceProfileSetting.DatabaseChangeMode
 	(WorkspaceProfileSetting_Announcement_AlertLevel)(0),                          // 2: bytebase.store.WorkspaceProfileSetting.Announcement.AlertLevel
 	(WorkspaceApprovalSetting_Rule_Source)(0),                                     // 3: bytebase.store.WorkspaceApprovalSetting.Rule.Source
-	(Algorithm_InnerOuterMask_MaskType)(0),                                        // 4: bytebase.store.Algorithm.InnerOuterMask.MaskType
-	(AISetting_Provider)(0),                                                       // 5: bytebase.store.AISetting.Provider
-	(EmailSetting_Type)(0),                                                        // 6: bytebase.store.EmailSetting.Type
-	(EmailSetting_SMTPConfig_Encryption)(0),                                       // 7: bytebase.store.EmailSetting.SMTPConfig.Encryption
-	(EmailSetting_SMTPConfig_Authentication)(0),                                   // 8: bytebase.store.EmailSetting.SMTPConfig.Authentication
-	(*SystemSetting)(nil),                                                         // 9: bytebase.store.SystemSetting
-	(*WorkspaceProfileSetting)(nil),                                               // 10: bytebase.store.WorkspaceProfileSetting
-	(*WorkspaceApprovalSetting)(nil),                                              // 11: bytebase.store.WorkspaceApprovalSetting
-	(*DataClassificationSetting)(nil),                                             // 12: bytebase.store.DataClassificationSetting
-	(*Algorithm)(nil),                                                             // 13: bytebase.store.Algorithm
-	(*SemanticTypeSetting)(nil),                                                   // 14: bytebase.store.SemanticTypeSetting
-	(*AppIMSetting)(nil),                                                          // 15: bytebase.store.AppIMSetting
-	(*AISetting)(nil),                                                             // 16: bytebase.store.AISetting
-	(*EnvironmentSetting)(nil),                                                    // 17: bytebase.store.EnvironmentSetting
-	(*EmailSetting)(nil),                                                          // 18: bytebase.store.EmailSetting
-	(*WorkspaceProfileSetting_Announcement)(nil),                                  // 19: bytebase.store.WorkspaceProfileSetting.Announcement
-	(*WorkspaceProfileSetting_PasswordRestriction)(nil),                           // 20: bytebase.store.WorkspaceProfileSetting.PasswordRestriction
-	(*WorkspaceApprovalSetting_Rule)(nil),                                         // 21: bytebase.store.WorkspaceApprovalSetting.Rule
-	(*DataClassificationSetting_DataClassificationConfig)(nil),                    // 22: bytebase.store.DataClassificationSetting.DataClassificationConfig
-	(*DataClassificationSetting_DataClassificationConfig_Level)(nil),              // 23: bytebase.store.DataClassificationSetting.DataClassificationConfig.Level
-	(*DataClassificationSetting_DataClassificationConfig_DataClassification)(nil), // 24: bytebase.store.DataClassificationSetting.DataClassificationConfig.DataClassification
-	nil,                                      // 25: bytebase.store.DataClassificationSetting.DataClassificationConfig.ClassificationEntry
-	(*Algorithm_FullMask)(nil),               // 26: bytebase.store.Algorithm.FullMask
-	(*Algorithm_RangeMask)(nil),              // 27: bytebase.store.Algorithm.RangeMask
-	(*Algorithm_MD5Mask)(nil),                // 28: bytebase.store.Algorithm.MD5Mask
-	(*Algorithm_InnerOuterMask)(nil),         // 29: bytebase.store.Algorithm.InnerOuterMask
-	(*Algorithm_RangeMask_Slice)(nil),        // 30: bytebase.store.Algorithm.RangeMask.Slice
-	(*SemanticTypeSetting_SemanticType)(nil), // 31: bytebase.store.SemanticTypeSetting.SemanticType
-	(*AppIMSetting_Slack)(nil),               // 32: bytebase.store.AppIMSetting.Slack
-	(*AppIMSetting_Feishu)(nil),              // 33: bytebase.store.AppIMSetting.Feishu
-	(*AppIMSetting_Wecom)(nil),               // 34: bytebase.store.AppIMSetting.Wecom
-	(*AppIMSetting_Lark)(nil),                // 35: bytebase.store.AppIMSetting.Lark
-	(*AppIMSetting_DingTalk)(nil),            // 36: bytebase.store.AppIMSetting.DingTalk
-	(*AppIMSetting_Teams)(nil),               // 37: bytebase.store.AppIMSetting.Teams
-	(*AppIMSetting_IMSetting)(nil),           // 38: bytebase.store.AppIMSetting.IMSetting
-	(*EnvironmentSetting_Environment)(nil),   // 39: bytebase.store.EnvironmentSetting.Environment
-	nil,                                      // 40: bytebase.store.EnvironmentSetting.Environment.TagsEntry
-	(*EmailSetting_SMTPConfig)(nil),          // 41: bytebase.store.EmailSetting.SMTPConfig
-	(*durationpb.Duration)(nil),              // 42: google.protobuf.Duration
-	(*ApprovalTemplate)(nil),                 // 43: bytebase.store.ApprovalTemplate
-	(*expr.Expr)(nil),                        // 44: google.type.Expr
-	(WebhookType)(0),                         // 45: bytebase.store.WebhookType
+	(DataClassificationSetting_DataClassificationConfig_Detector_Checksum)(0),     // 4: bytebase.store.DataClassificationSetting.DataClassificationConfig.Detector.Checksum
+	(Algorithm_InnerOuterMask_MaskType)(0),                                        // 5: bytebase.store.Algorithm.InnerOuterMask.MaskType
+	(Algorithm_TokenizationMask_Format)(0),                                        // 6: bytebase.store.Algorithm.TokenizationMask.Format
+	(AISetting_Provider)(0),                                                       // 7: bytebase.store.AISetting.Provider
+	(EmailSetting_Type)(0),                                                        // 8: bytebase.store.EmailSetting.Type
+	(EmailSetting_SMTPConfig_Encryption)(0),                                       // 9: bytebase.store.EmailSetting.SMTPConfig.Encryption
+	(EmailSetting_SMTPConfig_Authentication)(0),                                   // 10: bytebase.store.EmailSetting.SMTPConfig.Authentication
+	(*SystemSetting)(nil),                                                         // 11: bytebase.store.SystemSetting
+	(*WorkspaceProfileSetting)(nil),                                               // 12: bytebase.store.WorkspaceProfileSetting
+	(*WorkspaceApprovalSetting)(nil),                                              // 13: bytebase.store.WorkspaceApprovalSetting
+	(*DataClassificationSetting)(nil),                                             // 14: bytebase.store.DataClassificationSetting
+	(*Algorithm)(nil),                                                             // 15: bytebase.store.Algorithm
+	(*SemanticTypeSetting)(nil),                                                   // 16: bytebase.store.SemanticTypeSetting
+	(*AppIMSetting)(nil),                                                          // 17: bytebase.store.AppIMSetting
+	(*AISetting)(nil),                                                             // 18: bytebase.store.AISetting
+	(*EnvironmentSetting)(nil),                                                    // 19: bytebase.store.EnvironmentSetting
+	(*EmailSetting)(nil),                                                          // 20: bytebase.store.EmailSetting
+	(*WorkspaceProfileSetting_Announcement)(nil),                                  // 21: bytebase.store.WorkspaceProfileSetting.Announcement
+	(*WorkspaceProfileSetting_PasswordRestriction)(nil),                           // 22: bytebase.store.WorkspaceProfileSetting.PasswordRestriction
+	(*WorkspaceApprovalSetting_Rule)(nil),                                         // 23: bytebase.store.WorkspaceApprovalSetting.Rule
+	(*DataClassificationSetting_DataClassificationConfig)(nil),                    // 24: bytebase.store.DataClassificationSetting.DataClassificationConfig
+	(*DataClassificationSetting_DataClassificationConfig_Level)(nil),              // 25: bytebase.store.DataClassificationSetting.DataClassificationConfig.Level
+	(*DataClassificationSetting_DataClassificationConfig_DataClassification)(nil), // 26: bytebase.store.DataClassificationSetting.DataClassificationConfig.DataClassification
+	nil, // 27: bytebase.store.DataClassificationSetting.DataClassificationConfig.ClassificationEntry
+	(*DataClassificationSetting_DataClassificationConfig_Detector)(nil), // 28: bytebase.store.DataClassificationSetting.DataClassificationConfig.Detector
+	(*Algorithm_FullMask)(nil),               // 29: bytebase.store.Algorithm.FullMask
+	(*Algorithm_RangeMask)(nil),              // 30: bytebase.store.Algorithm.RangeMask
+	(*Algorithm_MD5Mask)(nil),                // 31: bytebase.store.Algorithm.MD5Mask
+	(*Algorithm_InnerOuterMask)(nil),         // 32: bytebase.store.Algorithm.InnerOuterMask
+	(*Algorithm_TokenizationMask)(nil),       // 33: bytebase.store.Algorithm.TokenizationMask
+	(*Algorithm_DateShiftMask)(nil),          // 34: bytebase.store.Algorithm.DateShiftMask
+	(*Algorithm_NumericNoiseMask)(nil),       // 35: bytebase.store.Algorithm.NumericNoiseMask
+	(*Algorithm_RangeMask_Slice)(nil),        // 36: bytebase.store.Algorithm.RangeMask.Slice
+	(*SemanticTypeSetting_SemanticType)(nil), // 37: bytebase.store.SemanticTypeSetting.SemanticType
+	(*AppIMSetting_Slack)(nil),               // 38: bytebase.store.AppIMSetting.Slack
+	(*AppIMSetting_Feishu)(nil),              // 39: bytebase.store.AppIMSetting.Feishu
+	(*AppIMSetting_Wecom)(nil),               // 40: bytebase.store.AppIMSetting.Wecom
+	(*AppIMSetting_Lark)(nil),                // 41: bytebase.store.AppIMSetting.Lark
+	(*AppIMSetting_DingTalk)(nil),            // 42: bytebase.store.AppIMSetting.DingTalk
+	(*AppIMSetting_Teams)(nil),               // 43: bytebase.store.AppIMSetting.Teams
+	(*AppIMSetting_IMSetting)(nil),           // 44: bytebase.store.AppIMSetting.IMSetting
+	(*EnvironmentSetting_Environment)(nil),   // 45: bytebase.store.EnvironmentSetting.Environment
+	nil,                                      // 46: bytebase.store.EnvironmentSetting.Environment.TagsEntry
+	(*EmailSetting_SMTPConfig)(nil),          // 47: bytebase.store.EmailSetting.SMTPConfig
+	(*durationpb.Duration)(nil),              // 48: google.protobuf.Duration
+	(*ApprovalTemplate)(nil),                 // 49: bytebase.store.ApprovalTemplate
+	(*expr.Expr)(nil),                        // 50: google.type.Expr
+	(*DataSourceExternalSecret)(nil),         // 51: bytebase.store.DataSourceExternalSecret
+	(WebhookType)(0),                         // 52: bytebase.store.WebhookType
 }
 var file_store_setting_proto_depIdxs = []int32{
-	42, // 0: bytebase.store.WorkspaceProfileSetting.refresh_token_duration:type_name -> google.protobuf.Duration
-	19, // 1: bytebase.store.WorkspaceProfileSetting.announcement:type_name -> bytebase.store.WorkspaceProfileSetting.Announcement
-	42, // 2: bytebase.store.WorkspaceProfileSetting.maximum_role_expiration:type_name -> google.protobuf.Duration
+	48, // 0: bytebase.store.WorkspaceProfileSetting.refresh_token_duration:type_name -> google.protobuf.Duration
+	21, // 1: bytebase.store.WorkspaceProfileSetting.announcement:type_name -> bytebase.store.WorkspaceProfileSetting.Announcement
+	48, // 2: bytebase.store.WorkspaceProfileSetting.maximum_role_expiration:type_name -> google.protobuf.Duration
 	1,  // 3: bytebase.store.WorkspaceProfileSetting.database_change_mode:type_name -> bytebase.store.WorkspaceProfileSetting.DatabaseChangeMode
-	42, // 4: bytebase.store.WorkspaceProfileSetting.inactive_session_timeout:type_name -> google.protobuf.Duration
-	20, // 5: bytebase.store.WorkspaceProfileSetting.password_restriction:type_name -> bytebase.store.WorkspaceProfileSetting.PasswordRestriction
-	42, // 6: bytebase.store.WorkspaceProfileSetting.access_token_duration:type_name -> google.protobuf.Duration
-	42, // 7: bytebase.store.WorkspaceProfileSetting.query_timeout:type_name -> google.protobuf.Duration
-	21, // 8: bytebase.store.WorkspaceApprovalSetting.rules:type_name -> bytebase.store.WorkspaceApprovalSetting.Rule
-	22, // 9: bytebase.store.DataClassificationSetting.configs:type_name -> bytebase.store.DataClassificationSetting.DataClassificationConfig
-	26, // 10: bytebase.store.Algorithm.full_mask:type_name -> bytebase.store.Algorithm.FullMask
-	27, // 11: bytebase.store.Algorithm.range_mask:type_name -> bytebase.store.Algorithm.RangeMask
-	28, // 12: bytebase.store.Algorithm.md5_mask:type_name -> bytebase.store.Algorithm.MD5Mask
-	29, // 13: bytebase.store.Algorithm.inner_outer_mask:type_name -> bytebase.store.Algorithm.InnerOuterMask
-	31, // 14: bytebase.store.SemanticTypeSetting.types:type_name -> bytebase.store.SemanticTypeSetting.SemanticType
-	38, // 15: bytebase.store.AppIMSetting.settings:type_name -> bytebase.store.AppIMSetting.IMSetting
-	5,  // 16: bytebase.store.AISetting.provider:type_name -> bytebase.store.AISetting.Provider
-	39, // 17: bytebase.store.EnvironmentSetting.environments:type_name -> bytebase.store.EnvironmentSetting.Environment
-	6,  // 18: bytebase.store.EmailSetting.type:type_name -> bytebase.store.EmailSetting.Type
-	41, // 19: bytebase.store.EmailSetting.smtp:type_name -> bytebase.store.EmailSetting.SMTPConfig
-	2,  // 20: bytebase.store.WorkspaceProfileSetting.Announcement.level:type_name -> bytebase.store.WorkspaceProfileSetting.Announcement.AlertLevel
-	42, // 21: bytebase.store.WorkspaceProfileSetting.PasswordRestriction.password_rotation:type_name -> google.protobuf.Duration
-	43, // 22: bytebase.store.WorkspaceApprovalSetting.Rule.template:type_name -> bytebase.store.ApprovalTemplate
-	44, // 23: bytebase.store.WorkspaceApprovalSetting.Rule.condition:type_name -> google.type.Expr
-	3,  // 24: bytebase.store.WorkspaceApprovalSetting.Rule.source:type_name -> bytebase.store.WorkspaceApprovalSetting.Rule.Source
-	23, // 25: bytebase.store.DataClassificationSetting.DataClassificationConfig.levels:type_name -> bytebase.store.DataClassificationSetting.DataClassificationConfig.Level
-	25, // 26: bytebase.store.DataClassificationSetting.DataClassificationConfig.classification:type_name -> bytebase.store.DataClassificationSetting.DataClassificationConfig.ClassificationEntry
-	24, // 27: bytebase.store.DataClassificationSetting.DataClassificationConfig.ClassificationEntry.value:type_name -> bytebase.store.DataClassificationSetting.DataClassificationConfig.DataClassification
-	30, // 28: bytebase.store.Algorithm.RangeMask.slices:type_name -> bytebase.store.Algorithm.RangeMask.Slice
-	4,  // 29: bytebase.store.Algorithm.InnerOuterMask.type:type_name -> bytebase.store.Algorithm.InnerOuterMask.MaskType
-	13, // 30: bytebase.store.SemanticTypeSetting.SemanticType.algorithm:type_name -> bytebase.store.Algorithm
-	45, // 31: bytebase.store.AppIMSetting.IMSetting.type:type_name -> bytebase.store.WebhookType
-	32, // 32: bytebase.store.AppIMSetting.IMSetting.slack:type_name -> bytebase.store.AppIMSetting.Slack
-	33, // 33: bytebase.store.AppIMSetting.IMSetting.feishu:type_name -> bytebase.store.AppIMSetting.Feishu
-	34, // 34: bytebase.store.AppIMSetting.IMSetting.wecom:type_name -> bytebase.store.AppIMSetting.Wecom
-	35, // 35: bytebase.store.AppIMSetting.IMSetting.lark:type_name -> bytebase.store.AppIMSetting.Lark
-	36, // 36: bytebase.store.AppIMSetting.IMSetting.dingtalk:type_name -> bytebase.store.AppIMSetting.DingTalk
-	37, // 37: bytebase.store.AppIMSetting.IMSetting.teams:type_name -> bytebase.store.AppIMSetting.Teams
-	40, // 38: bytebase.store.EnvironmentSetting.Environment.tags:type_name -> bytebase.store.EnvironmentSetting.Environment.TagsEntry
-	7,  // 39: bytebase.store.EmailSetting.SMTPConfig.encryption:type_name -> bytebase.store.EmailSetting.SMTPConfig.Encryption
-	8,  // 40: bytebase.store.EmailSetting.SMTPConfig.authentication:type_name -> bytebase.store.EmailSetting.SMTPConfig.Authentication
-	41, // [41:41] is the sub-list for method output_type
-	41, // [41:41] is the sub-list for method input_type
-	41, // [41:41] is the sub-list for extension type_name
-	41, // [41:41] is the sub-list for extension extendee
-	0,  // [0:41] is the sub-list for field type_name
+	48, // 4: bytebase.store.WorkspaceProfileSetting.inactive_session_timeout:type_name -> google.protobuf.Duration
+	22, // 5: bytebase.store.WorkspaceProfileSetting.password_restriction:type_name -> bytebase.store.WorkspaceProfileSetting.PasswordRestriction
+	48, // 6: bytebase.store.WorkspaceProfileSetting.access_token_duration:type_name -> google.protobuf.Duration
+	48, // 7: bytebase.store.WorkspaceProfileSetting.query_timeout:type_name -> google.protobuf.Duration
+	23, // 8: bytebase.store.WorkspaceApprovalSetting.rules:type_name -> bytebase.store.WorkspaceApprovalSetting.Rule
+	24, // 9: bytebase.store.DataClassificationSetting.configs:type_name -> bytebase.store.DataClassificationSetting.DataClassificationConfig
+	29, // 10: bytebase.store.Algorithm.full_mask:type_name -> bytebase.store.Algorithm.FullMask
+	30, // 11: bytebase.store.Algorithm.range_mask:type_name -> bytebase.store.Algorithm.RangeMask
+	31, // 12: bytebase.store.Algorithm.md5_mask:type_name -> bytebase.store.Algorithm.MD5Mask
+	32, // 13: bytebase.store.Algorithm.inner_outer_mask:type_name -> bytebase.store.Algorithm.InnerOuterMask
+	33, // 14: bytebase.store.Algorithm.tokenization_mask:type_name -> bytebase.store.Algorithm.TokenizationMask
+	34, // 15: bytebase.store.Algorithm.date_shift_mask:type_name -> bytebase.store.Algorithm.DateShiftMask
+	35, // 16: bytebase.store.Algorithm.numeric_noise_mask:type_name -> bytebase.store.Algorithm.NumericNoiseMask
+	37, // 17: bytebase.store.SemanticTypeSetting.types:type_name -> bytebase.store.SemanticTypeSetting.SemanticType
+	44, // 18: bytebase.store.AppIMSetting.settings:type_name -> bytebase.store.AppIMSetting.IMSetting
+	7,  // 19: bytebase.store.AISetting.provider:type_name -> bytebase.store.AISetting.Provider
+	45, // 20: bytebase.store.EnvironmentSetting.environments:type_name -> bytebase.store.EnvironmentSetting.Environment
+	8,  // 21: bytebase.store.EmailSetting.type:type_name -> bytebase.store.EmailSetting.Type
+	47, // 22: bytebase.store.EmailSetting.smtp:type_name -> bytebase.store.EmailSetting.SMTPConfig
+	2,  // 23: bytebase.store.WorkspaceProfileSetting.Announcement.level:type_name -> bytebase.store.WorkspaceProfileSetting.Announcement.AlertLevel
+	48, // 24: bytebase.store.WorkspaceProfileSetting.PasswordRestriction.password_rotation:type_name -> google.protobuf.Duration
+	49, // 25: bytebase.store.WorkspaceApprovalSetting.Rule.template:type_name -> bytebase.store.ApprovalTemplate
+	50, // 26: bytebase.store.WorkspaceApprovalSetting.Rule.condition:type_name -> google.type.Expr
+	3,  // 27: bytebase.store.WorkspaceApprovalSetting.Rule.source:type_name -> bytebase.store.WorkspaceApprovalSetting.Rule.Source
+	25, // 28: bytebase.store.DataClassificationSetting.DataClassificationConfig.levels:type_name -> bytebase.store.DataClassificationSetting.DataClassificationConfig.Level
+	27, // 29: bytebase.store.DataClassificationSetting.DataClassificationConfig.classification:type_name -> bytebase.store.DataClassificationSetting.DataClassificationConfig.ClassificationEntry
+	28, // 30: bytebase.store.DataClassificationSetting.DataClassificationConfig.detectors:type_name -> bytebase.store.DataClassificationSetting.DataClassificationConfig.Detector
+	26, // 31: bytebase.store.DataClassificationSetting.DataClassificationConfig.ClassificationEntry.value:type_name -> bytebase.store.DataClassificationSetting.DataClassificationConfig.DataClassification
+	4,  // 32: bytebase.store.DataClassificationSetting.DataClassificationConfig.Detector.checksum:type_name -> bytebase.store.DataClassificationSetting.DataClassificationConfig.Detector.Checksum
+	36, // 33: bytebase.store.Algorithm.RangeMask.slices:type_name -> bytebase.store.Algorithm.RangeMask.Slice
+	5,  // 34: bytebase.store.Algorithm.InnerOuterMask.type:type_name -> bytebase.store.Algorithm.InnerOuterMask.MaskType
+	51, // 35: bytebase.store.Algorithm.TokenizationMask.external_key:type_name -> bytebase.store.DataSourceExternalSecret
+	6,  // 36: bytebase.store.Algorithm.TokenizationMask.format:type_name -> bytebase.store.Algorithm.TokenizationMask.Format
+	51, // 37: bytebase.store.Algorithm.DateShiftMask.external_key:type_name -> bytebase.store.DataSourceExternalSecret
+	51, // 38: bytebase.store.Algorithm.NumericNoiseMask.external_key:type_name -> bytebase.store.DataSourceExternalSecret
+	15, // 39: bytebase.store.SemanticTypeSetting.SemanticType.algorithm:type_name -> bytebase.store.Algorithm
+	52, // 40: bytebase.store.AppIMSetting.IMSetting.type:type_name -> bytebase.store.WebhookType
+	38, // 41: bytebase.store.AppIMSetting.IMSetting.slack:type_name -> bytebase.store.AppIMSetting.Slack
+	39, // 42: bytebase.store.AppIMSetting.IMSetting.feishu:type_name -> bytebase.store.AppIMSetting.Feishu
+	40, // 43: bytebase.store.AppIMSetting.IMSetting.wecom:type_name -> bytebase.store.AppIMSetting.Wecom
+	41, // 44: bytebase.store.AppIMSetting.IMSetting.lark:type_name -> bytebase.store.AppIMSetting.Lark
+	42, // 45: bytebase.store.AppIMSetting.IMSetting.dingtalk:type_name -> bytebase.store.AppIMSetting.DingTalk
+	43, // 46: bytebase.store.AppIMSetting.IMSetting.teams:type_name -> bytebase.store.AppIMSetting.Teams
+	46, // 47: bytebase.store.EnvironmentSetting.Environment.tags:type_name -> bytebase.store.EnvironmentSetting.Environment.TagsEntry
+	9,  // 48: bytebase.store.EmailSetting.SMTPConfig.encryption:type_name -> bytebase.store.EmailSetting.SMTPConfig.Encryption
+	10, // 49: bytebase.store.EmailSetting.SMTPConfig.authentication:type_name -> bytebase.store.EmailSetting.SMTPConfig.Authentication
+	50, // [50:50] is the sub-list for method output_type
+	50, // [50:50] is the sub-list for method input_type
+	50, // [50:50] is the sub-list for extension type_name
+	50, // [50:50] is the sub-list for extension extendee
+	0,  // [0:50] is the sub-list for field type_name
 }
 
 func init() { file_store_setting_proto_init() }
@@ -3052,17 +3613,21 @@ func file_store_setting_proto_init() {
 	}
 	file_store_approval_proto_init()
 	file_store_common_proto_init()
+	file_store_instance_proto_init()
 	file_store_setting_proto_msgTypes[4].OneofWrappers = []any{
 		(*Algorithm_FullMask_)(nil),
 		(*Algorithm_RangeMask_)(nil),
 		(*Algorithm_Md5Mask)(nil),
 		(*Algorithm_InnerOuterMask_)(nil),
+		(*Algorithm_TokenizationMask_)(nil),
+		(*Algorithm_DateShiftMask_)(nil),
+		(*Algorithm_NumericNoiseMask_)(nil),
 	}
 	file_store_setting_proto_msgTypes[9].OneofWrappers = []any{
 		(*EmailSetting_Smtp)(nil),
 	}
 	file_store_setting_proto_msgTypes[15].OneofWrappers = []any{}
-	file_store_setting_proto_msgTypes[29].OneofWrappers = []any{
+	file_store_setting_proto_msgTypes[33].OneofWrappers = []any{
 		(*AppIMSetting_IMSetting_Slack)(nil),
 		(*AppIMSetting_IMSetting_Feishu)(nil),
 		(*AppIMSetting_IMSetting_Wecom)(nil),
@@ -3075,8 +3640,8 @@ func file_store_setting_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_setting_proto_rawDesc), len(file_store_setting_proto_rawDesc)),
-			NumEnums:      9,
-			NumMessages:   33,
+			NumEnums:      11,
+			NumMessages:   37,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -6,6 +6,7 @@ package store
 import (
 	expr "google.golang.org/genproto/googleapis/type/expr"
 	proto "google.golang.org/protobuf/proto"
+	math "math"
 )
 
 func (x *SystemSetting) Equal(y *SystemSetting) bool {
@@ -146,6 +147,14 @@ func (x *WorkspaceProfileSetting) Equal(y *WorkspaceProfileSetting) bool {
 	if x.AllowEmailCodeSignin != y.AllowEmailCodeSignin {
 		return false
 	}
+	if len(x.TrustedReleasePublicKeys) != len(y.TrustedReleasePublicKeys) {
+		return false
+	}
+	for i := 0; i < len(x.TrustedReleasePublicKeys); i++ {
+		if x.TrustedReleasePublicKeys[i] != y.TrustedReleasePublicKeys[i] {
+			return false
+		}
+	}
 	return true
 }
 
@@ -223,6 +232,51 @@ func (x *DataClassificationSetting_DataClassificationConfig_DataClassification)
 	return true
 }
 
+func (x *DataClassificationSetting_DataClassificationConfig_Detector) Equal(y *DataClassificationSetting_DataClassificationConfig_Detector) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Id != y.Id {
+		return false
+	}
+	if x.Title != y.Title {
+		return false
+	}
+	if x.ColumnNamePattern != y.ColumnNamePattern {
+		return false
+	}
+	if x.CommentPattern != y.CommentPattern {
+		return false
+	}
+	if x.ValuePattern != y.ValuePattern {
+		return false
+	}
+	if x.Checksum != y.Checksum {
+		return false
+	}
+	if len(x.Dictionary) != len(y.Dictionary) {
+		return false
+	}
+	for i := 0; i < len(x.Dictionary); i++ {
+		if x.Dictionary[i] != y.Dictionary[i] {
+			return false
+		}
+	}
+	if (math.IsNaN(float64(x.MinMatchRatio)) && !math.IsNaN(float64(y.MinMatchRatio)) || !math.IsNaN(float64(x.MinMatchRatio)) && math.IsNaN(float64(y.MinMatchRatio))) || (!math.IsNaN(float64(x.MinMatchRatio)) && !math.IsNaN(float64(y.MinMatchRatio)) && x.MinMatchRatio != y.MinMatchRatio) {
+		return false
+	}
+	if x.ClassificationId != y.ClassificationId {
+		return false
+	}
+	if x.SemanticTypeId != y.SemanticTypeId {
+		return false
+	}
+	return true
+}
+
 func (x *DataClassificationSetting_DataClassificationConfig) Equal(y *DataClassificationSetting_DataClassificationConfig) bool {
 	if x == y {
 		return true
@@ -256,6 +310,14 @@ func (x *DataClassificationSetting_DataClassificationConfig) Equal(y *DataClassi
 			return false
 		}
 	}
+	if len(x.Detectors) != len(y.Detectors) {
+		return false
+	}
+	for i := 0; i < len(x.Detectors); i++ {
+		if !x.Detectors[i].Equal(y.Detectors[i]) {
+			return false
+		}
+	}
 	return true
 }
 
@@ -362,6 +424,66 @@ func (x *Algorithm_InnerOuterMask) Equal(y *Algorithm_InnerOuterMask) bool {
 	return true
 }
 
+func (x *Algorithm_TokenizationMask) Equal(y *Algorithm_TokenizationMask) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Key != y.Key {
+		return false
+	}
+	if !x.ExternalKey.Equal(y.ExternalKey) {
+		return false
+	}
+	if x.Format != y.Format {
+		return false
+	}
+	return true
+}
+
+func (x *Algorithm_DateShiftMask) Equal(y *Algorithm_DateShiftMask) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Key != y.Key {
+		return false
+	}
+	if !x.ExternalKey.Equal(y.ExternalKey) {
+		return false
+	}
+	if x.MaxShiftDays != y.MaxShiftDays {
+		return false
+	}
+	if x.SubjectColumn != y.SubjectColumn {
+		return false
+	}
+	return true
+}
+
+func (x *Algorithm_NumericNoiseMask) Equal(y *Algorithm_NumericNoiseMask) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Key != y.Key {
+		return false
+	}
+	if !x.ExternalKey.Equal(y.ExternalKey) {
+		return false
+	}
+	if (math.IsNaN(float64(x.NoiseRatio)) && !math.IsNaN(float64(y.NoiseRatio)) || !math.IsNaN(float64(x.NoiseRatio)) && math.IsNaN(float64(y.NoiseRatio))) || (!math.IsNaN(float64(x.NoiseRatio)) && !math.IsNaN(float64(y.NoiseRatio)) && x.NoiseRatio != y.NoiseRatio) {
+		return false
+	}
+	return true
+}
+
 func (x *Algorithm) Equal(y *Algorithm) bool {
 	if x == y {
 		return true
@@ -381,6 +503,15 @@ func (x *Algorithm) Equal(y *Algorithm) bool {
 	if !x.GetInnerOuterMask().Equal(y.GetInnerOuterMask()) {
 		return false
 	}
+	if !x.GetTokenizationMask().Equal(y.GetTokenizationMask()) {
+		return false
+	}
+	if !x.GetDateShiftMask().Equal(y.GetDateShiftMask()) {
+		return false
+	}
+	if !x.GetNumericNoiseMask().Equal(y.GetNumericNoiseMask()) {
+		return false
+	}
 	return true
 }
 
@@ -28,6 +28,7 @@ const (
 	Signal_TYPE_UNSPECIFIED      Signal_Type = 0
 	Signal_CANCEL_PLAN_CHECK_RUN Signal_Type = 1
 	Signal_CANCEL_TASK_RUN       Signal_Type = 2
+	Signal_CANCEL_OPERATION      Signal_Type = 3
 )
 
 // Enum value maps for Signal_Type.
@@ -36,11 +37,13 @@ var (
 		0: "TYPE_UNSPECIFIED",
 		1: "CANCEL_PLAN_CHECK_RUN",
 		2: "CANCEL_TASK_RUN",
+		3: "CANCEL_OPERATION",
 	}
 	Signal_Type_value = map[string]int32{
 		"TYPE_UNSPECIFIED":      0,
 		"CANCEL_PLAN_CHECK_RUN": 1,
 		"CANCEL_TASK_RUN":       2,
+		"CANCEL_OPERATION":      3,
 	}
 )
 
@@ -136,15 +139,16 @@ var File_store_signal_proto protoreflect.FileDescriptor
 
 const file_store_signal_proto_rawDesc = "" +
 	"\n" +
-	"\x12store/signal.proto\x12\x0ebytebase.store\"\xb3\x01\n" +
+	"\x12store/signal.proto\x12\x0ebytebase.store\"\xc9\x01\n" +
 	"\x06Signal\x12/\n" +
 	"\x04type\x18\x01 \x01(\x0e2\x1b.bytebase.store.Signal.TypeR\x04type\x12\x10\n" +
 	"\x03uid\x18\x02 \x01(\x03R\x03uid\x12\x18\n" +
-	"\aproject\x18\x03 \x01(\tR\aproject\"L\n" +
+	"\aproject\x18\x03 \x01(\tR\aproject\"b\n" +
 	"\x04Type\x12\x14\n" +
 	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x19\n" +
 	"\x15CANCEL_PLAN_CHECK_RUN\x10\x01\x12\x13\n" +
-	"\x0fCANCEL_TASK_RUN\x10\x02B\x8e\x01\n" +
+	"\x0fCANCEL_TASK_RUN\x10\x02\x12\x14\n" +
+	"\x10CANCEL_OPERATION\x10\x03B\x8e\x01\n" +
 	"\x12com.bytebase.storeB\vSignalProtoP\x01Z\x12generated-go/store\xa2\x02\x03BSX\xaa\x02\x0eBytebase.Store\xca\x02\x0eBytebase\\Store\xe2\x02\x1aBytebase\\Store\\GPBMetadata\xea\x02\x0fBytebase::Storeb\x06proto3"
 
 var (
@@ -34,6 +34,8 @@ const (
 	Task_DATABASE_MIGRATE Task_Type = 2
 	// Export data from a database.
 	Task_DATABASE_EXPORT Task_Type = 3
+	// Clone the schema and masked data of a source database into the task database.
+	Task_DATABASE_CLONE Task_Type = 4
 )
 
 // Enum value maps for Task_Type.
@@ -43,12 +45,14 @@ var (
 		1: "DATABASE_CREATE",
 		2: "DATABASE_MIGRATE",
 		3: "DATABASE_EXPORT",
+		4: "DATABASE_CLONE",
 	}
 	Task_Type_value = map[string]int32{
 		"TASK_TYPE_UNSPECIFIED": 0,
 		"DATABASE_CREATE":       1,
 		"DATABASE_MIGRATE":      2,
 		"DATABASE_EXPORT":       3,
+		"DATABASE_CLONE":        4,
 	}
 )
 
@@ -208,7 +212,7 @@ var File_store_task_proto protoreflect.FileDescriptor
 
 const file_store_task_proto_rawDesc = "" +
 	"\n" +
-	"\x10store/task.proto\x12\x0ebytebase.store\"\xbe\x02\n" +
+	"\x10store/task.proto\x12\x0ebytebase.store\"\xd2\x02\n" +
 	"\x04Task\x12\x18\n" +
 	"\askipped\x18\x01 \x01(\bR\askipped\x12%\n" +
 	"\x0eskipped_reason\x18\x02 \x01(\tR\rskippedReason\x12\x17\n" +
@@ -216,12 +220,13 @@ const file_store_task_proto_rawDesc = "" +
 	"\fsheet_sha256\x18\n" +
 	" \x01(\tH\x00R\vsheetSha256\x12\x1a\n" +
 	"\arelease\x18\r \x01(\tH\x00R\arelease\x12.\n" +
-	"\x13enable_prior_backup\x18\v \x01(\bR\x11enablePriorBackup\"a\n" +
+	"\x13enable_prior_backup\x18\v \x01(\bR\x11enablePriorBackup\"u\n" +
 	"\x04Type\x12\x19\n" +
 	"\x15TASK_TYPE_UNSPECIFIED\x10\x00\x12\x13\n" +
 	"\x0fDATABASE_CREATE\x10\x01\x12\x14\n" +
 	"\x10DATABASE_MIGRATE\x10\x02\x12\x13\n" +
-	"\x0fDATABASE_EXPORT\x10\x03B\b\n" +
+	"\x0fDATABASE_EXPORT\x10\x03\x12\x12\n" +
+	"\x0eDATABASE_CLONE\x10\x04B\b\n" +
 	"\x06sourceB\x8c\x01\n" +
 	"\x12com.bytebase.storeB\tTaskProtoP\x01Z\x12generated-go/store\xa2\x02\x03BSX\xaa\x02\x0eBytebase.Store\xca\x02\x0eBytebase\\Store\xe2\x02\x1aBytebase\\Store\\GPBMetadata\xea\x02\x0fBytebase::Storeb\x06proto3"
 
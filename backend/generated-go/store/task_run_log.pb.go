@@ -38,6 +38,7 @@ const (
 	TaskRunLog_COMPUTE_DIFF_START   TaskRunLog_Type = 12
 	TaskRunLog_COMPUTE_DIFF_END     TaskRunLog_Type = 13
 	TaskRunLog_RELEASE_FILE_EXECUTE TaskRunLog_Type = 14
+	TaskRunLog_TABLE_COPY           TaskRunLog_Type = 15
 )
 
 // Enum value maps for TaskRunLog_Type.
@@ -57,6 +58,7 @@ var (
 		12: "COMPUTE_DIFF_START",
 		13: "COMPUTE_DIFF_END",
 		14: "RELEASE_FILE_EXECUTE",
+		15: "TABLE_COPY",
 	}
 	TaskRunLog_Type_value = map[string]int32{
 		"TYPE_UNSPECIFIED":     0,
@@ -73,6 +75,7 @@ var (
 		"COMPUTE_DIFF_START":   12,
 		"COMPUTE_DIFF_END":     13,
 		"RELEASE_FILE_EXECUTE": 14,
+		"TABLE_COPY":           15,
 	}
 )
 
@@ -172,6 +175,7 @@ type TaskRunLog struct {
 	ComputeDiffStart   *TaskRunLog_ComputeDiffStart   `protobuf:"bytes,14,opt,name=compute_diff_start,json=computeDiffStart,proto3" json:"compute_diff_start,omitempty"`
 	ComputeDiffEnd     *TaskRunLog_ComputeDiffEnd     `protobuf:"bytes,15,opt,name=compute_diff_end,json=computeDiffEnd,proto3" json:"compute_diff_end,omitempty"`
 	ReleaseFileExecute *TaskRunLog_ReleaseFileExecute `protobuf:"bytes,16,opt,name=release_file_execute,json=releaseFileExecute,proto3" json:"release_file_execute,omitempty"`
+	TableCopy          *TaskRunLog_TableCopy          `protobuf:"bytes,17,opt,name=table_copy,json=tableCopy,proto3" json:"table_copy,omitempty"`
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
@@ -311,6 +315,13 @@ func (x *TaskRunLog) GetReleaseFileExecute() *TaskRunLog_ReleaseFileExecute {
 	return nil
 }
 
+func (x *TaskRunLog) GetTableCopy() *TaskRunLog_TableCopy {
+	if x != nil {
+		return x.TableCopy
+	}
+	return nil
+}
+
 // PriorBackupDetail contains information about automatic backups created before migration.
 type PriorBackupDetail struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -969,6 +980,75 @@ func (x *TaskRunLog_ReleaseFileExecute) GetFilePath() string {
 	return ""
 }
 
+type TaskRunLog_TableCopy struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Schema string                 `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
+	Table  string                 `protobuf:"bytes,2,opt,name=table,proto3" json:"table,omitempty"`
+	// The number of rows copied so far.
+	CopiedRows    int64  `protobuf:"varint,3,opt,name=copied_rows,json=copiedRows,proto3" json:"copied_rows,omitempty"`
+	Error         string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskRunLog_TableCopy) Reset() {
+	*x = TaskRunLog_TableCopy{}
+	mi := &file_store_task_run_log_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskRunLog_TableCopy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskRunLog_TableCopy) ProtoMessage() {}
+
+func (x *TaskRunLog_TableCopy) ProtoReflect() protoreflect.Message {
+	mi := &file_store_task_run_log_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskRunLog_TableCopy.ProtoReflect.Descriptor instead.
+func (*TaskRunLog_TableCopy) Descriptor() ([]byte, []int) {
+	return file_store_task_run_log_proto_rawDescGZIP(), []int{0, 13}
+}
+
+func (x *TaskRunLog_TableCopy) GetSchema() string {
+	if x != nil {
+		return x.Schema
+	}
+	return ""
+}
+
+func (x *TaskRunLog_TableCopy) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *TaskRunLog_TableCopy) GetCopiedRows() int64 {
+	if x != nil {
+		return x.CopiedRows
+	}
+	return 0
+}
+
+func (x *TaskRunLog_TableCopy) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
 // Item represents a single backup operation for a table.
 type PriorBackupDetail_Item struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -986,7 +1066,7 @@ type PriorBackupDetail_Item struct {
 
 func (x *PriorBackupDetail_Item) Reset() {
 	*x = PriorBackupDetail_Item{}
-	mi := &file_store_task_run_log_proto_msgTypes[15]
+	mi := &file_store_task_run_log_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -998,7 +1078,7 @@ func (x *PriorBackupDetail_Item) String() string {
 func (*PriorBackupDetail_Item) ProtoMessage() {}
 
 func (x *PriorBackupDetail_Item) ProtoReflect() protoreflect.Message {
-	mi := &file_store_task_run_log_proto_msgTypes[15]
+	mi := &file_store_task_run_log_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1058,7 +1138,7 @@ type PriorBackupDetail_Item_Table struct {
 
 func (x *PriorBackupDetail_Item_Table) Reset() {
 	*x = PriorBackupDetail_Item_Table{}
-	mi := &file_store_task_run_log_proto_msgTypes[16]
+	mi := &file_store_task_run_log_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1070,7 +1150,7 @@ func (x *PriorBackupDetail_Item_Table) String() string {
 func (*PriorBackupDetail_Item_Table) ProtoMessage() {}
 
 func (x *PriorBackupDetail_Item_Table) ProtoReflect() protoreflect.Message {
-	mi := &file_store_task_run_log_proto_msgTypes[16]
+	mi := &file_store_task_run_log_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1111,7 +1191,7 @@ var File_store_task_run_log_proto protoreflect.FileDescriptor
 
 const file_store_task_run_log_proto_rawDesc = "" +
 	"\n" +
-	"\x18store/task_run_log.proto\x12\x0ebytebase.store\x1a\x12store/common.proto\"\xa2\x13\n" +
+	"\x18store/task_run_log.proto\x12\x0ebytebase.store\x1a\x12store/common.proto\"\xe9\x14\n" +
 	"\n" +
 	"TaskRunLog\x123\n" +
 	"\x04type\x18\x01 \x01(\x0e2\x1f.bytebase.store.TaskRunLog.TypeR\x04type\x12\x1d\n" +
@@ -1131,7 +1211,9 @@ const file_store_task_run_log_proto_rawDesc = "" +
 	"retry_info\x18\r \x01(\v2$.bytebase.store.TaskRunLog.RetryInfoR\tretryInfo\x12Y\n" +
 	"\x12compute_diff_start\x18\x0e \x01(\v2+.bytebase.store.TaskRunLog.ComputeDiffStartR\x10computeDiffStart\x12S\n" +
 	"\x10compute_diff_end\x18\x0f \x01(\v2).bytebase.store.TaskRunLog.ComputeDiffEndR\x0ecomputeDiffEnd\x12_\n" +
-	"\x14release_file_execute\x18\x10 \x01(\v2-.bytebase.store.TaskRunLog.ReleaseFileExecuteR\x12releaseFileExecute\x1a\x11\n" +
+	"\x14release_file_execute\x18\x10 \x01(\v2-.bytebase.store.TaskRunLog.ReleaseFileExecuteR\x12releaseFileExecute\x12C\n" +
+	"\n" +
+	"table_copy\x18\x11 \x01(\v2$.bytebase.store.TaskRunLog.TableCopyR\ttableCopy\x1a\x11\n" +
 	"\x0fSchemaDumpStart\x1a%\n" +
 	"\rSchemaDumpEnd\x12\x14\n" +
 	"\x05error\x18\x01 \x01(\tR\x05error\x1a[\n" +
@@ -1168,7 +1250,13 @@ const file_store_task_run_log_proto_rawDesc = "" +
 	"\x05error\x18\x01 \x01(\tR\x05error\x1aK\n" +
 	"\x12ReleaseFileExecute\x12\x18\n" +
 	"\aversion\x18\x01 \x01(\tR\aversion\x12\x1b\n" +
-	"\tfile_path\x18\x02 \x01(\tR\bfilePath\"\xc2\x02\n" +
+	"\tfile_path\x18\x02 \x01(\tR\bfilePath\x1ap\n" +
+	"\tTableCopy\x12\x16\n" +
+	"\x06schema\x18\x01 \x01(\tR\x06schema\x12\x14\n" +
+	"\x05table\x18\x02 \x01(\tR\x05table\x12\x1f\n" +
+	"\vcopied_rows\x18\x03 \x01(\x03R\n" +
+	"copiedRows\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"\xd2\x02\n" +
 	"\x04Type\x12\x14\n" +
 	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x15\n" +
 	"\x11SCHEMA_DUMP_START\x10\x01\x12\x13\n" +
@@ -1185,7 +1273,9 @@ const file_store_task_run_log_proto_rawDesc = "" +
 	"RETRY_INFO\x10\v\x12\x16\n" +
 	"\x12COMPUTE_DIFF_START\x10\f\x12\x14\n" +
 	"\x10COMPUTE_DIFF_END\x10\r\x12\x18\n" +
-	"\x14RELEASE_FILE_EXECUTE\x10\x0e\"\xcd\x03\n" +
+	"\x14RELEASE_FILE_EXECUTE\x10\x0e\x12\x0e\n" +
+	"\n" +
+	"TABLE_COPY\x10\x0f\"\xcd\x03\n" +
 	"\x11PriorBackupDetail\x12<\n" +
 	"\x05items\x18\x01 \x03(\v2&.bytebase.store.PriorBackupDetail.ItemR\x05items\x1a\xf9\x02\n" +
 	"\x04Item\x12O\n" +
@@ -1212,7 +1302,7 @@ func file_store_task_run_log_proto_rawDescGZIP() []byte {
 }
 
 var file_store_task_run_log_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_store_task_run_log_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_store_task_run_log_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_store_task_run_log_proto_goTypes = []any{
 	(TaskRunLog_Type)(0),                    // 0: bytebase.store.TaskRunLog.Type
 	(TaskRunLog_TransactionControl_Type)(0), // 1: bytebase.store.TaskRunLog.TransactionControl.Type
@@ -1231,10 +1321,11 @@ var file_store_task_run_log_proto_goTypes = []any{
 	(*TaskRunLog_ComputeDiffStart)(nil),     // 14: bytebase.store.TaskRunLog.ComputeDiffStart
 	(*TaskRunLog_ComputeDiffEnd)(nil),       // 15: bytebase.store.TaskRunLog.ComputeDiffEnd
 	(*TaskRunLog_ReleaseFileExecute)(nil),   // 16: bytebase.store.TaskRunLog.ReleaseFileExecute
-	(*PriorBackupDetail_Item)(nil),          // 17: bytebase.store.PriorBackupDetail.Item
-	(*PriorBackupDetail_Item_Table)(nil),    // 18: bytebase.store.PriorBackupDetail.Item.Table
-	(*Range)(nil),                           // 19: bytebase.store.Range
-	(*Position)(nil),                        // 20: bytebase.store.Position
+	(*TaskRunLog_TableCopy)(nil),            // 17: bytebase.store.TaskRunLog.TableCopy
+	(*PriorBackupDetail_Item)(nil),          // 18: bytebase.store.PriorBackupDetail.Item
+	(*PriorBackupDetail_Item_Table)(nil),    // 19: bytebase.store.PriorBackupDetail.Item.Table
+	(*Range)(nil),                           // 20: bytebase.store.Range
+	(*Position)(nil),                        // 21: bytebase.store.Position
 }
 var file_store_task_run_log_proto_depIdxs = []int32{
 	0,  // 0: bytebase.store.TaskRunLog.type:type_name -> bytebase.store.TaskRunLog.Type
@@ -1251,19 +1342,20 @@ var file_store_task_run_log_proto_depIdxs = []int32{
 	14, // 11: bytebase.store.TaskRunLog.compute_diff_start:type_name -> bytebase.store.TaskRunLog.ComputeDiffStart
 	15, // 12: bytebase.store.TaskRunLog.compute_diff_end:type_name -> bytebase.store.TaskRunLog.ComputeDiffEnd
 	16, // 13: bytebase.store.TaskRunLog.release_file_execute:type_name -> bytebase.store.TaskRunLog.ReleaseFileExecute
-	17, // 14: bytebase.store.PriorBackupDetail.items:type_name -> bytebase.store.PriorBackupDetail.Item
-	19, // 15: bytebase.store.TaskRunLog.CommandExecute.range:type_name -> bytebase.store.Range
-	1,  // 16: bytebase.store.TaskRunLog.TransactionControl.type:type_name -> bytebase.store.TaskRunLog.TransactionControl.Type
-	3,  // 17: bytebase.store.TaskRunLog.PriorBackupEnd.prior_backup_detail:type_name -> bytebase.store.PriorBackupDetail
-	18, // 18: bytebase.store.PriorBackupDetail.Item.source_table:type_name -> bytebase.store.PriorBackupDetail.Item.Table
-	18, // 19: bytebase.store.PriorBackupDetail.Item.target_table:type_name -> bytebase.store.PriorBackupDetail.Item.Table
-	20, // 20: bytebase.store.PriorBackupDetail.Item.start_position:type_name -> bytebase.store.Position
-	20, // 21: bytebase.store.PriorBackupDetail.Item.end_position:type_name -> bytebase.store.Position
-	22, // [22:22] is the sub-list for method output_type
-	22, // [22:22] is the sub-list for method input_type
-	22, // [22:22] is the sub-list for extension type_name
-	22, // [22:22] is the sub-list for extension extendee
-	0,  // [0:22] is the sub-list for field type_name
+	17, // 14: bytebase.store.TaskRunLog.table_copy:type_name -> bytebase.store.TaskRunLog.TableCopy
+	18, // 15: bytebase.store.PriorBackupDetail.items:type_name -> bytebase.store.PriorBackupDetail.Item
+	20, // 16: bytebase.store.TaskRunLog.CommandExecute.range:type_name -> bytebase.store.Range
+	1,  // 17: bytebase.store.TaskRunLog.TransactionControl.type:type_name -> bytebase.store.TaskRunLog.TransactionControl.Type
+	3,  // 18: bytebase.store.TaskRunLog.PriorBackupEnd.prior_backup_detail:type_name -> bytebase.store.PriorBackupDetail
+	19, // 19: bytebase.store.PriorBackupDetail.Item.source_table:type_name -> bytebase.store.PriorBackupDetail.Item.Table
+	19, // 20: bytebase.store.PriorBackupDetail.Item.target_table:type_name -> bytebase.store.PriorBackupDetail.Item.Table
+	21, // 21: bytebase.store.PriorBackupDetail.Item.start_position:type_name -> bytebase.store.Position
+	21, // 22: bytebase.store.PriorBackupDetail.Item.end_position:type_name -> bytebase.store.Position
+	23, // [23:23] is the sub-list for method output_type
+	23, // [23:23] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_store_task_run_log_proto_init() }
@@ -1278,7 +1370,7 @@ func file_store_task_run_log_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_task_run_log_proto_rawDesc), len(file_store_task_run_log_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   17,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
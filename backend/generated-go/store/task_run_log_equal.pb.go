@@ -189,6 +189,28 @@ func (x *TaskRunLog_ReleaseFileExecute) Equal(y *TaskRunLog_ReleaseFileExecute)
 	return true
 }
 
+func (x *TaskRunLog_TableCopy) Equal(y *TaskRunLog_TableCopy) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Schema != y.Schema {
+		return false
+	}
+	if x.Table != y.Table {
+		return false
+	}
+	if x.CopiedRows != y.CopiedRows {
+		return false
+	}
+	if x.Error != y.Error {
+		return false
+	}
+	return true
+}
+
 func (x *TaskRunLog) Equal(y *TaskRunLog) bool {
 	if x == y {
 		return true
@@ -241,6 +263,9 @@ func (x *TaskRunLog) Equal(y *TaskRunLog) bool {
 	if !x.ReleaseFileExecute.Equal(y.ReleaseFileExecute) {
 		return false
 	}
+	if !x.TableCopy.Equal(y.TableCopy) {
+		return false
+	}
 	return true
 }
 
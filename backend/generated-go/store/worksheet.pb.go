@@ -21,6 +21,61 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type WorksheetParameter_Type int32
+
+const (
+	WorksheetParameter_TYPE_UNSPECIFIED WorksheetParameter_Type = 0
+	WorksheetParameter_STRING           WorksheetParameter_Type = 1
+	WorksheetParameter_INT              WorksheetParameter_Type = 2
+	WorksheetParameter_DATE             WorksheetParameter_Type = 3
+	WorksheetParameter_ENUM             WorksheetParameter_Type = 4
+)
+
+// Enum value maps for WorksheetParameter_Type.
+var (
+	WorksheetParameter_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "STRING",
+		2: "INT",
+		3: "DATE",
+		4: "ENUM",
+	}
+	WorksheetParameter_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"STRING":           1,
+		"INT":              2,
+		"DATE":             3,
+		"ENUM":             4,
+	}
+)
+
+func (x WorksheetParameter_Type) Enum() *WorksheetParameter_Type {
+	p := new(WorksheetParameter_Type)
+	*p = x
+	return p
+}
+
+func (x WorksheetParameter_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WorksheetParameter_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_worksheet_proto_enumTypes[0].Descriptor()
+}
+
+func (WorksheetParameter_Type) Type() protoreflect.EnumType {
+	return &file_store_worksheet_proto_enumTypes[0]
+}
+
+func (x WorksheetParameter_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WorksheetParameter_Type.Descriptor instead.
+func (WorksheetParameter_Type) EnumDescriptor() ([]byte, []int) {
+	return file_store_worksheet_proto_rawDescGZIP(), []int{2, 0}
+}
+
 type WorkSheetOrganizerPayload struct {
 	state   protoimpl.MessageState `protogen:"open.v1"`
 	Starred bool                   `protobuf:"varint,1,opt,name=starred,proto3" json:"starred,omitempty"`
@@ -75,6 +130,139 @@ func (x *WorkSheetOrganizerPayload) GetFolders() []string {
 	return nil
 }
 
+type WorksheetPayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The named parameters referenced as :name in the worksheet statement.
+	Parameters []*WorksheetParameter `protobuf:"bytes,1,rep,name=parameters,proto3" json:"parameters,omitempty"`
+	// The role that can read and run the worksheet as a read-only runbook.
+	// Format: roles/{role}
+	RunbookRole   string `protobuf:"bytes,2,opt,name=runbook_role,json=runbookRole,proto3" json:"runbook_role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorksheetPayload) Reset() {
+	*x = WorksheetPayload{}
+	mi := &file_store_worksheet_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorksheetPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorksheetPayload) ProtoMessage() {}
+
+func (x *WorksheetPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_worksheet_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorksheetPayload.ProtoReflect.Descriptor instead.
+func (*WorksheetPayload) Descriptor() ([]byte, []int) {
+	return file_store_worksheet_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *WorksheetPayload) GetParameters() []*WorksheetParameter {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+func (x *WorksheetPayload) GetRunbookRole() string {
+	if x != nil {
+		return x.RunbookRole
+	}
+	return ""
+}
+
+type WorksheetParameter struct {
+	state       protoimpl.MessageState  `protogen:"open.v1"`
+	Name        string                  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type        WorksheetParameter_Type `protobuf:"varint,2,opt,name=type,proto3,enum=bytebase.store.WorksheetParameter_Type" json:"type,omitempty"`
+	Description string                  `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// The value used when the parameter is not given.
+	DefaultValue string `protobuf:"bytes,4,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	// The allowed values of ENUM parameters.
+	AllowedValues []string `protobuf:"bytes,5,rep,name=allowed_values,json=allowedValues,proto3" json:"allowed_values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorksheetParameter) Reset() {
+	*x = WorksheetParameter{}
+	mi := &file_store_worksheet_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorksheetParameter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorksheetParameter) ProtoMessage() {}
+
+func (x *WorksheetParameter) ProtoReflect() protoreflect.Message {
+	mi := &file_store_worksheet_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorksheetParameter.ProtoReflect.Descriptor instead.
+func (*WorksheetParameter) Descriptor() ([]byte, []int) {
+	return file_store_worksheet_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WorksheetParameter) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WorksheetParameter) GetType() WorksheetParameter_Type {
+	if x != nil {
+		return x.Type
+	}
+	return WorksheetParameter_TYPE_UNSPECIFIED
+}
+
+func (x *WorksheetParameter) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *WorksheetParameter) GetDefaultValue() string {
+	if x != nil {
+		return x.DefaultValue
+	}
+	return ""
+}
+
+func (x *WorksheetParameter) GetAllowedValues() []string {
+	if x != nil {
+		return x.AllowedValues
+	}
+	return nil
+}
+
 var File_store_worksheet_proto protoreflect.FileDescriptor
 
 const file_store_worksheet_proto_rawDesc = "" +
@@ -82,7 +270,25 @@ const file_store_worksheet_proto_rawDesc = "" +
 	"\x15store/worksheet.proto\x12\x0ebytebase.store\"O\n" +
 	"\x19WorkSheetOrganizerPayload\x12\x18\n" +
 	"\astarred\x18\x01 \x01(\bR\astarred\x12\x18\n" +
-	"\afolders\x18\x02 \x03(\tR\afoldersB\x91\x01\n" +
+	"\afolders\x18\x02 \x03(\tR\afolders\"y\n" +
+	"\x10WorksheetPayload\x12B\n" +
+	"\n" +
+	"parameters\x18\x01 \x03(\v2\".bytebase.store.WorksheetParameterR\n" +
+	"parameters\x12!\n" +
+	"\frunbook_role\x18\x02 \x01(\tR\vrunbookRole\"\x9a\x02\n" +
+	"\x12WorksheetParameter\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12;\n" +
+	"\x04type\x18\x02 \x01(\x0e2'.bytebase.store.WorksheetParameter.TypeR\x04type\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12#\n" +
+	"\rdefault_value\x18\x04 \x01(\tR\fdefaultValue\x12%\n" +
+	"\x0eallowed_values\x18\x05 \x03(\tR\rallowedValues\"E\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\n" +
+	"\n" +
+	"\x06STRING\x10\x01\x12\a\n" +
+	"\x03INT\x10\x02\x12\b\n" +
+	"\x04DATE\x10\x03\x12\b\n" +
+	"\x04ENUM\x10\x04B\x91\x01\n" +
 	"\x12com.bytebase.storeB\x0eWorksheetProtoP\x01Z\x12generated-go/store\xa2\x02\x03BSX\xaa\x02\x0eBytebase.Store\xca\x02\x0eBytebase\\Store\xe2\x02\x1aBytebase\\Store\\GPBMetadata\xea\x02\x0fBytebase::Storeb\x06proto3"
 
 var (
@@ -97,16 +303,22 @@ func file_store_worksheet_proto_rawDescGZIP() []byte {
 	return file_store_worksheet_proto_rawDescData
 }
 
-var file_store_worksheet_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_store_worksheet_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_store_worksheet_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_store_worksheet_proto_goTypes = []any{
-	(*WorkSheetOrganizerPayload)(nil), // 0: bytebase.store.WorkSheetOrganizerPayload
+	(WorksheetParameter_Type)(0),      // 0: bytebase.store.WorksheetParameter.Type
+	(*WorkSheetOrganizerPayload)(nil), // 1: bytebase.store.WorkSheetOrganizerPayload
+	(*WorksheetPayload)(nil),          // 2: bytebase.store.WorksheetPayload
+	(*WorksheetParameter)(nil),        // 3: bytebase.store.WorksheetParameter
 }
 var file_store_worksheet_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	3, // 0: bytebase.store.WorksheetPayload.parameters:type_name -> bytebase.store.WorksheetParameter
+	0, // 1: bytebase.store.WorksheetParameter.type:type_name -> bytebase.store.WorksheetParameter.Type
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_store_worksheet_proto_init() }
@@ -119,13 +331,14 @@ func file_store_worksheet_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_worksheet_proto_rawDesc), len(file_store_worksheet_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   1,
+			NumEnums:      1,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_store_worksheet_proto_goTypes,
 		DependencyIndexes: file_store_worksheet_proto_depIdxs,
+		EnumInfos:         file_store_worksheet_proto_enumTypes,
 		MessageInfos:      file_store_worksheet_proto_msgTypes,
 	}.Build()
 	File_store_worksheet_proto = out.File
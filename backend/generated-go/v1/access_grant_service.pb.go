@@ -105,6 +105,7 @@ type AccessGrant struct {
 	//	*AccessGrant_Ttl
 	Expiration isAccessGrant_Expiration `protobuf_oneof:"expiration"`
 	// The issue associated with the access grant.
+	// For break-glass grants, it is the review issue created after the grant ends.
 	// Can be empty.
 	// Format: projects/{project}/issues/{issue}
 	Issue string `protobuf:"bytes,5,opt,name=issue,proto3" json:"issue,omitempty"`
@@ -112,12 +113,22 @@ type AccessGrant struct {
 	// Format: instances/{instance}/databases/{database}
 	Targets []string `protobuf:"bytes,6,rep,name=targets,proto3" json:"targets,omitempty"`
 	// The query permission granted.
+	// Must be empty for break-glass grants, which allow any statement on the targets.
 	Query string `protobuf:"bytes,7,opt,name=query,proto3" json:"query,omitempty"`
 	// Whether the grant allows unmasking sensitive data.
-	Unmask        bool                   `protobuf:"varint,8,opt,name=unmask,proto3" json:"unmask,omitempty"`
-	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
-	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
-	Reason        string                 `protobuf:"bytes,12,opt,name=reason,proto3" json:"reason,omitempty"`
+	Unmask     bool                   `protobuf:"varint,8,opt,name=unmask,proto3" json:"unmask,omitempty"`
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	Reason     string                 `protobuf:"bytes,12,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Whether the access grant is a break-glass grant for incidents.
+	// A break-glass grant is activated at creation without approval and pages the
+	// security contacts of the project. The queries run with the grant are tagged
+	// in the query history, and a review issue is created after the grant expires
+	// or is revoked.
+	BreakGlass bool `protobuf:"varint,13,opt,name=break_glass,json=breakGlass,proto3" json:"break_glass,omitempty"`
+	// Whether the grant allows running statements in the admin mode of SQL Editor.
+	// Only supported for break-glass grants.
+	Admin         bool `protobuf:"varint,14,opt,name=admin,proto3" json:"admin,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -247,6 +258,20 @@ func (x *AccessGrant) GetReason() string {
 	return ""
 }
 
+func (x *AccessGrant) GetBreakGlass() bool {
+	if x != nil {
+		return x.BreakGlass
+	}
+	return false
+}
+
+func (x *AccessGrant) GetAdmin() bool {
+	if x != nil {
+		return x.Admin
+	}
+	return false
+}
+
 type isAccessGrant_Expiration interface {
 	isAccessGrant_Expiration()
 }
@@ -331,6 +356,8 @@ type ListAccessGrantsRequest struct {
 	// - create_time: the access creation time in "2006-01-02T15:04:05Z07:00" format, support ">=", ">", "<=" and "<" operator.
 	// - query: the access query, support "==" and ".contains(xx)" operator
 	// - target: the target database fullname, support "==" operator.
+	// - break_glass: whether the access grant is a break-glass grant, support "==" operator.
+	// - has_issue: whether the access grant has an issue, support "==" operator.
 	//
 	// Examples:
 	// - creator == "users/dev@example.com"
@@ -340,6 +367,8 @@ type ListAccessGrantsRequest struct {
 	// - issue == "projects/x/issues/123"
 	// - status == "ACTIVE" && expire_time > "2024-02-01T00:00:00Z"
 	// - target == "instances/sample/databases/employee"
+	// - break_glass == true
+	// - break_glass == true && has_issue == false
 	Filter string `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
 	// The order by of access grants.
 	// Support creator, expire_time, create_time. The default sorting order is ascending.
@@ -760,7 +789,7 @@ var File_v1_access_grant_service_proto protoreflect.FileDescriptor
 
 const file_v1_access_grant_service_proto_rawDesc = "" +
 	"\n" +
-	"\x1dv1/access_grant_service.proto\x12\vbytebase.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x13v1/annotation.proto\"\x9a\x05\n" +
+	"\x1dv1/access_grant_service.proto\x12\vbytebase.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x13v1/annotation.proto\"\xd1\x05\n" +
 	"\vAccessGrant\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1d\n" +
 	"\acreator\x18\x02 \x01(\tB\x03\xe0A\x02R\acreator\x12<\n" +
@@ -777,7 +806,10 @@ const file_v1_access_grant_service_proto_rawDesc = "" +
 	"\vupdate_time\x18\n" +
 	" \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
 	"updateTime\x12\x16\n" +
-	"\x06reason\x18\f \x01(\tR\x06reason\"F\n" +
+	"\x06reason\x18\f \x01(\tR\x06reason\x12\x1f\n" +
+	"\vbreak_glass\x18\r \x01(\bR\n" +
+	"breakGlass\x12\x14\n" +
+	"\x05admin\x18\x0e \x01(\bR\x05admin\"F\n" +
 	"\x06Status\x12\x16\n" +
 	"\x12STATUS_UNSPECIFIED\x10\x00\x12\v\n" +
 	"\aPENDING\x10\x01\x12\n" +
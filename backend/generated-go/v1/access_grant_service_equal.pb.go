@@ -51,6 +51,12 @@ func (x *AccessGrant) Equal(y *AccessGrant) bool {
 	if x.Reason != y.Reason {
 		return false
 	}
+	if x.BreakGlass != y.BreakGlass {
+		return false
+	}
+	if x.Admin != y.Admin {
+		return false
+	}
 	return true
 }
 
@@ -38,6 +38,7 @@ type AccessGrantServiceClient interface {
 	// Lists access grants in a project.
 	ListAccessGrants(ctx context.Context, in *ListAccessGrantsRequest, opts ...grpc.CallOption) (*ListAccessGrantsResponse, error)
 	// Creates an access grant.
+	// Break-glass grants additionally require bb.accessGrants.breakGlass in the project.
 	CreateAccessGrant(ctx context.Context, in *CreateAccessGrantRequest, opts ...grpc.CallOption) (*AccessGrant, error)
 	// Activates a pending access grant.
 	ActivateAccessGrant(ctx context.Context, in *ActivateAccessGrantRequest, opts ...grpc.CallOption) (*AccessGrant, error)
@@ -126,6 +127,7 @@ type AccessGrantServiceServer interface {
 	// Lists access grants in a project.
 	ListAccessGrants(context.Context, *ListAccessGrantsRequest) (*ListAccessGrantsResponse, error)
 	// Creates an access grant.
+	// Break-glass grants additionally require bb.accessGrants.breakGlass in the project.
 	CreateAccessGrant(context.Context, *CreateAccessGrantRequest) (*AccessGrant, error)
 	// Activates a pending access grant.
 	ActivateAccessGrant(context.Context, *ActivateAccessGrantRequest) (*AccessGrant, error)
@@ -115,12 +115,14 @@ type SearchAuditLogsRequest struct {
 	// - severity: support "==" operator, check Severity enum in AuditLog message for values.
 	// - user: the actor, should in "users/{email}" format, support "==" operator.
 	// - create_time: support ">=" and "<=" operator.
+	// - ticket: the ticket ID of the query justification in the SQL Editor requests, support "==" operator.
 	//
 	// For example:
 	//   - filter = "method == '/bytebase.v1.SQLService/Query'"
 	//   - filter = "method == '/bytebase.v1.SQLService/Query' && severity == 'ERROR'"
 	//   - filter = "method == '/bytebase.v1.SQLService/Query' && severity == 'ERROR' && user == 'users/bb@bytebase.com'"
 	//   - filter = "method == '/bytebase.v1.SQLService/Query' && severity == 'ERROR' && create_time <= '2021-01-01T00:00:00Z' && create_time >= '2020-01-01T00:00:00Z'"
+	//   - filter = "method == '/bytebase.v1.SQLService/Query' && ticket == 'INC-123'"
 	Filter string `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
 	// The order by of the log.
 	// Only support order by create_time. The default sorting order is ascending.
@@ -10,6 +10,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -87,6 +88,279 @@ func (ObjectSchema_Type) EnumDescriptor() ([]byte, []int) {
 	return file_v1_database_catalog_service_proto_rawDescGZIP(), []int{6, 0}
 }
 
+// The review decision.
+type BatchReviewClassificationSuggestionsRequest_Decision int32
+
+const (
+	// Unspecified decision.
+	BatchReviewClassificationSuggestionsRequest_DECISION_UNSPECIFIED BatchReviewClassificationSuggestionsRequest_Decision = 0
+	// Write the suggested classification and semantic type to the catalog.
+	BatchReviewClassificationSuggestionsRequest_ACCEPT BatchReviewClassificationSuggestionsRequest_Decision = 1
+	// Dismiss the suggestion. Rejected columns are not proposed again.
+	BatchReviewClassificationSuggestionsRequest_REJECT BatchReviewClassificationSuggestionsRequest_Decision = 2
+)
+
+// Enum value maps for BatchReviewClassificationSuggestionsRequest_Decision.
+var (
+	BatchReviewClassificationSuggestionsRequest_Decision_name = map[int32]string{
+		0: "DECISION_UNSPECIFIED",
+		1: "ACCEPT",
+		2: "REJECT",
+	}
+	BatchReviewClassificationSuggestionsRequest_Decision_value = map[string]int32{
+		"DECISION_UNSPECIFIED": 0,
+		"ACCEPT":               1,
+		"REJECT":               2,
+	}
+)
+
+func (x BatchReviewClassificationSuggestionsRequest_Decision) Enum() *BatchReviewClassificationSuggestionsRequest_Decision {
+	p := new(BatchReviewClassificationSuggestionsRequest_Decision)
+	*p = x
+	return p
+}
+
+func (x BatchReviewClassificationSuggestionsRequest_Decision) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BatchReviewClassificationSuggestionsRequest_Decision) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_database_catalog_service_proto_enumTypes[1].Descriptor()
+}
+
+func (BatchReviewClassificationSuggestionsRequest_Decision) Type() protoreflect.EnumType {
+	return &file_v1_database_catalog_service_proto_enumTypes[1]
+}
+
+func (x BatchReviewClassificationSuggestionsRequest_Decision) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BatchReviewClassificationSuggestionsRequest_Decision.Descriptor instead.
+func (BatchReviewClassificationSuggestionsRequest_Decision) EnumDescriptor() ([]byte, []int) {
+	return file_v1_database_catalog_service_proto_rawDescGZIP(), []int{9, 0}
+}
+
+// The column property matched by the detector.
+type ClassificationSuggestion_MatchSource int32
+
+const (
+	// Unspecified source.
+	ClassificationSuggestion_MATCH_SOURCE_UNSPECIFIED ClassificationSuggestion_MatchSource = 0
+	// The column name matched.
+	ClassificationSuggestion_COLUMN_NAME ClassificationSuggestion_MatchSource = 1
+	// The column comment matched.
+	ClassificationSuggestion_COLUMN_COMMENT ClassificationSuggestion_MatchSource = 2
+	// The sampled values matched.
+	ClassificationSuggestion_VALUE ClassificationSuggestion_MatchSource = 3
+)
+
+// Enum value maps for ClassificationSuggestion_MatchSource.
+var (
+	ClassificationSuggestion_MatchSource_name = map[int32]string{
+		0: "MATCH_SOURCE_UNSPECIFIED",
+		1: "COLUMN_NAME",
+		2: "COLUMN_COMMENT",
+		3: "VALUE",
+	}
+	ClassificationSuggestion_MatchSource_value = map[string]int32{
+		"MATCH_SOURCE_UNSPECIFIED": 0,
+		"COLUMN_NAME":              1,
+		"COLUMN_COMMENT":           2,
+		"VALUE":                    3,
+	}
+)
+
+func (x ClassificationSuggestion_MatchSource) Enum() *ClassificationSuggestion_MatchSource {
+	p := new(ClassificationSuggestion_MatchSource)
+	*p = x
+	return p
+}
+
+func (x ClassificationSuggestion_MatchSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ClassificationSuggestion_MatchSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_database_catalog_service_proto_enumTypes[2].Descriptor()
+}
+
+func (ClassificationSuggestion_MatchSource) Type() protoreflect.EnumType {
+	return &file_v1_database_catalog_service_proto_enumTypes[2]
+}
+
+func (x ClassificationSuggestion_MatchSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ClassificationSuggestion_MatchSource.Descriptor instead.
+func (ClassificationSuggestion_MatchSource) EnumDescriptor() ([]byte, []int) {
+	return file_v1_database_catalog_service_proto_rawDescGZIP(), []int{11, 0}
+}
+
+// The review state of a suggestion.
+type ClassificationSuggestion_State int32
+
+const (
+	// Unspecified state.
+	ClassificationSuggestion_STATE_UNSPECIFIED ClassificationSuggestion_State = 0
+	// Waiting for review.
+	ClassificationSuggestion_PENDING ClassificationSuggestion_State = 1
+	// Accepted and written to the catalog.
+	ClassificationSuggestion_ACCEPTED ClassificationSuggestion_State = 2
+	// Rejected.
+	ClassificationSuggestion_REJECTED ClassificationSuggestion_State = 3
+)
+
+// Enum value maps for ClassificationSuggestion_State.
+var (
+	ClassificationSuggestion_State_name = map[int32]string{
+		0: "STATE_UNSPECIFIED",
+		1: "PENDING",
+		2: "ACCEPTED",
+		3: "REJECTED",
+	}
+	ClassificationSuggestion_State_value = map[string]int32{
+		"STATE_UNSPECIFIED": 0,
+		"PENDING":           1,
+		"ACCEPTED":          2,
+		"REJECTED":          3,
+	}
+)
+
+func (x ClassificationSuggestion_State) Enum() *ClassificationSuggestion_State {
+	p := new(ClassificationSuggestion_State)
+	*p = x
+	return p
+}
+
+func (x ClassificationSuggestion_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ClassificationSuggestion_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_database_catalog_service_proto_enumTypes[3].Descriptor()
+}
+
+func (ClassificationSuggestion_State) Type() protoreflect.EnumType {
+	return &file_v1_database_catalog_service_proto_enumTypes[3]
+}
+
+func (x ClassificationSuggestion_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ClassificationSuggestion_State.Descriptor instead.
+func (ClassificationSuggestion_State) EnumDescriptor() ([]byte, []int) {
+	return file_v1_database_catalog_service_proto_rawDescGZIP(), []int{11, 1}
+}
+
+// The direction to traverse the lineage.
+type GetColumnLineageRequest_Direction int32
+
+const (
+	// Both upstream and downstream.
+	GetColumnLineageRequest_DIRECTION_UNSPECIFIED GetColumnLineageRequest_Direction = 0
+	// The columns that the column derives from.
+	GetColumnLineageRequest_UPSTREAM GetColumnLineageRequest_Direction = 1
+	// The columns derived from the column.
+	GetColumnLineageRequest_DOWNSTREAM GetColumnLineageRequest_Direction = 2
+)
+
+// Enum value maps for GetColumnLineageRequest_Direction.
+var (
+	GetColumnLineageRequest_Direction_name = map[int32]string{
+		0: "DIRECTION_UNSPECIFIED",
+		1: "UPSTREAM",
+		2: "DOWNSTREAM",
+	}
+	GetColumnLineageRequest_Direction_value = map[string]int32{
+		"DIRECTION_UNSPECIFIED": 0,
+		"UPSTREAM":              1,
+		"DOWNSTREAM":            2,
+	}
+)
+
+func (x GetColumnLineageRequest_Direction) Enum() *GetColumnLineageRequest_Direction {
+	p := new(GetColumnLineageRequest_Direction)
+	*p = x
+	return p
+}
+
+func (x GetColumnLineageRequest_Direction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetColumnLineageRequest_Direction) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_database_catalog_service_proto_enumTypes[4].Descriptor()
+}
+
+func (GetColumnLineageRequest_Direction) Type() protoreflect.EnumType {
+	return &file_v1_database_catalog_service_proto_enumTypes[4]
+}
+
+func (x GetColumnLineageRequest_Direction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetColumnLineageRequest_Direction.Descriptor instead.
+func (GetColumnLineageRequest_Direction) EnumDescriptor() ([]byte, []int) {
+	return file_v1_database_catalog_service_proto_rawDescGZIP(), []int{12, 0}
+}
+
+// Where the edge is recorded from.
+type ColumnLineageEdge_Origin int32
+
+const (
+	// Unspecified origin.
+	ColumnLineageEdge_ORIGIN_UNSPECIFIED ColumnLineageEdge_Origin = 0
+	// A statement executed by a rollout, e.g. INSERT ... SELECT or CREATE TABLE ... AS.
+	ColumnLineageEdge_CHANGE ColumnLineageEdge_Origin = 1
+	// A view definition synced from the database.
+	ColumnLineageEdge_VIEW ColumnLineageEdge_Origin = 2
+)
+
+// Enum value maps for ColumnLineageEdge_Origin.
+var (
+	ColumnLineageEdge_Origin_name = map[int32]string{
+		0: "ORIGIN_UNSPECIFIED",
+		1: "CHANGE",
+		2: "VIEW",
+	}
+	ColumnLineageEdge_Origin_value = map[string]int32{
+		"ORIGIN_UNSPECIFIED": 0,
+		"CHANGE":             1,
+		"VIEW":               2,
+	}
+)
+
+func (x ColumnLineageEdge_Origin) Enum() *ColumnLineageEdge_Origin {
+	p := new(ColumnLineageEdge_Origin)
+	*p = x
+	return p
+}
+
+func (x ColumnLineageEdge_Origin) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ColumnLineageEdge_Origin) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_database_catalog_service_proto_enumTypes[5].Descriptor()
+}
+
+func (ColumnLineageEdge_Origin) Type() protoreflect.EnumType {
+	return &file_v1_database_catalog_service_proto_enumTypes[5]
+}
+
+func (x ColumnLineageEdge_Origin) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ColumnLineageEdge_Origin.Descriptor instead.
+func (ColumnLineageEdge_Origin) EnumDescriptor() ([]byte, []int) {
+	return file_v1_database_catalog_service_proto_rawDescGZIP(), []int{15, 0}
+}
+
 // Request message for getting a database catalog.
 type GetDatabaseCatalogRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -592,29 +866,42 @@ func (*ObjectSchema_StructKind_) isObjectSchema_Kind() {}
 
 func (*ObjectSchema_ArrayKind_) isObjectSchema_Kind() {}
 
-// Column list for regular tables.
-type TableCatalog_Columns struct {
+// Request message for listing classification suggestions.
+type ListClassificationSuggestionsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The columns in the table.
-	Columns       []*ColumnCatalog `protobuf:"bytes,1,rep,name=columns,proto3" json:"columns,omitempty"`
+	// The parent database of the suggestions.
+	// Format: instances/{instance}/databases/{database}
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// The maximum number of suggestions to return. The service may return fewer
+	// than this value. If unspecified, at most 10 suggestions will be returned.
+	// The maximum value is 1000; values above 1000 will be coerced to 1000.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// A page token, received from the previous call.
+	// Provide this to retrieve the subsequent page.
+	//
+	// When paginating, all other parameters provided must match
+	// the call that provided the page token.
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Only return suggestions in this state. All states are returned if unspecified.
+	State         ClassificationSuggestion_State `protobuf:"varint,4,opt,name=state,proto3,enum=bytebase.v1.ClassificationSuggestion_State" json:"state,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TableCatalog_Columns) Reset() {
-	*x = TableCatalog_Columns{}
+func (x *ListClassificationSuggestionsRequest) Reset() {
+	*x = ListClassificationSuggestionsRequest{}
 	mi := &file_v1_database_catalog_service_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TableCatalog_Columns) String() string {
+func (x *ListClassificationSuggestionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TableCatalog_Columns) ProtoMessage() {}
+func (*ListClassificationSuggestionsRequest) ProtoMessage() {}
 
-func (x *TableCatalog_Columns) ProtoReflect() protoreflect.Message {
+func (x *ListClassificationSuggestionsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_database_catalog_service_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -626,41 +913,125 @@ func (x *TableCatalog_Columns) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TableCatalog_Columns.ProtoReflect.Descriptor instead.
-func (*TableCatalog_Columns) Descriptor() ([]byte, []int) {
-	return file_v1_database_catalog_service_proto_rawDescGZIP(), []int{4, 0}
+// Deprecated: Use ListClassificationSuggestionsRequest.ProtoReflect.Descriptor instead.
+func (*ListClassificationSuggestionsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_database_catalog_service_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *TableCatalog_Columns) GetColumns() []*ColumnCatalog {
+func (x *ListClassificationSuggestionsRequest) GetParent() string {
 	if x != nil {
-		return x.Columns
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *ListClassificationSuggestionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListClassificationSuggestionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListClassificationSuggestionsRequest) GetState() ClassificationSuggestion_State {
+	if x != nil {
+		return x.State
+	}
+	return ClassificationSuggestion_STATE_UNSPECIFIED
+}
+
+// Response message for listing classification suggestions.
+type ListClassificationSuggestionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The classification suggestions.
+	Suggestions []*ClassificationSuggestion `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	// A token to retrieve next page of results.
+	// Pass this value in the page_token field in the subsequent call
+	// to retrieve the next page of results.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListClassificationSuggestionsResponse) Reset() {
+	*x = ListClassificationSuggestionsResponse{}
+	mi := &file_v1_database_catalog_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListClassificationSuggestionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClassificationSuggestionsResponse) ProtoMessage() {}
+
+func (x *ListClassificationSuggestionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_database_catalog_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClassificationSuggestionsResponse.ProtoReflect.Descriptor instead.
+func (*ListClassificationSuggestionsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_database_catalog_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListClassificationSuggestionsResponse) GetSuggestions() []*ClassificationSuggestion {
+	if x != nil {
+		return x.Suggestions
 	}
 	return nil
 }
 
-// Structure type with named properties.
-type ObjectSchema_StructKind struct {
+func (x *ListClassificationSuggestionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Request message for reviewing classification suggestions.
+type BatchReviewClassificationSuggestionsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Properties of the struct.
-	Properties    map[string]*ObjectSchema `protobuf:"bytes,1,rep,name=properties,proto3" json:"properties,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// The parent database of the suggestions.
+	// Format: instances/{instance}/databases/{database}
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// The names of the pending suggestions to review.
+	// Format: instances/{instance}/databases/{database}/classificationSuggestions/{suggestion}
+	Names []string `protobuf:"bytes,2,rep,name=names,proto3" json:"names,omitempty"`
+	// The decision applied to all suggestions.
+	Decision      BatchReviewClassificationSuggestionsRequest_Decision `protobuf:"varint,3,opt,name=decision,proto3,enum=bytebase.v1.BatchReviewClassificationSuggestionsRequest_Decision" json:"decision,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ObjectSchema_StructKind) Reset() {
-	*x = ObjectSchema_StructKind{}
+func (x *BatchReviewClassificationSuggestionsRequest) Reset() {
+	*x = BatchReviewClassificationSuggestionsRequest{}
 	mi := &file_v1_database_catalog_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ObjectSchema_StructKind) String() string {
+func (x *BatchReviewClassificationSuggestionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ObjectSchema_StructKind) ProtoMessage() {}
+func (*BatchReviewClassificationSuggestionsRequest) ProtoMessage() {}
 
-func (x *ObjectSchema_StructKind) ProtoReflect() protoreflect.Message {
+func (x *BatchReviewClassificationSuggestionsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_database_catalog_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -672,41 +1043,55 @@ func (x *ObjectSchema_StructKind) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ObjectSchema_StructKind.ProtoReflect.Descriptor instead.
-func (*ObjectSchema_StructKind) Descriptor() ([]byte, []int) {
-	return file_v1_database_catalog_service_proto_rawDescGZIP(), []int{6, 0}
+// Deprecated: Use BatchReviewClassificationSuggestionsRequest.ProtoReflect.Descriptor instead.
+func (*BatchReviewClassificationSuggestionsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_database_catalog_service_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *ObjectSchema_StructKind) GetProperties() map[string]*ObjectSchema {
+func (x *BatchReviewClassificationSuggestionsRequest) GetParent() string {
 	if x != nil {
-		return x.Properties
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *BatchReviewClassificationSuggestionsRequest) GetNames() []string {
+	if x != nil {
+		return x.Names
 	}
 	return nil
 }
 
-// Array type with element schema.
-type ObjectSchema_ArrayKind struct {
+func (x *BatchReviewClassificationSuggestionsRequest) GetDecision() BatchReviewClassificationSuggestionsRequest_Decision {
+	if x != nil {
+		return x.Decision
+	}
+	return BatchReviewClassificationSuggestionsRequest_DECISION_UNSPECIFIED
+}
+
+// Response message for reviewing classification suggestions.
+type BatchReviewClassificationSuggestionsResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The schema of array elements.
-	Kind          *ObjectSchema `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// The reviewed suggestions.
+	Suggestions   []*ClassificationSuggestion `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ObjectSchema_ArrayKind) Reset() {
-	*x = ObjectSchema_ArrayKind{}
+func (x *BatchReviewClassificationSuggestionsResponse) Reset() {
+	*x = BatchReviewClassificationSuggestionsResponse{}
 	mi := &file_v1_database_catalog_service_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ObjectSchema_ArrayKind) String() string {
+func (x *BatchReviewClassificationSuggestionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ObjectSchema_ArrayKind) ProtoMessage() {}
+func (*BatchReviewClassificationSuggestionsResponse) ProtoMessage() {}
 
-func (x *ObjectSchema_ArrayKind) ProtoReflect() protoreflect.Message {
+func (x *BatchReviewClassificationSuggestionsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_database_catalog_service_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -718,45 +1103,817 @@ func (x *ObjectSchema_ArrayKind) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ObjectSchema_ArrayKind.ProtoReflect.Descriptor instead.
-func (*ObjectSchema_ArrayKind) Descriptor() ([]byte, []int) {
-	return file_v1_database_catalog_service_proto_rawDescGZIP(), []int{6, 1}
+// Deprecated: Use BatchReviewClassificationSuggestionsResponse.ProtoReflect.Descriptor instead.
+func (*BatchReviewClassificationSuggestionsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_database_catalog_service_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *ObjectSchema_ArrayKind) GetKind() *ObjectSchema {
+func (x *BatchReviewClassificationSuggestionsResponse) GetSuggestions() []*ClassificationSuggestion {
 	if x != nil {
-		return x.Kind
+		return x.Suggestions
 	}
 	return nil
 }
 
-var File_v1_database_catalog_service_proto protoreflect.FileDescriptor
+// A column classification proposed by sensitive data discovery.
+type ClassificationSuggestion struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the suggestion.
+	// Format: instances/{instance}/databases/{database}/classificationSuggestions/{suggestion}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The schema of the column.
+	Schema string `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
+	// The table of the column.
+	Table string `protobuf:"bytes,3,opt,name=table,proto3" json:"table,omitempty"`
+	// The column name.
+	Column string `protobuf:"bytes,4,opt,name=column,proto3" json:"column,omitempty"`
+	// The proposed classification id.
+	Classification string `protobuf:"bytes,5,opt,name=classification,proto3" json:"classification,omitempty"`
+	// The proposed semantic type id.
+	SemanticType string `protobuf:"bytes,6,opt,name=semantic_type,json=semanticType,proto3" json:"semantic_type,omitempty"`
+	// The id of the detector that matched the column.
+	Detector string `protobuf:"bytes,7,opt,name=detector,proto3" json:"detector,omitempty"`
+	// The column property matched by the detector.
+	MatchSource ClassificationSuggestion_MatchSource `protobuf:"varint,8,opt,name=match_source,json=matchSource,proto3,enum=bytebase.v1.ClassificationSuggestion_MatchSource" json:"match_source,omitempty"`
+	// The ratio of sampled values matching the detector, only set for VALUE matches.
+	MatchRatio float64 `protobuf:"fixed64,9,opt,name=match_ratio,json=matchRatio,proto3" json:"match_ratio,omitempty"`
+	// The number of non-empty sampled values, only set for VALUE matches.
+	SampleCount int32 `protobuf:"varint,10,opt,name=sample_count,json=sampleCount,proto3" json:"sample_count,omitempty"`
+	// The review state.
+	State ClassificationSuggestion_State `protobuf:"varint,11,opt,name=state,proto3,enum=bytebase.v1.ClassificationSuggestion_State" json:"state,omitempty"`
+	// The time the suggestion was proposed.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// The time the suggestion was last updated.
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_v1_database_catalog_service_proto_rawDesc = "" +
-	"\n" +
-	"!v1/database_catalog_service.proto\x12\vbytebase.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x13v1/annotation.proto\"U\n" +
-	"\x19GetDatabaseCatalogRequest\x128\n" +
-	"\x04name\x18\x01 \x01(\tB$\xe0A\x02\xfaA\x1e\n" +
-	"\x1cbytebase.com/DatabaseCatalogR\x04name\"\x80\x01\n" +
-	"\x1cUpdateDatabaseCatalogRequest\x12;\n" +
-	"\acatalog\x18\x01 \x01(\v2\x1c.bytebase.v1.DatabaseCatalogB\x03\xe0A\x02R\acatalog\x12#\n" +
-	"\rallow_missing\x18\x02 \x01(\bR\fallowMissing\"\xb1\x01\n" +
-	"\x0fDatabaseCatalog\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x124\n" +
-	"\aschemas\x18\x02 \x03(\v2\x1a.bytebase.v1.SchemaCatalogR\aschemas:T\xeaAQ\n" +
-	"\x1cbytebase.com/DatabaseCatalog\x121instances/{instance}/databases/{database}/catalog\"V\n" +
-	"\rSchemaCatalog\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x121\n" +
-	"\x06tables\x18\x02 \x03(\v2\x19.bytebase.v1.TableCatalogR\x06tables\"\x94\x02\n" +
-	"\fTableCatalog\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12=\n" +
-	"\acolumns\x18\x02 \x01(\v2!.bytebase.v1.TableCatalog.ColumnsH\x00R\acolumns\x12@\n" +
-	"\robject_schema\x18\x03 \x01(\v2\x19.bytebase.v1.ObjectSchemaH\x00R\fobjectSchema\x12&\n" +
-	"\x0eclassification\x18\x04 \x01(\tR\x0eclassification\x1a?\n" +
-	"\aColumns\x124\n" +
-	"\acolumns\x18\x01 \x03(\v2\x1a.bytebase.v1.ColumnCatalogR\acolumnsB\x06\n" +
-	"\x04kind\"\xc2\x02\n" +
-	"\rColumnCatalog\x12\x12\n" +
+func (x *ClassificationSuggestion) Reset() {
+	*x = ClassificationSuggestion{}
+	mi := &file_v1_database_catalog_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClassificationSuggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClassificationSuggestion) ProtoMessage() {}
+
+func (x *ClassificationSuggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_database_catalog_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClassificationSuggestion.ProtoReflect.Descriptor instead.
+func (*ClassificationSuggestion) Descriptor() ([]byte, []int) {
+	return file_v1_database_catalog_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ClassificationSuggestion) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ClassificationSuggestion) GetSchema() string {
+	if x != nil {
+		return x.Schema
+	}
+	return ""
+}
+
+func (x *ClassificationSuggestion) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *ClassificationSuggestion) GetColumn() string {
+	if x != nil {
+		return x.Column
+	}
+	return ""
+}
+
+func (x *ClassificationSuggestion) GetClassification() string {
+	if x != nil {
+		return x.Classification
+	}
+	return ""
+}
+
+func (x *ClassificationSuggestion) GetSemanticType() string {
+	if x != nil {
+		return x.SemanticType
+	}
+	return ""
+}
+
+func (x *ClassificationSuggestion) GetDetector() string {
+	if x != nil {
+		return x.Detector
+	}
+	return ""
+}
+
+func (x *ClassificationSuggestion) GetMatchSource() ClassificationSuggestion_MatchSource {
+	if x != nil {
+		return x.MatchSource
+	}
+	return ClassificationSuggestion_MATCH_SOURCE_UNSPECIFIED
+}
+
+func (x *ClassificationSuggestion) GetMatchRatio() float64 {
+	if x != nil {
+		return x.MatchRatio
+	}
+	return 0
+}
+
+func (x *ClassificationSuggestion) GetSampleCount() int32 {
+	if x != nil {
+		return x.SampleCount
+	}
+	return 0
+}
+
+func (x *ClassificationSuggestion) GetState() ClassificationSuggestion_State {
+	if x != nil {
+		return x.State
+	}
+	return ClassificationSuggestion_STATE_UNSPECIFIED
+}
+
+func (x *ClassificationSuggestion) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *ClassificationSuggestion) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+// Request message for getting the column lineage.
+type GetColumnLineageRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The database of the column.
+	// Format: instances/{instance}/databases/{database}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The schema of the column. Empty for engines without schemas.
+	Schema string `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
+	// The table or view of the column.
+	Table string `protobuf:"bytes,3,opt,name=table,proto3" json:"table,omitempty"`
+	// The column name.
+	Column    string                            `protobuf:"bytes,4,opt,name=column,proto3" json:"column,omitempty"`
+	Direction GetColumnLineageRequest_Direction `protobuf:"varint,5,opt,name=direction,proto3,enum=bytebase.v1.GetColumnLineageRequest_Direction" json:"direction,omitempty"`
+	// The maximum number of hops to traverse.
+	// If unspecified, at most 5 hops will be traversed. The maximum value is 20.
+	Depth         int32 `protobuf:"varint,6,opt,name=depth,proto3" json:"depth,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetColumnLineageRequest) Reset() {
+	*x = GetColumnLineageRequest{}
+	mi := &file_v1_database_catalog_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetColumnLineageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetColumnLineageRequest) ProtoMessage() {}
+
+func (x *GetColumnLineageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_database_catalog_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetColumnLineageRequest.ProtoReflect.Descriptor instead.
+func (*GetColumnLineageRequest) Descriptor() ([]byte, []int) {
+	return file_v1_database_catalog_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetColumnLineageRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetColumnLineageRequest) GetSchema() string {
+	if x != nil {
+		return x.Schema
+	}
+	return ""
+}
+
+func (x *GetColumnLineageRequest) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *GetColumnLineageRequest) GetColumn() string {
+	if x != nil {
+		return x.Column
+	}
+	return ""
+}
+
+func (x *GetColumnLineageRequest) GetDirection() GetColumnLineageRequest_Direction {
+	if x != nil {
+		return x.Direction
+	}
+	return GetColumnLineageRequest_DIRECTION_UNSPECIFIED
+}
+
+func (x *GetColumnLineageRequest) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+// The column-level lineage graph.
+type ColumnLineage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The columns in the graph. The first node is the requested column.
+	Nodes []*ColumnLineageNode `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	// The edges from the source columns to the target columns.
+	Edges         []*ColumnLineageEdge `protobuf:"bytes,2,rep,name=edges,proto3" json:"edges,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ColumnLineage) Reset() {
+	*x = ColumnLineage{}
+	mi := &file_v1_database_catalog_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ColumnLineage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColumnLineage) ProtoMessage() {}
+
+func (x *ColumnLineage) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_database_catalog_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColumnLineage.ProtoReflect.Descriptor instead.
+func (*ColumnLineage) Descriptor() ([]byte, []int) {
+	return file_v1_database_catalog_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ColumnLineage) GetNodes() []*ColumnLineageNode {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *ColumnLineage) GetEdges() []*ColumnLineageEdge {
+	if x != nil {
+		return x.Edges
+	}
+	return nil
+}
+
+// A column in the lineage graph.
+type ColumnLineageNode struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The database of the column.
+	// Format: instances/{instance}/databases/{database}
+	Database string `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	// The schema of the column.
+	Schema string `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
+	// The table or view of the column.
+	Table string `protobuf:"bytes,3,opt,name=table,proto3" json:"table,omitempty"`
+	// The column name.
+	Column string `protobuf:"bytes,4,opt,name=column,proto3" json:"column,omitempty"`
+	// The data classification level of the column in the database catalog.
+	Classification string `protobuf:"bytes,5,opt,name=classification,proto3" json:"classification,omitempty"`
+	// The semantic type of the column in the database catalog.
+	SemanticType  string `protobuf:"bytes,6,opt,name=semantic_type,json=semanticType,proto3" json:"semantic_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ColumnLineageNode) Reset() {
+	*x = ColumnLineageNode{}
+	mi := &file_v1_database_catalog_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ColumnLineageNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColumnLineageNode) ProtoMessage() {}
+
+func (x *ColumnLineageNode) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_database_catalog_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColumnLineageNode.ProtoReflect.Descriptor instead.
+func (*ColumnLineageNode) Descriptor() ([]byte, []int) {
+	return file_v1_database_catalog_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ColumnLineageNode) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+func (x *ColumnLineageNode) GetSchema() string {
+	if x != nil {
+		return x.Schema
+	}
+	return ""
+}
+
+func (x *ColumnLineageNode) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *ColumnLineageNode) GetColumn() string {
+	if x != nil {
+		return x.Column
+	}
+	return ""
+}
+
+func (x *ColumnLineageNode) GetClassification() string {
+	if x != nil {
+		return x.Classification
+	}
+	return ""
+}
+
+func (x *ColumnLineageNode) GetSemanticType() string {
+	if x != nil {
+		return x.SemanticType
+	}
+	return ""
+}
+
+// An edge in the lineage graph meaning the target column derives from the source column.
+type ColumnLineageEdge struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The index of the source column in the nodes.
+	Source int32 `protobuf:"varint,1,opt,name=source,proto3" json:"source,omitempty"`
+	// The index of the target column in the nodes.
+	Target int32                    `protobuf:"varint,2,opt,name=target,proto3" json:"target,omitempty"`
+	Origin ColumnLineageEdge_Origin `protobuf:"varint,3,opt,name=origin,proto3,enum=bytebase.v1.ColumnLineageEdge_Origin" json:"origin,omitempty"`
+	// The changelog of the change that recorded the edge, only set for the CHANGE origin.
+	// Format: instances/{instance}/databases/{database}/changelogs/{changelog}
+	Changelog string `protobuf:"bytes,4,opt,name=changelog,proto3" json:"changelog,omitempty"`
+	// The last time the edge was recorded.
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ColumnLineageEdge) Reset() {
+	*x = ColumnLineageEdge{}
+	mi := &file_v1_database_catalog_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ColumnLineageEdge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColumnLineageEdge) ProtoMessage() {}
+
+func (x *ColumnLineageEdge) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_database_catalog_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColumnLineageEdge.ProtoReflect.Descriptor instead.
+func (*ColumnLineageEdge) Descriptor() ([]byte, []int) {
+	return file_v1_database_catalog_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ColumnLineageEdge) GetSource() int32 {
+	if x != nil {
+		return x.Source
+	}
+	return 0
+}
+
+func (x *ColumnLineageEdge) GetTarget() int32 {
+	if x != nil {
+		return x.Target
+	}
+	return 0
+}
+
+func (x *ColumnLineageEdge) GetOrigin() ColumnLineageEdge_Origin {
+	if x != nil {
+		return x.Origin
+	}
+	return ColumnLineageEdge_ORIGIN_UNSPECIFIED
+}
+
+func (x *ColumnLineageEdge) GetChangelog() string {
+	if x != nil {
+		return x.Changelog
+	}
+	return ""
+}
+
+func (x *ColumnLineageEdge) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+// Request message for propagating a column classification.
+type PropagateColumnClassificationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The database of the source column.
+	// Format: instances/{instance}/databases/{database}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The schema of the source column. Empty for engines without schemas.
+	Schema string `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
+	// The table or view of the source column.
+	Table string `protobuf:"bytes,3,opt,name=table,proto3" json:"table,omitempty"`
+	// The source column name. The column must have a classification in the database catalog.
+	Column string `protobuf:"bytes,4,opt,name=column,proto3" json:"column,omitempty"`
+	// The maximum number of hops to propagate.
+	// If unspecified, at most 5 hops will be propagated. The maximum value is 20.
+	Depth int32 `protobuf:"varint,5,opt,name=depth,proto3" json:"depth,omitempty"`
+	// If true, the existing classifications of the downstream columns are replaced.
+	// Otherwise, only the columns without classification are updated.
+	Overwrite bool `protobuf:"varint,6,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
+	// If true, the columns to update are returned without updating the database catalogs.
+	ValidateOnly  bool `protobuf:"varint,7,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PropagateColumnClassificationRequest) Reset() {
+	*x = PropagateColumnClassificationRequest{}
+	mi := &file_v1_database_catalog_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PropagateColumnClassificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PropagateColumnClassificationRequest) ProtoMessage() {}
+
+func (x *PropagateColumnClassificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_database_catalog_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PropagateColumnClassificationRequest.ProtoReflect.Descriptor instead.
+func (*PropagateColumnClassificationRequest) Descriptor() ([]byte, []int) {
+	return file_v1_database_catalog_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *PropagateColumnClassificationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PropagateColumnClassificationRequest) GetSchema() string {
+	if x != nil {
+		return x.Schema
+	}
+	return ""
+}
+
+func (x *PropagateColumnClassificationRequest) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *PropagateColumnClassificationRequest) GetColumn() string {
+	if x != nil {
+		return x.Column
+	}
+	return ""
+}
+
+func (x *PropagateColumnClassificationRequest) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *PropagateColumnClassificationRequest) GetOverwrite() bool {
+	if x != nil {
+		return x.Overwrite
+	}
+	return false
+}
+
+func (x *PropagateColumnClassificationRequest) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
+// Response message for propagating a column classification.
+type PropagateColumnClassificationResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The propagated classification.
+	Classification string `protobuf:"bytes,1,opt,name=classification,proto3" json:"classification,omitempty"`
+	// The downstream columns updated with the classification.
+	UpdatedColumns []*ColumnLineageNode `protobuf:"bytes,2,rep,name=updated_columns,json=updatedColumns,proto3" json:"updated_columns,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PropagateColumnClassificationResponse) Reset() {
+	*x = PropagateColumnClassificationResponse{}
+	mi := &file_v1_database_catalog_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PropagateColumnClassificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PropagateColumnClassificationResponse) ProtoMessage() {}
+
+func (x *PropagateColumnClassificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_database_catalog_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PropagateColumnClassificationResponse.ProtoReflect.Descriptor instead.
+func (*PropagateColumnClassificationResponse) Descriptor() ([]byte, []int) {
+	return file_v1_database_catalog_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *PropagateColumnClassificationResponse) GetClassification() string {
+	if x != nil {
+		return x.Classification
+	}
+	return ""
+}
+
+func (x *PropagateColumnClassificationResponse) GetUpdatedColumns() []*ColumnLineageNode {
+	if x != nil {
+		return x.UpdatedColumns
+	}
+	return nil
+}
+
+// Column list for regular tables.
+type TableCatalog_Columns struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The columns in the table.
+	Columns       []*ColumnCatalog `protobuf:"bytes,1,rep,name=columns,proto3" json:"columns,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TableCatalog_Columns) Reset() {
+	*x = TableCatalog_Columns{}
+	mi := &file_v1_database_catalog_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TableCatalog_Columns) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TableCatalog_Columns) ProtoMessage() {}
+
+func (x *TableCatalog_Columns) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_database_catalog_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TableCatalog_Columns.ProtoReflect.Descriptor instead.
+func (*TableCatalog_Columns) Descriptor() ([]byte, []int) {
+	return file_v1_database_catalog_service_proto_rawDescGZIP(), []int{4, 0}
+}
+
+func (x *TableCatalog_Columns) GetColumns() []*ColumnCatalog {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+// Structure type with named properties.
+type ObjectSchema_StructKind struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Properties of the struct.
+	Properties    map[string]*ObjectSchema `protobuf:"bytes,1,rep,name=properties,proto3" json:"properties,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ObjectSchema_StructKind) Reset() {
+	*x = ObjectSchema_StructKind{}
+	mi := &file_v1_database_catalog_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ObjectSchema_StructKind) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ObjectSchema_StructKind) ProtoMessage() {}
+
+func (x *ObjectSchema_StructKind) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_database_catalog_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ObjectSchema_StructKind.ProtoReflect.Descriptor instead.
+func (*ObjectSchema_StructKind) Descriptor() ([]byte, []int) {
+	return file_v1_database_catalog_service_proto_rawDescGZIP(), []int{6, 0}
+}
+
+func (x *ObjectSchema_StructKind) GetProperties() map[string]*ObjectSchema {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+// Array type with element schema.
+type ObjectSchema_ArrayKind struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The schema of array elements.
+	Kind          *ObjectSchema `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ObjectSchema_ArrayKind) Reset() {
+	*x = ObjectSchema_ArrayKind{}
+	mi := &file_v1_database_catalog_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ObjectSchema_ArrayKind) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ObjectSchema_ArrayKind) ProtoMessage() {}
+
+func (x *ObjectSchema_ArrayKind) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_database_catalog_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ObjectSchema_ArrayKind.ProtoReflect.Descriptor instead.
+func (*ObjectSchema_ArrayKind) Descriptor() ([]byte, []int) {
+	return file_v1_database_catalog_service_proto_rawDescGZIP(), []int{6, 1}
+}
+
+func (x *ObjectSchema_ArrayKind) GetKind() *ObjectSchema {
+	if x != nil {
+		return x.Kind
+	}
+	return nil
+}
+
+var File_v1_database_catalog_service_proto protoreflect.FileDescriptor
+
+const file_v1_database_catalog_service_proto_rawDesc = "" +
+	"\n" +
+	"!v1/database_catalog_service.proto\x12\vbytebase.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x13v1/annotation.proto\"U\n" +
+	"\x19GetDatabaseCatalogRequest\x128\n" +
+	"\x04name\x18\x01 \x01(\tB$\xe0A\x02\xfaA\x1e\n" +
+	"\x1cbytebase.com/DatabaseCatalogR\x04name\"\x80\x01\n" +
+	"\x1cUpdateDatabaseCatalogRequest\x12;\n" +
+	"\acatalog\x18\x01 \x01(\v2\x1c.bytebase.v1.DatabaseCatalogB\x03\xe0A\x02R\acatalog\x12#\n" +
+	"\rallow_missing\x18\x02 \x01(\bR\fallowMissing\"\xb1\x01\n" +
+	"\x0fDatabaseCatalog\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x124\n" +
+	"\aschemas\x18\x02 \x03(\v2\x1a.bytebase.v1.SchemaCatalogR\aschemas:T\xeaAQ\n" +
+	"\x1cbytebase.com/DatabaseCatalog\x121instances/{instance}/databases/{database}/catalog\"V\n" +
+	"\rSchemaCatalog\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x121\n" +
+	"\x06tables\x18\x02 \x03(\v2\x19.bytebase.v1.TableCatalogR\x06tables\"\x94\x02\n" +
+	"\fTableCatalog\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12=\n" +
+	"\acolumns\x18\x02 \x01(\v2!.bytebase.v1.TableCatalog.ColumnsH\x00R\acolumns\x12@\n" +
+	"\robject_schema\x18\x03 \x01(\v2\x19.bytebase.v1.ObjectSchemaH\x00R\fobjectSchema\x12&\n" +
+	"\x0eclassification\x18\x04 \x01(\tR\x0eclassification\x1a?\n" +
+	"\aColumns\x124\n" +
+	"\acolumns\x18\x01 \x03(\v2\x1a.bytebase.v1.ColumnCatalogR\acolumnsB\x06\n" +
+	"\x04kind\"\xc2\x02\n" +
+	"\rColumnCatalog\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12#\n" +
 	"\rsemantic_type\x18\x02 \x01(\tR\fsemanticType\x12>\n" +
 	"\x06labels\x18\x03 \x03(\v2&.bytebase.v1.ColumnCatalog.LabelsEntryR\x06labels\x12&\n" +
@@ -793,10 +1950,114 @@ const file_v1_database_catalog_service_proto_rawDesc = "" +
 	"\n" +
 	"\x06OBJECT\x10\x04\x12\t\n" +
 	"\x05ARRAY\x10\x05B\x06\n" +
-	"\x04kind2\xb3\x03\n" +
+	"\x04kind\"\xdc\x01\n" +
+	"$ListClassificationSuggestionsRequest\x125\n" +
+	"\x06parent\x18\x01 \x01(\tB\x1d\xe0A\x02\xfaA\x17\n" +
+	"\x15bytebase.com/DatabaseR\x06parent\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12A\n" +
+	"\x05state\x18\x04 \x01(\x0e2+.bytebase.v1.ClassificationSuggestion.StateR\x05state\"\x98\x01\n" +
+	"%ListClassificationSuggestionsResponse\x12G\n" +
+	"\vsuggestions\x18\x01 \x03(\v2%.bytebase.v1.ClassificationSuggestionR\vsuggestions\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xa1\x02\n" +
+	"+BatchReviewClassificationSuggestionsRequest\x125\n" +
+	"\x06parent\x18\x01 \x01(\tB\x1d\xe0A\x02\xfaA\x17\n" +
+	"\x15bytebase.com/DatabaseR\x06parent\x12\x19\n" +
+	"\x05names\x18\x02 \x03(\tB\x03\xe0A\x02R\x05names\x12b\n" +
+	"\bdecision\x18\x03 \x01(\x0e2A.bytebase.v1.BatchReviewClassificationSuggestionsRequest.DecisionB\x03\xe0A\x02R\bdecision\"<\n" +
+	"\bDecision\x12\x18\n" +
+	"\x14DECISION_UNSPECIFIED\x10\x00\x12\n" +
+	"\n" +
+	"\x06ACCEPT\x10\x01\x12\n" +
+	"\n" +
+	"\x06REJECT\x10\x02\"w\n" +
+	",BatchReviewClassificationSuggestionsResponse\x12G\n" +
+	"\vsuggestions\x18\x01 \x03(\v2%.bytebase.v1.ClassificationSuggestionR\vsuggestions\"\xd8\x06\n" +
+	"\x18ClassificationSuggestion\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06schema\x18\x02 \x01(\tR\x06schema\x12\x14\n" +
+	"\x05table\x18\x03 \x01(\tR\x05table\x12\x16\n" +
+	"\x06column\x18\x04 \x01(\tR\x06column\x12&\n" +
+	"\x0eclassification\x18\x05 \x01(\tR\x0eclassification\x12#\n" +
+	"\rsemantic_type\x18\x06 \x01(\tR\fsemanticType\x12\x1a\n" +
+	"\bdetector\x18\a \x01(\tR\bdetector\x12T\n" +
+	"\fmatch_source\x18\b \x01(\x0e21.bytebase.v1.ClassificationSuggestion.MatchSourceR\vmatchSource\x12\x1f\n" +
+	"\vmatch_ratio\x18\t \x01(\x01R\n" +
+	"matchRatio\x12!\n" +
+	"\fsample_count\x18\n" +
+	" \x01(\x05R\vsampleCount\x12A\n" +
+	"\x05state\x18\v \x01(\x0e2+.bytebase.v1.ClassificationSuggestion.StateR\x05state\x12;\n" +
+	"\vcreate_time\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12;\n" +
+	"\vupdate_time\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\"[\n" +
+	"\vMatchSource\x12\x1c\n" +
+	"\x18MATCH_SOURCE_UNSPECIFIED\x10\x00\x12\x0f\n" +
+	"\vCOLUMN_NAME\x10\x01\x12\x12\n" +
+	"\x0eCOLUMN_COMMENT\x10\x02\x12\t\n" +
+	"\x05VALUE\x10\x03\"G\n" +
+	"\x05State\x12\x15\n" +
+	"\x11STATE_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aPENDING\x10\x01\x12\f\n" +
+	"\bACCEPTED\x10\x02\x12\f\n" +
+	"\bREJECTED\x10\x03:|\xeaAy\n" +
+	"%bytebase.com/ClassificationSuggestion\x12Pinstances/{instance}/databases/{database}/classificationSuggestions/{suggestion}\"\xc6\x02\n" +
+	"\x17GetColumnLineageRequest\x121\n" +
+	"\x04name\x18\x01 \x01(\tB\x1d\xe0A\x02\xfaA\x17\n" +
+	"\x15bytebase.com/DatabaseR\x04name\x12\x16\n" +
+	"\x06schema\x18\x02 \x01(\tR\x06schema\x12\x19\n" +
+	"\x05table\x18\x03 \x01(\tB\x03\xe0A\x02R\x05table\x12\x1b\n" +
+	"\x06column\x18\x04 \x01(\tB\x03\xe0A\x02R\x06column\x12L\n" +
+	"\tdirection\x18\x05 \x01(\x0e2..bytebase.v1.GetColumnLineageRequest.DirectionR\tdirection\x12\x14\n" +
+	"\x05depth\x18\x06 \x01(\x05R\x05depth\"D\n" +
+	"\tDirection\x12\x19\n" +
+	"\x15DIRECTION_UNSPECIFIED\x10\x00\x12\f\n" +
+	"\bUPSTREAM\x10\x01\x12\x0e\n" +
+	"\n" +
+	"DOWNSTREAM\x10\x02\"{\n" +
+	"\rColumnLineage\x124\n" +
+	"\x05nodes\x18\x01 \x03(\v2\x1e.bytebase.v1.ColumnLineageNodeR\x05nodes\x124\n" +
+	"\x05edges\x18\x02 \x03(\v2\x1e.bytebase.v1.ColumnLineageEdgeR\x05edges\"\xc2\x01\n" +
+	"\x11ColumnLineageNode\x12\x1a\n" +
+	"\bdatabase\x18\x01 \x01(\tR\bdatabase\x12\x16\n" +
+	"\x06schema\x18\x02 \x01(\tR\x06schema\x12\x14\n" +
+	"\x05table\x18\x03 \x01(\tR\x05table\x12\x16\n" +
+	"\x06column\x18\x04 \x01(\tR\x06column\x12&\n" +
+	"\x0eclassification\x18\x05 \x01(\tR\x0eclassification\x12#\n" +
+	"\rsemantic_type\x18\x06 \x01(\tR\fsemanticType\"\x95\x02\n" +
+	"\x11ColumnLineageEdge\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\x05R\x06source\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\x05R\x06target\x12=\n" +
+	"\x06origin\x18\x03 \x01(\x0e2%.bytebase.v1.ColumnLineageEdge.OriginR\x06origin\x12\x1c\n" +
+	"\tchangelog\x18\x04 \x01(\tR\tchangelog\x12;\n" +
+	"\vupdate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\"6\n" +
+	"\x06Origin\x12\x16\n" +
+	"\x12ORIGIN_UNSPECIFIED\x10\x00\x12\n" +
+	"\n" +
+	"\x06CHANGE\x10\x01\x12\b\n" +
+	"\x04VIEW\x10\x02\"\x82\x02\n" +
+	"$PropagateColumnClassificationRequest\x121\n" +
+	"\x04name\x18\x01 \x01(\tB\x1d\xe0A\x02\xfaA\x17\n" +
+	"\x15bytebase.com/DatabaseR\x04name\x12\x16\n" +
+	"\x06schema\x18\x02 \x01(\tR\x06schema\x12\x19\n" +
+	"\x05table\x18\x03 \x01(\tB\x03\xe0A\x02R\x05table\x12\x1b\n" +
+	"\x06column\x18\x04 \x01(\tB\x03\xe0A\x02R\x06column\x12\x14\n" +
+	"\x05depth\x18\x05 \x01(\x05R\x05depth\x12\x1c\n" +
+	"\toverwrite\x18\x06 \x01(\bR\toverwrite\x12#\n" +
+	"\rvalidate_only\x18\a \x01(\bR\fvalidateOnly\"\x98\x01\n" +
+	"%PropagateColumnClassificationResponse\x12&\n" +
+	"\x0eclassification\x18\x01 \x01(\tR\x0eclassification\x12G\n" +
+	"\x0fupdated_columns\x18\x02 \x03(\v2\x1e.bytebase.v1.ColumnLineageNodeR\x0eupdatedColumns2\xfd\n" +
+	"\n" +
 	"\x16DatabaseCatalogService\x12\xb4\x01\n" +
 	"\x12GetDatabaseCatalog\x12&.bytebase.v1.GetDatabaseCatalogRequest\x1a\x1c.bytebase.v1.DatabaseCatalog\"X\xdaA\x04name\x8a\xea0\x17bb.databaseCatalogs.get\x90\xea0\x01\x82\xd3\xe4\x93\x02,\x12*/v1/{name=instances/*/databases/*/catalog}\x12\xe1\x01\n" +
-	"\x15UpdateDatabaseCatalog\x12).bytebase.v1.UpdateDatabaseCatalogRequest\x1a\x1c.bytebase.v1.DatabaseCatalog\"\x7f\xdaA\x13catalog,update_mask\x8a\xea0\x1abb.databaseCatalogs.update\x90\xea0\x01\x98\xea0\x01\x82\xd3\xe4\x93\x02=:\acatalog22/v1/{catalog.name=instances/*/databases/*/catalog}B\xb1\x01\n" +
+	"\x15UpdateDatabaseCatalog\x12).bytebase.v1.UpdateDatabaseCatalogRequest\x1a\x1c.bytebase.v1.DatabaseCatalog\"\x7f\xdaA\x13catalog,update_mask\x8a\xea0\x1abb.databaseCatalogs.update\x90\xea0\x01\x98\xea0\x01\x82\xd3\xe4\x93\x02=:\acatalog22/v1/{catalog.name=instances/*/databases/*/catalog}\x12\xf6\x01\n" +
+	"\x1dListClassificationSuggestions\x121.bytebase.v1.ListClassificationSuggestionsRequest\x1a2.bytebase.v1.ListClassificationSuggestionsResponse\"n\xdaA\x06parent\x8a\xea0\x17bb.databaseCatalogs.get\x90\xea0\x01\x82\xd3\xe4\x93\x02@\x12>/v1/{parent=instances/*/databases/*}/classificationSuggestions\x12\x98\x02\n" +
+	"$BatchReviewClassificationSuggestions\x128.bytebase.v1.BatchReviewClassificationSuggestionsRequest\x1a9.bytebase.v1.BatchReviewClassificationSuggestionsResponse\"{\x8a\xea0\x1abb.databaseCatalogs.update\x90\xea0\x01\x98\xea0\x01\x82\xd3\xe4\x93\x02O:\x01*\"J/v1/{parent=instances/*/databases/*}/classificationSuggestions:batchReview\x12\xb7\x01\n" +
+	"\x10GetColumnLineage\x12$.bytebase.v1.GetColumnLineageRequest\x1a\x1a.bytebase.v1.ColumnLineage\"a\xdaA\x04name\x8a\xea0\x17bb.databaseCatalogs.get\x90\xea0\x01\x82\xd3\xe4\x93\x025\x123/v1/{name=instances/*/databases/*}:getColumnLineage\x12\xf9\x01\n" +
+	"\x1dPropagateColumnClassification\x121.bytebase.v1.PropagateColumnClassificationRequest\x1a2.bytebase.v1.PropagateColumnClassificationResponse\"q\x8a\xea0\x1abb.databaseCatalogs.update\x90\xea0\x01\x98\xea0\x01\x82\xd3\xe4\x93\x02E:\x01*\"@/v1/{name=instances/*/databases/*}:propagateColumnClassificationB\xb1\x01\n" +
 	"\x0fcom.bytebase.v1B\x1bDatabaseCatalogServiceProtoP\x01Z4github.com/bytebase/bytebase/backend/generated-go/v1\xa2\x02\x03BXX\xaa\x02\vBytebase.V1\xca\x02\vBytebase\\V1\xe2\x02\x17Bytebase\\V1\\GPBMetadata\xea\x02\fBytebase::V1b\x06proto3"
 
 var (
@@ -811,47 +2072,86 @@ func file_v1_database_catalog_service_proto_rawDescGZIP() []byte {
 	return file_v1_database_catalog_service_proto_rawDescData
 }
 
-var file_v1_database_catalog_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_v1_database_catalog_service_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_v1_database_catalog_service_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_v1_database_catalog_service_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_v1_database_catalog_service_proto_goTypes = []any{
-	(ObjectSchema_Type)(0),               // 0: bytebase.v1.ObjectSchema.Type
-	(*GetDatabaseCatalogRequest)(nil),    // 1: bytebase.v1.GetDatabaseCatalogRequest
-	(*UpdateDatabaseCatalogRequest)(nil), // 2: bytebase.v1.UpdateDatabaseCatalogRequest
-	(*DatabaseCatalog)(nil),              // 3: bytebase.v1.DatabaseCatalog
-	(*SchemaCatalog)(nil),                // 4: bytebase.v1.SchemaCatalog
-	(*TableCatalog)(nil),                 // 5: bytebase.v1.TableCatalog
-	(*ColumnCatalog)(nil),                // 6: bytebase.v1.ColumnCatalog
-	(*ObjectSchema)(nil),                 // 7: bytebase.v1.ObjectSchema
-	(*TableCatalog_Columns)(nil),         // 8: bytebase.v1.TableCatalog.Columns
-	nil,                                  // 9: bytebase.v1.ColumnCatalog.LabelsEntry
-	(*ObjectSchema_StructKind)(nil),      // 10: bytebase.v1.ObjectSchema.StructKind
-	(*ObjectSchema_ArrayKind)(nil),       // 11: bytebase.v1.ObjectSchema.ArrayKind
-	nil,                                  // 12: bytebase.v1.ObjectSchema.StructKind.PropertiesEntry
+	(ObjectSchema_Type)(0), // 0: bytebase.v1.ObjectSchema.Type
+	(BatchReviewClassificationSuggestionsRequest_Decision)(0), // 1: bytebase.v1.BatchReviewClassificationSuggestionsRequest.Decision
+	(ClassificationSuggestion_MatchSource)(0),                 // 2: bytebase.v1.ClassificationSuggestion.MatchSource
+	(ClassificationSuggestion_State)(0),                       // 3: bytebase.v1.ClassificationSuggestion.State
+	(GetColumnLineageRequest_Direction)(0),                    // 4: bytebase.v1.GetColumnLineageRequest.Direction
+	(ColumnLineageEdge_Origin)(0),                             // 5: bytebase.v1.ColumnLineageEdge.Origin
+	(*GetDatabaseCatalogRequest)(nil),                         // 6: bytebase.v1.GetDatabaseCatalogRequest
+	(*UpdateDatabaseCatalogRequest)(nil),                      // 7: bytebase.v1.UpdateDatabaseCatalogRequest
+	(*DatabaseCatalog)(nil),                                   // 8: bytebase.v1.DatabaseCatalog
+	(*SchemaCatalog)(nil),                                     // 9: bytebase.v1.SchemaCatalog
+	(*TableCatalog)(nil),                                      // 10: bytebase.v1.TableCatalog
+	(*ColumnCatalog)(nil),                                     // 11: bytebase.v1.ColumnCatalog
+	(*ObjectSchema)(nil),                                      // 12: bytebase.v1.ObjectSchema
+	(*ListClassificationSuggestionsRequest)(nil),              // 13: bytebase.v1.ListClassificationSuggestionsRequest
+	(*ListClassificationSuggestionsResponse)(nil),             // 14: bytebase.v1.ListClassificationSuggestionsResponse
+	(*BatchReviewClassificationSuggestionsRequest)(nil),       // 15: bytebase.v1.BatchReviewClassificationSuggestionsRequest
+	(*BatchReviewClassificationSuggestionsResponse)(nil),      // 16: bytebase.v1.BatchReviewClassificationSuggestionsResponse
+	(*ClassificationSuggestion)(nil),                          // 17: bytebase.v1.ClassificationSuggestion
+	(*GetColumnLineageRequest)(nil),                           // 18: bytebase.v1.GetColumnLineageRequest
+	(*ColumnLineage)(nil),                                     // 19: bytebase.v1.ColumnLineage
+	(*ColumnLineageNode)(nil),                                 // 20: bytebase.v1.ColumnLineageNode
+	(*ColumnLineageEdge)(nil),                                 // 21: bytebase.v1.ColumnLineageEdge
+	(*PropagateColumnClassificationRequest)(nil),              // 22: bytebase.v1.PropagateColumnClassificationRequest
+	(*PropagateColumnClassificationResponse)(nil),             // 23: bytebase.v1.PropagateColumnClassificationResponse
+	(*TableCatalog_Columns)(nil),                              // 24: bytebase.v1.TableCatalog.Columns
+	nil,                                                       // 25: bytebase.v1.ColumnCatalog.LabelsEntry
+	(*ObjectSchema_StructKind)(nil),                           // 26: bytebase.v1.ObjectSchema.StructKind
+	(*ObjectSchema_ArrayKind)(nil),                            // 27: bytebase.v1.ObjectSchema.ArrayKind
+	nil,                                                       // 28: bytebase.v1.ObjectSchema.StructKind.PropertiesEntry
+	(*timestamppb.Timestamp)(nil),                             // 29: google.protobuf.Timestamp
 }
 var file_v1_database_catalog_service_proto_depIdxs = []int32{
-	3,  // 0: bytebase.v1.UpdateDatabaseCatalogRequest.catalog:type_name -> bytebase.v1.DatabaseCatalog
-	4,  // 1: bytebase.v1.DatabaseCatalog.schemas:type_name -> bytebase.v1.SchemaCatalog
-	5,  // 2: bytebase.v1.SchemaCatalog.tables:type_name -> bytebase.v1.TableCatalog
-	8,  // 3: bytebase.v1.TableCatalog.columns:type_name -> bytebase.v1.TableCatalog.Columns
-	7,  // 4: bytebase.v1.TableCatalog.object_schema:type_name -> bytebase.v1.ObjectSchema
-	9,  // 5: bytebase.v1.ColumnCatalog.labels:type_name -> bytebase.v1.ColumnCatalog.LabelsEntry
-	7,  // 6: bytebase.v1.ColumnCatalog.object_schema:type_name -> bytebase.v1.ObjectSchema
+	8,  // 0: bytebase.v1.UpdateDatabaseCatalogRequest.catalog:type_name -> bytebase.v1.DatabaseCatalog
+	9,  // 1: bytebase.v1.DatabaseCatalog.schemas:type_name -> bytebase.v1.SchemaCatalog
+	10, // 2: bytebase.v1.SchemaCatalog.tables:type_name -> bytebase.v1.TableCatalog
+	24, // 3: bytebase.v1.TableCatalog.columns:type_name -> bytebase.v1.TableCatalog.Columns
+	12, // 4: bytebase.v1.TableCatalog.object_schema:type_name -> bytebase.v1.ObjectSchema
+	25, // 5: bytebase.v1.ColumnCatalog.labels:type_name -> bytebase.v1.ColumnCatalog.LabelsEntry
+	12, // 6: bytebase.v1.ColumnCatalog.object_schema:type_name -> bytebase.v1.ObjectSchema
 	0,  // 7: bytebase.v1.ObjectSchema.type:type_name -> bytebase.v1.ObjectSchema.Type
-	10, // 8: bytebase.v1.ObjectSchema.struct_kind:type_name -> bytebase.v1.ObjectSchema.StructKind
-	11, // 9: bytebase.v1.ObjectSchema.array_kind:type_name -> bytebase.v1.ObjectSchema.ArrayKind
-	6,  // 10: bytebase.v1.TableCatalog.Columns.columns:type_name -> bytebase.v1.ColumnCatalog
-	12, // 11: bytebase.v1.ObjectSchema.StructKind.properties:type_name -> bytebase.v1.ObjectSchema.StructKind.PropertiesEntry
-	7,  // 12: bytebase.v1.ObjectSchema.ArrayKind.kind:type_name -> bytebase.v1.ObjectSchema
-	7,  // 13: bytebase.v1.ObjectSchema.StructKind.PropertiesEntry.value:type_name -> bytebase.v1.ObjectSchema
-	1,  // 14: bytebase.v1.DatabaseCatalogService.GetDatabaseCatalog:input_type -> bytebase.v1.GetDatabaseCatalogRequest
-	2,  // 15: bytebase.v1.DatabaseCatalogService.UpdateDatabaseCatalog:input_type -> bytebase.v1.UpdateDatabaseCatalogRequest
-	3,  // 16: bytebase.v1.DatabaseCatalogService.GetDatabaseCatalog:output_type -> bytebase.v1.DatabaseCatalog
-	3,  // 17: bytebase.v1.DatabaseCatalogService.UpdateDatabaseCatalog:output_type -> bytebase.v1.DatabaseCatalog
-	16, // [16:18] is the sub-list for method output_type
-	14, // [14:16] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	26, // 8: bytebase.v1.ObjectSchema.struct_kind:type_name -> bytebase.v1.ObjectSchema.StructKind
+	27, // 9: bytebase.v1.ObjectSchema.array_kind:type_name -> bytebase.v1.ObjectSchema.ArrayKind
+	3,  // 10: bytebase.v1.ListClassificationSuggestionsRequest.state:type_name -> bytebase.v1.ClassificationSuggestion.State
+	17, // 11: bytebase.v1.ListClassificationSuggestionsResponse.suggestions:type_name -> bytebase.v1.ClassificationSuggestion
+	1,  // 12: bytebase.v1.BatchReviewClassificationSuggestionsRequest.decision:type_name -> bytebase.v1.BatchReviewClassificationSuggestionsRequest.Decision
+	17, // 13: bytebase.v1.BatchReviewClassificationSuggestionsResponse.suggestions:type_name -> bytebase.v1.ClassificationSuggestion
+	2,  // 14: bytebase.v1.ClassificationSuggestion.match_source:type_name -> bytebase.v1.ClassificationSuggestion.MatchSource
+	3,  // 15: bytebase.v1.ClassificationSuggestion.state:type_name -> bytebase.v1.ClassificationSuggestion.State
+	29, // 16: bytebase.v1.ClassificationSuggestion.create_time:type_name -> google.protobuf.Timestamp
+	29, // 17: bytebase.v1.ClassificationSuggestion.update_time:type_name -> google.protobuf.Timestamp
+	4,  // 18: bytebase.v1.GetColumnLineageRequest.direction:type_name -> bytebase.v1.GetColumnLineageRequest.Direction
+	20, // 19: bytebase.v1.ColumnLineage.nodes:type_name -> bytebase.v1.ColumnLineageNode
+	21, // 20: bytebase.v1.ColumnLineage.edges:type_name -> bytebase.v1.ColumnLineageEdge
+	5,  // 21: bytebase.v1.ColumnLineageEdge.origin:type_name -> bytebase.v1.ColumnLineageEdge.Origin
+	29, // 22: bytebase.v1.ColumnLineageEdge.update_time:type_name -> google.protobuf.Timestamp
+	20, // 23: bytebase.v1.PropagateColumnClassificationResponse.updated_columns:type_name -> bytebase.v1.ColumnLineageNode
+	11, // 24: bytebase.v1.TableCatalog.Columns.columns:type_name -> bytebase.v1.ColumnCatalog
+	28, // 25: bytebase.v1.ObjectSchema.StructKind.properties:type_name -> bytebase.v1.ObjectSchema.StructKind.PropertiesEntry
+	12, // 26: bytebase.v1.ObjectSchema.ArrayKind.kind:type_name -> bytebase.v1.ObjectSchema
+	12, // 27: bytebase.v1.ObjectSchema.StructKind.PropertiesEntry.value:type_name -> bytebase.v1.ObjectSchema
+	6,  // 28: bytebase.v1.DatabaseCatalogService.GetDatabaseCatalog:input_type -> bytebase.v1.GetDatabaseCatalogRequest
+	7,  // 29: bytebase.v1.DatabaseCatalogService.UpdateDatabaseCatalog:input_type -> bytebase.v1.UpdateDatabaseCatalogRequest
+	13, // 30: bytebase.v1.DatabaseCatalogService.ListClassificationSuggestions:input_type -> bytebase.v1.ListClassificationSuggestionsRequest
+	15, // 31: bytebase.v1.DatabaseCatalogService.BatchReviewClassificationSuggestions:input_type -> bytebase.v1.BatchReviewClassificationSuggestionsRequest
+	18, // 32: bytebase.v1.DatabaseCatalogService.GetColumnLineage:input_type -> bytebase.v1.GetColumnLineageRequest
+	22, // 33: bytebase.v1.DatabaseCatalogService.PropagateColumnClassification:input_type -> bytebase.v1.PropagateColumnClassificationRequest
+	8,  // 34: bytebase.v1.DatabaseCatalogService.GetDatabaseCatalog:output_type -> bytebase.v1.DatabaseCatalog
+	8,  // 35: bytebase.v1.DatabaseCatalogService.UpdateDatabaseCatalog:output_type -> bytebase.v1.DatabaseCatalog
+	14, // 36: bytebase.v1.DatabaseCatalogService.ListClassificationSuggestions:output_type -> bytebase.v1.ListClassificationSuggestionsResponse
+	16, // 37: bytebase.v1.DatabaseCatalogService.BatchReviewClassificationSuggestions:output_type -> bytebase.v1.BatchReviewClassificationSuggestionsResponse
+	19, // 38: bytebase.v1.DatabaseCatalogService.GetColumnLineage:output_type -> bytebase.v1.ColumnLineage
+	23, // 39: bytebase.v1.DatabaseCatalogService.PropagateColumnClassification:output_type -> bytebase.v1.PropagateColumnClassificationResponse
+	34, // [34:40] is the sub-list for method output_type
+	28, // [28:34] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
 }
 
 func init() { file_v1_database_catalog_service_proto_init() }
@@ -874,8 +2174,8 @@ func file_v1_database_catalog_service_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_database_catalog_service_proto_rawDesc), len(file_v1_database_catalog_service_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   12,
+			NumEnums:      6,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
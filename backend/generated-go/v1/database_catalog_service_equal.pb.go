@@ -3,6 +3,10 @@
 
 package v1
 
+import (
+	math "math"
+)
+
 func (x *GetDatabaseCatalogRequest) Equal(y *GetDatabaseCatalogRequest) bool {
 	if x == y {
 		return true
@@ -204,3 +208,296 @@ func (x *ObjectSchema) Equal(y *ObjectSchema) bool {
 	}
 	return true
 }
+
+func (x *ListClassificationSuggestionsRequest) Equal(y *ListClassificationSuggestionsRequest) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Parent != y.Parent {
+		return false
+	}
+	if x.PageSize != y.PageSize {
+		return false
+	}
+	if x.PageToken != y.PageToken {
+		return false
+	}
+	if x.State != y.State {
+		return false
+	}
+	return true
+}
+
+func (x *ListClassificationSuggestionsResponse) Equal(y *ListClassificationSuggestionsResponse) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if len(x.Suggestions) != len(y.Suggestions) {
+		return false
+	}
+	for i := 0; i < len(x.Suggestions); i++ {
+		if !x.Suggestions[i].Equal(y.Suggestions[i]) {
+			return false
+		}
+	}
+	if x.NextPageToken != y.NextPageToken {
+		return false
+	}
+	return true
+}
+
+func (x *BatchReviewClassificationSuggestionsRequest) Equal(y *BatchReviewClassificationSuggestionsRequest) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Parent != y.Parent {
+		return false
+	}
+	if len(x.Names) != len(y.Names) {
+		return false
+	}
+	for i := 0; i < len(x.Names); i++ {
+		if x.Names[i] != y.Names[i] {
+			return false
+		}
+	}
+	if x.Decision != y.Decision {
+		return false
+	}
+	return true
+}
+
+func (x *BatchReviewClassificationSuggestionsResponse) Equal(y *BatchReviewClassificationSuggestionsResponse) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if len(x.Suggestions) != len(y.Suggestions) {
+		return false
+	}
+	for i := 0; i < len(x.Suggestions); i++ {
+		if !x.Suggestions[i].Equal(y.Suggestions[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (x *ClassificationSuggestion) Equal(y *ClassificationSuggestion) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.Schema != y.Schema {
+		return false
+	}
+	if x.Table != y.Table {
+		return false
+	}
+	if x.Column != y.Column {
+		return false
+	}
+	if x.Classification != y.Classification {
+		return false
+	}
+	if x.SemanticType != y.SemanticType {
+		return false
+	}
+	if x.Detector != y.Detector {
+		return false
+	}
+	if x.MatchSource != y.MatchSource {
+		return false
+	}
+	if (math.IsNaN(float64(x.MatchRatio)) && !math.IsNaN(float64(y.MatchRatio)) || !math.IsNaN(float64(x.MatchRatio)) && math.IsNaN(float64(y.MatchRatio))) || (!math.IsNaN(float64(x.MatchRatio)) && !math.IsNaN(float64(y.MatchRatio)) && x.MatchRatio != y.MatchRatio) {
+		return false
+	}
+	if x.SampleCount != y.SampleCount {
+		return false
+	}
+	if x.State != y.State {
+		return false
+	}
+	if p, q := x.CreateTime, y.CreateTime; (p == nil && q != nil) || (p != nil && (q == nil || p.Seconds != q.Seconds || p.Nanos != q.Nanos)) {
+		return false
+	}
+	if p, q := x.UpdateTime, y.UpdateTime; (p == nil && q != nil) || (p != nil && (q == nil || p.Seconds != q.Seconds || p.Nanos != q.Nanos)) {
+		return false
+	}
+	return true
+}
+
+func (x *GetColumnLineageRequest) Equal(y *GetColumnLineageRequest) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.Schema != y.Schema {
+		return false
+	}
+	if x.Table != y.Table {
+		return false
+	}
+	if x.Column != y.Column {
+		return false
+	}
+	if x.Direction != y.Direction {
+		return false
+	}
+	if x.Depth != y.Depth {
+		return false
+	}
+	return true
+}
+
+func (x *ColumnLineage) Equal(y *ColumnLineage) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if len(x.Nodes) != len(y.Nodes) {
+		return false
+	}
+	for i := 0; i < len(x.Nodes); i++ {
+		if !x.Nodes[i].Equal(y.Nodes[i]) {
+			return false
+		}
+	}
+	if len(x.Edges) != len(y.Edges) {
+		return false
+	}
+	for i := 0; i < len(x.Edges); i++ {
+		if !x.Edges[i].Equal(y.Edges[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (x *ColumnLineageNode) Equal(y *ColumnLineageNode) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Database != y.Database {
+		return false
+	}
+	if x.Schema != y.Schema {
+		return false
+	}
+	if x.Table != y.Table {
+		return false
+	}
+	if x.Column != y.Column {
+		return false
+	}
+	if x.Classification != y.Classification {
+		return false
+	}
+	if x.SemanticType != y.SemanticType {
+		return false
+	}
+	return true
+}
+
+func (x *ColumnLineageEdge) Equal(y *ColumnLineageEdge) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Source != y.Source {
+		return false
+	}
+	if x.Target != y.Target {
+		return false
+	}
+	if x.Origin != y.Origin {
+		return false
+	}
+	if x.Changelog != y.Changelog {
+		return false
+	}
+	if p, q := x.UpdateTime, y.UpdateTime; (p == nil && q != nil) || (p != nil && (q == nil || p.Seconds != q.Seconds || p.Nanos != q.Nanos)) {
+		return false
+	}
+	return true
+}
+
+func (x *PropagateColumnClassificationRequest) Equal(y *PropagateColumnClassificationRequest) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.Schema != y.Schema {
+		return false
+	}
+	if x.Table != y.Table {
+		return false
+	}
+	if x.Column != y.Column {
+		return false
+	}
+	if x.Depth != y.Depth {
+		return false
+	}
+	if x.Overwrite != y.Overwrite {
+		return false
+	}
+	if x.ValidateOnly != y.ValidateOnly {
+		return false
+	}
+	return true
+}
+
+func (x *PropagateColumnClassificationResponse) Equal(y *PropagateColumnClassificationResponse) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Classification != y.Classification {
+		return false
+	}
+	if len(x.UpdatedColumns) != len(y.UpdatedColumns) {
+		return false
+	}
+	for i := 0; i < len(x.UpdatedColumns); i++ {
+		if !x.UpdatedColumns[i].Equal(y.UpdatedColumns[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -19,8 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	DatabaseCatalogService_GetDatabaseCatalog_FullMethodName    = "/bytebase.v1.DatabaseCatalogService/GetDatabaseCatalog"
-	DatabaseCatalogService_UpdateDatabaseCatalog_FullMethodName = "/bytebase.v1.DatabaseCatalogService/UpdateDatabaseCatalog"
+	DatabaseCatalogService_GetDatabaseCatalog_FullMethodName                   = "/bytebase.v1.DatabaseCatalogService/GetDatabaseCatalog"
+	DatabaseCatalogService_UpdateDatabaseCatalog_FullMethodName                = "/bytebase.v1.DatabaseCatalogService/UpdateDatabaseCatalog"
+	DatabaseCatalogService_ListClassificationSuggestions_FullMethodName        = "/bytebase.v1.DatabaseCatalogService/ListClassificationSuggestions"
+	DatabaseCatalogService_BatchReviewClassificationSuggestions_FullMethodName = "/bytebase.v1.DatabaseCatalogService/BatchReviewClassificationSuggestions"
+	DatabaseCatalogService_GetColumnLineage_FullMethodName                     = "/bytebase.v1.DatabaseCatalogService/GetColumnLineage"
+	DatabaseCatalogService_PropagateColumnClassification_FullMethodName        = "/bytebase.v1.DatabaseCatalogService/PropagateColumnClassification"
 )
 
 // DatabaseCatalogServiceClient is the client API for DatabaseCatalogService service.
@@ -35,6 +39,21 @@ type DatabaseCatalogServiceClient interface {
 	// Updates catalog metadata such as classifications and labels.
 	// Permissions required: bb.databaseCatalogs.update
 	UpdateDatabaseCatalog(ctx context.Context, in *UpdateDatabaseCatalogRequest, opts ...grpc.CallOption) (*DatabaseCatalog, error)
+	// Lists the column classifications proposed by sensitive data discovery.
+	// Permissions required: bb.databaseCatalogs.get
+	ListClassificationSuggestions(ctx context.Context, in *ListClassificationSuggestionsRequest, opts ...grpc.CallOption) (*ListClassificationSuggestionsResponse, error)
+	// Accepts or rejects classification suggestions in bulk.
+	// Accepted suggestions are written to the database catalog.
+	// Permissions required: bb.databaseCatalogs.update
+	BatchReviewClassificationSuggestions(ctx context.Context, in *BatchReviewClassificationSuggestionsRequest, opts ...grpc.CallOption) (*BatchReviewClassificationSuggestionsResponse, error)
+	// Gets the column-level lineage graph of a column.
+	// The lineage is recorded from the statements executed by rollouts and the synced view definitions.
+	// The lineage does not cross into databases of other projects without bb.databaseCatalogs.get on them.
+	// Permissions required: bb.databaseCatalogs.get
+	GetColumnLineage(ctx context.Context, in *GetColumnLineageRequest, opts ...grpc.CallOption) (*ColumnLineage, error)
+	// Propagates the classification of a column to the columns derived from it.
+	// Permissions required: bb.databaseCatalogs.update
+	PropagateColumnClassification(ctx context.Context, in *PropagateColumnClassificationRequest, opts ...grpc.CallOption) (*PropagateColumnClassificationResponse, error)
 }
 
 type databaseCatalogServiceClient struct {
@@ -65,6 +84,46 @@ func (c *databaseCatalogServiceClient) UpdateDatabaseCatalog(ctx context.Context
 	return out, nil
 }
 
+func (c *databaseCatalogServiceClient) ListClassificationSuggestions(ctx context.Context, in *ListClassificationSuggestionsRequest, opts ...grpc.CallOption) (*ListClassificationSuggestionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListClassificationSuggestionsResponse)
+	err := c.cc.Invoke(ctx, DatabaseCatalogService_ListClassificationSuggestions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseCatalogServiceClient) BatchReviewClassificationSuggestions(ctx context.Context, in *BatchReviewClassificationSuggestionsRequest, opts ...grpc.CallOption) (*BatchReviewClassificationSuggestionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchReviewClassificationSuggestionsResponse)
+	err := c.cc.Invoke(ctx, DatabaseCatalogService_BatchReviewClassificationSuggestions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseCatalogServiceClient) GetColumnLineage(ctx context.Context, in *GetColumnLineageRequest, opts ...grpc.CallOption) (*ColumnLineage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ColumnLineage)
+	err := c.cc.Invoke(ctx, DatabaseCatalogService_GetColumnLineage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseCatalogServiceClient) PropagateColumnClassification(ctx context.Context, in *PropagateColumnClassificationRequest, opts ...grpc.CallOption) (*PropagateColumnClassificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PropagateColumnClassificationResponse)
+	err := c.cc.Invoke(ctx, DatabaseCatalogService_PropagateColumnClassification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DatabaseCatalogServiceServer is the server API for DatabaseCatalogService service.
 // All implementations must embed UnimplementedDatabaseCatalogServiceServer
 // for forward compatibility.
@@ -77,6 +136,21 @@ type DatabaseCatalogServiceServer interface {
 	// Updates catalog metadata such as classifications and labels.
 	// Permissions required: bb.databaseCatalogs.update
 	UpdateDatabaseCatalog(context.Context, *UpdateDatabaseCatalogRequest) (*DatabaseCatalog, error)
+	// Lists the column classifications proposed by sensitive data discovery.
+	// Permissions required: bb.databaseCatalogs.get
+	ListClassificationSuggestions(context.Context, *ListClassificationSuggestionsRequest) (*ListClassificationSuggestionsResponse, error)
+	// Accepts or rejects classification suggestions in bulk.
+	// Accepted suggestions are written to the database catalog.
+	// Permissions required: bb.databaseCatalogs.update
+	BatchReviewClassificationSuggestions(context.Context, *BatchReviewClassificationSuggestionsRequest) (*BatchReviewClassificationSuggestionsResponse, error)
+	// Gets the column-level lineage graph of a column.
+	// The lineage is recorded from the statements executed by rollouts and the synced view definitions.
+	// The lineage does not cross into databases of other projects without bb.databaseCatalogs.get on them.
+	// Permissions required: bb.databaseCatalogs.get
+	GetColumnLineage(context.Context, *GetColumnLineageRequest) (*ColumnLineage, error)
+	// Propagates the classification of a column to the columns derived from it.
+	// Permissions required: bb.databaseCatalogs.update
+	PropagateColumnClassification(context.Context, *PropagateColumnClassificationRequest) (*PropagateColumnClassificationResponse, error)
 	mustEmbedUnimplementedDatabaseCatalogServiceServer()
 }
 
@@ -93,6 +167,18 @@ func (UnimplementedDatabaseCatalogServiceServer) GetDatabaseCatalog(context.Cont
 func (UnimplementedDatabaseCatalogServiceServer) UpdateDatabaseCatalog(context.Context, *UpdateDatabaseCatalogRequest) (*DatabaseCatalog, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateDatabaseCatalog not implemented")
 }
+func (UnimplementedDatabaseCatalogServiceServer) ListClassificationSuggestions(context.Context, *ListClassificationSuggestionsRequest) (*ListClassificationSuggestionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListClassificationSuggestions not implemented")
+}
+func (UnimplementedDatabaseCatalogServiceServer) BatchReviewClassificationSuggestions(context.Context, *BatchReviewClassificationSuggestionsRequest) (*BatchReviewClassificationSuggestionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchReviewClassificationSuggestions not implemented")
+}
+func (UnimplementedDatabaseCatalogServiceServer) GetColumnLineage(context.Context, *GetColumnLineageRequest) (*ColumnLineage, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetColumnLineage not implemented")
+}
+func (UnimplementedDatabaseCatalogServiceServer) PropagateColumnClassification(context.Context, *PropagateColumnClassificationRequest) (*PropagateColumnClassificationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PropagateColumnClassification not implemented")
+}
 func (UnimplementedDatabaseCatalogServiceServer) mustEmbedUnimplementedDatabaseCatalogServiceServer() {
 }
 func (UnimplementedDatabaseCatalogServiceServer) testEmbeddedByValue() {}
@@ -151,6 +237,78 @@ func _DatabaseCatalogService_UpdateDatabaseCatalog_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DatabaseCatalogService_ListClassificationSuggestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClassificationSuggestionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseCatalogServiceServer).ListClassificationSuggestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DatabaseCatalogService_ListClassificationSuggestions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseCatalogServiceServer).ListClassificationSuggestions(ctx, req.(*ListClassificationSuggestionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatabaseCatalogService_BatchReviewClassificationSuggestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchReviewClassificationSuggestionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseCatalogServiceServer).BatchReviewClassificationSuggestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DatabaseCatalogService_BatchReviewClassificationSuggestions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseCatalogServiceServer).BatchReviewClassificationSuggestions(ctx, req.(*BatchReviewClassificationSuggestionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatabaseCatalogService_GetColumnLineage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetColumnLineageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseCatalogServiceServer).GetColumnLineage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DatabaseCatalogService_GetColumnLineage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseCatalogServiceServer).GetColumnLineage(ctx, req.(*GetColumnLineageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatabaseCatalogService_PropagateColumnClassification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PropagateColumnClassificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseCatalogServiceServer).PropagateColumnClassification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DatabaseCatalogService_PropagateColumnClassification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseCatalogServiceServer).PropagateColumnClassification(ctx, req.(*PropagateColumnClassificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DatabaseCatalogService_ServiceDesc is the grpc.ServiceDesc for DatabaseCatalogService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -166,6 +324,22 @@ var DatabaseCatalogService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateDatabaseCatalog",
 			Handler:    _DatabaseCatalogService_UpdateDatabaseCatalog_Handler,
 		},
+		{
+			MethodName: "ListClassificationSuggestions",
+			Handler:    _DatabaseCatalogService_ListClassificationSuggestions_Handler,
+		},
+		{
+			MethodName: "BatchReviewClassificationSuggestions",
+			Handler:    _DatabaseCatalogService_BatchReviewClassificationSuggestions_Handler,
+		},
+		{
+			MethodName: "GetColumnLineage",
+			Handler:    _DatabaseCatalogService_GetColumnLineage_Handler,
+		},
+		{
+			MethodName: "PropagateColumnClassification",
+			Handler:    _DatabaseCatalogService_PropagateColumnClassification_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/database_catalog_service.proto",
@@ -549,7 +549,7 @@ func (x Changelog_Status) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Changelog_Status.Descriptor instead.
 func (Changelog_Status) EnumDescriptor() ([]byte, []int) {
-	return file_v1_database_service_proto_rawDescGZIP(), []int{54, 0}
+	return file_v1_database_service_proto_rawDescGZIP(), []int{56, 0}
 }
 
 type GetSchemaStringRequest_ObjectType int32
@@ -616,7 +616,7 @@ func (x GetSchemaStringRequest_ObjectType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use GetSchemaStringRequest_ObjectType.Descriptor instead.
 func (GetSchemaStringRequest_ObjectType) EnumDescriptor() ([]byte, []int) {
-	return file_v1_database_service_proto_rawDescGZIP(), []int{55, 0}
+	return file_v1_database_service_proto_rawDescGZIP(), []int{57, 0}
 }
 
 type GetDatabaseRequest struct {
@@ -1183,7 +1183,10 @@ func (x *BatchSyncDatabasesRequest) GetNames() []string {
 }
 
 type BatchSyncDatabasesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The operation tracking the sync of the databases.
+	// The response of the operation is an empty BatchSyncDatabasesResponse.
+	Operation     *Operation `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1218,6 +1221,13 @@ func (*BatchSyncDatabasesResponse) Descriptor() ([]byte, []int) {
 	return file_v1_database_service_proto_rawDescGZIP(), []int{9}
 }
 
+func (x *BatchSyncDatabasesResponse) GetOperation() *Operation {
+	if x != nil {
+		return x.Operation
+	}
+	return nil
+}
+
 type SyncDatabaseRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The name of the database to sync.
@@ -5050,6 +5060,52 @@ func (x *ForeignKeyMetadata) GetMatchType() string {
 	return ""
 }
 
+type GetDatabaseSDLSchemaFilesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the database to retrieve SDL schema files.
+	// Format: instances/{instance}/databases/{database}/sdlSchema
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDatabaseSDLSchemaFilesRequest) Reset() {
+	*x = GetDatabaseSDLSchemaFilesRequest{}
+	mi := &file_v1_database_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDatabaseSDLSchemaFilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDatabaseSDLSchemaFilesRequest) ProtoMessage() {}
+
+func (x *GetDatabaseSDLSchemaFilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_database_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDatabaseSDLSchemaFilesRequest.ProtoReflect.Descriptor instead.
+func (*GetDatabaseSDLSchemaFilesRequest) Descriptor() ([]byte, []int) {
+	return file_v1_database_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetDatabaseSDLSchemaFilesRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
 // DatabaseSchema is the metadata for databases.
 type DatabaseSchema struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -5061,7 +5117,7 @@ type DatabaseSchema struct {
 
 func (x *DatabaseSchema) Reset() {
 	*x = DatabaseSchema{}
-	mi := &file_v1_database_service_proto_msgTypes[49]
+	mi := &file_v1_database_service_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5073,7 +5129,7 @@ func (x *DatabaseSchema) String() string {
 func (*DatabaseSchema) ProtoMessage() {}
 
 func (x *DatabaseSchema) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_database_service_proto_msgTypes[49]
+	mi := &file_v1_database_service_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5086,7 +5142,7 @@ func (x *DatabaseSchema) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DatabaseSchema.ProtoReflect.Descriptor instead.
 func (*DatabaseSchema) Descriptor() ([]byte, []int) {
-	return file_v1_database_service_proto_rawDescGZIP(), []int{49}
+	return file_v1_database_service_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *DatabaseSchema) GetSchema() string {
@@ -5115,7 +5171,7 @@ type DatabaseSDLSchema struct {
 
 func (x *DatabaseSDLSchema) Reset() {
 	*x = DatabaseSDLSchema{}
-	mi := &file_v1_database_service_proto_msgTypes[50]
+	mi := &file_v1_database_service_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5127,7 +5183,7 @@ func (x *DatabaseSDLSchema) String() string {
 func (*DatabaseSDLSchema) ProtoMessage() {}
 
 func (x *DatabaseSDLSchema) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_database_service_proto_msgTypes[50]
+	mi := &file_v1_database_service_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5140,7 +5196,7 @@ func (x *DatabaseSDLSchema) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DatabaseSDLSchema.ProtoReflect.Descriptor instead.
 func (*DatabaseSDLSchema) Descriptor() ([]byte, []int) {
-	return file_v1_database_service_proto_rawDescGZIP(), []int{50}
+	return file_v1_database_service_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *DatabaseSDLSchema) GetSchema() []byte {
@@ -5157,6 +5213,52 @@ func (x *DatabaseSDLSchema) GetContentType() string {
 	return ""
 }
 
+// DatabaseSDLSchemaFiles contains the schema in SDL format with one file per schema object.
+type DatabaseSDLSchemaFiles struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The files of the SDL schema, ordered by path.
+	Files         []*DatabaseSDLSchemaFiles_File `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseSDLSchemaFiles) Reset() {
+	*x = DatabaseSDLSchemaFiles{}
+	mi := &file_v1_database_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseSDLSchemaFiles) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseSDLSchemaFiles) ProtoMessage() {}
+
+func (x *DatabaseSDLSchemaFiles) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_database_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseSDLSchemaFiles.ProtoReflect.Descriptor instead.
+func (*DatabaseSDLSchemaFiles) Descriptor() ([]byte, []int) {
+	return file_v1_database_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *DatabaseSDLSchemaFiles) GetFiles() []*DatabaseSDLSchemaFiles_File {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
 type ListChangelogsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The parent of the changelogs.
@@ -5191,7 +5293,7 @@ type ListChangelogsRequest struct {
 
 func (x *ListChangelogsRequest) Reset() {
 	*x = ListChangelogsRequest{}
-	mi := &file_v1_database_service_proto_msgTypes[51]
+	mi := &file_v1_database_service_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5203,7 +5305,7 @@ func (x *ListChangelogsRequest) String() string {
 func (*ListChangelogsRequest) ProtoMessage() {}
 
 func (x *ListChangelogsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_database_service_proto_msgTypes[51]
+	mi := &file_v1_database_service_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5216,7 +5318,7 @@ func (x *ListChangelogsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListChangelogsRequest.ProtoReflect.Descriptor instead.
 func (*ListChangelogsRequest) Descriptor() ([]byte, []int) {
-	return file_v1_database_service_proto_rawDescGZIP(), []int{51}
+	return file_v1_database_service_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *ListChangelogsRequest) GetParent() string {
@@ -5267,7 +5369,7 @@ type ListChangelogsResponse struct {
 
 func (x *ListChangelogsResponse) Reset() {
 	*x = ListChangelogsResponse{}
-	mi := &file_v1_database_service_proto_msgTypes[52]
+	mi := &file_v1_database_service_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5279,7 +5381,7 @@ func (x *ListChangelogsResponse) String() string {
 func (*ListChangelogsResponse) ProtoMessage() {}
 
 func (x *ListChangelogsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_database_service_proto_msgTypes[52]
+	mi := &file_v1_database_service_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5292,7 +5394,7 @@ func (x *ListChangelogsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListChangelogsResponse.ProtoReflect.Descriptor instead.
 func (*ListChangelogsResponse) Descriptor() ([]byte, []int) {
-	return file_v1_database_service_proto_rawDescGZIP(), []int{52}
+	return file_v1_database_service_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *ListChangelogsResponse) GetChangelogs() []*Changelog {
@@ -5321,7 +5423,7 @@ type GetChangelogRequest struct {
 
 func (x *GetChangelogRequest) Reset() {
 	*x = GetChangelogRequest{}
-	mi := &file_v1_database_service_proto_msgTypes[53]
+	mi := &file_v1_database_service_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5333,7 +5435,7 @@ func (x *GetChangelogRequest) String() string {
 func (*GetChangelogRequest) ProtoMessage() {}
 
 func (x *GetChangelogRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_database_service_proto_msgTypes[53]
+	mi := &file_v1_database_service_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5346,7 +5448,7 @@ func (x *GetChangelogRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetChangelogRequest.ProtoReflect.Descriptor instead.
 func (*GetChangelogRequest) Descriptor() ([]byte, []int) {
-	return file_v1_database_service_proto_rawDescGZIP(), []int{53}
+	return file_v1_database_service_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *GetChangelogRequest) GetName() string {
@@ -5382,7 +5484,7 @@ type Changelog struct {
 
 func (x *Changelog) Reset() {
 	*x = Changelog{}
-	mi := &file_v1_database_service_proto_msgTypes[54]
+	mi := &file_v1_database_service_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5394,7 +5496,7 @@ func (x *Changelog) String() string {
 func (*Changelog) ProtoMessage() {}
 
 func (x *Changelog) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_database_service_proto_msgTypes[54]
+	mi := &file_v1_database_service_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5407,7 +5509,7 @@ func (x *Changelog) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Changelog.ProtoReflect.Descriptor instead.
 func (*Changelog) Descriptor() ([]byte, []int) {
-	return file_v1_database_service_proto_rawDescGZIP(), []int{54}
+	return file_v1_database_service_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *Changelog) GetName() string {
@@ -5478,7 +5580,7 @@ type GetSchemaStringRequest struct {
 
 func (x *GetSchemaStringRequest) Reset() {
 	*x = GetSchemaStringRequest{}
-	mi := &file_v1_database_service_proto_msgTypes[55]
+	mi := &file_v1_database_service_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5490,7 +5592,7 @@ func (x *GetSchemaStringRequest) String() string {
 func (*GetSchemaStringRequest) ProtoMessage() {}
 
 func (x *GetSchemaStringRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_database_service_proto_msgTypes[55]
+	mi := &file_v1_database_service_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5503,7 +5605,7 @@ func (x *GetSchemaStringRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSchemaStringRequest.ProtoReflect.Descriptor instead.
 func (*GetSchemaStringRequest) Descriptor() ([]byte, []int) {
-	return file_v1_database_service_proto_rawDescGZIP(), []int{55}
+	return file_v1_database_service_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *GetSchemaStringRequest) GetName() string {
@@ -5550,7 +5652,7 @@ type GetSchemaStringResponse struct {
 
 func (x *GetSchemaStringResponse) Reset() {
 	*x = GetSchemaStringResponse{}
-	mi := &file_v1_database_service_proto_msgTypes[56]
+	mi := &file_v1_database_service_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5562,7 +5664,7 @@ func (x *GetSchemaStringResponse) String() string {
 func (*GetSchemaStringResponse) ProtoMessage() {}
 
 func (x *GetSchemaStringResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_database_service_proto_msgTypes[56]
+	mi := &file_v1_database_service_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5575,7 +5677,7 @@ func (x *GetSchemaStringResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSchemaStringResponse.ProtoReflect.Descriptor instead.
 func (*GetSchemaStringResponse) Descriptor() ([]byte, []int) {
-	return file_v1_database_service_proto_rawDescGZIP(), []int{56}
+	return file_v1_database_service_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *GetSchemaStringResponse) GetSchemaString() string {
@@ -5585,11 +5687,210 @@ func (x *GetSchemaStringResponse) GetSchemaString() string {
 	return ""
 }
 
+type GenerateSyntheticDataRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The database whose schema metadata is used.
+	// Format: instances/{instance}/databases/{database}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The number of rows generated per table.
+	// Defaults to 10. The maximum is 10000.
+	RowCount int32 `protobuf:"varint,2,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+	// Table patterns to generate data for, in the form of "schema.table" or "table".
+	// Glob wildcards are supported. Empty means all tables.
+	// Tables referenced by foreign keys are always included.
+	Tables []string `protobuf:"bytes,3,rep,name=tables,proto3" json:"tables,omitempty"`
+	// The seed of the generator. The same seed and schema generate the same data.
+	Seed int64 `protobuf:"varint,4,opt,name=seed,proto3" json:"seed,omitempty"`
+	// The database to load the generated rows into.
+	// It must use the same engine as the source database and contain the generated tables.
+	// Leave it empty to only return the INSERT statements.
+	// Format: instances/{instance}/databases/{database}
+	Target        string `protobuf:"bytes,5,opt,name=target,proto3" json:"target,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateSyntheticDataRequest) Reset() {
+	*x = GenerateSyntheticDataRequest{}
+	mi := &file_v1_database_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateSyntheticDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateSyntheticDataRequest) ProtoMessage() {}
+
+func (x *GenerateSyntheticDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_database_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateSyntheticDataRequest.ProtoReflect.Descriptor instead.
+func (*GenerateSyntheticDataRequest) Descriptor() ([]byte, []int) {
+	return file_v1_database_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GenerateSyntheticDataRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GenerateSyntheticDataRequest) GetRowCount() int32 {
+	if x != nil {
+		return x.RowCount
+	}
+	return 0
+}
+
+func (x *GenerateSyntheticDataRequest) GetTables() []string {
+	if x != nil {
+		return x.Tables
+	}
+	return nil
+}
+
+func (x *GenerateSyntheticDataRequest) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *GenerateSyntheticDataRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+type GenerateSyntheticDataResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The INSERT statements, ordered so that tables referenced by foreign keys come first.
+	Statement string `protobuf:"bytes,1,opt,name=statement,proto3" json:"statement,omitempty"`
+	// The number of rows loaded into the target database.
+	LoadedRows    int64 `protobuf:"varint,2,opt,name=loaded_rows,json=loadedRows,proto3" json:"loaded_rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateSyntheticDataResponse) Reset() {
+	*x = GenerateSyntheticDataResponse{}
+	mi := &file_v1_database_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateSyntheticDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateSyntheticDataResponse) ProtoMessage() {}
+
+func (x *GenerateSyntheticDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_database_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateSyntheticDataResponse.ProtoReflect.Descriptor instead.
+func (*GenerateSyntheticDataResponse) Descriptor() ([]byte, []int) {
+	return file_v1_database_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GenerateSyntheticDataResponse) GetStatement() string {
+	if x != nil {
+		return x.Statement
+	}
+	return ""
+}
+
+func (x *GenerateSyntheticDataResponse) GetLoadedRows() int64 {
+	if x != nil {
+		return x.LoadedRows
+	}
+	return 0
+}
+
+type DatabaseSDLSchemaFiles_File struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The relative path of the file.
+	// Examples:
+	// - "schemas/public/tables/users.sql"
+	// - "schemas/public/sequences/order_seq.sql"
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// The SDL statements of the file.
+	Content       string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseSDLSchemaFiles_File) Reset() {
+	*x = DatabaseSDLSchemaFiles_File{}
+	mi := &file_v1_database_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseSDLSchemaFiles_File) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseSDLSchemaFiles_File) ProtoMessage() {}
+
+func (x *DatabaseSDLSchemaFiles_File) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_database_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseSDLSchemaFiles_File.ProtoReflect.Descriptor instead.
+func (*DatabaseSDLSchemaFiles_File) Descriptor() ([]byte, []int) {
+	return file_v1_database_service_proto_rawDescGZIP(), []int{52, 0}
+}
+
+func (x *DatabaseSDLSchemaFiles_File) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *DatabaseSDLSchemaFiles_File) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
 var File_v1_database_service_proto protoreflect.FileDescriptor
 
 const file_v1_database_service_proto_rawDesc = "" +
 	"\n" +
-	"\x19v1/database_service.proto\x12\vbytebase.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x13v1/annotation.proto\x1a\x0fv1/common.proto\x1a\x19v1/instance_service.proto\"G\n" +
+	"\x19v1/database_service.proto\x12\vbytebase.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x13v1/annotation.proto\x1a\x0fv1/common.proto\x1a\x19v1/instance_service.proto\x1a\x1av1/operation_service.proto\"G\n" +
 	"\x12GetDatabaseRequest\x121\n" +
 	"\x04name\x18\x01 \x01(\tB\x1d\xe0A\x02\xfaA\x17\n" +
 	"\x15bytebase.com/DatabaseR\x04name\"\x86\x01\n" +
@@ -5623,8 +5924,9 @@ const file_v1_database_service_proto_rawDesc = "" +
 	"\x19BatchSyncDatabasesRequest\x12\x16\n" +
 	"\x06parent\x18\x01 \x01(\tR\x06parent\x123\n" +
 	"\x05names\x18\x02 \x03(\tB\x1d\xe0A\x02\xfaA\x17\n" +
-	"\x15bytebase.com/DatabaseR\x05names\"\x1c\n" +
-	"\x1aBatchSyncDatabasesResponse\"H\n" +
+	"\x15bytebase.com/DatabaseR\x05names\"R\n" +
+	"\x1aBatchSyncDatabasesResponse\x124\n" +
+	"\toperation\x18\x01 \x01(\v2\x16.bytebase.v1.OperationR\toperation\"H\n" +
 	"\x13SyncDatabaseRequest\x121\n" +
 	"\x04name\x18\x01 \x01(\tB\x1d\xe0A\x02\xfaA\x17\n" +
 	"\x15bytebase.com/DatabaseR\x04name\"\x16\n" +
@@ -6035,12 +6337,20 @@ const file_v1_database_service_proto_rawDesc = "" +
 	"\ton_delete\x18\x06 \x01(\tR\bonDelete\x12\x1b\n" +
 	"\ton_update\x18\a \x01(\tR\bonUpdate\x12\x1d\n" +
 	"\n" +
-	"match_type\x18\b \x01(\tR\tmatchType\"(\n" +
+	"match_type\x18\b \x01(\tR\tmatchType\"U\n" +
+	" GetDatabaseSDLSchemaFilesRequest\x121\n" +
+	"\x04name\x18\x01 \x01(\tB\x1d\xe0A\x02\xfaA\x17\n" +
+	"\x15bytebase.com/DatabaseR\x04name\"(\n" +
 	"\x0eDatabaseSchema\x12\x16\n" +
 	"\x06schema\x18\x01 \x01(\tR\x06schema\"S\n" +
 	"\x11DatabaseSDLSchema\x12\x16\n" +
 	"\x06schema\x18\x01 \x01(\fR\x06schema\x12&\n" +
-	"\fcontent_type\x18\x02 \x01(\tB\x03\xe0A\x03R\vcontentType\"\xd2\x01\n" +
+	"\fcontent_type\x18\x02 \x01(\tB\x03\xe0A\x03R\vcontentType\"\x8e\x01\n" +
+	"\x16DatabaseSDLSchemaFiles\x12>\n" +
+	"\x05files\x18\x01 \x03(\v2(.bytebase.v1.DatabaseSDLSchemaFiles.FileR\x05files\x1a4\n" +
+	"\x04File\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\"\xd2\x01\n" +
 	"\x15ListChangelogsRequest\x125\n" +
 	"\x06parent\x18\x01 \x01(\tB\x1d\xe0A\x02\xfaA\x17\n" +
 	"\x15bytebase.com/DatabaseR\x06parent\x12\x1b\n" +
@@ -6096,7 +6406,19 @@ const file_v1_database_service_proto_rawDesc = "" +
 	"\tPROCEDURE\x10\a\x12\f\n" +
 	"\bSEQUENCE\x10\b\">\n" +
 	"\x17GetSchemaStringResponse\x12#\n" +
-	"\rschema_string\x18\x01 \x01(\tR\fschemaString*=\n" +
+	"\rschema_string\x18\x01 \x01(\tR\fschemaString\"\xce\x01\n" +
+	"\x1cGenerateSyntheticDataRequest\x121\n" +
+	"\x04name\x18\x01 \x01(\tB\x1d\xe0A\x02\xfaA\x17\n" +
+	"\x15bytebase.com/DatabaseR\x04name\x12\x1b\n" +
+	"\trow_count\x18\x02 \x01(\x05R\browCount\x12\x16\n" +
+	"\x06tables\x18\x03 \x03(\tR\x06tables\x12\x12\n" +
+	"\x04seed\x18\x04 \x01(\x03R\x04seed\x122\n" +
+	"\x06target\x18\x05 \x01(\tB\x1a\xfaA\x17\n" +
+	"\x15bytebase.com/DatabaseR\x06target\"^\n" +
+	"\x1dGenerateSyntheticDataResponse\x12\x1c\n" +
+	"\tstatement\x18\x01 \x01(\tR\tstatement\x12\x1f\n" +
+	"\vloaded_rows\x18\x02 \x01(\x03R\n" +
+	"loadedRows*=\n" +
 	"\n" +
 	"SyncStatus\x12\x1b\n" +
 	"\x17SYNC_STATUS_UNSPECIFIED\x10\x00\x12\x06\n" +
@@ -6106,7 +6428,7 @@ const file_v1_database_service_proto_rawDesc = "" +
 	"\rChangelogView\x12\x1e\n" +
 	"\x1aCHANGELOG_VIEW_UNSPECIFIED\x10\x00\x12\x18\n" +
 	"\x14CHANGELOG_VIEW_BASIC\x10\x01\x12\x17\n" +
-	"\x13CHANGELOG_VIEW_FULL\x10\x022\xec\x14\n" +
+	"\x13CHANGELOG_VIEW_FULL\x10\x022\x90\x18\n" +
 	"\x0fDatabaseService\x12\x90\x01\n" +
 	"\vGetDatabase\x12\x1f.bytebase.v1.GetDatabaseRequest\x1a\x15.bytebase.v1.Database\"I\xdaA\x04name\x8a\xea0\x10bb.databases.get\x90\xea0\x01\x82\xd3\xe4\x93\x02$\x12\"/v1/{name=instances/*/databases/*}\x12\xdd\x01\n" +
 	"\x11BatchGetDatabases\x12%.bytebase.v1.BatchGetDatabasesRequest\x1a&.bytebase.v1.BatchGetDatabasesResponse\"y\x8a\xea0\x10bb.databases.get\x90\xea0\x02\x82\xd3\xe4\x93\x02[Z-\x12+/v1/{parent=instances/*}/databases:batchGet\x12*/v1/{parent=projects/*}/databases:batchGet\x12\xeb\x01\n" +
@@ -6117,12 +6439,14 @@ const file_v1_database_service_proto_rawDesc = "" +
 	"\x12BatchSyncDatabases\x12&.bytebase.v1.BatchSyncDatabasesRequest\x1a'.bytebase.v1.BatchSyncDatabasesResponse\"P\x8a\xea0\x11bb.databases.sync\x90\xea0\x01\x82\xd3\xe4\x93\x021:\x01*\",/v1/{parent=instances/*}/databases:batchSync\x12\xb0\x01\n" +
 	"\x13GetDatabaseMetadata\x12'.bytebase.v1.GetDatabaseMetadataRequest\x1a\x1d.bytebase.v1.DatabaseMetadata\"Q\x8a\xea0\x16bb.databases.getSchema\x90\xea0\x01\x82\xd3\xe4\x93\x02-\x12+/v1/{name=instances/*/databases/*/metadata}\x12\xa8\x01\n" +
 	"\x11GetDatabaseSchema\x12%.bytebase.v1.GetDatabaseSchemaRequest\x1a\x1b.bytebase.v1.DatabaseSchema\"O\x8a\xea0\x16bb.databases.getSchema\x90\xea0\x01\x82\xd3\xe4\x93\x02+\x12)/v1/{name=instances/*/databases/*/schema}\x12\xb4\x01\n" +
-	"\x14GetDatabaseSDLSchema\x12(.bytebase.v1.GetDatabaseSDLSchemaRequest\x1a\x1e.bytebase.v1.DatabaseSDLSchema\"R\x8a\xea0\x16bb.databases.getSchema\x90\xea0\x01\x82\xd3\xe4\x93\x02.\x12,/v1/{name=instances/*/databases/*/sdlSchema}\x12\xe1\x01\n" +
+	"\x14GetDatabaseSDLSchema\x12(.bytebase.v1.GetDatabaseSDLSchemaRequest\x1a\x1e.bytebase.v1.DatabaseSDLSchema\"R\x8a\xea0\x16bb.databases.getSchema\x90\xea0\x01\x82\xd3\xe4\x93\x02.\x12,/v1/{name=instances/*/databases/*/sdlSchema}\x12\xc9\x01\n" +
+	"\x19GetDatabaseSDLSchemaFiles\x12-.bytebase.v1.GetDatabaseSDLSchemaFilesRequest\x1a#.bytebase.v1.DatabaseSDLSchemaFiles\"X\x8a\xea0\x16bb.databases.getSchema\x90\xea0\x01\x82\xd3\xe4\x93\x024\x122/v1/{name=instances/*/databases/*/sdlSchema}:files\x12\xe1\x01\n" +
 	"\n" +
 	"DiffSchema\x12\x1e.bytebase.v1.DiffSchemaRequest\x1a\x1f.bytebase.v1.DiffSchemaResponse\"\x91\x01\x8a\xea0\x10bb.databases.get\x90\xea0\x01\x82\xd3\xe4\x93\x02s:\x01*Z?:\x01*\":/v1/{name=instances/*/databases/*/changelogs/*}:diffSchema\"-/v1/{name=instances/*/databases/*}:diffSchema\x12\xb5\x01\n" +
 	"\x0eListChangelogs\x12\".bytebase.v1.ListChangelogsRequest\x1a#.bytebase.v1.ListChangelogsResponse\"Z\xdaA\x06parent\x8a\xea0\x12bb.changelogs.list\x90\xea0\x01\x82\xd3\xe4\x93\x021\x12//v1/{parent=instances/*/databases/*}/changelogs\x12\xa1\x01\n" +
 	"\fGetChangelog\x12 .bytebase.v1.GetChangelogRequest\x1a\x16.bytebase.v1.Changelog\"W\xdaA\x04name\x8a\xea0\x11bb.changelogs.get\x90\xea0\x01\x82\xd3\xe4\x93\x021\x12//v1/{name=instances/*/databases/*/changelogs/*}\x12\xba\x01\n" +
-	"\x0fGetSchemaString\x12#.bytebase.v1.GetSchemaStringRequest\x1a$.bytebase.v1.GetSchemaStringResponse\"\\\xdaA\x04name\x8a\xea0\x16bb.databases.getSchema\x90\xea0\x01\x82\xd3\xe4\x93\x021\x12//v1/{name=instances/*/databases/*/schemaString}B\xaa\x01\n" +
+	"\x0fGetSchemaString\x12#.bytebase.v1.GetSchemaStringRequest\x1a$.bytebase.v1.GetSchemaStringResponse\"\\\xdaA\x04name\x8a\xea0\x16bb.databases.getSchema\x90\xea0\x01\x82\xd3\xe4\x93\x021\x12//v1/{name=instances/*/databases/*/schemaString}\x12\xd5\x01\n" +
+	"\x15GenerateSyntheticData\x12).bytebase.v1.GenerateSyntheticDataRequest\x1a*.bytebase.v1.GenerateSyntheticDataResponse\"e\x8a\xea0\x16bb.databases.getSchema\x90\xea0\x01\x98\xea0\x01\x82\xd3\xe4\x93\x02=:\x01*\"8/v1/{name=instances/*/databases/*}:generateSyntheticDataB\xaa\x01\n" +
 	"\x0fcom.bytebase.v1B\x14DatabaseServiceProtoP\x01Z4github.com/bytebase/bytebase/backend/generated-go/v1\xa2\x02\x03BXX\xaa\x02\vBytebase.V1\xca\x02\vBytebase\\V1\xe2\x02\x17Bytebase\\V1\\GPBMetadata\xea\x02\fBytebase::V1b\x06proto3"
 
 var (
@@ -6138,7 +6462,7 @@ func file_v1_database_service_proto_rawDescGZIP() []byte {
 }
 
 var file_v1_database_service_proto_enumTypes = make([]protoimpl.EnumInfo, 11)
-var file_v1_database_service_proto_msgTypes = make([]protoimpl.MessageInfo, 58)
+var file_v1_database_service_proto_msgTypes = make([]protoimpl.MessageInfo, 63)
 var file_v1_database_service_proto_goTypes = []any{
 	(SyncStatus)(0),    // 0: bytebase.v1.SyncStatus
 	(ChangelogView)(0), // 1: bytebase.v1.ChangelogView
@@ -6200,118 +6524,130 @@ var file_v1_database_service_proto_goTypes = []any{
 	(*IndexMetadata)(nil),                      // 57: bytebase.v1.IndexMetadata
 	(*ExtensionMetadata)(nil),                  // 58: bytebase.v1.ExtensionMetadata
 	(*ForeignKeyMetadata)(nil),                 // 59: bytebase.v1.ForeignKeyMetadata
-	(*DatabaseSchema)(nil),                     // 60: bytebase.v1.DatabaseSchema
-	(*DatabaseSDLSchema)(nil),                  // 61: bytebase.v1.DatabaseSDLSchema
-	(*ListChangelogsRequest)(nil),              // 62: bytebase.v1.ListChangelogsRequest
-	(*ListChangelogsResponse)(nil),             // 63: bytebase.v1.ListChangelogsResponse
-	(*GetChangelogRequest)(nil),                // 64: bytebase.v1.GetChangelogRequest
-	(*Changelog)(nil),                          // 65: bytebase.v1.Changelog
-	(*GetSchemaStringRequest)(nil),             // 66: bytebase.v1.GetSchemaStringRequest
-	(*GetSchemaStringResponse)(nil),            // 67: bytebase.v1.GetSchemaStringResponse
-	nil,                                        // 68: bytebase.v1.Database.LabelsEntry
-	(*fieldmaskpb.FieldMask)(nil),              // 69: google.protobuf.FieldMask
-	(State)(0),                                 // 70: bytebase.v1.State
-	(*timestamppb.Timestamp)(nil),              // 71: google.protobuf.Timestamp
-	(*InstanceResource)(nil),                   // 72: bytebase.v1.InstanceResource
+	(*GetDatabaseSDLSchemaFilesRequest)(nil),   // 60: bytebase.v1.GetDatabaseSDLSchemaFilesRequest
+	(*DatabaseSchema)(nil),                     // 61: bytebase.v1.DatabaseSchema
+	(*DatabaseSDLSchema)(nil),                  // 62: bytebase.v1.DatabaseSDLSchema
+	(*DatabaseSDLSchemaFiles)(nil),             // 63: bytebase.v1.DatabaseSDLSchemaFiles
+	(*ListChangelogsRequest)(nil),              // 64: bytebase.v1.ListChangelogsRequest
+	(*ListChangelogsResponse)(nil),             // 65: bytebase.v1.ListChangelogsResponse
+	(*GetChangelogRequest)(nil),                // 66: bytebase.v1.GetChangelogRequest
+	(*Changelog)(nil),                          // 67: bytebase.v1.Changelog
+	(*GetSchemaStringRequest)(nil),             // 68: bytebase.v1.GetSchemaStringRequest
+	(*GetSchemaStringResponse)(nil),            // 69: bytebase.v1.GetSchemaStringResponse
+	(*GenerateSyntheticDataRequest)(nil),       // 70: bytebase.v1.GenerateSyntheticDataRequest
+	(*GenerateSyntheticDataResponse)(nil),      // 71: bytebase.v1.GenerateSyntheticDataResponse
+	nil,                                        // 72: bytebase.v1.Database.LabelsEntry
+	(*DatabaseSDLSchemaFiles_File)(nil),        // 73: bytebase.v1.DatabaseSDLSchemaFiles.File
+	(*fieldmaskpb.FieldMask)(nil),              // 74: google.protobuf.FieldMask
+	(*Operation)(nil),                          // 75: bytebase.v1.Operation
+	(State)(0),                                 // 76: bytebase.v1.State
+	(*timestamppb.Timestamp)(nil),              // 77: google.protobuf.Timestamp
+	(*InstanceResource)(nil),                   // 78: bytebase.v1.InstanceResource
 }
 var file_v1_database_service_proto_depIdxs = []int32{
 	28, // 0: bytebase.v1.BatchGetDatabasesResponse.databases:type_name -> bytebase.v1.Database
 	28, // 1: bytebase.v1.ListDatabasesResponse.databases:type_name -> bytebase.v1.Database
 	28, // 2: bytebase.v1.UpdateDatabaseRequest.database:type_name -> bytebase.v1.Database
-	69, // 3: bytebase.v1.UpdateDatabaseRequest.update_mask:type_name -> google.protobuf.FieldMask
+	74, // 3: bytebase.v1.UpdateDatabaseRequest.update_mask:type_name -> google.protobuf.FieldMask
 	16, // 4: bytebase.v1.BatchUpdateDatabasesRequest.requests:type_name -> bytebase.v1.UpdateDatabaseRequest
 	28, // 5: bytebase.v1.BatchUpdateDatabasesResponse.databases:type_name -> bytebase.v1.Database
-	2,  // 6: bytebase.v1.GetDatabaseSDLSchemaRequest.format:type_name -> bytebase.v1.GetDatabaseSDLSchemaRequest.SDLFormat
-	70, // 7: bytebase.v1.Database.state:type_name -> bytebase.v1.State
-	71, // 8: bytebase.v1.Database.successful_sync_time:type_name -> google.protobuf.Timestamp
-	68, // 9: bytebase.v1.Database.labels:type_name -> bytebase.v1.Database.LabelsEntry
-	72, // 10: bytebase.v1.Database.instance_resource:type_name -> bytebase.v1.InstanceResource
-	0,  // 11: bytebase.v1.Database.sync_status:type_name -> bytebase.v1.SyncStatus
-	30, // 12: bytebase.v1.DatabaseMetadata.schemas:type_name -> bytebase.v1.SchemaMetadata
-	58, // 13: bytebase.v1.DatabaseMetadata.extensions:type_name -> bytebase.v1.ExtensionMetadata
-	36, // 14: bytebase.v1.SchemaMetadata.tables:type_name -> bytebase.v1.TableMetadata
-	35, // 15: bytebase.v1.SchemaMetadata.external_tables:type_name -> bytebase.v1.ExternalTableMetadata
-	41, // 16: bytebase.v1.SchemaMetadata.views:type_name -> bytebase.v1.ViewMetadata
-	45, // 17: bytebase.v1.SchemaMetadata.functions:type_name -> bytebase.v1.FunctionMetadata
-	46, // 18: bytebase.v1.SchemaMetadata.procedures:type_name -> bytebase.v1.ProcedureMetadata
-	49, // 19: bytebase.v1.SchemaMetadata.streams:type_name -> bytebase.v1.StreamMetadata
-	48, // 20: bytebase.v1.SchemaMetadata.tasks:type_name -> bytebase.v1.TaskMetadata
-	43, // 21: bytebase.v1.SchemaMetadata.materialized_views:type_name -> bytebase.v1.MaterializedViewMetadata
-	47, // 22: bytebase.v1.SchemaMetadata.packages:type_name -> bytebase.v1.PackageMetadata
-	33, // 23: bytebase.v1.SchemaMetadata.sequences:type_name -> bytebase.v1.SequenceMetadata
-	32, // 24: bytebase.v1.SchemaMetadata.events:type_name -> bytebase.v1.EventMetadata
-	31, // 25: bytebase.v1.SchemaMetadata.enum_types:type_name -> bytebase.v1.EnumTypeMetadata
-	39, // 26: bytebase.v1.ExternalTableMetadata.columns:type_name -> bytebase.v1.ColumnMetadata
-	39, // 27: bytebase.v1.TableMetadata.columns:type_name -> bytebase.v1.ColumnMetadata
-	57, // 28: bytebase.v1.TableMetadata.indexes:type_name -> bytebase.v1.IndexMetadata
-	59, // 29: bytebase.v1.TableMetadata.foreign_keys:type_name -> bytebase.v1.ForeignKeyMetadata
-	38, // 30: bytebase.v1.TableMetadata.partitions:type_name -> bytebase.v1.TablePartitionMetadata
-	37, // 31: bytebase.v1.TableMetadata.check_constraints:type_name -> bytebase.v1.CheckConstraintMetadata
-	34, // 32: bytebase.v1.TableMetadata.triggers:type_name -> bytebase.v1.TriggerMetadata
-	3,  // 33: bytebase.v1.TablePartitionMetadata.type:type_name -> bytebase.v1.TablePartitionMetadata.Type
-	38, // 34: bytebase.v1.TablePartitionMetadata.subpartitions:type_name -> bytebase.v1.TablePartitionMetadata
-	57, // 35: bytebase.v1.TablePartitionMetadata.indexes:type_name -> bytebase.v1.IndexMetadata
-	37, // 36: bytebase.v1.TablePartitionMetadata.check_constraints:type_name -> bytebase.v1.CheckConstraintMetadata
-	40, // 37: bytebase.v1.ColumnMetadata.generation:type_name -> bytebase.v1.GenerationMetadata
-	4,  // 38: bytebase.v1.ColumnMetadata.identity_generation:type_name -> bytebase.v1.ColumnMetadata.IdentityGeneration
-	5,  // 39: bytebase.v1.GenerationMetadata.type:type_name -> bytebase.v1.GenerationMetadata.Type
-	42, // 40: bytebase.v1.ViewMetadata.dependency_columns:type_name -> bytebase.v1.DependencyColumn
-	39, // 41: bytebase.v1.ViewMetadata.columns:type_name -> bytebase.v1.ColumnMetadata
-	34, // 42: bytebase.v1.ViewMetadata.triggers:type_name -> bytebase.v1.TriggerMetadata
-	42, // 43: bytebase.v1.MaterializedViewMetadata.dependency_columns:type_name -> bytebase.v1.DependencyColumn
-	34, // 44: bytebase.v1.MaterializedViewMetadata.triggers:type_name -> bytebase.v1.TriggerMetadata
-	57, // 45: bytebase.v1.MaterializedViewMetadata.indexes:type_name -> bytebase.v1.IndexMetadata
-	44, // 46: bytebase.v1.FunctionMetadata.dependency_tables:type_name -> bytebase.v1.DependencyTable
-	6,  // 47: bytebase.v1.TaskMetadata.state:type_name -> bytebase.v1.TaskMetadata.State
-	7,  // 48: bytebase.v1.StreamMetadata.type:type_name -> bytebase.v1.StreamMetadata.Type
-	8,  // 49: bytebase.v1.StreamMetadata.mode:type_name -> bytebase.v1.StreamMetadata.Mode
-	51, // 50: bytebase.v1.SpatialIndexConfig.tessellation:type_name -> bytebase.v1.TessellationConfig
-	54, // 51: bytebase.v1.SpatialIndexConfig.storage:type_name -> bytebase.v1.StorageConfig
-	55, // 52: bytebase.v1.SpatialIndexConfig.dimensional:type_name -> bytebase.v1.DimensionalConfig
-	52, // 53: bytebase.v1.TessellationConfig.grid_levels:type_name -> bytebase.v1.GridLevel
-	53, // 54: bytebase.v1.TessellationConfig.bounding_box:type_name -> bytebase.v1.BoundingBox
-	56, // 55: bytebase.v1.DimensionalConfig.constraints:type_name -> bytebase.v1.DimensionConstraint
-	50, // 56: bytebase.v1.IndexMetadata.spatial_config:type_name -> bytebase.v1.SpatialIndexConfig
-	1,  // 57: bytebase.v1.ListChangelogsRequest.view:type_name -> bytebase.v1.ChangelogView
-	65, // 58: bytebase.v1.ListChangelogsResponse.changelogs:type_name -> bytebase.v1.Changelog
-	1,  // 59: bytebase.v1.GetChangelogRequest.view:type_name -> bytebase.v1.ChangelogView
-	71, // 60: bytebase.v1.Changelog.create_time:type_name -> google.protobuf.Timestamp
-	9,  // 61: bytebase.v1.Changelog.status:type_name -> bytebase.v1.Changelog.Status
-	10, // 62: bytebase.v1.GetSchemaStringRequest.type:type_name -> bytebase.v1.GetSchemaStringRequest.ObjectType
-	29, // 63: bytebase.v1.GetSchemaStringRequest.metadata:type_name -> bytebase.v1.DatabaseMetadata
-	11, // 64: bytebase.v1.DatabaseService.GetDatabase:input_type -> bytebase.v1.GetDatabaseRequest
-	12, // 65: bytebase.v1.DatabaseService.BatchGetDatabases:input_type -> bytebase.v1.BatchGetDatabasesRequest
-	14, // 66: bytebase.v1.DatabaseService.ListDatabases:input_type -> bytebase.v1.ListDatabasesRequest
-	16, // 67: bytebase.v1.DatabaseService.UpdateDatabase:input_type -> bytebase.v1.UpdateDatabaseRequest
-	17, // 68: bytebase.v1.DatabaseService.BatchUpdateDatabases:input_type -> bytebase.v1.BatchUpdateDatabasesRequest
-	21, // 69: bytebase.v1.DatabaseService.SyncDatabase:input_type -> bytebase.v1.SyncDatabaseRequest
-	19, // 70: bytebase.v1.DatabaseService.BatchSyncDatabases:input_type -> bytebase.v1.BatchSyncDatabasesRequest
-	23, // 71: bytebase.v1.DatabaseService.GetDatabaseMetadata:input_type -> bytebase.v1.GetDatabaseMetadataRequest
-	24, // 72: bytebase.v1.DatabaseService.GetDatabaseSchema:input_type -> bytebase.v1.GetDatabaseSchemaRequest
-	25, // 73: bytebase.v1.DatabaseService.GetDatabaseSDLSchema:input_type -> bytebase.v1.GetDatabaseSDLSchemaRequest
-	26, // 74: bytebase.v1.DatabaseService.DiffSchema:input_type -> bytebase.v1.DiffSchemaRequest
-	62, // 75: bytebase.v1.DatabaseService.ListChangelogs:input_type -> bytebase.v1.ListChangelogsRequest
-	64, // 76: bytebase.v1.DatabaseService.GetChangelog:input_type -> bytebase.v1.GetChangelogRequest
-	66, // 77: bytebase.v1.DatabaseService.GetSchemaString:input_type -> bytebase.v1.GetSchemaStringRequest
-	28, // 78: bytebase.v1.DatabaseService.GetDatabase:output_type -> bytebase.v1.Database
-	13, // 79: bytebase.v1.DatabaseService.BatchGetDatabases:output_type -> bytebase.v1.BatchGetDatabasesResponse
-	15, // 80: bytebase.v1.DatabaseService.ListDatabases:output_type -> bytebase.v1.ListDatabasesResponse
-	28, // 81: bytebase.v1.DatabaseService.UpdateDatabase:output_type -> bytebase.v1.Database
-	18, // 82: bytebase.v1.DatabaseService.BatchUpdateDatabases:output_type -> bytebase.v1.BatchUpdateDatabasesResponse
-	22, // 83: bytebase.v1.DatabaseService.SyncDatabase:output_type -> bytebase.v1.SyncDatabaseResponse
-	20, // 84: bytebase.v1.DatabaseService.BatchSyncDatabases:output_type -> bytebase.v1.BatchSyncDatabasesResponse
-	29, // 85: bytebase.v1.DatabaseService.GetDatabaseMetadata:output_type -> bytebase.v1.DatabaseMetadata
-	60, // 86: bytebase.v1.DatabaseService.GetDatabaseSchema:output_type -> bytebase.v1.DatabaseSchema
-	61, // 87: bytebase.v1.DatabaseService.GetDatabaseSDLSchema:output_type -> bytebase.v1.DatabaseSDLSchema
-	27, // 88: bytebase.v1.DatabaseService.DiffSchema:output_type -> bytebase.v1.DiffSchemaResponse
-	63, // 89: bytebase.v1.DatabaseService.ListChangelogs:output_type -> bytebase.v1.ListChangelogsResponse
-	65, // 90: bytebase.v1.DatabaseService.GetChangelog:output_type -> bytebase.v1.Changelog
-	67, // 91: bytebase.v1.DatabaseService.GetSchemaString:output_type -> bytebase.v1.GetSchemaStringResponse
-	78, // [78:92] is the sub-list for method output_type
-	64, // [64:78] is the sub-list for method input_type
-	64, // [64:64] is the sub-list for extension type_name
-	64, // [64:64] is the sub-list for extension extendee
-	0,  // [0:64] is the sub-list for field type_name
+	75, // 6: bytebase.v1.BatchSyncDatabasesResponse.operation:type_name -> bytebase.v1.Operation
+	2,  // 7: bytebase.v1.GetDatabaseSDLSchemaRequest.format:type_name -> bytebase.v1.GetDatabaseSDLSchemaRequest.SDLFormat
+	76, // 8: bytebase.v1.Database.state:type_name -> bytebase.v1.State
+	77, // 9: bytebase.v1.Database.successful_sync_time:type_name -> google.protobuf.Timestamp
+	72, // 10: bytebase.v1.Database.labels:type_name -> bytebase.v1.Database.LabelsEntry
+	78, // 11: bytebase.v1.Database.instance_resource:type_name -> bytebase.v1.InstanceResource
+	0,  // 12: bytebase.v1.Database.sync_status:type_name -> bytebase.v1.SyncStatus
+	30, // 13: bytebase.v1.DatabaseMetadata.schemas:type_name -> bytebase.v1.SchemaMetadata
+	58, // 14: bytebase.v1.DatabaseMetadata.extensions:type_name -> bytebase.v1.ExtensionMetadata
+	36, // 15: bytebase.v1.SchemaMetadata.tables:type_name -> bytebase.v1.TableMetadata
+	35, // 16: bytebase.v1.SchemaMetadata.external_tables:type_name -> bytebase.v1.ExternalTableMetadata
+	41, // 17: bytebase.v1.SchemaMetadata.views:type_name -> bytebase.v1.ViewMetadata
+	45, // 18: bytebase.v1.SchemaMetadata.functions:type_name -> bytebase.v1.FunctionMetadata
+	46, // 19: bytebase.v1.SchemaMetadata.procedures:type_name -> bytebase.v1.ProcedureMetadata
+	49, // 20: bytebase.v1.SchemaMetadata.streams:type_name -> bytebase.v1.StreamMetadata
+	48, // 21: bytebase.v1.SchemaMetadata.tasks:type_name -> bytebase.v1.TaskMetadata
+	43, // 22: bytebase.v1.SchemaMetadata.materialized_views:type_name -> bytebase.v1.MaterializedViewMetadata
+	47, // 23: bytebase.v1.SchemaMetadata.packages:type_name -> bytebase.v1.PackageMetadata
+	33, // 24: bytebase.v1.SchemaMetadata.sequences:type_name -> bytebase.v1.SequenceMetadata
+	32, // 25: bytebase.v1.SchemaMetadata.events:type_name -> bytebase.v1.EventMetadata
+	31, // 26: bytebase.v1.SchemaMetadata.enum_types:type_name -> bytebase.v1.EnumTypeMetadata
+	39, // 27: bytebase.v1.ExternalTableMetadata.columns:type_name -> bytebase.v1.ColumnMetadata
+	39, // 28: bytebase.v1.TableMetadata.columns:type_name -> bytebase.v1.ColumnMetadata
+	57, // 29: bytebase.v1.TableMetadata.indexes:type_name -> bytebase.v1.IndexMetadata
+	59, // 30: bytebase.v1.TableMetadata.foreign_keys:type_name -> bytebase.v1.ForeignKeyMetadata
+	38, // 31: bytebase.v1.TableMetadata.partitions:type_name -> bytebase.v1.TablePartitionMetadata
+	37, // 32: bytebase.v1.TableMetadata.check_constraints:type_name -> bytebase.v1.CheckConstraintMetadata
+	34, // 33: bytebase.v1.TableMetadata.triggers:type_name -> bytebase.v1.TriggerMetadata
+	3,  // 34: bytebase.v1.TablePartitionMetadata.type:type_name -> bytebase.v1.TablePartitionMetadata.Type
+	38, // 35: bytebase.v1.TablePartitionMetadata.subpartitions:type_name -> bytebase.v1.TablePartitionMetadata
+	57, // 36: bytebase.v1.TablePartitionMetadata.indexes:type_name -> bytebase.v1.IndexMetadata
+	37, // 37: bytebase.v1.TablePartitionMetadata.check_constraints:type_name -> bytebase.v1.CheckConstraintMetadata
+	40, // 38: bytebase.v1.ColumnMetadata.generation:type_name -> bytebase.v1.GenerationMetadata
+	4,  // 39: bytebase.v1.ColumnMetadata.identity_generation:type_name -> bytebase.v1.ColumnMetadata.IdentityGeneration
+	5,  // 40: bytebase.v1.GenerationMetadata.type:type_name -> bytebase.v1.GenerationMetadata.Type
+	42, // 41: bytebase.v1.ViewMetadata.dependency_columns:type_name -> bytebase.v1.DependencyColumn
+	39, // 42: bytebase.v1.ViewMetadata.columns:type_name -> bytebase.v1.ColumnMetadata
+	34, // 43: bytebase.v1.ViewMetadata.triggers:type_name -> bytebase.v1.TriggerMetadata
+	42, // 44: bytebase.v1.MaterializedViewMetadata.dependency_columns:type_name -> bytebase.v1.DependencyColumn
+	34, // 45: bytebase.v1.MaterializedViewMetadata.triggers:type_name -> bytebase.v1.TriggerMetadata
+	57, // 46: bytebase.v1.MaterializedViewMetadata.indexes:type_name -> bytebase.v1.IndexMetadata
+	44, // 47: bytebase.v1.FunctionMetadata.dependency_tables:type_name -> bytebase.v1.DependencyTable
+	6,  // 48: bytebase.v1.TaskMetadata.state:type_name -> bytebase.v1.TaskMetadata.State
+	7,  // 49: bytebase.v1.StreamMetadata.type:type_name -> bytebase.v1.StreamMetadata.Type
+	8,  // 50: bytebase.v1.StreamMetadata.mode:type_name -> bytebase.v1.StreamMetadata.Mode
+	51, // 51: bytebase.v1.SpatialIndexConfig.tessellation:type_name -> bytebase.v1.TessellationConfig
+	54, // 52: bytebase.v1.SpatialIndexConfig.storage:type_name -> bytebase.v1.StorageConfig
+	55, // 53: bytebase.v1.SpatialIndexConfig.dimensional:type_name -> bytebase.v1.DimensionalConfig
+	52, // 54: bytebase.v1.TessellationConfig.grid_levels:type_name -> bytebase.v1.GridLevel
+	53, // 55: bytebase.v1.TessellationConfig.bounding_box:type_name -> bytebase.v1.BoundingBox
+	56, // 56: bytebase.v1.DimensionalConfig.constraints:type_name -> bytebase.v1.DimensionConstraint
+	50, // 57: bytebase.v1.IndexMetadata.spatial_config:type_name -> bytebase.v1.SpatialIndexConfig
+	73, // 58: bytebase.v1.DatabaseSDLSchemaFiles.files:type_name -> bytebase.v1.DatabaseSDLSchemaFiles.File
+	1,  // 59: bytebase.v1.ListChangelogsRequest.view:type_name -> bytebase.v1.ChangelogView
+	67, // 60: bytebase.v1.ListChangelogsResponse.changelogs:type_name -> bytebase.v1.Changelog
+	1,  // 61: bytebase.v1.GetChangelogRequest.view:type_name -> bytebase.v1.ChangelogView
+	77, // 62: bytebase.v1.Changelog.create_time:type_name -> google.protobuf.Timestamp
+	9,  // 63: bytebase.v1.Changelog.status:type_name -> bytebase.v1.Changelog.Status
+	10, // 64: bytebase.v1.GetSchemaStringRequest.type:type_name -> bytebase.v1.GetSchemaStringRequest.ObjectType
+	29, // 65: bytebase.v1.GetSchemaStringRequest.metadata:type_name -> bytebase.v1.DatabaseMetadata
+	11, // 66: bytebase.v1.DatabaseService.GetDatabase:input_type -> bytebase.v1.GetDatabaseRequest
+	12, // 67: bytebase.v1.DatabaseService.BatchGetDatabases:input_type -> bytebase.v1.BatchGetDatabasesRequest
+	14, // 68: bytebase.v1.DatabaseService.ListDatabases:input_type -> bytebase.v1.ListDatabasesRequest
+	16, // 69: bytebase.v1.DatabaseService.UpdateDatabase:input_type -> bytebase.v1.UpdateDatabaseRequest
+	17, // 70: bytebase.v1.DatabaseService.BatchUpdateDatabases:input_type -> bytebase.v1.BatchUpdateDatabasesRequest
+	21, // 71: bytebase.v1.DatabaseService.SyncDatabase:input_type -> bytebase.v1.SyncDatabaseRequest
+	19, // 72: bytebase.v1.DatabaseService.BatchSyncDatabases:input_type -> bytebase.v1.BatchSyncDatabasesRequest
+	23, // 73: bytebase.v1.DatabaseService.GetDatabaseMetadata:input_type -> bytebase.v1.GetDatabaseMetadataRequest
+	24, // 74: bytebase.v1.DatabaseService.GetDatabaseSchema:input_type -> bytebase.v1.GetDatabaseSchemaRequest
+	25, // 75: bytebase.v1.DatabaseService.GetDatabaseSDLSchema:input_type -> bytebase.v1.GetDatabaseSDLSchemaRequest
+	60, // 76: bytebase.v1.DatabaseService.GetDatabaseSDLSchemaFiles:input_type -> bytebase.v1.GetDatabaseSDLSchemaFilesRequest
+	26, // 77: bytebase.v1.DatabaseService.DiffSchema:input_type -> bytebase.v1.DiffSchemaRequest
+	64, // 78: bytebase.v1.DatabaseService.ListChangelogs:input_type -> bytebase.v1.ListChangelogsRequest
+	66, // 79: bytebase.v1.DatabaseService.GetChangelog:input_type -> bytebase.v1.GetChangelogRequest
+	68, // 80: bytebase.v1.DatabaseService.GetSchemaString:input_type -> bytebase.v1.GetSchemaStringRequest
+	70, // 81: bytebase.v1.DatabaseService.GenerateSyntheticData:input_type -> bytebase.v1.GenerateSyntheticDataRequest
+	28, // 82: bytebase.v1.DatabaseService.GetDatabase:output_type -> bytebase.v1.Database
+	13, // 83: bytebase.v1.DatabaseService.BatchGetDatabases:output_type -> bytebase.v1.BatchGetDatabasesResponse
+	15, // 84: bytebase.v1.DatabaseService.ListDatabases:output_type -> bytebase.v1.ListDatabasesResponse
+	28, // 85: bytebase.v1.DatabaseService.UpdateDatabase:output_type -> bytebase.v1.Database
+	18, // 86: bytebase.v1.DatabaseService.BatchUpdateDatabases:output_type -> bytebase.v1.BatchUpdateDatabasesResponse
+	22, // 87: bytebase.v1.DatabaseService.SyncDatabase:output_type -> bytebase.v1.SyncDatabaseResponse
+	20, // 88: bytebase.v1.DatabaseService.BatchSyncDatabases:output_type -> bytebase.v1.BatchSyncDatabasesResponse
+	29, // 89: bytebase.v1.DatabaseService.GetDatabaseMetadata:output_type -> bytebase.v1.DatabaseMetadata
+	61, // 90: bytebase.v1.DatabaseService.GetDatabaseSchema:output_type -> bytebase.v1.DatabaseSchema
+	62, // 91: bytebase.v1.DatabaseService.GetDatabaseSDLSchema:output_type -> bytebase.v1.DatabaseSDLSchema
+	63, // 92: bytebase.v1.DatabaseService.GetDatabaseSDLSchemaFiles:output_type -> bytebase.v1.DatabaseSDLSchemaFiles
+	27, // 93: bytebase.v1.DatabaseService.DiffSchema:output_type -> bytebase.v1.DiffSchemaResponse
+	65, // 94: bytebase.v1.DatabaseService.ListChangelogs:output_type -> bytebase.v1.ListChangelogsResponse
+	67, // 95: bytebase.v1.DatabaseService.GetChangelog:output_type -> bytebase.v1.Changelog
+	69, // 96: bytebase.v1.DatabaseService.GetSchemaString:output_type -> bytebase.v1.GetSchemaStringResponse
+	71, // 97: bytebase.v1.DatabaseService.GenerateSyntheticData:output_type -> bytebase.v1.GenerateSyntheticDataResponse
+	82, // [82:98] is the sub-list for method output_type
+	66, // [66:82] is the sub-list for method input_type
+	66, // [66:66] is the sub-list for extension type_name
+	66, // [66:66] is the sub-list for extension extendee
+	0,  // [0:66] is the sub-list for field type_name
 }
 
 func init() { file_v1_database_service_proto_init() }
@@ -6322,6 +6658,7 @@ func file_v1_database_service_proto_init() {
 	file_v1_annotation_proto_init()
 	file_v1_common_proto_init()
 	file_v1_instance_service_proto_init()
+	file_v1_operation_service_proto_init()
 	file_v1_database_service_proto_msgTypes[15].OneofWrappers = []any{
 		(*DiffSchemaRequest_Schema)(nil),
 		(*DiffSchemaRequest_Changelog)(nil),
@@ -6333,7 +6670,7 @@ func file_v1_database_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_database_service_proto_rawDesc), len(file_v1_database_service_proto_rawDesc)),
 			NumEnums:      11,
-			NumMessages:   58,
+			NumMessages:   63,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -200,6 +200,9 @@ func (x *BatchSyncDatabasesResponse) Equal(y *BatchSyncDatabasesResponse) bool {
 	if x == nil || y == nil {
 		return x == nil && y == nil
 	}
+	if !x.Operation.Equal(y.Operation) {
+		return false
+	}
 	return true
 }
 
@@ -1585,6 +1588,19 @@ func (x *ForeignKeyMetadata) Equal(y *ForeignKeyMetadata) bool {
 	return true
 }
 
+func (x *GetDatabaseSDLSchemaFilesRequest) Equal(y *GetDatabaseSDLSchemaFilesRequest) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	return true
+}
+
 func (x *DatabaseSchema) Equal(y *DatabaseSchema) bool {
 	if x == y {
 		return true
@@ -1614,6 +1630,40 @@ func (x *DatabaseSDLSchema) Equal(y *DatabaseSDLSchema) bool {
 	return true
 }
 
+func (x *DatabaseSDLSchemaFiles_File) Equal(y *DatabaseSDLSchemaFiles_File) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Path != y.Path {
+		return false
+	}
+	if x.Content != y.Content {
+		return false
+	}
+	return true
+}
+
+func (x *DatabaseSDLSchemaFiles) Equal(y *DatabaseSDLSchemaFiles) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if len(x.Files) != len(y.Files) {
+		return false
+	}
+	for i := 0; i < len(x.Files); i++ {
+		if !x.Files[i].Equal(y.Files[i]) {
+			return false
+		}
+	}
+	return true
+}
+
 func (x *ListChangelogsRequest) Equal(y *ListChangelogsRequest) bool {
 	if x == y {
 		return true
@@ -1744,3 +1794,49 @@ func (x *GetSchemaStringResponse) Equal(y *GetSchemaStringResponse) bool {
 	}
 	return true
 }
+
+func (x *GenerateSyntheticDataRequest) Equal(y *GenerateSyntheticDataRequest) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.RowCount != y.RowCount {
+		return false
+	}
+	if len(x.Tables) != len(y.Tables) {
+		return false
+	}
+	for i := 0; i < len(x.Tables); i++ {
+		if x.Tables[i] != y.Tables[i] {
+			return false
+		}
+	}
+	if x.Seed != y.Seed {
+		return false
+	}
+	if x.Target != y.Target {
+		return false
+	}
+	return true
+}
+
+func (x *GenerateSyntheticDataResponse) Equal(y *GenerateSyntheticDataResponse) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Statement != y.Statement {
+		return false
+	}
+	if x.LoadedRows != y.LoadedRows {
+		return false
+	}
+	return true
+}
@@ -19,20 +19,22 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	DatabaseService_GetDatabase_FullMethodName          = "/bytebase.v1.DatabaseService/GetDatabase"
-	DatabaseService_BatchGetDatabases_FullMethodName    = "/bytebase.v1.DatabaseService/BatchGetDatabases"
-	DatabaseService_ListDatabases_FullMethodName        = "/bytebase.v1.DatabaseService/ListDatabases"
-	DatabaseService_UpdateDatabase_FullMethodName       = "/bytebase.v1.DatabaseService/UpdateDatabase"
-	DatabaseService_BatchUpdateDatabases_FullMethodName = "/bytebase.v1.DatabaseService/BatchUpdateDatabases"
-	DatabaseService_SyncDatabase_FullMethodName         = "/bytebase.v1.DatabaseService/SyncDatabase"
-	DatabaseService_BatchSyncDatabases_FullMethodName   = "/bytebase.v1.DatabaseService/BatchSyncDatabases"
-	DatabaseService_GetDatabaseMetadata_FullMethodName  = "/bytebase.v1.DatabaseService/GetDatabaseMetadata"
-	DatabaseService_GetDatabaseSchema_FullMethodName    = "/bytebase.v1.DatabaseService/GetDatabaseSchema"
-	DatabaseService_GetDatabaseSDLSchema_FullMethodName = "/bytebase.v1.DatabaseService/GetDatabaseSDLSchema"
-	DatabaseService_DiffSchema_FullMethodName           = "/bytebase.v1.DatabaseService/DiffSchema"
-	DatabaseService_ListChangelogs_FullMethodName       = "/bytebase.v1.DatabaseService/ListChangelogs"
-	DatabaseService_GetChangelog_FullMethodName         = "/bytebase.v1.DatabaseService/GetChangelog"
-	DatabaseService_GetSchemaString_FullMethodName      = "/bytebase.v1.DatabaseService/GetSchemaString"
+	DatabaseService_GetDatabase_FullMethodName               = "/bytebase.v1.DatabaseService/GetDatabase"
+	DatabaseService_BatchGetDatabases_FullMethodName         = "/bytebase.v1.DatabaseService/BatchGetDatabases"
+	DatabaseService_ListDatabases_FullMethodName             = "/bytebase.v1.DatabaseService/ListDatabases"
+	DatabaseService_UpdateDatabase_FullMethodName            = "/bytebase.v1.DatabaseService/UpdateDatabase"
+	DatabaseService_BatchUpdateDatabases_FullMethodName      = "/bytebase.v1.DatabaseService/BatchUpdateDatabases"
+	DatabaseService_SyncDatabase_FullMethodName              = "/bytebase.v1.DatabaseService/SyncDatabase"
+	DatabaseService_BatchSyncDatabases_FullMethodName        = "/bytebase.v1.DatabaseService/BatchSyncDatabases"
+	DatabaseService_GetDatabaseMetadata_FullMethodName       = "/bytebase.v1.DatabaseService/GetDatabaseMetadata"
+	DatabaseService_GetDatabaseSchema_FullMethodName         = "/bytebase.v1.DatabaseService/GetDatabaseSchema"
+	DatabaseService_GetDatabaseSDLSchema_FullMethodName      = "/bytebase.v1.DatabaseService/GetDatabaseSDLSchema"
+	DatabaseService_GetDatabaseSDLSchemaFiles_FullMethodName = "/bytebase.v1.DatabaseService/GetDatabaseSDLSchemaFiles"
+	DatabaseService_DiffSchema_FullMethodName                = "/bytebase.v1.DatabaseService/DiffSchema"
+	DatabaseService_ListChangelogs_FullMethodName            = "/bytebase.v1.DatabaseService/ListChangelogs"
+	DatabaseService_GetChangelog_FullMethodName              = "/bytebase.v1.DatabaseService/GetChangelog"
+	DatabaseService_GetSchemaString_FullMethodName           = "/bytebase.v1.DatabaseService/GetSchemaString"
+	DatabaseService_GenerateSyntheticData_FullMethodName     = "/bytebase.v1.DatabaseService/GenerateSyntheticData"
 )
 
 // DatabaseServiceClient is the client API for DatabaseService service.
@@ -71,6 +73,10 @@ type DatabaseServiceClient interface {
 	// Retrieves database schema in SDL (Schema Definition Language) format.
 	// Permissions required: bb.databases.getSchema
 	GetDatabaseSDLSchema(ctx context.Context, in *GetDatabaseSDLSchemaRequest, opts ...grpc.CallOption) (*DatabaseSDLSchema, error)
+	// Retrieves database schema in SDL format with one file per schema object,
+	// laid out as a declarative repository that can be rolled out unchanged.
+	// Permissions required: bb.databases.getSchema
+	GetDatabaseSDLSchemaFiles(ctx context.Context, in *GetDatabaseSDLSchemaFilesRequest, opts ...grpc.CallOption) (*DatabaseSDLSchemaFiles, error)
 	// Compares and generates migration statements between two schemas.
 	// Permissions required: bb.databases.get
 	DiffSchema(ctx context.Context, in *DiffSchemaRequest, opts ...grpc.CallOption) (*DiffSchemaResponse, error)
@@ -83,6 +89,12 @@ type DatabaseServiceClient interface {
 	// Generates schema DDL for a database object.
 	// Permissions required: bb.databases.getSchema
 	GetSchemaString(ctx context.Context, in *GetSchemaStringRequest, opts ...grpc.CallOption) (*GetSchemaStringResponse, error)
+	// Generates synthetic test data from the schema metadata of a database.
+	// The rows respect column types, nullability, foreign keys, unique indexes,
+	// check constraints, enum types and column semantic types.
+	// Permissions required: bb.databases.getSchema, and bb.sql.dml on the target database when loading into a target database.
+	// Loading uses the admin data source of the target, subject to the query data policy as DML in the SQL editor.
+	GenerateSyntheticData(ctx context.Context, in *GenerateSyntheticDataRequest, opts ...grpc.CallOption) (*GenerateSyntheticDataResponse, error)
 }
 
 type databaseServiceClient struct {
@@ -193,6 +205,16 @@ func (c *databaseServiceClient) GetDatabaseSDLSchema(ctx context.Context, in *Ge
 	return out, nil
 }
 
+func (c *databaseServiceClient) GetDatabaseSDLSchemaFiles(ctx context.Context, in *GetDatabaseSDLSchemaFilesRequest, opts ...grpc.CallOption) (*DatabaseSDLSchemaFiles, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DatabaseSDLSchemaFiles)
+	err := c.cc.Invoke(ctx, DatabaseService_GetDatabaseSDLSchemaFiles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *databaseServiceClient) DiffSchema(ctx context.Context, in *DiffSchemaRequest, opts ...grpc.CallOption) (*DiffSchemaResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DiffSchemaResponse)
@@ -233,6 +255,16 @@ func (c *databaseServiceClient) GetSchemaString(ctx context.Context, in *GetSche
 	return out, nil
 }
 
+func (c *databaseServiceClient) GenerateSyntheticData(ctx context.Context, in *GenerateSyntheticDataRequest, opts ...grpc.CallOption) (*GenerateSyntheticDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateSyntheticDataResponse)
+	err := c.cc.Invoke(ctx, DatabaseService_GenerateSyntheticData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DatabaseServiceServer is the server API for DatabaseService service.
 // All implementations must embed UnimplementedDatabaseServiceServer
 // for forward compatibility.
@@ -269,6 +301,10 @@ type DatabaseServiceServer interface {
 	// Retrieves database schema in SDL (Schema Definition Language) format.
 	// Permissions required: bb.databases.getSchema
 	GetDatabaseSDLSchema(context.Context, *GetDatabaseSDLSchemaRequest) (*DatabaseSDLSchema, error)
+	// Retrieves database schema in SDL format with one file per schema object,
+	// laid out as a declarative repository that can be rolled out unchanged.
+	// Permissions required: bb.databases.getSchema
+	GetDatabaseSDLSchemaFiles(context.Context, *GetDatabaseSDLSchemaFilesRequest) (*DatabaseSDLSchemaFiles, error)
 	// Compares and generates migration statements between two schemas.
 	// Permissions required: bb.databases.get
 	DiffSchema(context.Context, *DiffSchemaRequest) (*DiffSchemaResponse, error)
@@ -281,6 +317,12 @@ type DatabaseServiceServer interface {
 	// Generates schema DDL for a database object.
 	// Permissions required: bb.databases.getSchema
 	GetSchemaString(context.Context, *GetSchemaStringRequest) (*GetSchemaStringResponse, error)
+	// Generates synthetic test data from the schema metadata of a database.
+	// The rows respect column types, nullability, foreign keys, unique indexes,
+	// check constraints, enum types and column semantic types.
+	// Permissions required: bb.databases.getSchema, and bb.sql.dml on the target database when loading into a target database.
+	// Loading uses the admin data source of the target, subject to the query data policy as DML in the SQL editor.
+	GenerateSyntheticData(context.Context, *GenerateSyntheticDataRequest) (*GenerateSyntheticDataResponse, error)
 	mustEmbedUnimplementedDatabaseServiceServer()
 }
 
@@ -321,6 +363,9 @@ func (UnimplementedDatabaseServiceServer) GetDatabaseSchema(context.Context, *Ge
 func (UnimplementedDatabaseServiceServer) GetDatabaseSDLSchema(context.Context, *GetDatabaseSDLSchemaRequest) (*DatabaseSDLSchema, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetDatabaseSDLSchema not implemented")
 }
+func (UnimplementedDatabaseServiceServer) GetDatabaseSDLSchemaFiles(context.Context, *GetDatabaseSDLSchemaFilesRequest) (*DatabaseSDLSchemaFiles, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDatabaseSDLSchemaFiles not implemented")
+}
 func (UnimplementedDatabaseServiceServer) DiffSchema(context.Context, *DiffSchemaRequest) (*DiffSchemaResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DiffSchema not implemented")
 }
@@ -333,6 +378,9 @@ func (UnimplementedDatabaseServiceServer) GetChangelog(context.Context, *GetChan
 func (UnimplementedDatabaseServiceServer) GetSchemaString(context.Context, *GetSchemaStringRequest) (*GetSchemaStringResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetSchemaString not implemented")
 }
+func (UnimplementedDatabaseServiceServer) GenerateSyntheticData(context.Context, *GenerateSyntheticDataRequest) (*GenerateSyntheticDataResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GenerateSyntheticData not implemented")
+}
 func (UnimplementedDatabaseServiceServer) mustEmbedUnimplementedDatabaseServiceServer() {}
 func (UnimplementedDatabaseServiceServer) testEmbeddedByValue()                         {}
 
@@ -534,6 +582,24 @@ func _DatabaseService_GetDatabaseSDLSchema_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DatabaseService_GetDatabaseSDLSchemaFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDatabaseSDLSchemaFilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).GetDatabaseSDLSchemaFiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DatabaseService_GetDatabaseSDLSchemaFiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).GetDatabaseSDLSchemaFiles(ctx, req.(*GetDatabaseSDLSchemaFilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DatabaseService_DiffSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DiffSchemaRequest)
 	if err := dec(in); err != nil {
@@ -606,6 +672,24 @@ func _DatabaseService_GetSchemaString_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DatabaseService_GenerateSyntheticData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateSyntheticDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).GenerateSyntheticData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DatabaseService_GenerateSyntheticData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).GenerateSyntheticData(ctx, req.(*GenerateSyntheticDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DatabaseService_ServiceDesc is the grpc.ServiceDesc for DatabaseService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -653,6 +737,10 @@ var DatabaseService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetDatabaseSDLSchema",
 			Handler:    _DatabaseService_GetDatabaseSDLSchema_Handler,
 		},
+		{
+			MethodName: "GetDatabaseSDLSchemaFiles",
+			Handler:    _DatabaseService_GetDatabaseSDLSchemaFiles_Handler,
+		},
 		{
 			MethodName: "DiffSchema",
 			Handler:    _DatabaseService_DiffSchema_Handler,
@@ -669,6 +757,10 @@ var DatabaseService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSchemaString",
 			Handler:    _DatabaseService_GetSchemaString_Handler,
 		},
+		{
+			MethodName: "GenerateSyntheticData",
+			Handler:    _DatabaseService_GenerateSyntheticData_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/database_service.proto",
@@ -984,7 +984,10 @@ func (x *ListInstanceDatabaseResponse) GetDatabases() []string {
 type SyncInstanceResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// All database name list in the instance.
-	Databases     []string `protobuf:"bytes,1,rep,name=databases,proto3" json:"databases,omitempty"`
+	Databases []string `protobuf:"bytes,1,rep,name=databases,proto3" json:"databases,omitempty"`
+	// The operation tracking the sync of the databases.
+	// The response of the operation is a SyncInstanceResponse.
+	Operation     *Operation `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1026,6 +1029,13 @@ func (x *SyncInstanceResponse) GetDatabases() []string {
 	return nil
 }
 
+func (x *SyncInstanceResponse) GetOperation() *Operation {
+	if x != nil {
+		return x.Operation
+	}
+	return nil
+}
+
 type BatchSyncInstancesRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The request message specifying the instances to sync.
@@ -2841,7 +2851,7 @@ var File_v1_instance_service_proto protoreflect.FileDescriptor
 
 const file_v1_instance_service_proto_rawDesc = "" +
 	"\n" +
-	"\x19v1/instance_service.proto\x12\vbytebase.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x13v1/annotation.proto\x1a\x0fv1/common.proto\x1a\x1ev1/instance_role_service.proto\"G\n" +
+	"\x19v1/instance_service.proto\x12\vbytebase.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x13v1/annotation.proto\x1a\x0fv1/common.proto\x1a\x1ev1/instance_role_service.proto\x1a\x1av1/operation_service.proto\"G\n" +
 	"\x12GetInstanceRequest\x121\n" +
 	"\x04name\x18\x01 \x01(\tB\x1d\xe0A\x02\xfaA\x17\n" +
 	"\x15bytebase.com/InstanceR\x04name\"\xa8\x01\n" +
@@ -2883,9 +2893,10 @@ const file_v1_instance_service_proto_rawDesc = "" +
 	"\binstance\x18\x02 \x01(\v2\x15.bytebase.v1.InstanceB\x03\xe0A\x02H\x00R\binstance\x88\x01\x01B\v\n" +
 	"\t_instance\"<\n" +
 	"\x1cListInstanceDatabaseResponse\x12\x1c\n" +
-	"\tdatabases\x18\x01 \x03(\tR\tdatabases\"4\n" +
+	"\tdatabases\x18\x01 \x03(\tR\tdatabases\"j\n" +
 	"\x14SyncInstanceResponse\x12\x1c\n" +
-	"\tdatabases\x18\x01 \x03(\tR\tdatabases\"^\n" +
+	"\tdatabases\x18\x01 \x03(\tR\tdatabases\x124\n" +
+	"\toperation\x18\x02 \x01(\v2\x16.bytebase.v1.OperationR\toperation\"^\n" +
 	"\x19BatchSyncInstancesRequest\x12A\n" +
 	"\brequests\x18\x01 \x03(\v2 .bytebase.v1.SyncInstanceRequestB\x03\xe0A\x02R\brequests\"\x1c\n" +
 	"\x1aBatchSyncInstancesResponse\"b\n" +
@@ -3165,12 +3176,13 @@ var file_v1_instance_service_proto_goTypes = []any{
 	(*DataSource_Address)(nil),                                 // 35: bytebase.v1.DataSource.Address
 	nil,                                                        // 36: bytebase.v1.DataSource.ExtraConnectionParametersEntry
 	(*fieldmaskpb.FieldMask)(nil),                              // 37: google.protobuf.FieldMask
-	(State)(0),                                                 // 38: bytebase.v1.State
-	(Engine)(0),                                                // 39: bytebase.v1.Engine
-	(*InstanceRole)(nil),                                       // 40: bytebase.v1.InstanceRole
-	(*durationpb.Duration)(nil),                                // 41: google.protobuf.Duration
-	(*timestamppb.Timestamp)(nil),                              // 42: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),                                      // 43: google.protobuf.Empty
+	(*Operation)(nil),                                          // 38: bytebase.v1.Operation
+	(State)(0),                                                 // 39: bytebase.v1.State
+	(Engine)(0),                                                // 40: bytebase.v1.Engine
+	(*InstanceRole)(nil),                                       // 41: bytebase.v1.InstanceRole
+	(*durationpb.Duration)(nil),                                // 42: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),                              // 43: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                                      // 44: google.protobuf.Empty
 }
 var file_v1_instance_service_proto_depIdxs = []int32{
 	24, // 0: bytebase.v1.ListInstancesResponse.instances:type_name -> bytebase.v1.Instance
@@ -3178,68 +3190,69 @@ var file_v1_instance_service_proto_depIdxs = []int32{
 	24, // 2: bytebase.v1.UpdateInstanceRequest.instance:type_name -> bytebase.v1.Instance
 	37, // 3: bytebase.v1.UpdateInstanceRequest.update_mask:type_name -> google.protobuf.FieldMask
 	24, // 4: bytebase.v1.ListInstanceDatabaseRequest.instance:type_name -> bytebase.v1.Instance
-	13, // 5: bytebase.v1.BatchSyncInstancesRequest.requests:type_name -> bytebase.v1.SyncInstanceRequest
-	10, // 6: bytebase.v1.BatchUpdateInstancesRequest.requests:type_name -> bytebase.v1.UpdateInstanceRequest
-	24, // 7: bytebase.v1.BatchUpdateInstancesResponse.instances:type_name -> bytebase.v1.Instance
-	26, // 8: bytebase.v1.AddDataSourceRequest.data_source:type_name -> bytebase.v1.DataSource
-	26, // 9: bytebase.v1.RemoveDataSourceRequest.data_source:type_name -> bytebase.v1.DataSource
-	26, // 10: bytebase.v1.UpdateDataSourceRequest.data_source:type_name -> bytebase.v1.DataSource
-	37, // 11: bytebase.v1.UpdateDataSourceRequest.update_mask:type_name -> google.protobuf.FieldMask
-	38, // 12: bytebase.v1.Instance.state:type_name -> bytebase.v1.State
-	39, // 13: bytebase.v1.Instance.engine:type_name -> bytebase.v1.Engine
-	26, // 14: bytebase.v1.Instance.data_sources:type_name -> bytebase.v1.DataSource
-	40, // 15: bytebase.v1.Instance.roles:type_name -> bytebase.v1.InstanceRole
-	41, // 16: bytebase.v1.Instance.sync_interval:type_name -> google.protobuf.Duration
-	42, // 17: bytebase.v1.Instance.last_sync_time:type_name -> google.protobuf.Timestamp
-	30, // 18: bytebase.v1.Instance.labels:type_name -> bytebase.v1.Instance.LabelsEntry
-	1,  // 19: bytebase.v1.DataSourceExternalSecret.secret_type:type_name -> bytebase.v1.DataSourceExternalSecret.SecretType
-	2,  // 20: bytebase.v1.DataSourceExternalSecret.auth_type:type_name -> bytebase.v1.DataSourceExternalSecret.AuthType
-	31, // 21: bytebase.v1.DataSourceExternalSecret.app_role:type_name -> bytebase.v1.DataSourceExternalSecret.AppRoleAuthOption
-	0,  // 22: bytebase.v1.DataSource.type:type_name -> bytebase.v1.DataSourceType
-	25, // 23: bytebase.v1.DataSource.external_secret:type_name -> bytebase.v1.DataSourceExternalSecret
-	4,  // 24: bytebase.v1.DataSource.authentication_type:type_name -> bytebase.v1.DataSource.AuthenticationType
-	32, // 25: bytebase.v1.DataSource.azure_credential:type_name -> bytebase.v1.DataSource.AzureCredential
-	33, // 26: bytebase.v1.DataSource.aws_credential:type_name -> bytebase.v1.DataSource.AWSCredential
-	34, // 27: bytebase.v1.DataSource.gcp_credential:type_name -> bytebase.v1.DataSource.GCPCredential
-	28, // 28: bytebase.v1.DataSource.sasl_config:type_name -> bytebase.v1.SASLConfig
-	35, // 29: bytebase.v1.DataSource.additional_addresses:type_name -> bytebase.v1.DataSource.Address
-	5,  // 30: bytebase.v1.DataSource.redis_type:type_name -> bytebase.v1.DataSource.RedisType
-	36, // 31: bytebase.v1.DataSource.extra_connection_parameters:type_name -> bytebase.v1.DataSource.ExtraConnectionParametersEntry
-	39, // 32: bytebase.v1.InstanceResource.engine:type_name -> bytebase.v1.Engine
-	26, // 33: bytebase.v1.InstanceResource.data_sources:type_name -> bytebase.v1.DataSource
-	29, // 34: bytebase.v1.SASLConfig.krb_config:type_name -> bytebase.v1.KerberosConfig
-	3,  // 35: bytebase.v1.DataSourceExternalSecret.AppRoleAuthOption.type:type_name -> bytebase.v1.DataSourceExternalSecret.AppRoleAuthOption.SecretType
-	6,  // 36: bytebase.v1.InstanceService.GetInstance:input_type -> bytebase.v1.GetInstanceRequest
-	7,  // 37: bytebase.v1.InstanceService.ListInstances:input_type -> bytebase.v1.ListInstancesRequest
-	9,  // 38: bytebase.v1.InstanceService.CreateInstance:input_type -> bytebase.v1.CreateInstanceRequest
-	10, // 39: bytebase.v1.InstanceService.UpdateInstance:input_type -> bytebase.v1.UpdateInstanceRequest
-	11, // 40: bytebase.v1.InstanceService.DeleteInstance:input_type -> bytebase.v1.DeleteInstanceRequest
-	12, // 41: bytebase.v1.InstanceService.UndeleteInstance:input_type -> bytebase.v1.UndeleteInstanceRequest
-	13, // 42: bytebase.v1.InstanceService.SyncInstance:input_type -> bytebase.v1.SyncInstanceRequest
-	14, // 43: bytebase.v1.InstanceService.ListInstanceDatabase:input_type -> bytebase.v1.ListInstanceDatabaseRequest
-	17, // 44: bytebase.v1.InstanceService.BatchSyncInstances:input_type -> bytebase.v1.BatchSyncInstancesRequest
-	19, // 45: bytebase.v1.InstanceService.BatchUpdateInstances:input_type -> bytebase.v1.BatchUpdateInstancesRequest
-	21, // 46: bytebase.v1.InstanceService.AddDataSource:input_type -> bytebase.v1.AddDataSourceRequest
-	22, // 47: bytebase.v1.InstanceService.RemoveDataSource:input_type -> bytebase.v1.RemoveDataSourceRequest
-	23, // 48: bytebase.v1.InstanceService.UpdateDataSource:input_type -> bytebase.v1.UpdateDataSourceRequest
-	24, // 49: bytebase.v1.InstanceService.GetInstance:output_type -> bytebase.v1.Instance
-	8,  // 50: bytebase.v1.InstanceService.ListInstances:output_type -> bytebase.v1.ListInstancesResponse
-	24, // 51: bytebase.v1.InstanceService.CreateInstance:output_type -> bytebase.v1.Instance
-	24, // 52: bytebase.v1.InstanceService.UpdateInstance:output_type -> bytebase.v1.Instance
-	43, // 53: bytebase.v1.InstanceService.DeleteInstance:output_type -> google.protobuf.Empty
-	24, // 54: bytebase.v1.InstanceService.UndeleteInstance:output_type -> bytebase.v1.Instance
-	16, // 55: bytebase.v1.InstanceService.SyncInstance:output_type -> bytebase.v1.SyncInstanceResponse
-	15, // 56: bytebase.v1.InstanceService.ListInstanceDatabase:output_type -> bytebase.v1.ListInstanceDatabaseResponse
-	18, // 57: bytebase.v1.InstanceService.BatchSyncInstances:output_type -> bytebase.v1.BatchSyncInstancesResponse
-	20, // 58: bytebase.v1.InstanceService.BatchUpdateInstances:output_type -> bytebase.v1.BatchUpdateInstancesResponse
-	24, // 59: bytebase.v1.InstanceService.AddDataSource:output_type -> bytebase.v1.Instance
-	24, // 60: bytebase.v1.InstanceService.RemoveDataSource:output_type -> bytebase.v1.Instance
-	24, // 61: bytebase.v1.InstanceService.UpdateDataSource:output_type -> bytebase.v1.Instance
-	49, // [49:62] is the sub-list for method output_type
-	36, // [36:49] is the sub-list for method input_type
-	36, // [36:36] is the sub-list for extension type_name
-	36, // [36:36] is the sub-list for extension extendee
-	0,  // [0:36] is the sub-list for field type_name
+	38, // 5: bytebase.v1.SyncInstanceResponse.operation:type_name -> bytebase.v1.Operation
+	13, // 6: bytebase.v1.BatchSyncInstancesRequest.requests:type_name -> bytebase.v1.SyncInstanceRequest
+	10, // 7: bytebase.v1.BatchUpdateInstancesRequest.requests:type_name -> bytebase.v1.UpdateInstanceRequest
+	24, // 8: bytebase.v1.BatchUpdateInstancesResponse.instances:type_name -> bytebase.v1.Instance
+	26, // 9: bytebase.v1.AddDataSourceRequest.data_source:type_name -> bytebase.v1.DataSource
+	26, // 10: bytebase.v1.RemoveDataSourceRequest.data_source:type_name -> bytebase.v1.DataSource
+	26, // 11: bytebase.v1.UpdateDataSourceRequest.data_source:type_name -> bytebase.v1.DataSource
+	37, // 12: bytebase.v1.UpdateDataSourceRequest.update_mask:type_name -> google.protobuf.FieldMask
+	39, // 13: bytebase.v1.Instance.state:type_name -> bytebase.v1.State
+	40, // 14: bytebase.v1.Instance.engine:type_name -> bytebase.v1.Engine
+	26, // 15: bytebase.v1.Instance.data_sources:type_name -> bytebase.v1.DataSource
+	41, // 16: bytebase.v1.Instance.roles:type_name -> bytebase.v1.InstanceRole
+	42, // 17: bytebase.v1.Instance.sync_interval:type_name -> google.protobuf.Duration
+	43, // 18: bytebase.v1.Instance.last_sync_time:type_name -> google.protobuf.Timestamp
+	30, // 19: bytebase.v1.Instance.labels:type_name -> bytebase.v1.Instance.LabelsEntry
+	1,  // 20: bytebase.v1.DataSourceExternalSecret.secret_type:type_name -> bytebase.v1.DataSourceExternalSecret.SecretType
+	2,  // 21: bytebase.v1.DataSourceExternalSecret.auth_type:type_name -> bytebase.v1.DataSourceExternalSecret.AuthType
+	31, // 22: bytebase.v1.DataSourceExternalSecret.app_role:type_name -> bytebase.v1.DataSourceExternalSecret.AppRoleAuthOption
+	0,  // 23: bytebase.v1.DataSource.type:type_name -> bytebase.v1.DataSourceType
+	25, // 24: bytebase.v1.DataSource.external_secret:type_name -> bytebase.v1.DataSourceExternalSecret
+	4,  // 25: bytebase.v1.DataSource.authentication_type:type_name -> bytebase.v1.DataSource.AuthenticationType
+	32, // 26: bytebase.v1.DataSource.azure_credential:type_name -> bytebase.v1.DataSource.AzureCredential
+	33, // 27: bytebase.v1.DataSource.aws_credential:type_name -> bytebase.v1.DataSource.AWSCredential
+	34, // 28: bytebase.v1.DataSource.gcp_credential:type_name -> bytebase.v1.DataSource.GCPCredential
+	28, // 29: bytebase.v1.DataSource.sasl_config:type_name -> bytebase.v1.SASLConfig
+	35, // 30: bytebase.v1.DataSource.additional_addresses:type_name -> bytebase.v1.DataSource.Address
+	5,  // 31: bytebase.v1.DataSource.redis_type:type_name -> bytebase.v1.DataSource.RedisType
+	36, // 32: bytebase.v1.DataSource.extra_connection_parameters:type_name -> bytebase.v1.DataSource.ExtraConnectionParametersEntry
+	40, // 33: bytebase.v1.InstanceResource.engine:type_name -> bytebase.v1.Engine
+	26, // 34: bytebase.v1.InstanceResource.data_sources:type_name -> bytebase.v1.DataSource
+	29, // 35: bytebase.v1.SASLConfig.krb_config:type_name -> bytebase.v1.KerberosConfig
+	3,  // 36: bytebase.v1.DataSourceExternalSecret.AppRoleAuthOption.type:type_name -> bytebase.v1.DataSourceExternalSecret.AppRoleAuthOption.SecretType
+	6,  // 37: bytebase.v1.InstanceService.GetInstance:input_type -> bytebase.v1.GetInstanceRequest
+	7,  // 38: bytebase.v1.InstanceService.ListInstances:input_type -> bytebase.v1.ListInstancesRequest
+	9,  // 39: bytebase.v1.InstanceService.CreateInstance:input_type -> bytebase.v1.CreateInstanceRequest
+	10, // 40: bytebase.v1.InstanceService.UpdateInstance:input_type -> bytebase.v1.UpdateInstanceRequest
+	11, // 41: bytebase.v1.InstanceService.DeleteInstance:input_type -> bytebase.v1.DeleteInstanceRequest
+	12, // 42: bytebase.v1.InstanceService.UndeleteInstance:input_type -> bytebase.v1.UndeleteInstanceRequest
+	13, // 43: bytebase.v1.InstanceService.SyncInstance:input_type -> bytebase.v1.SyncInstanceRequest
+	14, // 44: bytebase.v1.InstanceService.ListInstanceDatabase:input_type -> bytebase.v1.ListInstanceDatabaseRequest
+	17, // 45: bytebase.v1.InstanceService.BatchSyncInstances:input_type -> bytebase.v1.BatchSyncInstancesRequest
+	19, // 46: bytebase.v1.InstanceService.BatchUpdateInstances:input_type -> bytebase.v1.BatchUpdateInstancesRequest
+	21, // 47: bytebase.v1.InstanceService.AddDataSource:input_type -> bytebase.v1.AddDataSourceRequest
+	22, // 48: bytebase.v1.InstanceService.RemoveDataSource:input_type -> bytebase.v1.RemoveDataSourceRequest
+	23, // 49: bytebase.v1.InstanceService.UpdateDataSource:input_type -> bytebase.v1.UpdateDataSourceRequest
+	24, // 50: bytebase.v1.InstanceService.GetInstance:output_type -> bytebase.v1.Instance
+	8,  // 51: bytebase.v1.InstanceService.ListInstances:output_type -> bytebase.v1.ListInstancesResponse
+	24, // 52: bytebase.v1.InstanceService.CreateInstance:output_type -> bytebase.v1.Instance
+	24, // 53: bytebase.v1.InstanceService.UpdateInstance:output_type -> bytebase.v1.Instance
+	44, // 54: bytebase.v1.InstanceService.DeleteInstance:output_type -> google.protobuf.Empty
+	24, // 55: bytebase.v1.InstanceService.UndeleteInstance:output_type -> bytebase.v1.Instance
+	16, // 56: bytebase.v1.InstanceService.SyncInstance:output_type -> bytebase.v1.SyncInstanceResponse
+	15, // 57: bytebase.v1.InstanceService.ListInstanceDatabase:output_type -> bytebase.v1.ListInstanceDatabaseResponse
+	18, // 58: bytebase.v1.InstanceService.BatchSyncInstances:output_type -> bytebase.v1.BatchSyncInstancesResponse
+	20, // 59: bytebase.v1.InstanceService.BatchUpdateInstances:output_type -> bytebase.v1.BatchUpdateInstancesResponse
+	24, // 60: bytebase.v1.InstanceService.AddDataSource:output_type -> bytebase.v1.Instance
+	24, // 61: bytebase.v1.InstanceService.RemoveDataSource:output_type -> bytebase.v1.Instance
+	24, // 62: bytebase.v1.InstanceService.UpdateDataSource:output_type -> bytebase.v1.Instance
+	50, // [50:63] is the sub-list for method output_type
+	37, // [37:50] is the sub-list for method input_type
+	37, // [37:37] is the sub-list for extension type_name
+	37, // [37:37] is the sub-list for extension extendee
+	0,  // [0:37] is the sub-list for field type_name
 }
 
 func init() { file_v1_instance_service_proto_init() }
@@ -3250,6 +3263,7 @@ func file_v1_instance_service_proto_init() {
 	file_v1_annotation_proto_init()
 	file_v1_common_proto_init()
 	file_v1_instance_role_service_proto_init()
+	file_v1_operation_service_proto_init()
 	file_v1_instance_service_proto_msgTypes[8].OneofWrappers = []any{}
 	file_v1_instance_service_proto_msgTypes[18].OneofWrappers = []any{}
 	file_v1_instance_service_proto_msgTypes[19].OneofWrappers = []any{
@@ -206,6 +206,9 @@ func (x *SyncInstanceResponse) Equal(y *SyncInstanceResponse) bool {
 			return false
 		}
 	}
+	if !x.Operation.Equal(y.Operation) {
+		return false
+	}
 	return true
 }
 
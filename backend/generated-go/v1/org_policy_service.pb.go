@@ -41,6 +41,8 @@ const (
 	PolicyType_TAG PolicyType = 4
 	// Query data access policy.
 	PolicyType_DATA_QUERY PolicyType = 6
+	// Row filter policy.
+	PolicyType_ROW_FILTER PolicyType = 7
 )
 
 // Enum value maps for PolicyType.
@@ -52,6 +54,7 @@ var (
 		3: "ROLLOUT_POLICY",
 		4: "TAG",
 		6: "DATA_QUERY",
+		7: "ROW_FILTER",
 	}
 	PolicyType_value = map[string]int32{
 		"POLICY_TYPE_UNSPECIFIED": 0,
@@ -60,6 +63,7 @@ var (
 		"ROLLOUT_POLICY":          3,
 		"TAG":                     4,
 		"DATA_QUERY":              6,
+		"ROW_FILTER":              7,
 	}
 )
 
@@ -512,6 +516,7 @@ type Policy struct {
 	//	*Policy_MaskingExemptionPolicy
 	//	*Policy_TagPolicy
 	//	*Policy_QueryDataPolicy
+	//	*Policy_RowFilterPolicy
 	Policy isPolicy_Policy `protobuf_oneof:"policy"`
 	// Whether the policy is enforced.
 	Enforce bool `protobuf:"varint,10,opt,name=enforce,proto3" json:"enforce,omitempty"`
@@ -624,6 +629,15 @@ func (x *Policy) GetQueryDataPolicy() *QueryDataPolicy {
 	return nil
 }
 
+func (x *Policy) GetRowFilterPolicy() *RowFilterPolicy {
+	if x != nil {
+		if x, ok := x.Policy.(*Policy_RowFilterPolicy); ok {
+			return x.RowFilterPolicy
+		}
+	}
+	return nil
+}
+
 func (x *Policy) GetEnforce() bool {
 	if x != nil {
 		return x.Enforce
@@ -662,6 +676,10 @@ type Policy_QueryDataPolicy struct {
 	QueryDataPolicy *QueryDataPolicy `protobuf:"bytes,9,opt,name=query_data_policy,json=queryDataPolicy,proto3,oneof"`
 }
 
+type Policy_RowFilterPolicy struct {
+	RowFilterPolicy *RowFilterPolicy `protobuf:"bytes,12,opt,name=row_filter_policy,json=rowFilterPolicy,proto3,oneof"`
+}
+
 func (*Policy_RolloutPolicy) isPolicy_Policy() {}
 
 func (*Policy_MaskingRulePolicy) isPolicy_Policy() {}
@@ -672,6 +690,8 @@ func (*Policy_TagPolicy) isPolicy_Policy() {}
 
 func (*Policy_QueryDataPolicy) isPolicy_Policy() {}
 
+func (*Policy_RowFilterPolicy) isPolicy_Policy() {}
+
 // Rollout policy configuration.
 type RolloutPolicy struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -747,8 +767,14 @@ type QueryDataPolicy struct {
 	// 1. when read-only data source is configured, users're force to use the read-only data source
 	// 2. otherwise fallback to use the admin data source.
 	AllowAdminDataSource bool `protobuf:"varint,4,opt,name=allow_admin_data_source,json=allowAdminDataSource,proto3" json:"allow_admin_data_source,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+	// Support both project-level and workspace-level.
+	// Queries and exports against databases containing data at or above this
+	// classification level require a justification with a reason and a ticket.
+	// The stricter of the workspace-level and project-level values takes effect.
+	// The default value <= 0, means no justification is required.
+	JustificationClassificationLevel int32 `protobuf:"varint,5,opt,name=justification_classification_level,json=justificationClassificationLevel,proto3" json:"justification_classification_level,omitempty"`
+	unknownFields                    protoimpl.UnknownFields
+	sizeCache                        protoimpl.SizeCache
 }
 
 func (x *QueryDataPolicy) Reset() {
@@ -809,6 +835,13 @@ func (x *QueryDataPolicy) GetAllowAdminDataSource() bool {
 	return false
 }
 
+func (x *QueryDataPolicy) GetJustificationClassificationLevel() int32 {
+	if x != nil {
+		return x.JustificationClassificationLevel
+	}
+	return 0
+}
+
 // MaskingExemptionPolicy is the allowlist of users who can access sensitive data.
 type MaskingExemptionPolicy struct {
 	state         protoimpl.MessageState              `protogen:"open.v1"`
@@ -900,6 +933,54 @@ func (x *MaskingRulePolicy) GetRules() []*MaskingRulePolicy_MaskingRule {
 	return nil
 }
 
+// RowFilterPolicy restricts the rows that members can read in the SQL Editor.
+// It is a project-level policy enforced on queries and exports of Postgres and MySQL databases.
+// The filters applied to a member are combined with "AND".
+type RowFilterPolicy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The list of row filters.
+	Filters       []*RowFilterPolicy_RowFilter `protobuf:"bytes,1,rep,name=filters,proto3" json:"filters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RowFilterPolicy) Reset() {
+	*x = RowFilterPolicy{}
+	mi := &file_v1_org_policy_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RowFilterPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RowFilterPolicy) ProtoMessage() {}
+
+func (x *RowFilterPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_org_policy_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RowFilterPolicy.ProtoReflect.Descriptor instead.
+func (*RowFilterPolicy) Descriptor() ([]byte, []int) {
+	return file_v1_org_policy_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RowFilterPolicy) GetFilters() []*RowFilterPolicy_RowFilter {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
 // Policy for tagging resources with metadata.
 type TagPolicy struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -912,7 +993,7 @@ type TagPolicy struct {
 
 func (x *TagPolicy) Reset() {
 	*x = TagPolicy{}
-	mi := &file_v1_org_policy_service_proto_msgTypes[11]
+	mi := &file_v1_org_policy_service_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -924,7 +1005,7 @@ func (x *TagPolicy) String() string {
 func (*TagPolicy) ProtoMessage() {}
 
 func (x *TagPolicy) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_org_policy_service_proto_msgTypes[11]
+	mi := &file_v1_org_policy_service_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -937,7 +1018,7 @@ func (x *TagPolicy) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TagPolicy.ProtoReflect.Descriptor instead.
 func (*TagPolicy) Descriptor() ([]byte, []int) {
-	return file_v1_org_policy_service_proto_rawDescGZIP(), []int{11}
+	return file_v1_org_policy_service_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *TagPolicy) GetTags() map[string]string {
@@ -978,7 +1059,7 @@ type MaskingExemptionPolicy_Exemption struct {
 
 func (x *MaskingExemptionPolicy_Exemption) Reset() {
 	*x = MaskingExemptionPolicy_Exemption{}
-	mi := &file_v1_org_policy_service_proto_msgTypes[12]
+	mi := &file_v1_org_policy_service_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -990,7 +1071,7 @@ func (x *MaskingExemptionPolicy_Exemption) String() string {
 func (*MaskingExemptionPolicy_Exemption) ProtoMessage() {}
 
 func (x *MaskingExemptionPolicy_Exemption) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_org_policy_service_proto_msgTypes[12]
+	mi := &file_v1_org_policy_service_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1058,7 +1139,7 @@ type MaskingRulePolicy_MaskingRule struct {
 
 func (x *MaskingRulePolicy_MaskingRule) Reset() {
 	*x = MaskingRulePolicy_MaskingRule{}
-	mi := &file_v1_org_policy_service_proto_msgTypes[13]
+	mi := &file_v1_org_policy_service_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1070,7 +1151,7 @@ func (x *MaskingRulePolicy_MaskingRule) String() string {
 func (*MaskingRulePolicy_MaskingRule) ProtoMessage() {}
 
 func (x *MaskingRulePolicy_MaskingRule) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_org_policy_service_proto_msgTypes[13]
+	mi := &file_v1_org_policy_service_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1107,6 +1188,125 @@ func (x *MaskingRulePolicy_MaskingRule) GetSemanticType() string {
 	return ""
 }
 
+// A filter on the rows of the tables matched by the condition.
+type RowFilterPolicy_RowFilter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// A unique identifier of the filter.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The title shown to users when the filter is applied to their queries.
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// The members the filter applies to.
+	// For users, the member should be: user:{email}
+	// For groups, the member should be: group:{email}
+	Members []string `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`
+	// The project roles the filter applies to.
+	// Format: roles/{role}
+	Roles []string `protobuf:"bytes,4,rep,name=roles,proto3" json:"roles,omitempty"`
+	// The tables the filter applies to.
+	// The syntax and semantics of CEL are documented at https://github.com/google/cel-spec
+	// If the condition is empty, the filter applies to all tables that have the columns referenced by the expression.
+	//
+	// Support variables:
+	// resource.instance_id: the instance resource id.
+	// resource.database_name: the database name.
+	// resource.schema_name: the schema name.
+	// resource.table_name: the table name.
+	//
+	// For example:
+	// resource.database_name == "support" && resource.table_name in ["tickets", "customers"]
+	Condition *expr.Expr `protobuf:"bytes,5,opt,name=condition,proto3" json:"condition,omitempty"`
+	// The rows that the members can read.
+	// The syntax and semantics of CEL are documented at https://github.com/google/cel-spec
+	// The expression is rewritten into a SQL predicate, so only comparisons, "in" with lists,
+	// "&&", "||" and "!" can use the row columns.
+	//
+	// Support variables:
+	// row.{column}: the column of the row.
+	// user.email: the email of the user.
+	// user.name: the name of the user.
+	// user.groups: the groups of the user in group:{email} format.
+	// user.roles: the project roles of the user in roles/{role} format.
+	//
+	// For example:
+	// row.region == "emea"
+	// row.owner_email == user.email || "roles/projectOwner" in user.roles
+	Expression    *expr.Expr `protobuf:"bytes,6,opt,name=expression,proto3" json:"expression,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RowFilterPolicy_RowFilter) Reset() {
+	*x = RowFilterPolicy_RowFilter{}
+	mi := &file_v1_org_policy_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RowFilterPolicy_RowFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RowFilterPolicy_RowFilter) ProtoMessage() {}
+
+func (x *RowFilterPolicy_RowFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_org_policy_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RowFilterPolicy_RowFilter.ProtoReflect.Descriptor instead.
+func (*RowFilterPolicy_RowFilter) Descriptor() ([]byte, []int) {
+	return file_v1_org_policy_service_proto_rawDescGZIP(), []int{11, 0}
+}
+
+func (x *RowFilterPolicy_RowFilter) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RowFilterPolicy_RowFilter) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *RowFilterPolicy_RowFilter) GetMembers() []string {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *RowFilterPolicy_RowFilter) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *RowFilterPolicy_RowFilter) GetCondition() *expr.Expr {
+	if x != nil {
+		return x.Condition
+	}
+	return nil
+}
+
+func (x *RowFilterPolicy_RowFilter) GetExpression() *expr.Expr {
+	if x != nil {
+		return x.Expression
+	}
+	return nil
+}
+
 var File_v1_org_policy_service_proto protoreflect.FileDescriptor
 
 const file_v1_org_policy_service_proto_rawDesc = "" +
@@ -1134,7 +1334,7 @@ const file_v1_org_policy_service_proto_rawDesc = "" +
 	"\fshow_deleted\x18\x03 \x01(\bR\vshowDeletedB\x0e\n" +
 	"\f_policy_type\"G\n" +
 	"\x14ListPoliciesResponse\x12/\n" +
-	"\bpolicies\x18\x01 \x03(\v2\x13.bytebase.v1.PolicyR\bpolicies\"\xe4\x06\n" +
+	"\bpolicies\x18\x01 \x03(\v2\x13.bytebase.v1.PolicyR\bpolicies\"\xb0\a\n" +
 	"\x06Policy\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12.\n" +
 	"\x13inherit_from_parent\x18\x02 \x01(\bR\x11inheritFromParent\x12+\n" +
@@ -1144,7 +1344,8 @@ const file_v1_org_policy_service_proto_rawDesc = "" +
 	"\x18masking_exemption_policy\x18\x06 \x01(\v2#.bytebase.v1.MaskingExemptionPolicyH\x00R\x16maskingExemptionPolicy\x127\n" +
 	"\n" +
 	"tag_policy\x18\a \x01(\v2\x16.bytebase.v1.TagPolicyH\x00R\ttagPolicy\x12J\n" +
-	"\x11query_data_policy\x18\t \x01(\v2\x1c.bytebase.v1.QueryDataPolicyH\x00R\x0fqueryDataPolicy\x12\x18\n" +
+	"\x11query_data_policy\x18\t \x01(\v2\x1c.bytebase.v1.QueryDataPolicyH\x00R\x0fqueryDataPolicy\x12J\n" +
+	"\x11row_filter_policy\x18\f \x01(\v2\x1c.bytebase.v1.RowFilterPolicyH\x00R\x0frowFilterPolicy\x12\x18\n" +
 	"\aenforce\x18\n" +
 	" \x01(\bR\aenforce\x12I\n" +
 	"\rresource_type\x18\v \x01(\x0e2\x1f.bytebase.v1.PolicyResourceTypeB\x03\xe0A\x03R\fresourceType:\xfc\x01\xeaA\xf8\x01\n" +
@@ -1152,12 +1353,13 @@ const file_v1_org_policy_service_proto_rawDesc = "" +
 	"\x06policy\"C\n" +
 	"\rRolloutPolicy\x12\x1c\n" +
 	"\tautomatic\x18\x01 \x01(\bR\tautomatic\x12\x14\n" +
-	"\x05roles\x18\x02 \x03(\tR\x05roles\"\xcb\x01\n" +
+	"\x05roles\x18\x02 \x03(\tR\x05roles\"\x99\x02\n" +
 	"\x0fQueryDataPolicy\x12.\n" +
 	"\x13maximum_result_rows\x18\x01 \x01(\x05R\x11maximumResultRows\x12%\n" +
 	"\x0edisable_export\x18\x02 \x01(\bR\rdisableExport\x12*\n" +
 	"\x11disable_copy_data\x18\x03 \x01(\bR\x0fdisableCopyData\x125\n" +
-	"\x17allow_admin_data_source\x18\x04 \x01(\bR\x14allowAdminDataSource\"\xbf\x01\n" +
+	"\x17allow_admin_data_source\x18\x04 \x01(\bR\x14allowAdminDataSource\x12L\n" +
+	"\"justification_classification_level\x18\x05 \x01(\x05R justificationClassificationLevel\"\xbf\x01\n" +
 	"\x16MaskingExemptionPolicy\x12M\n" +
 	"\n" +
 	"exemptions\x18\x01 \x03(\v2-.bytebase.v1.MaskingExemptionPolicy.ExemptionR\n" +
@@ -1170,12 +1372,23 @@ const file_v1_org_policy_service_proto_rawDesc = "" +
 	"\vMaskingRule\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12/\n" +
 	"\tcondition\x18\x02 \x01(\v2\x11.google.type.ExprR\tcondition\x12#\n" +
-	"\rsemantic_type\x18\x03 \x01(\tR\fsemanticType\"z\n" +
+	"\rsemantic_type\x18\x03 \x01(\tR\fsemanticType\"\x9b\x02\n" +
+	"\x0fRowFilterPolicy\x12@\n" +
+	"\afilters\x18\x01 \x03(\v2&.bytebase.v1.RowFilterPolicy.RowFilterR\afilters\x1a\xc5\x01\n" +
+	"\tRowFilter\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
+	"\amembers\x18\x03 \x03(\tR\amembers\x12\x14\n" +
+	"\x05roles\x18\x04 \x03(\tR\x05roles\x12/\n" +
+	"\tcondition\x18\x05 \x01(\v2\x11.google.type.ExprR\tcondition\x121\n" +
+	"\n" +
+	"expression\x18\x06 \x01(\v2\x11.google.type.ExprR\n" +
+	"expression\"z\n" +
 	"\tTagPolicy\x124\n" +
 	"\x04tags\x18\x01 \x03(\v2 .bytebase.v1.TagPolicy.TagsEntryR\x04tags\x1a7\n" +
 	"\tTagsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01*\x7f\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01*\x8f\x01\n" +
 	"\n" +
 	"PolicyType\x12\x1b\n" +
 	"\x17POLICY_TYPE_UNSPECIFIED\x10\x00\x12\x10\n" +
@@ -1184,7 +1397,9 @@ const file_v1_org_policy_service_proto_rawDesc = "" +
 	"\x0eROLLOUT_POLICY\x10\x03\x12\a\n" +
 	"\x03TAG\x10\x04\x12\x0e\n" +
 	"\n" +
-	"DATA_QUERY\x10\x06*`\n" +
+	"DATA_QUERY\x10\x06\x12\x0e\n" +
+	"\n" +
+	"ROW_FILTER\x10\a*`\n" +
 	"\x12PolicyResourceType\x12\x1d\n" +
 	"\x19RESOURCE_TYPE_UNSPECIFIED\x10\x00\x12\r\n" +
 	"\tWORKSPACE\x10\x01\x12\x0f\n" +
@@ -1211,7 +1426,7 @@ func file_v1_org_policy_service_proto_rawDescGZIP() []byte {
 }
 
 var file_v1_org_policy_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_v1_org_policy_service_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_v1_org_policy_service_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_v1_org_policy_service_proto_goTypes = []any{
 	(PolicyType)(0),                          // 0: bytebase.v1.PolicyType
 	(PolicyResourceType)(0),                  // 1: bytebase.v1.PolicyResourceType
@@ -1226,48 +1441,54 @@ var file_v1_org_policy_service_proto_goTypes = []any{
 	(*QueryDataPolicy)(nil),                  // 10: bytebase.v1.QueryDataPolicy
 	(*MaskingExemptionPolicy)(nil),           // 11: bytebase.v1.MaskingExemptionPolicy
 	(*MaskingRulePolicy)(nil),                // 12: bytebase.v1.MaskingRulePolicy
-	(*TagPolicy)(nil),                        // 13: bytebase.v1.TagPolicy
-	(*MaskingExemptionPolicy_Exemption)(nil), // 14: bytebase.v1.MaskingExemptionPolicy.Exemption
-	(*MaskingRulePolicy_MaskingRule)(nil),    // 15: bytebase.v1.MaskingRulePolicy.MaskingRule
-	nil,                                      // 16: bytebase.v1.TagPolicy.TagsEntry
-	(*fieldmaskpb.FieldMask)(nil),            // 17: google.protobuf.FieldMask
-	(*expr.Expr)(nil),                        // 18: google.type.Expr
-	(*emptypb.Empty)(nil),                    // 19: google.protobuf.Empty
+	(*RowFilterPolicy)(nil),                  // 13: bytebase.v1.RowFilterPolicy
+	(*TagPolicy)(nil),                        // 14: bytebase.v1.TagPolicy
+	(*MaskingExemptionPolicy_Exemption)(nil), // 15: bytebase.v1.MaskingExemptionPolicy.Exemption
+	(*MaskingRulePolicy_MaskingRule)(nil),    // 16: bytebase.v1.MaskingRulePolicy.MaskingRule
+	(*RowFilterPolicy_RowFilter)(nil),        // 17: bytebase.v1.RowFilterPolicy.RowFilter
+	nil,                                      // 18: bytebase.v1.TagPolicy.TagsEntry
+	(*fieldmaskpb.FieldMask)(nil),            // 19: google.protobuf.FieldMask
+	(*expr.Expr)(nil),                        // 20: google.type.Expr
+	(*emptypb.Empty)(nil),                    // 21: google.protobuf.Empty
 }
 var file_v1_org_policy_service_proto_depIdxs = []int32{
 	8,  // 0: bytebase.v1.CreatePolicyRequest.policy:type_name -> bytebase.v1.Policy
 	0,  // 1: bytebase.v1.CreatePolicyRequest.type:type_name -> bytebase.v1.PolicyType
 	8,  // 2: bytebase.v1.UpdatePolicyRequest.policy:type_name -> bytebase.v1.Policy
-	17, // 3: bytebase.v1.UpdatePolicyRequest.update_mask:type_name -> google.protobuf.FieldMask
+	19, // 3: bytebase.v1.UpdatePolicyRequest.update_mask:type_name -> google.protobuf.FieldMask
 	0,  // 4: bytebase.v1.ListPoliciesRequest.policy_type:type_name -> bytebase.v1.PolicyType
 	8,  // 5: bytebase.v1.ListPoliciesResponse.policies:type_name -> bytebase.v1.Policy
 	0,  // 6: bytebase.v1.Policy.type:type_name -> bytebase.v1.PolicyType
 	9,  // 7: bytebase.v1.Policy.rollout_policy:type_name -> bytebase.v1.RolloutPolicy
 	12, // 8: bytebase.v1.Policy.masking_rule_policy:type_name -> bytebase.v1.MaskingRulePolicy
 	11, // 9: bytebase.v1.Policy.masking_exemption_policy:type_name -> bytebase.v1.MaskingExemptionPolicy
-	13, // 10: bytebase.v1.Policy.tag_policy:type_name -> bytebase.v1.TagPolicy
+	14, // 10: bytebase.v1.Policy.tag_policy:type_name -> bytebase.v1.TagPolicy
 	10, // 11: bytebase.v1.Policy.query_data_policy:type_name -> bytebase.v1.QueryDataPolicy
-	1,  // 12: bytebase.v1.Policy.resource_type:type_name -> bytebase.v1.PolicyResourceType
-	14, // 13: bytebase.v1.MaskingExemptionPolicy.exemptions:type_name -> bytebase.v1.MaskingExemptionPolicy.Exemption
-	15, // 14: bytebase.v1.MaskingRulePolicy.rules:type_name -> bytebase.v1.MaskingRulePolicy.MaskingRule
-	16, // 15: bytebase.v1.TagPolicy.tags:type_name -> bytebase.v1.TagPolicy.TagsEntry
-	18, // 16: bytebase.v1.MaskingExemptionPolicy.Exemption.condition:type_name -> google.type.Expr
-	18, // 17: bytebase.v1.MaskingRulePolicy.MaskingRule.condition:type_name -> google.type.Expr
-	5,  // 18: bytebase.v1.OrgPolicyService.GetPolicy:input_type -> bytebase.v1.GetPolicyRequest
-	6,  // 19: bytebase.v1.OrgPolicyService.ListPolicies:input_type -> bytebase.v1.ListPoliciesRequest
-	2,  // 20: bytebase.v1.OrgPolicyService.CreatePolicy:input_type -> bytebase.v1.CreatePolicyRequest
-	3,  // 21: bytebase.v1.OrgPolicyService.UpdatePolicy:input_type -> bytebase.v1.UpdatePolicyRequest
-	4,  // 22: bytebase.v1.OrgPolicyService.DeletePolicy:input_type -> bytebase.v1.DeletePolicyRequest
-	8,  // 23: bytebase.v1.OrgPolicyService.GetPolicy:output_type -> bytebase.v1.Policy
-	7,  // 24: bytebase.v1.OrgPolicyService.ListPolicies:output_type -> bytebase.v1.ListPoliciesResponse
-	8,  // 25: bytebase.v1.OrgPolicyService.CreatePolicy:output_type -> bytebase.v1.Policy
-	8,  // 26: bytebase.v1.OrgPolicyService.UpdatePolicy:output_type -> bytebase.v1.Policy
-	19, // 27: bytebase.v1.OrgPolicyService.DeletePolicy:output_type -> google.protobuf.Empty
-	23, // [23:28] is the sub-list for method output_type
-	18, // [18:23] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
+	13, // 12: bytebase.v1.Policy.row_filter_policy:type_name -> bytebase.v1.RowFilterPolicy
+	1,  // 13: bytebase.v1.Policy.resource_type:type_name -> bytebase.v1.PolicyResourceType
+	15, // 14: bytebase.v1.MaskingExemptionPolicy.exemptions:type_name -> bytebase.v1.MaskingExemptionPolicy.Exemption
+	16, // 15: bytebase.v1.MaskingRulePolicy.rules:type_name -> bytebase.v1.MaskingRulePolicy.MaskingRule
+	17, // 16: bytebase.v1.RowFilterPolicy.filters:type_name -> bytebase.v1.RowFilterPolicy.RowFilter
+	18, // 17: bytebase.v1.TagPolicy.tags:type_name -> bytebase.v1.TagPolicy.TagsEntry
+	20, // 18: bytebase.v1.MaskingExemptionPolicy.Exemption.condition:type_name -> google.type.Expr
+	20, // 19: bytebase.v1.MaskingRulePolicy.MaskingRule.condition:type_name -> google.type.Expr
+	20, // 20: bytebase.v1.RowFilterPolicy.RowFilter.condition:type_name -> google.type.Expr
+	20, // 21: bytebase.v1.RowFilterPolicy.RowFilter.expression:type_name -> google.type.Expr
+	5,  // 22: bytebase.v1.OrgPolicyService.GetPolicy:input_type -> bytebase.v1.GetPolicyRequest
+	6,  // 23: bytebase.v1.OrgPolicyService.ListPolicies:input_type -> bytebase.v1.ListPoliciesRequest
+	2,  // 24: bytebase.v1.OrgPolicyService.CreatePolicy:input_type -> bytebase.v1.CreatePolicyRequest
+	3,  // 25: bytebase.v1.OrgPolicyService.UpdatePolicy:input_type -> bytebase.v1.UpdatePolicyRequest
+	4,  // 26: bytebase.v1.OrgPolicyService.DeletePolicy:input_type -> bytebase.v1.DeletePolicyRequest
+	8,  // 27: bytebase.v1.OrgPolicyService.GetPolicy:output_type -> bytebase.v1.Policy
+	7,  // 28: bytebase.v1.OrgPolicyService.ListPolicies:output_type -> bytebase.v1.ListPoliciesResponse
+	8,  // 29: bytebase.v1.OrgPolicyService.CreatePolicy:output_type -> bytebase.v1.Policy
+	8,  // 30: bytebase.v1.OrgPolicyService.UpdatePolicy:output_type -> bytebase.v1.Policy
+	21, // 31: bytebase.v1.OrgPolicyService.DeletePolicy:output_type -> google.protobuf.Empty
+	27, // [27:32] is the sub-list for method output_type
+	22, // [22:27] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_v1_org_policy_service_proto_init() }
@@ -1283,6 +1504,7 @@ func file_v1_org_policy_service_proto_init() {
 		(*Policy_MaskingExemptionPolicy)(nil),
 		(*Policy_TagPolicy)(nil),
 		(*Policy_QueryDataPolicy)(nil),
+		(*Policy_RowFilterPolicy)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1290,7 +1512,7 @@ func file_v1_org_policy_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_org_policy_service_proto_rawDesc), len(file_v1_org_policy_service_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   15,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -77,7 +77,7 @@ func (x PlanCheckRun_Status) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PlanCheckRun_Status.Descriptor instead.
 func (PlanCheckRun_Status) EnumDescriptor() ([]byte, []int) {
-	return file_v1_plan_service_proto_rawDescGZIP(), []int{11, 0}
+	return file_v1_plan_service_proto_rawDescGZIP(), []int{13, 0}
 }
 
 type PlanCheckRun_Result_Type int32
@@ -129,7 +129,7 @@ func (x PlanCheckRun_Result_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PlanCheckRun_Result_Type.Descriptor instead.
 func (PlanCheckRun_Result_Type) EnumDescriptor() ([]byte, []int) {
-	return file_v1_plan_service_proto_rawDescGZIP(), []int{11, 0, 0}
+	return file_v1_plan_service_proto_rawDescGZIP(), []int{13, 0, 0}
 }
 
 type GetPlanRequest struct {
@@ -662,6 +662,117 @@ func (x *GetPlanCheckRunRequest) GetName() string {
 	return ""
 }
 
+type PreviewPlanApprovalRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The plan to preview the approval.
+	// Format: projects/{project}/plans/{plan}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewPlanApprovalRequest) Reset() {
+	*x = PreviewPlanApprovalRequest{}
+	mi := &file_v1_plan_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewPlanApprovalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewPlanApprovalRequest) ProtoMessage() {}
+
+func (x *PreviewPlanApprovalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_plan_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewPlanApprovalRequest.ProtoReflect.Descriptor instead.
+func (*PreviewPlanApprovalRequest) Descriptor() ([]byte, []int) {
+	return file_v1_plan_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PreviewPlanApprovalRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type PreviewPlanApprovalResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the approval finding is done.
+	// It's false if the plan check run is still running.
+	ApprovalFindingDone bool `protobuf:"varint,1,opt,name=approval_finding_done,json=approvalFindingDone,proto3" json:"approval_finding_done,omitempty"`
+	// The approval template required by the issue of the plan.
+	// Unset if no approval is required.
+	ApprovalTemplate *ApprovalTemplate `protobuf:"bytes,2,opt,name=approval_template,json=approvalTemplate,proto3" json:"approval_template,omitempty"`
+	// The risk level of the plan.
+	RiskLevel     RiskLevel `protobuf:"varint,3,opt,name=risk_level,json=riskLevel,proto3,enum=bytebase.v1.RiskLevel" json:"risk_level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewPlanApprovalResponse) Reset() {
+	*x = PreviewPlanApprovalResponse{}
+	mi := &file_v1_plan_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewPlanApprovalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewPlanApprovalResponse) ProtoMessage() {}
+
+func (x *PreviewPlanApprovalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_plan_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewPlanApprovalResponse.ProtoReflect.Descriptor instead.
+func (*PreviewPlanApprovalResponse) Descriptor() ([]byte, []int) {
+	return file_v1_plan_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PreviewPlanApprovalResponse) GetApprovalFindingDone() bool {
+	if x != nil {
+		return x.ApprovalFindingDone
+	}
+	return false
+}
+
+func (x *PreviewPlanApprovalResponse) GetApprovalTemplate() *ApprovalTemplate {
+	if x != nil {
+		return x.ApprovalTemplate
+	}
+	return nil
+}
+
+func (x *PreviewPlanApprovalResponse) GetRiskLevel() RiskLevel {
+	if x != nil {
+		return x.RiskLevel
+	}
+	return RiskLevel_RISK_LEVEL_UNSPECIFIED
+}
+
 type RunPlanChecksRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The plan to run plan checks.
@@ -677,7 +788,7 @@ type RunPlanChecksRequest struct {
 
 func (x *RunPlanChecksRequest) Reset() {
 	*x = RunPlanChecksRequest{}
-	mi := &file_v1_plan_service_proto_msgTypes[7]
+	mi := &file_v1_plan_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -689,7 +800,7 @@ func (x *RunPlanChecksRequest) String() string {
 func (*RunPlanChecksRequest) ProtoMessage() {}
 
 func (x *RunPlanChecksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_plan_service_proto_msgTypes[7]
+	mi := &file_v1_plan_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -702,7 +813,7 @@ func (x *RunPlanChecksRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RunPlanChecksRequest.ProtoReflect.Descriptor instead.
 func (*RunPlanChecksRequest) Descriptor() ([]byte, []int) {
-	return file_v1_plan_service_proto_rawDescGZIP(), []int{7}
+	return file_v1_plan_service_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *RunPlanChecksRequest) GetName() string {
@@ -720,14 +831,18 @@ func (x *RunPlanChecksRequest) GetSpecId() string {
 }
 
 type RunPlanChecksResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The operation tracking the plan check run.
+	// The response of the operation is the finished PlanCheckRun.
+	// Canceling the operation cancels the plan check run.
+	Operation     *Operation `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *RunPlanChecksResponse) Reset() {
 	*x = RunPlanChecksResponse{}
-	mi := &file_v1_plan_service_proto_msgTypes[8]
+	mi := &file_v1_plan_service_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -739,7 +854,7 @@ func (x *RunPlanChecksResponse) String() string {
 func (*RunPlanChecksResponse) ProtoMessage() {}
 
 func (x *RunPlanChecksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_plan_service_proto_msgTypes[8]
+	mi := &file_v1_plan_service_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -752,7 +867,14 @@ func (x *RunPlanChecksResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RunPlanChecksResponse.ProtoReflect.Descriptor instead.
 func (*RunPlanChecksResponse) Descriptor() ([]byte, []int) {
-	return file_v1_plan_service_proto_rawDescGZIP(), []int{8}
+	return file_v1_plan_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RunPlanChecksResponse) GetOperation() *Operation {
+	if x != nil {
+		return x.Operation
+	}
+	return nil
 }
 
 type CancelPlanCheckRunRequest struct {
@@ -766,7 +888,7 @@ type CancelPlanCheckRunRequest struct {
 
 func (x *CancelPlanCheckRunRequest) Reset() {
 	*x = CancelPlanCheckRunRequest{}
-	mi := &file_v1_plan_service_proto_msgTypes[9]
+	mi := &file_v1_plan_service_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -778,7 +900,7 @@ func (x *CancelPlanCheckRunRequest) String() string {
 func (*CancelPlanCheckRunRequest) ProtoMessage() {}
 
 func (x *CancelPlanCheckRunRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_plan_service_proto_msgTypes[9]
+	mi := &file_v1_plan_service_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -791,7 +913,7 @@ func (x *CancelPlanCheckRunRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelPlanCheckRunRequest.ProtoReflect.Descriptor instead.
 func (*CancelPlanCheckRunRequest) Descriptor() ([]byte, []int) {
-	return file_v1_plan_service_proto_rawDescGZIP(), []int{9}
+	return file_v1_plan_service_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *CancelPlanCheckRunRequest) GetName() string {
@@ -809,7 +931,7 @@ type CancelPlanCheckRunResponse struct {
 
 func (x *CancelPlanCheckRunResponse) Reset() {
 	*x = CancelPlanCheckRunResponse{}
-	mi := &file_v1_plan_service_proto_msgTypes[10]
+	mi := &file_v1_plan_service_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -821,7 +943,7 @@ func (x *CancelPlanCheckRunResponse) String() string {
 func (*CancelPlanCheckRunResponse) ProtoMessage() {}
 
 func (x *CancelPlanCheckRunResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_plan_service_proto_msgTypes[10]
+	mi := &file_v1_plan_service_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -834,7 +956,7 @@ func (x *CancelPlanCheckRunResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelPlanCheckRunResponse.ProtoReflect.Descriptor instead.
 func (*CancelPlanCheckRunResponse) Descriptor() ([]byte, []int) {
-	return file_v1_plan_service_proto_rawDescGZIP(), []int{10}
+	return file_v1_plan_service_proto_rawDescGZIP(), []int{12}
 }
 
 type PlanCheckRun struct {
@@ -852,7 +974,7 @@ type PlanCheckRun struct {
 
 func (x *PlanCheckRun) Reset() {
 	*x = PlanCheckRun{}
-	mi := &file_v1_plan_service_proto_msgTypes[11]
+	mi := &file_v1_plan_service_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -864,7 +986,7 @@ func (x *PlanCheckRun) String() string {
 func (*PlanCheckRun) ProtoMessage() {}
 
 func (x *PlanCheckRun) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_plan_service_proto_msgTypes[11]
+	mi := &file_v1_plan_service_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -877,7 +999,7 @@ func (x *PlanCheckRun) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlanCheckRun.ProtoReflect.Descriptor instead.
 func (*PlanCheckRun) Descriptor() ([]byte, []int) {
-	return file_v1_plan_service_proto_rawDescGZIP(), []int{11}
+	return file_v1_plan_service_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *PlanCheckRun) GetName() string {
@@ -924,6 +1046,7 @@ type Plan_Spec struct {
 	//	*Plan_Spec_CreateDatabaseConfig
 	//	*Plan_Spec_ChangeDatabaseConfig
 	//	*Plan_Spec_ExportDataConfig
+	//	*Plan_Spec_CloneDatabaseConfig
 	Config        isPlan_Spec_Config `protobuf_oneof:"config"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -931,7 +1054,7 @@ type Plan_Spec struct {
 
 func (x *Plan_Spec) Reset() {
 	*x = Plan_Spec{}
-	mi := &file_v1_plan_service_proto_msgTypes[12]
+	mi := &file_v1_plan_service_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -943,7 +1066,7 @@ func (x *Plan_Spec) String() string {
 func (*Plan_Spec) ProtoMessage() {}
 
 func (x *Plan_Spec) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_plan_service_proto_msgTypes[12]
+	mi := &file_v1_plan_service_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1000,6 +1123,15 @@ func (x *Plan_Spec) GetExportDataConfig() *Plan_ExportDataConfig {
 	return nil
 }
 
+func (x *Plan_Spec) GetCloneDatabaseConfig() *Plan_CloneDatabaseConfig {
+	if x != nil {
+		if x, ok := x.Config.(*Plan_Spec_CloneDatabaseConfig); ok {
+			return x.CloneDatabaseConfig
+		}
+	}
+	return nil
+}
+
 type isPlan_Spec_Config interface {
 	isPlan_Spec_Config()
 }
@@ -1016,12 +1148,18 @@ type Plan_Spec_ExportDataConfig struct {
 	ExportDataConfig *Plan_ExportDataConfig `protobuf:"bytes,4,opt,name=export_data_config,json=exportDataConfig,proto3,oneof"`
 }
 
+type Plan_Spec_CloneDatabaseConfig struct {
+	CloneDatabaseConfig *Plan_CloneDatabaseConfig `protobuf:"bytes,5,opt,name=clone_database_config,json=cloneDatabaseConfig,proto3,oneof"`
+}
+
 func (*Plan_Spec_CreateDatabaseConfig) isPlan_Spec_Config() {}
 
 func (*Plan_Spec_ChangeDatabaseConfig) isPlan_Spec_Config() {}
 
 func (*Plan_Spec_ExportDataConfig) isPlan_Spec_Config() {}
 
+func (*Plan_Spec_CloneDatabaseConfig) isPlan_Spec_Config() {}
+
 type Plan_CreateDatabaseConfig struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The resource name of the instance on which the database is created.
@@ -1049,7 +1187,7 @@ type Plan_CreateDatabaseConfig struct {
 
 func (x *Plan_CreateDatabaseConfig) Reset() {
 	*x = Plan_CreateDatabaseConfig{}
-	mi := &file_v1_plan_service_proto_msgTypes[14]
+	mi := &file_v1_plan_service_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1061,7 +1199,7 @@ func (x *Plan_CreateDatabaseConfig) String() string {
 func (*Plan_CreateDatabaseConfig) ProtoMessage() {}
 
 func (x *Plan_CreateDatabaseConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_plan_service_proto_msgTypes[14]
+	mi := &file_v1_plan_service_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1153,7 +1291,7 @@ type Plan_ChangeDatabaseConfig struct {
 
 func (x *Plan_ChangeDatabaseConfig) Reset() {
 	*x = Plan_ChangeDatabaseConfig{}
-	mi := &file_v1_plan_service_proto_msgTypes[15]
+	mi := &file_v1_plan_service_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1165,7 +1303,7 @@ func (x *Plan_ChangeDatabaseConfig) String() string {
 func (*Plan_ChangeDatabaseConfig) ProtoMessage() {}
 
 func (x *Plan_ChangeDatabaseConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_plan_service_proto_msgTypes[15]
+	mi := &file_v1_plan_service_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1229,7 +1367,7 @@ type Plan_ExportDataConfig struct {
 
 func (x *Plan_ExportDataConfig) Reset() {
 	*x = Plan_ExportDataConfig{}
-	mi := &file_v1_plan_service_proto_msgTypes[16]
+	mi := &file_v1_plan_service_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1241,7 +1379,7 @@ func (x *Plan_ExportDataConfig) String() string {
 func (*Plan_ExportDataConfig) ProtoMessage() {}
 
 func (x *Plan_ExportDataConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_plan_service_proto_msgTypes[16]
+	mi := &file_v1_plan_service_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1285,6 +1423,97 @@ func (x *Plan_ExportDataConfig) GetPassword() string {
 	return ""
 }
 
+// CloneDatabaseConfig copies the schema and masked data of a source database
+// into a target database, typically in a lower environment.
+type Plan_CloneDatabaseConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The source database to copy from.
+	// Format: instances/{instance}/databases/{database}
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// The target database to copy into. It must already exist and belong to the plan project.
+	// Format: instances/{instance}/databases/{database}
+	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// Table patterns to copy, in the form of "schema.table" or "table".
+	// Glob wildcards such as "public.order_*" are supported.
+	// Leave it empty to copy all tables.
+	IncludeTables []string `protobuf:"bytes,3,rep,name=include_tables,json=includeTables,proto3" json:"include_tables,omitempty"`
+	// Table patterns to skip, in the same form as include_tables.
+	// Exclusion takes precedence over inclusion.
+	ExcludeTables []string `protobuf:"bytes,4,rep,name=exclude_tables,json=excludeTables,proto3" json:"exclude_tables,omitempty"`
+	// The percentage of rows to copy from each table, in [1, 100].
+	// 0 means all rows.
+	// The copied rows referencing rows that are not copied, because of sampling or excluded tables,
+	// are removed before the foreign keys are created.
+	SamplePercent int32 `protobuf:"varint,5,opt,name=sample_percent,json=samplePercent,proto3" json:"sample_percent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Plan_CloneDatabaseConfig) Reset() {
+	*x = Plan_CloneDatabaseConfig{}
+	mi := &file_v1_plan_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Plan_CloneDatabaseConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Plan_CloneDatabaseConfig) ProtoMessage() {}
+
+func (x *Plan_CloneDatabaseConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_plan_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Plan_CloneDatabaseConfig.ProtoReflect.Descriptor instead.
+func (*Plan_CloneDatabaseConfig) Descriptor() ([]byte, []int) {
+	return file_v1_plan_service_proto_rawDescGZIP(), []int{5, 5}
+}
+
+func (x *Plan_CloneDatabaseConfig) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *Plan_CloneDatabaseConfig) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *Plan_CloneDatabaseConfig) GetIncludeTables() []string {
+	if x != nil {
+		return x.IncludeTables
+	}
+	return nil
+}
+
+func (x *Plan_CloneDatabaseConfig) GetExcludeTables() []string {
+	if x != nil {
+		return x.ExcludeTables
+	}
+	return nil
+}
+
+func (x *Plan_CloneDatabaseConfig) GetSamplePercent() int32 {
+	if x != nil {
+		return x.SamplePercent
+	}
+	return 0
+}
+
 type Plan_RolloutStageSummary struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The stage resource name.
@@ -1298,7 +1527,7 @@ type Plan_RolloutStageSummary struct {
 
 func (x *Plan_RolloutStageSummary) Reset() {
 	*x = Plan_RolloutStageSummary{}
-	mi := &file_v1_plan_service_proto_msgTypes[17]
+	mi := &file_v1_plan_service_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1310,7 +1539,7 @@ func (x *Plan_RolloutStageSummary) String() string {
 func (*Plan_RolloutStageSummary) ProtoMessage() {}
 
 func (x *Plan_RolloutStageSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_plan_service_proto_msgTypes[17]
+	mi := &file_v1_plan_service_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1323,7 +1552,7 @@ func (x *Plan_RolloutStageSummary) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Plan_RolloutStageSummary.ProtoReflect.Descriptor instead.
 func (*Plan_RolloutStageSummary) Descriptor() ([]byte, []int) {
-	return file_v1_plan_service_proto_rawDescGZIP(), []int{5, 5}
+	return file_v1_plan_service_proto_rawDescGZIP(), []int{5, 6}
 }
 
 func (x *Plan_RolloutStageSummary) GetStage() string {
@@ -1352,7 +1581,7 @@ type Plan_TaskStatusCount struct {
 
 func (x *Plan_TaskStatusCount) Reset() {
 	*x = Plan_TaskStatusCount{}
-	mi := &file_v1_plan_service_proto_msgTypes[18]
+	mi := &file_v1_plan_service_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1364,7 +1593,7 @@ func (x *Plan_TaskStatusCount) String() string {
 func (*Plan_TaskStatusCount) ProtoMessage() {}
 
 func (x *Plan_TaskStatusCount) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_plan_service_proto_msgTypes[18]
+	mi := &file_v1_plan_service_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1377,7 +1606,7 @@ func (x *Plan_TaskStatusCount) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Plan_TaskStatusCount.ProtoReflect.Descriptor instead.
 func (*Plan_TaskStatusCount) Descriptor() ([]byte, []int) {
-	return file_v1_plan_service_proto_rawDescGZIP(), []int{5, 6}
+	return file_v1_plan_service_proto_rawDescGZIP(), []int{5, 7}
 }
 
 func (x *Plan_TaskStatusCount) GetStatus() Task_Status {
@@ -1415,7 +1644,7 @@ type PlanCheckRun_Result struct {
 
 func (x *PlanCheckRun_Result) Reset() {
 	*x = PlanCheckRun_Result{}
-	mi := &file_v1_plan_service_proto_msgTypes[19]
+	mi := &file_v1_plan_service_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1427,7 +1656,7 @@ func (x *PlanCheckRun_Result) String() string {
 func (*PlanCheckRun_Result) ProtoMessage() {}
 
 func (x *PlanCheckRun_Result) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_plan_service_proto_msgTypes[19]
+	mi := &file_v1_plan_service_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1440,7 +1669,7 @@ func (x *PlanCheckRun_Result) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlanCheckRun_Result.ProtoReflect.Descriptor instead.
 func (*PlanCheckRun_Result) Descriptor() ([]byte, []int) {
-	return file_v1_plan_service_proto_rawDescGZIP(), []int{11, 0}
+	return file_v1_plan_service_proto_rawDescGZIP(), []int{13, 0}
 }
 
 func (x *PlanCheckRun_Result) GetStatus() Advice_Level {
@@ -1537,7 +1766,7 @@ type PlanCheckRun_Result_SqlSummaryReport struct {
 
 func (x *PlanCheckRun_Result_SqlSummaryReport) Reset() {
 	*x = PlanCheckRun_Result_SqlSummaryReport{}
-	mi := &file_v1_plan_service_proto_msgTypes[20]
+	mi := &file_v1_plan_service_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1549,7 +1778,7 @@ func (x *PlanCheckRun_Result_SqlSummaryReport) String() string {
 func (*PlanCheckRun_Result_SqlSummaryReport) ProtoMessage() {}
 
 func (x *PlanCheckRun_Result_SqlSummaryReport) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_plan_service_proto_msgTypes[20]
+	mi := &file_v1_plan_service_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1562,7 +1791,7 @@ func (x *PlanCheckRun_Result_SqlSummaryReport) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use PlanCheckRun_Result_SqlSummaryReport.ProtoReflect.Descriptor instead.
 func (*PlanCheckRun_Result_SqlSummaryReport) Descriptor() ([]byte, []int) {
-	return file_v1_plan_service_proto_rawDescGZIP(), []int{11, 0, 0}
+	return file_v1_plan_service_proto_rawDescGZIP(), []int{13, 0, 0}
 }
 
 func (x *PlanCheckRun_Result_SqlSummaryReport) GetStatementTypes() []StatementType {
@@ -1590,7 +1819,7 @@ type PlanCheckRun_Result_SqlReviewReport struct {
 
 func (x *PlanCheckRun_Result_SqlReviewReport) Reset() {
 	*x = PlanCheckRun_Result_SqlReviewReport{}
-	mi := &file_v1_plan_service_proto_msgTypes[21]
+	mi := &file_v1_plan_service_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1602,7 +1831,7 @@ func (x *PlanCheckRun_Result_SqlReviewReport) String() string {
 func (*PlanCheckRun_Result_SqlReviewReport) ProtoMessage() {}
 
 func (x *PlanCheckRun_Result_SqlReviewReport) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_plan_service_proto_msgTypes[21]
+	mi := &file_v1_plan_service_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1615,7 +1844,7 @@ func (x *PlanCheckRun_Result_SqlReviewReport) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use PlanCheckRun_Result_SqlReviewReport.ProtoReflect.Descriptor instead.
 func (*PlanCheckRun_Result_SqlReviewReport) Descriptor() ([]byte, []int) {
-	return file_v1_plan_service_proto_rawDescGZIP(), []int{11, 0, 1}
+	return file_v1_plan_service_proto_rawDescGZIP(), []int{13, 0, 1}
 }
 
 func (x *PlanCheckRun_Result_SqlReviewReport) GetStartPosition() *Position {
@@ -1636,7 +1865,7 @@ var File_v1_plan_service_proto protoreflect.FileDescriptor
 
 const file_v1_plan_service_proto_rawDesc = "" +
 	"\n" +
-	"\x15v1/plan_service.proto\x12\vbytebase.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x13v1/annotation.proto\x1a\x0fv1/common.proto\x1a\x16v1/issue_service.proto\x1a\x18v1/rollout_service.proto\x1a\x14v1/sql_service.proto\"?\n" +
+	"\x15v1/plan_service.proto\x12\vbytebase.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x13v1/annotation.proto\x1a\x0fv1/common.proto\x1a\x16v1/issue_service.proto\x1a\x1av1/operation_service.proto\x1a\x18v1/rollout_service.proto\x1a\x14v1/sql_service.proto\"?\n" +
 	"\x0eGetPlanRequest\x12-\n" +
 	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
 	"\x11bytebase.com/PlanR\x04name\"\x9c\x01\n" +
@@ -1658,7 +1887,7 @@ const file_v1_plan_service_proto_rawDesc = "" +
 	"\x04plan\x18\x01 \x01(\v2\x11.bytebase.v1.PlanB\x03\xe0A\x02R\x04plan\x12@\n" +
 	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x02R\n" +
 	"updateMask\x12#\n" +
-	"\rallow_missing\x18\x03 \x01(\bR\fallowMissing\"\xd3\x0f\n" +
+	"\rallow_missing\x18\x03 \x01(\bR\fallowMissing\"\xed\x11\n" +
 	"\x04Plan\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12(\n" +
 	"\x05state\x18\x02 \x01(\x0e2\x12.bytebase.v1.StateR\x05state\x12\x19\n" +
@@ -1676,12 +1905,13 @@ const file_v1_plan_service_proto_rawDesc = "" +
 	"\vhas_rollout\x18\f \x01(\bB\x03\xe0A\x03R\n" +
 	"hasRollout\x12O\n" +
 	"\x0fapproval_status\x18\r \x01(\x0e2!.bytebase.v1.Issue.ApprovalStatusB\x03\xe0A\x03R\x0eapprovalStatus\x12b\n" +
-	"\x17rollout_stage_summaries\x18\x0e \x03(\v2%.bytebase.v1.Plan.RolloutStageSummaryB\x03\xe0A\x03R\x15rolloutStageSummaries\x1a\xb4\x02\n" +
+	"\x17rollout_stage_summaries\x18\x0e \x03(\v2%.bytebase.v1.Plan.RolloutStageSummaryB\x03\xe0A\x03R\x15rolloutStageSummaries\x1a\x91\x03\n" +
 	"\x04Spec\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12^\n" +
 	"\x16create_database_config\x18\x02 \x01(\v2&.bytebase.v1.Plan.CreateDatabaseConfigH\x00R\x14createDatabaseConfig\x12^\n" +
 	"\x16change_database_config\x18\x03 \x01(\v2&.bytebase.v1.Plan.ChangeDatabaseConfigH\x00R\x14changeDatabaseConfig\x12R\n" +
-	"\x12export_data_config\x18\x04 \x01(\v2\".bytebase.v1.Plan.ExportDataConfigH\x00R\x10exportDataConfigB\b\n" +
+	"\x12export_data_config\x18\x04 \x01(\v2\".bytebase.v1.Plan.ExportDataConfigH\x00R\x10exportDataConfig\x12[\n" +
+	"\x15clone_database_config\x18\x05 \x01(\v2%.bytebase.v1.Plan.CloneDatabaseConfigH\x00R\x13cloneDatabaseConfigB\b\n" +
 	"\x06config\x1aJ\n" +
 	"\x1cPlanCheckRunStatusCountEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
@@ -1706,7 +1936,13 @@ const file_v1_plan_service_proto_rawDesc = "" +
 	"\x05sheet\x18\x02 \x01(\tR\x05sheet\x121\n" +
 	"\x06format\x18\x03 \x01(\x0e2\x19.bytebase.v1.ExportFormatR\x06format\x12\x1f\n" +
 	"\bpassword\x18\x04 \x01(\tH\x00R\bpassword\x88\x01\x01B\v\n" +
-	"\t_password\x1a|\n" +
+	"\t_password\x1a\xba\x01\n" +
+	"\x13CloneDatabaseConfig\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\tR\x06target\x12%\n" +
+	"\x0einclude_tables\x18\x03 \x03(\tR\rincludeTables\x12%\n" +
+	"\x0eexclude_tables\x18\x04 \x03(\tR\rexcludeTables\x12%\n" +
+	"\x0esample_percent\x18\x05 \x01(\x05R\rsamplePercent\x1a|\n" +
 	"\x13RolloutStageSummary\x12\x14\n" +
 	"\x05stage\x18\x01 \x01(\tR\x05stage\x12O\n" +
 	"\x12task_status_counts\x18\x02 \x03(\v2!.bytebase.v1.Plan.TaskStatusCountR\x10taskStatusCounts\x1aY\n" +
@@ -1716,14 +1952,23 @@ const file_v1_plan_service_proto_rawDesc = "" +
 	"\x11bytebase.com/Plan\x12\x1fprojects/{project}/plans/{plan}\"O\n" +
 	"\x16GetPlanCheckRunRequest\x125\n" +
 	"\x04name\x18\x01 \x01(\tB!\xe0A\x02\xfaA\x1b\n" +
-	"\x19bytebase.com/PlanCheckRunR\x04name\"o\n" +
+	"\x19bytebase.com/PlanCheckRunR\x04name\"K\n" +
+	"\x1aPreviewPlanApprovalRequest\x12-\n" +
+	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11bytebase.com/PlanR\x04name\"\xd4\x01\n" +
+	"\x1bPreviewPlanApprovalResponse\x122\n" +
+	"\x15approval_finding_done\x18\x01 \x01(\bR\x13approvalFindingDone\x12J\n" +
+	"\x11approval_template\x18\x02 \x01(\v2\x1d.bytebase.v1.ApprovalTemplateR\x10approvalTemplate\x125\n" +
+	"\n" +
+	"risk_level\x18\x03 \x01(\x0e2\x16.bytebase.v1.RiskLevelR\triskLevel\"o\n" +
 	"\x14RunPlanChecksRequest\x12-\n" +
 	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
 	"\x11bytebase.com/PlanR\x04name\x12\x1c\n" +
 	"\aspec_id\x18\x02 \x01(\tH\x00R\x06specId\x88\x01\x01B\n" +
 	"\n" +
-	"\b_spec_id\"\x17\n" +
-	"\x15RunPlanChecksResponse\"R\n" +
+	"\b_spec_id\"M\n" +
+	"\x15RunPlanChecksResponse\x124\n" +
+	"\toperation\x18\x01 \x01(\v2\x16.bytebase.v1.OperationR\toperation\"R\n" +
 	"\x19CancelPlanCheckRunRequest\x125\n" +
 	"\x04name\x18\x01 \x01(\tB!\xe0A\x02\xfaA\x1b\n" +
 	"\x19bytebase.com/PlanCheckRunR\x04name\"\x1c\n" +
@@ -1764,7 +2009,8 @@ const file_v1_plan_service_proto_rawDesc = "" +
 	"\n" +
 	"\x06FAILED\x10\x03\x12\f\n" +
 	"\bCANCELED\x10\x04:L\xeaAI\n" +
-	"\x19bytebase.com/PlanCheckRun\x12,projects/{project}/plans/{plan}/planCheckRun2\xfe\b\n" +
+	"\x19bytebase.com/PlanCheckRun\x12,projects/{project}/plans/{plan}/planCheckRun2\xbb\n" +
+	"\n" +
 	"\vPlanService\x12{\n" +
 	"\aGetPlan\x12\x1b.bytebase.v1.GetPlanRequest\x1a\x11.bytebase.v1.Plan\"@\xdaA\x04name\x8a\xea0\fbb.plans.get\x90\xea0\x01\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/{name=projects/*/plans/*}\x12\x8f\x01\n" +
 	"\tListPlans\x12\x1d.bytebase.v1.ListPlansRequest\x1a\x1e.bytebase.v1.ListPlansResponse\"C\xdaA\x06parent\x8a\xea0\rbb.plans.list\x90\xea0\x01\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/{parent=projects/*}/plans\x12\x95\x01\n" +
@@ -1774,7 +2020,8 @@ const file_v1_plan_service_proto_rawDesc = "" +
 	"UpdatePlan\x12\x1e.bytebase.v1.UpdatePlanRequest\x1a\x11.bytebase.v1.Plan\"^\xdaA\x10plan,update_mask\x8a\xea0\x0fbb.plans.update\x90\xea0\x02\x98\xea0\x01\x82\xd3\xe4\x93\x02*:\x04plan2\"/v1/{plan.name=projects/*/plans/*}\x12\xa8\x01\n" +
 	"\x0fGetPlanCheckRun\x12#.bytebase.v1.GetPlanCheckRunRequest\x1a\x19.bytebase.v1.PlanCheckRun\"U\xdaA\x04name\x8a\xea0\x14bb.planCheckRuns.get\x90\xea0\x01\x82\xd3\xe4\x93\x02,\x12*/v1/{name=projects/*/plans/*/planCheckRun}\x12\xb1\x01\n" +
 	"\rRunPlanChecks\x12!.bytebase.v1.RunPlanChecksRequest\x1a\".bytebase.v1.RunPlanChecksResponse\"Y\xdaA\x04name\x8a\xea0\x14bb.planCheckRuns.run\x90\xea0\x01\x82\xd3\xe4\x93\x020:\x01*\"+/v1/{name=projects/*/plans/*}:runPlanChecks\x12\xc6\x01\n" +
-	"\x12CancelPlanCheckRun\x12&.bytebase.v1.CancelPlanCheckRunRequest\x1a'.bytebase.v1.CancelPlanCheckRunResponse\"_\xdaA\x04name\x8a\xea0\x14bb.planCheckRuns.run\x90\xea0\x01\x82\xd3\xe4\x93\x026:\x01*\"1/v1/{name=projects/*/plans/*/planCheckRun}:cancelB\xa6\x01\n" +
+	"\x12CancelPlanCheckRun\x12&.bytebase.v1.CancelPlanCheckRunRequest\x1a'.bytebase.v1.CancelPlanCheckRunResponse\"_\xdaA\x04name\x8a\xea0\x14bb.planCheckRuns.run\x90\xea0\x01\x82\xd3\xe4\x93\x026:\x01*\"1/v1/{name=projects/*/plans/*/planCheckRun}:cancel\x12\xba\x01\n" +
+	"\x13PreviewPlanApproval\x12'.bytebase.v1.PreviewPlanApprovalRequest\x1a(.bytebase.v1.PreviewPlanApprovalResponse\"P\xdaA\x04name\x8a\xea0\fbb.plans.get\x90\xea0\x01\x82\xd3\xe4\x93\x02/\x12-/v1/{name=projects/*/plans/*}:previewApprovalB\xa6\x01\n" +
 	"\x0fcom.bytebase.v1B\x10PlanServiceProtoP\x01Z4github.com/bytebase/bytebase/backend/generated-go/v1\xa2\x02\x03BXX\xaa\x02\vBytebase.V1\xca\x02\vBytebase\\V1\xe2\x02\x17Bytebase\\V1\\GPBMetadata\xea\x02\fBytebase::V1b\x06proto3"
 
 var (
@@ -1790,7 +2037,7 @@ func file_v1_plan_service_proto_rawDescGZIP() []byte {
 }
 
 var file_v1_plan_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_v1_plan_service_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_v1_plan_service_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
 var file_v1_plan_service_proto_goTypes = []any{
 	(PlanCheckRun_Status)(0),                     // 0: bytebase.v1.PlanCheckRun.Status
 	(PlanCheckRun_Result_Type)(0),                // 1: bytebase.v1.PlanCheckRun.Result.Type
@@ -1801,78 +2048,90 @@ var file_v1_plan_service_proto_goTypes = []any{
 	(*UpdatePlanRequest)(nil),                    // 6: bytebase.v1.UpdatePlanRequest
 	(*Plan)(nil),                                 // 7: bytebase.v1.Plan
 	(*GetPlanCheckRunRequest)(nil),               // 8: bytebase.v1.GetPlanCheckRunRequest
-	(*RunPlanChecksRequest)(nil),                 // 9: bytebase.v1.RunPlanChecksRequest
-	(*RunPlanChecksResponse)(nil),                // 10: bytebase.v1.RunPlanChecksResponse
-	(*CancelPlanCheckRunRequest)(nil),            // 11: bytebase.v1.CancelPlanCheckRunRequest
-	(*CancelPlanCheckRunResponse)(nil),           // 12: bytebase.v1.CancelPlanCheckRunResponse
-	(*PlanCheckRun)(nil),                         // 13: bytebase.v1.PlanCheckRun
-	(*Plan_Spec)(nil),                            // 14: bytebase.v1.Plan.Spec
-	nil,                                          // 15: bytebase.v1.Plan.PlanCheckRunStatusCountEntry
-	(*Plan_CreateDatabaseConfig)(nil),            // 16: bytebase.v1.Plan.CreateDatabaseConfig
-	(*Plan_ChangeDatabaseConfig)(nil),            // 17: bytebase.v1.Plan.ChangeDatabaseConfig
-	(*Plan_ExportDataConfig)(nil),                // 18: bytebase.v1.Plan.ExportDataConfig
-	(*Plan_RolloutStageSummary)(nil),             // 19: bytebase.v1.Plan.RolloutStageSummary
-	(*Plan_TaskStatusCount)(nil),                 // 20: bytebase.v1.Plan.TaskStatusCount
-	(*PlanCheckRun_Result)(nil),                  // 21: bytebase.v1.PlanCheckRun.Result
-	(*PlanCheckRun_Result_SqlSummaryReport)(nil), // 22: bytebase.v1.PlanCheckRun.Result.SqlSummaryReport
-	(*PlanCheckRun_Result_SqlReviewReport)(nil),  // 23: bytebase.v1.PlanCheckRun.Result.SqlReviewReport
-	(*fieldmaskpb.FieldMask)(nil),                // 24: google.protobuf.FieldMask
-	(State)(0),                                   // 25: bytebase.v1.State
-	(*timestamppb.Timestamp)(nil),                // 26: google.protobuf.Timestamp
-	(Issue_ApprovalStatus)(0),                    // 27: bytebase.v1.Issue.ApprovalStatus
-	(ExportFormat)(0),                            // 28: bytebase.v1.ExportFormat
-	(Task_Status)(0),                             // 29: bytebase.v1.Task.Status
-	(Advice_Level)(0),                            // 30: bytebase.v1.Advice.Level
-	(StatementType)(0),                           // 31: bytebase.v1.StatementType
-	(*Position)(nil),                             // 32: bytebase.v1.Position
+	(*PreviewPlanApprovalRequest)(nil),           // 9: bytebase.v1.PreviewPlanApprovalRequest
+	(*PreviewPlanApprovalResponse)(nil),          // 10: bytebase.v1.PreviewPlanApprovalResponse
+	(*RunPlanChecksRequest)(nil),                 // 11: bytebase.v1.RunPlanChecksRequest
+	(*RunPlanChecksResponse)(nil),                // 12: bytebase.v1.RunPlanChecksResponse
+	(*CancelPlanCheckRunRequest)(nil),            // 13: bytebase.v1.CancelPlanCheckRunRequest
+	(*CancelPlanCheckRunResponse)(nil),           // 14: bytebase.v1.CancelPlanCheckRunResponse
+	(*PlanCheckRun)(nil),                         // 15: bytebase.v1.PlanCheckRun
+	(*Plan_Spec)(nil),                            // 16: bytebase.v1.Plan.Spec
+	nil,                                          // 17: bytebase.v1.Plan.PlanCheckRunStatusCountEntry
+	(*Plan_CreateDatabaseConfig)(nil),            // 18: bytebase.v1.Plan.CreateDatabaseConfig
+	(*Plan_ChangeDatabaseConfig)(nil),            // 19: bytebase.v1.Plan.ChangeDatabaseConfig
+	(*Plan_ExportDataConfig)(nil),                // 20: bytebase.v1.Plan.ExportDataConfig
+	(*Plan_CloneDatabaseConfig)(nil),             // 21: bytebase.v1.Plan.CloneDatabaseConfig
+	(*Plan_RolloutStageSummary)(nil),             // 22: bytebase.v1.Plan.RolloutStageSummary
+	(*Plan_TaskStatusCount)(nil),                 // 23: bytebase.v1.Plan.TaskStatusCount
+	(*PlanCheckRun_Result)(nil),                  // 24: bytebase.v1.PlanCheckRun.Result
+	(*PlanCheckRun_Result_SqlSummaryReport)(nil), // 25: bytebase.v1.PlanCheckRun.Result.SqlSummaryReport
+	(*PlanCheckRun_Result_SqlReviewReport)(nil),  // 26: bytebase.v1.PlanCheckRun.Result.SqlReviewReport
+	(*fieldmaskpb.FieldMask)(nil),                // 27: google.protobuf.FieldMask
+	(State)(0),                                   // 28: bytebase.v1.State
+	(*timestamppb.Timestamp)(nil),                // 29: google.protobuf.Timestamp
+	(Issue_ApprovalStatus)(0),                    // 30: bytebase.v1.Issue.ApprovalStatus
+	(*ApprovalTemplate)(nil),                     // 31: bytebase.v1.ApprovalTemplate
+	(RiskLevel)(0),                               // 32: bytebase.v1.RiskLevel
+	(*Operation)(nil),                            // 33: bytebase.v1.Operation
+	(ExportFormat)(0),                            // 34: bytebase.v1.ExportFormat
+	(Task_Status)(0),                             // 35: bytebase.v1.Task.Status
+	(Advice_Level)(0),                            // 36: bytebase.v1.Advice.Level
+	(StatementType)(0),                           // 37: bytebase.v1.StatementType
+	(*Position)(nil),                             // 38: bytebase.v1.Position
 }
 var file_v1_plan_service_proto_depIdxs = []int32{
 	7,  // 0: bytebase.v1.ListPlansResponse.plans:type_name -> bytebase.v1.Plan
 	7,  // 1: bytebase.v1.CreatePlanRequest.plan:type_name -> bytebase.v1.Plan
 	7,  // 2: bytebase.v1.UpdatePlanRequest.plan:type_name -> bytebase.v1.Plan
-	24, // 3: bytebase.v1.UpdatePlanRequest.update_mask:type_name -> google.protobuf.FieldMask
-	25, // 4: bytebase.v1.Plan.state:type_name -> bytebase.v1.State
-	14, // 5: bytebase.v1.Plan.specs:type_name -> bytebase.v1.Plan.Spec
-	26, // 6: bytebase.v1.Plan.create_time:type_name -> google.protobuf.Timestamp
-	26, // 7: bytebase.v1.Plan.update_time:type_name -> google.protobuf.Timestamp
-	15, // 8: bytebase.v1.Plan.plan_check_run_status_count:type_name -> bytebase.v1.Plan.PlanCheckRunStatusCountEntry
-	27, // 9: bytebase.v1.Plan.approval_status:type_name -> bytebase.v1.Issue.ApprovalStatus
-	19, // 10: bytebase.v1.Plan.rollout_stage_summaries:type_name -> bytebase.v1.Plan.RolloutStageSummary
-	0,  // 11: bytebase.v1.PlanCheckRun.status:type_name -> bytebase.v1.PlanCheckRun.Status
-	21, // 12: bytebase.v1.PlanCheckRun.results:type_name -> bytebase.v1.PlanCheckRun.Result
-	26, // 13: bytebase.v1.PlanCheckRun.create_time:type_name -> google.protobuf.Timestamp
-	16, // 14: bytebase.v1.Plan.Spec.create_database_config:type_name -> bytebase.v1.Plan.CreateDatabaseConfig
-	17, // 15: bytebase.v1.Plan.Spec.change_database_config:type_name -> bytebase.v1.Plan.ChangeDatabaseConfig
-	18, // 16: bytebase.v1.Plan.Spec.export_data_config:type_name -> bytebase.v1.Plan.ExportDataConfig
-	28, // 17: bytebase.v1.Plan.ExportDataConfig.format:type_name -> bytebase.v1.ExportFormat
-	20, // 18: bytebase.v1.Plan.RolloutStageSummary.task_status_counts:type_name -> bytebase.v1.Plan.TaskStatusCount
-	29, // 19: bytebase.v1.Plan.TaskStatusCount.status:type_name -> bytebase.v1.Task.Status
-	30, // 20: bytebase.v1.PlanCheckRun.Result.status:type_name -> bytebase.v1.Advice.Level
-	1,  // 21: bytebase.v1.PlanCheckRun.Result.type:type_name -> bytebase.v1.PlanCheckRun.Result.Type
-	22, // 22: bytebase.v1.PlanCheckRun.Result.sql_summary_report:type_name -> bytebase.v1.PlanCheckRun.Result.SqlSummaryReport
-	23, // 23: bytebase.v1.PlanCheckRun.Result.sql_review_report:type_name -> bytebase.v1.PlanCheckRun.Result.SqlReviewReport
-	31, // 24: bytebase.v1.PlanCheckRun.Result.SqlSummaryReport.statement_types:type_name -> bytebase.v1.StatementType
-	32, // 25: bytebase.v1.PlanCheckRun.Result.SqlReviewReport.start_position:type_name -> bytebase.v1.Position
-	32, // 26: bytebase.v1.PlanCheckRun.Result.SqlReviewReport.end_position:type_name -> bytebase.v1.Position
-	2,  // 27: bytebase.v1.PlanService.GetPlan:input_type -> bytebase.v1.GetPlanRequest
-	3,  // 28: bytebase.v1.PlanService.ListPlans:input_type -> bytebase.v1.ListPlansRequest
-	5,  // 29: bytebase.v1.PlanService.CreatePlan:input_type -> bytebase.v1.CreatePlanRequest
-	6,  // 30: bytebase.v1.PlanService.UpdatePlan:input_type -> bytebase.v1.UpdatePlanRequest
-	8,  // 31: bytebase.v1.PlanService.GetPlanCheckRun:input_type -> bytebase.v1.GetPlanCheckRunRequest
-	9,  // 32: bytebase.v1.PlanService.RunPlanChecks:input_type -> bytebase.v1.RunPlanChecksRequest
-	11, // 33: bytebase.v1.PlanService.CancelPlanCheckRun:input_type -> bytebase.v1.CancelPlanCheckRunRequest
-	7,  // 34: bytebase.v1.PlanService.GetPlan:output_type -> bytebase.v1.Plan
-	4,  // 35: bytebase.v1.PlanService.ListPlans:output_type -> bytebase.v1.ListPlansResponse
-	7,  // 36: bytebase.v1.PlanService.CreatePlan:output_type -> bytebase.v1.Plan
-	7,  // 37: bytebase.v1.PlanService.UpdatePlan:output_type -> bytebase.v1.Plan
-	13, // 38: bytebase.v1.PlanService.GetPlanCheckRun:output_type -> bytebase.v1.PlanCheckRun
-	10, // 39: bytebase.v1.PlanService.RunPlanChecks:output_type -> bytebase.v1.RunPlanChecksResponse
-	12, // 40: bytebase.v1.PlanService.CancelPlanCheckRun:output_type -> bytebase.v1.CancelPlanCheckRunResponse
-	34, // [34:41] is the sub-list for method output_type
-	27, // [27:34] is the sub-list for method input_type
-	27, // [27:27] is the sub-list for extension type_name
-	27, // [27:27] is the sub-list for extension extendee
-	0,  // [0:27] is the sub-list for field type_name
+	27, // 3: bytebase.v1.UpdatePlanRequest.update_mask:type_name -> google.protobuf.FieldMask
+	28, // 4: bytebase.v1.Plan.state:type_name -> bytebase.v1.State
+	16, // 5: bytebase.v1.Plan.specs:type_name -> bytebase.v1.Plan.Spec
+	29, // 6: bytebase.v1.Plan.create_time:type_name -> google.protobuf.Timestamp
+	29, // 7: bytebase.v1.Plan.update_time:type_name -> google.protobuf.Timestamp
+	17, // 8: bytebase.v1.Plan.plan_check_run_status_count:type_name -> bytebase.v1.Plan.PlanCheckRunStatusCountEntry
+	30, // 9: bytebase.v1.Plan.approval_status:type_name -> bytebase.v1.Issue.ApprovalStatus
+	22, // 10: bytebase.v1.Plan.rollout_stage_summaries:type_name -> bytebase.v1.Plan.RolloutStageSummary
+	31, // 11: bytebase.v1.PreviewPlanApprovalResponse.approval_template:type_name -> bytebase.v1.ApprovalTemplate
+	32, // 12: bytebase.v1.PreviewPlanApprovalResponse.risk_level:type_name -> bytebase.v1.RiskLevel
+	33, // 13: bytebase.v1.RunPlanChecksResponse.operation:type_name -> bytebase.v1.Operation
+	0,  // 14: bytebase.v1.PlanCheckRun.status:type_name -> bytebase.v1.PlanCheckRun.Status
+	24, // 15: bytebase.v1.PlanCheckRun.results:type_name -> bytebase.v1.PlanCheckRun.Result
+	29, // 16: bytebase.v1.PlanCheckRun.create_time:type_name -> google.protobuf.Timestamp
+	18, // 17: bytebase.v1.Plan.Spec.create_database_config:type_name -> bytebase.v1.Plan.CreateDatabaseConfig
+	19, // 18: bytebase.v1.Plan.Spec.change_database_config:type_name -> bytebase.v1.Plan.ChangeDatabaseConfig
+	20, // 19: bytebase.v1.Plan.Spec.export_data_config:type_name -> bytebase.v1.Plan.ExportDataConfig
+	21, // 20: bytebase.v1.Plan.Spec.clone_database_config:type_name -> bytebase.v1.Plan.CloneDatabaseConfig
+	34, // 21: bytebase.v1.Plan.ExportDataConfig.format:type_name -> bytebase.v1.ExportFormat
+	23, // 22: bytebase.v1.Plan.RolloutStageSummary.task_status_counts:type_name -> bytebase.v1.Plan.TaskStatusCount
+	35, // 23: bytebase.v1.Plan.TaskStatusCount.status:type_name -> bytebase.v1.Task.Status
+	36, // 24: bytebase.v1.PlanCheckRun.Result.status:type_name -> bytebase.v1.Advice.Level
+	1,  // 25: bytebase.v1.PlanCheckRun.Result.type:type_name -> bytebase.v1.PlanCheckRun.Result.Type
+	25, // 26: bytebase.v1.PlanCheckRun.Result.sql_summary_report:type_name -> bytebase.v1.PlanCheckRun.Result.SqlSummaryReport
+	26, // 27: bytebase.v1.PlanCheckRun.Result.sql_review_report:type_name -> bytebase.v1.PlanCheckRun.Result.SqlReviewReport
+	37, // 28: bytebase.v1.PlanCheckRun.Result.SqlSummaryReport.statement_types:type_name -> bytebase.v1.StatementType
+	38, // 29: bytebase.v1.PlanCheckRun.Result.SqlReviewReport.start_position:type_name -> bytebase.v1.Position
+	38, // 30: bytebase.v1.PlanCheckRun.Result.SqlReviewReport.end_position:type_name -> bytebase.v1.Position
+	2,  // 31: bytebase.v1.PlanService.GetPlan:input_type -> bytebase.v1.GetPlanRequest
+	3,  // 32: bytebase.v1.PlanService.ListPlans:input_type -> bytebase.v1.ListPlansRequest
+	5,  // 33: bytebase.v1.PlanService.CreatePlan:input_type -> bytebase.v1.CreatePlanRequest
+	6,  // 34: bytebase.v1.PlanService.UpdatePlan:input_type -> bytebase.v1.UpdatePlanRequest
+	8,  // 35: bytebase.v1.PlanService.GetPlanCheckRun:input_type -> bytebase.v1.GetPlanCheckRunRequest
+	11, // 36: bytebase.v1.PlanService.RunPlanChecks:input_type -> bytebase.v1.RunPlanChecksRequest
+	13, // 37: bytebase.v1.PlanService.CancelPlanCheckRun:input_type -> bytebase.v1.CancelPlanCheckRunRequest
+	9,  // 38: bytebase.v1.PlanService.PreviewPlanApproval:input_type -> bytebase.v1.PreviewPlanApprovalRequest
+	7,  // 39: bytebase.v1.PlanService.GetPlan:output_type -> bytebase.v1.Plan
+	4,  // 40: bytebase.v1.PlanService.ListPlans:output_type -> bytebase.v1.ListPlansResponse
+	7,  // 41: bytebase.v1.PlanService.CreatePlan:output_type -> bytebase.v1.Plan
+	7,  // 42: bytebase.v1.PlanService.UpdatePlan:output_type -> bytebase.v1.Plan
+	15, // 43: bytebase.v1.PlanService.GetPlanCheckRun:output_type -> bytebase.v1.PlanCheckRun
+	12, // 44: bytebase.v1.PlanService.RunPlanChecks:output_type -> bytebase.v1.RunPlanChecksResponse
+	14, // 45: bytebase.v1.PlanService.CancelPlanCheckRun:output_type -> bytebase.v1.CancelPlanCheckRunResponse
+	10, // 46: bytebase.v1.PlanService.PreviewPlanApproval:output_type -> bytebase.v1.PreviewPlanApprovalResponse
+	39, // [39:47] is the sub-list for method output_type
+	31, // [31:39] is the sub-list for method input_type
+	31, // [31:31] is the sub-list for extension type_name
+	31, // [31:31] is the sub-list for extension extendee
+	0,  // [0:31] is the sub-list for field type_name
 }
 
 func init() { file_v1_plan_service_proto_init() }
@@ -1883,16 +2142,18 @@ func file_v1_plan_service_proto_init() {
 	file_v1_annotation_proto_init()
 	file_v1_common_proto_init()
 	file_v1_issue_service_proto_init()
+	file_v1_operation_service_proto_init()
 	file_v1_rollout_service_proto_init()
 	file_v1_sql_service_proto_init()
-	file_v1_plan_service_proto_msgTypes[7].OneofWrappers = []any{}
-	file_v1_plan_service_proto_msgTypes[12].OneofWrappers = []any{
+	file_v1_plan_service_proto_msgTypes[9].OneofWrappers = []any{}
+	file_v1_plan_service_proto_msgTypes[14].OneofWrappers = []any{
 		(*Plan_Spec_CreateDatabaseConfig)(nil),
 		(*Plan_Spec_ChangeDatabaseConfig)(nil),
 		(*Plan_Spec_ExportDataConfig)(nil),
+		(*Plan_Spec_CloneDatabaseConfig)(nil),
 	}
-	file_v1_plan_service_proto_msgTypes[16].OneofWrappers = []any{}
-	file_v1_plan_service_proto_msgTypes[19].OneofWrappers = []any{
+	file_v1_plan_service_proto_msgTypes[18].OneofWrappers = []any{}
+	file_v1_plan_service_proto_msgTypes[22].OneofWrappers = []any{
 		(*PlanCheckRun_Result_SqlSummaryReport_)(nil),
 		(*PlanCheckRun_Result_SqlReviewReport_)(nil),
 	}
@@ -1902,7 +2163,7 @@ func file_v1_plan_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_plan_service_proto_rawDesc), len(file_v1_plan_service_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   22,
+			NumMessages:   25,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
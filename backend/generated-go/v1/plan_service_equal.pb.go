@@ -122,6 +122,9 @@ func (x *Plan_Spec) Equal(y *Plan_Spec) bool {
 	if !x.GetExportDataConfig().Equal(y.GetExportDataConfig()) {
 		return false
 	}
+	if !x.GetCloneDatabaseConfig().Equal(y.GetCloneDatabaseConfig()) {
+		return false
+	}
 	return true
 }
 
@@ -213,6 +216,41 @@ func (x *Plan_ExportDataConfig) Equal(y *Plan_ExportDataConfig) bool {
 	return true
 }
 
+func (x *Plan_CloneDatabaseConfig) Equal(y *Plan_CloneDatabaseConfig) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Source != y.Source {
+		return false
+	}
+	if x.Target != y.Target {
+		return false
+	}
+	if len(x.IncludeTables) != len(y.IncludeTables) {
+		return false
+	}
+	for i := 0; i < len(x.IncludeTables); i++ {
+		if x.IncludeTables[i] != y.IncludeTables[i] {
+			return false
+		}
+	}
+	if len(x.ExcludeTables) != len(y.ExcludeTables) {
+		return false
+	}
+	for i := 0; i < len(x.ExcludeTables); i++ {
+		if x.ExcludeTables[i] != y.ExcludeTables[i] {
+			return false
+		}
+	}
+	if x.SamplePercent != y.SamplePercent {
+		return false
+	}
+	return true
+}
+
 func (x *Plan_RolloutStageSummary) Equal(y *Plan_RolloutStageSummary) bool {
 	if x == y {
 		return true
@@ -331,6 +369,38 @@ func (x *GetPlanCheckRunRequest) Equal(y *GetPlanCheckRunRequest) bool {
 	return true
 }
 
+func (x *PreviewPlanApprovalRequest) Equal(y *PreviewPlanApprovalRequest) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	return true
+}
+
+func (x *PreviewPlanApprovalResponse) Equal(y *PreviewPlanApprovalResponse) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.ApprovalFindingDone != y.ApprovalFindingDone {
+		return false
+	}
+	if !x.ApprovalTemplate.Equal(y.ApprovalTemplate) {
+		return false
+	}
+	if x.RiskLevel != y.RiskLevel {
+		return false
+	}
+	return true
+}
+
 func (x *RunPlanChecksRequest) Equal(y *RunPlanChecksRequest) bool {
 	if x == y {
 		return true
@@ -354,6 +424,9 @@ func (x *RunPlanChecksResponse) Equal(y *RunPlanChecksResponse) bool {
 	if x == nil || y == nil {
 		return x == nil && y == nil
 	}
+	if !x.Operation.Equal(y.Operation) {
+		return false
+	}
 	return true
 }
 
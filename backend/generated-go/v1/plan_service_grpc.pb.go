@@ -19,13 +19,14 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	PlanService_GetPlan_FullMethodName            = "/bytebase.v1.PlanService/GetPlan"
-	PlanService_ListPlans_FullMethodName          = "/bytebase.v1.PlanService/ListPlans"
-	PlanService_CreatePlan_FullMethodName         = "/bytebase.v1.PlanService/CreatePlan"
-	PlanService_UpdatePlan_FullMethodName         = "/bytebase.v1.PlanService/UpdatePlan"
-	PlanService_GetPlanCheckRun_FullMethodName    = "/bytebase.v1.PlanService/GetPlanCheckRun"
-	PlanService_RunPlanChecks_FullMethodName      = "/bytebase.v1.PlanService/RunPlanChecks"
-	PlanService_CancelPlanCheckRun_FullMethodName = "/bytebase.v1.PlanService/CancelPlanCheckRun"
+	PlanService_GetPlan_FullMethodName             = "/bytebase.v1.PlanService/GetPlan"
+	PlanService_ListPlans_FullMethodName           = "/bytebase.v1.PlanService/ListPlans"
+	PlanService_CreatePlan_FullMethodName          = "/bytebase.v1.PlanService/CreatePlan"
+	PlanService_UpdatePlan_FullMethodName          = "/bytebase.v1.PlanService/UpdatePlan"
+	PlanService_GetPlanCheckRun_FullMethodName     = "/bytebase.v1.PlanService/GetPlanCheckRun"
+	PlanService_RunPlanChecks_FullMethodName       = "/bytebase.v1.PlanService/RunPlanChecks"
+	PlanService_CancelPlanCheckRun_FullMethodName  = "/bytebase.v1.PlanService/CancelPlanCheckRun"
+	PlanService_PreviewPlanApproval_FullMethodName = "/bytebase.v1.PlanService/PreviewPlanApproval"
 )
 
 // PlanServiceClient is the client API for PlanService service.
@@ -56,6 +57,10 @@ type PlanServiceClient interface {
 	// Cancels the plan check run for a deployment plan.
 	// Permissions required: bb.planCheckRuns.run
 	CancelPlanCheckRun(ctx context.Context, in *CancelPlanCheckRunRequest, opts ...grpc.CallOption) (*CancelPlanCheckRunResponse, error)
+	// Previews the approval template required by the issue of a deployment plan without creating the issue.
+	// The approval template is found once the plan check run is completed.
+	// Permissions required: bb.plans.get
+	PreviewPlanApproval(ctx context.Context, in *PreviewPlanApprovalRequest, opts ...grpc.CallOption) (*PreviewPlanApprovalResponse, error)
 }
 
 type planServiceClient struct {
@@ -136,6 +141,16 @@ func (c *planServiceClient) CancelPlanCheckRun(ctx context.Context, in *CancelPl
 	return out, nil
 }
 
+func (c *planServiceClient) PreviewPlanApproval(ctx context.Context, in *PreviewPlanApprovalRequest, opts ...grpc.CallOption) (*PreviewPlanApprovalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreviewPlanApprovalResponse)
+	err := c.cc.Invoke(ctx, PlanService_PreviewPlanApproval_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PlanServiceServer is the server API for PlanService service.
 // All implementations must embed UnimplementedPlanServiceServer
 // for forward compatibility.
@@ -164,6 +179,10 @@ type PlanServiceServer interface {
 	// Cancels the plan check run for a deployment plan.
 	// Permissions required: bb.planCheckRuns.run
 	CancelPlanCheckRun(context.Context, *CancelPlanCheckRunRequest) (*CancelPlanCheckRunResponse, error)
+	// Previews the approval template required by the issue of a deployment plan without creating the issue.
+	// The approval template is found once the plan check run is completed.
+	// Permissions required: bb.plans.get
+	PreviewPlanApproval(context.Context, *PreviewPlanApprovalRequest) (*PreviewPlanApprovalResponse, error)
 	mustEmbedUnimplementedPlanServiceServer()
 }
 
@@ -195,6 +214,9 @@ func (UnimplementedPlanServiceServer) RunPlanChecks(context.Context, *RunPlanChe
 func (UnimplementedPlanServiceServer) CancelPlanCheckRun(context.Context, *CancelPlanCheckRunRequest) (*CancelPlanCheckRunResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CancelPlanCheckRun not implemented")
 }
+func (UnimplementedPlanServiceServer) PreviewPlanApproval(context.Context, *PreviewPlanApprovalRequest) (*PreviewPlanApprovalResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PreviewPlanApproval not implemented")
+}
 func (UnimplementedPlanServiceServer) mustEmbedUnimplementedPlanServiceServer() {}
 func (UnimplementedPlanServiceServer) testEmbeddedByValue()                     {}
 
@@ -342,6 +364,24 @@ func _PlanService_CancelPlanCheckRun_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PlanService_PreviewPlanApproval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewPlanApprovalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlanServiceServer).PreviewPlanApproval(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlanService_PreviewPlanApproval_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlanServiceServer).PreviewPlanApproval(ctx, req.(*PreviewPlanApprovalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PlanService_ServiceDesc is the grpc.ServiceDesc for PlanService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -377,6 +417,10 @@ var PlanService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelPlanCheckRun",
 			Handler:    _PlanService_CancelPlanCheckRun_Handler,
 		},
+		{
+			MethodName: "PreviewPlanApproval",
+			Handler:    _PlanService_PreviewPlanApproval_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/plan_service.proto",
@@ -11,6 +11,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	reflect "reflect"
@@ -43,6 +44,9 @@ const (
 	Activity_PIPELINE_COMPLETED Activity_Type = 14
 	// ISSUE_APPROVED represents an issue being fully approved.
 	Activity_ISSUE_APPROVED Activity_Type = 15
+	// ACCESS_GRANT_BREAK_GLASS represents a break-glass access grant being activated.
+	// The security contacts of the project are mentioned.
+	Activity_ACCESS_GRANT_BREAK_GLASS Activity_Type = 16
 )
 
 // Enum value maps for Activity_Type.
@@ -55,6 +59,7 @@ var (
 		13: "PIPELINE_FAILED",
 		14: "PIPELINE_COMPLETED",
 		15: "ISSUE_APPROVED",
+		16: "ACCESS_GRANT_BREAK_GLASS",
 	}
 	Activity_Type_value = map[string]int32{
 		"TYPE_UNSPECIFIED":         0,
@@ -64,6 +69,7 @@ var (
 		"PIPELINE_FAILED":          13,
 		"PIPELINE_COMPLETED":       14,
 		"ISSUE_APPROVED":           15,
+		"ACCESS_GRANT_BREAK_GLASS": 16,
 	}
 )
 
@@ -951,8 +957,12 @@ type Project struct {
 	AllowRequestRole        bool `protobuf:"varint,22,opt,name=allow_request_role,json=allowRequestRole,proto3" json:"allow_request_role,omitempty"`
 	// Once enabled, users can request and use the just-in-time access in the SQL Editor.
 	AllowJustInTimeAccess bool `protobuf:"varint,23,opt,name=allow_just_in_time_access,json=allowJustInTimeAccess,proto3" json:"allow_just_in_time_access,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+	// The SQL Editor query result cache of the project.
+	QueryResultCache *Project_QueryResultCache `protobuf:"bytes,24,opt,name=query_result_cache,json=queryResultCache,proto3" json:"query_result_cache,omitempty"`
+	// The break-glass access setting of the project.
+	BreakGlassAccess *Project_BreakGlassAccess `protobuf:"bytes,25,opt,name=break_glass_access,json=breakGlassAccess,proto3" json:"break_glass_access,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *Project) Reset() {
@@ -1118,6 +1128,20 @@ func (x *Project) GetAllowJustInTimeAccess() bool {
 	return false
 }
 
+func (x *Project) GetQueryResultCache() *Project_QueryResultCache {
+	if x != nil {
+		return x.QueryResultCache
+	}
+	return nil
+}
+
+func (x *Project) GetBreakGlassAccess() *Project_BreakGlassAccess {
+	if x != nil {
+		return x.BreakGlassAccess
+	}
+	return nil
+}
+
 type AddWebhookRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The name of the project to add the webhook to.
@@ -1407,6 +1431,7 @@ type Webhook struct {
 	// - ISSUE_APPROVED
 	// - PIPELINE_FAILED
 	// - PIPELINE_COMPLETED
+	// - ACCESS_GRANT_BREAK_GLASS
 	NotificationTypes []Activity_Type `protobuf:"varint,5,rep,packed,name=notification_types,json=notificationTypes,proto3,enum=bytebase.v1.Activity_Type" json:"notification_types,omitempty"`
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
@@ -1567,11 +1592,144 @@ func (x *Project_ExecutionRetryPolicy) GetMaximumRetries() int32 {
 	return 0
 }
 
+// QueryResultCache caches the results of read-only queries.
+// The cache holds the results before masking, so the access check and masking
+// are applied to each caller.
+type Project_QueryResultCache struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the cache is enabled.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// How long a result stays in the cache. Required if enabled, at most 24 hours.
+	Ttl *durationpb.Duration `protobuf:"bytes,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	// The maximum total size in bytes of the cached results of the project.
+	// Defaults to 64 MiB, and must not exceed 1 GiB.
+	MaximumSize   int64 `protobuf:"varint,3,opt,name=maximum_size,json=maximumSize,proto3" json:"maximum_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Project_QueryResultCache) Reset() {
+	*x = Project_QueryResultCache{}
+	mi := &file_v1_project_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Project_QueryResultCache) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Project_QueryResultCache) ProtoMessage() {}
+
+func (x *Project_QueryResultCache) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_project_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Project_QueryResultCache.ProtoReflect.Descriptor instead.
+func (*Project_QueryResultCache) Descriptor() ([]byte, []int) {
+	return file_v1_project_service_proto_rawDescGZIP(), []int{13, 2}
+}
+
+func (x *Project_QueryResultCache) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Project_QueryResultCache) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+func (x *Project_QueryResultCache) GetMaximumSize() int64 {
+	if x != nil {
+		return x.MaximumSize
+	}
+	return 0
+}
+
+// BreakGlassAccess allows creating break-glass access grants for incidents.
+// Break-glass grants are activated without approval, and reviewed after they end.
+type Project_BreakGlassAccess struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the break-glass access is enabled.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// The maximum duration of a break-glass access grant. Required if enabled, at most 24 hours.
+	MaximumDuration *durationpb.Duration `protobuf:"bytes,2,opt,name=maximum_duration,json=maximumDuration,proto3" json:"maximum_duration,omitempty"`
+	// The security contacts paged when a break-glass access grant is activated.
+	// Format: users/{email}
+	SecurityContacts []string `protobuf:"bytes,3,rep,name=security_contacts,json=securityContacts,proto3" json:"security_contacts,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Project_BreakGlassAccess) Reset() {
+	*x = Project_BreakGlassAccess{}
+	mi := &file_v1_project_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Project_BreakGlassAccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Project_BreakGlassAccess) ProtoMessage() {}
+
+func (x *Project_BreakGlassAccess) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_project_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Project_BreakGlassAccess.ProtoReflect.Descriptor instead.
+func (*Project_BreakGlassAccess) Descriptor() ([]byte, []int) {
+	return file_v1_project_service_proto_rawDescGZIP(), []int{13, 3}
+}
+
+func (x *Project_BreakGlassAccess) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Project_BreakGlassAccess) GetMaximumDuration() *durationpb.Duration {
+	if x != nil {
+		return x.MaximumDuration
+	}
+	return nil
+}
+
+func (x *Project_BreakGlassAccess) GetSecurityContacts() []string {
+	if x != nil {
+		return x.SecurityContacts
+	}
+	return nil
+}
+
 var File_v1_project_service_proto protoreflect.FileDescriptor
 
 const file_v1_project_service_proto_rawDesc = "" +
 	"\n" +
-	"\x18v1/project_service.proto\x12\vbytebase.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x13v1/annotation.proto\x1a\x0fv1/common.proto\x1a\x13v1/iam_policy.proto\"E\n" +
+	"\x18v1/project_service.proto\x12\vbytebase.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x13v1/annotation.proto\x1a\x0fv1/common.proto\x1a\x13v1/iam_policy.proto\"E\n" +
 	"\x11GetProjectRequest\x120\n" +
 	"\x04name\x18\x01 \x01(\tB\x1c\xe0A\x02\xfaA\x16\n" +
 	"\x14bytebase.com/ProjectR\x04name\"M\n" +
@@ -1623,7 +1781,7 @@ const file_v1_project_service_proto_rawDesc = "" +
 	"\x05Label\x12\x14\n" +
 	"\x05value\x18\x01 \x01(\tR\x05value\x12\x14\n" +
 	"\x05color\x18\x02 \x01(\tR\x05color\x12\x14\n" +
-	"\x05group\x18\x03 \x01(\tR\x05group\"\x9b\t\n" +
+	"\x05group\x18\x03 \x01(\tR\x05group\"\xe5\f\n" +
 	"\aProject\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12(\n" +
 	"\x05state\x18\x02 \x01(\x0e2\x12.bytebase.v1.StateR\x05state\x12\x1e\n" +
@@ -1644,12 +1802,22 @@ const file_v1_project_service_proto_rawDesc = "" +
 	"\x16require_issue_approval\x18\x14 \x01(\bR\x14requireIssueApproval\x12<\n" +
 	"\x1brequire_plan_check_no_error\x18\x15 \x01(\bR\x17requirePlanCheckNoError\x12,\n" +
 	"\x12allow_request_role\x18\x16 \x01(\bR\x10allowRequestRole\x128\n" +
-	"\x19allow_just_in_time_access\x18\x17 \x01(\bR\x15allowJustInTimeAccess\x1a?\n" +
+	"\x19allow_just_in_time_access\x18\x17 \x01(\bR\x15allowJustInTimeAccess\x12S\n" +
+	"\x12query_result_cache\x18\x18 \x01(\v2%.bytebase.v1.Project.QueryResultCacheR\x10queryResultCache\x12S\n" +
+	"\x12break_glass_access\x18\x19 \x01(\v2%.bytebase.v1.Project.BreakGlassAccessR\x10breakGlassAccess\x1a?\n" +
 	"\x14ExecutionRetryPolicy\x12'\n" +
 	"\x0fmaximum_retries\x18\x01 \x01(\x05R\x0emaximumRetries\x1a9\n" +
 	"\vLabelsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01:-\xeaA*\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a|\n" +
+	"\x10QueryResultCache\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12+\n" +
+	"\x03ttl\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x03ttl\x12!\n" +
+	"\fmaximum_size\x18\x03 \x01(\x03R\vmaximumSize\x1a\x9f\x01\n" +
+	"\x10BreakGlassAccess\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12D\n" +
+	"\x10maximum_duration\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x0fmaximumDuration\x12+\n" +
+	"\x11security_contacts\x18\x03 \x03(\tR\x10securityContacts:-\xeaA*\n" +
 	"\x14bytebase.com/Project\x12\x12projects/{project}\"\x80\x01\n" +
 	"\x11AddWebhookRequest\x126\n" +
 	"\aproject\x18\x01 \x01(\tB\x1c\xe0A\x02\xfaA\x16\n" +
@@ -1675,8 +1843,8 @@ const file_v1_project_service_proto_rawDesc = "" +
 	"\x03url\x18\x04 \x01(\tB\x03\xe0A\x02R\x03url\x12%\n" +
 	"\x0edirect_message\x18\x06 \x01(\bR\rdirectMessage\x12N\n" +
 	"\x12notification_types\x18\x05 \x03(\x0e2\x1a.bytebase.v1.Activity.TypeB\x03\xe0A\x06R\x11notificationTypes:@\xeaA=\n" +
-	"\x14bytebase.com/Webhook\x12%projects/{project}/webhooks/{webhook}\"\xb0\x01\n" +
-	"\bActivity\"\xa3\x01\n" +
+	"\x14bytebase.com/Webhook\x12%projects/{project}/webhooks/{webhook}\"\xce\x01\n" +
+	"\bActivity\"\xc1\x01\n" +
 	"\x04Type\x12\x14\n" +
 	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x11\n" +
 	"\rISSUE_CREATED\x10\n" +
@@ -1685,15 +1853,16 @@ const file_v1_project_service_proto_rawDesc = "" +
 	"\x0fISSUE_SENT_BACK\x10\f\x12\x13\n" +
 	"\x0fPIPELINE_FAILED\x10\r\x12\x16\n" +
 	"\x12PIPELINE_COMPLETED\x10\x0e\x12\x12\n" +
-	"\x0eISSUE_APPROVED\x10\x0f2\x82\x12\n" +
+	"\x0eISSUE_APPROVED\x10\x0f\x12\x1c\n" +
+	"\x18ACCESS_GRANT_BREAK_GLASS\x10\x102\x86\x12\n" +
 	"\x0eProjectService\x12\x7f\n" +
 	"\n" +
 	"GetProject\x12\x1e.bytebase.v1.GetProjectRequest\x1a\x14.bytebase.v1.Project\";\xdaA\x04name\x8a\xea0\x0fbb.projects.get\x90\xea0\x01\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/{name=projects/*}\x12\x95\x01\n" +
 	"\x10BatchGetProjects\x12$.bytebase.v1.BatchGetProjectsRequest\x1a%.bytebase.v1.BatchGetProjectsResponse\"4\x8a\xea0\x0fbb.projects.get\x90\xea0\x01\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/projects:batchGet\x12\x84\x01\n" +
 	"\fListProjects\x12 .bytebase.v1.ListProjectsRequest\x1a!.bytebase.v1.ListProjectsResponse\"/\xdaA\x00\x8a\xea0\x10bb.projects.list\x90\xea0\x01\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/projects\x12\x80\x01\n" +
 	"\x0eSearchProjects\x12\".bytebase.v1.SearchProjectsRequest\x1a#.bytebase.v1.SearchProjectsResponse\"%\xdaA\x00\x90\xea0\x02\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/projects:search\x12\x84\x01\n" +
-	"\rCreateProject\x12!.bytebase.v1.CreateProjectRequest\x1a\x14.bytebase.v1.Project\":\xdaA\x00\x8a\xea0\x12bb.projects.create\x90\xea0\x01\x82\xd3\xe4\x93\x02\x17:\aproject\"\f/v1/projects\x12\xa8\x01\n" +
-	"\rUpdateProject\x12!.bytebase.v1.UpdateProjectRequest\x1a\x14.bytebase.v1.Project\"^\xdaA\x13project,update_mask\x8a\xea0\x12bb.projects.update\x90\xea0\x01\x82\xd3\xe4\x93\x02(:\aproject2\x1d/v1/{project.name=projects/*}\x12\x8e\x01\n" +
+	"\rCreateProject\x12!.bytebase.v1.CreateProjectRequest\x1a\x14.bytebase.v1.Project\":\xdaA\x00\x8a\xea0\x12bb.projects.create\x90\xea0\x01\x82\xd3\xe4\x93\x02\x17:\aproject\"\f/v1/projects\x12\xac\x01\n" +
+	"\rUpdateProject\x12!.bytebase.v1.UpdateProjectRequest\x1a\x14.bytebase.v1.Project\"b\xdaA\x13project,update_mask\x8a\xea0\x12bb.projects.update\x90\xea0\x01\x98\xea0\x01\x82\xd3\xe4\x93\x02(:\aproject2\x1d/v1/{project.name=projects/*}\x12\x8e\x01\n" +
 	"\rDeleteProject\x12!.bytebase.v1.DeleteProjectRequest\x1a\x16.google.protobuf.Empty\"B\xdaA\x04name\x8a\xea0\x12bb.projects.delete\x90\xea0\x01\x98\xea0\x01\x82\xd3\xe4\x93\x02\x17*\x15/v1/{name=projects/*}\x12\x97\x01\n" +
 	"\x0fUndeleteProject\x12#.bytebase.v1.UndeleteProjectRequest\x1a\x14.bytebase.v1.Project\"I\x8a\xea0\x14bb.projects.undelete\x90\xea0\x01\x98\xea0\x01\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/v1/{name=projects/*}:undelete\x12\x99\x01\n" +
 	"\x13BatchDeleteProjects\x12'.bytebase.v1.BatchDeleteProjectsRequest\x1a\x16.google.protobuf.Empty\"A\x8a\xea0\x12bb.projects.delete\x90\xea0\x01\x98\xea0\x01\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/projects:batchDelete\x12\x98\x01\n" +
@@ -1719,7 +1888,7 @@ func file_v1_project_service_proto_rawDescGZIP() []byte {
 }
 
 var file_v1_project_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_v1_project_service_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
+var file_v1_project_service_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
 var file_v1_project_service_proto_goTypes = []any{
 	(Activity_Type)(0),                   // 0: bytebase.v1.Activity.Type
 	(*GetProjectRequest)(nil),            // 1: bytebase.v1.GetProjectRequest
@@ -1745,13 +1914,16 @@ var file_v1_project_service_proto_goTypes = []any{
 	(*Activity)(nil),                     // 21: bytebase.v1.Activity
 	(*Project_ExecutionRetryPolicy)(nil), // 22: bytebase.v1.Project.ExecutionRetryPolicy
 	nil,                                  // 23: bytebase.v1.Project.LabelsEntry
-	(*fieldmaskpb.FieldMask)(nil),        // 24: google.protobuf.FieldMask
-	(State)(0),                           // 25: bytebase.v1.State
-	(WebhookType)(0),                     // 26: bytebase.v1.WebhookType
-	(*GetIamPolicyRequest)(nil),          // 27: bytebase.v1.GetIamPolicyRequest
-	(*SetIamPolicyRequest)(nil),          // 28: bytebase.v1.SetIamPolicyRequest
-	(*emptypb.Empty)(nil),                // 29: google.protobuf.Empty
-	(*IamPolicy)(nil),                    // 30: bytebase.v1.IamPolicy
+	(*Project_QueryResultCache)(nil),     // 24: bytebase.v1.Project.QueryResultCache
+	(*Project_BreakGlassAccess)(nil),     // 25: bytebase.v1.Project.BreakGlassAccess
+	(*fieldmaskpb.FieldMask)(nil),        // 26: google.protobuf.FieldMask
+	(State)(0),                           // 27: bytebase.v1.State
+	(WebhookType)(0),                     // 28: bytebase.v1.WebhookType
+	(*durationpb.Duration)(nil),          // 29: google.protobuf.Duration
+	(*GetIamPolicyRequest)(nil),          // 30: bytebase.v1.GetIamPolicyRequest
+	(*SetIamPolicyRequest)(nil),          // 31: bytebase.v1.SetIamPolicyRequest
+	(*emptypb.Empty)(nil),                // 32: google.protobuf.Empty
+	(*IamPolicy)(nil),                    // 33: bytebase.v1.IamPolicy
 }
 var file_v1_project_service_proto_depIdxs = []int32{
 	14, // 0: bytebase.v1.BatchGetProjectsResponse.projects:type_name -> bytebase.v1.Project
@@ -1759,54 +1931,58 @@ var file_v1_project_service_proto_depIdxs = []int32{
 	14, // 2: bytebase.v1.SearchProjectsResponse.projects:type_name -> bytebase.v1.Project
 	14, // 3: bytebase.v1.CreateProjectRequest.project:type_name -> bytebase.v1.Project
 	14, // 4: bytebase.v1.UpdateProjectRequest.project:type_name -> bytebase.v1.Project
-	24, // 5: bytebase.v1.UpdateProjectRequest.update_mask:type_name -> google.protobuf.FieldMask
-	25, // 6: bytebase.v1.Project.state:type_name -> bytebase.v1.State
+	26, // 5: bytebase.v1.UpdateProjectRequest.update_mask:type_name -> google.protobuf.FieldMask
+	27, // 6: bytebase.v1.Project.state:type_name -> bytebase.v1.State
 	20, // 7: bytebase.v1.Project.webhooks:type_name -> bytebase.v1.Webhook
 	13, // 8: bytebase.v1.Project.issue_labels:type_name -> bytebase.v1.Label
 	22, // 9: bytebase.v1.Project.execution_retry_policy:type_name -> bytebase.v1.Project.ExecutionRetryPolicy
 	23, // 10: bytebase.v1.Project.labels:type_name -> bytebase.v1.Project.LabelsEntry
-	20, // 11: bytebase.v1.AddWebhookRequest.webhook:type_name -> bytebase.v1.Webhook
-	20, // 12: bytebase.v1.UpdateWebhookRequest.webhook:type_name -> bytebase.v1.Webhook
-	24, // 13: bytebase.v1.UpdateWebhookRequest.update_mask:type_name -> google.protobuf.FieldMask
-	20, // 14: bytebase.v1.RemoveWebhookRequest.webhook:type_name -> bytebase.v1.Webhook
-	20, // 15: bytebase.v1.TestWebhookRequest.webhook:type_name -> bytebase.v1.Webhook
-	26, // 16: bytebase.v1.Webhook.type:type_name -> bytebase.v1.WebhookType
-	0,  // 17: bytebase.v1.Webhook.notification_types:type_name -> bytebase.v1.Activity.Type
-	1,  // 18: bytebase.v1.ProjectService.GetProject:input_type -> bytebase.v1.GetProjectRequest
-	2,  // 19: bytebase.v1.ProjectService.BatchGetProjects:input_type -> bytebase.v1.BatchGetProjectsRequest
-	4,  // 20: bytebase.v1.ProjectService.ListProjects:input_type -> bytebase.v1.ListProjectsRequest
-	6,  // 21: bytebase.v1.ProjectService.SearchProjects:input_type -> bytebase.v1.SearchProjectsRequest
-	8,  // 22: bytebase.v1.ProjectService.CreateProject:input_type -> bytebase.v1.CreateProjectRequest
-	9,  // 23: bytebase.v1.ProjectService.UpdateProject:input_type -> bytebase.v1.UpdateProjectRequest
-	10, // 24: bytebase.v1.ProjectService.DeleteProject:input_type -> bytebase.v1.DeleteProjectRequest
-	11, // 25: bytebase.v1.ProjectService.UndeleteProject:input_type -> bytebase.v1.UndeleteProjectRequest
-	12, // 26: bytebase.v1.ProjectService.BatchDeleteProjects:input_type -> bytebase.v1.BatchDeleteProjectsRequest
-	27, // 27: bytebase.v1.ProjectService.GetIamPolicy:input_type -> bytebase.v1.GetIamPolicyRequest
-	28, // 28: bytebase.v1.ProjectService.SetIamPolicy:input_type -> bytebase.v1.SetIamPolicyRequest
-	15, // 29: bytebase.v1.ProjectService.AddWebhook:input_type -> bytebase.v1.AddWebhookRequest
-	16, // 30: bytebase.v1.ProjectService.UpdateWebhook:input_type -> bytebase.v1.UpdateWebhookRequest
-	17, // 31: bytebase.v1.ProjectService.RemoveWebhook:input_type -> bytebase.v1.RemoveWebhookRequest
-	18, // 32: bytebase.v1.ProjectService.TestWebhook:input_type -> bytebase.v1.TestWebhookRequest
-	14, // 33: bytebase.v1.ProjectService.GetProject:output_type -> bytebase.v1.Project
-	3,  // 34: bytebase.v1.ProjectService.BatchGetProjects:output_type -> bytebase.v1.BatchGetProjectsResponse
-	5,  // 35: bytebase.v1.ProjectService.ListProjects:output_type -> bytebase.v1.ListProjectsResponse
-	7,  // 36: bytebase.v1.ProjectService.SearchProjects:output_type -> bytebase.v1.SearchProjectsResponse
-	14, // 37: bytebase.v1.ProjectService.CreateProject:output_type -> bytebase.v1.Project
-	14, // 38: bytebase.v1.ProjectService.UpdateProject:output_type -> bytebase.v1.Project
-	29, // 39: bytebase.v1.ProjectService.DeleteProject:output_type -> google.protobuf.Empty
-	14, // 40: bytebase.v1.ProjectService.UndeleteProject:output_type -> bytebase.v1.Project
-	29, // 41: bytebase.v1.ProjectService.BatchDeleteProjects:output_type -> google.protobuf.Empty
-	30, // 42: bytebase.v1.ProjectService.GetIamPolicy:output_type -> bytebase.v1.IamPolicy
-	30, // 43: bytebase.v1.ProjectService.SetIamPolicy:output_type -> bytebase.v1.IamPolicy
-	14, // 44: bytebase.v1.ProjectService.AddWebhook:output_type -> bytebase.v1.Project
-	14, // 45: bytebase.v1.ProjectService.UpdateWebhook:output_type -> bytebase.v1.Project
-	14, // 46: bytebase.v1.ProjectService.RemoveWebhook:output_type -> bytebase.v1.Project
-	19, // 47: bytebase.v1.ProjectService.TestWebhook:output_type -> bytebase.v1.TestWebhookResponse
-	33, // [33:48] is the sub-list for method output_type
-	18, // [18:33] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
+	24, // 11: bytebase.v1.Project.query_result_cache:type_name -> bytebase.v1.Project.QueryResultCache
+	25, // 12: bytebase.v1.Project.break_glass_access:type_name -> bytebase.v1.Project.BreakGlassAccess
+	20, // 13: bytebase.v1.AddWebhookRequest.webhook:type_name -> bytebase.v1.Webhook
+	20, // 14: bytebase.v1.UpdateWebhookRequest.webhook:type_name -> bytebase.v1.Webhook
+	26, // 15: bytebase.v1.UpdateWebhookRequest.update_mask:type_name -> google.protobuf.FieldMask
+	20, // 16: bytebase.v1.RemoveWebhookRequest.webhook:type_name -> bytebase.v1.Webhook
+	20, // 17: bytebase.v1.TestWebhookRequest.webhook:type_name -> bytebase.v1.Webhook
+	28, // 18: bytebase.v1.Webhook.type:type_name -> bytebase.v1.WebhookType
+	0,  // 19: bytebase.v1.Webhook.notification_types:type_name -> bytebase.v1.Activity.Type
+	29, // 20: bytebase.v1.Project.QueryResultCache.ttl:type_name -> google.protobuf.Duration
+	29, // 21: bytebase.v1.Project.BreakGlassAccess.maximum_duration:type_name -> google.protobuf.Duration
+	1,  // 22: bytebase.v1.ProjectService.GetProject:input_type -> bytebase.v1.GetProjectRequest
+	2,  // 23: bytebase.v1.ProjectService.BatchGetProjects:input_type -> bytebase.v1.BatchGetProjectsRequest
+	4,  // 24: bytebase.v1.ProjectService.ListProjects:input_type -> bytebase.v1.ListProjectsRequest
+	6,  // 25: bytebase.v1.ProjectService.SearchProjects:input_type -> bytebase.v1.SearchProjectsRequest
+	8,  // 26: bytebase.v1.ProjectService.CreateProject:input_type -> bytebase.v1.CreateProjectRequest
+	9,  // 27: bytebase.v1.ProjectService.UpdateProject:input_type -> bytebase.v1.UpdateProjectRequest
+	10, // 28: bytebase.v1.ProjectService.DeleteProject:input_type -> bytebase.v1.DeleteProjectRequest
+	11, // 29: bytebase.v1.ProjectService.UndeleteProject:input_type -> bytebase.v1.UndeleteProjectRequest
+	12, // 30: bytebase.v1.ProjectService.BatchDeleteProjects:input_type -> bytebase.v1.BatchDeleteProjectsRequest
+	30, // 31: bytebase.v1.ProjectService.GetIamPolicy:input_type -> bytebase.v1.GetIamPolicyRequest
+	31, // 32: bytebase.v1.ProjectService.SetIamPolicy:input_type -> bytebase.v1.SetIamPolicyRequest
+	15, // 33: bytebase.v1.ProjectService.AddWebhook:input_type -> bytebase.v1.AddWebhookRequest
+	16, // 34: bytebase.v1.ProjectService.UpdateWebhook:input_type -> bytebase.v1.UpdateWebhookRequest
+	17, // 35: bytebase.v1.ProjectService.RemoveWebhook:input_type -> bytebase.v1.RemoveWebhookRequest
+	18, // 36: bytebase.v1.ProjectService.TestWebhook:input_type -> bytebase.v1.TestWebhookRequest
+	14, // 37: bytebase.v1.ProjectService.GetProject:output_type -> bytebase.v1.Project
+	3,  // 38: bytebase.v1.ProjectService.BatchGetProjects:output_type -> bytebase.v1.BatchGetProjectsResponse
+	5,  // 39: bytebase.v1.ProjectService.ListProjects:output_type -> bytebase.v1.ListProjectsResponse
+	7,  // 40: bytebase.v1.ProjectService.SearchProjects:output_type -> bytebase.v1.SearchProjectsResponse
+	14, // 41: bytebase.v1.ProjectService.CreateProject:output_type -> bytebase.v1.Project
+	14, // 42: bytebase.v1.ProjectService.UpdateProject:output_type -> bytebase.v1.Project
+	32, // 43: bytebase.v1.ProjectService.DeleteProject:output_type -> google.protobuf.Empty
+	14, // 44: bytebase.v1.ProjectService.UndeleteProject:output_type -> bytebase.v1.Project
+	32, // 45: bytebase.v1.ProjectService.BatchDeleteProjects:output_type -> google.protobuf.Empty
+	33, // 46: bytebase.v1.ProjectService.GetIamPolicy:output_type -> bytebase.v1.IamPolicy
+	33, // 47: bytebase.v1.ProjectService.SetIamPolicy:output_type -> bytebase.v1.IamPolicy
+	14, // 48: bytebase.v1.ProjectService.AddWebhook:output_type -> bytebase.v1.Project
+	14, // 49: bytebase.v1.ProjectService.UpdateWebhook:output_type -> bytebase.v1.Project
+	14, // 50: bytebase.v1.ProjectService.RemoveWebhook:output_type -> bytebase.v1.Project
+	19, // 51: bytebase.v1.ProjectService.TestWebhook:output_type -> bytebase.v1.TestWebhookResponse
+	37, // [37:52] is the sub-list for method output_type
+	22, // [22:37] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_v1_project_service_proto_init() }
@@ -1823,7 +1999,7 @@ func file_v1_project_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_project_service_proto_rawDesc), len(file_v1_project_service_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   23,
+			NumMessages:   25,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -270,6 +270,49 @@ func (x *Project_ExecutionRetryPolicy) Equal(y *Project_ExecutionRetryPolicy) bo
 	return true
 }
 
+func (x *Project_QueryResultCache) Equal(y *Project_QueryResultCache) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Enabled != y.Enabled {
+		return false
+	}
+	if p, q := x.Ttl, y.Ttl; (p == nil && q != nil) || (p != nil && (q == nil || p.Seconds != q.Seconds || p.Nanos != q.Nanos)) {
+		return false
+	}
+	if x.MaximumSize != y.MaximumSize {
+		return false
+	}
+	return true
+}
+
+func (x *Project_BreakGlassAccess) Equal(y *Project_BreakGlassAccess) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Enabled != y.Enabled {
+		return false
+	}
+	if p, q := x.MaximumDuration, y.MaximumDuration; (p == nil && q != nil) || (p != nil && (q == nil || p.Seconds != q.Seconds || p.Nanos != q.Nanos)) {
+		return false
+	}
+	if len(x.SecurityContacts) != len(y.SecurityContacts) {
+		return false
+	}
+	for i := 0; i < len(x.SecurityContacts); i++ {
+		if x.SecurityContacts[i] != y.SecurityContacts[i] {
+			return false
+		}
+	}
+	return true
+}
+
 func (x *Project) Equal(y *Project) bool {
 	if x == y {
 		return true
@@ -353,6 +396,12 @@ func (x *Project) Equal(y *Project) bool {
 	if x.AllowJustInTimeAccess != y.AllowJustInTimeAccess {
 		return false
 	}
+	if !x.QueryResultCache.Equal(y.QueryResultCache) {
+		return false
+	}
+	if !x.BreakGlassAccess.Equal(y.BreakGlassAccess) {
+		return false
+	}
 	return true
 }
 
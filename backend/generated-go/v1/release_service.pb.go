@@ -75,7 +75,7 @@ func (x Release_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Release_Type.Descriptor instead.
 func (Release_Type) EnumDescriptor() ([]byte, []int) {
-	return file_v1_release_service_proto_rawDescGZIP(), []int{11, 0}
+	return file_v1_release_service_proto_rawDescGZIP(), []int{14, 0}
 }
 
 type GetReleaseRequest struct {
@@ -637,6 +637,203 @@ func (x *CheckReleaseResponse) GetRiskLevel() RiskLevel {
 	return RiskLevel_RISK_LEVEL_UNSPECIFIED
 }
 
+type ExportReleaseRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the release to export.
+	// Format: projects/{project}/releases/{release}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The targets to check the release on before export, e.g. the staging databases.
+	// The check results are included in the bundle.
+	// Format: instances/{instance}/databases/{database}
+	// Format: projects/{project}/databaseGroups/{databaseGroup}
+	Targets       []string `protobuf:"bytes,2,rep,name=targets,proto3" json:"targets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportReleaseRequest) Reset() {
+	*x = ExportReleaseRequest{}
+	mi := &file_v1_release_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportReleaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportReleaseRequest) ProtoMessage() {}
+
+func (x *ExportReleaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_release_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportReleaseRequest.ProtoReflect.Descriptor instead.
+func (*ExportReleaseRequest) Descriptor() ([]byte, []int) {
+	return file_v1_release_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ExportReleaseRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExportReleaseRequest) GetTargets() []string {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+type ImportReleaseRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Format: projects/{project}
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// The bundle exported by ExportRelease.
+	// The bundle must be signed by one of the trusted_release_public_keys of the workspace profile.
+	Bundle *ReleaseBundle `protobuf:"bytes,2,opt,name=bundle,proto3" json:"bundle,omitempty"`
+	// Template for release ID generation. Same as CreateReleaseRequest.release_id_template.
+	ReleaseIdTemplate string `protobuf:"bytes,3,opt,name=release_id_template,json=releaseIdTemplate,proto3" json:"release_id_template,omitempty"`
+	// Timezone for {date} and {time} variables in the template. Same as CreateReleaseRequest.release_id_timezone.
+	ReleaseIdTimezone string `protobuf:"bytes,4,opt,name=release_id_timezone,json=releaseIdTimezone,proto3" json:"release_id_timezone,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ImportReleaseRequest) Reset() {
+	*x = ImportReleaseRequest{}
+	mi := &file_v1_release_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportReleaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportReleaseRequest) ProtoMessage() {}
+
+func (x *ImportReleaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_release_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportReleaseRequest.ProtoReflect.Descriptor instead.
+func (*ImportReleaseRequest) Descriptor() ([]byte, []int) {
+	return file_v1_release_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ImportReleaseRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *ImportReleaseRequest) GetBundle() *ReleaseBundle {
+	if x != nil {
+		return x.Bundle
+	}
+	return nil
+}
+
+func (x *ImportReleaseRequest) GetReleaseIdTemplate() string {
+	if x != nil {
+		return x.ReleaseIdTemplate
+	}
+	return ""
+}
+
+func (x *ImportReleaseRequest) GetReleaseIdTimezone() string {
+	if x != nil {
+		return x.ReleaseIdTimezone
+	}
+	return ""
+}
+
+// ReleaseBundle is a signed export of a release, including the file contents.
+type ReleaseBundle struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The serialized Content in the protobuf binary format.
+	// The content is kept serialized so that the signature is verified against the exact exported bytes.
+	Content []byte `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	// The base64-encoded Ed25519 signature of the content.
+	Signature string `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	// The base64-encoded Ed25519 public key of the exporting workspace to verify the signature.
+	// The importing workspace only accepts the bundle if the key is one of its trusted_release_public_keys.
+	PublicKey     string `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseBundle) Reset() {
+	*x = ReleaseBundle{}
+	mi := &file_v1_release_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseBundle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseBundle) ProtoMessage() {}
+
+func (x *ReleaseBundle) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_release_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseBundle.ProtoReflect.Descriptor instead.
+func (*ReleaseBundle) Descriptor() ([]byte, []int) {
+	return file_v1_release_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ReleaseBundle) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ReleaseBundle) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *ReleaseBundle) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
 type ListReleaseCategoriesRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Format: projects/{project}
@@ -647,7 +844,7 @@ type ListReleaseCategoriesRequest struct {
 
 func (x *ListReleaseCategoriesRequest) Reset() {
 	*x = ListReleaseCategoriesRequest{}
-	mi := &file_v1_release_service_proto_msgTypes[9]
+	mi := &file_v1_release_service_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -659,7 +856,7 @@ func (x *ListReleaseCategoriesRequest) String() string {
 func (*ListReleaseCategoriesRequest) ProtoMessage() {}
 
 func (x *ListReleaseCategoriesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_release_service_proto_msgTypes[9]
+	mi := &file_v1_release_service_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -672,7 +869,7 @@ func (x *ListReleaseCategoriesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListReleaseCategoriesRequest.ProtoReflect.Descriptor instead.
 func (*ListReleaseCategoriesRequest) Descriptor() ([]byte, []int) {
-	return file_v1_release_service_proto_rawDescGZIP(), []int{9}
+	return file_v1_release_service_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ListReleaseCategoriesRequest) GetParent() string {
@@ -692,7 +889,7 @@ type ListReleaseCategoriesResponse struct {
 
 func (x *ListReleaseCategoriesResponse) Reset() {
 	*x = ListReleaseCategoriesResponse{}
-	mi := &file_v1_release_service_proto_msgTypes[10]
+	mi := &file_v1_release_service_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -704,7 +901,7 @@ func (x *ListReleaseCategoriesResponse) String() string {
 func (*ListReleaseCategoriesResponse) ProtoMessage() {}
 
 func (x *ListReleaseCategoriesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_release_service_proto_msgTypes[10]
+	mi := &file_v1_release_service_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -717,7 +914,7 @@ func (x *ListReleaseCategoriesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListReleaseCategoriesResponse.ProtoReflect.Descriptor instead.
 func (*ListReleaseCategoriesResponse) Descriptor() ([]byte, []int) {
-	return file_v1_release_service_proto_rawDescGZIP(), []int{10}
+	return file_v1_release_service_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ListReleaseCategoriesResponse) GetCategories() []string {
@@ -744,14 +941,16 @@ type Release struct {
 	// The lifecycle state of the release.
 	State State `protobuf:"varint,7,opt,name=state,proto3,enum=bytebase.v1.State" json:"state,omitempty"`
 	// The type of schema change for all files in this release.
-	Type          Release_Type `protobuf:"varint,8,opt,name=type,proto3,enum=bytebase.v1.Release_Type" json:"type,omitempty"`
+	Type Release_Type `protobuf:"varint,8,opt,name=type,proto3,enum=bytebase.v1.Release_Type" json:"type,omitempty"`
+	// The source of the release imported from a release bundle.
+	ImportSource  *Release_ImportSource `protobuf:"bytes,9,opt,name=import_source,json=importSource,proto3" json:"import_source,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Release) Reset() {
 	*x = Release{}
-	mi := &file_v1_release_service_proto_msgTypes[11]
+	mi := &file_v1_release_service_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -763,7 +962,7 @@ func (x *Release) String() string {
 func (*Release) ProtoMessage() {}
 
 func (x *Release) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_release_service_proto_msgTypes[11]
+	mi := &file_v1_release_service_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -776,7 +975,7 @@ func (x *Release) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Release.ProtoReflect.Descriptor instead.
 func (*Release) Descriptor() ([]byte, []int) {
-	return file_v1_release_service_proto_rawDescGZIP(), []int{11}
+	return file_v1_release_service_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *Release) GetName() string {
@@ -835,6 +1034,13 @@ func (x *Release) GetType() Release_Type {
 	return Release_TYPE_UNSPECIFIED
 }
 
+func (x *Release) GetImportSource() *Release_ImportSource {
+	if x != nil {
+		return x.ImportSource
+	}
+	return nil
+}
+
 // Check result for a single release file on a target database.
 type CheckReleaseResponse_CheckResult struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -855,7 +1061,7 @@ type CheckReleaseResponse_CheckResult struct {
 
 func (x *CheckReleaseResponse_CheckResult) Reset() {
 	*x = CheckReleaseResponse_CheckResult{}
-	mi := &file_v1_release_service_proto_msgTypes[12]
+	mi := &file_v1_release_service_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -867,7 +1073,7 @@ func (x *CheckReleaseResponse_CheckResult) String() string {
 func (*CheckReleaseResponse_CheckResult) ProtoMessage() {}
 
 func (x *CheckReleaseResponse_CheckResult) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_release_service_proto_msgTypes[12]
+	mi := &file_v1_release_service_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -918,6 +1124,245 @@ func (x *CheckReleaseResponse_CheckResult) GetRiskLevel() RiskLevel {
 	return RiskLevel_RISK_LEVEL_UNSPECIFIED
 }
 
+// The content of the bundle.
+type ReleaseBundle_Content struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the exported release.
+	// Format: projects/{project}/releases/{release}
+	Release string `protobuf:"bytes,1,opt,name=release,proto3" json:"release,omitempty"`
+	// The category of the release.
+	Category string `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	// The type of the release.
+	Type Release_Type `protobuf:"varint,3,opt,name=type,proto3,enum=bytebase.v1.Release_Type" json:"type,omitempty"`
+	// The version control source of the release.
+	VcsSource *Release_VCSSource `protobuf:"bytes,4,opt,name=vcs_source,json=vcsSource,proto3" json:"vcs_source,omitempty"`
+	// The files of the release.
+	Files []*ReleaseBundle_File `protobuf:"bytes,5,rep,name=files,proto3" json:"files,omitempty"`
+	// The check results of the release at the time of export, run by the exporting server.
+	CheckResults *CheckReleaseResponse `protobuf:"bytes,6,opt,name=check_results,json=checkResults,proto3" json:"check_results,omitempty"`
+	// The time the bundle is exported.
+	ExportTime    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=export_time,json=exportTime,proto3" json:"export_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseBundle_Content) Reset() {
+	*x = ReleaseBundle_Content{}
+	mi := &file_v1_release_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseBundle_Content) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseBundle_Content) ProtoMessage() {}
+
+func (x *ReleaseBundle_Content) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_release_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseBundle_Content.ProtoReflect.Descriptor instead.
+func (*ReleaseBundle_Content) Descriptor() ([]byte, []int) {
+	return file_v1_release_service_proto_rawDescGZIP(), []int{11, 0}
+}
+
+func (x *ReleaseBundle_Content) GetRelease() string {
+	if x != nil {
+		return x.Release
+	}
+	return ""
+}
+
+func (x *ReleaseBundle_Content) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ReleaseBundle_Content) GetType() Release_Type {
+	if x != nil {
+		return x.Type
+	}
+	return Release_TYPE_UNSPECIFIED
+}
+
+func (x *ReleaseBundle_Content) GetVcsSource() *Release_VCSSource {
+	if x != nil {
+		return x.VcsSource
+	}
+	return nil
+}
+
+func (x *ReleaseBundle_Content) GetFiles() []*ReleaseBundle_File {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *ReleaseBundle_Content) GetCheckResults() *CheckReleaseResponse {
+	if x != nil {
+		return x.CheckResults
+	}
+	return nil
+}
+
+func (x *ReleaseBundle_Content) GetExportTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExportTime
+	}
+	return nil
+}
+
+// A file of the release with its content.
+type ReleaseBundle_File struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The path of the file. e.g., `2.2/V0001_create_table.sql`.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// The version identifier for the file.
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// The SQL statement content.
+	Statement []byte `protobuf:"bytes,3,opt,name=statement,proto3" json:"statement,omitempty"`
+	// The hex-encoded SHA256 hash of the statement.
+	Sha256        string `protobuf:"bytes,4,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseBundle_File) Reset() {
+	*x = ReleaseBundle_File{}
+	mi := &file_v1_release_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseBundle_File) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseBundle_File) ProtoMessage() {}
+
+func (x *ReleaseBundle_File) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_release_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseBundle_File.ProtoReflect.Descriptor instead.
+func (*ReleaseBundle_File) Descriptor() ([]byte, []int) {
+	return file_v1_release_service_proto_rawDescGZIP(), []int{11, 1}
+}
+
+func (x *ReleaseBundle_File) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ReleaseBundle_File) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ReleaseBundle_File) GetStatement() []byte {
+	if x != nil {
+		return x.Statement
+	}
+	return nil
+}
+
+func (x *ReleaseBundle_File) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+// The source of a release imported by ImportRelease.
+type Release_ImportSource struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the exported release.
+	// Format: projects/{project}/releases/{release}
+	Release string `protobuf:"bytes,1,opt,name=release,proto3" json:"release,omitempty"`
+	// The time the bundle is exported.
+	ExportTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=export_time,json=exportTime,proto3" json:"export_time,omitempty"`
+	// The check results of the release in the bundle.
+	CheckResults  *CheckReleaseResponse `protobuf:"bytes,3,opt,name=check_results,json=checkResults,proto3" json:"check_results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Release_ImportSource) Reset() {
+	*x = Release_ImportSource{}
+	mi := &file_v1_release_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Release_ImportSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Release_ImportSource) ProtoMessage() {}
+
+func (x *Release_ImportSource) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_release_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Release_ImportSource.ProtoReflect.Descriptor instead.
+func (*Release_ImportSource) Descriptor() ([]byte, []int) {
+	return file_v1_release_service_proto_rawDescGZIP(), []int{14, 0}
+}
+
+func (x *Release_ImportSource) GetRelease() string {
+	if x != nil {
+		return x.Release
+	}
+	return ""
+}
+
+func (x *Release_ImportSource) GetExportTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExportTime
+	}
+	return nil
+}
+
+func (x *Release_ImportSource) GetCheckResults() *CheckReleaseResponse {
+	if x != nil {
+		return x.CheckResults
+	}
+	return nil
+}
+
 // A SQL file in a release.
 type Release_File struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -941,7 +1386,7 @@ type Release_File struct {
 
 func (x *Release_File) Reset() {
 	*x = Release_File{}
-	mi := &file_v1_release_service_proto_msgTypes[13]
+	mi := &file_v1_release_service_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -953,7 +1398,7 @@ func (x *Release_File) String() string {
 func (*Release_File) ProtoMessage() {}
 
 func (x *Release_File) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_release_service_proto_msgTypes[13]
+	mi := &file_v1_release_service_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -966,7 +1411,7 @@ func (x *Release_File) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Release_File.ProtoReflect.Descriptor instead.
 func (*Release_File) Descriptor() ([]byte, []int) {
-	return file_v1_release_service_proto_rawDescGZIP(), []int{11, 0}
+	return file_v1_release_service_proto_rawDescGZIP(), []int{14, 1}
 }
 
 func (x *Release_File) GetPath() string {
@@ -1010,14 +1455,20 @@ type Release_VCSSource struct {
 	// The type of VCS.
 	VcsType VCSType `protobuf:"varint,1,opt,name=vcs_type,json=vcsType,proto3,enum=bytebase.v1.VCSType" json:"vcs_type,omitempty"`
 	// The url link to the e.g., GitHub commit or pull request.
-	Url           string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// The commit SHA of the release files.
+	Commit string `protobuf:"bytes,3,opt,name=commit,proto3" json:"commit,omitempty"`
+	// The url link to the pull request, if the release is created from one.
+	PullRequestUrl string `protobuf:"bytes,4,opt,name=pull_request_url,json=pullRequestUrl,proto3" json:"pull_request_url,omitempty"`
+	// The url link to the repository, e.g., https://github.com/bytebase/bytebase.
+	Repository    string `protobuf:"bytes,5,opt,name=repository,proto3" json:"repository,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Release_VCSSource) Reset() {
 	*x = Release_VCSSource{}
-	mi := &file_v1_release_service_proto_msgTypes[14]
+	mi := &file_v1_release_service_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1029,7 +1480,7 @@ func (x *Release_VCSSource) String() string {
 func (*Release_VCSSource) ProtoMessage() {}
 
 func (x *Release_VCSSource) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_release_service_proto_msgTypes[14]
+	mi := &file_v1_release_service_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1042,7 +1493,7 @@ func (x *Release_VCSSource) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Release_VCSSource.ProtoReflect.Descriptor instead.
 func (*Release_VCSSource) Descriptor() ([]byte, []int) {
-	return file_v1_release_service_proto_rawDescGZIP(), []int{11, 1}
+	return file_v1_release_service_proto_rawDescGZIP(), []int{14, 2}
 }
 
 func (x *Release_VCSSource) GetVcsType() VCSType {
@@ -1059,6 +1510,27 @@ func (x *Release_VCSSource) GetUrl() string {
 	return ""
 }
 
+func (x *Release_VCSSource) GetCommit() string {
+	if x != nil {
+		return x.Commit
+	}
+	return ""
+}
+
+func (x *Release_VCSSource) GetPullRequestUrl() string {
+	if x != nil {
+		return x.PullRequestUrl
+	}
+	return ""
+}
+
+func (x *Release_VCSSource) GetRepository() string {
+	if x != nil {
+		return x.Repository
+	}
+	return ""
+}
+
 var File_v1_release_service_proto protoreflect.FileDescriptor
 
 const file_v1_release_service_proto_rawDesc = "" +
@@ -1111,14 +1583,44 @@ const file_v1_release_service_proto_rawDesc = "" +
 	"\aadvices\x18\x03 \x03(\v2\x13.bytebase.v1.AdviceR\aadvices\x12#\n" +
 	"\raffected_rows\x18\x04 \x01(\x03R\faffectedRows\x125\n" +
 	"\n" +
-	"risk_level\x18\x05 \x01(\x0e2\x16.bytebase.v1.RiskLevelR\triskLevel\"T\n" +
+	"risk_level\x18\x05 \x01(\x0e2\x16.bytebase.v1.RiskLevelR\triskLevel\"b\n" +
+	"\x14ExportReleaseRequest\x120\n" +
+	"\x04name\x18\x01 \x01(\tB\x1c\xe0A\x02\xfaA\x16\n" +
+	"\x14bytebase.com/ReleaseR\x04name\x12\x18\n" +
+	"\atargets\x18\x02 \x03(\tR\atargets\"\xe5\x01\n" +
+	"\x14ImportReleaseRequest\x124\n" +
+	"\x06parent\x18\x01 \x01(\tB\x1c\xe0A\x02\xfaA\x16\n" +
+	"\x14bytebase.com/ProjectR\x06parent\x127\n" +
+	"\x06bundle\x18\x02 \x01(\v2\x1a.bytebase.v1.ReleaseBundleB\x03\xe0A\x02R\x06bundle\x12.\n" +
+	"\x13release_id_template\x18\x03 \x01(\tR\x11releaseIdTemplate\x12.\n" +
+	"\x13release_id_timezone\x18\x04 \x01(\tR\x11releaseIdTimezone\"\xbe\x04\n" +
+	"\rReleaseBundle\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\fR\acontent\x12\x1c\n" +
+	"\tsignature\x18\x02 \x01(\tR\tsignature\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x03 \x01(\tR\tpublicKey\x1a\xe9\x02\n" +
+	"\aContent\x12\x18\n" +
+	"\arelease\x18\x01 \x01(\tR\arelease\x12\x1a\n" +
+	"\bcategory\x18\x02 \x01(\tR\bcategory\x12-\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x19.bytebase.v1.Release.TypeR\x04type\x12=\n" +
+	"\n" +
+	"vcs_source\x18\x04 \x01(\v2\x1e.bytebase.v1.Release.VCSSourceR\tvcsSource\x125\n" +
+	"\x05files\x18\x05 \x03(\v2\x1f.bytebase.v1.ReleaseBundle.FileR\x05files\x12F\n" +
+	"\rcheck_results\x18\x06 \x01(\v2!.bytebase.v1.CheckReleaseResponseR\fcheckResults\x12;\n" +
+	"\vexport_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"exportTime\x1aj\n" +
+	"\x04File\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x12\x1c\n" +
+	"\tstatement\x18\x03 \x01(\fR\tstatement\x12\x16\n" +
+	"\x06sha256\x18\x04 \x01(\tR\x06sha256\"T\n" +
 	"\x1cListReleaseCategoriesRequest\x124\n" +
 	"\x06parent\x18\x01 \x01(\tB\x1c\xe0A\x02\xfaA\x16\n" +
 	"\x14bytebase.com/ProjectR\x06parent\"?\n" +
 	"\x1dListReleaseCategoriesResponse\x12\x1e\n" +
 	"\n" +
 	"categories\x18\x01 \x03(\tR\n" +
-	"categories\"\xee\x05\n" +
+	"categories\"\xce\b\n" +
 	"\aRelease\x12\x17\n" +
 	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x03R\x04name\x12\x1a\n" +
 	"\bcategory\x18\x02 \x01(\tR\bcategory\x12/\n" +
@@ -1129,23 +1631,33 @@ const file_v1_release_service_proto_rawDesc = "" +
 	"\vcreate_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
 	"createTime\x12-\n" +
 	"\x05state\x18\a \x01(\x0e2\x12.bytebase.v1.StateB\x03\xe0A\x03R\x05state\x12-\n" +
-	"\x04type\x18\b \x01(\x0e2\x19.bytebase.v1.Release.TypeR\x04type\x1a\xae\x01\n" +
+	"\x04type\x18\b \x01(\x0e2\x19.bytebase.v1.Release.TypeR\x04type\x12K\n" +
+	"\rimport_source\x18\t \x01(\v2!.bytebase.v1.Release.ImportSourceB\x03\xe0A\x03R\fimportSource\x1a\xad\x01\n" +
+	"\fImportSource\x12\x18\n" +
+	"\arelease\x18\x01 \x01(\tR\arelease\x12;\n" +
+	"\vexport_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"exportTime\x12F\n" +
+	"\rcheck_results\x18\x03 \x01(\v2!.bytebase.v1.CheckReleaseResponseR\fcheckResults\x1a\xae\x01\n" +
 	"\x04File\x12\x12\n" +
 	"\x04path\x18\x02 \x01(\tR\x04path\x12\x18\n" +
 	"\aversion\x18\x06 \x01(\tR\aversion\x12-\n" +
 	"\x05sheet\x18\x03 \x01(\tB\x17\xfaA\x14\n" +
 	"\x12bytebase.com/SheetR\x05sheet\x12!\n" +
 	"\tstatement\x18\a \x01(\fB\x03\xe0A\x04R\tstatement\x12&\n" +
-	"\fsheet_sha256\x18\x04 \x01(\tB\x03\xe0A\x03R\vsheetSha256\x1aN\n" +
+	"\fsheet_sha256\x18\x04 \x01(\tB\x03\xe0A\x03R\vsheetSha256\x1a\xb0\x01\n" +
 	"\tVCSSource\x12/\n" +
 	"\bvcs_type\x18\x01 \x01(\x0e2\x14.bytebase.v1.VCSTypeR\avcsType\x12\x10\n" +
-	"\x03url\x18\x02 \x01(\tR\x03url\"<\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x16\n" +
+	"\x06commit\x18\x03 \x01(\tR\x06commit\x12(\n" +
+	"\x10pull_request_url\x18\x04 \x01(\tR\x0epullRequestUrl\x12\x1e\n" +
+	"\n" +
+	"repository\x18\x05 \x01(\tR\n" +
+	"repository\"<\n" +
 	"\x04Type\x12\x14\n" +
 	"\x10TYPE_UNSPECIFIED\x10\x00\x12\r\n" +
 	"\tVERSIONED\x10\x01\x12\x0f\n" +
 	"\vDECLARATIVE\x10\x02:@\xeaA=\n" +
-	"\x14bytebase.com/Release\x12%projects/{project}/releases/{release}2\xc0\n" +
-	"\n" +
+	"\x14bytebase.com/Release\x12%projects/{project}/releases/{release}2\xf5\f\n" +
 	"\x0eReleaseService\x12\x8a\x01\n" +
 	"\n" +
 	"GetRelease\x12\x1e.bytebase.v1.GetReleaseRequest\x1a\x14.bytebase.v1.Release\"F\xdaA\x04name\x8a\xea0\x0fbb.releases.get\x90\xea0\x01\x82\xd3\xe4\x93\x02\"\x12 /v1/{name=projects/*/releases/*}\x12\x9e\x01\n" +
@@ -1154,7 +1666,9 @@ const file_v1_release_service_proto_rawDesc = "" +
 	"\rUpdateRelease\x12!.bytebase.v1.UpdateReleaseRequest\x1a\x14.bytebase.v1.Release\"i\xdaA\x13release,update_mask\x8a\xea0\x12bb.releases.update\x90\xea0\x01\x82\xd3\xe4\x93\x023:\arelease2(/v1/{release.name=projects/*/releases/*}\x12\x95\x01\n" +
 	"\rDeleteRelease\x12!.bytebase.v1.DeleteReleaseRequest\x1a\x16.google.protobuf.Empty\"I\xdaA\x04name\x8a\xea0\x12bb.releases.delete\x90\xea0\x01\x82\xd3\xe4\x93\x02\"* /v1/{name=projects/*/releases/*}\x12\x9b\x01\n" +
 	"\x0fUndeleteRelease\x12#.bytebase.v1.UndeleteReleaseRequest\x1a\x14.bytebase.v1.Release\"M\x8a\xea0\x14bb.releases.undelete\x90\xea0\x01\x82\xd3\xe4\x93\x02+\")/v1/{name=projects/*/releases/*}:undelete\x12\x9f\x01\n" +
-	"\fCheckRelease\x12 .bytebase.v1.CheckReleaseRequest\x1a!.bytebase.v1.CheckReleaseResponse\"J\x8a\xea0\x11bb.releases.check\x90\xea0\x01\x82\xd3\xe4\x93\x02+:\x01*\"&/v1/{parent=projects/*}/releases:check\x12\xc8\x01\n" +
+	"\fCheckRelease\x12 .bytebase.v1.CheckReleaseRequest\x1a!.bytebase.v1.CheckReleaseResponse\"J\x8a\xea0\x11bb.releases.check\x90\xea0\x01\x82\xd3\xe4\x93\x02+:\x01*\"&/v1/{parent=projects/*}/releases:check\x12\x99\x01\n" +
+	"\rExportRelease\x12!.bytebase.v1.ExportReleaseRequest\x1a\x1a.bytebase.v1.ReleaseBundle\"I\x8a\xea0\x0fbb.releases.get\x90\xea0\x01\x82\xd3\xe4\x93\x02,:\x01*\"'/v1/{name=projects/*/releases/*}:export\x12\x96\x01\n" +
+	"\rImportRelease\x12!.bytebase.v1.ImportReleaseRequest\x1a\x14.bytebase.v1.Release\"L\x8a\xea0\x12bb.releases.create\x90\xea0\x01\x82\xd3\xe4\x93\x02,:\x01*\"'/v1/{parent=projects/*}/releases:import\x12\xc8\x01\n" +
 	"\x15ListReleaseCategories\x12).bytebase.v1.ListReleaseCategoriesRequest\x1a*.bytebase.v1.ListReleaseCategoriesResponse\"X\xdaA\x06parent\x8a\xea0\x10bb.releases.list\x90\xea0\x01\x82\xd3\xe4\x93\x021\x12//v1/{parent=projects/*}/releases:listCategoriesB\xa9\x01\n" +
 	"\x0fcom.bytebase.v1B\x13ReleaseServiceProtoP\x01Z4github.com/bytebase/bytebase/backend/generated-go/v1\xa2\x02\x03BXX\xaa\x02\vBytebase.V1\xca\x02\vBytebase\\V1\xe2\x02\x17Bytebase\\V1\\GPBMetadata\xea\x02\fBytebase::V1b\x06proto3"
 
@@ -1171,7 +1685,7 @@ func file_v1_release_service_proto_rawDescGZIP() []byte {
 }
 
 var file_v1_release_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_v1_release_service_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_v1_release_service_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
 var file_v1_release_service_proto_goTypes = []any{
 	(Release_Type)(0),                        // 0: bytebase.v1.Release.Type
 	(*GetReleaseRequest)(nil),                // 1: bytebase.v1.GetReleaseRequest
@@ -1183,57 +1697,76 @@ var file_v1_release_service_proto_goTypes = []any{
 	(*UndeleteReleaseRequest)(nil),           // 7: bytebase.v1.UndeleteReleaseRequest
 	(*CheckReleaseRequest)(nil),              // 8: bytebase.v1.CheckReleaseRequest
 	(*CheckReleaseResponse)(nil),             // 9: bytebase.v1.CheckReleaseResponse
-	(*ListReleaseCategoriesRequest)(nil),     // 10: bytebase.v1.ListReleaseCategoriesRequest
-	(*ListReleaseCategoriesResponse)(nil),    // 11: bytebase.v1.ListReleaseCategoriesResponse
-	(*Release)(nil),                          // 12: bytebase.v1.Release
-	(*CheckReleaseResponse_CheckResult)(nil), // 13: bytebase.v1.CheckReleaseResponse.CheckResult
-	(*Release_File)(nil),                     // 14: bytebase.v1.Release.File
-	(*Release_VCSSource)(nil),                // 15: bytebase.v1.Release.VCSSource
-	(*fieldmaskpb.FieldMask)(nil),            // 16: google.protobuf.FieldMask
-	(RiskLevel)(0),                           // 17: bytebase.v1.RiskLevel
-	(*timestamppb.Timestamp)(nil),            // 18: google.protobuf.Timestamp
-	(State)(0),                               // 19: bytebase.v1.State
-	(*Advice)(nil),                           // 20: bytebase.v1.Advice
-	(VCSType)(0),                             // 21: bytebase.v1.VCSType
-	(*emptypb.Empty)(nil),                    // 22: google.protobuf.Empty
+	(*ExportReleaseRequest)(nil),             // 10: bytebase.v1.ExportReleaseRequest
+	(*ImportReleaseRequest)(nil),             // 11: bytebase.v1.ImportReleaseRequest
+	(*ReleaseBundle)(nil),                    // 12: bytebase.v1.ReleaseBundle
+	(*ListReleaseCategoriesRequest)(nil),     // 13: bytebase.v1.ListReleaseCategoriesRequest
+	(*ListReleaseCategoriesResponse)(nil),    // 14: bytebase.v1.ListReleaseCategoriesResponse
+	(*Release)(nil),                          // 15: bytebase.v1.Release
+	(*CheckReleaseResponse_CheckResult)(nil), // 16: bytebase.v1.CheckReleaseResponse.CheckResult
+	(*ReleaseBundle_Content)(nil),            // 17: bytebase.v1.ReleaseBundle.Content
+	(*ReleaseBundle_File)(nil),               // 18: bytebase.v1.ReleaseBundle.File
+	(*Release_ImportSource)(nil),             // 19: bytebase.v1.Release.ImportSource
+	(*Release_File)(nil),                     // 20: bytebase.v1.Release.File
+	(*Release_VCSSource)(nil),                // 21: bytebase.v1.Release.VCSSource
+	(*fieldmaskpb.FieldMask)(nil),            // 22: google.protobuf.FieldMask
+	(RiskLevel)(0),                           // 23: bytebase.v1.RiskLevel
+	(*timestamppb.Timestamp)(nil),            // 24: google.protobuf.Timestamp
+	(State)(0),                               // 25: bytebase.v1.State
+	(*Advice)(nil),                           // 26: bytebase.v1.Advice
+	(VCSType)(0),                             // 27: bytebase.v1.VCSType
+	(*emptypb.Empty)(nil),                    // 28: google.protobuf.Empty
 }
 var file_v1_release_service_proto_depIdxs = []int32{
-	12, // 0: bytebase.v1.ListReleasesResponse.releases:type_name -> bytebase.v1.Release
-	12, // 1: bytebase.v1.CreateReleaseRequest.release:type_name -> bytebase.v1.Release
-	12, // 2: bytebase.v1.UpdateReleaseRequest.release:type_name -> bytebase.v1.Release
-	16, // 3: bytebase.v1.UpdateReleaseRequest.update_mask:type_name -> google.protobuf.FieldMask
-	12, // 4: bytebase.v1.CheckReleaseRequest.release:type_name -> bytebase.v1.Release
-	13, // 5: bytebase.v1.CheckReleaseResponse.results:type_name -> bytebase.v1.CheckReleaseResponse.CheckResult
-	17, // 6: bytebase.v1.CheckReleaseResponse.risk_level:type_name -> bytebase.v1.RiskLevel
-	14, // 7: bytebase.v1.Release.files:type_name -> bytebase.v1.Release.File
-	15, // 8: bytebase.v1.Release.vcs_source:type_name -> bytebase.v1.Release.VCSSource
-	18, // 9: bytebase.v1.Release.create_time:type_name -> google.protobuf.Timestamp
-	19, // 10: bytebase.v1.Release.state:type_name -> bytebase.v1.State
-	0,  // 11: bytebase.v1.Release.type:type_name -> bytebase.v1.Release.Type
-	20, // 12: bytebase.v1.CheckReleaseResponse.CheckResult.advices:type_name -> bytebase.v1.Advice
-	17, // 13: bytebase.v1.CheckReleaseResponse.CheckResult.risk_level:type_name -> bytebase.v1.RiskLevel
-	21, // 14: bytebase.v1.Release.VCSSource.vcs_type:type_name -> bytebase.v1.VCSType
-	1,  // 15: bytebase.v1.ReleaseService.GetRelease:input_type -> bytebase.v1.GetReleaseRequest
-	2,  // 16: bytebase.v1.ReleaseService.ListReleases:input_type -> bytebase.v1.ListReleasesRequest
-	4,  // 17: bytebase.v1.ReleaseService.CreateRelease:input_type -> bytebase.v1.CreateReleaseRequest
-	5,  // 18: bytebase.v1.ReleaseService.UpdateRelease:input_type -> bytebase.v1.UpdateReleaseRequest
-	6,  // 19: bytebase.v1.ReleaseService.DeleteRelease:input_type -> bytebase.v1.DeleteReleaseRequest
-	7,  // 20: bytebase.v1.ReleaseService.UndeleteRelease:input_type -> bytebase.v1.UndeleteReleaseRequest
-	8,  // 21: bytebase.v1.ReleaseService.CheckRelease:input_type -> bytebase.v1.CheckReleaseRequest
-	10, // 22: bytebase.v1.ReleaseService.ListReleaseCategories:input_type -> bytebase.v1.ListReleaseCategoriesRequest
-	12, // 23: bytebase.v1.ReleaseService.GetRelease:output_type -> bytebase.v1.Release
-	3,  // 24: bytebase.v1.ReleaseService.ListReleases:output_type -> bytebase.v1.ListReleasesResponse
-	12, // 25: bytebase.v1.ReleaseService.CreateRelease:output_type -> bytebase.v1.Release
-	12, // 26: bytebase.v1.ReleaseService.UpdateRelease:output_type -> bytebase.v1.Release
-	22, // 27: bytebase.v1.ReleaseService.DeleteRelease:output_type -> google.protobuf.Empty
-	12, // 28: bytebase.v1.ReleaseService.UndeleteRelease:output_type -> bytebase.v1.Release
-	9,  // 29: bytebase.v1.ReleaseService.CheckRelease:output_type -> bytebase.v1.CheckReleaseResponse
-	11, // 30: bytebase.v1.ReleaseService.ListReleaseCategories:output_type -> bytebase.v1.ListReleaseCategoriesResponse
-	23, // [23:31] is the sub-list for method output_type
-	15, // [15:23] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	15, // 0: bytebase.v1.ListReleasesResponse.releases:type_name -> bytebase.v1.Release
+	15, // 1: bytebase.v1.CreateReleaseRequest.release:type_name -> bytebase.v1.Release
+	15, // 2: bytebase.v1.UpdateReleaseRequest.release:type_name -> bytebase.v1.Release
+	22, // 3: bytebase.v1.UpdateReleaseRequest.update_mask:type_name -> google.protobuf.FieldMask
+	15, // 4: bytebase.v1.CheckReleaseRequest.release:type_name -> bytebase.v1.Release
+	16, // 5: bytebase.v1.CheckReleaseResponse.results:type_name -> bytebase.v1.CheckReleaseResponse.CheckResult
+	23, // 6: bytebase.v1.CheckReleaseResponse.risk_level:type_name -> bytebase.v1.RiskLevel
+	12, // 7: bytebase.v1.ImportReleaseRequest.bundle:type_name -> bytebase.v1.ReleaseBundle
+	20, // 8: bytebase.v1.Release.files:type_name -> bytebase.v1.Release.File
+	21, // 9: bytebase.v1.Release.vcs_source:type_name -> bytebase.v1.Release.VCSSource
+	24, // 10: bytebase.v1.Release.create_time:type_name -> google.protobuf.Timestamp
+	25, // 11: bytebase.v1.Release.state:type_name -> bytebase.v1.State
+	0,  // 12: bytebase.v1.Release.type:type_name -> bytebase.v1.Release.Type
+	19, // 13: bytebase.v1.Release.import_source:type_name -> bytebase.v1.Release.ImportSource
+	26, // 14: bytebase.v1.CheckReleaseResponse.CheckResult.advices:type_name -> bytebase.v1.Advice
+	23, // 15: bytebase.v1.CheckReleaseResponse.CheckResult.risk_level:type_name -> bytebase.v1.RiskLevel
+	0,  // 16: bytebase.v1.ReleaseBundle.Content.type:type_name -> bytebase.v1.Release.Type
+	21, // 17: bytebase.v1.ReleaseBundle.Content.vcs_source:type_name -> bytebase.v1.Release.VCSSource
+	18, // 18: bytebase.v1.ReleaseBundle.Content.files:type_name -> bytebase.v1.ReleaseBundle.File
+	9,  // 19: bytebase.v1.ReleaseBundle.Content.check_results:type_name -> bytebase.v1.CheckReleaseResponse
+	24, // 20: bytebase.v1.ReleaseBundle.Content.export_time:type_name -> google.protobuf.Timestamp
+	24, // 21: bytebase.v1.Release.ImportSource.export_time:type_name -> google.protobuf.Timestamp
+	9,  // 22: bytebase.v1.Release.ImportSource.check_results:type_name -> bytebase.v1.CheckReleaseResponse
+	27, // 23: bytebase.v1.Release.VCSSource.vcs_type:type_name -> bytebase.v1.VCSType
+	1,  // 24: bytebase.v1.ReleaseService.GetRelease:input_type -> bytebase.v1.GetReleaseRequest
+	2,  // 25: bytebase.v1.ReleaseService.ListReleases:input_type -> bytebase.v1.ListReleasesRequest
+	4,  // 26: bytebase.v1.ReleaseService.CreateRelease:input_type -> bytebase.v1.CreateReleaseRequest
+	5,  // 27: bytebase.v1.ReleaseService.UpdateRelease:input_type -> bytebase.v1.UpdateReleaseRequest
+	6,  // 28: bytebase.v1.ReleaseService.DeleteRelease:input_type -> bytebase.v1.DeleteReleaseRequest
+	7,  // 29: bytebase.v1.ReleaseService.UndeleteRelease:input_type -> bytebase.v1.UndeleteReleaseRequest
+	8,  // 30: bytebase.v1.ReleaseService.CheckRelease:input_type -> bytebase.v1.CheckReleaseRequest
+	10, // 31: bytebase.v1.ReleaseService.ExportRelease:input_type -> bytebase.v1.ExportReleaseRequest
+	11, // 32: bytebase.v1.ReleaseService.ImportRelease:input_type -> bytebase.v1.ImportReleaseRequest
+	13, // 33: bytebase.v1.ReleaseService.ListReleaseCategories:input_type -> bytebase.v1.ListReleaseCategoriesRequest
+	15, // 34: bytebase.v1.ReleaseService.GetRelease:output_type -> bytebase.v1.Release
+	3,  // 35: bytebase.v1.ReleaseService.ListReleases:output_type -> bytebase.v1.ListReleasesResponse
+	15, // 36: bytebase.v1.ReleaseService.CreateRelease:output_type -> bytebase.v1.Release
+	15, // 37: bytebase.v1.ReleaseService.UpdateRelease:output_type -> bytebase.v1.Release
+	28, // 38: bytebase.v1.ReleaseService.DeleteRelease:output_type -> google.protobuf.Empty
+	15, // 39: bytebase.v1.ReleaseService.UndeleteRelease:output_type -> bytebase.v1.Release
+	9,  // 40: bytebase.v1.ReleaseService.CheckRelease:output_type -> bytebase.v1.CheckReleaseResponse
+	12, // 41: bytebase.v1.ReleaseService.ExportRelease:output_type -> bytebase.v1.ReleaseBundle
+	15, // 42: bytebase.v1.ReleaseService.ImportRelease:output_type -> bytebase.v1.Release
+	14, // 43: bytebase.v1.ReleaseService.ListReleaseCategories:output_type -> bytebase.v1.ListReleaseCategoriesResponse
+	34, // [34:44] is the sub-list for method output_type
+	24, // [24:34] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
 }
 
 func init() { file_v1_release_service_proto_init() }
@@ -1250,7 +1783,7 @@ func file_v1_release_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_release_service_proto_rawDesc), len(file_v1_release_service_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   15,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -216,6 +216,126 @@ func (x *CheckReleaseResponse) Equal(y *CheckReleaseResponse) bool {
 	return true
 }
 
+func (x *ExportReleaseRequest) Equal(y *ExportReleaseRequest) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if len(x.Targets) != len(y.Targets) {
+		return false
+	}
+	for i := 0; i < len(x.Targets); i++ {
+		if x.Targets[i] != y.Targets[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (x *ImportReleaseRequest) Equal(y *ImportReleaseRequest) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Parent != y.Parent {
+		return false
+	}
+	if !x.Bundle.Equal(y.Bundle) {
+		return false
+	}
+	if x.ReleaseIdTemplate != y.ReleaseIdTemplate {
+		return false
+	}
+	if x.ReleaseIdTimezone != y.ReleaseIdTimezone {
+		return false
+	}
+	return true
+}
+
+func (x *ReleaseBundle_Content) Equal(y *ReleaseBundle_Content) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Release != y.Release {
+		return false
+	}
+	if x.Category != y.Category {
+		return false
+	}
+	if x.Type != y.Type {
+		return false
+	}
+	if !x.VcsSource.Equal(y.VcsSource) {
+		return false
+	}
+	if len(x.Files) != len(y.Files) {
+		return false
+	}
+	for i := 0; i < len(x.Files); i++ {
+		if !x.Files[i].Equal(y.Files[i]) {
+			return false
+		}
+	}
+	if !x.CheckResults.Equal(y.CheckResults) {
+		return false
+	}
+	if p, q := x.ExportTime, y.ExportTime; (p == nil && q != nil) || (p != nil && (q == nil || p.Seconds != q.Seconds || p.Nanos != q.Nanos)) {
+		return false
+	}
+	return true
+}
+
+func (x *ReleaseBundle_File) Equal(y *ReleaseBundle_File) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Path != y.Path {
+		return false
+	}
+	if x.Version != y.Version {
+		return false
+	}
+	if string(x.Statement) != string(y.Statement) {
+		return false
+	}
+	if x.Sha256 != y.Sha256 {
+		return false
+	}
+	return true
+}
+
+func (x *ReleaseBundle) Equal(y *ReleaseBundle) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if string(x.Content) != string(y.Content) {
+		return false
+	}
+	if x.Signature != y.Signature {
+		return false
+	}
+	if x.PublicKey != y.PublicKey {
+		return false
+	}
+	return true
+}
+
 func (x *ListReleaseCategoriesRequest) Equal(y *ListReleaseCategoriesRequest) bool {
 	if x == y {
 		return true
@@ -247,6 +367,25 @@ func (x *ListReleaseCategoriesResponse) Equal(y *ListReleaseCategoriesResponse)
 	return true
 }
 
+func (x *Release_ImportSource) Equal(y *Release_ImportSource) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Release != y.Release {
+		return false
+	}
+	if p, q := x.ExportTime, y.ExportTime; (p == nil && q != nil) || (p != nil && (q == nil || p.Seconds != q.Seconds || p.Nanos != q.Nanos)) {
+		return false
+	}
+	if !x.CheckResults.Equal(y.CheckResults) {
+		return false
+	}
+	return true
+}
+
 func (x *Release_File) Equal(y *Release_File) bool {
 	if x == y {
 		return true
@@ -285,6 +424,15 @@ func (x *Release_VCSSource) Equal(y *Release_VCSSource) bool {
 	if x.Url != y.Url {
 		return false
 	}
+	if x.Commit != y.Commit {
+		return false
+	}
+	if x.PullRequestUrl != y.PullRequestUrl {
+		return false
+	}
+	if x.Repository != y.Repository {
+		return false
+	}
 	return true
 }
 
@@ -324,5 +472,8 @@ func (x *Release) Equal(y *Release) bool {
 	if x.Type != y.Type {
 		return false
 	}
+	if !x.ImportSource.Equal(y.ImportSource) {
+		return false
+	}
 	return true
 }
@@ -27,6 +27,8 @@ const (
 	ReleaseService_DeleteRelease_FullMethodName         = "/bytebase.v1.ReleaseService/DeleteRelease"
 	ReleaseService_UndeleteRelease_FullMethodName       = "/bytebase.v1.ReleaseService/UndeleteRelease"
 	ReleaseService_CheckRelease_FullMethodName          = "/bytebase.v1.ReleaseService/CheckRelease"
+	ReleaseService_ExportRelease_FullMethodName         = "/bytebase.v1.ReleaseService/ExportRelease"
+	ReleaseService_ImportRelease_FullMethodName         = "/bytebase.v1.ReleaseService/ImportRelease"
 	ReleaseService_ListReleaseCategories_FullMethodName = "/bytebase.v1.ReleaseService/ListReleaseCategories"
 )
 
@@ -58,6 +60,14 @@ type ReleaseServiceClient interface {
 	// Validates a release by dry-running checks on target databases.
 	// Permissions required: bb.releases.check
 	CheckRelease(ctx context.Context, in *CheckReleaseRequest, opts ...grpc.CallOption) (*CheckReleaseResponse, error)
+	// Exports a release as a signed bundle, which can be imported into another project or Bytebase workspace.
+	// The release is checked on the targets by the server, and the check results are signed in the bundle.
+	// Permissions required: bb.releases.get, and bb.releases.check if targets are given
+	ExportRelease(ctx context.Context, in *ExportReleaseRequest, opts ...grpc.CallOption) (*ReleaseBundle, error)
+	// Imports a signed release bundle as a new release.
+	// The bundle is refused if the signature or the file hashes don't match the content.
+	// Permissions required: bb.releases.create
+	ImportRelease(ctx context.Context, in *ImportReleaseRequest, opts ...grpc.CallOption) (*Release, error)
 	// Lists all unique categories in a project.
 	// Permissions required: bb.releases.list
 	ListReleaseCategories(ctx context.Context, in *ListReleaseCategoriesRequest, opts ...grpc.CallOption) (*ListReleaseCategoriesResponse, error)
@@ -141,6 +151,26 @@ func (c *releaseServiceClient) CheckRelease(ctx context.Context, in *CheckReleas
 	return out, nil
 }
 
+func (c *releaseServiceClient) ExportRelease(ctx context.Context, in *ExportReleaseRequest, opts ...grpc.CallOption) (*ReleaseBundle, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseBundle)
+	err := c.cc.Invoke(ctx, ReleaseService_ExportRelease_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *releaseServiceClient) ImportRelease(ctx context.Context, in *ImportReleaseRequest, opts ...grpc.CallOption) (*Release, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Release)
+	err := c.cc.Invoke(ctx, ReleaseService_ImportRelease_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *releaseServiceClient) ListReleaseCategories(ctx context.Context, in *ListReleaseCategoriesRequest, opts ...grpc.CallOption) (*ListReleaseCategoriesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListReleaseCategoriesResponse)
@@ -179,6 +209,14 @@ type ReleaseServiceServer interface {
 	// Validates a release by dry-running checks on target databases.
 	// Permissions required: bb.releases.check
 	CheckRelease(context.Context, *CheckReleaseRequest) (*CheckReleaseResponse, error)
+	// Exports a release as a signed bundle, which can be imported into another project or Bytebase workspace.
+	// The release is checked on the targets by the server, and the check results are signed in the bundle.
+	// Permissions required: bb.releases.get, and bb.releases.check if targets are given
+	ExportRelease(context.Context, *ExportReleaseRequest) (*ReleaseBundle, error)
+	// Imports a signed release bundle as a new release.
+	// The bundle is refused if the signature or the file hashes don't match the content.
+	// Permissions required: bb.releases.create
+	ImportRelease(context.Context, *ImportReleaseRequest) (*Release, error)
 	// Lists all unique categories in a project.
 	// Permissions required: bb.releases.list
 	ListReleaseCategories(context.Context, *ListReleaseCategoriesRequest) (*ListReleaseCategoriesResponse, error)
@@ -213,6 +251,12 @@ func (UnimplementedReleaseServiceServer) UndeleteRelease(context.Context, *Undel
 func (UnimplementedReleaseServiceServer) CheckRelease(context.Context, *CheckReleaseRequest) (*CheckReleaseResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CheckRelease not implemented")
 }
+func (UnimplementedReleaseServiceServer) ExportRelease(context.Context, *ExportReleaseRequest) (*ReleaseBundle, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportRelease not implemented")
+}
+func (UnimplementedReleaseServiceServer) ImportRelease(context.Context, *ImportReleaseRequest) (*Release, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportRelease not implemented")
+}
 func (UnimplementedReleaseServiceServer) ListReleaseCategories(context.Context, *ListReleaseCategoriesRequest) (*ListReleaseCategoriesResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListReleaseCategories not implemented")
 }
@@ -363,6 +407,42 @@ func _ReleaseService_CheckRelease_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ReleaseService_ExportRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportReleaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReleaseServiceServer).ExportRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReleaseService_ExportRelease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReleaseServiceServer).ExportRelease(ctx, req.(*ExportReleaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReleaseService_ImportRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportReleaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReleaseServiceServer).ImportRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReleaseService_ImportRelease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReleaseServiceServer).ImportRelease(ctx, req.(*ImportReleaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ReleaseService_ListReleaseCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListReleaseCategoriesRequest)
 	if err := dec(in); err != nil {
@@ -416,6 +496,14 @@ var ReleaseService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CheckRelease",
 			Handler:    _ReleaseService_CheckRelease_Handler,
 		},
+		{
+			MethodName: "ExportRelease",
+			Handler:    _ReleaseService_ExportRelease_Handler,
+		},
+		{
+			MethodName: "ImportRelease",
+			Handler:    _ReleaseService_ImportRelease_Handler,
+		},
 		{
 			MethodName: "ListReleaseCategories",
 			Handler:    _ReleaseService_ListReleaseCategories_Handler,
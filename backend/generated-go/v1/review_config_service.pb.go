@@ -1450,13 +1450,13 @@ const file_v1_review_config_service_proto_rawDesc = "" +
 	"\x1aBUILTIN_PRIOR_BACKUP_CHECK\x10m\x12\x1e\n" +
 	"\x1aBUILTIN_WALK_THROUGH_CHECK\x10n\x12\x1f\n" +
 	"\x1bSTATEMENT_DISALLOW_TRUNCATE\x10oB\t\n" +
-	"\apayload2\xed\x06\n" +
-	"\x13ReviewConfigService\x12\xa3\x01\n" +
-	"\x12CreateReviewConfig\x12&.bytebase.v1.CreateReviewConfigRequest\x1a\x19.bytebase.v1.ReviewConfig\"J\xdaA\x00\x8a\xea0\x17bb.reviewConfigs.create\x90\xea0\x01\x82\xd3\xe4\x93\x02\":\rreview_config\"\x11/v1/reviewConfigs\x12\x9d\x01\n" +
+	"\apayload2\xf9\x06\n" +
+	"\x13ReviewConfigService\x12\xa7\x01\n" +
+	"\x12CreateReviewConfig\x12&.bytebase.v1.CreateReviewConfigRequest\x1a\x19.bytebase.v1.ReviewConfig\"N\xdaA\x00\x8a\xea0\x17bb.reviewConfigs.create\x90\xea0\x01\x98\xea0\x01\x82\xd3\xe4\x93\x02\":\rreview_config\"\x11/v1/reviewConfigs\x12\x9d\x01\n" +
 	"\x11ListReviewConfigs\x12%.bytebase.v1.ListReviewConfigsRequest\x1a&.bytebase.v1.ListReviewConfigsResponse\"9\xdaA\x00\x8a\xea0\x15bb.reviewConfigs.list\x90\xea0\x01\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/reviewConfigs\x12\x98\x01\n" +
-	"\x0fGetReviewConfig\x12#.bytebase.v1.GetReviewConfigRequest\x1a\x19.bytebase.v1.ReviewConfig\"E\xdaA\x04name\x8a\xea0\x14bb.reviewConfigs.get\x90\xea0\x01\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/{name=reviewConfigs/*}\x12\xd3\x01\n" +
-	"\x12UpdateReviewConfig\x12&.bytebase.v1.UpdateReviewConfigRequest\x1a\x19.bytebase.v1.ReviewConfig\"z\xdaA\x19review_config,update_mask\x8a\xea0\x17bb.reviewConfigs.update\x90\xea0\x01\x82\xd3\xe4\x93\x029:\rreview_config2(/v1/{review_config.name=reviewConfigs/*}\x12\x9e\x01\n" +
-	"\x12DeleteReviewConfig\x12&.bytebase.v1.DeleteReviewConfigRequest\x1a\x16.google.protobuf.Empty\"H\xdaA\x04name\x8a\xea0\x17bb.reviewConfigs.delete\x90\xea0\x01\x82\xd3\xe4\x93\x02\x1c*\x1a/v1/{name=reviewConfigs/*}B\xae\x01\n" +
+	"\x0fGetReviewConfig\x12#.bytebase.v1.GetReviewConfigRequest\x1a\x19.bytebase.v1.ReviewConfig\"E\xdaA\x04name\x8a\xea0\x14bb.reviewConfigs.get\x90\xea0\x01\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/{name=reviewConfigs/*}\x12\xd7\x01\n" +
+	"\x12UpdateReviewConfig\x12&.bytebase.v1.UpdateReviewConfigRequest\x1a\x19.bytebase.v1.ReviewConfig\"~\xdaA\x19review_config,update_mask\x8a\xea0\x17bb.reviewConfigs.update\x90\xea0\x01\x98\xea0\x01\x82\xd3\xe4\x93\x029:\rreview_config2(/v1/{review_config.name=reviewConfigs/*}\x12\xa2\x01\n" +
+	"\x12DeleteReviewConfig\x12&.bytebase.v1.DeleteReviewConfigRequest\x1a\x16.google.protobuf.Empty\"L\xdaA\x04name\x8a\xea0\x17bb.reviewConfigs.delete\x90\xea0\x01\x98\xea0\x01\x82\xd3\xe4\x93\x02\x1c*\x1a/v1/{name=reviewConfigs/*}B\xae\x01\n" +
 	"\x0fcom.bytebase.v1B\x18ReviewConfigServiceProtoP\x01Z4github.com/bytebase/bytebase/backend/generated-go/v1\xa2\x02\x03BXX\xaa\x02\vBytebase.V1\xca\x02\vBytebase\\V1\xe2\x02\x17Bytebase\\V1\\GPBMetadata\xea\x02\fBytebase::V1b\x06proto3"
 
 var (
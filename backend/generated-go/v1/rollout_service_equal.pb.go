@@ -707,6 +707,28 @@ func (x *TaskRunLogEntry_ReleaseFileExecute) Equal(y *TaskRunLogEntry_ReleaseFil
 	return true
 }
 
+func (x *TaskRunLogEntry_TableCopy) Equal(y *TaskRunLogEntry_TableCopy) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Schema != y.Schema {
+		return false
+	}
+	if x.Table != y.Table {
+		return false
+	}
+	if x.CopiedRows != y.CopiedRows {
+		return false
+	}
+	if x.Error != y.Error {
+		return false
+	}
+	return true
+}
+
 func (x *TaskRunLogEntry) Equal(y *TaskRunLogEntry) bool {
 	if x == y {
 		return true
@@ -747,6 +769,9 @@ func (x *TaskRunLogEntry) Equal(y *TaskRunLogEntry) bool {
 	if !x.ReleaseFileExecute.Equal(y.ReleaseFileExecute) {
 		return false
 	}
+	if !x.TableCopy.Equal(y.TableCopy) {
+		return false
+	}
 	return true
 }
 
@@ -255,6 +255,57 @@ func (WorkspaceApprovalSetting_Rule_Source) EnumDescriptor() ([]byte, []int) {
 	return file_v1_setting_service_proto_rawDescGZIP(), []int{10, 0, 0}
 }
 
+type DataClassificationSetting_DataClassificationConfig_Detector_Checksum int32
+
+const (
+	DataClassificationSetting_DataClassificationConfig_Detector_CHECKSUM_UNSPECIFIED DataClassificationSetting_DataClassificationConfig_Detector_Checksum = 0
+	// Luhn checksum used by payment card numbers.
+	DataClassificationSetting_DataClassificationConfig_Detector_LUHN DataClassificationSetting_DataClassificationConfig_Detector_Checksum = 1
+	// ISO 13616 IBAN checksum.
+	DataClassificationSetting_DataClassificationConfig_Detector_IBAN DataClassificationSetting_DataClassificationConfig_Detector_Checksum = 2
+)
+
+// Enum value maps for DataClassificationSetting_DataClassificationConfig_Detector_Checksum.
+var (
+	DataClassificationSetting_DataClassificationConfig_Detector_Checksum_name = map[int32]string{
+		0: "CHECKSUM_UNSPECIFIED",
+		1: "LUHN",
+		2: "IBAN",
+	}
+	DataClassificationSetting_DataClassificationConfig_Detector_Checksum_value = map[string]int32{
+		"CHECKSUM_UNSPECIFIED": 0,
+		"LUHN":                 1,
+		"IBAN":                 2,
+	}
+)
+
+func (x DataClassificationSetting_DataClassificationConfig_Detector_Checksum) Enum() *DataClassificationSetting_DataClassificationConfig_Detector_Checksum {
+	p := new(DataClassificationSetting_DataClassificationConfig_Detector_Checksum)
+	*p = x
+	return p
+}
+
+func (x DataClassificationSetting_DataClassificationConfig_Detector_Checksum) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DataClassificationSetting_DataClassificationConfig_Detector_Checksum) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_setting_service_proto_enumTypes[4].Descriptor()
+}
+
+func (DataClassificationSetting_DataClassificationConfig_Detector_Checksum) Type() protoreflect.EnumType {
+	return &file_v1_setting_service_proto_enumTypes[4]
+}
+
+func (x DataClassificationSetting_DataClassificationConfig_Detector_Checksum) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DataClassificationSetting_DataClassificationConfig_Detector_Checksum.Descriptor instead.
+func (DataClassificationSetting_DataClassificationConfig_Detector_Checksum) EnumDescriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{11, 0, 3, 0}
+}
+
 type Algorithm_InnerOuterMask_MaskType int32
 
 const (
@@ -288,11 +339,11 @@ func (x Algorithm_InnerOuterMask_MaskType) String() string {
 }
 
 func (Algorithm_InnerOuterMask_MaskType) Descriptor() protoreflect.EnumDescriptor {
-	return file_v1_setting_service_proto_enumTypes[4].Descriptor()
+	return file_v1_setting_service_proto_enumTypes[5].Descriptor()
 }
 
 func (Algorithm_InnerOuterMask_MaskType) Type() protoreflect.EnumType {
-	return &file_v1_setting_service_proto_enumTypes[4]
+	return &file_v1_setting_service_proto_enumTypes[5]
 }
 
 func (x Algorithm_InnerOuterMask_MaskType) Number() protoreflect.EnumNumber {
@@ -304,6 +355,58 @@ func (Algorithm_InnerOuterMask_MaskType) EnumDescriptor() ([]byte, []int) {
 	return file_v1_setting_service_proto_rawDescGZIP(), []int{13, 3, 0}
 }
 
+type Algorithm_TokenizationMask_Format int32
+
+const (
+	// Preserve the character classes of the whole value.
+	Algorithm_TokenizationMask_FORMAT_UNSPECIFIED Algorithm_TokenizationMask_Format = 0
+	// Tokenize the local part of the email and keep the domain.
+	Algorithm_TokenizationMask_EMAIL Algorithm_TokenizationMask_Format = 1
+	// Tokenize the digits and recompute the Luhn check digit.
+	Algorithm_TokenizationMask_PAYMENT_CARD Algorithm_TokenizationMask_Format = 2
+)
+
+// Enum value maps for Algorithm_TokenizationMask_Format.
+var (
+	Algorithm_TokenizationMask_Format_name = map[int32]string{
+		0: "FORMAT_UNSPECIFIED",
+		1: "EMAIL",
+		2: "PAYMENT_CARD",
+	}
+	Algorithm_TokenizationMask_Format_value = map[string]int32{
+		"FORMAT_UNSPECIFIED": 0,
+		"EMAIL":              1,
+		"PAYMENT_CARD":       2,
+	}
+)
+
+func (x Algorithm_TokenizationMask_Format) Enum() *Algorithm_TokenizationMask_Format {
+	p := new(Algorithm_TokenizationMask_Format)
+	*p = x
+	return p
+}
+
+func (x Algorithm_TokenizationMask_Format) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Algorithm_TokenizationMask_Format) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_setting_service_proto_enumTypes[6].Descriptor()
+}
+
+func (Algorithm_TokenizationMask_Format) Type() protoreflect.EnumType {
+	return &file_v1_setting_service_proto_enumTypes[6]
+}
+
+func (x Algorithm_TokenizationMask_Format) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Algorithm_TokenizationMask_Format.Descriptor instead.
+func (Algorithm_TokenizationMask_Format) EnumDescriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{13, 4, 0}
+}
+
 type AISetting_Provider int32
 
 const (
@@ -343,11 +446,11 @@ func (x AISetting_Provider) String() string {
 }
 
 func (AISetting_Provider) Descriptor() protoreflect.EnumDescriptor {
-	return file_v1_setting_service_proto_enumTypes[5].Descriptor()
+	return file_v1_setting_service_proto_enumTypes[7].Descriptor()
 }
 
 func (AISetting_Provider) Type() protoreflect.EnumType {
-	return &file_v1_setting_service_proto_enumTypes[5]
+	return &file_v1_setting_service_proto_enumTypes[7]
 }
 
 func (x AISetting_Provider) Number() protoreflect.EnumNumber {
@@ -389,11 +492,11 @@ func (x EmailSetting_Type) String() string {
 }
 
 func (EmailSetting_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_v1_setting_service_proto_enumTypes[6].Descriptor()
+	return file_v1_setting_service_proto_enumTypes[8].Descriptor()
 }
 
 func (EmailSetting_Type) Type() protoreflect.EnumType {
-	return &file_v1_setting_service_proto_enumTypes[6]
+	return &file_v1_setting_service_proto_enumTypes[8]
 }
 
 func (x EmailSetting_Type) Number() protoreflect.EnumNumber {
@@ -441,11 +544,11 @@ func (x EmailSetting_SMTPConfig_Encryption) String() string {
 }
 
 func (EmailSetting_SMTPConfig_Encryption) Descriptor() protoreflect.EnumDescriptor {
-	return file_v1_setting_service_proto_enumTypes[7].Descriptor()
+	return file_v1_setting_service_proto_enumTypes[9].Descriptor()
 }
 
 func (EmailSetting_SMTPConfig_Encryption) Type() protoreflect.EnumType {
-	return &file_v1_setting_service_proto_enumTypes[7]
+	return &file_v1_setting_service_proto_enumTypes[9]
 }
 
 func (x EmailSetting_SMTPConfig_Encryption) Number() protoreflect.EnumNumber {
@@ -496,11 +599,11 @@ func (x EmailSetting_SMTPConfig_Authentication) String() string {
 }
 
 func (EmailSetting_SMTPConfig_Authentication) Descriptor() protoreflect.EnumDescriptor {
-	return file_v1_setting_service_proto_enumTypes[8].Descriptor()
+	return file_v1_setting_service_proto_enumTypes[10].Descriptor()
 }
 
 func (EmailSetting_SMTPConfig_Authentication) Type() protoreflect.EnumType {
-	return &file_v1_setting_service_proto_enumTypes[8]
+	return &file_v1_setting_service_proto_enumTypes[10]
 }
 
 func (x EmailSetting_SMTPConfig_Authentication) Number() protoreflect.EnumNumber {
@@ -1084,8 +1187,11 @@ type WorkspaceProfileSetting struct {
 	// Allow signin/signup using email + a 6-digit one-time verification code.
 	// Requires the EMAIL setting to be configured on the workspace.
 	AllowEmailCodeSignin bool `protobuf:"varint,22,opt,name=allow_email_code_signin,json=allowEmailCodeSignin,proto3" json:"allow_email_code_signin,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+	// The base64-encoded Ed25519 public keys trusted to verify the imported release bundles.
+	// The public key of a workspace is included in the bundles it exports.
+	TrustedReleasePublicKeys []string `protobuf:"bytes,23,rep,name=trusted_release_public_keys,json=trustedReleasePublicKeys,proto3" json:"trusted_release_public_keys,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
 }
 
 func (x *WorkspaceProfileSetting) Reset() {
@@ -1265,6 +1371,13 @@ func (x *WorkspaceProfileSetting) GetAllowEmailCodeSignin() bool {
 	return false
 }
 
+func (x *WorkspaceProfileSetting) GetTrustedReleasePublicKeys() []string {
+	if x != nil {
+		return x.TrustedReleasePublicKeys
+	}
+	return nil
+}
+
 type Announcement struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The alert level of announcement
@@ -1468,6 +1581,9 @@ type Algorithm struct {
 	//	*Algorithm_RangeMask_
 	//	*Algorithm_Md5Mask
 	//	*Algorithm_InnerOuterMask_
+	//	*Algorithm_TokenizationMask_
+	//	*Algorithm_DateShiftMask_
+	//	*Algorithm_NumericNoiseMask_
 	Mask          isAlgorithm_Mask `protobuf_oneof:"mask"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -1546,6 +1662,33 @@ func (x *Algorithm) GetInnerOuterMask() *Algorithm_InnerOuterMask {
 	return nil
 }
 
+func (x *Algorithm) GetTokenizationMask() *Algorithm_TokenizationMask {
+	if x != nil {
+		if x, ok := x.Mask.(*Algorithm_TokenizationMask_); ok {
+			return x.TokenizationMask
+		}
+	}
+	return nil
+}
+
+func (x *Algorithm) GetDateShiftMask() *Algorithm_DateShiftMask {
+	if x != nil {
+		if x, ok := x.Mask.(*Algorithm_DateShiftMask_); ok {
+			return x.DateShiftMask
+		}
+	}
+	return nil
+}
+
+func (x *Algorithm) GetNumericNoiseMask() *Algorithm_NumericNoiseMask {
+	if x != nil {
+		if x, ok := x.Mask.(*Algorithm_NumericNoiseMask_); ok {
+			return x.NumericNoiseMask
+		}
+	}
+	return nil
+}
+
 type isAlgorithm_Mask interface {
 	isAlgorithm_Mask()
 }
@@ -1566,6 +1709,18 @@ type Algorithm_InnerOuterMask_ struct {
 	InnerOuterMask *Algorithm_InnerOuterMask `protobuf:"bytes,4,opt,name=inner_outer_mask,json=innerOuterMask,proto3,oneof"`
 }
 
+type Algorithm_TokenizationMask_ struct {
+	TokenizationMask *Algorithm_TokenizationMask `protobuf:"bytes,5,opt,name=tokenization_mask,json=tokenizationMask,proto3,oneof"`
+}
+
+type Algorithm_DateShiftMask_ struct {
+	DateShiftMask *Algorithm_DateShiftMask `protobuf:"bytes,6,opt,name=date_shift_mask,json=dateShiftMask,proto3,oneof"`
+}
+
+type Algorithm_NumericNoiseMask_ struct {
+	NumericNoiseMask *Algorithm_NumericNoiseMask `protobuf:"bytes,7,opt,name=numeric_noise_mask,json=numericNoiseMask,proto3,oneof"`
+}
+
 func (*Algorithm_FullMask_) isAlgorithm_Mask() {}
 
 func (*Algorithm_RangeMask_) isAlgorithm_Mask() {}
@@ -1574,6 +1729,12 @@ func (*Algorithm_Md5Mask) isAlgorithm_Mask() {}
 
 func (*Algorithm_InnerOuterMask_) isAlgorithm_Mask() {}
 
+func (*Algorithm_TokenizationMask_) isAlgorithm_Mask() {}
+
+func (*Algorithm_DateShiftMask_) isAlgorithm_Mask() {}
+
+func (*Algorithm_NumericNoiseMask_) isAlgorithm_Mask() {}
+
 type AISetting struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
@@ -2586,8 +2747,10 @@ type DataClassificationSetting_DataClassificationConfig struct {
 	// classification is the id - DataClassification map.
 	// The id should in [0-9]+-[0-9]+-[0-9]+ format.
 	Classification map[string]*DataClassificationSetting_DataClassificationConfig_DataClassification `protobuf:"bytes,4,rep,name=classification,proto3" json:"classification,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// detectors are used by sensitive data discovery to propose column classifications.
+	Detectors     []*DataClassificationSetting_DataClassificationConfig_Detector `protobuf:"bytes,5,rep,name=detectors,proto3" json:"detectors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DataClassificationSetting_DataClassificationConfig) Reset() {
@@ -2648,6 +2811,13 @@ func (x *DataClassificationSetting_DataClassificationConfig) GetClassification()
 	return nil
 }
 
+func (x *DataClassificationSetting_DataClassificationConfig) GetDetectors() []*DataClassificationSetting_DataClassificationConfig_Detector {
+	if x != nil {
+		return x.Detectors
+	}
+	return nil
+}
+
 type DataClassificationSetting_DataClassificationConfig_Level struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	Title string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
@@ -2763,6 +2933,135 @@ func (x *DataClassificationSetting_DataClassificationConfig_DataClassification)
 	return 0
 }
 
+// Detector proposes a classification and semantic type for columns it matches.
+// A column matches if its name or comment matches the patterns, or if enough of
+// its sampled values match the value rules.
+type DataClassificationSetting_DataClassificationConfig_Detector struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique id of the detector in the config.
+	Id    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// column_name_pattern is a RE2 regex matched against the column name.
+	ColumnNamePattern string `protobuf:"bytes,3,opt,name=column_name_pattern,json=columnNamePattern,proto3" json:"column_name_pattern,omitempty"`
+	// comment_pattern is a RE2 regex matched against the column comment.
+	CommentPattern string `protobuf:"bytes,4,opt,name=comment_pattern,json=commentPattern,proto3" json:"comment_pattern,omitempty"`
+	// value_pattern is a RE2 regex matched against the whole sampled value.
+	ValuePattern string `protobuf:"bytes,5,opt,name=value_pattern,json=valuePattern,proto3" json:"value_pattern,omitempty"`
+	// checksum is validated against the sampled values.
+	Checksum DataClassificationSetting_DataClassificationConfig_Detector_Checksum `protobuf:"varint,6,opt,name=checksum,proto3,enum=bytebase.v1.DataClassificationSetting_DataClassificationConfig_Detector_Checksum" json:"checksum,omitempty"`
+	// dictionary is the list of words matched case-insensitively against the sampled values.
+	Dictionary []string `protobuf:"bytes,7,rep,name=dictionary,proto3" json:"dictionary,omitempty"`
+	// min_match_ratio is the ratio of non-empty sampled values that must match the value rules.
+	// Defaults to 0.8.
+	MinMatchRatio float64 `protobuf:"fixed64,8,opt,name=min_match_ratio,json=minMatchRatio,proto3" json:"min_match_ratio,omitempty"`
+	// classification_id is the id in the classification map proposed for matched columns.
+	ClassificationId string `protobuf:"bytes,9,opt,name=classification_id,json=classificationId,proto3" json:"classification_id,omitempty"`
+	// semantic_type_id is the id of the semantic type proposed for matched columns.
+	SemanticTypeId string `protobuf:"bytes,10,opt,name=semantic_type_id,json=semanticTypeId,proto3" json:"semantic_type_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DataClassificationSetting_DataClassificationConfig_Detector) Reset() {
+	*x = DataClassificationSetting_DataClassificationConfig_Detector{}
+	mi := &file_v1_setting_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DataClassificationSetting_DataClassificationConfig_Detector) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataClassificationSetting_DataClassificationConfig_Detector) ProtoMessage() {}
+
+func (x *DataClassificationSetting_DataClassificationConfig_Detector) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataClassificationSetting_DataClassificationConfig_Detector.ProtoReflect.Descriptor instead.
+func (*DataClassificationSetting_DataClassificationConfig_Detector) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{11, 0, 3}
+}
+
+func (x *DataClassificationSetting_DataClassificationConfig_Detector) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DataClassificationSetting_DataClassificationConfig_Detector) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *DataClassificationSetting_DataClassificationConfig_Detector) GetColumnNamePattern() string {
+	if x != nil {
+		return x.ColumnNamePattern
+	}
+	return ""
+}
+
+func (x *DataClassificationSetting_DataClassificationConfig_Detector) GetCommentPattern() string {
+	if x != nil {
+		return x.CommentPattern
+	}
+	return ""
+}
+
+func (x *DataClassificationSetting_DataClassificationConfig_Detector) GetValuePattern() string {
+	if x != nil {
+		return x.ValuePattern
+	}
+	return ""
+}
+
+func (x *DataClassificationSetting_DataClassificationConfig_Detector) GetChecksum() DataClassificationSetting_DataClassificationConfig_Detector_Checksum {
+	if x != nil {
+		return x.Checksum
+	}
+	return DataClassificationSetting_DataClassificationConfig_Detector_CHECKSUM_UNSPECIFIED
+}
+
+func (x *DataClassificationSetting_DataClassificationConfig_Detector) GetDictionary() []string {
+	if x != nil {
+		return x.Dictionary
+	}
+	return nil
+}
+
+func (x *DataClassificationSetting_DataClassificationConfig_Detector) GetMinMatchRatio() float64 {
+	if x != nil {
+		return x.MinMatchRatio
+	}
+	return 0
+}
+
+func (x *DataClassificationSetting_DataClassificationConfig_Detector) GetClassificationId() string {
+	if x != nil {
+		return x.ClassificationId
+	}
+	return ""
+}
+
+func (x *DataClassificationSetting_DataClassificationConfig_Detector) GetSemanticTypeId() string {
+	if x != nil {
+		return x.SemanticTypeId
+	}
+	return ""
+}
+
 type SemanticTypeSetting_SemanticType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// id is the uuid for semantic type.
@@ -2780,7 +3079,7 @@ type SemanticTypeSetting_SemanticType struct {
 
 func (x *SemanticTypeSetting_SemanticType) Reset() {
 	*x = SemanticTypeSetting_SemanticType{}
-	mi := &file_v1_setting_service_proto_msgTypes[32]
+	mi := &file_v1_setting_service_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2792,7 +3091,7 @@ func (x *SemanticTypeSetting_SemanticType) String() string {
 func (*SemanticTypeSetting_SemanticType) ProtoMessage() {}
 
 func (x *SemanticTypeSetting_SemanticType) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[32]
+	mi := &file_v1_setting_service_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2854,7 +3153,7 @@ type Algorithm_FullMask struct {
 
 func (x *Algorithm_FullMask) Reset() {
 	*x = Algorithm_FullMask{}
-	mi := &file_v1_setting_service_proto_msgTypes[33]
+	mi := &file_v1_setting_service_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2866,7 +3165,7 @@ func (x *Algorithm_FullMask) String() string {
 func (*Algorithm_FullMask) ProtoMessage() {}
 
 func (x *Algorithm_FullMask) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[33]
+	mi := &file_v1_setting_service_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2900,7 +3199,7 @@ type Algorithm_RangeMask struct {
 
 func (x *Algorithm_RangeMask) Reset() {
 	*x = Algorithm_RangeMask{}
-	mi := &file_v1_setting_service_proto_msgTypes[34]
+	mi := &file_v1_setting_service_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2912,7 +3211,7 @@ func (x *Algorithm_RangeMask) String() string {
 func (*Algorithm_RangeMask) ProtoMessage() {}
 
 func (x *Algorithm_RangeMask) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[34]
+	mi := &file_v1_setting_service_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2945,7 +3244,7 @@ type Algorithm_MD5Mask struct {
 
 func (x *Algorithm_MD5Mask) Reset() {
 	*x = Algorithm_MD5Mask{}
-	mi := &file_v1_setting_service_proto_msgTypes[35]
+	mi := &file_v1_setting_service_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2957,7 +3256,7 @@ func (x *Algorithm_MD5Mask) String() string {
 func (*Algorithm_MD5Mask) ProtoMessage() {}
 
 func (x *Algorithm_MD5Mask) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[35]
+	mi := &file_v1_setting_service_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2992,7 +3291,7 @@ type Algorithm_InnerOuterMask struct {
 
 func (x *Algorithm_InnerOuterMask) Reset() {
 	*x = Algorithm_InnerOuterMask{}
-	mi := &file_v1_setting_service_proto_msgTypes[36]
+	mi := &file_v1_setting_service_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3004,7 +3303,7 @@ func (x *Algorithm_InnerOuterMask) String() string {
 func (*Algorithm_InnerOuterMask) ProtoMessage() {}
 
 func (x *Algorithm_InnerOuterMask) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[36]
+	mi := &file_v1_setting_service_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3048,6 +3347,210 @@ func (x *Algorithm_InnerOuterMask) GetSubstitution() string {
 	return ""
 }
 
+// TokenizationMask replaces the value with a deterministic token of the same shape.
+// Digits are replaced with digits and letters with letters of the same case, so the
+// same value always gets the same token with the same key.
+type Algorithm_TokenizationMask struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// key is the tokenization key. It is ignored if external_key is set.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// external_key reads the key from an external secret manager.
+	ExternalKey   *DataSourceExternalSecret         `protobuf:"bytes,2,opt,name=external_key,json=externalKey,proto3" json:"external_key,omitempty"`
+	Format        Algorithm_TokenizationMask_Format `protobuf:"varint,3,opt,name=format,proto3,enum=bytebase.v1.Algorithm_TokenizationMask_Format" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Algorithm_TokenizationMask) Reset() {
+	*x = Algorithm_TokenizationMask{}
+	mi := &file_v1_setting_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Algorithm_TokenizationMask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Algorithm_TokenizationMask) ProtoMessage() {}
+
+func (x *Algorithm_TokenizationMask) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Algorithm_TokenizationMask.ProtoReflect.Descriptor instead.
+func (*Algorithm_TokenizationMask) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{13, 4}
+}
+
+func (x *Algorithm_TokenizationMask) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Algorithm_TokenizationMask) GetExternalKey() *DataSourceExternalSecret {
+	if x != nil {
+		return x.ExternalKey
+	}
+	return nil
+}
+
+func (x *Algorithm_TokenizationMask) GetFormat() Algorithm_TokenizationMask_Format {
+	if x != nil {
+		return x.Format
+	}
+	return Algorithm_TokenizationMask_FORMAT_UNSPECIFIED
+}
+
+// DateShiftMask shifts dates and timestamps by a deterministic number of days.
+type Algorithm_DateShiftMask struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// key is the shift key. It is ignored if external_key is set.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// external_key reads the key from an external secret manager.
+	ExternalKey *DataSourceExternalSecret `protobuf:"bytes,2,opt,name=external_key,json=externalKey,proto3" json:"external_key,omitempty"`
+	// max_shift_days is the maximum number of days shifted in either direction.
+	MaxShiftDays int32 `protobuf:"varint,3,opt,name=max_shift_days,json=maxShiftDays,proto3" json:"max_shift_days,omitempty"`
+	// subject_column is the result column identifying the subject, such as a patient id.
+	// All dates of the same subject are shifted by the same number of days.
+	// If empty or not in the result, all dates are shifted by the same number of days.
+	SubjectColumn string `protobuf:"bytes,4,opt,name=subject_column,json=subjectColumn,proto3" json:"subject_column,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Algorithm_DateShiftMask) Reset() {
+	*x = Algorithm_DateShiftMask{}
+	mi := &file_v1_setting_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Algorithm_DateShiftMask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Algorithm_DateShiftMask) ProtoMessage() {}
+
+func (x *Algorithm_DateShiftMask) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Algorithm_DateShiftMask.ProtoReflect.Descriptor instead.
+func (*Algorithm_DateShiftMask) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{13, 5}
+}
+
+func (x *Algorithm_DateShiftMask) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Algorithm_DateShiftMask) GetExternalKey() *DataSourceExternalSecret {
+	if x != nil {
+		return x.ExternalKey
+	}
+	return nil
+}
+
+func (x *Algorithm_DateShiftMask) GetMaxShiftDays() int32 {
+	if x != nil {
+		return x.MaxShiftDays
+	}
+	return 0
+}
+
+func (x *Algorithm_DateShiftMask) GetSubjectColumn() string {
+	if x != nil {
+		return x.SubjectColumn
+	}
+	return ""
+}
+
+// NumericNoiseMask adds deterministic relative noise to numbers.
+type Algorithm_NumericNoiseMask struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// key is the noise key. It is ignored if external_key is set.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// external_key reads the key from an external secret manager.
+	ExternalKey *DataSourceExternalSecret `protobuf:"bytes,2,opt,name=external_key,json=externalKey,proto3" json:"external_key,omitempty"`
+	// noise_ratio is the maximum relative noise in (0, 1], for example 0.1 for ±10%.
+	NoiseRatio    float64 `protobuf:"fixed64,3,opt,name=noise_ratio,json=noiseRatio,proto3" json:"noise_ratio,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Algorithm_NumericNoiseMask) Reset() {
+	*x = Algorithm_NumericNoiseMask{}
+	mi := &file_v1_setting_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Algorithm_NumericNoiseMask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Algorithm_NumericNoiseMask) ProtoMessage() {}
+
+func (x *Algorithm_NumericNoiseMask) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_setting_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Algorithm_NumericNoiseMask.ProtoReflect.Descriptor instead.
+func (*Algorithm_NumericNoiseMask) Descriptor() ([]byte, []int) {
+	return file_v1_setting_service_proto_rawDescGZIP(), []int{13, 6}
+}
+
+func (x *Algorithm_NumericNoiseMask) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Algorithm_NumericNoiseMask) GetExternalKey() *DataSourceExternalSecret {
+	if x != nil {
+		return x.ExternalKey
+	}
+	return nil
+}
+
+func (x *Algorithm_NumericNoiseMask) GetNoiseRatio() float64 {
+	if x != nil {
+		return x.NoiseRatio
+	}
+	return 0
+}
+
 type Algorithm_RangeMask_Slice struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// start is the start character index (0-based) of the original value, should be less than end.
@@ -3065,7 +3568,7 @@ type Algorithm_RangeMask_Slice struct {
 
 func (x *Algorithm_RangeMask_Slice) Reset() {
 	*x = Algorithm_RangeMask_Slice{}
-	mi := &file_v1_setting_service_proto_msgTypes[37]
+	mi := &file_v1_setting_service_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3077,7 +3580,7 @@ func (x *Algorithm_RangeMask_Slice) String() string {
 func (*Algorithm_RangeMask_Slice) ProtoMessage() {}
 
 func (x *Algorithm_RangeMask_Slice) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[37]
+	mi := &file_v1_setting_service_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3134,7 +3637,7 @@ type EnvironmentSetting_Environment struct {
 
 func (x *EnvironmentSetting_Environment) Reset() {
 	*x = EnvironmentSetting_Environment{}
-	mi := &file_v1_setting_service_proto_msgTypes[38]
+	mi := &file_v1_setting_service_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3146,7 +3649,7 @@ func (x *EnvironmentSetting_Environment) String() string {
 func (*EnvironmentSetting_Environment) ProtoMessage() {}
 
 func (x *EnvironmentSetting_Environment) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[38]
+	mi := &file_v1_setting_service_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3211,7 +3714,7 @@ type EmailSetting_SMTPConfig struct {
 
 func (x *EmailSetting_SMTPConfig) Reset() {
 	*x = EmailSetting_SMTPConfig{}
-	mi := &file_v1_setting_service_proto_msgTypes[40]
+	mi := &file_v1_setting_service_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3223,7 +3726,7 @@ func (x *EmailSetting_SMTPConfig) String() string {
 func (*EmailSetting_SMTPConfig) ProtoMessage() {}
 
 func (x *EmailSetting_SMTPConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_setting_service_proto_msgTypes[40]
+	mi := &file_v1_setting_service_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3285,7 +3788,7 @@ var File_v1_setting_service_proto protoreflect.FileDescriptor
 
 const file_v1_setting_service_proto_rawDesc = "" +
 	"\n" +
-	"\x18v1/setting_service.proto\x12\vbytebase.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1egoogle/protobuf/duration.proto\x1a google/protobuf/field_mask.proto\x1a\x16google/type/expr.proto\x1a\x13v1/annotation.proto\x1a\x0fv1/common.proto\x1a\x16v1/issue_service.proto\"\x15\n" +
+	"\x18v1/setting_service.proto\x12\vbytebase.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1egoogle/protobuf/duration.proto\x1a google/protobuf/field_mask.proto\x1a\x16google/type/expr.proto\x1a\x13v1/annotation.proto\x1a\x0fv1/common.proto\x1a\x19v1/instance_service.proto\x1a\x16v1/issue_service.proto\"\x15\n" +
 	"\x13ListSettingsRequest\"H\n" +
 	"\x14ListSettingsResponse\x120\n" +
 	"\bsettings\x18\x01 \x03(\v2\x14.bytebase.v1.SettingR\bsettings\"E\n" +
@@ -3358,7 +3861,7 @@ const file_v1_setting_service_proto_rawDesc = "" +
 	"\x04lark\x18\x05 \x01(\v2\x1e.bytebase.v1.AppIMSetting.LarkH\x00R\x04lark\x12@\n" +
 	"\bdingtalk\x18\x06 \x01(\v2\".bytebase.v1.AppIMSetting.DingTalkH\x00R\bdingtalk\x127\n" +
 	"\x05teams\x18\a \x01(\v2\x1f.bytebase.v1.AppIMSetting.TeamsH\x00R\x05teamsB\t\n" +
-	"\apayload\"\xf8\f\n" +
+	"\apayload\"\xb7\r\n" +
 	"\x17WorkspaceProfileSetting\x12!\n" +
 	"\fexternal_url\x18\x01 \x01(\tR\vexternalUrl\x12'\n" +
 	"\x0fdisallow_signup\x18\x02 \x01(\bR\x0edisallowSignup\x12\x1f\n" +
@@ -3382,7 +3885,8 @@ const file_v1_setting_service_proto_rawDesc = "" +
 	"\fenable_debug\x18\x13 \x01(\bR\venableDebug\x12&\n" +
 	"\x0fsql_result_size\x18\x14 \x01(\x03R\rsqlResultSize\x12>\n" +
 	"\rquery_timeout\x18\x15 \x01(\v2\x19.google.protobuf.DurationR\fqueryTimeout\x125\n" +
-	"\x17allow_email_code_signin\x18\x16 \x01(\bR\x14allowEmailCodeSignin\x1a\x93\x03\n" +
+	"\x17allow_email_code_signin\x18\x16 \x01(\bR\x14allowEmailCodeSignin\x12=\n" +
+	"\x1btrusted_release_public_keys\x18\x17 \x03(\tR\x18trustedReleasePublicKeys\x1a\x93\x03\n" +
 	"\x13PasswordRestriction\x12\x1d\n" +
 	"\n" +
 	"min_length\x18\x01 \x01(\x05R\tminLength\x12%\n" +
@@ -3414,14 +3918,16 @@ const file_v1_setting_service_proto_rawDesc = "" +
 	"\x0fCREATE_DATABASE\x10\x02\x12\x0f\n" +
 	"\vEXPORT_DATA\x10\x03\x12\x10\n" +
 	"\fREQUEST_ROLE\x10\x04\x12\x12\n" +
-	"\x0eREQUEST_ACCESS\x10\x05\"\xc3\x05\n" +
+	"\x0eREQUEST_ACCESS\x10\x05\"\xa4\n" +
+	"\n" +
 	"\x19DataClassificationSetting\x12Y\n" +
-	"\aconfigs\x18\x01 \x03(\v2?.bytebase.v1.DataClassificationSetting.DataClassificationConfigR\aconfigs\x1a\xca\x04\n" +
+	"\aconfigs\x18\x01 \x03(\v2?.bytebase.v1.DataClassificationSetting.DataClassificationConfigR\aconfigs\x1a\xab\t\n" +
 	"\x18DataClassificationConfig\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12]\n" +
 	"\x06levels\x18\x03 \x03(\v2E.bytebase.v1.DataClassificationSetting.DataClassificationConfig.LevelR\x06levels\x12{\n" +
-	"\x0eclassification\x18\x04 \x03(\v2S.bytebase.v1.DataClassificationSetting.DataClassificationConfig.ClassificationEntryR\x0eclassification\x1a3\n" +
+	"\x0eclassification\x18\x04 \x03(\v2S.bytebase.v1.DataClassificationSetting.DataClassificationConfig.ClassificationEntryR\x0eclassification\x12f\n" +
+	"\tdetectors\x18\x05 \x03(\v2H.bytebase.v1.DataClassificationSetting.DataClassificationConfig.DetectorR\tdetectors\x1a3\n" +
 	"\x05Level\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x14\n" +
 	"\x05level\x18\x04 \x01(\x05R\x05level\x1a_\n" +
@@ -3432,7 +3938,25 @@ const file_v1_setting_service_proto_rawDesc = "" +
 	"\x06_level\x1a\x95\x01\n" +
 	"\x13ClassificationEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12h\n" +
-	"\x05value\x18\x02 \x01(\v2R.bytebase.v1.DataClassificationSetting.DataClassificationConfig.DataClassificationR\x05value:\x028\x01\"\xfd\x01\n" +
+	"\x05value\x18\x02 \x01(\v2R.bytebase.v1.DataClassificationSetting.DataClassificationConfig.DataClassificationR\x05value:\x028\x01\x1a\xf6\x03\n" +
+	"\bDetector\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12.\n" +
+	"\x13column_name_pattern\x18\x03 \x01(\tR\x11columnNamePattern\x12'\n" +
+	"\x0fcomment_pattern\x18\x04 \x01(\tR\x0ecommentPattern\x12#\n" +
+	"\rvalue_pattern\x18\x05 \x01(\tR\fvaluePattern\x12m\n" +
+	"\bchecksum\x18\x06 \x01(\x0e2Q.bytebase.v1.DataClassificationSetting.DataClassificationConfig.Detector.ChecksumR\bchecksum\x12\x1e\n" +
+	"\n" +
+	"dictionary\x18\a \x03(\tR\n" +
+	"dictionary\x12&\n" +
+	"\x0fmin_match_ratio\x18\b \x01(\x01R\rminMatchRatio\x12+\n" +
+	"\x11classification_id\x18\t \x01(\tR\x10classificationId\x12(\n" +
+	"\x10semantic_type_id\x18\n" +
+	" \x01(\tR\x0esemanticTypeId\"8\n" +
+	"\bChecksum\x12\x18\n" +
+	"\x14CHECKSUM_UNSPECIFIED\x10\x00\x12\b\n" +
+	"\x04LUHN\x10\x01\x12\b\n" +
+	"\x04IBAN\x10\x02\"\xfd\x01\n" +
 	"\x13SemanticTypeSetting\x12C\n" +
 	"\x05types\x18\x01 \x03(\v2-.bytebase.v1.SemanticTypeSetting.SemanticTypeR\x05types\x1a\xa0\x01\n" +
 	"\fSemanticType\x12\x0e\n" +
@@ -3440,13 +3964,16 @@ const file_v1_setting_service_proto_rawDesc = "" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
 	"\vdescription\x18\x03 \x01(\tR\vdescription\x124\n" +
 	"\talgorithm\x18\x06 \x01(\v2\x16.bytebase.v1.AlgorithmR\talgorithm\x12\x12\n" +
-	"\x04icon\x18\a \x01(\tR\x04icon\"\x8e\x06\n" +
+	"\x04icon\x18\a \x01(\tR\x04icon\"\xd4\f\n" +
 	"\tAlgorithm\x12>\n" +
 	"\tfull_mask\x18\x01 \x01(\v2\x1f.bytebase.v1.Algorithm.FullMaskH\x00R\bfullMask\x12A\n" +
 	"\n" +
 	"range_mask\x18\x02 \x01(\v2 .bytebase.v1.Algorithm.RangeMaskH\x00R\trangeMask\x12;\n" +
 	"\bmd5_mask\x18\x03 \x01(\v2\x1e.bytebase.v1.Algorithm.MD5MaskH\x00R\amd5Mask\x12Q\n" +
-	"\x10inner_outer_mask\x18\x04 \x01(\v2%.bytebase.v1.Algorithm.InnerOuterMaskH\x00R\x0einnerOuterMask\x1a.\n" +
+	"\x10inner_outer_mask\x18\x04 \x01(\v2%.bytebase.v1.Algorithm.InnerOuterMaskH\x00R\x0einnerOuterMask\x12V\n" +
+	"\x11tokenization_mask\x18\x05 \x01(\v2'.bytebase.v1.Algorithm.TokenizationMaskH\x00R\x10tokenizationMask\x12N\n" +
+	"\x0fdate_shift_mask\x18\x06 \x01(\v2$.bytebase.v1.Algorithm.DateShiftMaskH\x00R\rdateShiftMask\x12W\n" +
+	"\x12numeric_noise_mask\x18\a \x01(\v2'.bytebase.v1.Algorithm.NumericNoiseMaskH\x00R\x10numericNoiseMask\x1a.\n" +
 	"\bFullMask\x12\"\n" +
 	"\fsubstitution\x18\x01 \x01(\tR\fsubstitution\x1a\xa0\x01\n" +
 	"\tRangeMask\x12>\n" +
@@ -3467,7 +3994,25 @@ const file_v1_setting_service_proto_rawDesc = "" +
 	"\bMaskType\x12\x19\n" +
 	"\x15MASK_TYPE_UNSPECIFIED\x10\x00\x12\t\n" +
 	"\x05INNER\x10\x01\x12\t\n" +
-	"\x05OUTER\x10\x02B\x06\n" +
+	"\x05OUTER\x10\x02\x1a\xf5\x01\n" +
+	"\x10TokenizationMask\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12H\n" +
+	"\fexternal_key\x18\x02 \x01(\v2%.bytebase.v1.DataSourceExternalSecretR\vexternalKey\x12F\n" +
+	"\x06format\x18\x03 \x01(\x0e2..bytebase.v1.Algorithm.TokenizationMask.FormatR\x06format\"=\n" +
+	"\x06Format\x12\x16\n" +
+	"\x12FORMAT_UNSPECIFIED\x10\x00\x12\t\n" +
+	"\x05EMAIL\x10\x01\x12\x10\n" +
+	"\fPAYMENT_CARD\x10\x02\x1a\xb8\x01\n" +
+	"\rDateShiftMask\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12H\n" +
+	"\fexternal_key\x18\x02 \x01(\v2%.bytebase.v1.DataSourceExternalSecretR\vexternalKey\x12$\n" +
+	"\x0emax_shift_days\x18\x03 \x01(\x05R\fmaxShiftDays\x12%\n" +
+	"\x0esubject_column\x18\x04 \x01(\tR\rsubjectColumn\x1a\x8f\x01\n" +
+	"\x10NumericNoiseMask\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12H\n" +
+	"\fexternal_key\x18\x02 \x01(\v2%.bytebase.v1.DataSourceExternalSecretR\vexternalKey\x12\x1f\n" +
+	"\vnoise_ratio\x18\x03 \x01(\x01R\n" +
+	"noiseRatioB\x06\n" +
 	"\x04mask\"\xa4\x02\n" +
 	"\tAISetting\x12\x18\n" +
 	"\aenabled\x18\x01 \x01(\bR\aenabled\x12;\n" +
@@ -3558,134 +4103,150 @@ func file_v1_setting_service_proto_rawDescGZIP() []byte {
 	return file_v1_setting_service_proto_rawDescData
 }
 
-var file_v1_setting_service_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
-var file_v1_setting_service_proto_msgTypes = make([]protoimpl.MessageInfo, 41)
+var file_v1_setting_service_proto_enumTypes = make([]protoimpl.EnumInfo, 11)
+var file_v1_setting_service_proto_msgTypes = make([]protoimpl.MessageInfo, 45)
 var file_v1_setting_service_proto_goTypes = []any{
-	(DatabaseChangeMode)(0),                                          // 0: bytebase.v1.DatabaseChangeMode
-	(Setting_SettingName)(0),                                         // 1: bytebase.v1.Setting.SettingName
-	(Announcement_AlertLevel)(0),                                     // 2: bytebase.v1.Announcement.AlertLevel
-	(WorkspaceApprovalSetting_Rule_Source)(0),                        // 3: bytebase.v1.WorkspaceApprovalSetting.Rule.Source
-	(Algorithm_InnerOuterMask_MaskType)(0),                           // 4: bytebase.v1.Algorithm.InnerOuterMask.MaskType
-	(AISetting_Provider)(0),                                          // 5: bytebase.v1.AISetting.Provider
-	(EmailSetting_Type)(0),                                           // 6: bytebase.v1.EmailSetting.Type
-	(EmailSetting_SMTPConfig_Encryption)(0),                          // 7: bytebase.v1.EmailSetting.SMTPConfig.Encryption
-	(EmailSetting_SMTPConfig_Authentication)(0),                      // 8: bytebase.v1.EmailSetting.SMTPConfig.Authentication
-	(*ListSettingsRequest)(nil),                                      // 9: bytebase.v1.ListSettingsRequest
-	(*ListSettingsResponse)(nil),                                     // 10: bytebase.v1.ListSettingsResponse
-	(*GetSettingRequest)(nil),                                        // 11: bytebase.v1.GetSettingRequest
-	(*GetSettingResponse)(nil),                                       // 12: bytebase.v1.GetSettingResponse
-	(*UpdateSettingRequest)(nil),                                     // 13: bytebase.v1.UpdateSettingRequest
-	(*Setting)(nil),                                                  // 14: bytebase.v1.Setting
-	(*SettingValue)(nil),                                             // 15: bytebase.v1.SettingValue
-	(*AppIMSetting)(nil),                                             // 16: bytebase.v1.AppIMSetting
-	(*WorkspaceProfileSetting)(nil),                                  // 17: bytebase.v1.WorkspaceProfileSetting
-	(*Announcement)(nil),                                             // 18: bytebase.v1.Announcement
-	(*WorkspaceApprovalSetting)(nil),                                 // 19: bytebase.v1.WorkspaceApprovalSetting
-	(*DataClassificationSetting)(nil),                                // 20: bytebase.v1.DataClassificationSetting
-	(*SemanticTypeSetting)(nil),                                      // 21: bytebase.v1.SemanticTypeSetting
-	(*Algorithm)(nil),                                                // 22: bytebase.v1.Algorithm
-	(*AISetting)(nil),                                                // 23: bytebase.v1.AISetting
-	(*EnvironmentSetting)(nil),                                       // 24: bytebase.v1.EnvironmentSetting
-	(*EmailSetting)(nil),                                             // 25: bytebase.v1.EmailSetting
-	(*TestEmailSettingRequest)(nil),                                  // 26: bytebase.v1.TestEmailSettingRequest
-	(*TestEmailSettingResponse)(nil),                                 // 27: bytebase.v1.TestEmailSettingResponse
-	(*AppIMSetting_Slack)(nil),                                       // 28: bytebase.v1.AppIMSetting.Slack
-	(*AppIMSetting_Feishu)(nil),                                      // 29: bytebase.v1.AppIMSetting.Feishu
-	(*AppIMSetting_Wecom)(nil),                                       // 30: bytebase.v1.AppIMSetting.Wecom
-	(*AppIMSetting_Lark)(nil),                                        // 31: bytebase.v1.AppIMSetting.Lark
-	(*AppIMSetting_DingTalk)(nil),                                    // 32: bytebase.v1.AppIMSetting.DingTalk
-	(*AppIMSetting_Teams)(nil),                                       // 33: bytebase.v1.AppIMSetting.Teams
-	(*AppIMSetting_IMSetting)(nil),                                   // 34: bytebase.v1.AppIMSetting.IMSetting
-	(*WorkspaceProfileSetting_PasswordRestriction)(nil),              // 35: bytebase.v1.WorkspaceProfileSetting.PasswordRestriction
-	(*WorkspaceApprovalSetting_Rule)(nil),                            // 36: bytebase.v1.WorkspaceApprovalSetting.Rule
-	(*DataClassificationSetting_DataClassificationConfig)(nil),       // 37: bytebase.v1.DataClassificationSetting.DataClassificationConfig
-	(*DataClassificationSetting_DataClassificationConfig_Level)(nil), // 38: bytebase.v1.DataClassificationSetting.DataClassificationConfig.Level
-	(*DataClassificationSetting_DataClassificationConfig_DataClassification)(nil), // 39: bytebase.v1.DataClassificationSetting.DataClassificationConfig.DataClassification
-	nil,                                      // 40: bytebase.v1.DataClassificationSetting.DataClassificationConfig.ClassificationEntry
-	(*SemanticTypeSetting_SemanticType)(nil), // 41: bytebase.v1.SemanticTypeSetting.SemanticType
-	(*Algorithm_FullMask)(nil),               // 42: bytebase.v1.Algorithm.FullMask
-	(*Algorithm_RangeMask)(nil),              // 43: bytebase.v1.Algorithm.RangeMask
-	(*Algorithm_MD5Mask)(nil),                // 44: bytebase.v1.Algorithm.MD5Mask
-	(*Algorithm_InnerOuterMask)(nil),         // 45: bytebase.v1.Algorithm.InnerOuterMask
-	(*Algorithm_RangeMask_Slice)(nil),        // 46: bytebase.v1.Algorithm.RangeMask.Slice
-	(*EnvironmentSetting_Environment)(nil),   // 47: bytebase.v1.EnvironmentSetting.Environment
-	nil,                                      // 48: bytebase.v1.EnvironmentSetting.Environment.TagsEntry
-	(*EmailSetting_SMTPConfig)(nil),          // 49: bytebase.v1.EmailSetting.SMTPConfig
-	(*fieldmaskpb.FieldMask)(nil),            // 50: google.protobuf.FieldMask
-	(*durationpb.Duration)(nil),              // 51: google.protobuf.Duration
-	(WebhookType)(0),                         // 52: bytebase.v1.WebhookType
-	(*ApprovalTemplate)(nil),                 // 53: bytebase.v1.ApprovalTemplate
-	(*expr.Expr)(nil),                        // 54: google.type.Expr
+	(DatabaseChangeMode)(0),                   // 0: bytebase.v1.DatabaseChangeMode
+	(Setting_SettingName)(0),                  // 1: bytebase.v1.Setting.SettingName
+	(Announcement_AlertLevel)(0),              // 2: bytebase.v1.Announcement.AlertLevel
+	(WorkspaceApprovalSetting_Rule_Source)(0), // 3: bytebase.v1.WorkspaceApprovalSetting.Rule.Source
+	(DataClassificationSetting_DataClassificationConfig_Detector_Checksum)(0), // 4: bytebase.v1.DataClassificationSetting.DataClassificationConfig.Detector.Checksum
+	(Algorithm_InnerOuterMask_MaskType)(0),                                    // 5: bytebase.v1.Algorithm.InnerOuterMask.MaskType
+	(Algorithm_TokenizationMask_Format)(0),                                    // 6: bytebase.v1.Algorithm.TokenizationMask.Format
+	(AISetting_Provider)(0),                                                   // 7: bytebase.v1.AISetting.Provider
+	(EmailSetting_Type)(0),                                                    // 8: bytebase.v1.EmailSetting.Type
+	(EmailSetting_SMTPConfig_Encryption)(0),                                   // 9: bytebase.v1.EmailSetting.SMTPConfig.Encryption
+	(EmailSetting_SMTPConfig_Authentication)(0),                               // 10: bytebase.v1.EmailSetting.SMTPConfig.Authentication
+	(*ListSettingsRequest)(nil),                                               // 11: bytebase.v1.ListSettingsRequest
+	(*ListSettingsResponse)(nil),                                              // 12: bytebase.v1.ListSettingsResponse
+	(*GetSettingRequest)(nil),                                                 // 13: bytebase.v1.GetSettingRequest
+	(*GetSettingResponse)(nil),                                                // 14: bytebase.v1.GetSettingResponse
+	(*UpdateSettingRequest)(nil),                                              // 15: bytebase.v1.UpdateSettingRequest
+	(*Setting)(nil),                                                           // 16: bytebase.v1.Setting
+	(*SettingValue)(nil),                                                      // 17: bytebase.v1.SettingValue
+	(*AppIMSetting)(nil),                                                      // 18: bytebase.v1.AppIMSetting
+	(*WorkspaceProfileSetting)(nil),                                           // 19: bytebase.v1.WorkspaceProfileSetting
+	(*Announcement)(nil),                                                      // 20: bytebase.v1.Announcement
+	(*WorkspaceApprovalSetting)(nil),                                          // 21: bytebase.v1.WorkspaceApprovalSetting
+	(*DataClassificationSetting)(nil),                                         // 22: bytebase.v1.DataClassificationSetting
+	(*SemanticTypeSetting)(nil),                                               // 23: bytebase.v1.SemanticTypeSetting
+	(*Algorithm)(nil),                                                         // 24: bytebase.v1.Algorithm
+	(*AISetting)(nil),                                                         // 25: bytebase.v1.AISetting
+	(*EnvironmentSetting)(nil),                                                // 26: bytebase.v1.EnvironmentSetting
+	(*EmailSetting)(nil),                                                      // 27: bytebase.v1.EmailSetting
+	(*TestEmailSettingRequest)(nil),                                           // 28: bytebase.v1.TestEmailSettingRequest
+	(*TestEmailSettingResponse)(nil),                                          // 29: bytebase.v1.TestEmailSettingResponse
+	(*AppIMSetting_Slack)(nil),                                                // 30: bytebase.v1.AppIMSetting.Slack
+	(*AppIMSetting_Feishu)(nil),                                               // 31: bytebase.v1.AppIMSetting.Feishu
+	(*AppIMSetting_Wecom)(nil),                                                // 32: bytebase.v1.AppIMSetting.Wecom
+	(*AppIMSetting_Lark)(nil),                                                 // 33: bytebase.v1.AppIMSetting.Lark
+	(*AppIMSetting_DingTalk)(nil),                                             // 34: bytebase.v1.AppIMSetting.DingTalk
+	(*AppIMSetting_Teams)(nil),                                                // 35: bytebase.v1.AppIMSetting.Teams
+	(*AppIMSetting_IMSetting)(nil),                                            // 36: bytebase.v1.AppIMSetting.IMSetting
+	(*WorkspaceProfileSetting_PasswordRestriction)(nil),                       // 37: bytebase.v1.WorkspaceProfileSetting.PasswordRestriction
+	(*WorkspaceApprovalSetting_Rule)(nil),                                     // 38: bytebase.v1.WorkspaceApprovalSetting.Rule
+	(*DataClassificationSetting_DataClassificationConfig)(nil),                // 39: bytebase.v1.DataClassificationSetting.DataClassificationConfig
+	(*DataClassificationSetting_DataClassificationConfig_Level)(nil),          // 40: bytebase.v1.DataClassificationSetting.DataClassificationConfig.Level
+	(*DataClassificationSetting_DataClassificationConfig_DataClassification)(nil), // 41: bytebase.v1.DataClassificationSetting.DataClassificationConfig.DataClassification
+	nil, // 42: bytebase.v1.DataClassificationSetting.DataClassificationConfig.ClassificationEntry
+	(*DataClassificationSetting_DataClassificationConfig_Detector)(nil), // 43: bytebase.v1.DataClassificationSetting.DataClassificationConfig.Detector
+	(*SemanticTypeSetting_SemanticType)(nil),                            // 44: bytebase.v1.SemanticTypeSetting.SemanticType
+	(*Algorithm_FullMask)(nil),                                          // 45: bytebase.v1.Algorithm.FullMask
+	(*Algorithm_RangeMask)(nil),                                         // 46: bytebase.v1.Algorithm.RangeMask
+	(*Algorithm_MD5Mask)(nil),                                           // 47: bytebase.v1.Algorithm.MD5Mask
+	(*Algorithm_InnerOuterMask)(nil),                                    // 48: bytebase.v1.Algorithm.InnerOuterMask
+	(*Algorithm_TokenizationMask)(nil),                                  // 49: bytebase.v1.Algorithm.TokenizationMask
+	(*Algorithm_DateShiftMask)(nil),                                     // 50: bytebase.v1.Algorithm.DateShiftMask
+	(*Algorithm_NumericNoiseMask)(nil),                                  // 51: bytebase.v1.Algorithm.NumericNoiseMask
+	(*Algorithm_RangeMask_Slice)(nil),                                   // 52: bytebase.v1.Algorithm.RangeMask.Slice
+	(*EnvironmentSetting_Environment)(nil),                              // 53: bytebase.v1.EnvironmentSetting.Environment
+	nil,                                                                 // 54: bytebase.v1.EnvironmentSetting.Environment.TagsEntry
+	(*EmailSetting_SMTPConfig)(nil),                                     // 55: bytebase.v1.EmailSetting.SMTPConfig
+	(*fieldmaskpb.FieldMask)(nil),                                       // 56: google.protobuf.FieldMask
+	(*durationpb.Duration)(nil),                                         // 57: google.protobuf.Duration
+	(WebhookType)(0),                                                    // 58: bytebase.v1.WebhookType
+	(*ApprovalTemplate)(nil),                                            // 59: bytebase.v1.ApprovalTemplate
+	(*expr.Expr)(nil),                                                   // 60: google.type.Expr
+	(*DataSourceExternalSecret)(nil),                                    // 61: bytebase.v1.DataSourceExternalSecret
 }
 var file_v1_setting_service_proto_depIdxs = []int32{
-	14, // 0: bytebase.v1.ListSettingsResponse.settings:type_name -> bytebase.v1.Setting
-	14, // 1: bytebase.v1.GetSettingResponse.setting:type_name -> bytebase.v1.Setting
-	14, // 2: bytebase.v1.UpdateSettingRequest.setting:type_name -> bytebase.v1.Setting
-	50, // 3: bytebase.v1.UpdateSettingRequest.update_mask:type_name -> google.protobuf.FieldMask
-	15, // 4: bytebase.v1.Setting.value:type_name -> bytebase.v1.SettingValue
-	16, // 5: bytebase.v1.SettingValue.app_im:type_name -> bytebase.v1.AppIMSetting
-	17, // 6: bytebase.v1.SettingValue.workspace_profile:type_name -> bytebase.v1.WorkspaceProfileSetting
-	19, // 7: bytebase.v1.SettingValue.workspace_approval:type_name -> bytebase.v1.WorkspaceApprovalSetting
-	20, // 8: bytebase.v1.SettingValue.data_classification:type_name -> bytebase.v1.DataClassificationSetting
-	21, // 9: bytebase.v1.SettingValue.semantic_type:type_name -> bytebase.v1.SemanticTypeSetting
-	23, // 10: bytebase.v1.SettingValue.ai:type_name -> bytebase.v1.AISetting
-	24, // 11: bytebase.v1.SettingValue.environment:type_name -> bytebase.v1.EnvironmentSetting
-	25, // 12: bytebase.v1.SettingValue.email:type_name -> bytebase.v1.EmailSetting
-	34, // 13: bytebase.v1.AppIMSetting.settings:type_name -> bytebase.v1.AppIMSetting.IMSetting
-	51, // 14: bytebase.v1.WorkspaceProfileSetting.refresh_token_duration:type_name -> google.protobuf.Duration
-	18, // 15: bytebase.v1.WorkspaceProfileSetting.announcement:type_name -> bytebase.v1.Announcement
-	51, // 16: bytebase.v1.WorkspaceProfileSetting.maximum_role_expiration:type_name -> google.protobuf.Duration
+	16, // 0: bytebase.v1.ListSettingsResponse.settings:type_name -> bytebase.v1.Setting
+	16, // 1: bytebase.v1.GetSettingResponse.setting:type_name -> bytebase.v1.Setting
+	16, // 2: bytebase.v1.UpdateSettingRequest.setting:type_name -> bytebase.v1.Setting
+	56, // 3: bytebase.v1.UpdateSettingRequest.update_mask:type_name -> google.protobuf.FieldMask
+	17, // 4: bytebase.v1.Setting.value:type_name -> bytebase.v1.SettingValue
+	18, // 5: bytebase.v1.SettingValue.app_im:type_name -> bytebase.v1.AppIMSetting
+	19, // 6: bytebase.v1.SettingValue.workspace_profile:type_name -> bytebase.v1.WorkspaceProfileSetting
+	21, // 7: bytebase.v1.SettingValue.workspace_approval:type_name -> bytebase.v1.WorkspaceApprovalSetting
+	22, // 8: bytebase.v1.SettingValue.data_classification:type_name -> bytebase.v1.DataClassificationSetting
+	23, // 9: bytebase.v1.SettingValue.semantic_type:type_name -> bytebase.v1.SemanticTypeSetting
+	25, // 10: bytebase.v1.SettingValue.ai:type_name -> bytebase.v1.AISetting
+	26, // 11: bytebase.v1.SettingValue.environment:type_name -> bytebase.v1.EnvironmentSetting
+	27, // 12: bytebase.v1.SettingValue.email:type_name -> bytebase.v1.EmailSetting
+	36, // 13: bytebase.v1.AppIMSetting.settings:type_name -> bytebase.v1.AppIMSetting.IMSetting
+	57, // 14: bytebase.v1.WorkspaceProfileSetting.refresh_token_duration:type_name -> google.protobuf.Duration
+	20, // 15: bytebase.v1.WorkspaceProfileSetting.announcement:type_name -> bytebase.v1.Announcement
+	57, // 16: bytebase.v1.WorkspaceProfileSetting.maximum_role_expiration:type_name -> google.protobuf.Duration
 	0,  // 17: bytebase.v1.WorkspaceProfileSetting.database_change_mode:type_name -> bytebase.v1.DatabaseChangeMode
-	51, // 18: bytebase.v1.WorkspaceProfileSetting.inactive_session_timeout:type_name -> google.protobuf.Duration
-	35, // 19: bytebase.v1.WorkspaceProfileSetting.password_restriction:type_name -> bytebase.v1.WorkspaceProfileSetting.PasswordRestriction
-	51, // 20: bytebase.v1.WorkspaceProfileSetting.access_token_duration:type_name -> google.protobuf.Duration
-	51, // 21: bytebase.v1.WorkspaceProfileSetting.query_timeout:type_name -> google.protobuf.Duration
+	57, // 18: bytebase.v1.WorkspaceProfileSetting.inactive_session_timeout:type_name -> google.protobuf.Duration
+	37, // 19: bytebase.v1.WorkspaceProfileSetting.password_restriction:type_name -> bytebase.v1.WorkspaceProfileSetting.PasswordRestriction
+	57, // 20: bytebase.v1.WorkspaceProfileSetting.access_token_duration:type_name -> google.protobuf.Duration
+	57, // 21: bytebase.v1.WorkspaceProfileSetting.query_timeout:type_name -> google.protobuf.Duration
 	2,  // 22: bytebase.v1.Announcement.level:type_name -> bytebase.v1.Announcement.AlertLevel
-	36, // 23: bytebase.v1.WorkspaceApprovalSetting.rules:type_name -> bytebase.v1.WorkspaceApprovalSetting.Rule
-	37, // 24: bytebase.v1.DataClassificationSetting.configs:type_name -> bytebase.v1.DataClassificationSetting.DataClassificationConfig
-	41, // 25: bytebase.v1.SemanticTypeSetting.types:type_name -> bytebase.v1.SemanticTypeSetting.SemanticType
-	42, // 26: bytebase.v1.Algorithm.full_mask:type_name -> bytebase.v1.Algorithm.FullMask
-	43, // 27: bytebase.v1.Algorithm.range_mask:type_name -> bytebase.v1.Algorithm.RangeMask
-	44, // 28: bytebase.v1.Algorithm.md5_mask:type_name -> bytebase.v1.Algorithm.MD5Mask
-	45, // 29: bytebase.v1.Algorithm.inner_outer_mask:type_name -> bytebase.v1.Algorithm.InnerOuterMask
-	5,  // 30: bytebase.v1.AISetting.provider:type_name -> bytebase.v1.AISetting.Provider
-	47, // 31: bytebase.v1.EnvironmentSetting.environments:type_name -> bytebase.v1.EnvironmentSetting.Environment
-	6,  // 32: bytebase.v1.EmailSetting.type:type_name -> bytebase.v1.EmailSetting.Type
-	49, // 33: bytebase.v1.EmailSetting.smtp:type_name -> bytebase.v1.EmailSetting.SMTPConfig
-	25, // 34: bytebase.v1.TestEmailSettingRequest.email_setting:type_name -> bytebase.v1.EmailSetting
-	52, // 35: bytebase.v1.AppIMSetting.IMSetting.type:type_name -> bytebase.v1.WebhookType
-	28, // 36: bytebase.v1.AppIMSetting.IMSetting.slack:type_name -> bytebase.v1.AppIMSetting.Slack
-	29, // 37: bytebase.v1.AppIMSetting.IMSetting.feishu:type_name -> bytebase.v1.AppIMSetting.Feishu
-	30, // 38: bytebase.v1.AppIMSetting.IMSetting.wecom:type_name -> bytebase.v1.AppIMSetting.Wecom
-	31, // 39: bytebase.v1.AppIMSetting.IMSetting.lark:type_name -> bytebase.v1.AppIMSetting.Lark
-	32, // 40: bytebase.v1.AppIMSetting.IMSetting.dingtalk:type_name -> bytebase.v1.AppIMSetting.DingTalk
-	33, // 41: bytebase.v1.AppIMSetting.IMSetting.teams:type_name -> bytebase.v1.AppIMSetting.Teams
-	51, // 42: bytebase.v1.WorkspaceProfileSetting.PasswordRestriction.password_rotation:type_name -> google.protobuf.Duration
-	53, // 43: bytebase.v1.WorkspaceApprovalSetting.Rule.template:type_name -> bytebase.v1.ApprovalTemplate
-	54, // 44: bytebase.v1.WorkspaceApprovalSetting.Rule.condition:type_name -> google.type.Expr
-	3,  // 45: bytebase.v1.WorkspaceApprovalSetting.Rule.source:type_name -> bytebase.v1.WorkspaceApprovalSetting.Rule.Source
-	38, // 46: bytebase.v1.DataClassificationSetting.DataClassificationConfig.levels:type_name -> bytebase.v1.DataClassificationSetting.DataClassificationConfig.Level
-	40, // 47: bytebase.v1.DataClassificationSetting.DataClassificationConfig.classification:type_name -> bytebase.v1.DataClassificationSetting.DataClassificationConfig.ClassificationEntry
-	39, // 48: bytebase.v1.DataClassificationSetting.DataClassificationConfig.ClassificationEntry.value:type_name -> bytebase.v1.DataClassificationSetting.DataClassificationConfig.DataClassification
-	22, // 49: bytebase.v1.SemanticTypeSetting.SemanticType.algorithm:type_name -> bytebase.v1.Algorithm
-	46, // 50: bytebase.v1.Algorithm.RangeMask.slices:type_name -> bytebase.v1.Algorithm.RangeMask.Slice
-	4,  // 51: bytebase.v1.Algorithm.InnerOuterMask.type:type_name -> bytebase.v1.Algorithm.InnerOuterMask.MaskType
-	48, // 52: bytebase.v1.EnvironmentSetting.Environment.tags:type_name -> bytebase.v1.EnvironmentSetting.Environment.TagsEntry
-	7,  // 53: bytebase.v1.EmailSetting.SMTPConfig.encryption:type_name -> bytebase.v1.EmailSetting.SMTPConfig.Encryption
-	8,  // 54: bytebase.v1.EmailSetting.SMTPConfig.authentication:type_name -> bytebase.v1.EmailSetting.SMTPConfig.Authentication
-	9,  // 55: bytebase.v1.SettingService.ListSettings:input_type -> bytebase.v1.ListSettingsRequest
-	11, // 56: bytebase.v1.SettingService.GetSetting:input_type -> bytebase.v1.GetSettingRequest
-	13, // 57: bytebase.v1.SettingService.UpdateSetting:input_type -> bytebase.v1.UpdateSettingRequest
-	26, // 58: bytebase.v1.SettingService.TestEmailSetting:input_type -> bytebase.v1.TestEmailSettingRequest
-	10, // 59: bytebase.v1.SettingService.ListSettings:output_type -> bytebase.v1.ListSettingsResponse
-	14, // 60: bytebase.v1.SettingService.GetSetting:output_type -> bytebase.v1.Setting
-	14, // 61: bytebase.v1.SettingService.UpdateSetting:output_type -> bytebase.v1.Setting
-	27, // 62: bytebase.v1.SettingService.TestEmailSetting:output_type -> bytebase.v1.TestEmailSettingResponse
-	59, // [59:63] is the sub-list for method output_type
-	55, // [55:59] is the sub-list for method input_type
-	55, // [55:55] is the sub-list for extension type_name
-	55, // [55:55] is the sub-list for extension extendee
-	0,  // [0:55] is the sub-list for field type_name
+	38, // 23: bytebase.v1.WorkspaceApprovalSetting.rules:type_name -> bytebase.v1.WorkspaceApprovalSetting.Rule
+	39, // 24: bytebase.v1.DataClassificationSetting.configs:type_name -> bytebase.v1.DataClassificationSetting.DataClassificationConfig
+	44, // 25: bytebase.v1.SemanticTypeSetting.types:type_name -> bytebase.v1.SemanticTypeSetting.SemanticType
+	45, // 26: bytebase.v1.Algorithm.full_mask:type_name -> bytebase.v1.Algorithm.FullMask
+	46, // 27: bytebase.v1.Algorithm.range_mask:type_name -> bytebase.v1.Algorithm.RangeMask
+	47, // 28: bytebase.v1.Algorithm.md5_mask:type_name -> bytebase.v1.Algorithm.MD5Mask
+	48, // 29: bytebase.v1.Algorithm.inner_outer_mask:type_name -> bytebase.v1.Algorithm.InnerOuterMask
+	49, // 30: bytebase.v1.Algorithm.tokenization_mask:type_name -> bytebase.v1.Algorithm.TokenizationMask
+	50, // 31: bytebase.v1.Algorithm.date_shift_mask:type_name -> bytebase.v1.Algorithm.DateShiftMask
+	51, // 32: bytebase.v1.Algorithm.numeric_noise_mask:type_name -> bytebase.v1.Algorithm.NumericNoiseMask
+	7,  // 33: bytebase.v1.AISetting.provider:type_name -> bytebase.v1.AISetting.Provider
+	53, // 34: bytebase.v1.EnvironmentSetting.environments:type_name -> bytebase.v1.EnvironmentSetting.Environment
+	8,  // 35: bytebase.v1.EmailSetting.type:type_name -> bytebase.v1.EmailSetting.Type
+	55, // 36: bytebase.v1.EmailSetting.smtp:type_name -> bytebase.v1.EmailSetting.SMTPConfig
+	27, // 37: bytebase.v1.TestEmailSettingRequest.email_setting:type_name -> bytebase.v1.EmailSetting
+	58, // 38: bytebase.v1.AppIMSetting.IMSetting.type:type_name -> bytebase.v1.WebhookType
+	30, // 39: bytebase.v1.AppIMSetting.IMSetting.slack:type_name -> bytebase.v1.AppIMSetting.Slack
+	31, // 40: bytebase.v1.AppIMSetting.IMSetting.feishu:type_name -> bytebase.v1.AppIMSetting.Feishu
+	32, // 41: bytebase.v1.AppIMSetting.IMSetting.wecom:type_name -> bytebase.v1.AppIMSetting.Wecom
+	33, // 42: bytebase.v1.AppIMSetting.IMSetting.lark:type_name -> bytebase.v1.AppIMSetting.Lark
+	34, // 43: bytebase.v1.AppIMSetting.IMSetting.dingtalk:type_name -> bytebase.v1.AppIMSetting.DingTalk
+	35, // 44: bytebase.v1.AppIMSetting.IMSetting.teams:type_name -> bytebase.v1.AppIMSetting.Teams
+	57, // 45: bytebase.v1.WorkspaceProfileSetting.PasswordRestriction.password_rotation:type_name -> google.protobuf.Duration
+	59, // 46: bytebase.v1.WorkspaceApprovalSetting.Rule.template:type_name -> bytebase.v1.ApprovalTemplate
+	60, // 47: bytebase.v1.WorkspaceApprovalSetting.Rule.condition:type_name -> google.type.Expr
+	3,  // 48: bytebase.v1.WorkspaceApprovalSetting.Rule.source:type_name -> bytebase.v1.WorkspaceApprovalSetting.Rule.Source
+	40, // 49: bytebase.v1.DataClassificationSetting.DataClassificationConfig.levels:type_name -> bytebase.v1.DataClassificationSetting.DataClassificationConfig.Level
+	42, // 50: bytebase.v1.DataClassificationSetting.DataClassificationConfig.classification:type_name -> bytebase.v1.DataClassificationSetting.DataClassificationConfig.ClassificationEntry
+	43, // 51: bytebase.v1.DataClassificationSetting.DataClassificationConfig.detectors:type_name -> bytebase.v1.DataClassificationSetting.DataClassificationConfig.Detector
+	41, // 52: bytebase.v1.DataClassificationSetting.DataClassificationConfig.ClassificationEntry.value:type_name -> bytebase.v1.DataClassificationSetting.DataClassificationConfig.DataClassification
+	4,  // 53: bytebase.v1.DataClassificationSetting.DataClassificationConfig.Detector.checksum:type_name -> bytebase.v1.DataClassificationSetting.DataClassificationConfig.Detector.Checksum
+	24, // 54: bytebase.v1.SemanticTypeSetting.SemanticType.algorithm:type_name -> bytebase.v1.Algorithm
+	52, // 55: bytebase.v1.Algorithm.RangeMask.slices:type_name -> bytebase.v1.Algorithm.RangeMask.Slice
+	5,  // 56: bytebase.v1.Algorithm.InnerOuterMask.type:type_name -> bytebase.v1.Algorithm.InnerOuterMask.MaskType
+	61, // 57: bytebase.v1.Algorithm.TokenizationMask.external_key:type_name -> bytebase.v1.DataSourceExternalSecret
+	6,  // 58: bytebase.v1.Algorithm.TokenizationMask.format:type_name -> bytebase.v1.Algorithm.TokenizationMask.Format
+	61, // 59: bytebase.v1.Algorithm.DateShiftMask.external_key:type_name -> bytebase.v1.DataSourceExternalSecret
+	61, // 60: bytebase.v1.Algorithm.NumericNoiseMask.external_key:type_name -> bytebase.v1.DataSourceExternalSecret
+	54, // 61: bytebase.v1.EnvironmentSetting.Environment.tags:type_name -> bytebase.v1.EnvironmentSetting.Environment.TagsEntry
+	9,  // 62: bytebase.v1.EmailSetting.SMTPConfig.encryption:type_name -> bytebase.v1.EmailSetting.SMTPConfig.Encryption
+	10, // 63: bytebase.v1.EmailSetting.SMTPConfig.authentication:type_name -> bytebase.v1.EmailSetting.SMTPConfig.Authentication
+	11, // 64: bytebase.v1.SettingService.ListSettings:input_type -> bytebase.v1.ListSettingsRequest
+	13, // 65: bytebase.v1.SettingService.GetSetting:input_type -> bytebase.v1.GetSettingRequest
+	15, // 66: bytebase.v1.SettingService.UpdateSetting:input_type -> bytebase.v1.UpdateSettingRequest
+	28, // 67: bytebase.v1.SettingService.TestEmailSetting:input_type -> bytebase.v1.TestEmailSettingRequest
+	12, // 68: bytebase.v1.SettingService.ListSettings:output_type -> bytebase.v1.ListSettingsResponse
+	16, // 69: bytebase.v1.SettingService.GetSetting:output_type -> bytebase.v1.Setting
+	16, // 70: bytebase.v1.SettingService.UpdateSetting:output_type -> bytebase.v1.Setting
+	29, // 71: bytebase.v1.SettingService.TestEmailSetting:output_type -> bytebase.v1.TestEmailSettingResponse
+	68, // [68:72] is the sub-list for method output_type
+	64, // [64:68] is the sub-list for method input_type
+	64, // [64:64] is the sub-list for extension type_name
+	64, // [64:64] is the sub-list for extension extendee
+	0,  // [0:64] is the sub-list for field type_name
 }
 
 func init() { file_v1_setting_service_proto_init() }
@@ -3695,6 +4256,7 @@ func file_v1_setting_service_proto_init() {
 	}
 	file_v1_annotation_proto_init()
 	file_v1_common_proto_init()
+	file_v1_instance_service_proto_init()
 	file_v1_issue_service_proto_init()
 	file_v1_setting_service_proto_msgTypes[6].OneofWrappers = []any{
 		(*SettingValue_AppIm)(nil),
@@ -3711,6 +4273,9 @@ func file_v1_setting_service_proto_init() {
 		(*Algorithm_RangeMask_)(nil),
 		(*Algorithm_Md5Mask)(nil),
 		(*Algorithm_InnerOuterMask_)(nil),
+		(*Algorithm_TokenizationMask_)(nil),
+		(*Algorithm_DateShiftMask_)(nil),
+		(*Algorithm_NumericNoiseMask_)(nil),
 	}
 	file_v1_setting_service_proto_msgTypes[16].OneofWrappers = []any{
 		(*EmailSetting_Smtp)(nil),
@@ -3729,8 +4294,8 @@ func file_v1_setting_service_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_setting_service_proto_rawDesc), len(file_v1_setting_service_proto_rawDesc)),
-			NumEnums:      9,
-			NumMessages:   41,
+			NumEnums:      11,
+			NumMessages:   45,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -74,7 +74,7 @@ func (x QueryOption_RedisRunCommandsOn) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use QueryOption_RedisRunCommandsOn.Descriptor instead.
 func (QueryOption_RedisRunCommandsOn) EnumDescriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{4, 0}
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{5, 0}
 }
 
 type QueryOption_MSSQLExplainFormat int32
@@ -126,7 +126,7 @@ func (x QueryOption_MSSQLExplainFormat) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use QueryOption_MSSQLExplainFormat.Descriptor instead.
 func (QueryOption_MSSQLExplainFormat) EnumDescriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{4, 1}
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{5, 1}
 }
 
 type QueryResult_CommandError_Type int32
@@ -178,7 +178,7 @@ func (x QueryResult_CommandError_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use QueryResult_CommandError_Type.Descriptor instead.
 func (QueryResult_CommandError_Type) EnumDescriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{5, 2, 0}
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{6, 2, 0}
 }
 
 type QueryResult_Message_Level int32
@@ -246,7 +246,58 @@ func (x QueryResult_Message_Level) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use QueryResult_Message_Level.Descriptor instead.
 func (QueryResult_Message_Level) EnumDescriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{5, 3, 0}
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{6, 3, 0}
+}
+
+type QueryPlanWarning_Type int32
+
+const (
+	QueryPlanWarning_TYPE_UNSPECIFIED QueryPlanWarning_Type = 0
+	// The plan reads a whole relation.
+	QueryPlanWarning_FULL_SCAN QueryPlanWarning_Type = 1
+	// The plan would benefit from an index that does not exist.
+	QueryPlanWarning_MISSING_INDEX QueryPlanWarning_Type = 2
+)
+
+// Enum value maps for QueryPlanWarning_Type.
+var (
+	QueryPlanWarning_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "FULL_SCAN",
+		2: "MISSING_INDEX",
+	}
+	QueryPlanWarning_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"FULL_SCAN":        1,
+		"MISSING_INDEX":    2,
+	}
+)
+
+func (x QueryPlanWarning_Type) Enum() *QueryPlanWarning_Type {
+	p := new(QueryPlanWarning_Type)
+	*p = x
+	return p
+}
+
+func (x QueryPlanWarning_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QueryPlanWarning_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_sql_service_proto_enumTypes[4].Descriptor()
+}
+
+func (QueryPlanWarning_Type) Type() protoreflect.EnumType {
+	return &file_v1_sql_service_proto_enumTypes[4]
+}
+
+func (x QueryPlanWarning_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QueryPlanWarning_Type.Descriptor instead.
+func (QueryPlanWarning_Type) EnumDescriptor() ([]byte, []int) {
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{10, 0}
 }
 
 // Level represents the severity level of the advice.
@@ -290,11 +341,11 @@ func (x Advice_Level) String() string {
 }
 
 func (Advice_Level) Descriptor() protoreflect.EnumDescriptor {
-	return file_v1_sql_service_proto_enumTypes[4].Descriptor()
+	return file_v1_sql_service_proto_enumTypes[5].Descriptor()
 }
 
 func (Advice_Level) Type() protoreflect.EnumType {
-	return &file_v1_sql_service_proto_enumTypes[4]
+	return &file_v1_sql_service_proto_enumTypes[5]
 }
 
 func (x Advice_Level) Number() protoreflect.EnumNumber {
@@ -303,7 +354,7 @@ func (x Advice_Level) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Advice_Level.Descriptor instead.
 func (Advice_Level) EnumDescriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{9, 0}
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{14, 0}
 }
 
 // RuleType indicates the source of the linting rule.
@@ -345,11 +396,11 @@ func (x Advice_RuleType) String() string {
 }
 
 func (Advice_RuleType) Descriptor() protoreflect.EnumDescriptor {
-	return file_v1_sql_service_proto_enumTypes[5].Descriptor()
+	return file_v1_sql_service_proto_enumTypes[6].Descriptor()
 }
 
 func (Advice_RuleType) Type() protoreflect.EnumType {
-	return &file_v1_sql_service_proto_enumTypes[5]
+	return &file_v1_sql_service_proto_enumTypes[6]
 }
 
 func (x Advice_RuleType) Number() protoreflect.EnumNumber {
@@ -358,7 +409,7 @@ func (x Advice_RuleType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Advice_RuleType.Descriptor instead.
 func (Advice_RuleType) EnumDescriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{9, 1}
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{14, 1}
 }
 
 type QueryHistory_Type int32
@@ -397,11 +448,11 @@ func (x QueryHistory_Type) String() string {
 }
 
 func (QueryHistory_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_v1_sql_service_proto_enumTypes[6].Descriptor()
+	return file_v1_sql_service_proto_enumTypes[7].Descriptor()
 }
 
 func (QueryHistory_Type) Type() protoreflect.EnumType {
-	return &file_v1_sql_service_proto_enumTypes[6]
+	return &file_v1_sql_service_proto_enumTypes[7]
 }
 
 func (x QueryHistory_Type) Number() protoreflect.EnumNumber {
@@ -410,7 +461,7 @@ func (x QueryHistory_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use QueryHistory_Type.Descriptor instead.
 func (QueryHistory_Type) EnumDescriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{16, 0}
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{25, 0}
 }
 
 type AdminExecuteRequest struct {
@@ -427,7 +478,11 @@ type AdminExecuteRequest struct {
 	Schema *string `protobuf:"bytes,4,opt,name=schema,proto3,oneof" json:"schema,omitempty"`
 	// Container is the container name to execute the query against, used for
 	// CosmosDB only.
-	Container     *string `protobuf:"bytes,5,opt,name=container,proto3,oneof" json:"container,omitempty"`
+	Container *string `protobuf:"bytes,5,opt,name=container,proto3,oneof" json:"container,omitempty"`
+	// The justification of the statement.
+	// Required if the database contains data at or above the justification
+	// classification level of the query data policy.
+	Justification *QueryJustification `protobuf:"bytes,6,opt,name=justification,proto3" json:"justification,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -497,6 +552,13 @@ func (x *AdminExecuteRequest) GetContainer() string {
 	return ""
 }
 
+func (x *AdminExecuteRequest) GetJustification() *QueryJustification {
+	if x != nil {
+		return x.Justification
+	}
+	return nil
+}
+
 type AdminExecuteResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The query results.
@@ -565,7 +627,31 @@ type QueryRequest struct {
 	QueryOption *QueryOption `protobuf:"bytes,7,opt,name=query_option,json=queryOption,proto3" json:"query_option,omitempty"`
 	// Container is the container name to execute the query against, used for
 	// CosmosDB only.
-	Container     *string `protobuf:"bytes,8,opt,name=container,proto3,oneof" json:"container,omitempty"`
+	Container *string `protobuf:"bytes,8,opt,name=container,proto3,oneof" json:"container,omitempty"`
+	// The worksheet defining the parameters of the statement.
+	// Format: projects/{project}/worksheets/{worksheet}
+	Worksheet string `protobuf:"bytes,9,opt,name=worksheet,proto3" json:"worksheet,omitempty"`
+	// The values of the worksheet parameters, keyed by the parameter name.
+	// The statement must be a single statement referencing the parameters as `:name`,
+	// and the values are bound through the database driver placeholders.
+	Parameters map[string]string `protobuf:"bytes,10,rep,name=parameters,proto3" json:"parameters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Skip reading the project query result cache.
+	// The fresh result is still written to the cache if the cache is enabled.
+	SkipCache bool `protobuf:"varint,11,opt,name=skip_cache,json=skipCache,proto3" json:"skip_cache,omitempty"`
+	// Execute the statement as a federated query across databases.
+	// The statement references tables of any database in the workspace as
+	// `instance.database.table` or `instance.database.schema.table`. Each
+	// referenced table is read from its database with the access check and
+	// masking applied, and the statement is then executed by an embedded SQLite
+	// engine over the masked tables. Only the columns used by the statement are
+	// read, and top-level WHERE conditions comparing a column of a single table
+	// with literals are pushed down to its database. The query fails if a table
+	// still exceeds the row or memory limits.
+	Federated bool `protobuf:"varint,12,opt,name=federated,proto3" json:"federated,omitempty"`
+	// The justification of the query.
+	// Required if the database contains data at or above the justification
+	// classification level of the query data policy.
+	Justification *QueryJustification `protobuf:"bytes,13,opt,name=justification,proto3" json:"justification,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -656,6 +742,96 @@ func (x *QueryRequest) GetContainer() string {
 	return ""
 }
 
+func (x *QueryRequest) GetWorksheet() string {
+	if x != nil {
+		return x.Worksheet
+	}
+	return ""
+}
+
+func (x *QueryRequest) GetParameters() map[string]string {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+func (x *QueryRequest) GetSkipCache() bool {
+	if x != nil {
+		return x.SkipCache
+	}
+	return false
+}
+
+func (x *QueryRequest) GetFederated() bool {
+	if x != nil {
+		return x.Federated
+	}
+	return false
+}
+
+func (x *QueryRequest) GetJustification() *QueryJustification {
+	if x != nil {
+		return x.Justification
+	}
+	return nil
+}
+
+// QueryJustification is the business reason for running a query against sensitive data.
+type QueryJustification struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The reason for the query.
+	Reason string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	// The ID of the external ticket, e.g. a Jira or ServiceNow ticket, authorizing the query.
+	Ticket        string `protobuf:"bytes,2,opt,name=ticket,proto3" json:"ticket,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryJustification) Reset() {
+	*x = QueryJustification{}
+	mi := &file_v1_sql_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryJustification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryJustification) ProtoMessage() {}
+
+func (x *QueryJustification) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_sql_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryJustification.ProtoReflect.Descriptor instead.
+func (*QueryJustification) Descriptor() ([]byte, []int) {
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *QueryJustification) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *QueryJustification) GetTicket() string {
+	if x != nil {
+		return x.Ticket
+	}
+	return ""
+}
+
 type QueryResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The query results.
@@ -666,7 +842,7 @@ type QueryResponse struct {
 
 func (x *QueryResponse) Reset() {
 	*x = QueryResponse{}
-	mi := &file_v1_sql_service_proto_msgTypes[3]
+	mi := &file_v1_sql_service_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -678,7 +854,7 @@ func (x *QueryResponse) String() string {
 func (*QueryResponse) ProtoMessage() {}
 
 func (x *QueryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_sql_service_proto_msgTypes[3]
+	mi := &file_v1_sql_service_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -691,7 +867,7 @@ func (x *QueryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryResponse.ProtoReflect.Descriptor instead.
 func (*QueryResponse) Descriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{3}
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *QueryResponse) GetResults() []*QueryResult {
@@ -711,7 +887,7 @@ type QueryOption struct {
 
 func (x *QueryOption) Reset() {
 	*x = QueryOption{}
-	mi := &file_v1_sql_service_proto_msgTypes[4]
+	mi := &file_v1_sql_service_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -723,7 +899,7 @@ func (x *QueryOption) String() string {
 func (*QueryOption) ProtoMessage() {}
 
 func (x *QueryOption) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_sql_service_proto_msgTypes[4]
+	mi := &file_v1_sql_service_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -736,7 +912,7 @@ func (x *QueryOption) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryOption.ProtoReflect.Descriptor instead.
 func (*QueryOption) Descriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{4}
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *QueryOption) GetRedisRunCommandsOn() QueryOption_RedisRunCommandsOn {
@@ -780,14 +956,26 @@ type QueryResult struct {
 	// Examples include PostgreSQL's RAISE NOTICE, MSSQL's PRINT, or Oracle's DBMS_OUTPUT.PUT_LINE.
 	Messages []*QueryResult_Message `protobuf:"bytes,11,rep,name=messages,proto3" json:"messages,omitempty"`
 	// Masking reasons for each column (empty for non-masked columns).
-	Masked        []*MaskingReason `protobuf:"bytes,12,rep,name=masked,proto3" json:"masked,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Masked []*MaskingReason `protobuf:"bytes,12,rep,name=masked,proto3" json:"masked,omitempty"`
+	// The normalized query plan of the statement.
+	// Only set for explain queries whose plan output can be parsed, i.e.
+	// Postgres, MySQL, MSSQL and Oracle.
+	// Explain queries do not execute the statement, so the actual rows and time
+	// of the nodes are not set, e.g. Postgres plans are explained without ANALYZE.
+	Plan *QueryPlan `protobuf:"bytes,14,opt,name=plan,proto3" json:"plan,omitempty"`
+	// Whether the result is served from the project query result cache.
+	// Access check and masking are always applied to the caller, regardless of the cache.
+	Cached bool `protobuf:"varint,15,opt,name=cached,proto3" json:"cached,omitempty"`
+	// The row filters of the project row filter policy applied to the statement.
+	// The result only contains the rows allowed by the filters.
+	AppliedRowFilters []*AppliedRowFilter `protobuf:"bytes,16,rep,name=applied_row_filters,json=appliedRowFilters,proto3" json:"applied_row_filters,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *QueryResult) Reset() {
 	*x = QueryResult{}
-	mi := &file_v1_sql_service_proto_msgTypes[5]
+	mi := &file_v1_sql_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -799,7 +987,7 @@ func (x *QueryResult) String() string {
 func (*QueryResult) ProtoMessage() {}
 
 func (x *QueryResult) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_sql_service_proto_msgTypes[5]
+	mi := &file_v1_sql_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -812,7 +1000,7 @@ func (x *QueryResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryResult.ProtoReflect.Descriptor instead.
 func (*QueryResult) Descriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{5}
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *QueryResult) GetColumnNames() []string {
@@ -921,6 +1109,27 @@ func (x *QueryResult) GetMasked() []*MaskingReason {
 	return nil
 }
 
+func (x *QueryResult) GetPlan() *QueryPlan {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+func (x *QueryResult) GetCached() bool {
+	if x != nil {
+		return x.Cached
+	}
+	return false
+}
+
+func (x *QueryResult) GetAppliedRowFilters() []*AppliedRowFilter {
+	if x != nil {
+		return x.AppliedRowFilters
+	}
+	return nil
+}
+
 type isQueryResult_DetailedError interface {
 	isQueryResult_DetailedError()
 }
@@ -949,41 +1158,35 @@ func (*QueryResult_PermissionDenied) isQueryResult_DetailedError() {}
 
 func (*QueryResult_CommandError_) isQueryResult_DetailedError() {}
 
-type MaskingReason struct {
+// AppliedRowFilter is the notice of a row filter applied to a query.
+type AppliedRowFilter struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The semantic type that triggered masking (e.g., "SSN", "email", "phone").
-	SemanticTypeId string `protobuf:"bytes,1,opt,name=semantic_type_id,json=semanticTypeId,proto3" json:"semantic_type_id,omitempty"`
-	// Human-readable semantic type title.
-	SemanticTypeTitle string `protobuf:"bytes,2,opt,name=semantic_type_title,json=semanticTypeTitle,proto3" json:"semantic_type_title,omitempty"`
-	// The masking rule ID that matched (if applicable).
-	MaskingRuleId string `protobuf:"bytes,3,opt,name=masking_rule_id,json=maskingRuleId,proto3" json:"masking_rule_id,omitempty"`
-	// The masking algorithm used.
-	Algorithm string `protobuf:"bytes,4,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
-	// Additional context (e.g., "Matched global rule: PII Protection").
-	Context string `protobuf:"bytes,5,opt,name=context,proto3" json:"context,omitempty"`
-	// The classification level that triggered masking.
-	ClassificationLevel int32 `protobuf:"varint,6,opt,name=classification_level,json=classificationLevel,proto3" json:"classification_level,omitempty"`
-	// Icon associated with the semantic type (if any).
-	SemanticTypeIcon string `protobuf:"bytes,7,opt,name=semantic_type_icon,json=semanticTypeIcon,proto3" json:"semantic_type_icon,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	// The ID of the row filter in the row filter policy.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The title of the row filter.
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// The filtered table.
+	// Format: {schema}.{table} for engines with schemas, or {database}.{table}.
+	Table         string `protobuf:"bytes,3,opt,name=table,proto3" json:"table,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MaskingReason) Reset() {
-	*x = MaskingReason{}
-	mi := &file_v1_sql_service_proto_msgTypes[6]
+func (x *AppliedRowFilter) Reset() {
+	*x = AppliedRowFilter{}
+	mi := &file_v1_sql_service_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MaskingReason) String() string {
+func (x *AppliedRowFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MaskingReason) ProtoMessage() {}
+func (*AppliedRowFilter) ProtoMessage() {}
 
-func (x *MaskingReason) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_sql_service_proto_msgTypes[6]
+func (x *AppliedRowFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_sql_service_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -994,83 +1197,130 @@ func (x *MaskingReason) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MaskingReason.ProtoReflect.Descriptor instead.
-func (*MaskingReason) Descriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use AppliedRowFilter.ProtoReflect.Descriptor instead.
+func (*AppliedRowFilter) Descriptor() ([]byte, []int) {
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *MaskingReason) GetSemanticTypeId() string {
+func (x *AppliedRowFilter) GetId() string {
 	if x != nil {
-		return x.SemanticTypeId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *MaskingReason) GetSemanticTypeTitle() string {
+func (x *AppliedRowFilter) GetTitle() string {
 	if x != nil {
-		return x.SemanticTypeTitle
+		return x.Title
 	}
 	return ""
 }
 
-func (x *MaskingReason) GetMaskingRuleId() string {
+func (x *AppliedRowFilter) GetTable() string {
 	if x != nil {
-		return x.MaskingRuleId
+		return x.Table
 	}
 	return ""
 }
 
-func (x *MaskingReason) GetAlgorithm() string {
-	if x != nil {
-		return x.Algorithm
-	}
-	return ""
+// QueryPlan is the engine-independent execution plan of a statement.
+type QueryPlan struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The root node of the plan tree.
+	Root *QueryPlanNode `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	// Warnings detected in the plan, such as full scans and missing indexes.
+	Warnings      []*QueryPlanWarning `protobuf:"bytes,2,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MaskingReason) GetContext() string {
+func (x *QueryPlan) Reset() {
+	*x = QueryPlan{}
+	mi := &file_v1_sql_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryPlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPlan) ProtoMessage() {}
+
+func (x *QueryPlan) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_sql_service_proto_msgTypes[8]
 	if x != nil {
-		return x.Context
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *MaskingReason) GetClassificationLevel() int32 {
+// Deprecated: Use QueryPlan.ProtoReflect.Descriptor instead.
+func (*QueryPlan) Descriptor() ([]byte, []int) {
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *QueryPlan) GetRoot() *QueryPlanNode {
 	if x != nil {
-		return x.ClassificationLevel
+		return x.Root
 	}
-	return 0
+	return nil
 }
 
-func (x *MaskingReason) GetSemanticTypeIcon() string {
+func (x *QueryPlan) GetWarnings() []*QueryPlanWarning {
 	if x != nil {
-		return x.SemanticTypeIcon
+		return x.Warnings
 	}
-	return ""
+	return nil
 }
 
-type QueryRow struct {
+type QueryPlanNode struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Row values of the query result.
-	Values        []*RowValue `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	// The operation of the node in the engine's own terms,
+	// e.g. "Seq Scan" in Postgres or "TABLE ACCESS FULL" in Oracle.
+	NodeType string `protobuf:"bytes,1,opt,name=node_type,json=nodeType,proto3" json:"node_type,omitempty"`
+	// The relation read by the node, if any.
+	Relation string `protobuf:"bytes,2,opt,name=relation,proto3" json:"relation,omitempty"`
+	// The index used by the node, if any.
+	Index string `protobuf:"bytes,3,opt,name=index,proto3" json:"index,omitempty"`
+	// The filter condition evaluated by the node, if any.
+	Filter string `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	// The number of rows estimated by the optimizer.
+	EstimatedRows *float64 `protobuf:"fixed64,5,opt,name=estimated_rows,json=estimatedRows,proto3,oneof" json:"estimated_rows,omitempty"`
+	// The number of rows actually produced.
+	// Only available for analyzed plans, which explain queries do not produce yet.
+	ActualRows *float64 `protobuf:"fixed64,6,opt,name=actual_rows,json=actualRows,proto3,oneof" json:"actual_rows,omitempty"`
+	// The estimated total cost of the node in the engine's own unit.
+	Cost *float64 `protobuf:"fixed64,7,opt,name=cost,proto3,oneof" json:"cost,omitempty"`
+	// The actual total time of the node in milliseconds.
+	// Only available for analyzed plans, which explain queries do not produce yet.
+	ActualTimeMs *float64 `protobuf:"fixed64,8,opt,name=actual_time_ms,json=actualTimeMs,proto3,oneof" json:"actual_time_ms,omitempty"`
+	// Whether the node reads the whole relation.
+	FullScan      bool             `protobuf:"varint,9,opt,name=full_scan,json=fullScan,proto3" json:"full_scan,omitempty"`
+	Children      []*QueryPlanNode `protobuf:"bytes,10,rep,name=children,proto3" json:"children,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *QueryRow) Reset() {
-	*x = QueryRow{}
-	mi := &file_v1_sql_service_proto_msgTypes[7]
+func (x *QueryPlanNode) Reset() {
+	*x = QueryPlanNode{}
+	mi := &file_v1_sql_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *QueryRow) String() string {
+func (x *QueryPlanNode) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryRow) ProtoMessage() {}
+func (*QueryPlanNode) ProtoMessage() {}
 
-func (x *QueryRow) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_sql_service_proto_msgTypes[7]
+func (x *QueryPlanNode) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_sql_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1081,33 +1331,311 @@ func (x *QueryRow) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryRow.ProtoReflect.Descriptor instead.
-func (*QueryRow) Descriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use QueryPlanNode.ProtoReflect.Descriptor instead.
+func (*QueryPlanNode) Descriptor() ([]byte, []int) {
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *QueryRow) GetValues() []*RowValue {
+func (x *QueryPlanNode) GetNodeType() string {
 	if x != nil {
-		return x.Values
+		return x.NodeType
 	}
-	return nil
+	return ""
 }
 
-type RowValue struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Kind:
-	//
-	//	*RowValue_NullValue
-	//	*RowValue_BoolValue
-	//	*RowValue_BytesValue
-	//	*RowValue_DoubleValue
-	//	*RowValue_FloatValue
-	//	*RowValue_Int32Value
-	//	*RowValue_Int64Value
-	//	*RowValue_StringValue
-	//	*RowValue_Uint32Value
-	//	*RowValue_Uint64Value
-	//	*RowValue_ValueValue
+func (x *QueryPlanNode) GetRelation() string {
+	if x != nil {
+		return x.Relation
+	}
+	return ""
+}
+
+func (x *QueryPlanNode) GetIndex() string {
+	if x != nil {
+		return x.Index
+	}
+	return ""
+}
+
+func (x *QueryPlanNode) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *QueryPlanNode) GetEstimatedRows() float64 {
+	if x != nil && x.EstimatedRows != nil {
+		return *x.EstimatedRows
+	}
+	return 0
+}
+
+func (x *QueryPlanNode) GetActualRows() float64 {
+	if x != nil && x.ActualRows != nil {
+		return *x.ActualRows
+	}
+	return 0
+}
+
+func (x *QueryPlanNode) GetCost() float64 {
+	if x != nil && x.Cost != nil {
+		return *x.Cost
+	}
+	return 0
+}
+
+func (x *QueryPlanNode) GetActualTimeMs() float64 {
+	if x != nil && x.ActualTimeMs != nil {
+		return *x.ActualTimeMs
+	}
+	return 0
+}
+
+func (x *QueryPlanNode) GetFullScan() bool {
+	if x != nil {
+		return x.FullScan
+	}
+	return false
+}
+
+func (x *QueryPlanNode) GetChildren() []*QueryPlanNode {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+type QueryPlanWarning struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Type  QueryPlanWarning_Type  `protobuf:"varint,1,opt,name=type,proto3,enum=bytebase.v1.QueryPlanWarning_Type" json:"type,omitempty"`
+	// The relation the warning is about.
+	Relation string `protobuf:"bytes,2,opt,name=relation,proto3" json:"relation,omitempty"`
+	Content  string `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	// The SQL review advice code of the warning, so that it can be reported
+	// the same way as statement advisor results.
+	Code          int32 `protobuf:"varint,4,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryPlanWarning) Reset() {
+	*x = QueryPlanWarning{}
+	mi := &file_v1_sql_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryPlanWarning) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPlanWarning) ProtoMessage() {}
+
+func (x *QueryPlanWarning) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_sql_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryPlanWarning.ProtoReflect.Descriptor instead.
+func (*QueryPlanWarning) Descriptor() ([]byte, []int) {
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *QueryPlanWarning) GetType() QueryPlanWarning_Type {
+	if x != nil {
+		return x.Type
+	}
+	return QueryPlanWarning_TYPE_UNSPECIFIED
+}
+
+func (x *QueryPlanWarning) GetRelation() string {
+	if x != nil {
+		return x.Relation
+	}
+	return ""
+}
+
+func (x *QueryPlanWarning) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *QueryPlanWarning) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+type MaskingReason struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The semantic type that triggered masking (e.g., "SSN", "email", "phone").
+	SemanticTypeId string `protobuf:"bytes,1,opt,name=semantic_type_id,json=semanticTypeId,proto3" json:"semantic_type_id,omitempty"`
+	// Human-readable semantic type title.
+	SemanticTypeTitle string `protobuf:"bytes,2,opt,name=semantic_type_title,json=semanticTypeTitle,proto3" json:"semantic_type_title,omitempty"`
+	// The masking rule ID that matched (if applicable).
+	MaskingRuleId string `protobuf:"bytes,3,opt,name=masking_rule_id,json=maskingRuleId,proto3" json:"masking_rule_id,omitempty"`
+	// The masking algorithm used.
+	Algorithm string `protobuf:"bytes,4,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	// Additional context (e.g., "Matched global rule: PII Protection").
+	Context string `protobuf:"bytes,5,opt,name=context,proto3" json:"context,omitempty"`
+	// The classification level that triggered masking.
+	ClassificationLevel int32 `protobuf:"varint,6,opt,name=classification_level,json=classificationLevel,proto3" json:"classification_level,omitempty"`
+	// Icon associated with the semantic type (if any).
+	SemanticTypeIcon string `protobuf:"bytes,7,opt,name=semantic_type_icon,json=semanticTypeIcon,proto3" json:"semantic_type_icon,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *MaskingReason) Reset() {
+	*x = MaskingReason{}
+	mi := &file_v1_sql_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MaskingReason) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaskingReason) ProtoMessage() {}
+
+func (x *MaskingReason) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_sql_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaskingReason.ProtoReflect.Descriptor instead.
+func (*MaskingReason) Descriptor() ([]byte, []int) {
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *MaskingReason) GetSemanticTypeId() string {
+	if x != nil {
+		return x.SemanticTypeId
+	}
+	return ""
+}
+
+func (x *MaskingReason) GetSemanticTypeTitle() string {
+	if x != nil {
+		return x.SemanticTypeTitle
+	}
+	return ""
+}
+
+func (x *MaskingReason) GetMaskingRuleId() string {
+	if x != nil {
+		return x.MaskingRuleId
+	}
+	return ""
+}
+
+func (x *MaskingReason) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *MaskingReason) GetContext() string {
+	if x != nil {
+		return x.Context
+	}
+	return ""
+}
+
+func (x *MaskingReason) GetClassificationLevel() int32 {
+	if x != nil {
+		return x.ClassificationLevel
+	}
+	return 0
+}
+
+func (x *MaskingReason) GetSemanticTypeIcon() string {
+	if x != nil {
+		return x.SemanticTypeIcon
+	}
+	return ""
+}
+
+type QueryRow struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Row values of the query result.
+	Values        []*RowValue `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryRow) Reset() {
+	*x = QueryRow{}
+	mi := &file_v1_sql_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryRow) ProtoMessage() {}
+
+func (x *QueryRow) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_sql_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryRow.ProtoReflect.Descriptor instead.
+func (*QueryRow) Descriptor() ([]byte, []int) {
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *QueryRow) GetValues() []*RowValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type RowValue struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Kind:
+	//
+	//	*RowValue_NullValue
+	//	*RowValue_BoolValue
+	//	*RowValue_BytesValue
+	//	*RowValue_DoubleValue
+	//	*RowValue_FloatValue
+	//	*RowValue_Int32Value
+	//	*RowValue_Int64Value
+	//	*RowValue_StringValue
+	//	*RowValue_Uint32Value
+	//	*RowValue_Uint64Value
+	//	*RowValue_ValueValue
 	//	*RowValue_TimestampValue
 	//	*RowValue_TimestampTzValue
 	Kind          isRowValue_Kind `protobuf_oneof:"kind"`
@@ -1117,7 +1645,7 @@ type RowValue struct {
 
 func (x *RowValue) Reset() {
 	*x = RowValue{}
-	mi := &file_v1_sql_service_proto_msgTypes[8]
+	mi := &file_v1_sql_service_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1129,7 +1657,7 @@ func (x *RowValue) String() string {
 func (*RowValue) ProtoMessage() {}
 
 func (x *RowValue) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_sql_service_proto_msgTypes[8]
+	mi := &file_v1_sql_service_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1142,7 +1670,7 @@ func (x *RowValue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RowValue.ProtoReflect.Descriptor instead.
 func (*RowValue) Descriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{8}
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *RowValue) GetKind() isRowValue_Kind {
@@ -1380,7 +1908,7 @@ type Advice struct {
 
 func (x *Advice) Reset() {
 	*x = Advice{}
-	mi := &file_v1_sql_service_proto_msgTypes[9]
+	mi := &file_v1_sql_service_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1392,7 +1920,7 @@ func (x *Advice) String() string {
 func (*Advice) ProtoMessage() {}
 
 func (x *Advice) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_sql_service_proto_msgTypes[9]
+	mi := &file_v1_sql_service_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1405,7 +1933,7 @@ func (x *Advice) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Advice.ProtoReflect.Descriptor instead.
 func (*Advice) Descriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{9}
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *Advice) GetStatus() Advice_Level {
@@ -1484,14 +2012,26 @@ type ExportRequest struct {
 	DataSourceId string `protobuf:"bytes,7,opt,name=data_source_id,json=dataSourceId,proto3" json:"data_source_id,omitempty"`
 	// The default schema to search objects. Equals to the current schema in
 	// Oracle and search path in Postgres.
-	Schema        *string `protobuf:"bytes,8,opt,name=schema,proto3,oneof" json:"schema,omitempty"`
+	Schema *string `protobuf:"bytes,8,opt,name=schema,proto3,oneof" json:"schema,omitempty"`
+	// The justification of the export.
+	// Required if the database contains data at or above the justification
+	// classification level of the query data policy.
+	Justification *QueryJustification `protobuf:"bytes,9,opt,name=justification,proto3" json:"justification,omitempty"`
+	// Whether to run the export in the background.
+	// If true, the export returns an operation immediately, and the response of the operation is the ExportResponse.
+	Async bool `protobuf:"varint,10,opt,name=async,proto3" json:"async,omitempty"`
+	// The export archive to download, returned in the response of the export operation.
+	// If set, the content of the archive is returned instead of running the export.
+	// Only the user who runs the export can download it, with the same name.
+	// Format: exportArchives/{export_archive}
+	ExportArchive string `protobuf:"bytes,11,opt,name=export_archive,json=exportArchive,proto3" json:"export_archive,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ExportRequest) Reset() {
 	*x = ExportRequest{}
-	mi := &file_v1_sql_service_proto_msgTypes[10]
+	mi := &file_v1_sql_service_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1503,7 +2043,7 @@ func (x *ExportRequest) String() string {
 func (*ExportRequest) ProtoMessage() {}
 
 func (x *ExportRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_sql_service_proto_msgTypes[10]
+	mi := &file_v1_sql_service_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1516,7 +2056,7 @@ func (x *ExportRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExportRequest.ProtoReflect.Descriptor instead.
 func (*ExportRequest) Descriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{10}
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ExportRequest) GetName() string {
@@ -1575,17 +2115,47 @@ func (x *ExportRequest) GetSchema() string {
 	return ""
 }
 
+func (x *ExportRequest) GetJustification() *QueryJustification {
+	if x != nil {
+		return x.Justification
+	}
+	return nil
+}
+
+func (x *ExportRequest) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
+func (x *ExportRequest) GetExportArchive() string {
+	if x != nil {
+		return x.ExportArchive
+	}
+	return ""
+}
+
 type ExportResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The export file content.
-	Content       []byte `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	// Empty if the export runs in the background.
+	Content []byte `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	// The operation running the export in the background.
+	// Only set if async is true.
+	Operation *Operation `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation,omitempty"`
+	// The export archive storing the content of the export run in the background.
+	// Only set in the response of the export operation. Download it by export_archive
+	// of ExportRequest before it expires in 24 hours.
+	// Format: exportArchives/{export_archive}
+	ExportArchive string `protobuf:"bytes,3,opt,name=export_archive,json=exportArchive,proto3" json:"export_archive,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ExportResponse) Reset() {
 	*x = ExportResponse{}
-	mi := &file_v1_sql_service_proto_msgTypes[11]
+	mi := &file_v1_sql_service_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1597,7 +2167,7 @@ func (x *ExportResponse) String() string {
 func (*ExportResponse) ProtoMessage() {}
 
 func (x *ExportResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_sql_service_proto_msgTypes[11]
+	mi := &file_v1_sql_service_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1610,7 +2180,7 @@ func (x *ExportResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExportResponse.ProtoReflect.Descriptor instead.
 func (*ExportResponse) Descriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{11}
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ExportResponse) GetContent() []byte {
@@ -1620,6 +2190,181 @@ func (x *ExportResponse) GetContent() []byte {
 	return nil
 }
 
+func (x *ExportResponse) GetOperation() *Operation {
+	if x != nil {
+		return x.Operation
+	}
+	return nil
+}
+
+func (x *ExportResponse) GetExportArchive() string {
+	if x != nil {
+		return x.ExportArchive
+	}
+	return ""
+}
+
+type VerifyExportWatermarkRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The content of the export archive.
+	// It can also be a SQL or XLSX file extracted from the archive, which embeds the watermark.
+	Content []byte `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	// The password of the export archive.
+	// Required to verify the files of a password-protected archive.
+	Password      string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyExportWatermarkRequest) Reset() {
+	*x = VerifyExportWatermarkRequest{}
+	mi := &file_v1_sql_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyExportWatermarkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyExportWatermarkRequest) ProtoMessage() {}
+
+func (x *VerifyExportWatermarkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_sql_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyExportWatermarkRequest.ProtoReflect.Descriptor instead.
+func (*VerifyExportWatermarkRequest) Descriptor() ([]byte, []int) {
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *VerifyExportWatermarkRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *VerifyExportWatermarkRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+// ExportWatermark identifies the exporter of an export archive.
+type ExportWatermark struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The exporter.
+	// Format: users/{email}
+	Exporter string `protobuf:"bytes,1,opt,name=exporter,proto3" json:"exporter,omitempty"`
+	// The time of the export.
+	ExportTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=export_time,json=exportTime,proto3" json:"export_time,omitempty"`
+	// The resource exported from.
+	// Format: instances/{instance}/databases/{database}
+	// Format: projects/{project}/plans/{plan}/rollout
+	// Format: projects/{project}/plans/{plan}/rollout/stages/{stage}
+	Source string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	// The SHA-256 hex digest of the exported statement.
+	// Empty for the exports of data export issues.
+	StatementSha256 string `protobuf:"bytes,4,opt,name=statement_sha256,json=statementSha256,proto3" json:"statement_sha256,omitempty"`
+	// Whether the dynamic data masking was applied to the exported data.
+	Masked bool `protobuf:"varint,5,opt,name=masked,proto3" json:"masked,omitempty"`
+	// The exported files in the archive.
+	Files []*ExportWatermark_File `protobuf:"bytes,6,rep,name=files,proto3" json:"files,omitempty"`
+	// The unique ID of the export.
+	Id            string `protobuf:"bytes,7,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportWatermark) Reset() {
+	*x = ExportWatermark{}
+	mi := &file_v1_sql_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportWatermark) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportWatermark) ProtoMessage() {}
+
+func (x *ExportWatermark) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_sql_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportWatermark.ProtoReflect.Descriptor instead.
+func (*ExportWatermark) Descriptor() ([]byte, []int) {
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ExportWatermark) GetExporter() string {
+	if x != nil {
+		return x.Exporter
+	}
+	return ""
+}
+
+func (x *ExportWatermark) GetExportTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExportTime
+	}
+	return nil
+}
+
+func (x *ExportWatermark) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ExportWatermark) GetStatementSha256() string {
+	if x != nil {
+		return x.StatementSha256
+	}
+	return ""
+}
+
+func (x *ExportWatermark) GetMasked() bool {
+	if x != nil {
+		return x.Masked
+	}
+	return false
+}
+
+func (x *ExportWatermark) GetFiles() []*ExportWatermark_File {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *ExportWatermark) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
 type DiffMetadataRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The metadata of the source schema.
@@ -1634,7 +2379,7 @@ type DiffMetadataRequest struct {
 
 func (x *DiffMetadataRequest) Reset() {
 	*x = DiffMetadataRequest{}
-	mi := &file_v1_sql_service_proto_msgTypes[12]
+	mi := &file_v1_sql_service_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1646,7 +2391,7 @@ func (x *DiffMetadataRequest) String() string {
 func (*DiffMetadataRequest) ProtoMessage() {}
 
 func (x *DiffMetadataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_sql_service_proto_msgTypes[12]
+	mi := &file_v1_sql_service_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1659,7 +2404,7 @@ func (x *DiffMetadataRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DiffMetadataRequest.ProtoReflect.Descriptor instead.
 func (*DiffMetadataRequest) Descriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{12}
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *DiffMetadataRequest) GetSourceMetadata() *DatabaseMetadata {
@@ -1693,7 +2438,7 @@ type DiffMetadataResponse struct {
 
 func (x *DiffMetadataResponse) Reset() {
 	*x = DiffMetadataResponse{}
-	mi := &file_v1_sql_service_proto_msgTypes[13]
+	mi := &file_v1_sql_service_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1702,10 +2447,127 @@ func (x *DiffMetadataResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DiffMetadataResponse) ProtoMessage() {}
+func (*DiffMetadataResponse) ProtoMessage() {}
+
+func (x *DiffMetadataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_sql_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffMetadataResponse.ProtoReflect.Descriptor instead.
+func (*DiffMetadataResponse) Descriptor() ([]byte, []int) {
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DiffMetadataResponse) GetDiff() string {
+	if x != nil {
+		return x.Diff
+	}
+	return ""
+}
+
+type FormatStatementRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The SQL statements to format.
+	Statement string `protobuf:"bytes,1,opt,name=statement,proto3" json:"statement,omitempty"`
+	// The database engine whose dialect the statements are written in.
+	Engine Engine `protobuf:"varint,2,opt,name=engine,proto3,enum=bytebase.v1.Engine" json:"engine,omitempty"`
+	// The number of spaces per indentation level. Defaults to 2.
+	IndentSize int32 `protobuf:"varint,3,opt,name=indent_size,json=indentSize,proto3" json:"indent_size,omitempty"`
+	// Whether to indent with tabs instead of spaces.
+	UseTab        bool `protobuf:"varint,4,opt,name=use_tab,json=useTab,proto3" json:"use_tab,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FormatStatementRequest) Reset() {
+	*x = FormatStatementRequest{}
+	mi := &file_v1_sql_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FormatStatementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FormatStatementRequest) ProtoMessage() {}
+
+func (x *FormatStatementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_sql_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FormatStatementRequest.ProtoReflect.Descriptor instead.
+func (*FormatStatementRequest) Descriptor() ([]byte, []int) {
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *FormatStatementRequest) GetStatement() string {
+	if x != nil {
+		return x.Statement
+	}
+	return ""
+}
+
+func (x *FormatStatementRequest) GetEngine() Engine {
+	if x != nil {
+		return x.Engine
+	}
+	return Engine_ENGINE_UNSPECIFIED
+}
+
+func (x *FormatStatementRequest) GetIndentSize() int32 {
+	if x != nil {
+		return x.IndentSize
+	}
+	return 0
+}
+
+func (x *FormatStatementRequest) GetUseTab() bool {
+	if x != nil {
+		return x.UseTab
+	}
+	return false
+}
+
+type FormatStatementResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The formatted SQL statements.
+	Statement     string `protobuf:"bytes,1,opt,name=statement,proto3" json:"statement,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FormatStatementResponse) Reset() {
+	*x = FormatStatementResponse{}
+	mi := &file_v1_sql_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FormatStatementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FormatStatementResponse) ProtoMessage() {}
 
-func (x *DiffMetadataResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_sql_service_proto_msgTypes[13]
+func (x *FormatStatementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_sql_service_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1716,14 +2578,14 @@ func (x *DiffMetadataResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DiffMetadataResponse.ProtoReflect.Descriptor instead.
-func (*DiffMetadataResponse) Descriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use FormatStatementResponse.ProtoReflect.Descriptor instead.
+func (*FormatStatementResponse) Descriptor() ([]byte, []int) {
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *DiffMetadataResponse) GetDiff() string {
+func (x *FormatStatementResponse) GetStatement() string {
 	if x != nil {
-		return x.Diff
+		return x.Statement
 	}
 	return ""
 }
@@ -1747,6 +2609,8 @@ type SearchQueryHistoriesRequest struct {
 	// - instance: the instance full name in "instances/{id}" format, support "==" operator.
 	// - type: the type, should be "QUERY" or "EXPORT", support "==" operator.
 	// - statement: the SQL statement, support ".contains()" operator.
+	// - access_grant: the access grant full name in "projects/{project}/accessGrants/{access_grant}" format, support "==" operator.
+	// - ticket: the ticket ID of the query justification, support "==" operator.
 	//
 	// For example:
 	// project == "projects/{project}"
@@ -1756,6 +2620,8 @@ type SearchQueryHistoriesRequest struct {
 	// type == "EXPORT"
 	// statement.contains("select")
 	// type == "QUERY" && statement.contains("select")
+	// access_grant == "projects/{project}/accessGrants/{access_grant}"
+	// ticket == "INC-123"
 	Filter        string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -1763,7 +2629,7 @@ type SearchQueryHistoriesRequest struct {
 
 func (x *SearchQueryHistoriesRequest) Reset() {
 	*x = SearchQueryHistoriesRequest{}
-	mi := &file_v1_sql_service_proto_msgTypes[14]
+	mi := &file_v1_sql_service_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1775,7 +2641,7 @@ func (x *SearchQueryHistoriesRequest) String() string {
 func (*SearchQueryHistoriesRequest) ProtoMessage() {}
 
 func (x *SearchQueryHistoriesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_sql_service_proto_msgTypes[14]
+	mi := &file_v1_sql_service_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1788,7 +2654,7 @@ func (x *SearchQueryHistoriesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchQueryHistoriesRequest.ProtoReflect.Descriptor instead.
 func (*SearchQueryHistoriesRequest) Descriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{14}
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *SearchQueryHistoriesRequest) GetPageSize() int32 {
@@ -1826,7 +2692,7 @@ type SearchQueryHistoriesResponse struct {
 
 func (x *SearchQueryHistoriesResponse) Reset() {
 	*x = SearchQueryHistoriesResponse{}
-	mi := &file_v1_sql_service_proto_msgTypes[15]
+	mi := &file_v1_sql_service_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1838,7 +2704,7 @@ func (x *SearchQueryHistoriesResponse) String() string {
 func (*SearchQueryHistoriesResponse) ProtoMessage() {}
 
 func (x *SearchQueryHistoriesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_sql_service_proto_msgTypes[15]
+	mi := &file_v1_sql_service_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1851,7 +2717,7 @@ func (x *SearchQueryHistoriesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchQueryHistoriesResponse.ProtoReflect.Descriptor instead.
 func (*SearchQueryHistoriesResponse) Descriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{15}
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *SearchQueryHistoriesResponse) GetQueryHistories() []*QueryHistory {
@@ -1875,20 +2741,26 @@ type QueryHistory struct {
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	// The database name to execute the query.
 	// Format: instances/{instance}/databases/{databaseName}
-	Database      string                 `protobuf:"bytes,2,opt,name=database,proto3" json:"database,omitempty"`
-	Creator       string                 `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
-	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
-	Statement     string                 `protobuf:"bytes,5,opt,name=statement,proto3" json:"statement,omitempty"`
-	Error         *string                `protobuf:"bytes,6,opt,name=error,proto3,oneof" json:"error,omitempty"`
-	Duration      *durationpb.Duration   `protobuf:"bytes,7,opt,name=duration,proto3" json:"duration,omitempty"`
-	Type          QueryHistory_Type      `protobuf:"varint,8,opt,name=type,proto3,enum=bytebase.v1.QueryHistory_Type" json:"type,omitempty"`
+	Database   string                 `protobuf:"bytes,2,opt,name=database,proto3" json:"database,omitempty"`
+	Creator    string                 `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	Statement  string                 `protobuf:"bytes,5,opt,name=statement,proto3" json:"statement,omitempty"`
+	Error      *string                `protobuf:"bytes,6,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	Duration   *durationpb.Duration   `protobuf:"bytes,7,opt,name=duration,proto3" json:"duration,omitempty"`
+	Type       QueryHistory_Type      `protobuf:"varint,8,opt,name=type,proto3,enum=bytebase.v1.QueryHistory_Type" json:"type,omitempty"`
+	// The access grant that authorized the query.
+	// It tags the queries run in a break-glass session.
+	// Format: projects/{project}/accessGrants/{access_grant}
+	AccessGrant string `protobuf:"bytes,9,opt,name=access_grant,json=accessGrant,proto3" json:"access_grant,omitempty"`
+	// The justification provided with the query.
+	Justification *QueryJustification `protobuf:"bytes,10,opt,name=justification,proto3" json:"justification,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *QueryHistory) Reset() {
 	*x = QueryHistory{}
-	mi := &file_v1_sql_service_proto_msgTypes[16]
+	mi := &file_v1_sql_service_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1900,7 +2772,7 @@ func (x *QueryHistory) String() string {
 func (*QueryHistory) ProtoMessage() {}
 
 func (x *QueryHistory) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_sql_service_proto_msgTypes[16]
+	mi := &file_v1_sql_service_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1913,7 +2785,7 @@ func (x *QueryHistory) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryHistory.ProtoReflect.Descriptor instead.
 func (*QueryHistory) Descriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{16}
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *QueryHistory) GetName() string {
@@ -1972,6 +2844,231 @@ func (x *QueryHistory) GetType() QueryHistory_Type {
 	return QueryHistory_TYPE_UNSPECIFIED
 }
 
+func (x *QueryHistory) GetAccessGrant() string {
+	if x != nil {
+		return x.AccessGrant
+	}
+	return ""
+}
+
+func (x *QueryHistory) GetJustification() *QueryJustification {
+	if x != nil {
+		return x.Justification
+	}
+	return nil
+}
+
+type CreateQueryResultShareRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The parent project of the share.
+	// Format: projects/{project}
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// The query to snapshot. The database must belong to the parent project.
+	Query *QueryRequest `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	// The time to live of the share. Defaults to 7 days, and must not exceed 30 days.
+	Ttl           *durationpb.Duration `protobuf:"bytes,3,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateQueryResultShareRequest) Reset() {
+	*x = CreateQueryResultShareRequest{}
+	mi := &file_v1_sql_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateQueryResultShareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateQueryResultShareRequest) ProtoMessage() {}
+
+func (x *CreateQueryResultShareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_sql_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateQueryResultShareRequest.ProtoReflect.Descriptor instead.
+func (*CreateQueryResultShareRequest) Descriptor() ([]byte, []int) {
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CreateQueryResultShareRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *CreateQueryResultShareRequest) GetQuery() *QueryRequest {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *CreateQueryResultShareRequest) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+type GetQueryResultShareRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the share.
+	// Format: projects/{project}/queryResultShares/{share}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQueryResultShareRequest) Reset() {
+	*x = GetQueryResultShareRequest{}
+	mi := &file_v1_sql_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQueryResultShareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQueryResultShareRequest) ProtoMessage() {}
+
+func (x *GetQueryResultShareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_sql_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQueryResultShareRequest.ProtoReflect.Descriptor instead.
+func (*GetQueryResultShareRequest) Descriptor() ([]byte, []int) {
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetQueryResultShareRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// QueryResultShare is a snapshot of a query result shared by link.
+type QueryResultShare struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the share.
+	// Format: projects/{project}/queryResultShares/{share}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The database the statement was executed against.
+	// Format: instances/{instance}/databases/{databaseName}
+	Database string `protobuf:"bytes,2,opt,name=database,proto3" json:"database,omitempty"`
+	// The statement of the snapshot.
+	Statement string `protobuf:"bytes,3,opt,name=statement,proto3" json:"statement,omitempty"`
+	// Format: users/{email}
+	Creator    string                 `protobuf:"bytes,4,opt,name=creator,proto3" json:"creator,omitempty"`
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	ExpireTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	// The results of the snapshot, with the viewer's access check and masking applied.
+	// Only set by GetQueryResultShare.
+	Results       []*QueryResult `protobuf:"bytes,7,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryResultShare) Reset() {
+	*x = QueryResultShare{}
+	mi := &file_v1_sql_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryResultShare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryResultShare) ProtoMessage() {}
+
+func (x *QueryResultShare) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_sql_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryResultShare.ProtoReflect.Descriptor instead.
+func (*QueryResultShare) Descriptor() ([]byte, []int) {
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *QueryResultShare) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *QueryResultShare) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+func (x *QueryResultShare) GetStatement() string {
+	if x != nil {
+		return x.Statement
+	}
+	return ""
+}
+
+func (x *QueryResultShare) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *QueryResultShare) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *QueryResultShare) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+func (x *QueryResultShare) GetResults() []*QueryResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
 type AICompletionRequest struct {
 	state         protoimpl.MessageState         `protogen:"open.v1"`
 	Messages      []*AICompletionRequest_Message `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
@@ -1981,7 +3078,7 @@ type AICompletionRequest struct {
 
 func (x *AICompletionRequest) Reset() {
 	*x = AICompletionRequest{}
-	mi := &file_v1_sql_service_proto_msgTypes[17]
+	mi := &file_v1_sql_service_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1993,7 +3090,7 @@ func (x *AICompletionRequest) String() string {
 func (*AICompletionRequest) ProtoMessage() {}
 
 func (x *AICompletionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_sql_service_proto_msgTypes[17]
+	mi := &file_v1_sql_service_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2006,7 +3103,7 @@ func (x *AICompletionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AICompletionRequest.ProtoReflect.Descriptor instead.
 func (*AICompletionRequest) Descriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{17}
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *AICompletionRequest) GetMessages() []*AICompletionRequest_Message {
@@ -2027,7 +3124,7 @@ type AICompletionResponse struct {
 
 func (x *AICompletionResponse) Reset() {
 	*x = AICompletionResponse{}
-	mi := &file_v1_sql_service_proto_msgTypes[18]
+	mi := &file_v1_sql_service_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2039,7 +3136,7 @@ func (x *AICompletionResponse) String() string {
 func (*AICompletionResponse) ProtoMessage() {}
 
 func (x *AICompletionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_sql_service_proto_msgTypes[18]
+	mi := &file_v1_sql_service_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2052,7 +3149,7 @@ func (x *AICompletionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AICompletionResponse.ProtoReflect.Descriptor instead.
 func (*AICompletionResponse) Descriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{18}
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *AICompletionResponse) GetCandidates() []*AICompletionResponse_Candidate {
@@ -2089,7 +3186,7 @@ type QueryResult_PostgresError struct {
 
 func (x *QueryResult_PostgresError) Reset() {
 	*x = QueryResult_PostgresError{}
-	mi := &file_v1_sql_service_proto_msgTypes[19]
+	mi := &file_v1_sql_service_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2101,7 +3198,7 @@ func (x *QueryResult_PostgresError) String() string {
 func (*QueryResult_PostgresError) ProtoMessage() {}
 
 func (x *QueryResult_PostgresError) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_sql_service_proto_msgTypes[19]
+	mi := &file_v1_sql_service_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2114,7 +3211,7 @@ func (x *QueryResult_PostgresError) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryResult_PostgresError.ProtoReflect.Descriptor instead.
 func (*QueryResult_PostgresError) Descriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{5, 0}
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{6, 0}
 }
 
 func (x *QueryResult_PostgresError) GetSeverity() string {
@@ -2247,7 +3344,7 @@ type QueryResult_SyntaxError struct {
 
 func (x *QueryResult_SyntaxError) Reset() {
 	*x = QueryResult_SyntaxError{}
-	mi := &file_v1_sql_service_proto_msgTypes[20]
+	mi := &file_v1_sql_service_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2259,7 +3356,7 @@ func (x *QueryResult_SyntaxError) String() string {
 func (*QueryResult_SyntaxError) ProtoMessage() {}
 
 func (x *QueryResult_SyntaxError) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_sql_service_proto_msgTypes[20]
+	mi := &file_v1_sql_service_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2272,7 +3369,7 @@ func (x *QueryResult_SyntaxError) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryResult_SyntaxError.ProtoReflect.Descriptor instead.
 func (*QueryResult_SyntaxError) Descriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{5, 1}
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{6, 1}
 }
 
 func (x *QueryResult_SyntaxError) GetStartPosition() *Position {
@@ -2294,7 +3391,7 @@ type QueryResult_CommandError struct {
 
 func (x *QueryResult_CommandError) Reset() {
 	*x = QueryResult_CommandError{}
-	mi := &file_v1_sql_service_proto_msgTypes[21]
+	mi := &file_v1_sql_service_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2306,7 +3403,7 @@ func (x *QueryResult_CommandError) String() string {
 func (*QueryResult_CommandError) ProtoMessage() {}
 
 func (x *QueryResult_CommandError) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_sql_service_proto_msgTypes[21]
+	mi := &file_v1_sql_service_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2319,7 +3416,7 @@ func (x *QueryResult_CommandError) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryResult_CommandError.ProtoReflect.Descriptor instead.
 func (*QueryResult_CommandError) Descriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{5, 2}
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{6, 2}
 }
 
 func (x *QueryResult_CommandError) GetCommandType() QueryResult_CommandError_Type {
@@ -2339,7 +3436,7 @@ type QueryResult_Message struct {
 
 func (x *QueryResult_Message) Reset() {
 	*x = QueryResult_Message{}
-	mi := &file_v1_sql_service_proto_msgTypes[22]
+	mi := &file_v1_sql_service_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2351,7 +3448,7 @@ func (x *QueryResult_Message) String() string {
 func (*QueryResult_Message) ProtoMessage() {}
 
 func (x *QueryResult_Message) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_sql_service_proto_msgTypes[22]
+	mi := &file_v1_sql_service_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2364,7 +3461,7 @@ func (x *QueryResult_Message) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryResult_Message.ProtoReflect.Descriptor instead.
 func (*QueryResult_Message) Descriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{5, 3}
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{6, 3}
 }
 
 func (x *QueryResult_Message) GetLevel() QueryResult_Message_Level {
@@ -2392,7 +3489,7 @@ type RowValue_Timestamp struct {
 
 func (x *RowValue_Timestamp) Reset() {
 	*x = RowValue_Timestamp{}
-	mi := &file_v1_sql_service_proto_msgTypes[23]
+	mi := &file_v1_sql_service_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2404,7 +3501,7 @@ func (x *RowValue_Timestamp) String() string {
 func (*RowValue_Timestamp) ProtoMessage() {}
 
 func (x *RowValue_Timestamp) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_sql_service_proto_msgTypes[23]
+	mi := &file_v1_sql_service_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2417,7 +3514,7 @@ func (x *RowValue_Timestamp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RowValue_Timestamp.ProtoReflect.Descriptor instead.
 func (*RowValue_Timestamp) Descriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{8, 0}
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{13, 0}
 }
 
 func (x *RowValue_Timestamp) GetGoogleTimestamp() *timestamppb.Timestamp {
@@ -2453,7 +3550,7 @@ type RowValue_TimestampTZ struct {
 
 func (x *RowValue_TimestampTZ) Reset() {
 	*x = RowValue_TimestampTZ{}
-	mi := &file_v1_sql_service_proto_msgTypes[24]
+	mi := &file_v1_sql_service_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2465,7 +3562,7 @@ func (x *RowValue_TimestampTZ) String() string {
 func (*RowValue_TimestampTZ) ProtoMessage() {}
 
 func (x *RowValue_TimestampTZ) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_sql_service_proto_msgTypes[24]
+	mi := &file_v1_sql_service_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2478,7 +3575,7 @@ func (x *RowValue_TimestampTZ) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RowValue_TimestampTZ.ProtoReflect.Descriptor instead.
 func (*RowValue_TimestampTZ) Descriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{8, 1}
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{13, 1}
 }
 
 func (x *RowValue_TimestampTZ) GetGoogleTimestamp() *timestamppb.Timestamp {
@@ -2509,6 +3606,71 @@ func (x *RowValue_TimestampTZ) GetAccuracy() int32 {
 	return 0
 }
 
+type ExportWatermark_File struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The file name in the archive.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The SHA-256 hex digest of the file content.
+	Sha256 string `protobuf:"bytes,2,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	// Whether the watermark is embedded in the file content.
+	// SQL files carry it in a leading comment and XLSX files in the document description.
+	// CSV and JSON files have no place for it.
+	Marked        bool `protobuf:"varint,3,opt,name=marked,proto3" json:"marked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportWatermark_File) Reset() {
+	*x = ExportWatermark_File{}
+	mi := &file_v1_sql_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportWatermark_File) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportWatermark_File) ProtoMessage() {}
+
+func (x *ExportWatermark_File) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_sql_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportWatermark_File.ProtoReflect.Descriptor instead.
+func (*ExportWatermark_File) Descriptor() ([]byte, []int) {
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{18, 0}
+}
+
+func (x *ExportWatermark_File) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExportWatermark_File) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *ExportWatermark_File) GetMarked() bool {
+	if x != nil {
+		return x.Marked
+	}
+	return false
+}
+
 type AICompletionRequest_Message struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Role          string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
@@ -2519,7 +3681,7 @@ type AICompletionRequest_Message struct {
 
 func (x *AICompletionRequest_Message) Reset() {
 	*x = AICompletionRequest_Message{}
-	mi := &file_v1_sql_service_proto_msgTypes[25]
+	mi := &file_v1_sql_service_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2531,7 +3693,7 @@ func (x *AICompletionRequest_Message) String() string {
 func (*AICompletionRequest_Message) ProtoMessage() {}
 
 func (x *AICompletionRequest_Message) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_sql_service_proto_msgTypes[25]
+	mi := &file_v1_sql_service_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2544,7 +3706,7 @@ func (x *AICompletionRequest_Message) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AICompletionRequest_Message.ProtoReflect.Descriptor instead.
 func (*AICompletionRequest_Message) Descriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{17, 0}
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{29, 0}
 }
 
 func (x *AICompletionRequest_Message) GetRole() string {
@@ -2570,7 +3732,7 @@ type AICompletionResponse_Candidate struct {
 
 func (x *AICompletionResponse_Candidate) Reset() {
 	*x = AICompletionResponse_Candidate{}
-	mi := &file_v1_sql_service_proto_msgTypes[26]
+	mi := &file_v1_sql_service_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2582,7 +3744,7 @@ func (x *AICompletionResponse_Candidate) String() string {
 func (*AICompletionResponse_Candidate) ProtoMessage() {}
 
 func (x *AICompletionResponse_Candidate) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_sql_service_proto_msgTypes[26]
+	mi := &file_v1_sql_service_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2595,7 +3757,7 @@ func (x *AICompletionResponse_Candidate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AICompletionResponse_Candidate.ProtoReflect.Descriptor instead.
 func (*AICompletionResponse_Candidate) Descriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{18, 0}
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{30, 0}
 }
 
 func (x *AICompletionResponse_Candidate) GetContent() *AICompletionResponse_Candidate_Content {
@@ -2615,7 +3777,7 @@ type AICompletionResponse_Candidate_Content struct {
 
 func (x *AICompletionResponse_Candidate_Content) Reset() {
 	*x = AICompletionResponse_Candidate_Content{}
-	mi := &file_v1_sql_service_proto_msgTypes[27]
+	mi := &file_v1_sql_service_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2627,7 +3789,7 @@ func (x *AICompletionResponse_Candidate_Content) String() string {
 func (*AICompletionResponse_Candidate_Content) ProtoMessage() {}
 
 func (x *AICompletionResponse_Candidate_Content) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_sql_service_proto_msgTypes[27]
+	mi := &file_v1_sql_service_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2640,7 +3802,7 @@ func (x *AICompletionResponse_Candidate_Content) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use AICompletionResponse_Candidate_Content.ProtoReflect.Descriptor instead.
 func (*AICompletionResponse_Candidate_Content) Descriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{18, 0, 0}
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{30, 0, 0}
 }
 
 func (x *AICompletionResponse_Candidate_Content) GetParts() []*AICompletionResponse_Candidate_Content_Part {
@@ -2659,7 +3821,7 @@ type AICompletionResponse_Candidate_Content_Part struct {
 
 func (x *AICompletionResponse_Candidate_Content_Part) Reset() {
 	*x = AICompletionResponse_Candidate_Content_Part{}
-	mi := &file_v1_sql_service_proto_msgTypes[28]
+	mi := &file_v1_sql_service_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2671,7 +3833,7 @@ func (x *AICompletionResponse_Candidate_Content_Part) String() string {
 func (*AICompletionResponse_Candidate_Content_Part) ProtoMessage() {}
 
 func (x *AICompletionResponse_Candidate_Content_Part) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_sql_service_proto_msgTypes[28]
+	mi := &file_v1_sql_service_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2684,7 +3846,7 @@ func (x *AICompletionResponse_Candidate_Content_Part) ProtoReflect() protoreflec
 
 // Deprecated: Use AICompletionResponse_Candidate_Content_Part.ProtoReflect.Descriptor instead.
 func (*AICompletionResponse_Candidate_Content_Part) Descriptor() ([]byte, []int) {
-	return file_v1_sql_service_proto_rawDescGZIP(), []int{18, 0, 0, 0}
+	return file_v1_sql_service_proto_rawDescGZIP(), []int{30, 0, 0, 0}
 }
 
 func (x *AICompletionResponse_Candidate_Content_Part) GetText() string {
@@ -2698,19 +3860,20 @@ var File_v1_sql_service_proto protoreflect.FileDescriptor
 
 const file_v1_sql_service_proto_rawDesc = "" +
 	"\n" +
-	"\x14v1/sql_service.proto\x12\vbytebase.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x13v1/annotation.proto\x1a\x0fv1/common.proto\x1a\x19v1/database_service.proto\"\xd5\x01\n" +
+	"\x14v1/sql_service.proto\x12\vbytebase.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x13v1/annotation.proto\x1a\x0fv1/common.proto\x1a\x19v1/database_service.proto\x1a\x1av1/operation_service.proto\"\x9c\x02\n" +
 	"\x13AdminExecuteRequest\x121\n" +
 	"\x04name\x18\x01 \x01(\tB\x1d\xe0A\x02\xfaA\x17\n" +
 	"\x15bytebase.com/DatabaseR\x04name\x12\x1c\n" +
 	"\tstatement\x18\x02 \x01(\tR\tstatement\x12\x14\n" +
 	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x1b\n" +
 	"\x06schema\x18\x04 \x01(\tH\x00R\x06schema\x88\x01\x01\x12!\n" +
-	"\tcontainer\x18\x05 \x01(\tH\x01R\tcontainer\x88\x01\x01B\t\n" +
+	"\tcontainer\x18\x05 \x01(\tH\x01R\tcontainer\x88\x01\x01\x12E\n" +
+	"\rjustification\x18\x06 \x01(\v2\x1f.bytebase.v1.QueryJustificationR\rjustificationB\t\n" +
 	"\a_schemaB\f\n" +
 	"\n" +
 	"_container\"J\n" +
 	"\x14AdminExecuteResponse\x122\n" +
-	"\aresults\x18\x01 \x03(\v2\x18.bytebase.v1.QueryResultR\aresults\"\xcb\x02\n" +
+	"\aresults\x18\x01 \x03(\v2\x18.bytebase.v1.QueryResultR\aresults\"\xf7\x04\n" +
 	"\fQueryRequest\x121\n" +
 	"\x04name\x18\x01 \x01(\tB\x1d\xe0A\x02\xfaA\x17\n" +
 	"\x15bytebase.com/DatabaseR\x04name\x12\x1c\n" +
@@ -2720,10 +3883,25 @@ const file_v1_sql_service_proto_rawDesc = "" +
 	"\aexplain\x18\x05 \x01(\bR\aexplain\x12\x1b\n" +
 	"\x06schema\x18\x06 \x01(\tH\x00R\x06schema\x88\x01\x01\x12;\n" +
 	"\fquery_option\x18\a \x01(\v2\x18.bytebase.v1.QueryOptionR\vqueryOption\x12!\n" +
-	"\tcontainer\x18\b \x01(\tH\x01R\tcontainer\x88\x01\x01B\t\n" +
+	"\tcontainer\x18\b \x01(\tH\x01R\tcontainer\x88\x01\x01\x12\x1c\n" +
+	"\tworksheet\x18\t \x01(\tR\tworksheet\x12I\n" +
+	"\n" +
+	"parameters\x18\n" +
+	" \x03(\v2).bytebase.v1.QueryRequest.ParametersEntryR\n" +
+	"parameters\x12\x1d\n" +
+	"\n" +
+	"skip_cache\x18\v \x01(\bR\tskipCache\x12\x1c\n" +
+	"\tfederated\x18\f \x01(\bR\tfederated\x12E\n" +
+	"\rjustification\x18\r \x01(\v2\x1f.bytebase.v1.QueryJustificationR\rjustification\x1a=\n" +
+	"\x0fParametersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\t\n" +
 	"\a_schemaB\f\n" +
 	"\n" +
-	"_container\"C\n" +
+	"_container\"D\n" +
+	"\x12QueryJustification\x12\x16\n" +
+	"\x06reason\x18\x01 \x01(\tR\x06reason\x12\x16\n" +
+	"\x06ticket\x18\x02 \x01(\tR\x06ticket\"C\n" +
 	"\rQueryResponse\x122\n" +
 	"\aresults\x18\x01 \x03(\v2\x18.bytebase.v1.QueryResultR\aresults\"\xa1\x03\n" +
 	"\vQueryOption\x12^\n" +
@@ -2736,7 +3914,7 @@ const file_v1_sql_service_proto_rawDesc = "" +
 	"\x12MSSQLExplainFormat\x12$\n" +
 	" MSSQL_EXPLAIN_FORMAT_UNSPECIFIED\x10\x00\x12\x1c\n" +
 	"\x18MSSQL_EXPLAIN_FORMAT_ALL\x10\x01\x12\x1c\n" +
-	"\x18MSSQL_EXPLAIN_FORMAT_XML\x10\x02\"\x8b\r\n" +
+	"\x18MSSQL_EXPLAIN_FORMAT_XML\x10\x02\"\x9e\x0e\n" +
 	"\vQueryResult\x12!\n" +
 	"\fcolumn_names\x18\x01 \x03(\tR\vcolumnNames\x12*\n" +
 	"\x11column_type_names\x18\x02 \x03(\tR\x0fcolumnTypeNames\x12)\n" +
@@ -2752,7 +3930,10 @@ const file_v1_sql_service_proto_rawDesc = "" +
 	" \x01(\v2#.bytebase.v1.PermissionDeniedDetailH\x00R\x10permissionDenied\x12L\n" +
 	"\rcommand_error\x18\r \x01(\v2%.bytebase.v1.QueryResult.CommandErrorH\x00R\fcommandError\x12<\n" +
 	"\bmessages\x18\v \x03(\v2 .bytebase.v1.QueryResult.MessageR\bmessages\x122\n" +
-	"\x06masked\x18\f \x03(\v2\x1a.bytebase.v1.MaskingReasonR\x06masked\x1a\xfd\x03\n" +
+	"\x06masked\x18\f \x03(\v2\x1a.bytebase.v1.MaskingReasonR\x06masked\x12*\n" +
+	"\x04plan\x18\x0e \x01(\v2\x16.bytebase.v1.QueryPlanR\x04plan\x12\x16\n" +
+	"\x06cached\x18\x0f \x01(\bR\x06cached\x12M\n" +
+	"\x13applied_row_filters\x18\x10 \x03(\v2\x1d.bytebase.v1.AppliedRowFilterR\x11appliedRowFilters\x1a\xfd\x03\n" +
 	"\rPostgresError\x12\x1a\n" +
 	"\bseverity\x18\x01 \x01(\tR\bseverity\x12\x12\n" +
 	"\x04code\x18\x02 \x01(\tR\x04code\x12\x18\n" +
@@ -2796,7 +3977,40 @@ const file_v1_sql_service_proto_rawDesc = "" +
 	"\n" +
 	"\x06NOTICE\x10\x05\x12\r\n" +
 	"\tEXCEPTION\x10\x06B\x10\n" +
-	"\x0edetailed_error\"\xaa\x02\n" +
+	"\x0edetailed_error\"N\n" +
+	"\x10AppliedRowFilter\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x14\n" +
+	"\x05table\x18\x03 \x01(\tR\x05table\"v\n" +
+	"\tQueryPlan\x12.\n" +
+	"\x04root\x18\x01 \x01(\v2\x1a.bytebase.v1.QueryPlanNodeR\x04root\x129\n" +
+	"\bwarnings\x18\x02 \x03(\v2\x1d.bytebase.v1.QueryPlanWarningR\bwarnings\"\xa0\x03\n" +
+	"\rQueryPlanNode\x12\x1b\n" +
+	"\tnode_type\x18\x01 \x01(\tR\bnodeType\x12\x1a\n" +
+	"\brelation\x18\x02 \x01(\tR\brelation\x12\x14\n" +
+	"\x05index\x18\x03 \x01(\tR\x05index\x12\x16\n" +
+	"\x06filter\x18\x04 \x01(\tR\x06filter\x12*\n" +
+	"\x0eestimated_rows\x18\x05 \x01(\x01H\x00R\restimatedRows\x88\x01\x01\x12$\n" +
+	"\vactual_rows\x18\x06 \x01(\x01H\x01R\n" +
+	"actualRows\x88\x01\x01\x12\x17\n" +
+	"\x04cost\x18\a \x01(\x01H\x02R\x04cost\x88\x01\x01\x12)\n" +
+	"\x0eactual_time_ms\x18\b \x01(\x01H\x03R\factualTimeMs\x88\x01\x01\x12\x1b\n" +
+	"\tfull_scan\x18\t \x01(\bR\bfullScan\x126\n" +
+	"\bchildren\x18\n" +
+	" \x03(\v2\x1a.bytebase.v1.QueryPlanNodeR\bchildrenB\x11\n" +
+	"\x0f_estimated_rowsB\x0e\n" +
+	"\f_actual_rowsB\a\n" +
+	"\x05_costB\x11\n" +
+	"\x0f_actual_time_ms\"\xd4\x01\n" +
+	"\x10QueryPlanWarning\x126\n" +
+	"\x04type\x18\x01 \x01(\x0e2\".bytebase.v1.QueryPlanWarning.TypeR\x04type\x12\x1a\n" +
+	"\brelation\x18\x02 \x01(\tR\brelation\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x12\x12\n" +
+	"\x04code\x18\x04 \x01(\x05R\x04code\">\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\r\n" +
+	"\tFULL_SCAN\x10\x01\x12\x11\n" +
+	"\rMISSING_INDEX\x10\x02\"\xaa\x02\n" +
 	"\rMaskingReason\x12(\n" +
 	"\x10semantic_type_id\x18\x01 \x01(\tR\x0esemanticTypeId\x12.\n" +
 	"\x13semantic_type_title\x18\x02 \x01(\tR\x11semanticTypeTitle\x12&\n" +
@@ -2855,7 +4069,7 @@ const file_v1_sql_service_proto_rawDesc = "" +
 	"\x15RULE_TYPE_UNSPECIFIED\x10\x00\x12\x10\n" +
 	"\fPARSER_BASED\x10\x01\x12\x0e\n" +
 	"\n" +
-	"AI_POWERED\x10\x02\"\xa9\x02\n" +
+	"AI_POWERED\x10\x02\"\xad\x03\n" +
 	"\rExportRequest\x121\n" +
 	"\x04name\x18\x01 \x01(\tB\x1d\xe0A\x02\xfaA\x17\n" +
 	"\x15bytebase.com/DatabaseR\x04name\x12\x1c\n" +
@@ -2865,16 +4079,46 @@ const file_v1_sql_service_proto_rawDesc = "" +
 	"\x05admin\x18\x05 \x01(\bR\x05admin\x12\x1a\n" +
 	"\bpassword\x18\x06 \x01(\tR\bpassword\x12$\n" +
 	"\x0edata_source_id\x18\a \x01(\tR\fdataSourceId\x12\x1b\n" +
-	"\x06schema\x18\b \x01(\tH\x00R\x06schema\x88\x01\x01B\t\n" +
-	"\a_schema\"*\n" +
+	"\x06schema\x18\b \x01(\tH\x00R\x06schema\x88\x01\x01\x12E\n" +
+	"\rjustification\x18\t \x01(\v2\x1f.bytebase.v1.QueryJustificationR\rjustification\x12\x14\n" +
+	"\x05async\x18\n" +
+	" \x01(\bR\x05async\x12%\n" +
+	"\x0eexport_archive\x18\v \x01(\tR\rexportArchiveB\t\n" +
+	"\a_schema\"\x87\x01\n" +
 	"\x0eExportResponse\x12\x18\n" +
-	"\acontent\x18\x01 \x01(\fR\acontent\"\xdc\x01\n" +
+	"\acontent\x18\x01 \x01(\fR\acontent\x124\n" +
+	"\toperation\x18\x02 \x01(\v2\x16.bytebase.v1.OperationR\toperation\x12%\n" +
+	"\x0eexport_archive\x18\x03 \x01(\tR\rexportArchive\"Y\n" +
+	"\x1cVerifyExportWatermarkRequest\x12\x1d\n" +
+	"\acontent\x18\x01 \x01(\fB\x03\xe0A\x02R\acontent\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"\xda\x02\n" +
+	"\x0fExportWatermark\x12\x1a\n" +
+	"\bexporter\x18\x01 \x01(\tR\bexporter\x12;\n" +
+	"\vexport_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"exportTime\x12\x16\n" +
+	"\x06source\x18\x03 \x01(\tR\x06source\x12)\n" +
+	"\x10statement_sha256\x18\x04 \x01(\tR\x0fstatementSha256\x12\x16\n" +
+	"\x06masked\x18\x05 \x01(\bR\x06masked\x127\n" +
+	"\x05files\x18\x06 \x03(\v2!.bytebase.v1.ExportWatermark.FileR\x05files\x12\x0e\n" +
+	"\x02id\x18\a \x01(\tR\x02id\x1aJ\n" +
+	"\x04File\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06sha256\x18\x02 \x01(\tR\x06sha256\x12\x16\n" +
+	"\x06marked\x18\x03 \x01(\bR\x06marked\"\xdc\x01\n" +
 	"\x13DiffMetadataRequest\x12K\n" +
 	"\x0fsource_metadata\x18\x01 \x01(\v2\x1d.bytebase.v1.DatabaseMetadataB\x03\xe0A\x02R\x0esourceMetadata\x12K\n" +
 	"\x0ftarget_metadata\x18\x02 \x01(\v2\x1d.bytebase.v1.DatabaseMetadataB\x03\xe0A\x02R\x0etargetMetadata\x12+\n" +
 	"\x06engine\x18\x03 \x01(\x0e2\x13.bytebase.v1.EngineR\x06engine\"*\n" +
 	"\x14DiffMetadataResponse\x12\x12\n" +
-	"\x04diff\x18\x01 \x01(\tR\x04diff\"q\n" +
+	"\x04diff\x18\x01 \x01(\tR\x04diff\"\xa7\x01\n" +
+	"\x16FormatStatementRequest\x12!\n" +
+	"\tstatement\x18\x01 \x01(\tB\x03\xe0A\x02R\tstatement\x120\n" +
+	"\x06engine\x18\x02 \x01(\x0e2\x13.bytebase.v1.EngineB\x03\xe0A\x02R\x06engine\x12\x1f\n" +
+	"\vindent_size\x18\x03 \x01(\x05R\n" +
+	"indentSize\x12\x17\n" +
+	"\ause_tab\x18\x04 \x01(\bR\x06useTab\"7\n" +
+	"\x17FormatStatementResponse\x12\x1c\n" +
+	"\tstatement\x18\x01 \x01(\tR\tstatement\"q\n" +
 	"\x1bSearchQueryHistoriesRequest\x12\x1b\n" +
 	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
@@ -2882,7 +4126,7 @@ const file_v1_sql_service_proto_rawDesc = "" +
 	"\x06filter\x18\x03 \x01(\tR\x06filter\"\x8f\x01\n" +
 	"\x1cSearchQueryHistoriesResponse\x12G\n" +
 	"\x0fquery_histories\x18\x01 \x03(\v2\x19.bytebase.v1.QueryHistoryB\x03\xe0A\x03R\x0equeryHistories\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x9b\x03\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x8f\x04\n" +
 	"\fQueryHistory\x12\x17\n" +
 	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x03R\x04name\x12\x1f\n" +
 	"\bdatabase\x18\x02 \x01(\tB\x03\xe0A\x03R\bdatabase\x12\x1d\n" +
@@ -2892,13 +4136,35 @@ const file_v1_sql_service_proto_rawDesc = "" +
 	"\tstatement\x18\x05 \x01(\tB\x03\xe0A\x03R\tstatement\x12\x1e\n" +
 	"\x05error\x18\x06 \x01(\tB\x03\xe0A\x03H\x00R\x05error\x88\x01\x01\x12:\n" +
 	"\bduration\x18\a \x01(\v2\x19.google.protobuf.DurationB\x03\xe0A\x03R\bduration\x122\n" +
-	"\x04type\x18\b \x01(\x0e2\x1e.bytebase.v1.QueryHistory.TypeR\x04type\"3\n" +
+	"\x04type\x18\b \x01(\x0e2\x1e.bytebase.v1.QueryHistory.TypeR\x04type\x12&\n" +
+	"\faccess_grant\x18\t \x01(\tB\x03\xe0A\x03R\vaccessGrant\x12J\n" +
+	"\rjustification\x18\n" +
+	" \x01(\v2\x1f.bytebase.v1.QueryJustificationB\x03\xe0A\x03R\rjustification\"3\n" +
 	"\x04Type\x12\x14\n" +
 	"\x10TYPE_UNSPECIFIED\x10\x00\x12\t\n" +
 	"\x05QUERY\x10\x01\x12\n" +
 	"\n" +
 	"\x06EXPORT\x10\x02B\b\n" +
-	"\x06_error\"\x94\x01\n" +
+	"\x06_error\"\xb8\x01\n" +
+	"\x1dCreateQueryResultShareRequest\x124\n" +
+	"\x06parent\x18\x01 \x01(\tB\x1c\xe0A\x02\xfaA\x16\n" +
+	"\x14bytebase.com/ProjectR\x06parent\x124\n" +
+	"\x05query\x18\x02 \x01(\v2\x19.bytebase.v1.QueryRequestB\x03\xe0A\x02R\x05query\x12+\n" +
+	"\x03ttl\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x03ttl\"W\n" +
+	"\x1aGetQueryResultShareRequest\x129\n" +
+	"\x04name\x18\x01 \x01(\tB%\xe0A\x02\xfaA\x1f\n" +
+	"\x1dbytebase.com/QueryResultShareR\x04name\"\x9d\x03\n" +
+	"\x10QueryResultShare\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x03R\x04name\x12\x1f\n" +
+	"\bdatabase\x18\x02 \x01(\tB\x03\xe0A\x03R\bdatabase\x12!\n" +
+	"\tstatement\x18\x03 \x01(\tB\x03\xe0A\x03R\tstatement\x12\x1d\n" +
+	"\acreator\x18\x04 \x01(\tB\x03\xe0A\x03R\acreator\x12@\n" +
+	"\vcreate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12@\n" +
+	"\vexpire_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"expireTime\x127\n" +
+	"\aresults\x18\a \x03(\v2\x18.bytebase.v1.QueryResultB\x03\xe0A\x03R\aresults:P\xeaAM\n" +
+	"\x1dbytebase.com/QueryResultShare\x12,projects/{project}/queryResultShares/{share}\"\x94\x01\n" +
 	"\x13AICompletionRequest\x12D\n" +
 	"\bmessages\x18\x01 \x03(\v2(.bytebase.v1.AICompletionRequest.MessageR\bmessages\x1a7\n" +
 	"\aMessage\x12\x12\n" +
@@ -2913,14 +4179,18 @@ const file_v1_sql_service_proto_rawDesc = "" +
 	"\aContent\x12N\n" +
 	"\x05parts\x18\x01 \x03(\v28.bytebase.v1.AICompletionResponse.Candidate.Content.PartR\x05parts\x1a\x1a\n" +
 	"\x04Part\x12\x12\n" +
-	"\x04text\x18\x01 \x01(\tR\x04text2\xc7\a\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text2\xe5\f\n" +
 	"\n" +
 	"SQLService\x12\x8f\x01\n" +
 	"\x05Query\x12\x19.bytebase.v1.QueryRequest\x1a\x1a.bytebase.v1.QueryResponse\"O\x8a\xea0\x10bb.databases.get\x90\xea0\x01\x98\xea0\x01\x82\xd3\xe4\x93\x02-:\x01*\"(/v1/{name=instances/*/databases/*}:query\x12\x89\x01\n" +
-	"\fAdminExecute\x12 .bytebase.v1.AdminExecuteRequest\x1a!.bytebase.v1.AdminExecuteResponse\"0\x8a\xea0\fbb.sql.admin\x90\xea0\x01\x98\xea0\x01\x82\xd3\xe4\x93\x02\x12\x12\x10/v1:adminExecute(\x010\x01\x12\x95\x01\n" +
+	"\fAdminExecute\x12 .bytebase.v1.AdminExecuteRequest\x1a!.bytebase.v1.AdminExecuteResponse\"0\x8a\xea0\fbb.sql.admin\x90\xea0\x02\x98\xea0\x01\x82\xd3\xe4\x93\x02\x12\x12\x10/v1:adminExecute(\x010\x01\x12\xc4\x01\n" +
+	"\x16CreateQueryResultShare\x12*.bytebase.v1.CreateQueryResultShareRequest\x1a\x1d.bytebase.v1.QueryResultShare\"_\xdaA\fparent,query\x8a\xea0\x10bb.databases.get\x90\xea0\x01\x98\xea0\x01\x82\xd3\xe4\x93\x02.:\x01*\")/v1/{parent=projects/*}/queryResultShares\x12\xaf\x01\n" +
+	"\x13GetQueryResultShare\x12'.bytebase.v1.GetQueryResultShareRequest\x1a\x1d.bytebase.v1.QueryResultShare\"P\xdaA\x04name\x8a\xea0\x10bb.databases.get\x90\xea0\x01\x82\xd3\xe4\x93\x02+\x12)/v1/{name=projects/*/queryResultShares/*}\x12\x95\x01\n" +
 	"\x14SearchQueryHistories\x12(.bytebase.v1.SearchQueryHistoriesRequest\x1a).bytebase.v1.SearchQueryHistoriesResponse\"(\x90\xea0\x02\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/queryHistories:search\x12\x84\x02\n" +
-	"\x06Export\x12\x1a.bytebase.v1.ExportRequest\x1a\x1b.bytebase.v1.ExportResponse\"\xc0\x01\x8a\xea0\x10bb.databases.get\x90\xea0\x01\x98\xea0\x01\x82\xd3\xe4\x93\x02\x9d\x01:\x01*Z1:\x01*\",/v1/{name=projects/*/plans/*/rollout}:exportZ::\x01*\"5/v1/{name=projects/*/plans/*/rollout/stages/*}:export\")/v1/{name=instances/*/databases/*}:export\x12\x81\x01\n" +
-	"\fDiffMetadata\x12 .bytebase.v1.DiffMetadataRequest\x1a!.bytebase.v1.DiffMetadataResponse\",\x80\xea0\x01\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/schemaDesign:diffMetadata\x12x\n" +
+	"\x06Export\x12\x1a.bytebase.v1.ExportRequest\x1a\x1b.bytebase.v1.ExportResponse\"\xc0\x01\x8a\xea0\x10bb.databases.get\x90\xea0\x01\x98\xea0\x01\x82\xd3\xe4\x93\x02\x9d\x01:\x01*Z1:\x01*\",/v1/{name=projects/*/plans/*/rollout}:exportZ::\x01*\"5/v1/{name=projects/*/plans/*/rollout/stages/*}:export\")/v1/{name=instances/*/databases/*}:export\x12\xa5\x01\n" +
+	"\x15VerifyExportWatermark\x12).bytebase.v1.VerifyExportWatermarkRequest\x1a\x1c.bytebase.v1.ExportWatermark\"C\x8a\xea0\x13bb.auditLogs.search\x90\xea0\x01\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/sql:verifyExportWatermark\x12\x81\x01\n" +
+	"\fDiffMetadata\x12 .bytebase.v1.DiffMetadataRequest\x1a!.bytebase.v1.DiffMetadataResponse\",\x80\xea0\x01\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/schemaDesign:diffMetadata\x12{\n" +
+	"\x0fFormatStatement\x12#.bytebase.v1.FormatStatementRequest\x1a$.bytebase.v1.FormatStatementResponse\"\x1d\x80\xea0\x01\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/sql:format\x12x\n" +
 	"\fAICompletion\x12 .bytebase.v1.AICompletionRequest\x1a!.bytebase.v1.AICompletionResponse\"#\x90\xea0\x02\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/sql/aiCompletionB\xa5\x01\n" +
 	"\x0fcom.bytebase.v1B\x0fSqlServiceProtoP\x01Z4github.com/bytebase/bytebase/backend/generated-go/v1\xa2\x02\x03BXX\xaa\x02\vBytebase.V1\xca\x02\vBytebase\\V1\xe2\x02\x17Bytebase\\V1\\GPBMetadata\xea\x02\fBytebase::V1b\x06proto3"
 
@@ -2936,112 +4206,156 @@ func file_v1_sql_service_proto_rawDescGZIP() []byte {
 	return file_v1_sql_service_proto_rawDescData
 }
 
-var file_v1_sql_service_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
-var file_v1_sql_service_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
+var file_v1_sql_service_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_v1_sql_service_proto_msgTypes = make([]protoimpl.MessageInfo, 43)
 var file_v1_sql_service_proto_goTypes = []any{
 	(QueryOption_RedisRunCommandsOn)(0),                 // 0: bytebase.v1.QueryOption.RedisRunCommandsOn
 	(QueryOption_MSSQLExplainFormat)(0),                 // 1: bytebase.v1.QueryOption.MSSQLExplainFormat
 	(QueryResult_CommandError_Type)(0),                  // 2: bytebase.v1.QueryResult.CommandError.Type
 	(QueryResult_Message_Level)(0),                      // 3: bytebase.v1.QueryResult.Message.Level
-	(Advice_Level)(0),                                   // 4: bytebase.v1.Advice.Level
-	(Advice_RuleType)(0),                                // 5: bytebase.v1.Advice.RuleType
-	(QueryHistory_Type)(0),                              // 6: bytebase.v1.QueryHistory.Type
-	(*AdminExecuteRequest)(nil),                         // 7: bytebase.v1.AdminExecuteRequest
-	(*AdminExecuteResponse)(nil),                        // 8: bytebase.v1.AdminExecuteResponse
-	(*QueryRequest)(nil),                                // 9: bytebase.v1.QueryRequest
-	(*QueryResponse)(nil),                               // 10: bytebase.v1.QueryResponse
-	(*QueryOption)(nil),                                 // 11: bytebase.v1.QueryOption
-	(*QueryResult)(nil),                                 // 12: bytebase.v1.QueryResult
-	(*MaskingReason)(nil),                               // 13: bytebase.v1.MaskingReason
-	(*QueryRow)(nil),                                    // 14: bytebase.v1.QueryRow
-	(*RowValue)(nil),                                    // 15: bytebase.v1.RowValue
-	(*Advice)(nil),                                      // 16: bytebase.v1.Advice
-	(*ExportRequest)(nil),                               // 17: bytebase.v1.ExportRequest
-	(*ExportResponse)(nil),                              // 18: bytebase.v1.ExportResponse
-	(*DiffMetadataRequest)(nil),                         // 19: bytebase.v1.DiffMetadataRequest
-	(*DiffMetadataResponse)(nil),                        // 20: bytebase.v1.DiffMetadataResponse
-	(*SearchQueryHistoriesRequest)(nil),                 // 21: bytebase.v1.SearchQueryHistoriesRequest
-	(*SearchQueryHistoriesResponse)(nil),                // 22: bytebase.v1.SearchQueryHistoriesResponse
-	(*QueryHistory)(nil),                                // 23: bytebase.v1.QueryHistory
-	(*AICompletionRequest)(nil),                         // 24: bytebase.v1.AICompletionRequest
-	(*AICompletionResponse)(nil),                        // 25: bytebase.v1.AICompletionResponse
-	(*QueryResult_PostgresError)(nil),                   // 26: bytebase.v1.QueryResult.PostgresError
-	(*QueryResult_SyntaxError)(nil),                     // 27: bytebase.v1.QueryResult.SyntaxError
-	(*QueryResult_CommandError)(nil),                    // 28: bytebase.v1.QueryResult.CommandError
-	(*QueryResult_Message)(nil),                         // 29: bytebase.v1.QueryResult.Message
-	(*RowValue_Timestamp)(nil),                          // 30: bytebase.v1.RowValue.Timestamp
-	(*RowValue_TimestampTZ)(nil),                        // 31: bytebase.v1.RowValue.TimestampTZ
-	(*AICompletionRequest_Message)(nil),                 // 32: bytebase.v1.AICompletionRequest.Message
-	(*AICompletionResponse_Candidate)(nil),              // 33: bytebase.v1.AICompletionResponse.Candidate
-	(*AICompletionResponse_Candidate_Content)(nil),      // 34: bytebase.v1.AICompletionResponse.Candidate.Content
-	(*AICompletionResponse_Candidate_Content_Part)(nil), // 35: bytebase.v1.AICompletionResponse.Candidate.Content.Part
-	(*durationpb.Duration)(nil),                         // 36: google.protobuf.Duration
-	(*PermissionDeniedDetail)(nil),                      // 37: bytebase.v1.PermissionDeniedDetail
-	(structpb.NullValue)(0),                             // 38: google.protobuf.NullValue
-	(*structpb.Value)(nil),                              // 39: google.protobuf.Value
-	(*Position)(nil),                                    // 40: bytebase.v1.Position
-	(ExportFormat)(0),                                   // 41: bytebase.v1.ExportFormat
-	(*DatabaseMetadata)(nil),                            // 42: bytebase.v1.DatabaseMetadata
-	(Engine)(0),                                         // 43: bytebase.v1.Engine
-	(*timestamppb.Timestamp)(nil),                       // 44: google.protobuf.Timestamp
+	(QueryPlanWarning_Type)(0),                          // 4: bytebase.v1.QueryPlanWarning.Type
+	(Advice_Level)(0),                                   // 5: bytebase.v1.Advice.Level
+	(Advice_RuleType)(0),                                // 6: bytebase.v1.Advice.RuleType
+	(QueryHistory_Type)(0),                              // 7: bytebase.v1.QueryHistory.Type
+	(*AdminExecuteRequest)(nil),                         // 8: bytebase.v1.AdminExecuteRequest
+	(*AdminExecuteResponse)(nil),                        // 9: bytebase.v1.AdminExecuteResponse
+	(*QueryRequest)(nil),                                // 10: bytebase.v1.QueryRequest
+	(*QueryJustification)(nil),                          // 11: bytebase.v1.QueryJustification
+	(*QueryResponse)(nil),                               // 12: bytebase.v1.QueryResponse
+	(*QueryOption)(nil),                                 // 13: bytebase.v1.QueryOption
+	(*QueryResult)(nil),                                 // 14: bytebase.v1.QueryResult
+	(*AppliedRowFilter)(nil),                            // 15: bytebase.v1.AppliedRowFilter
+	(*QueryPlan)(nil),                                   // 16: bytebase.v1.QueryPlan
+	(*QueryPlanNode)(nil),                               // 17: bytebase.v1.QueryPlanNode
+	(*QueryPlanWarning)(nil),                            // 18: bytebase.v1.QueryPlanWarning
+	(*MaskingReason)(nil),                               // 19: bytebase.v1.MaskingReason
+	(*QueryRow)(nil),                                    // 20: bytebase.v1.QueryRow
+	(*RowValue)(nil),                                    // 21: bytebase.v1.RowValue
+	(*Advice)(nil),                                      // 22: bytebase.v1.Advice
+	(*ExportRequest)(nil),                               // 23: bytebase.v1.ExportRequest
+	(*ExportResponse)(nil),                              // 24: bytebase.v1.ExportResponse
+	(*VerifyExportWatermarkRequest)(nil),                // 25: bytebase.v1.VerifyExportWatermarkRequest
+	(*ExportWatermark)(nil),                             // 26: bytebase.v1.ExportWatermark
+	(*DiffMetadataRequest)(nil),                         // 27: bytebase.v1.DiffMetadataRequest
+	(*DiffMetadataResponse)(nil),                        // 28: bytebase.v1.DiffMetadataResponse
+	(*FormatStatementRequest)(nil),                      // 29: bytebase.v1.FormatStatementRequest
+	(*FormatStatementResponse)(nil),                     // 30: bytebase.v1.FormatStatementResponse
+	(*SearchQueryHistoriesRequest)(nil),                 // 31: bytebase.v1.SearchQueryHistoriesRequest
+	(*SearchQueryHistoriesResponse)(nil),                // 32: bytebase.v1.SearchQueryHistoriesResponse
+	(*QueryHistory)(nil),                                // 33: bytebase.v1.QueryHistory
+	(*CreateQueryResultShareRequest)(nil),               // 34: bytebase.v1.CreateQueryResultShareRequest
+	(*GetQueryResultShareRequest)(nil),                  // 35: bytebase.v1.GetQueryResultShareRequest
+	(*QueryResultShare)(nil),                            // 36: bytebase.v1.QueryResultShare
+	(*AICompletionRequest)(nil),                         // 37: bytebase.v1.AICompletionRequest
+	(*AICompletionResponse)(nil),                        // 38: bytebase.v1.AICompletionResponse
+	nil,                                                 // 39: bytebase.v1.QueryRequest.ParametersEntry
+	(*QueryResult_PostgresError)(nil),                   // 40: bytebase.v1.QueryResult.PostgresError
+	(*QueryResult_SyntaxError)(nil),                     // 41: bytebase.v1.QueryResult.SyntaxError
+	(*QueryResult_CommandError)(nil),                    // 42: bytebase.v1.QueryResult.CommandError
+	(*QueryResult_Message)(nil),                         // 43: bytebase.v1.QueryResult.Message
+	(*RowValue_Timestamp)(nil),                          // 44: bytebase.v1.RowValue.Timestamp
+	(*RowValue_TimestampTZ)(nil),                        // 45: bytebase.v1.RowValue.TimestampTZ
+	(*ExportWatermark_File)(nil),                        // 46: bytebase.v1.ExportWatermark.File
+	(*AICompletionRequest_Message)(nil),                 // 47: bytebase.v1.AICompletionRequest.Message
+	(*AICompletionResponse_Candidate)(nil),              // 48: bytebase.v1.AICompletionResponse.Candidate
+	(*AICompletionResponse_Candidate_Content)(nil),      // 49: bytebase.v1.AICompletionResponse.Candidate.Content
+	(*AICompletionResponse_Candidate_Content_Part)(nil), // 50: bytebase.v1.AICompletionResponse.Candidate.Content.Part
+	(*durationpb.Duration)(nil),                         // 51: google.protobuf.Duration
+	(*PermissionDeniedDetail)(nil),                      // 52: bytebase.v1.PermissionDeniedDetail
+	(structpb.NullValue)(0),                             // 53: google.protobuf.NullValue
+	(*structpb.Value)(nil),                              // 54: google.protobuf.Value
+	(*Position)(nil),                                    // 55: bytebase.v1.Position
+	(ExportFormat)(0),                                   // 56: bytebase.v1.ExportFormat
+	(*Operation)(nil),                                   // 57: bytebase.v1.Operation
+	(*timestamppb.Timestamp)(nil),                       // 58: google.protobuf.Timestamp
+	(*DatabaseMetadata)(nil),                            // 59: bytebase.v1.DatabaseMetadata
+	(Engine)(0),                                         // 60: bytebase.v1.Engine
 }
 var file_v1_sql_service_proto_depIdxs = []int32{
-	12, // 0: bytebase.v1.AdminExecuteResponse.results:type_name -> bytebase.v1.QueryResult
-	11, // 1: bytebase.v1.QueryRequest.query_option:type_name -> bytebase.v1.QueryOption
-	12, // 2: bytebase.v1.QueryResponse.results:type_name -> bytebase.v1.QueryResult
-	0,  // 3: bytebase.v1.QueryOption.redis_run_commands_on:type_name -> bytebase.v1.QueryOption.RedisRunCommandsOn
-	1,  // 4: bytebase.v1.QueryOption.mssql_explain_format:type_name -> bytebase.v1.QueryOption.MSSQLExplainFormat
-	14, // 5: bytebase.v1.QueryResult.rows:type_name -> bytebase.v1.QueryRow
-	36, // 6: bytebase.v1.QueryResult.latency:type_name -> google.protobuf.Duration
-	26, // 7: bytebase.v1.QueryResult.postgres_error:type_name -> bytebase.v1.QueryResult.PostgresError
-	27, // 8: bytebase.v1.QueryResult.syntax_error:type_name -> bytebase.v1.QueryResult.SyntaxError
-	37, // 9: bytebase.v1.QueryResult.permission_denied:type_name -> bytebase.v1.PermissionDeniedDetail
-	28, // 10: bytebase.v1.QueryResult.command_error:type_name -> bytebase.v1.QueryResult.CommandError
-	29, // 11: bytebase.v1.QueryResult.messages:type_name -> bytebase.v1.QueryResult.Message
-	13, // 12: bytebase.v1.QueryResult.masked:type_name -> bytebase.v1.MaskingReason
-	15, // 13: bytebase.v1.QueryRow.values:type_name -> bytebase.v1.RowValue
-	38, // 14: bytebase.v1.RowValue.null_value:type_name -> google.protobuf.NullValue
-	39, // 15: bytebase.v1.RowValue.value_value:type_name -> google.protobuf.Value
-	30, // 16: bytebase.v1.RowValue.timestamp_value:type_name -> bytebase.v1.RowValue.Timestamp
-	31, // 17: bytebase.v1.RowValue.timestamp_tz_value:type_name -> bytebase.v1.RowValue.TimestampTZ
-	4,  // 18: bytebase.v1.Advice.status:type_name -> bytebase.v1.Advice.Level
-	40, // 19: bytebase.v1.Advice.start_position:type_name -> bytebase.v1.Position
-	40, // 20: bytebase.v1.Advice.end_position:type_name -> bytebase.v1.Position
-	5,  // 21: bytebase.v1.Advice.rule_type:type_name -> bytebase.v1.Advice.RuleType
-	41, // 22: bytebase.v1.ExportRequest.format:type_name -> bytebase.v1.ExportFormat
-	42, // 23: bytebase.v1.DiffMetadataRequest.source_metadata:type_name -> bytebase.v1.DatabaseMetadata
-	42, // 24: bytebase.v1.DiffMetadataRequest.target_metadata:type_name -> bytebase.v1.DatabaseMetadata
-	43, // 25: bytebase.v1.DiffMetadataRequest.engine:type_name -> bytebase.v1.Engine
-	23, // 26: bytebase.v1.SearchQueryHistoriesResponse.query_histories:type_name -> bytebase.v1.QueryHistory
-	44, // 27: bytebase.v1.QueryHistory.create_time:type_name -> google.protobuf.Timestamp
-	36, // 28: bytebase.v1.QueryHistory.duration:type_name -> google.protobuf.Duration
-	6,  // 29: bytebase.v1.QueryHistory.type:type_name -> bytebase.v1.QueryHistory.Type
-	32, // 30: bytebase.v1.AICompletionRequest.messages:type_name -> bytebase.v1.AICompletionRequest.Message
-	33, // 31: bytebase.v1.AICompletionResponse.candidates:type_name -> bytebase.v1.AICompletionResponse.Candidate
-	40, // 32: bytebase.v1.QueryResult.SyntaxError.start_position:type_name -> bytebase.v1.Position
-	2,  // 33: bytebase.v1.QueryResult.CommandError.command_type:type_name -> bytebase.v1.QueryResult.CommandError.Type
-	3,  // 34: bytebase.v1.QueryResult.Message.level:type_name -> bytebase.v1.QueryResult.Message.Level
-	44, // 35: bytebase.v1.RowValue.Timestamp.google_timestamp:type_name -> google.protobuf.Timestamp
-	44, // 36: bytebase.v1.RowValue.TimestampTZ.google_timestamp:type_name -> google.protobuf.Timestamp
-	34, // 37: bytebase.v1.AICompletionResponse.Candidate.content:type_name -> bytebase.v1.AICompletionResponse.Candidate.Content
-	35, // 38: bytebase.v1.AICompletionResponse.Candidate.Content.parts:type_name -> bytebase.v1.AICompletionResponse.Candidate.Content.Part
-	9,  // 39: bytebase.v1.SQLService.Query:input_type -> bytebase.v1.QueryRequest
-	7,  // 40: bytebase.v1.SQLService.AdminExecute:input_type -> bytebase.v1.AdminExecuteRequest
-	21, // 41: bytebase.v1.SQLService.SearchQueryHistories:input_type -> bytebase.v1.SearchQueryHistoriesRequest
-	17, // 42: bytebase.v1.SQLService.Export:input_type -> bytebase.v1.ExportRequest
-	19, // 43: bytebase.v1.SQLService.DiffMetadata:input_type -> bytebase.v1.DiffMetadataRequest
-	24, // 44: bytebase.v1.SQLService.AICompletion:input_type -> bytebase.v1.AICompletionRequest
-	10, // 45: bytebase.v1.SQLService.Query:output_type -> bytebase.v1.QueryResponse
-	8,  // 46: bytebase.v1.SQLService.AdminExecute:output_type -> bytebase.v1.AdminExecuteResponse
-	22, // 47: bytebase.v1.SQLService.SearchQueryHistories:output_type -> bytebase.v1.SearchQueryHistoriesResponse
-	18, // 48: bytebase.v1.SQLService.Export:output_type -> bytebase.v1.ExportResponse
-	20, // 49: bytebase.v1.SQLService.DiffMetadata:output_type -> bytebase.v1.DiffMetadataResponse
-	25, // 50: bytebase.v1.SQLService.AICompletion:output_type -> bytebase.v1.AICompletionResponse
-	45, // [45:51] is the sub-list for method output_type
-	39, // [39:45] is the sub-list for method input_type
-	39, // [39:39] is the sub-list for extension type_name
-	39, // [39:39] is the sub-list for extension extendee
-	0,  // [0:39] is the sub-list for field type_name
+	11, // 0: bytebase.v1.AdminExecuteRequest.justification:type_name -> bytebase.v1.QueryJustification
+	14, // 1: bytebase.v1.AdminExecuteResponse.results:type_name -> bytebase.v1.QueryResult
+	13, // 2: bytebase.v1.QueryRequest.query_option:type_name -> bytebase.v1.QueryOption
+	39, // 3: bytebase.v1.QueryRequest.parameters:type_name -> bytebase.v1.QueryRequest.ParametersEntry
+	11, // 4: bytebase.v1.QueryRequest.justification:type_name -> bytebase.v1.QueryJustification
+	14, // 5: bytebase.v1.QueryResponse.results:type_name -> bytebase.v1.QueryResult
+	0,  // 6: bytebase.v1.QueryOption.redis_run_commands_on:type_name -> bytebase.v1.QueryOption.RedisRunCommandsOn
+	1,  // 7: bytebase.v1.QueryOption.mssql_explain_format:type_name -> bytebase.v1.QueryOption.MSSQLExplainFormat
+	20, // 8: bytebase.v1.QueryResult.rows:type_name -> bytebase.v1.QueryRow
+	51, // 9: bytebase.v1.QueryResult.latency:type_name -> google.protobuf.Duration
+	40, // 10: bytebase.v1.QueryResult.postgres_error:type_name -> bytebase.v1.QueryResult.PostgresError
+	41, // 11: bytebase.v1.QueryResult.syntax_error:type_name -> bytebase.v1.QueryResult.SyntaxError
+	52, // 12: bytebase.v1.QueryResult.permission_denied:type_name -> bytebase.v1.PermissionDeniedDetail
+	42, // 13: bytebase.v1.QueryResult.command_error:type_name -> bytebase.v1.QueryResult.CommandError
+	43, // 14: bytebase.v1.QueryResult.messages:type_name -> bytebase.v1.QueryResult.Message
+	19, // 15: bytebase.v1.QueryResult.masked:type_name -> bytebase.v1.MaskingReason
+	16, // 16: bytebase.v1.QueryResult.plan:type_name -> bytebase.v1.QueryPlan
+	15, // 17: bytebase.v1.QueryResult.applied_row_filters:type_name -> bytebase.v1.AppliedRowFilter
+	17, // 18: bytebase.v1.QueryPlan.root:type_name -> bytebase.v1.QueryPlanNode
+	18, // 19: bytebase.v1.QueryPlan.warnings:type_name -> bytebase.v1.QueryPlanWarning
+	17, // 20: bytebase.v1.QueryPlanNode.children:type_name -> bytebase.v1.QueryPlanNode
+	4,  // 21: bytebase.v1.QueryPlanWarning.type:type_name -> bytebase.v1.QueryPlanWarning.Type
+	21, // 22: bytebase.v1.QueryRow.values:type_name -> bytebase.v1.RowValue
+	53, // 23: bytebase.v1.RowValue.null_value:type_name -> google.protobuf.NullValue
+	54, // 24: bytebase.v1.RowValue.value_value:type_name -> google.protobuf.Value
+	44, // 25: bytebase.v1.RowValue.timestamp_value:type_name -> bytebase.v1.RowValue.Timestamp
+	45, // 26: bytebase.v1.RowValue.timestamp_tz_value:type_name -> bytebase.v1.RowValue.TimestampTZ
+	5,  // 27: bytebase.v1.Advice.status:type_name -> bytebase.v1.Advice.Level
+	55, // 28: bytebase.v1.Advice.start_position:type_name -> bytebase.v1.Position
+	55, // 29: bytebase.v1.Advice.end_position:type_name -> bytebase.v1.Position
+	6,  // 30: bytebase.v1.Advice.rule_type:type_name -> bytebase.v1.Advice.RuleType
+	56, // 31: bytebase.v1.ExportRequest.format:type_name -> bytebase.v1.ExportFormat
+	11, // 32: bytebase.v1.ExportRequest.justification:type_name -> bytebase.v1.QueryJustification
+	57, // 33: bytebase.v1.ExportResponse.operation:type_name -> bytebase.v1.Operation
+	58, // 34: bytebase.v1.ExportWatermark.export_time:type_name -> google.protobuf.Timestamp
+	46, // 35: bytebase.v1.ExportWatermark.files:type_name -> bytebase.v1.ExportWatermark.File
+	59, // 36: bytebase.v1.DiffMetadataRequest.source_metadata:type_name -> bytebase.v1.DatabaseMetadata
+	59, // 37: bytebase.v1.DiffMetadataRequest.target_metadata:type_name -> bytebase.v1.DatabaseMetadata
+	60, // 38: bytebase.v1.DiffMetadataRequest.engine:type_name -> bytebase.v1.Engine
+	60, // 39: bytebase.v1.FormatStatementRequest.engine:type_name -> bytebase.v1.Engine
+	33, // 40: bytebase.v1.SearchQueryHistoriesResponse.query_histories:type_name -> bytebase.v1.QueryHistory
+	58, // 41: bytebase.v1.QueryHistory.create_time:type_name -> google.protobuf.Timestamp
+	51, // 42: bytebase.v1.QueryHistory.duration:type_name -> google.protobuf.Duration
+	7,  // 43: bytebase.v1.QueryHistory.type:type_name -> bytebase.v1.QueryHistory.Type
+	11, // 44: bytebase.v1.QueryHistory.justification:type_name -> bytebase.v1.QueryJustification
+	10, // 45: bytebase.v1.CreateQueryResultShareRequest.query:type_name -> bytebase.v1.QueryRequest
+	51, // 46: bytebase.v1.CreateQueryResultShareRequest.ttl:type_name -> google.protobuf.Duration
+	58, // 47: bytebase.v1.QueryResultShare.create_time:type_name -> google.protobuf.Timestamp
+	58, // 48: bytebase.v1.QueryResultShare.expire_time:type_name -> google.protobuf.Timestamp
+	14, // 49: bytebase.v1.QueryResultShare.results:type_name -> bytebase.v1.QueryResult
+	47, // 50: bytebase.v1.AICompletionRequest.messages:type_name -> bytebase.v1.AICompletionRequest.Message
+	48, // 51: bytebase.v1.AICompletionResponse.candidates:type_name -> bytebase.v1.AICompletionResponse.Candidate
+	55, // 52: bytebase.v1.QueryResult.SyntaxError.start_position:type_name -> bytebase.v1.Position
+	2,  // 53: bytebase.v1.QueryResult.CommandError.command_type:type_name -> bytebase.v1.QueryResult.CommandError.Type
+	3,  // 54: bytebase.v1.QueryResult.Message.level:type_name -> bytebase.v1.QueryResult.Message.Level
+	58, // 55: bytebase.v1.RowValue.Timestamp.google_timestamp:type_name -> google.protobuf.Timestamp
+	58, // 56: bytebase.v1.RowValue.TimestampTZ.google_timestamp:type_name -> google.protobuf.Timestamp
+	49, // 57: bytebase.v1.AICompletionResponse.Candidate.content:type_name -> bytebase.v1.AICompletionResponse.Candidate.Content
+	50, // 58: bytebase.v1.AICompletionResponse.Candidate.Content.parts:type_name -> bytebase.v1.AICompletionResponse.Candidate.Content.Part
+	10, // 59: bytebase.v1.SQLService.Query:input_type -> bytebase.v1.QueryRequest
+	8,  // 60: bytebase.v1.SQLService.AdminExecute:input_type -> bytebase.v1.AdminExecuteRequest
+	34, // 61: bytebase.v1.SQLService.CreateQueryResultShare:input_type -> bytebase.v1.CreateQueryResultShareRequest
+	35, // 62: bytebase.v1.SQLService.GetQueryResultShare:input_type -> bytebase.v1.GetQueryResultShareRequest
+	31, // 63: bytebase.v1.SQLService.SearchQueryHistories:input_type -> bytebase.v1.SearchQueryHistoriesRequest
+	23, // 64: bytebase.v1.SQLService.Export:input_type -> bytebase.v1.ExportRequest
+	25, // 65: bytebase.v1.SQLService.VerifyExportWatermark:input_type -> bytebase.v1.VerifyExportWatermarkRequest
+	27, // 66: bytebase.v1.SQLService.DiffMetadata:input_type -> bytebase.v1.DiffMetadataRequest
+	29, // 67: bytebase.v1.SQLService.FormatStatement:input_type -> bytebase.v1.FormatStatementRequest
+	37, // 68: bytebase.v1.SQLService.AICompletion:input_type -> bytebase.v1.AICompletionRequest
+	12, // 69: bytebase.v1.SQLService.Query:output_type -> bytebase.v1.QueryResponse
+	9,  // 70: bytebase.v1.SQLService.AdminExecute:output_type -> bytebase.v1.AdminExecuteResponse
+	36, // 71: bytebase.v1.SQLService.CreateQueryResultShare:output_type -> bytebase.v1.QueryResultShare
+	36, // 72: bytebase.v1.SQLService.GetQueryResultShare:output_type -> bytebase.v1.QueryResultShare
+	32, // 73: bytebase.v1.SQLService.SearchQueryHistories:output_type -> bytebase.v1.SearchQueryHistoriesResponse
+	24, // 74: bytebase.v1.SQLService.Export:output_type -> bytebase.v1.ExportResponse
+	26, // 75: bytebase.v1.SQLService.VerifyExportWatermark:output_type -> bytebase.v1.ExportWatermark
+	28, // 76: bytebase.v1.SQLService.DiffMetadata:output_type -> bytebase.v1.DiffMetadataResponse
+	30, // 77: bytebase.v1.SQLService.FormatStatement:output_type -> bytebase.v1.FormatStatementResponse
+	38, // 78: bytebase.v1.SQLService.AICompletion:output_type -> bytebase.v1.AICompletionResponse
+	69, // [69:79] is the sub-list for method output_type
+	59, // [59:69] is the sub-list for method input_type
+	59, // [59:59] is the sub-list for extension type_name
+	59, // [59:59] is the sub-list for extension extendee
+	0,  // [0:59] is the sub-list for field type_name
 }
 
 func init() { file_v1_sql_service_proto_init() }
@@ -3052,15 +4366,17 @@ func file_v1_sql_service_proto_init() {
 	file_v1_annotation_proto_init()
 	file_v1_common_proto_init()
 	file_v1_database_service_proto_init()
+	file_v1_operation_service_proto_init()
 	file_v1_sql_service_proto_msgTypes[0].OneofWrappers = []any{}
 	file_v1_sql_service_proto_msgTypes[2].OneofWrappers = []any{}
-	file_v1_sql_service_proto_msgTypes[5].OneofWrappers = []any{
+	file_v1_sql_service_proto_msgTypes[6].OneofWrappers = []any{
 		(*QueryResult_PostgresError_)(nil),
 		(*QueryResult_SyntaxError_)(nil),
 		(*QueryResult_PermissionDenied)(nil),
 		(*QueryResult_CommandError_)(nil),
 	}
-	file_v1_sql_service_proto_msgTypes[8].OneofWrappers = []any{
+	file_v1_sql_service_proto_msgTypes[9].OneofWrappers = []any{}
+	file_v1_sql_service_proto_msgTypes[13].OneofWrappers = []any{
 		(*RowValue_NullValue)(nil),
 		(*RowValue_BoolValue)(nil),
 		(*RowValue_BytesValue)(nil),
@@ -3075,15 +4391,15 @@ func file_v1_sql_service_proto_init() {
 		(*RowValue_TimestampValue)(nil),
 		(*RowValue_TimestampTzValue)(nil),
 	}
-	file_v1_sql_service_proto_msgTypes[10].OneofWrappers = []any{}
-	file_v1_sql_service_proto_msgTypes[16].OneofWrappers = []any{}
+	file_v1_sql_service_proto_msgTypes[15].OneofWrappers = []any{}
+	file_v1_sql_service_proto_msgTypes[25].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_sql_service_proto_rawDesc), len(file_v1_sql_service_proto_rawDesc)),
-			NumEnums:      7,
-			NumMessages:   29,
+			NumEnums:      8,
+			NumMessages:   43,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -31,6 +31,9 @@ func (x *AdminExecuteRequest) Equal(y *AdminExecuteRequest) bool {
 	if p, q := x.Container, y.Container; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
 		return false
 	}
+	if !x.Justification.Equal(y.Justification) {
+		return false
+	}
 	return true
 }
 
@@ -83,6 +86,46 @@ func (x *QueryRequest) Equal(y *QueryRequest) bool {
 	if p, q := x.Container, y.Container; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
 		return false
 	}
+	if x.Worksheet != y.Worksheet {
+		return false
+	}
+	if len(x.Parameters) != len(y.Parameters) {
+		return false
+	}
+	for k := range x.Parameters {
+		_, ok := y.Parameters[k]
+		if !ok {
+			return false
+		}
+		if x.Parameters[k] != y.Parameters[k] {
+			return false
+		}
+	}
+	if x.SkipCache != y.SkipCache {
+		return false
+	}
+	if x.Federated != y.Federated {
+		return false
+	}
+	if !x.Justification.Equal(y.Justification) {
+		return false
+	}
+	return true
+}
+
+func (x *QueryJustification) Equal(y *QueryJustification) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Reason != y.Reason {
+		return false
+	}
+	if x.Ticket != y.Ticket {
+		return false
+	}
 	return true
 }
 
@@ -294,6 +337,127 @@ func (x *QueryResult) Equal(y *QueryResult) bool {
 			return false
 		}
 	}
+	if !x.Plan.Equal(y.Plan) {
+		return false
+	}
+	if x.Cached != y.Cached {
+		return false
+	}
+	if len(x.AppliedRowFilters) != len(y.AppliedRowFilters) {
+		return false
+	}
+	for i := 0; i < len(x.AppliedRowFilters); i++ {
+		if !x.AppliedRowFilters[i].Equal(y.AppliedRowFilters[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (x *AppliedRowFilter) Equal(y *AppliedRowFilter) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Id != y.Id {
+		return false
+	}
+	if x.Title != y.Title {
+		return false
+	}
+	if x.Table != y.Table {
+		return false
+	}
+	return true
+}
+
+func (x *QueryPlan) Equal(y *QueryPlan) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if !x.Root.Equal(y.Root) {
+		return false
+	}
+	if len(x.Warnings) != len(y.Warnings) {
+		return false
+	}
+	for i := 0; i < len(x.Warnings); i++ {
+		if !x.Warnings[i].Equal(y.Warnings[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (x *QueryPlanNode) Equal(y *QueryPlanNode) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.NodeType != y.NodeType {
+		return false
+	}
+	if x.Relation != y.Relation {
+		return false
+	}
+	if x.Index != y.Index {
+		return false
+	}
+	if x.Filter != y.Filter {
+		return false
+	}
+	if p, q := x.EstimatedRows, y.EstimatedRows; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
+	}
+	if p, q := x.ActualRows, y.ActualRows; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
+	}
+	if p, q := x.Cost, y.Cost; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
+	}
+	if p, q := x.ActualTimeMs, y.ActualTimeMs; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
+	}
+	if x.FullScan != y.FullScan {
+		return false
+	}
+	if len(x.Children) != len(y.Children) {
+		return false
+	}
+	for i := 0; i < len(x.Children); i++ {
+		if !x.Children[i].Equal(y.Children[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (x *QueryPlanWarning) Equal(y *QueryPlanWarning) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Type != y.Type {
+		return false
+	}
+	if x.Relation != y.Relation {
+		return false
+	}
+	if x.Content != y.Content {
+		return false
+	}
+	if x.Code != y.Code {
+		return false
+	}
 	return true
 }
 
@@ -497,6 +661,15 @@ func (x *ExportRequest) Equal(y *ExportRequest) bool {
 	if p, q := x.Schema, y.Schema; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
 		return false
 	}
+	if !x.Justification.Equal(y.Justification) {
+		return false
+	}
+	if x.Async != y.Async {
+		return false
+	}
+	if x.ExportArchive != y.ExportArchive {
+		return false
+	}
 	return true
 }
 
@@ -510,6 +683,83 @@ func (x *ExportResponse) Equal(y *ExportResponse) bool {
 	if string(x.Content) != string(y.Content) {
 		return false
 	}
+	if !x.Operation.Equal(y.Operation) {
+		return false
+	}
+	if x.ExportArchive != y.ExportArchive {
+		return false
+	}
+	return true
+}
+
+func (x *VerifyExportWatermarkRequest) Equal(y *VerifyExportWatermarkRequest) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if string(x.Content) != string(y.Content) {
+		return false
+	}
+	if x.Password != y.Password {
+		return false
+	}
+	return true
+}
+
+func (x *ExportWatermark_File) Equal(y *ExportWatermark_File) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.Sha256 != y.Sha256 {
+		return false
+	}
+	if x.Marked != y.Marked {
+		return false
+	}
+	return true
+}
+
+func (x *ExportWatermark) Equal(y *ExportWatermark) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Exporter != y.Exporter {
+		return false
+	}
+	if p, q := x.ExportTime, y.ExportTime; (p == nil && q != nil) || (p != nil && (q == nil || p.Seconds != q.Seconds || p.Nanos != q.Nanos)) {
+		return false
+	}
+	if x.Source != y.Source {
+		return false
+	}
+	if x.StatementSha256 != y.StatementSha256 {
+		return false
+	}
+	if x.Masked != y.Masked {
+		return false
+	}
+	if len(x.Files) != len(y.Files) {
+		return false
+	}
+	for i := 0; i < len(x.Files); i++ {
+		if !x.Files[i].Equal(y.Files[i]) {
+			return false
+		}
+	}
+	if x.Id != y.Id {
+		return false
+	}
 	return true
 }
 
@@ -545,6 +795,41 @@ func (x *DiffMetadataResponse) Equal(y *DiffMetadataResponse) bool {
 	return true
 }
 
+func (x *FormatStatementRequest) Equal(y *FormatStatementRequest) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Statement != y.Statement {
+		return false
+	}
+	if x.Engine != y.Engine {
+		return false
+	}
+	if x.IndentSize != y.IndentSize {
+		return false
+	}
+	if x.UseTab != y.UseTab {
+		return false
+	}
+	return true
+}
+
+func (x *FormatStatementResponse) Equal(y *FormatStatementResponse) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Statement != y.Statement {
+		return false
+	}
+	return true
+}
+
 func (x *SearchQueryHistoriesRequest) Equal(y *SearchQueryHistoriesRequest) bool {
 	if x == y {
 		return true
@@ -616,6 +901,80 @@ func (x *QueryHistory) Equal(y *QueryHistory) bool {
 	if x.Type != y.Type {
 		return false
 	}
+	if x.AccessGrant != y.AccessGrant {
+		return false
+	}
+	if !x.Justification.Equal(y.Justification) {
+		return false
+	}
+	return true
+}
+
+func (x *CreateQueryResultShareRequest) Equal(y *CreateQueryResultShareRequest) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Parent != y.Parent {
+		return false
+	}
+	if !x.Query.Equal(y.Query) {
+		return false
+	}
+	if p, q := x.Ttl, y.Ttl; (p == nil && q != nil) || (p != nil && (q == nil || p.Seconds != q.Seconds || p.Nanos != q.Nanos)) {
+		return false
+	}
+	return true
+}
+
+func (x *GetQueryResultShareRequest) Equal(y *GetQueryResultShareRequest) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	return true
+}
+
+func (x *QueryResultShare) Equal(y *QueryResultShare) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.Database != y.Database {
+		return false
+	}
+	if x.Statement != y.Statement {
+		return false
+	}
+	if x.Creator != y.Creator {
+		return false
+	}
+	if p, q := x.CreateTime, y.CreateTime; (p == nil && q != nil) || (p != nil && (q == nil || p.Seconds != q.Seconds || p.Nanos != q.Nanos)) {
+		return false
+	}
+	if p, q := x.ExpireTime, y.ExpireTime; (p == nil && q != nil) || (p != nil && (q == nil || p.Seconds != q.Seconds || p.Nanos != q.Nanos)) {
+		return false
+	}
+	if len(x.Results) != len(y.Results) {
+		return false
+	}
+	for i := 0; i < len(x.Results); i++ {
+		if !x.Results[i].Equal(y.Results[i]) {
+			return false
+		}
+	}
 	return true
 }
 
@@ -19,12 +19,16 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	SQLService_Query_FullMethodName                = "/bytebase.v1.SQLService/Query"
-	SQLService_AdminExecute_FullMethodName         = "/bytebase.v1.SQLService/AdminExecute"
-	SQLService_SearchQueryHistories_FullMethodName = "/bytebase.v1.SQLService/SearchQueryHistories"
-	SQLService_Export_FullMethodName               = "/bytebase.v1.SQLService/Export"
-	SQLService_DiffMetadata_FullMethodName         = "/bytebase.v1.SQLService/DiffMetadata"
-	SQLService_AICompletion_FullMethodName         = "/bytebase.v1.SQLService/AICompletion"
+	SQLService_Query_FullMethodName                  = "/bytebase.v1.SQLService/Query"
+	SQLService_AdminExecute_FullMethodName           = "/bytebase.v1.SQLService/AdminExecute"
+	SQLService_CreateQueryResultShare_FullMethodName = "/bytebase.v1.SQLService/CreateQueryResultShare"
+	SQLService_GetQueryResultShare_FullMethodName    = "/bytebase.v1.SQLService/GetQueryResultShare"
+	SQLService_SearchQueryHistories_FullMethodName   = "/bytebase.v1.SQLService/SearchQueryHistories"
+	SQLService_Export_FullMethodName                 = "/bytebase.v1.SQLService/Export"
+	SQLService_VerifyExportWatermark_FullMethodName  = "/bytebase.v1.SQLService/VerifyExportWatermark"
+	SQLService_DiffMetadata_FullMethodName           = "/bytebase.v1.SQLService/DiffMetadata"
+	SQLService_FormatStatement_FullMethodName        = "/bytebase.v1.SQLService/FormatStatement"
+	SQLService_AICompletion_FullMethodName           = "/bytebase.v1.SQLService/AICompletion"
 )
 
 // SQLServiceClient is the client API for SQLService service.
@@ -37,17 +41,33 @@ type SQLServiceClient interface {
 	// Permissions required: bb.databases.get
 	Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResponse, error)
 	// Executes SQL with admin privileges via streaming connection.
-	// Permissions required: bb.sql.admin
+	// Permissions required: bb.sql.admin OR caller has an active break-glass admin access grant on the database
 	AdminExecute(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[AdminExecuteRequest, AdminExecuteResponse], error)
+	// Creates a shareable snapshot of a query result.
+	// The statement is executed with the creator's access, and the snapshot is
+	// re-checked and re-masked with the viewer's access when it is read.
+	// Permissions required: bb.databases.get
+	CreateQueryResultShare(ctx context.Context, in *CreateQueryResultShareRequest, opts ...grpc.CallOption) (*QueryResultShare, error)
+	// Gets a shared query result with the caller's access check and masking applied.
+	// Permissions required: bb.databases.get
+	GetQueryResultShare(ctx context.Context, in *GetQueryResultShareRequest, opts ...grpc.CallOption) (*QueryResultShare, error)
 	// SearchQueryHistories searches query histories for the caller.
 	// Permissions required: None (only returns caller's own query histories)
 	SearchQueryHistories(ctx context.Context, in *SearchQueryHistoriesRequest, opts ...grpc.CallOption) (*SearchQueryHistoriesResponse, error)
 	// Exports query results to a file format.
+	// The export archive carries a signed watermark identifying the exporter.
 	// Permissions required: bb.databases.get
 	Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*ExportResponse, error)
+	// Verifies the watermark of an export archive, or of a file extracted from it, and identifies the exporter.
+	// Only the exports of the workspace are verified, against the digests recorded by the server at the export.
+	// Permissions required: bb.auditLogs.search
+	VerifyExportWatermark(ctx context.Context, in *VerifyExportWatermarkRequest, opts ...grpc.CallOption) (*ExportWatermark, error)
 	// Computes schema differences between two database metadata.
 	// Permissions required: None
 	DiffMetadata(ctx context.Context, in *DiffMetadataRequest, opts ...grpc.CallOption) (*DiffMetadataResponse, error)
+	// Formats SQL statements in the dialect of the database engine.
+	// Permissions required: None
+	FormatStatement(ctx context.Context, in *FormatStatementRequest, opts ...grpc.CallOption) (*FormatStatementResponse, error)
 	// Provides AI-powered SQL completion and generation.
 	// Permissions required: None (authenticated users only, requires AI to be enabled)
 	AICompletion(ctx context.Context, in *AICompletionRequest, opts ...grpc.CallOption) (*AICompletionResponse, error)
@@ -84,6 +104,26 @@ func (c *sQLServiceClient) AdminExecute(ctx context.Context, opts ...grpc.CallOp
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type SQLService_AdminExecuteClient = grpc.BidiStreamingClient[AdminExecuteRequest, AdminExecuteResponse]
 
+func (c *sQLServiceClient) CreateQueryResultShare(ctx context.Context, in *CreateQueryResultShareRequest, opts ...grpc.CallOption) (*QueryResultShare, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryResultShare)
+	err := c.cc.Invoke(ctx, SQLService_CreateQueryResultShare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sQLServiceClient) GetQueryResultShare(ctx context.Context, in *GetQueryResultShareRequest, opts ...grpc.CallOption) (*QueryResultShare, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryResultShare)
+	err := c.cc.Invoke(ctx, SQLService_GetQueryResultShare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sQLServiceClient) SearchQueryHistories(ctx context.Context, in *SearchQueryHistoriesRequest, opts ...grpc.CallOption) (*SearchQueryHistoriesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SearchQueryHistoriesResponse)
@@ -104,6 +144,16 @@ func (c *sQLServiceClient) Export(ctx context.Context, in *ExportRequest, opts .
 	return out, nil
 }
 
+func (c *sQLServiceClient) VerifyExportWatermark(ctx context.Context, in *VerifyExportWatermarkRequest, opts ...grpc.CallOption) (*ExportWatermark, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportWatermark)
+	err := c.cc.Invoke(ctx, SQLService_VerifyExportWatermark_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sQLServiceClient) DiffMetadata(ctx context.Context, in *DiffMetadataRequest, opts ...grpc.CallOption) (*DiffMetadataResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DiffMetadataResponse)
@@ -114,6 +164,16 @@ func (c *sQLServiceClient) DiffMetadata(ctx context.Context, in *DiffMetadataReq
 	return out, nil
 }
 
+func (c *sQLServiceClient) FormatStatement(ctx context.Context, in *FormatStatementRequest, opts ...grpc.CallOption) (*FormatStatementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FormatStatementResponse)
+	err := c.cc.Invoke(ctx, SQLService_FormatStatement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sQLServiceClient) AICompletion(ctx context.Context, in *AICompletionRequest, opts ...grpc.CallOption) (*AICompletionResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AICompletionResponse)
@@ -134,17 +194,33 @@ type SQLServiceServer interface {
 	// Permissions required: bb.databases.get
 	Query(context.Context, *QueryRequest) (*QueryResponse, error)
 	// Executes SQL with admin privileges via streaming connection.
-	// Permissions required: bb.sql.admin
+	// Permissions required: bb.sql.admin OR caller has an active break-glass admin access grant on the database
 	AdminExecute(grpc.BidiStreamingServer[AdminExecuteRequest, AdminExecuteResponse]) error
+	// Creates a shareable snapshot of a query result.
+	// The statement is executed with the creator's access, and the snapshot is
+	// re-checked and re-masked with the viewer's access when it is read.
+	// Permissions required: bb.databases.get
+	CreateQueryResultShare(context.Context, *CreateQueryResultShareRequest) (*QueryResultShare, error)
+	// Gets a shared query result with the caller's access check and masking applied.
+	// Permissions required: bb.databases.get
+	GetQueryResultShare(context.Context, *GetQueryResultShareRequest) (*QueryResultShare, error)
 	// SearchQueryHistories searches query histories for the caller.
 	// Permissions required: None (only returns caller's own query histories)
 	SearchQueryHistories(context.Context, *SearchQueryHistoriesRequest) (*SearchQueryHistoriesResponse, error)
 	// Exports query results to a file format.
+	// The export archive carries a signed watermark identifying the exporter.
 	// Permissions required: bb.databases.get
 	Export(context.Context, *ExportRequest) (*ExportResponse, error)
+	// Verifies the watermark of an export archive, or of a file extracted from it, and identifies the exporter.
+	// Only the exports of the workspace are verified, against the digests recorded by the server at the export.
+	// Permissions required: bb.auditLogs.search
+	VerifyExportWatermark(context.Context, *VerifyExportWatermarkRequest) (*ExportWatermark, error)
 	// Computes schema differences between two database metadata.
 	// Permissions required: None
 	DiffMetadata(context.Context, *DiffMetadataRequest) (*DiffMetadataResponse, error)
+	// Formats SQL statements in the dialect of the database engine.
+	// Permissions required: None
+	FormatStatement(context.Context, *FormatStatementRequest) (*FormatStatementResponse, error)
 	// Provides AI-powered SQL completion and generation.
 	// Permissions required: None (authenticated users only, requires AI to be enabled)
 	AICompletion(context.Context, *AICompletionRequest) (*AICompletionResponse, error)
@@ -164,15 +240,27 @@ func (UnimplementedSQLServiceServer) Query(context.Context, *QueryRequest) (*Que
 func (UnimplementedSQLServiceServer) AdminExecute(grpc.BidiStreamingServer[AdminExecuteRequest, AdminExecuteResponse]) error {
 	return status.Error(codes.Unimplemented, "method AdminExecute not implemented")
 }
+func (UnimplementedSQLServiceServer) CreateQueryResultShare(context.Context, *CreateQueryResultShareRequest) (*QueryResultShare, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateQueryResultShare not implemented")
+}
+func (UnimplementedSQLServiceServer) GetQueryResultShare(context.Context, *GetQueryResultShareRequest) (*QueryResultShare, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetQueryResultShare not implemented")
+}
 func (UnimplementedSQLServiceServer) SearchQueryHistories(context.Context, *SearchQueryHistoriesRequest) (*SearchQueryHistoriesResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method SearchQueryHistories not implemented")
 }
 func (UnimplementedSQLServiceServer) Export(context.Context, *ExportRequest) (*ExportResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method Export not implemented")
 }
+func (UnimplementedSQLServiceServer) VerifyExportWatermark(context.Context, *VerifyExportWatermarkRequest) (*ExportWatermark, error) {
+	return nil, status.Error(codes.Unimplemented, "method VerifyExportWatermark not implemented")
+}
 func (UnimplementedSQLServiceServer) DiffMetadata(context.Context, *DiffMetadataRequest) (*DiffMetadataResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DiffMetadata not implemented")
 }
+func (UnimplementedSQLServiceServer) FormatStatement(context.Context, *FormatStatementRequest) (*FormatStatementResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method FormatStatement not implemented")
+}
 func (UnimplementedSQLServiceServer) AICompletion(context.Context, *AICompletionRequest) (*AICompletionResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method AICompletion not implemented")
 }
@@ -222,6 +310,42 @@ func _SQLService_AdminExecute_Handler(srv interface{}, stream grpc.ServerStream)
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type SQLService_AdminExecuteServer = grpc.BidiStreamingServer[AdminExecuteRequest, AdminExecuteResponse]
 
+func _SQLService_CreateQueryResultShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateQueryResultShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SQLServiceServer).CreateQueryResultShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SQLService_CreateQueryResultShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SQLServiceServer).CreateQueryResultShare(ctx, req.(*CreateQueryResultShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SQLService_GetQueryResultShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQueryResultShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SQLServiceServer).GetQueryResultShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SQLService_GetQueryResultShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SQLServiceServer).GetQueryResultShare(ctx, req.(*GetQueryResultShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SQLService_SearchQueryHistories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SearchQueryHistoriesRequest)
 	if err := dec(in); err != nil {
@@ -258,6 +382,24 @@ func _SQLService_Export_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SQLService_VerifyExportWatermark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyExportWatermarkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SQLServiceServer).VerifyExportWatermark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SQLService_VerifyExportWatermark_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SQLServiceServer).VerifyExportWatermark(ctx, req.(*VerifyExportWatermarkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SQLService_DiffMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DiffMetadataRequest)
 	if err := dec(in); err != nil {
@@ -276,6 +418,24 @@ func _SQLService_DiffMetadata_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SQLService_FormatStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FormatStatementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SQLServiceServer).FormatStatement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SQLService_FormatStatement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SQLServiceServer).FormatStatement(ctx, req.(*FormatStatementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SQLService_AICompletion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AICompletionRequest)
 	if err := dec(in); err != nil {
@@ -305,6 +465,14 @@ var SQLService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Query",
 			Handler:    _SQLService_Query_Handler,
 		},
+		{
+			MethodName: "CreateQueryResultShare",
+			Handler:    _SQLService_CreateQueryResultShare_Handler,
+		},
+		{
+			MethodName: "GetQueryResultShare",
+			Handler:    _SQLService_GetQueryResultShare_Handler,
+		},
 		{
 			MethodName: "SearchQueryHistories",
 			Handler:    _SQLService_SearchQueryHistories_Handler,
@@ -313,10 +481,18 @@ var SQLService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Export",
 			Handler:    _SQLService_Export_Handler,
 		},
+		{
+			MethodName: "VerifyExportWatermark",
+			Handler:    _SQLService_VerifyExportWatermark_Handler,
+		},
 		{
 			MethodName: "DiffMetadata",
 			Handler:    _SQLService_DiffMetadata_Handler,
 		},
+		{
+			MethodName: "FormatStatement",
+			Handler:    _SQLService_FormatStatement_Handler,
+		},
 		{
 			MethodName: "AICompletion",
 			Handler:    _SQLService_AICompletion_Handler,
@@ -60,6 +60,7 @@ type AccessGrantServiceClient interface {
 	// Lists access grants in a project.
 	ListAccessGrants(context.Context, *connect.Request[v1.ListAccessGrantsRequest]) (*connect.Response[v1.ListAccessGrantsResponse], error)
 	// Creates an access grant.
+	// Break-glass grants additionally require bb.accessGrants.breakGlass in the project.
 	CreateAccessGrant(context.Context, *connect.Request[v1.CreateAccessGrantRequest]) (*connect.Response[v1.AccessGrant], error)
 	// Activates a pending access grant.
 	ActivateAccessGrant(context.Context, *connect.Request[v1.ActivateAccessGrantRequest]) (*connect.Response[v1.AccessGrant], error)
@@ -166,6 +167,7 @@ type AccessGrantServiceHandler interface {
 	// Lists access grants in a project.
 	ListAccessGrants(context.Context, *connect.Request[v1.ListAccessGrantsRequest]) (*connect.Response[v1.ListAccessGrantsResponse], error)
 	// Creates an access grant.
+	// Break-glass grants additionally require bb.accessGrants.breakGlass in the project.
 	CreateAccessGrant(context.Context, *connect.Request[v1.CreateAccessGrantRequest]) (*connect.Response[v1.AccessGrant], error)
 	// Activates a pending access grant.
 	ActivateAccessGrant(context.Context, *connect.Request[v1.ActivateAccessGrantRequest]) (*connect.Response[v1.AccessGrant], error)
@@ -39,6 +39,18 @@ const (
 	// DatabaseCatalogServiceUpdateDatabaseCatalogProcedure is the fully-qualified name of the
 	// DatabaseCatalogService's UpdateDatabaseCatalog RPC.
 	DatabaseCatalogServiceUpdateDatabaseCatalogProcedure = "/bytebase.v1.DatabaseCatalogService/UpdateDatabaseCatalog"
+	// DatabaseCatalogServiceListClassificationSuggestionsProcedure is the fully-qualified name of the
+	// DatabaseCatalogService's ListClassificationSuggestions RPC.
+	DatabaseCatalogServiceListClassificationSuggestionsProcedure = "/bytebase.v1.DatabaseCatalogService/ListClassificationSuggestions"
+	// DatabaseCatalogServiceBatchReviewClassificationSuggestionsProcedure is the fully-qualified name
+	// of the DatabaseCatalogService's BatchReviewClassificationSuggestions RPC.
+	DatabaseCatalogServiceBatchReviewClassificationSuggestionsProcedure = "/bytebase.v1.DatabaseCatalogService/BatchReviewClassificationSuggestions"
+	// DatabaseCatalogServiceGetColumnLineageProcedure is the fully-qualified name of the
+	// DatabaseCatalogService's GetColumnLineage RPC.
+	DatabaseCatalogServiceGetColumnLineageProcedure = "/bytebase.v1.DatabaseCatalogService/GetColumnLineage"
+	// DatabaseCatalogServicePropagateColumnClassificationProcedure is the fully-qualified name of the
+	// DatabaseCatalogService's PropagateColumnClassification RPC.
+	DatabaseCatalogServicePropagateColumnClassificationProcedure = "/bytebase.v1.DatabaseCatalogService/PropagateColumnClassification"
 )
 
 // DatabaseCatalogServiceClient is a client for the bytebase.v1.DatabaseCatalogService service.
@@ -49,6 +61,21 @@ type DatabaseCatalogServiceClient interface {
 	// Updates catalog metadata such as classifications and labels.
 	// Permissions required: bb.databaseCatalogs.update
 	UpdateDatabaseCatalog(context.Context, *connect.Request[v1.UpdateDatabaseCatalogRequest]) (*connect.Response[v1.DatabaseCatalog], error)
+	// Lists the column classifications proposed by sensitive data discovery.
+	// Permissions required: bb.databaseCatalogs.get
+	ListClassificationSuggestions(context.Context, *connect.Request[v1.ListClassificationSuggestionsRequest]) (*connect.Response[v1.ListClassificationSuggestionsResponse], error)
+	// Accepts or rejects classification suggestions in bulk.
+	// Accepted suggestions are written to the database catalog.
+	// Permissions required: bb.databaseCatalogs.update
+	BatchReviewClassificationSuggestions(context.Context, *connect.Request[v1.BatchReviewClassificationSuggestionsRequest]) (*connect.Response[v1.BatchReviewClassificationSuggestionsResponse], error)
+	// Gets the column-level lineage graph of a column.
+	// The lineage is recorded from the statements executed by rollouts and the synced view definitions.
+	// The lineage does not cross into databases of other projects without bb.databaseCatalogs.get on them.
+	// Permissions required: bb.databaseCatalogs.get
+	GetColumnLineage(context.Context, *connect.Request[v1.GetColumnLineageRequest]) (*connect.Response[v1.ColumnLineage], error)
+	// Propagates the classification of a column to the columns derived from it.
+	// Permissions required: bb.databaseCatalogs.update
+	PropagateColumnClassification(context.Context, *connect.Request[v1.PropagateColumnClassificationRequest]) (*connect.Response[v1.PropagateColumnClassificationResponse], error)
 }
 
 // NewDatabaseCatalogServiceClient constructs a client for the bytebase.v1.DatabaseCatalogService
@@ -74,13 +101,41 @@ func NewDatabaseCatalogServiceClient(httpClient connect.HTTPClient, baseURL stri
 			connect.WithSchema(databaseCatalogServiceMethods.ByName("UpdateDatabaseCatalog")),
 			connect.WithClientOptions(opts...),
 		),
+		listClassificationSuggestions: connect.NewClient[v1.ListClassificationSuggestionsRequest, v1.ListClassificationSuggestionsResponse](
+			httpClient,
+			baseURL+DatabaseCatalogServiceListClassificationSuggestionsProcedure,
+			connect.WithSchema(databaseCatalogServiceMethods.ByName("ListClassificationSuggestions")),
+			connect.WithClientOptions(opts...),
+		),
+		batchReviewClassificationSuggestions: connect.NewClient[v1.BatchReviewClassificationSuggestionsRequest, v1.BatchReviewClassificationSuggestionsResponse](
+			httpClient,
+			baseURL+DatabaseCatalogServiceBatchReviewClassificationSuggestionsProcedure,
+			connect.WithSchema(databaseCatalogServiceMethods.ByName("BatchReviewClassificationSuggestions")),
+			connect.WithClientOptions(opts...),
+		),
+		getColumnLineage: connect.NewClient[v1.GetColumnLineageRequest, v1.ColumnLineage](
+			httpClient,
+			baseURL+DatabaseCatalogServiceGetColumnLineageProcedure,
+			connect.WithSchema(databaseCatalogServiceMethods.ByName("GetColumnLineage")),
+			connect.WithClientOptions(opts...),
+		),
+		propagateColumnClassification: connect.NewClient[v1.PropagateColumnClassificationRequest, v1.PropagateColumnClassificationResponse](
+			httpClient,
+			baseURL+DatabaseCatalogServicePropagateColumnClassificationProcedure,
+			connect.WithSchema(databaseCatalogServiceMethods.ByName("PropagateColumnClassification")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // databaseCatalogServiceClient implements DatabaseCatalogServiceClient.
 type databaseCatalogServiceClient struct {
-	getDatabaseCatalog    *connect.Client[v1.GetDatabaseCatalogRequest, v1.DatabaseCatalog]
-	updateDatabaseCatalog *connect.Client[v1.UpdateDatabaseCatalogRequest, v1.DatabaseCatalog]
+	getDatabaseCatalog                   *connect.Client[v1.GetDatabaseCatalogRequest, v1.DatabaseCatalog]
+	updateDatabaseCatalog                *connect.Client[v1.UpdateDatabaseCatalogRequest, v1.DatabaseCatalog]
+	listClassificationSuggestions        *connect.Client[v1.ListClassificationSuggestionsRequest, v1.ListClassificationSuggestionsResponse]
+	batchReviewClassificationSuggestions *connect.Client[v1.BatchReviewClassificationSuggestionsRequest, v1.BatchReviewClassificationSuggestionsResponse]
+	getColumnLineage                     *connect.Client[v1.GetColumnLineageRequest, v1.ColumnLineage]
+	propagateColumnClassification        *connect.Client[v1.PropagateColumnClassificationRequest, v1.PropagateColumnClassificationResponse]
 }
 
 // GetDatabaseCatalog calls bytebase.v1.DatabaseCatalogService.GetDatabaseCatalog.
@@ -93,6 +148,29 @@ func (c *databaseCatalogServiceClient) UpdateDatabaseCatalog(ctx context.Context
 	return c.updateDatabaseCatalog.CallUnary(ctx, req)
 }
 
+// ListClassificationSuggestions calls
+// bytebase.v1.DatabaseCatalogService.ListClassificationSuggestions.
+func (c *databaseCatalogServiceClient) ListClassificationSuggestions(ctx context.Context, req *connect.Request[v1.ListClassificationSuggestionsRequest]) (*connect.Response[v1.ListClassificationSuggestionsResponse], error) {
+	return c.listClassificationSuggestions.CallUnary(ctx, req)
+}
+
+// BatchReviewClassificationSuggestions calls
+// bytebase.v1.DatabaseCatalogService.BatchReviewClassificationSuggestions.
+func (c *databaseCatalogServiceClient) BatchReviewClassificationSuggestions(ctx context.Context, req *connect.Request[v1.BatchReviewClassificationSuggestionsRequest]) (*connect.Response[v1.BatchReviewClassificationSuggestionsResponse], error) {
+	return c.batchReviewClassificationSuggestions.CallUnary(ctx, req)
+}
+
+// GetColumnLineage calls bytebase.v1.DatabaseCatalogService.GetColumnLineage.
+func (c *databaseCatalogServiceClient) GetColumnLineage(ctx context.Context, req *connect.Request[v1.GetColumnLineageRequest]) (*connect.Response[v1.ColumnLineage], error) {
+	return c.getColumnLineage.CallUnary(ctx, req)
+}
+
+// PropagateColumnClassification calls
+// bytebase.v1.DatabaseCatalogService.PropagateColumnClassification.
+func (c *databaseCatalogServiceClient) PropagateColumnClassification(ctx context.Context, req *connect.Request[v1.PropagateColumnClassificationRequest]) (*connect.Response[v1.PropagateColumnClassificationResponse], error) {
+	return c.propagateColumnClassification.CallUnary(ctx, req)
+}
+
 // DatabaseCatalogServiceHandler is an implementation of the bytebase.v1.DatabaseCatalogService
 // service.
 type DatabaseCatalogServiceHandler interface {
@@ -102,6 +180,21 @@ type DatabaseCatalogServiceHandler interface {
 	// Updates catalog metadata such as classifications and labels.
 	// Permissions required: bb.databaseCatalogs.update
 	UpdateDatabaseCatalog(context.Context, *connect.Request[v1.UpdateDatabaseCatalogRequest]) (*connect.Response[v1.DatabaseCatalog], error)
+	// Lists the column classifications proposed by sensitive data discovery.
+	// Permissions required: bb.databaseCatalogs.get
+	ListClassificationSuggestions(context.Context, *connect.Request[v1.ListClassificationSuggestionsRequest]) (*connect.Response[v1.ListClassificationSuggestionsResponse], error)
+	// Accepts or rejects classification suggestions in bulk.
+	// Accepted suggestions are written to the database catalog.
+	// Permissions required: bb.databaseCatalogs.update
+	BatchReviewClassificationSuggestions(context.Context, *connect.Request[v1.BatchReviewClassificationSuggestionsRequest]) (*connect.Response[v1.BatchReviewClassificationSuggestionsResponse], error)
+	// Gets the column-level lineage graph of a column.
+	// The lineage is recorded from the statements executed by rollouts and the synced view definitions.
+	// The lineage does not cross into databases of other projects without bb.databaseCatalogs.get on them.
+	// Permissions required: bb.databaseCatalogs.get
+	GetColumnLineage(context.Context, *connect.Request[v1.GetColumnLineageRequest]) (*connect.Response[v1.ColumnLineage], error)
+	// Propagates the classification of a column to the columns derived from it.
+	// Permissions required: bb.databaseCatalogs.update
+	PropagateColumnClassification(context.Context, *connect.Request[v1.PropagateColumnClassificationRequest]) (*connect.Response[v1.PropagateColumnClassificationResponse], error)
 }
 
 // NewDatabaseCatalogServiceHandler builds an HTTP handler from the service implementation. It
@@ -123,12 +216,44 @@ func NewDatabaseCatalogServiceHandler(svc DatabaseCatalogServiceHandler, opts ..
 		connect.WithSchema(databaseCatalogServiceMethods.ByName("UpdateDatabaseCatalog")),
 		connect.WithHandlerOptions(opts...),
 	)
+	databaseCatalogServiceListClassificationSuggestionsHandler := connect.NewUnaryHandler(
+		DatabaseCatalogServiceListClassificationSuggestionsProcedure,
+		svc.ListClassificationSuggestions,
+		connect.WithSchema(databaseCatalogServiceMethods.ByName("ListClassificationSuggestions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	databaseCatalogServiceBatchReviewClassificationSuggestionsHandler := connect.NewUnaryHandler(
+		DatabaseCatalogServiceBatchReviewClassificationSuggestionsProcedure,
+		svc.BatchReviewClassificationSuggestions,
+		connect.WithSchema(databaseCatalogServiceMethods.ByName("BatchReviewClassificationSuggestions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	databaseCatalogServiceGetColumnLineageHandler := connect.NewUnaryHandler(
+		DatabaseCatalogServiceGetColumnLineageProcedure,
+		svc.GetColumnLineage,
+		connect.WithSchema(databaseCatalogServiceMethods.ByName("GetColumnLineage")),
+		connect.WithHandlerOptions(opts...),
+	)
+	databaseCatalogServicePropagateColumnClassificationHandler := connect.NewUnaryHandler(
+		DatabaseCatalogServicePropagateColumnClassificationProcedure,
+		svc.PropagateColumnClassification,
+		connect.WithSchema(databaseCatalogServiceMethods.ByName("PropagateColumnClassification")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/bytebase.v1.DatabaseCatalogService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case DatabaseCatalogServiceGetDatabaseCatalogProcedure:
 			databaseCatalogServiceGetDatabaseCatalogHandler.ServeHTTP(w, r)
 		case DatabaseCatalogServiceUpdateDatabaseCatalogProcedure:
 			databaseCatalogServiceUpdateDatabaseCatalogHandler.ServeHTTP(w, r)
+		case DatabaseCatalogServiceListClassificationSuggestionsProcedure:
+			databaseCatalogServiceListClassificationSuggestionsHandler.ServeHTTP(w, r)
+		case DatabaseCatalogServiceBatchReviewClassificationSuggestionsProcedure:
+			databaseCatalogServiceBatchReviewClassificationSuggestionsHandler.ServeHTTP(w, r)
+		case DatabaseCatalogServiceGetColumnLineageProcedure:
+			databaseCatalogServiceGetColumnLineageHandler.ServeHTTP(w, r)
+		case DatabaseCatalogServicePropagateColumnClassificationProcedure:
+			databaseCatalogServicePropagateColumnClassificationHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -145,3 +270,19 @@ func (UnimplementedDatabaseCatalogServiceHandler) GetDatabaseCatalog(context.Con
 func (UnimplementedDatabaseCatalogServiceHandler) UpdateDatabaseCatalog(context.Context, *connect.Request[v1.UpdateDatabaseCatalogRequest]) (*connect.Response[v1.DatabaseCatalog], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.DatabaseCatalogService.UpdateDatabaseCatalog is not implemented"))
 }
+
+func (UnimplementedDatabaseCatalogServiceHandler) ListClassificationSuggestions(context.Context, *connect.Request[v1.ListClassificationSuggestionsRequest]) (*connect.Response[v1.ListClassificationSuggestionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.DatabaseCatalogService.ListClassificationSuggestions is not implemented"))
+}
+
+func (UnimplementedDatabaseCatalogServiceHandler) BatchReviewClassificationSuggestions(context.Context, *connect.Request[v1.BatchReviewClassificationSuggestionsRequest]) (*connect.Response[v1.BatchReviewClassificationSuggestionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.DatabaseCatalogService.BatchReviewClassificationSuggestions is not implemented"))
+}
+
+func (UnimplementedDatabaseCatalogServiceHandler) GetColumnLineage(context.Context, *connect.Request[v1.GetColumnLineageRequest]) (*connect.Response[v1.ColumnLineage], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.DatabaseCatalogService.GetColumnLineage is not implemented"))
+}
+
+func (UnimplementedDatabaseCatalogServiceHandler) PropagateColumnClassification(context.Context, *connect.Request[v1.PropagateColumnClassificationRequest]) (*connect.Response[v1.PropagateColumnClassificationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.DatabaseCatalogService.PropagateColumnClassification is not implemented"))
+}
@@ -63,6 +63,9 @@ const (
 	// DatabaseServiceGetDatabaseSDLSchemaProcedure is the fully-qualified name of the DatabaseService's
 	// GetDatabaseSDLSchema RPC.
 	DatabaseServiceGetDatabaseSDLSchemaProcedure = "/bytebase.v1.DatabaseService/GetDatabaseSDLSchema"
+	// DatabaseServiceGetDatabaseSDLSchemaFilesProcedure is the fully-qualified name of the
+	// DatabaseService's GetDatabaseSDLSchemaFiles RPC.
+	DatabaseServiceGetDatabaseSDLSchemaFilesProcedure = "/bytebase.v1.DatabaseService/GetDatabaseSDLSchemaFiles"
 	// DatabaseServiceDiffSchemaProcedure is the fully-qualified name of the DatabaseService's
 	// DiffSchema RPC.
 	DatabaseServiceDiffSchemaProcedure = "/bytebase.v1.DatabaseService/DiffSchema"
@@ -75,6 +78,9 @@ const (
 	// DatabaseServiceGetSchemaStringProcedure is the fully-qualified name of the DatabaseService's
 	// GetSchemaString RPC.
 	DatabaseServiceGetSchemaStringProcedure = "/bytebase.v1.DatabaseService/GetSchemaString"
+	// DatabaseServiceGenerateSyntheticDataProcedure is the fully-qualified name of the
+	// DatabaseService's GenerateSyntheticData RPC.
+	DatabaseServiceGenerateSyntheticDataProcedure = "/bytebase.v1.DatabaseService/GenerateSyntheticData"
 )
 
 // DatabaseServiceClient is a client for the bytebase.v1.DatabaseService service.
@@ -109,6 +115,10 @@ type DatabaseServiceClient interface {
 	// Retrieves database schema in SDL (Schema Definition Language) format.
 	// Permissions required: bb.databases.getSchema
 	GetDatabaseSDLSchema(context.Context, *connect.Request[v1.GetDatabaseSDLSchemaRequest]) (*connect.Response[v1.DatabaseSDLSchema], error)
+	// Retrieves database schema in SDL format with one file per schema object,
+	// laid out as a declarative repository that can be rolled out unchanged.
+	// Permissions required: bb.databases.getSchema
+	GetDatabaseSDLSchemaFiles(context.Context, *connect.Request[v1.GetDatabaseSDLSchemaFilesRequest]) (*connect.Response[v1.DatabaseSDLSchemaFiles], error)
 	// Compares and generates migration statements between two schemas.
 	// Permissions required: bb.databases.get
 	DiffSchema(context.Context, *connect.Request[v1.DiffSchemaRequest]) (*connect.Response[v1.DiffSchemaResponse], error)
@@ -121,6 +131,12 @@ type DatabaseServiceClient interface {
 	// Generates schema DDL for a database object.
 	// Permissions required: bb.databases.getSchema
 	GetSchemaString(context.Context, *connect.Request[v1.GetSchemaStringRequest]) (*connect.Response[v1.GetSchemaStringResponse], error)
+	// Generates synthetic test data from the schema metadata of a database.
+	// The rows respect column types, nullability, foreign keys, unique indexes,
+	// check constraints, enum types and column semantic types.
+	// Permissions required: bb.databases.getSchema, and bb.sql.dml on the target database when loading into a target database.
+	// Loading uses the admin data source of the target, subject to the query data policy as DML in the SQL editor.
+	GenerateSyntheticData(context.Context, *connect.Request[v1.GenerateSyntheticDataRequest]) (*connect.Response[v1.GenerateSyntheticDataResponse], error)
 }
 
 // NewDatabaseServiceClient constructs a client for the bytebase.v1.DatabaseService service. By
@@ -194,6 +210,12 @@ func NewDatabaseServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(databaseServiceMethods.ByName("GetDatabaseSDLSchema")),
 			connect.WithClientOptions(opts...),
 		),
+		getDatabaseSDLSchemaFiles: connect.NewClient[v1.GetDatabaseSDLSchemaFilesRequest, v1.DatabaseSDLSchemaFiles](
+			httpClient,
+			baseURL+DatabaseServiceGetDatabaseSDLSchemaFilesProcedure,
+			connect.WithSchema(databaseServiceMethods.ByName("GetDatabaseSDLSchemaFiles")),
+			connect.WithClientOptions(opts...),
+		),
 		diffSchema: connect.NewClient[v1.DiffSchemaRequest, v1.DiffSchemaResponse](
 			httpClient,
 			baseURL+DatabaseServiceDiffSchemaProcedure,
@@ -218,25 +240,33 @@ func NewDatabaseServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(databaseServiceMethods.ByName("GetSchemaString")),
 			connect.WithClientOptions(opts...),
 		),
+		generateSyntheticData: connect.NewClient[v1.GenerateSyntheticDataRequest, v1.GenerateSyntheticDataResponse](
+			httpClient,
+			baseURL+DatabaseServiceGenerateSyntheticDataProcedure,
+			connect.WithSchema(databaseServiceMethods.ByName("GenerateSyntheticData")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // databaseServiceClient implements DatabaseServiceClient.
 type databaseServiceClient struct {
-	getDatabase          *connect.Client[v1.GetDatabaseRequest, v1.Database]
-	batchGetDatabases    *connect.Client[v1.BatchGetDatabasesRequest, v1.BatchGetDatabasesResponse]
-	listDatabases        *connect.Client[v1.ListDatabasesRequest, v1.ListDatabasesResponse]
-	updateDatabase       *connect.Client[v1.UpdateDatabaseRequest, v1.Database]
-	batchUpdateDatabases *connect.Client[v1.BatchUpdateDatabasesRequest, v1.BatchUpdateDatabasesResponse]
-	syncDatabase         *connect.Client[v1.SyncDatabaseRequest, v1.SyncDatabaseResponse]
-	batchSyncDatabases   *connect.Client[v1.BatchSyncDatabasesRequest, v1.BatchSyncDatabasesResponse]
-	getDatabaseMetadata  *connect.Client[v1.GetDatabaseMetadataRequest, v1.DatabaseMetadata]
-	getDatabaseSchema    *connect.Client[v1.GetDatabaseSchemaRequest, v1.DatabaseSchema]
-	getDatabaseSDLSchema *connect.Client[v1.GetDatabaseSDLSchemaRequest, v1.DatabaseSDLSchema]
-	diffSchema           *connect.Client[v1.DiffSchemaRequest, v1.DiffSchemaResponse]
-	listChangelogs       *connect.Client[v1.ListChangelogsRequest, v1.ListChangelogsResponse]
-	getChangelog         *connect.Client[v1.GetChangelogRequest, v1.Changelog]
-	getSchemaString      *connect.Client[v1.GetSchemaStringRequest, v1.GetSchemaStringResponse]
+	getDatabase               *connect.Client[v1.GetDatabaseRequest, v1.Database]
+	batchGetDatabases         *connect.Client[v1.BatchGetDatabasesRequest, v1.BatchGetDatabasesResponse]
+	listDatabases             *connect.Client[v1.ListDatabasesRequest, v1.ListDatabasesResponse]
+	updateDatabase            *connect.Client[v1.UpdateDatabaseRequest, v1.Database]
+	batchUpdateDatabases      *connect.Client[v1.BatchUpdateDatabasesRequest, v1.BatchUpdateDatabasesResponse]
+	syncDatabase              *connect.Client[v1.SyncDatabaseRequest, v1.SyncDatabaseResponse]
+	batchSyncDatabases        *connect.Client[v1.BatchSyncDatabasesRequest, v1.BatchSyncDatabasesResponse]
+	getDatabaseMetadata       *connect.Client[v1.GetDatabaseMetadataRequest, v1.DatabaseMetadata]
+	getDatabaseSchema         *connect.Client[v1.GetDatabaseSchemaRequest, v1.DatabaseSchema]
+	getDatabaseSDLSchema      *connect.Client[v1.GetDatabaseSDLSchemaRequest, v1.DatabaseSDLSchema]
+	getDatabaseSDLSchemaFiles *connect.Client[v1.GetDatabaseSDLSchemaFilesRequest, v1.DatabaseSDLSchemaFiles]
+	diffSchema                *connect.Client[v1.DiffSchemaRequest, v1.DiffSchemaResponse]
+	listChangelogs            *connect.Client[v1.ListChangelogsRequest, v1.ListChangelogsResponse]
+	getChangelog              *connect.Client[v1.GetChangelogRequest, v1.Changelog]
+	getSchemaString           *connect.Client[v1.GetSchemaStringRequest, v1.GetSchemaStringResponse]
+	generateSyntheticData     *connect.Client[v1.GenerateSyntheticDataRequest, v1.GenerateSyntheticDataResponse]
 }
 
 // GetDatabase calls bytebase.v1.DatabaseService.GetDatabase.
@@ -289,6 +319,11 @@ func (c *databaseServiceClient) GetDatabaseSDLSchema(ctx context.Context, req *c
 	return c.getDatabaseSDLSchema.CallUnary(ctx, req)
 }
 
+// GetDatabaseSDLSchemaFiles calls bytebase.v1.DatabaseService.GetDatabaseSDLSchemaFiles.
+func (c *databaseServiceClient) GetDatabaseSDLSchemaFiles(ctx context.Context, req *connect.Request[v1.GetDatabaseSDLSchemaFilesRequest]) (*connect.Response[v1.DatabaseSDLSchemaFiles], error) {
+	return c.getDatabaseSDLSchemaFiles.CallUnary(ctx, req)
+}
+
 // DiffSchema calls bytebase.v1.DatabaseService.DiffSchema.
 func (c *databaseServiceClient) DiffSchema(ctx context.Context, req *connect.Request[v1.DiffSchemaRequest]) (*connect.Response[v1.DiffSchemaResponse], error) {
 	return c.diffSchema.CallUnary(ctx, req)
@@ -309,6 +344,11 @@ func (c *databaseServiceClient) GetSchemaString(ctx context.Context, req *connec
 	return c.getSchemaString.CallUnary(ctx, req)
 }
 
+// GenerateSyntheticData calls bytebase.v1.DatabaseService.GenerateSyntheticData.
+func (c *databaseServiceClient) GenerateSyntheticData(ctx context.Context, req *connect.Request[v1.GenerateSyntheticDataRequest]) (*connect.Response[v1.GenerateSyntheticDataResponse], error) {
+	return c.generateSyntheticData.CallUnary(ctx, req)
+}
+
 // DatabaseServiceHandler is an implementation of the bytebase.v1.DatabaseService service.
 type DatabaseServiceHandler interface {
 	// Retrieves a database by name.
@@ -341,6 +381,10 @@ type DatabaseServiceHandler interface {
 	// Retrieves database schema in SDL (Schema Definition Language) format.
 	// Permissions required: bb.databases.getSchema
 	GetDatabaseSDLSchema(context.Context, *connect.Request[v1.GetDatabaseSDLSchemaRequest]) (*connect.Response[v1.DatabaseSDLSchema], error)
+	// Retrieves database schema in SDL format with one file per schema object,
+	// laid out as a declarative repository that can be rolled out unchanged.
+	// Permissions required: bb.databases.getSchema
+	GetDatabaseSDLSchemaFiles(context.Context, *connect.Request[v1.GetDatabaseSDLSchemaFilesRequest]) (*connect.Response[v1.DatabaseSDLSchemaFiles], error)
 	// Compares and generates migration statements between two schemas.
 	// Permissions required: bb.databases.get
 	DiffSchema(context.Context, *connect.Request[v1.DiffSchemaRequest]) (*connect.Response[v1.DiffSchemaResponse], error)
@@ -353,6 +397,12 @@ type DatabaseServiceHandler interface {
 	// Generates schema DDL for a database object.
 	// Permissions required: bb.databases.getSchema
 	GetSchemaString(context.Context, *connect.Request[v1.GetSchemaStringRequest]) (*connect.Response[v1.GetSchemaStringResponse], error)
+	// Generates synthetic test data from the schema metadata of a database.
+	// The rows respect column types, nullability, foreign keys, unique indexes,
+	// check constraints, enum types and column semantic types.
+	// Permissions required: bb.databases.getSchema, and bb.sql.dml on the target database when loading into a target database.
+	// Loading uses the admin data source of the target, subject to the query data policy as DML in the SQL editor.
+	GenerateSyntheticData(context.Context, *connect.Request[v1.GenerateSyntheticDataRequest]) (*connect.Response[v1.GenerateSyntheticDataResponse], error)
 }
 
 // NewDatabaseServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -422,6 +472,12 @@ func NewDatabaseServiceHandler(svc DatabaseServiceHandler, opts ...connect.Handl
 		connect.WithSchema(databaseServiceMethods.ByName("GetDatabaseSDLSchema")),
 		connect.WithHandlerOptions(opts...),
 	)
+	databaseServiceGetDatabaseSDLSchemaFilesHandler := connect.NewUnaryHandler(
+		DatabaseServiceGetDatabaseSDLSchemaFilesProcedure,
+		svc.GetDatabaseSDLSchemaFiles,
+		connect.WithSchema(databaseServiceMethods.ByName("GetDatabaseSDLSchemaFiles")),
+		connect.WithHandlerOptions(opts...),
+	)
 	databaseServiceDiffSchemaHandler := connect.NewUnaryHandler(
 		DatabaseServiceDiffSchemaProcedure,
 		svc.DiffSchema,
@@ -446,6 +502,12 @@ func NewDatabaseServiceHandler(svc DatabaseServiceHandler, opts ...connect.Handl
 		connect.WithSchema(databaseServiceMethods.ByName("GetSchemaString")),
 		connect.WithHandlerOptions(opts...),
 	)
+	databaseServiceGenerateSyntheticDataHandler := connect.NewUnaryHandler(
+		DatabaseServiceGenerateSyntheticDataProcedure,
+		svc.GenerateSyntheticData,
+		connect.WithSchema(databaseServiceMethods.ByName("GenerateSyntheticData")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/bytebase.v1.DatabaseService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case DatabaseServiceGetDatabaseProcedure:
@@ -468,6 +530,8 @@ func NewDatabaseServiceHandler(svc DatabaseServiceHandler, opts ...connect.Handl
 			databaseServiceGetDatabaseSchemaHandler.ServeHTTP(w, r)
 		case DatabaseServiceGetDatabaseSDLSchemaProcedure:
 			databaseServiceGetDatabaseSDLSchemaHandler.ServeHTTP(w, r)
+		case DatabaseServiceGetDatabaseSDLSchemaFilesProcedure:
+			databaseServiceGetDatabaseSDLSchemaFilesHandler.ServeHTTP(w, r)
 		case DatabaseServiceDiffSchemaProcedure:
 			databaseServiceDiffSchemaHandler.ServeHTTP(w, r)
 		case DatabaseServiceListChangelogsProcedure:
@@ -476,6 +540,8 @@ func NewDatabaseServiceHandler(svc DatabaseServiceHandler, opts ...connect.Handl
 			databaseServiceGetChangelogHandler.ServeHTTP(w, r)
 		case DatabaseServiceGetSchemaStringProcedure:
 			databaseServiceGetSchemaStringHandler.ServeHTTP(w, r)
+		case DatabaseServiceGenerateSyntheticDataProcedure:
+			databaseServiceGenerateSyntheticDataHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -525,6 +591,10 @@ func (UnimplementedDatabaseServiceHandler) GetDatabaseSDLSchema(context.Context,
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.DatabaseService.GetDatabaseSDLSchema is not implemented"))
 }
 
+func (UnimplementedDatabaseServiceHandler) GetDatabaseSDLSchemaFiles(context.Context, *connect.Request[v1.GetDatabaseSDLSchemaFilesRequest]) (*connect.Response[v1.DatabaseSDLSchemaFiles], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.DatabaseService.GetDatabaseSDLSchemaFiles is not implemented"))
+}
+
 func (UnimplementedDatabaseServiceHandler) DiffSchema(context.Context, *connect.Request[v1.DiffSchemaRequest]) (*connect.Response[v1.DiffSchemaResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.DatabaseService.DiffSchema is not implemented"))
 }
@@ -540,3 +610,7 @@ func (UnimplementedDatabaseServiceHandler) GetChangelog(context.Context, *connec
 func (UnimplementedDatabaseServiceHandler) GetSchemaString(context.Context, *connect.Request[v1.GetSchemaStringRequest]) (*connect.Response[v1.GetSchemaStringResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.DatabaseService.GetSchemaString is not implemented"))
 }
+
+func (UnimplementedDatabaseServiceHandler) GenerateSyntheticData(context.Context, *connect.Request[v1.GenerateSyntheticDataRequest]) (*connect.Response[v1.GenerateSyntheticDataResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.DatabaseService.GenerateSyntheticData is not implemented"))
+}
@@ -50,6 +50,9 @@ const (
 	// PlanServiceCancelPlanCheckRunProcedure is the fully-qualified name of the PlanService's
 	// CancelPlanCheckRun RPC.
 	PlanServiceCancelPlanCheckRunProcedure = "/bytebase.v1.PlanService/CancelPlanCheckRun"
+	// PlanServicePreviewPlanApprovalProcedure is the fully-qualified name of the PlanService's
+	// PreviewPlanApproval RPC.
+	PlanServicePreviewPlanApprovalProcedure = "/bytebase.v1.PlanService/PreviewPlanApproval"
 )
 
 // PlanServiceClient is a client for the bytebase.v1.PlanService service.
@@ -76,6 +79,10 @@ type PlanServiceClient interface {
 	// Cancels the plan check run for a deployment plan.
 	// Permissions required: bb.planCheckRuns.run
 	CancelPlanCheckRun(context.Context, *connect.Request[v1.CancelPlanCheckRunRequest]) (*connect.Response[v1.CancelPlanCheckRunResponse], error)
+	// Previews the approval template required by the issue of a deployment plan without creating the issue.
+	// The approval template is found once the plan check run is completed.
+	// Permissions required: bb.plans.get
+	PreviewPlanApproval(context.Context, *connect.Request[v1.PreviewPlanApprovalRequest]) (*connect.Response[v1.PreviewPlanApprovalResponse], error)
 }
 
 // NewPlanServiceClient constructs a client for the bytebase.v1.PlanService service. By default, it
@@ -131,18 +138,25 @@ func NewPlanServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(planServiceMethods.ByName("CancelPlanCheckRun")),
 			connect.WithClientOptions(opts...),
 		),
+		previewPlanApproval: connect.NewClient[v1.PreviewPlanApprovalRequest, v1.PreviewPlanApprovalResponse](
+			httpClient,
+			baseURL+PlanServicePreviewPlanApprovalProcedure,
+			connect.WithSchema(planServiceMethods.ByName("PreviewPlanApproval")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // planServiceClient implements PlanServiceClient.
 type planServiceClient struct {
-	getPlan            *connect.Client[v1.GetPlanRequest, v1.Plan]
-	listPlans          *connect.Client[v1.ListPlansRequest, v1.ListPlansResponse]
-	createPlan         *connect.Client[v1.CreatePlanRequest, v1.Plan]
-	updatePlan         *connect.Client[v1.UpdatePlanRequest, v1.Plan]
-	getPlanCheckRun    *connect.Client[v1.GetPlanCheckRunRequest, v1.PlanCheckRun]
-	runPlanChecks      *connect.Client[v1.RunPlanChecksRequest, v1.RunPlanChecksResponse]
-	cancelPlanCheckRun *connect.Client[v1.CancelPlanCheckRunRequest, v1.CancelPlanCheckRunResponse]
+	getPlan             *connect.Client[v1.GetPlanRequest, v1.Plan]
+	listPlans           *connect.Client[v1.ListPlansRequest, v1.ListPlansResponse]
+	createPlan          *connect.Client[v1.CreatePlanRequest, v1.Plan]
+	updatePlan          *connect.Client[v1.UpdatePlanRequest, v1.Plan]
+	getPlanCheckRun     *connect.Client[v1.GetPlanCheckRunRequest, v1.PlanCheckRun]
+	runPlanChecks       *connect.Client[v1.RunPlanChecksRequest, v1.RunPlanChecksResponse]
+	cancelPlanCheckRun  *connect.Client[v1.CancelPlanCheckRunRequest, v1.CancelPlanCheckRunResponse]
+	previewPlanApproval *connect.Client[v1.PreviewPlanApprovalRequest, v1.PreviewPlanApprovalResponse]
 }
 
 // GetPlan calls bytebase.v1.PlanService.GetPlan.
@@ -180,6 +194,11 @@ func (c *planServiceClient) CancelPlanCheckRun(ctx context.Context, req *connect
 	return c.cancelPlanCheckRun.CallUnary(ctx, req)
 }
 
+// PreviewPlanApproval calls bytebase.v1.PlanService.PreviewPlanApproval.
+func (c *planServiceClient) PreviewPlanApproval(ctx context.Context, req *connect.Request[v1.PreviewPlanApprovalRequest]) (*connect.Response[v1.PreviewPlanApprovalResponse], error) {
+	return c.previewPlanApproval.CallUnary(ctx, req)
+}
+
 // PlanServiceHandler is an implementation of the bytebase.v1.PlanService service.
 type PlanServiceHandler interface {
 	// Retrieves a deployment plan by name.
@@ -204,6 +223,10 @@ type PlanServiceHandler interface {
 	// Cancels the plan check run for a deployment plan.
 	// Permissions required: bb.planCheckRuns.run
 	CancelPlanCheckRun(context.Context, *connect.Request[v1.CancelPlanCheckRunRequest]) (*connect.Response[v1.CancelPlanCheckRunResponse], error)
+	// Previews the approval template required by the issue of a deployment plan without creating the issue.
+	// The approval template is found once the plan check run is completed.
+	// Permissions required: bb.plans.get
+	PreviewPlanApproval(context.Context, *connect.Request[v1.PreviewPlanApprovalRequest]) (*connect.Response[v1.PreviewPlanApprovalResponse], error)
 }
 
 // NewPlanServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -255,6 +278,12 @@ func NewPlanServiceHandler(svc PlanServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(planServiceMethods.ByName("CancelPlanCheckRun")),
 		connect.WithHandlerOptions(opts...),
 	)
+	planServicePreviewPlanApprovalHandler := connect.NewUnaryHandler(
+		PlanServicePreviewPlanApprovalProcedure,
+		svc.PreviewPlanApproval,
+		connect.WithSchema(planServiceMethods.ByName("PreviewPlanApproval")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/bytebase.v1.PlanService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case PlanServiceGetPlanProcedure:
@@ -271,6 +300,8 @@ func NewPlanServiceHandler(svc PlanServiceHandler, opts ...connect.HandlerOption
 			planServiceRunPlanChecksHandler.ServeHTTP(w, r)
 		case PlanServiceCancelPlanCheckRunProcedure:
 			planServiceCancelPlanCheckRunHandler.ServeHTTP(w, r)
+		case PlanServicePreviewPlanApprovalProcedure:
+			planServicePreviewPlanApprovalHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -307,3 +338,7 @@ func (UnimplementedPlanServiceHandler) RunPlanChecks(context.Context, *connect.R
 func (UnimplementedPlanServiceHandler) CancelPlanCheckRun(context.Context, *connect.Request[v1.CancelPlanCheckRunRequest]) (*connect.Response[v1.CancelPlanCheckRunResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.PlanService.CancelPlanCheckRun is not implemented"))
 }
+
+func (UnimplementedPlanServiceHandler) PreviewPlanApproval(context.Context, *connect.Request[v1.PreviewPlanApprovalRequest]) (*connect.Response[v1.PreviewPlanApprovalResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.PlanService.PreviewPlanApproval is not implemented"))
+}
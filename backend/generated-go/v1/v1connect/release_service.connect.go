@@ -55,6 +55,12 @@ const (
 	// ReleaseServiceCheckReleaseProcedure is the fully-qualified name of the ReleaseService's
 	// CheckRelease RPC.
 	ReleaseServiceCheckReleaseProcedure = "/bytebase.v1.ReleaseService/CheckRelease"
+	// ReleaseServiceExportReleaseProcedure is the fully-qualified name of the ReleaseService's
+	// ExportRelease RPC.
+	ReleaseServiceExportReleaseProcedure = "/bytebase.v1.ReleaseService/ExportRelease"
+	// ReleaseServiceImportReleaseProcedure is the fully-qualified name of the ReleaseService's
+	// ImportRelease RPC.
+	ReleaseServiceImportReleaseProcedure = "/bytebase.v1.ReleaseService/ImportRelease"
 	// ReleaseServiceListReleaseCategoriesProcedure is the fully-qualified name of the ReleaseService's
 	// ListReleaseCategories RPC.
 	ReleaseServiceListReleaseCategoriesProcedure = "/bytebase.v1.ReleaseService/ListReleaseCategories"
@@ -84,6 +90,14 @@ type ReleaseServiceClient interface {
 	// Validates a release by dry-running checks on target databases.
 	// Permissions required: bb.releases.check
 	CheckRelease(context.Context, *connect.Request[v1.CheckReleaseRequest]) (*connect.Response[v1.CheckReleaseResponse], error)
+	// Exports a release as a signed bundle, which can be imported into another project or Bytebase workspace.
+	// The release is checked on the targets by the server, and the check results are signed in the bundle.
+	// Permissions required: bb.releases.get, and bb.releases.check if targets are given
+	ExportRelease(context.Context, *connect.Request[v1.ExportReleaseRequest]) (*connect.Response[v1.ReleaseBundle], error)
+	// Imports a signed release bundle as a new release.
+	// The bundle is refused if the signature or the file hashes don't match the content.
+	// Permissions required: bb.releases.create
+	ImportRelease(context.Context, *connect.Request[v1.ImportReleaseRequest]) (*connect.Response[v1.Release], error)
 	// Lists all unique categories in a project.
 	// Permissions required: bb.releases.list
 	ListReleaseCategories(context.Context, *connect.Request[v1.ListReleaseCategoriesRequest]) (*connect.Response[v1.ListReleaseCategoriesResponse], error)
@@ -142,6 +156,18 @@ func NewReleaseServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(releaseServiceMethods.ByName("CheckRelease")),
 			connect.WithClientOptions(opts...),
 		),
+		exportRelease: connect.NewClient[v1.ExportReleaseRequest, v1.ReleaseBundle](
+			httpClient,
+			baseURL+ReleaseServiceExportReleaseProcedure,
+			connect.WithSchema(releaseServiceMethods.ByName("ExportRelease")),
+			connect.WithClientOptions(opts...),
+		),
+		importRelease: connect.NewClient[v1.ImportReleaseRequest, v1.Release](
+			httpClient,
+			baseURL+ReleaseServiceImportReleaseProcedure,
+			connect.WithSchema(releaseServiceMethods.ByName("ImportRelease")),
+			connect.WithClientOptions(opts...),
+		),
 		listReleaseCategories: connect.NewClient[v1.ListReleaseCategoriesRequest, v1.ListReleaseCategoriesResponse](
 			httpClient,
 			baseURL+ReleaseServiceListReleaseCategoriesProcedure,
@@ -160,6 +186,8 @@ type releaseServiceClient struct {
 	deleteRelease         *connect.Client[v1.DeleteReleaseRequest, emptypb.Empty]
 	undeleteRelease       *connect.Client[v1.UndeleteReleaseRequest, v1.Release]
 	checkRelease          *connect.Client[v1.CheckReleaseRequest, v1.CheckReleaseResponse]
+	exportRelease         *connect.Client[v1.ExportReleaseRequest, v1.ReleaseBundle]
+	importRelease         *connect.Client[v1.ImportReleaseRequest, v1.Release]
 	listReleaseCategories *connect.Client[v1.ListReleaseCategoriesRequest, v1.ListReleaseCategoriesResponse]
 }
 
@@ -198,6 +226,16 @@ func (c *releaseServiceClient) CheckRelease(ctx context.Context, req *connect.Re
 	return c.checkRelease.CallUnary(ctx, req)
 }
 
+// ExportRelease calls bytebase.v1.ReleaseService.ExportRelease.
+func (c *releaseServiceClient) ExportRelease(ctx context.Context, req *connect.Request[v1.ExportReleaseRequest]) (*connect.Response[v1.ReleaseBundle], error) {
+	return c.exportRelease.CallUnary(ctx, req)
+}
+
+// ImportRelease calls bytebase.v1.ReleaseService.ImportRelease.
+func (c *releaseServiceClient) ImportRelease(ctx context.Context, req *connect.Request[v1.ImportReleaseRequest]) (*connect.Response[v1.Release], error) {
+	return c.importRelease.CallUnary(ctx, req)
+}
+
 // ListReleaseCategories calls bytebase.v1.ReleaseService.ListReleaseCategories.
 func (c *releaseServiceClient) ListReleaseCategories(ctx context.Context, req *connect.Request[v1.ListReleaseCategoriesRequest]) (*connect.Response[v1.ListReleaseCategoriesResponse], error) {
 	return c.listReleaseCategories.CallUnary(ctx, req)
@@ -227,6 +265,14 @@ type ReleaseServiceHandler interface {
 	// Validates a release by dry-running checks on target databases.
 	// Permissions required: bb.releases.check
 	CheckRelease(context.Context, *connect.Request[v1.CheckReleaseRequest]) (*connect.Response[v1.CheckReleaseResponse], error)
+	// Exports a release as a signed bundle, which can be imported into another project or Bytebase workspace.
+	// The release is checked on the targets by the server, and the check results are signed in the bundle.
+	// Permissions required: bb.releases.get, and bb.releases.check if targets are given
+	ExportRelease(context.Context, *connect.Request[v1.ExportReleaseRequest]) (*connect.Response[v1.ReleaseBundle], error)
+	// Imports a signed release bundle as a new release.
+	// The bundle is refused if the signature or the file hashes don't match the content.
+	// Permissions required: bb.releases.create
+	ImportRelease(context.Context, *connect.Request[v1.ImportReleaseRequest]) (*connect.Response[v1.Release], error)
 	// Lists all unique categories in a project.
 	// Permissions required: bb.releases.list
 	ListReleaseCategories(context.Context, *connect.Request[v1.ListReleaseCategoriesRequest]) (*connect.Response[v1.ListReleaseCategoriesResponse], error)
@@ -281,6 +327,18 @@ func NewReleaseServiceHandler(svc ReleaseServiceHandler, opts ...connect.Handler
 		connect.WithSchema(releaseServiceMethods.ByName("CheckRelease")),
 		connect.WithHandlerOptions(opts...),
 	)
+	releaseServiceExportReleaseHandler := connect.NewUnaryHandler(
+		ReleaseServiceExportReleaseProcedure,
+		svc.ExportRelease,
+		connect.WithSchema(releaseServiceMethods.ByName("ExportRelease")),
+		connect.WithHandlerOptions(opts...),
+	)
+	releaseServiceImportReleaseHandler := connect.NewUnaryHandler(
+		ReleaseServiceImportReleaseProcedure,
+		svc.ImportRelease,
+		connect.WithSchema(releaseServiceMethods.ByName("ImportRelease")),
+		connect.WithHandlerOptions(opts...),
+	)
 	releaseServiceListReleaseCategoriesHandler := connect.NewUnaryHandler(
 		ReleaseServiceListReleaseCategoriesProcedure,
 		svc.ListReleaseCategories,
@@ -303,6 +361,10 @@ func NewReleaseServiceHandler(svc ReleaseServiceHandler, opts ...connect.Handler
 			releaseServiceUndeleteReleaseHandler.ServeHTTP(w, r)
 		case ReleaseServiceCheckReleaseProcedure:
 			releaseServiceCheckReleaseHandler.ServeHTTP(w, r)
+		case ReleaseServiceExportReleaseProcedure:
+			releaseServiceExportReleaseHandler.ServeHTTP(w, r)
+		case ReleaseServiceImportReleaseProcedure:
+			releaseServiceImportReleaseHandler.ServeHTTP(w, r)
 		case ReleaseServiceListReleaseCategoriesProcedure:
 			releaseServiceListReleaseCategoriesHandler.ServeHTTP(w, r)
 		default:
@@ -342,6 +404,14 @@ func (UnimplementedReleaseServiceHandler) CheckRelease(context.Context, *connect
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.ReleaseService.CheckRelease is not implemented"))
 }
 
+func (UnimplementedReleaseServiceHandler) ExportRelease(context.Context, *connect.Request[v1.ExportReleaseRequest]) (*connect.Response[v1.ReleaseBundle], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.ReleaseService.ExportRelease is not implemented"))
+}
+
+func (UnimplementedReleaseServiceHandler) ImportRelease(context.Context, *connect.Request[v1.ImportReleaseRequest]) (*connect.Response[v1.Release], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.ReleaseService.ImportRelease is not implemented"))
+}
+
 func (UnimplementedReleaseServiceHandler) ListReleaseCategories(context.Context, *connect.Request[v1.ListReleaseCategoriesRequest]) (*connect.Response[v1.ListReleaseCategoriesResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.ReleaseService.ListReleaseCategories is not implemented"))
 }
@@ -37,13 +37,25 @@ const (
 	SQLServiceQueryProcedure = "/bytebase.v1.SQLService/Query"
 	// SQLServiceAdminExecuteProcedure is the fully-qualified name of the SQLService's AdminExecute RPC.
 	SQLServiceAdminExecuteProcedure = "/bytebase.v1.SQLService/AdminExecute"
+	// SQLServiceCreateQueryResultShareProcedure is the fully-qualified name of the SQLService's
+	// CreateQueryResultShare RPC.
+	SQLServiceCreateQueryResultShareProcedure = "/bytebase.v1.SQLService/CreateQueryResultShare"
+	// SQLServiceGetQueryResultShareProcedure is the fully-qualified name of the SQLService's
+	// GetQueryResultShare RPC.
+	SQLServiceGetQueryResultShareProcedure = "/bytebase.v1.SQLService/GetQueryResultShare"
 	// SQLServiceSearchQueryHistoriesProcedure is the fully-qualified name of the SQLService's
 	// SearchQueryHistories RPC.
 	SQLServiceSearchQueryHistoriesProcedure = "/bytebase.v1.SQLService/SearchQueryHistories"
 	// SQLServiceExportProcedure is the fully-qualified name of the SQLService's Export RPC.
 	SQLServiceExportProcedure = "/bytebase.v1.SQLService/Export"
+	// SQLServiceVerifyExportWatermarkProcedure is the fully-qualified name of the SQLService's
+	// VerifyExportWatermark RPC.
+	SQLServiceVerifyExportWatermarkProcedure = "/bytebase.v1.SQLService/VerifyExportWatermark"
 	// SQLServiceDiffMetadataProcedure is the fully-qualified name of the SQLService's DiffMetadata RPC.
 	SQLServiceDiffMetadataProcedure = "/bytebase.v1.SQLService/DiffMetadata"
+	// SQLServiceFormatStatementProcedure is the fully-qualified name of the SQLService's
+	// FormatStatement RPC.
+	SQLServiceFormatStatementProcedure = "/bytebase.v1.SQLService/FormatStatement"
 	// SQLServiceAICompletionProcedure is the fully-qualified name of the SQLService's AICompletion RPC.
 	SQLServiceAICompletionProcedure = "/bytebase.v1.SQLService/AICompletion"
 )
@@ -54,17 +66,33 @@ type SQLServiceClient interface {
 	// Permissions required: bb.databases.get
 	Query(context.Context, *connect.Request[v1.QueryRequest]) (*connect.Response[v1.QueryResponse], error)
 	// Executes SQL with admin privileges via streaming connection.
-	// Permissions required: bb.sql.admin
+	// Permissions required: bb.sql.admin OR caller has an active break-glass admin access grant on the database
 	AdminExecute(context.Context) *connect.BidiStreamForClient[v1.AdminExecuteRequest, v1.AdminExecuteResponse]
+	// Creates a shareable snapshot of a query result.
+	// The statement is executed with the creator's access, and the snapshot is
+	// re-checked and re-masked with the viewer's access when it is read.
+	// Permissions required: bb.databases.get
+	CreateQueryResultShare(context.Context, *connect.Request[v1.CreateQueryResultShareRequest]) (*connect.Response[v1.QueryResultShare], error)
+	// Gets a shared query result with the caller's access check and masking applied.
+	// Permissions required: bb.databases.get
+	GetQueryResultShare(context.Context, *connect.Request[v1.GetQueryResultShareRequest]) (*connect.Response[v1.QueryResultShare], error)
 	// SearchQueryHistories searches query histories for the caller.
 	// Permissions required: None (only returns caller's own query histories)
 	SearchQueryHistories(context.Context, *connect.Request[v1.SearchQueryHistoriesRequest]) (*connect.Response[v1.SearchQueryHistoriesResponse], error)
 	// Exports query results to a file format.
+	// The export archive carries a signed watermark identifying the exporter.
 	// Permissions required: bb.databases.get
 	Export(context.Context, *connect.Request[v1.ExportRequest]) (*connect.Response[v1.ExportResponse], error)
+	// Verifies the watermark of an export archive, or of a file extracted from it, and identifies the exporter.
+	// Only the exports of the workspace are verified, against the digests recorded by the server at the export.
+	// Permissions required: bb.auditLogs.search
+	VerifyExportWatermark(context.Context, *connect.Request[v1.VerifyExportWatermarkRequest]) (*connect.Response[v1.ExportWatermark], error)
 	// Computes schema differences between two database metadata.
 	// Permissions required: None
 	DiffMetadata(context.Context, *connect.Request[v1.DiffMetadataRequest]) (*connect.Response[v1.DiffMetadataResponse], error)
+	// Formats SQL statements in the dialect of the database engine.
+	// Permissions required: None
+	FormatStatement(context.Context, *connect.Request[v1.FormatStatementRequest]) (*connect.Response[v1.FormatStatementResponse], error)
 	// Provides AI-powered SQL completion and generation.
 	// Permissions required: None (authenticated users only, requires AI to be enabled)
 	AICompletion(context.Context, *connect.Request[v1.AICompletionRequest]) (*connect.Response[v1.AICompletionResponse], error)
@@ -93,6 +121,18 @@ func NewSQLServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 			connect.WithSchema(sQLServiceMethods.ByName("AdminExecute")),
 			connect.WithClientOptions(opts...),
 		),
+		createQueryResultShare: connect.NewClient[v1.CreateQueryResultShareRequest, v1.QueryResultShare](
+			httpClient,
+			baseURL+SQLServiceCreateQueryResultShareProcedure,
+			connect.WithSchema(sQLServiceMethods.ByName("CreateQueryResultShare")),
+			connect.WithClientOptions(opts...),
+		),
+		getQueryResultShare: connect.NewClient[v1.GetQueryResultShareRequest, v1.QueryResultShare](
+			httpClient,
+			baseURL+SQLServiceGetQueryResultShareProcedure,
+			connect.WithSchema(sQLServiceMethods.ByName("GetQueryResultShare")),
+			connect.WithClientOptions(opts...),
+		),
 		searchQueryHistories: connect.NewClient[v1.SearchQueryHistoriesRequest, v1.SearchQueryHistoriesResponse](
 			httpClient,
 			baseURL+SQLServiceSearchQueryHistoriesProcedure,
@@ -105,12 +145,24 @@ func NewSQLServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 			connect.WithSchema(sQLServiceMethods.ByName("Export")),
 			connect.WithClientOptions(opts...),
 		),
+		verifyExportWatermark: connect.NewClient[v1.VerifyExportWatermarkRequest, v1.ExportWatermark](
+			httpClient,
+			baseURL+SQLServiceVerifyExportWatermarkProcedure,
+			connect.WithSchema(sQLServiceMethods.ByName("VerifyExportWatermark")),
+			connect.WithClientOptions(opts...),
+		),
 		diffMetadata: connect.NewClient[v1.DiffMetadataRequest, v1.DiffMetadataResponse](
 			httpClient,
 			baseURL+SQLServiceDiffMetadataProcedure,
 			connect.WithSchema(sQLServiceMethods.ByName("DiffMetadata")),
 			connect.WithClientOptions(opts...),
 		),
+		formatStatement: connect.NewClient[v1.FormatStatementRequest, v1.FormatStatementResponse](
+			httpClient,
+			baseURL+SQLServiceFormatStatementProcedure,
+			connect.WithSchema(sQLServiceMethods.ByName("FormatStatement")),
+			connect.WithClientOptions(opts...),
+		),
 		aICompletion: connect.NewClient[v1.AICompletionRequest, v1.AICompletionResponse](
 			httpClient,
 			baseURL+SQLServiceAICompletionProcedure,
@@ -122,12 +174,16 @@ func NewSQLServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 
 // sQLServiceClient implements SQLServiceClient.
 type sQLServiceClient struct {
-	query                *connect.Client[v1.QueryRequest, v1.QueryResponse]
-	adminExecute         *connect.Client[v1.AdminExecuteRequest, v1.AdminExecuteResponse]
-	searchQueryHistories *connect.Client[v1.SearchQueryHistoriesRequest, v1.SearchQueryHistoriesResponse]
-	export               *connect.Client[v1.ExportRequest, v1.ExportResponse]
-	diffMetadata         *connect.Client[v1.DiffMetadataRequest, v1.DiffMetadataResponse]
-	aICompletion         *connect.Client[v1.AICompletionRequest, v1.AICompletionResponse]
+	query                  *connect.Client[v1.QueryRequest, v1.QueryResponse]
+	adminExecute           *connect.Client[v1.AdminExecuteRequest, v1.AdminExecuteResponse]
+	createQueryResultShare *connect.Client[v1.CreateQueryResultShareRequest, v1.QueryResultShare]
+	getQueryResultShare    *connect.Client[v1.GetQueryResultShareRequest, v1.QueryResultShare]
+	searchQueryHistories   *connect.Client[v1.SearchQueryHistoriesRequest, v1.SearchQueryHistoriesResponse]
+	export                 *connect.Client[v1.ExportRequest, v1.ExportResponse]
+	verifyExportWatermark  *connect.Client[v1.VerifyExportWatermarkRequest, v1.ExportWatermark]
+	diffMetadata           *connect.Client[v1.DiffMetadataRequest, v1.DiffMetadataResponse]
+	formatStatement        *connect.Client[v1.FormatStatementRequest, v1.FormatStatementResponse]
+	aICompletion           *connect.Client[v1.AICompletionRequest, v1.AICompletionResponse]
 }
 
 // Query calls bytebase.v1.SQLService.Query.
@@ -140,6 +196,16 @@ func (c *sQLServiceClient) AdminExecute(ctx context.Context) *connect.BidiStream
 	return c.adminExecute.CallBidiStream(ctx)
 }
 
+// CreateQueryResultShare calls bytebase.v1.SQLService.CreateQueryResultShare.
+func (c *sQLServiceClient) CreateQueryResultShare(ctx context.Context, req *connect.Request[v1.CreateQueryResultShareRequest]) (*connect.Response[v1.QueryResultShare], error) {
+	return c.createQueryResultShare.CallUnary(ctx, req)
+}
+
+// GetQueryResultShare calls bytebase.v1.SQLService.GetQueryResultShare.
+func (c *sQLServiceClient) GetQueryResultShare(ctx context.Context, req *connect.Request[v1.GetQueryResultShareRequest]) (*connect.Response[v1.QueryResultShare], error) {
+	return c.getQueryResultShare.CallUnary(ctx, req)
+}
+
 // SearchQueryHistories calls bytebase.v1.SQLService.SearchQueryHistories.
 func (c *sQLServiceClient) SearchQueryHistories(ctx context.Context, req *connect.Request[v1.SearchQueryHistoriesRequest]) (*connect.Response[v1.SearchQueryHistoriesResponse], error) {
 	return c.searchQueryHistories.CallUnary(ctx, req)
@@ -150,11 +216,21 @@ func (c *sQLServiceClient) Export(ctx context.Context, req *connect.Request[v1.E
 	return c.export.CallUnary(ctx, req)
 }
 
+// VerifyExportWatermark calls bytebase.v1.SQLService.VerifyExportWatermark.
+func (c *sQLServiceClient) VerifyExportWatermark(ctx context.Context, req *connect.Request[v1.VerifyExportWatermarkRequest]) (*connect.Response[v1.ExportWatermark], error) {
+	return c.verifyExportWatermark.CallUnary(ctx, req)
+}
+
 // DiffMetadata calls bytebase.v1.SQLService.DiffMetadata.
 func (c *sQLServiceClient) DiffMetadata(ctx context.Context, req *connect.Request[v1.DiffMetadataRequest]) (*connect.Response[v1.DiffMetadataResponse], error) {
 	return c.diffMetadata.CallUnary(ctx, req)
 }
 
+// FormatStatement calls bytebase.v1.SQLService.FormatStatement.
+func (c *sQLServiceClient) FormatStatement(ctx context.Context, req *connect.Request[v1.FormatStatementRequest]) (*connect.Response[v1.FormatStatementResponse], error) {
+	return c.formatStatement.CallUnary(ctx, req)
+}
+
 // AICompletion calls bytebase.v1.SQLService.AICompletion.
 func (c *sQLServiceClient) AICompletion(ctx context.Context, req *connect.Request[v1.AICompletionRequest]) (*connect.Response[v1.AICompletionResponse], error) {
 	return c.aICompletion.CallUnary(ctx, req)
@@ -166,17 +242,33 @@ type SQLServiceHandler interface {
 	// Permissions required: bb.databases.get
 	Query(context.Context, *connect.Request[v1.QueryRequest]) (*connect.Response[v1.QueryResponse], error)
 	// Executes SQL with admin privileges via streaming connection.
-	// Permissions required: bb.sql.admin
+	// Permissions required: bb.sql.admin OR caller has an active break-glass admin access grant on the database
 	AdminExecute(context.Context, *connect.BidiStream[v1.AdminExecuteRequest, v1.AdminExecuteResponse]) error
+	// Creates a shareable snapshot of a query result.
+	// The statement is executed with the creator's access, and the snapshot is
+	// re-checked and re-masked with the viewer's access when it is read.
+	// Permissions required: bb.databases.get
+	CreateQueryResultShare(context.Context, *connect.Request[v1.CreateQueryResultShareRequest]) (*connect.Response[v1.QueryResultShare], error)
+	// Gets a shared query result with the caller's access check and masking applied.
+	// Permissions required: bb.databases.get
+	GetQueryResultShare(context.Context, *connect.Request[v1.GetQueryResultShareRequest]) (*connect.Response[v1.QueryResultShare], error)
 	// SearchQueryHistories searches query histories for the caller.
 	// Permissions required: None (only returns caller's own query histories)
 	SearchQueryHistories(context.Context, *connect.Request[v1.SearchQueryHistoriesRequest]) (*connect.Response[v1.SearchQueryHistoriesResponse], error)
 	// Exports query results to a file format.
+	// The export archive carries a signed watermark identifying the exporter.
 	// Permissions required: bb.databases.get
 	Export(context.Context, *connect.Request[v1.ExportRequest]) (*connect.Response[v1.ExportResponse], error)
+	// Verifies the watermark of an export archive, or of a file extracted from it, and identifies the exporter.
+	// Only the exports of the workspace are verified, against the digests recorded by the server at the export.
+	// Permissions required: bb.auditLogs.search
+	VerifyExportWatermark(context.Context, *connect.Request[v1.VerifyExportWatermarkRequest]) (*connect.Response[v1.ExportWatermark], error)
 	// Computes schema differences between two database metadata.
 	// Permissions required: None
 	DiffMetadata(context.Context, *connect.Request[v1.DiffMetadataRequest]) (*connect.Response[v1.DiffMetadataResponse], error)
+	// Formats SQL statements in the dialect of the database engine.
+	// Permissions required: None
+	FormatStatement(context.Context, *connect.Request[v1.FormatStatementRequest]) (*connect.Response[v1.FormatStatementResponse], error)
 	// Provides AI-powered SQL completion and generation.
 	// Permissions required: None (authenticated users only, requires AI to be enabled)
 	AICompletion(context.Context, *connect.Request[v1.AICompletionRequest]) (*connect.Response[v1.AICompletionResponse], error)
@@ -201,6 +293,18 @@ func NewSQLServiceHandler(svc SQLServiceHandler, opts ...connect.HandlerOption)
 		connect.WithSchema(sQLServiceMethods.ByName("AdminExecute")),
 		connect.WithHandlerOptions(opts...),
 	)
+	sQLServiceCreateQueryResultShareHandler := connect.NewUnaryHandler(
+		SQLServiceCreateQueryResultShareProcedure,
+		svc.CreateQueryResultShare,
+		connect.WithSchema(sQLServiceMethods.ByName("CreateQueryResultShare")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sQLServiceGetQueryResultShareHandler := connect.NewUnaryHandler(
+		SQLServiceGetQueryResultShareProcedure,
+		svc.GetQueryResultShare,
+		connect.WithSchema(sQLServiceMethods.ByName("GetQueryResultShare")),
+		connect.WithHandlerOptions(opts...),
+	)
 	sQLServiceSearchQueryHistoriesHandler := connect.NewUnaryHandler(
 		SQLServiceSearchQueryHistoriesProcedure,
 		svc.SearchQueryHistories,
@@ -213,12 +317,24 @@ func NewSQLServiceHandler(svc SQLServiceHandler, opts ...connect.HandlerOption)
 		connect.WithSchema(sQLServiceMethods.ByName("Export")),
 		connect.WithHandlerOptions(opts...),
 	)
+	sQLServiceVerifyExportWatermarkHandler := connect.NewUnaryHandler(
+		SQLServiceVerifyExportWatermarkProcedure,
+		svc.VerifyExportWatermark,
+		connect.WithSchema(sQLServiceMethods.ByName("VerifyExportWatermark")),
+		connect.WithHandlerOptions(opts...),
+	)
 	sQLServiceDiffMetadataHandler := connect.NewUnaryHandler(
 		SQLServiceDiffMetadataProcedure,
 		svc.DiffMetadata,
 		connect.WithSchema(sQLServiceMethods.ByName("DiffMetadata")),
 		connect.WithHandlerOptions(opts...),
 	)
+	sQLServiceFormatStatementHandler := connect.NewUnaryHandler(
+		SQLServiceFormatStatementProcedure,
+		svc.FormatStatement,
+		connect.WithSchema(sQLServiceMethods.ByName("FormatStatement")),
+		connect.WithHandlerOptions(opts...),
+	)
 	sQLServiceAICompletionHandler := connect.NewUnaryHandler(
 		SQLServiceAICompletionProcedure,
 		svc.AICompletion,
@@ -231,12 +347,20 @@ func NewSQLServiceHandler(svc SQLServiceHandler, opts ...connect.HandlerOption)
 			sQLServiceQueryHandler.ServeHTTP(w, r)
 		case SQLServiceAdminExecuteProcedure:
 			sQLServiceAdminExecuteHandler.ServeHTTP(w, r)
+		case SQLServiceCreateQueryResultShareProcedure:
+			sQLServiceCreateQueryResultShareHandler.ServeHTTP(w, r)
+		case SQLServiceGetQueryResultShareProcedure:
+			sQLServiceGetQueryResultShareHandler.ServeHTTP(w, r)
 		case SQLServiceSearchQueryHistoriesProcedure:
 			sQLServiceSearchQueryHistoriesHandler.ServeHTTP(w, r)
 		case SQLServiceExportProcedure:
 			sQLServiceExportHandler.ServeHTTP(w, r)
+		case SQLServiceVerifyExportWatermarkProcedure:
+			sQLServiceVerifyExportWatermarkHandler.ServeHTTP(w, r)
 		case SQLServiceDiffMetadataProcedure:
 			sQLServiceDiffMetadataHandler.ServeHTTP(w, r)
+		case SQLServiceFormatStatementProcedure:
+			sQLServiceFormatStatementHandler.ServeHTTP(w, r)
 		case SQLServiceAICompletionProcedure:
 			sQLServiceAICompletionHandler.ServeHTTP(w, r)
 		default:
@@ -256,6 +380,14 @@ func (UnimplementedSQLServiceHandler) AdminExecute(context.Context, *connect.Bid
 	return connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.SQLService.AdminExecute is not implemented"))
 }
 
+func (UnimplementedSQLServiceHandler) CreateQueryResultShare(context.Context, *connect.Request[v1.CreateQueryResultShareRequest]) (*connect.Response[v1.QueryResultShare], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.SQLService.CreateQueryResultShare is not implemented"))
+}
+
+func (UnimplementedSQLServiceHandler) GetQueryResultShare(context.Context, *connect.Request[v1.GetQueryResultShareRequest]) (*connect.Response[v1.QueryResultShare], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.SQLService.GetQueryResultShare is not implemented"))
+}
+
 func (UnimplementedSQLServiceHandler) SearchQueryHistories(context.Context, *connect.Request[v1.SearchQueryHistoriesRequest]) (*connect.Response[v1.SearchQueryHistoriesResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.SQLService.SearchQueryHistories is not implemented"))
 }
@@ -264,10 +396,18 @@ func (UnimplementedSQLServiceHandler) Export(context.Context, *connect.Request[v
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.SQLService.Export is not implemented"))
 }
 
+func (UnimplementedSQLServiceHandler) VerifyExportWatermark(context.Context, *connect.Request[v1.VerifyExportWatermarkRequest]) (*connect.Response[v1.ExportWatermark], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.SQLService.VerifyExportWatermark is not implemented"))
+}
+
 func (UnimplementedSQLServiceHandler) DiffMetadata(context.Context, *connect.Request[v1.DiffMetadataRequest]) (*connect.Response[v1.DiffMetadataResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.SQLService.DiffMetadata is not implemented"))
 }
 
+func (UnimplementedSQLServiceHandler) FormatStatement(context.Context, *connect.Request[v1.FormatStatementRequest]) (*connect.Response[v1.FormatStatementResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.SQLService.FormatStatement is not implemented"))
+}
+
 func (UnimplementedSQLServiceHandler) AICompletion(context.Context, *connect.Request[v1.AICompletionRequest]) (*connect.Response[v1.AICompletionResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.SQLService.AICompletion is not implemented"))
 }
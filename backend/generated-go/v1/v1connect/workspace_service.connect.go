@@ -48,6 +48,9 @@ const (
 	// WorkspaceServiceSetIamPolicyProcedure is the fully-qualified name of the WorkspaceService's
 	// SetIamPolicy RPC.
 	WorkspaceServiceSetIamPolicyProcedure = "/bytebase.v1.WorkspaceService/SetIamPolicy"
+	// WorkspaceServiceBatchApplyProcedure is the fully-qualified name of the WorkspaceService's
+	// BatchApply RPC.
+	WorkspaceServiceBatchApplyProcedure = "/bytebase.v1.WorkspaceService/BatchApply"
 )
 
 // WorkspaceServiceClient is a client for the bytebase.v1.WorkspaceService service.
@@ -68,6 +71,15 @@ type WorkspaceServiceClient interface {
 	// Sets IAM policy for the workspace.
 	// Permissions required: bb.workspaces.setIamPolicy
 	SetIamPolicy(context.Context, *connect.Request[v1.SetIamPolicyRequest]) (*connect.Response[v1.IamPolicy], error)
+	// Declaratively applies a set of instances, projects, IAM policies, policies,
+	// settings, review configs and database groups.
+	// All resources are validated before any of them is written, and resources
+	// already in the desired state are left untouched.
+	// The resources other than the instances and new projects are written in a
+	// single transaction. The instances and new projects are written before it,
+	// and they are reverted on a best-effort basis if a later write fails.
+	// Permissions required: the permissions to create or update each resource.
+	BatchApply(context.Context, *connect.Request[v1.BatchApplyRequest]) (*connect.Response[v1.BatchApplyResponse], error)
 }
 
 // NewWorkspaceServiceClient constructs a client for the bytebase.v1.WorkspaceService service. By
@@ -111,6 +123,12 @@ func NewWorkspaceServiceClient(httpClient connect.HTTPClient, baseURL string, op
 			connect.WithSchema(workspaceServiceMethods.ByName("SetIamPolicy")),
 			connect.WithClientOptions(opts...),
 		),
+		batchApply: connect.NewClient[v1.BatchApplyRequest, v1.BatchApplyResponse](
+			httpClient,
+			baseURL+WorkspaceServiceBatchApplyProcedure,
+			connect.WithSchema(workspaceServiceMethods.ByName("BatchApply")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -121,6 +139,7 @@ type workspaceServiceClient struct {
 	updateWorkspace *connect.Client[v1.UpdateWorkspaceRequest, v1.Workspace]
 	getIamPolicy    *connect.Client[v1.GetIamPolicyRequest, v1.IamPolicy]
 	setIamPolicy    *connect.Client[v1.SetIamPolicyRequest, v1.IamPolicy]
+	batchApply      *connect.Client[v1.BatchApplyRequest, v1.BatchApplyResponse]
 }
 
 // GetWorkspace calls bytebase.v1.WorkspaceService.GetWorkspace.
@@ -148,6 +167,11 @@ func (c *workspaceServiceClient) SetIamPolicy(ctx context.Context, req *connect.
 	return c.setIamPolicy.CallUnary(ctx, req)
 }
 
+// BatchApply calls bytebase.v1.WorkspaceService.BatchApply.
+func (c *workspaceServiceClient) BatchApply(ctx context.Context, req *connect.Request[v1.BatchApplyRequest]) (*connect.Response[v1.BatchApplyResponse], error) {
+	return c.batchApply.CallUnary(ctx, req)
+}
+
 // WorkspaceServiceHandler is an implementation of the bytebase.v1.WorkspaceService service.
 type WorkspaceServiceHandler interface {
 	// Gets a workspace by name.
@@ -166,6 +190,15 @@ type WorkspaceServiceHandler interface {
 	// Sets IAM policy for the workspace.
 	// Permissions required: bb.workspaces.setIamPolicy
 	SetIamPolicy(context.Context, *connect.Request[v1.SetIamPolicyRequest]) (*connect.Response[v1.IamPolicy], error)
+	// Declaratively applies a set of instances, projects, IAM policies, policies,
+	// settings, review configs and database groups.
+	// All resources are validated before any of them is written, and resources
+	// already in the desired state are left untouched.
+	// The resources other than the instances and new projects are written in a
+	// single transaction. The instances and new projects are written before it,
+	// and they are reverted on a best-effort basis if a later write fails.
+	// Permissions required: the permissions to create or update each resource.
+	BatchApply(context.Context, *connect.Request[v1.BatchApplyRequest]) (*connect.Response[v1.BatchApplyResponse], error)
 }
 
 // NewWorkspaceServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -205,6 +238,12 @@ func NewWorkspaceServiceHandler(svc WorkspaceServiceHandler, opts ...connect.Han
 		connect.WithSchema(workspaceServiceMethods.ByName("SetIamPolicy")),
 		connect.WithHandlerOptions(opts...),
 	)
+	workspaceServiceBatchApplyHandler := connect.NewUnaryHandler(
+		WorkspaceServiceBatchApplyProcedure,
+		svc.BatchApply,
+		connect.WithSchema(workspaceServiceMethods.ByName("BatchApply")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/bytebase.v1.WorkspaceService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case WorkspaceServiceGetWorkspaceProcedure:
@@ -217,6 +256,8 @@ func NewWorkspaceServiceHandler(svc WorkspaceServiceHandler, opts ...connect.Han
 			workspaceServiceGetIamPolicyHandler.ServeHTTP(w, r)
 		case WorkspaceServiceSetIamPolicyProcedure:
 			workspaceServiceSetIamPolicyHandler.ServeHTTP(w, r)
+		case WorkspaceServiceBatchApplyProcedure:
+			workspaceServiceBatchApplyHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -245,3 +286,7 @@ func (UnimplementedWorkspaceServiceHandler) GetIamPolicy(context.Context, *conne
 func (UnimplementedWorkspaceServiceHandler) SetIamPolicy(context.Context, *connect.Request[v1.SetIamPolicyRequest]) (*connect.Response[v1.IamPolicy], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.WorkspaceService.SetIamPolicy is not implemented"))
 }
+
+func (UnimplementedWorkspaceServiceHandler) BatchApply(context.Context, *connect.Request[v1.BatchApplyRequest]) (*connect.Response[v1.BatchApplyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bytebase.v1.WorkspaceService.BatchApply is not implemented"))
+}
@@ -81,6 +81,64 @@ func (Worksheet_Visibility) EnumDescriptor() ([]byte, []int) {
 	return file_v1_worksheet_service_proto_rawDescGZIP(), []int{10, 0}
 }
 
+type WorksheetParameter_Type int32
+
+const (
+	WorksheetParameter_TYPE_UNSPECIFIED WorksheetParameter_Type = 0
+	WorksheetParameter_STRING           WorksheetParameter_Type = 1
+	// A 64-bit integer.
+	WorksheetParameter_INT WorksheetParameter_Type = 2
+	// A date in YYYY-MM-DD format.
+	WorksheetParameter_DATE WorksheetParameter_Type = 3
+	// One of the allowed values.
+	WorksheetParameter_ENUM WorksheetParameter_Type = 4
+)
+
+// Enum value maps for WorksheetParameter_Type.
+var (
+	WorksheetParameter_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "STRING",
+		2: "INT",
+		3: "DATE",
+		4: "ENUM",
+	}
+	WorksheetParameter_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"STRING":           1,
+		"INT":              2,
+		"DATE":             3,
+		"ENUM":             4,
+	}
+)
+
+func (x WorksheetParameter_Type) Enum() *WorksheetParameter_Type {
+	p := new(WorksheetParameter_Type)
+	*p = x
+	return p
+}
+
+func (x WorksheetParameter_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WorksheetParameter_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_worksheet_service_proto_enumTypes[1].Descriptor()
+}
+
+func (WorksheetParameter_Type) Type() protoreflect.EnumType {
+	return &file_v1_worksheet_service_proto_enumTypes[1]
+}
+
+func (x WorksheetParameter_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WorksheetParameter_Type.Descriptor instead.
+func (WorksheetParameter_Type) EnumDescriptor() ([]byte, []int) {
+	return file_v1_worksheet_service_proto_rawDescGZIP(), []int{11, 0}
+}
+
 type CreateWorksheetRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The parent resource where this worksheet will be created.
@@ -197,6 +255,8 @@ type UpdateWorksheetRequest struct {
 	// - `statement`
 	// - `starred`
 	// - `visibility`
+	// - `parameters`
+	// - `runbook_role`
 	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -657,8 +717,15 @@ type Worksheet struct {
 	ContentSize int64                `protobuf:"varint,9,opt,name=content_size,json=contentSize,proto3" json:"content_size,omitempty"`
 	Visibility  Worksheet_Visibility `protobuf:"varint,10,opt,name=visibility,proto3,enum=bytebase.v1.Worksheet_Visibility" json:"visibility,omitempty"`
 	// starred indicates whether the worksheet is starred by the current authenticated user.
-	Starred       bool     `protobuf:"varint,11,opt,name=starred,proto3" json:"starred,omitempty"`
-	Folders       []string `protobuf:"bytes,12,rep,name=folders,proto3" json:"folders,omitempty"`
+	Starred bool     `protobuf:"varint,11,opt,name=starred,proto3" json:"starred,omitempty"`
+	Folders []string `protobuf:"bytes,12,rep,name=folders,proto3" json:"folders,omitempty"`
+	// The named parameters referenced as `:name` in the content.
+	// The values are bound through the database driver placeholders when the worksheet is queried.
+	Parameters []*WorksheetParameter `protobuf:"bytes,13,rep,name=parameters,proto3" json:"parameters,omitempty"`
+	// The role that can read and run the worksheet as a read-only runbook.
+	// Project members with the role can read the worksheet regardless of the visibility, but cannot write it.
+	// Format: roles/{role}
+	RunbookRole   string `protobuf:"bytes,14,opt,name=runbook_role,json=runbookRole,proto3" json:"runbook_role,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -777,6 +844,100 @@ func (x *Worksheet) GetFolders() []string {
 	return nil
 }
 
+func (x *Worksheet) GetParameters() []*WorksheetParameter {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+func (x *Worksheet) GetRunbookRole() string {
+	if x != nil {
+		return x.RunbookRole
+	}
+	return ""
+}
+
+type WorksheetParameter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the parameter, referenced as `:name` in the worksheet content.
+	Name        string                  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type        WorksheetParameter_Type `protobuf:"varint,2,opt,name=type,proto3,enum=bytebase.v1.WorksheetParameter_Type" json:"type,omitempty"`
+	Description string                  `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// The value used when the parameter is not given in the query.
+	// If empty, the parameter is required.
+	DefaultValue string `protobuf:"bytes,4,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	// The allowed values of ENUM parameters.
+	AllowedValues []string `protobuf:"bytes,5,rep,name=allowed_values,json=allowedValues,proto3" json:"allowed_values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorksheetParameter) Reset() {
+	*x = WorksheetParameter{}
+	mi := &file_v1_worksheet_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorksheetParameter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorksheetParameter) ProtoMessage() {}
+
+func (x *WorksheetParameter) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_worksheet_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorksheetParameter.ProtoReflect.Descriptor instead.
+func (*WorksheetParameter) Descriptor() ([]byte, []int) {
+	return file_v1_worksheet_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *WorksheetParameter) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WorksheetParameter) GetType() WorksheetParameter_Type {
+	if x != nil {
+		return x.Type
+	}
+	return WorksheetParameter_TYPE_UNSPECIFIED
+}
+
+func (x *WorksheetParameter) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *WorksheetParameter) GetDefaultValue() string {
+	if x != nil {
+		return x.DefaultValue
+	}
+	return ""
+}
+
+func (x *WorksheetParameter) GetAllowedValues() []string {
+	if x != nil {
+		return x.AllowedValues
+	}
+	return nil
+}
+
 var File_v1_worksheet_service_proto protoreflect.FileDescriptor
 
 const file_v1_worksheet_service_proto_rawDesc = "" +
@@ -812,7 +973,7 @@ const file_v1_worksheet_service_proto_rawDesc = "" +
 	"\x18SearchWorksheetsResponse\x126\n" +
 	"\n" +
 	"worksheets\x18\x01 \x03(\v2\x16.bytebase.v1.WorksheetR\n" +
-	"worksheets\"\xd1\x04\n" +
+	"worksheets\"\xb5\x05\n" +
 	"\tWorksheet\x12\x1a\n" +
 	"\x04name\x18\x01 \x01(\tB\x06\xe0A\x02\xe0A\x05R\x04name\x12\x1d\n" +
 	"\aproject\x18\x02 \x01(\tB\x03\xe0A\x03R\aproject\x12\x1a\n" +
@@ -830,13 +991,30 @@ const file_v1_worksheet_service_proto_rawDesc = "" +
 	" \x01(\x0e2!.bytebase.v1.Worksheet.VisibilityB\x03\xe0A\x02R\n" +
 	"visibility\x12\x1d\n" +
 	"\astarred\x18\v \x01(\bB\x03\xe0A\x03R\astarred\x12\x1d\n" +
-	"\afolders\x18\f \x03(\tB\x03\xe0A\x03R\afolders\"Z\n" +
+	"\afolders\x18\f \x03(\tB\x03\xe0A\x03R\afolders\x12?\n" +
+	"\n" +
+	"parameters\x18\r \x03(\v2\x1f.bytebase.v1.WorksheetParameterR\n" +
+	"parameters\x12!\n" +
+	"\frunbook_role\x18\x0e \x01(\tR\vrunbookRole\"Z\n" +
 	"\n" +
 	"Visibility\x12\x1a\n" +
 	"\x16VISIBILITY_UNSPECIFIED\x10\x00\x12\x10\n" +
 	"\fPROJECT_READ\x10\x01\x12\x11\n" +
 	"\rPROJECT_WRITE\x10\x02\x12\v\n" +
-	"\aPRIVATE\x10\x032\xa3\t\n" +
+	"\aPRIVATE\x10\x03\"\xa1\x02\n" +
+	"\x12WorksheetParameter\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x02R\x04name\x12=\n" +
+	"\x04type\x18\x02 \x01(\x0e2$.bytebase.v1.WorksheetParameter.TypeB\x03\xe0A\x02R\x04type\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12#\n" +
+	"\rdefault_value\x18\x04 \x01(\tR\fdefaultValue\x12%\n" +
+	"\x0eallowed_values\x18\x05 \x03(\tR\rallowedValues\"E\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\n" +
+	"\n" +
+	"\x06STRING\x10\x01\x12\a\n" +
+	"\x03INT\x10\x02\x12\b\n" +
+	"\x04DATE\x10\x03\x12\b\n" +
+	"\x04ENUM\x10\x042\xa3\t\n" +
 	"\x10WorksheetService\x12\x9c\x01\n" +
 	"\x0fCreateWorksheet\x12#.bytebase.v1.CreateWorksheetRequest\x1a\x16.bytebase.v1.Worksheet\"L\xdaA\x10parent,worksheet\x90\xea0\x02\x82\xd3\xe4\x93\x02/:\tworksheet\"\"/v1/{parent=projects/*}/worksheets\x12\x7f\n" +
 	"\fGetWorksheet\x12 .bytebase.v1.GetWorksheetRequest\x1a\x16.bytebase.v1.Worksheet\"5\xdaA\x04name\x90\xea0\x02\x82\xd3\xe4\x93\x02$\x12\"/v1/{name=projects/*/worksheets/*}\x12\xa2\x01\n" +
@@ -859,56 +1037,60 @@ func file_v1_worksheet_service_proto_rawDescGZIP() []byte {
 	return file_v1_worksheet_service_proto_rawDescData
 }
 
-var file_v1_worksheet_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_v1_worksheet_service_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_v1_worksheet_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_v1_worksheet_service_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_v1_worksheet_service_proto_goTypes = []any{
 	(Worksheet_Visibility)(0),                     // 0: bytebase.v1.Worksheet.Visibility
-	(*CreateWorksheetRequest)(nil),                // 1: bytebase.v1.CreateWorksheetRequest
-	(*GetWorksheetRequest)(nil),                   // 2: bytebase.v1.GetWorksheetRequest
-	(*UpdateWorksheetRequest)(nil),                // 3: bytebase.v1.UpdateWorksheetRequest
-	(*BatchUpdateWorksheetOrganizerRequest)(nil),  // 4: bytebase.v1.BatchUpdateWorksheetOrganizerRequest
-	(*BatchUpdateWorksheetOrganizerResponse)(nil), // 5: bytebase.v1.BatchUpdateWorksheetOrganizerResponse
-	(*UpdateWorksheetOrganizerRequest)(nil),       // 6: bytebase.v1.UpdateWorksheetOrganizerRequest
-	(*WorksheetOrganizer)(nil),                    // 7: bytebase.v1.WorksheetOrganizer
-	(*DeleteWorksheetRequest)(nil),                // 8: bytebase.v1.DeleteWorksheetRequest
-	(*SearchWorksheetsRequest)(nil),               // 9: bytebase.v1.SearchWorksheetsRequest
-	(*SearchWorksheetsResponse)(nil),              // 10: bytebase.v1.SearchWorksheetsResponse
-	(*Worksheet)(nil),                             // 11: bytebase.v1.Worksheet
-	(*fieldmaskpb.FieldMask)(nil),                 // 12: google.protobuf.FieldMask
-	(*timestamppb.Timestamp)(nil),                 // 13: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),                         // 14: google.protobuf.Empty
+	(WorksheetParameter_Type)(0),                  // 1: bytebase.v1.WorksheetParameter.Type
+	(*CreateWorksheetRequest)(nil),                // 2: bytebase.v1.CreateWorksheetRequest
+	(*GetWorksheetRequest)(nil),                   // 3: bytebase.v1.GetWorksheetRequest
+	(*UpdateWorksheetRequest)(nil),                // 4: bytebase.v1.UpdateWorksheetRequest
+	(*BatchUpdateWorksheetOrganizerRequest)(nil),  // 5: bytebase.v1.BatchUpdateWorksheetOrganizerRequest
+	(*BatchUpdateWorksheetOrganizerResponse)(nil), // 6: bytebase.v1.BatchUpdateWorksheetOrganizerResponse
+	(*UpdateWorksheetOrganizerRequest)(nil),       // 7: bytebase.v1.UpdateWorksheetOrganizerRequest
+	(*WorksheetOrganizer)(nil),                    // 8: bytebase.v1.WorksheetOrganizer
+	(*DeleteWorksheetRequest)(nil),                // 9: bytebase.v1.DeleteWorksheetRequest
+	(*SearchWorksheetsRequest)(nil),               // 10: bytebase.v1.SearchWorksheetsRequest
+	(*SearchWorksheetsResponse)(nil),              // 11: bytebase.v1.SearchWorksheetsResponse
+	(*Worksheet)(nil),                             // 12: bytebase.v1.Worksheet
+	(*WorksheetParameter)(nil),                    // 13: bytebase.v1.WorksheetParameter
+	(*fieldmaskpb.FieldMask)(nil),                 // 14: google.protobuf.FieldMask
+	(*timestamppb.Timestamp)(nil),                 // 15: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                         // 16: google.protobuf.Empty
 }
 var file_v1_worksheet_service_proto_depIdxs = []int32{
-	11, // 0: bytebase.v1.CreateWorksheetRequest.worksheet:type_name -> bytebase.v1.Worksheet
-	11, // 1: bytebase.v1.UpdateWorksheetRequest.worksheet:type_name -> bytebase.v1.Worksheet
-	12, // 2: bytebase.v1.UpdateWorksheetRequest.update_mask:type_name -> google.protobuf.FieldMask
-	6,  // 3: bytebase.v1.BatchUpdateWorksheetOrganizerRequest.requests:type_name -> bytebase.v1.UpdateWorksheetOrganizerRequest
-	7,  // 4: bytebase.v1.BatchUpdateWorksheetOrganizerResponse.worksheet_organizers:type_name -> bytebase.v1.WorksheetOrganizer
-	7,  // 5: bytebase.v1.UpdateWorksheetOrganizerRequest.organizer:type_name -> bytebase.v1.WorksheetOrganizer
-	12, // 6: bytebase.v1.UpdateWorksheetOrganizerRequest.update_mask:type_name -> google.protobuf.FieldMask
-	11, // 7: bytebase.v1.SearchWorksheetsResponse.worksheets:type_name -> bytebase.v1.Worksheet
-	13, // 8: bytebase.v1.Worksheet.create_time:type_name -> google.protobuf.Timestamp
-	13, // 9: bytebase.v1.Worksheet.update_time:type_name -> google.protobuf.Timestamp
+	12, // 0: bytebase.v1.CreateWorksheetRequest.worksheet:type_name -> bytebase.v1.Worksheet
+	12, // 1: bytebase.v1.UpdateWorksheetRequest.worksheet:type_name -> bytebase.v1.Worksheet
+	14, // 2: bytebase.v1.UpdateWorksheetRequest.update_mask:type_name -> google.protobuf.FieldMask
+	7,  // 3: bytebase.v1.BatchUpdateWorksheetOrganizerRequest.requests:type_name -> bytebase.v1.UpdateWorksheetOrganizerRequest
+	8,  // 4: bytebase.v1.BatchUpdateWorksheetOrganizerResponse.worksheet_organizers:type_name -> bytebase.v1.WorksheetOrganizer
+	8,  // 5: bytebase.v1.UpdateWorksheetOrganizerRequest.organizer:type_name -> bytebase.v1.WorksheetOrganizer
+	14, // 6: bytebase.v1.UpdateWorksheetOrganizerRequest.update_mask:type_name -> google.protobuf.FieldMask
+	12, // 7: bytebase.v1.SearchWorksheetsResponse.worksheets:type_name -> bytebase.v1.Worksheet
+	15, // 8: bytebase.v1.Worksheet.create_time:type_name -> google.protobuf.Timestamp
+	15, // 9: bytebase.v1.Worksheet.update_time:type_name -> google.protobuf.Timestamp
 	0,  // 10: bytebase.v1.Worksheet.visibility:type_name -> bytebase.v1.Worksheet.Visibility
-	1,  // 11: bytebase.v1.WorksheetService.CreateWorksheet:input_type -> bytebase.v1.CreateWorksheetRequest
-	2,  // 12: bytebase.v1.WorksheetService.GetWorksheet:input_type -> bytebase.v1.GetWorksheetRequest
-	9,  // 13: bytebase.v1.WorksheetService.SearchWorksheets:input_type -> bytebase.v1.SearchWorksheetsRequest
-	3,  // 14: bytebase.v1.WorksheetService.UpdateWorksheet:input_type -> bytebase.v1.UpdateWorksheetRequest
-	6,  // 15: bytebase.v1.WorksheetService.UpdateWorksheetOrganizer:input_type -> bytebase.v1.UpdateWorksheetOrganizerRequest
-	4,  // 16: bytebase.v1.WorksheetService.BatchUpdateWorksheetOrganizer:input_type -> bytebase.v1.BatchUpdateWorksheetOrganizerRequest
-	8,  // 17: bytebase.v1.WorksheetService.DeleteWorksheet:input_type -> bytebase.v1.DeleteWorksheetRequest
-	11, // 18: bytebase.v1.WorksheetService.CreateWorksheet:output_type -> bytebase.v1.Worksheet
-	11, // 19: bytebase.v1.WorksheetService.GetWorksheet:output_type -> bytebase.v1.Worksheet
-	10, // 20: bytebase.v1.WorksheetService.SearchWorksheets:output_type -> bytebase.v1.SearchWorksheetsResponse
-	11, // 21: bytebase.v1.WorksheetService.UpdateWorksheet:output_type -> bytebase.v1.Worksheet
-	7,  // 22: bytebase.v1.WorksheetService.UpdateWorksheetOrganizer:output_type -> bytebase.v1.WorksheetOrganizer
-	5,  // 23: bytebase.v1.WorksheetService.BatchUpdateWorksheetOrganizer:output_type -> bytebase.v1.BatchUpdateWorksheetOrganizerResponse
-	14, // 24: bytebase.v1.WorksheetService.DeleteWorksheet:output_type -> google.protobuf.Empty
-	18, // [18:25] is the sub-list for method output_type
-	11, // [11:18] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	13, // 11: bytebase.v1.Worksheet.parameters:type_name -> bytebase.v1.WorksheetParameter
+	1,  // 12: bytebase.v1.WorksheetParameter.type:type_name -> bytebase.v1.WorksheetParameter.Type
+	2,  // 13: bytebase.v1.WorksheetService.CreateWorksheet:input_type -> bytebase.v1.CreateWorksheetRequest
+	3,  // 14: bytebase.v1.WorksheetService.GetWorksheet:input_type -> bytebase.v1.GetWorksheetRequest
+	10, // 15: bytebase.v1.WorksheetService.SearchWorksheets:input_type -> bytebase.v1.SearchWorksheetsRequest
+	4,  // 16: bytebase.v1.WorksheetService.UpdateWorksheet:input_type -> bytebase.v1.UpdateWorksheetRequest
+	7,  // 17: bytebase.v1.WorksheetService.UpdateWorksheetOrganizer:input_type -> bytebase.v1.UpdateWorksheetOrganizerRequest
+	5,  // 18: bytebase.v1.WorksheetService.BatchUpdateWorksheetOrganizer:input_type -> bytebase.v1.BatchUpdateWorksheetOrganizerRequest
+	9,  // 19: bytebase.v1.WorksheetService.DeleteWorksheet:input_type -> bytebase.v1.DeleteWorksheetRequest
+	12, // 20: bytebase.v1.WorksheetService.CreateWorksheet:output_type -> bytebase.v1.Worksheet
+	12, // 21: bytebase.v1.WorksheetService.GetWorksheet:output_type -> bytebase.v1.Worksheet
+	11, // 22: bytebase.v1.WorksheetService.SearchWorksheets:output_type -> bytebase.v1.SearchWorksheetsResponse
+	12, // 23: bytebase.v1.WorksheetService.UpdateWorksheet:output_type -> bytebase.v1.Worksheet
+	8,  // 24: bytebase.v1.WorksheetService.UpdateWorksheetOrganizer:output_type -> bytebase.v1.WorksheetOrganizer
+	6,  // 25: bytebase.v1.WorksheetService.BatchUpdateWorksheetOrganizer:output_type -> bytebase.v1.BatchUpdateWorksheetOrganizerResponse
+	16, // 26: bytebase.v1.WorksheetService.DeleteWorksheet:output_type -> google.protobuf.Empty
+	20, // [20:27] is the sub-list for method output_type
+	13, // [13:20] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_v1_worksheet_service_proto_init() }
@@ -922,8 +1104,8 @@ func file_v1_worksheet_service_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_worksheet_service_proto_rawDesc), len(file_v1_worksheet_service_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   11,
+			NumEnums:      2,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -235,5 +235,46 @@ func (x *Worksheet) Equal(y *Worksheet) bool {
 			return false
 		}
 	}
+	if len(x.Parameters) != len(y.Parameters) {
+		return false
+	}
+	for i := 0; i < len(x.Parameters); i++ {
+		if !x.Parameters[i].Equal(y.Parameters[i]) {
+			return false
+		}
+	}
+	if x.RunbookRole != y.RunbookRole {
+		return false
+	}
+	return true
+}
+
+func (x *WorksheetParameter) Equal(y *WorksheetParameter) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.Type != y.Type {
+		return false
+	}
+	if x.Description != y.Description {
+		return false
+	}
+	if x.DefaultValue != y.DefaultValue {
+		return false
+	}
+	if len(x.AllowedValues) != len(y.AllowedValues) {
+		return false
+	}
+	for i := 0; i < len(x.AllowedValues); i++ {
+		if x.AllowedValues[i] != y.AllowedValues[i] {
+			return false
+		}
+	}
 	return true
 }
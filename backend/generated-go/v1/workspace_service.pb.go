@@ -23,6 +23,71 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// The status of the resource.
+type BatchApplyResponse_Result_Status int32
+
+const (
+	BatchApplyResponse_Result_STATUS_UNSPECIFIED BatchApplyResponse_Result_Status = 0
+	// The resource has been created.
+	BatchApplyResponse_Result_CREATED BatchApplyResponse_Result_Status = 1
+	// The resource has been updated.
+	BatchApplyResponse_Result_UPDATED BatchApplyResponse_Result_Status = 2
+	// The resource is already in the desired state.
+	BatchApplyResponse_Result_UNCHANGED BatchApplyResponse_Result_Status = 3
+	// The resource failed to validate or apply.
+	BatchApplyResponse_Result_FAILED BatchApplyResponse_Result_Status = 4
+	// The resource has not been applied, or it has been restored,
+	// because another resource failed.
+	BatchApplyResponse_Result_NOT_APPLIED BatchApplyResponse_Result_Status = 5
+)
+
+// Enum value maps for BatchApplyResponse_Result_Status.
+var (
+	BatchApplyResponse_Result_Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "CREATED",
+		2: "UPDATED",
+		3: "UNCHANGED",
+		4: "FAILED",
+		5: "NOT_APPLIED",
+	}
+	BatchApplyResponse_Result_Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED": 0,
+		"CREATED":            1,
+		"UPDATED":            2,
+		"UNCHANGED":          3,
+		"FAILED":             4,
+		"NOT_APPLIED":        5,
+	}
+)
+
+func (x BatchApplyResponse_Result_Status) Enum() *BatchApplyResponse_Result_Status {
+	p := new(BatchApplyResponse_Result_Status)
+	*p = x
+	return p
+}
+
+func (x BatchApplyResponse_Result_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BatchApplyResponse_Result_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_workspace_service_proto_enumTypes[0].Descriptor()
+}
+
+func (BatchApplyResponse_Result_Status) Type() protoreflect.EnumType {
+	return &file_v1_workspace_service_proto_enumTypes[0]
+}
+
+func (x BatchApplyResponse_Result_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BatchApplyResponse_Result_Status.Descriptor instead.
+func (BatchApplyResponse_Result_Status) EnumDescriptor() ([]byte, []int) {
+	return file_v1_workspace_service_proto_rawDescGZIP(), []int{6, 0, 0}
+}
+
 type ListWorkspacesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -263,11 +328,500 @@ func (x *UpdateWorkspaceRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
 	return nil
 }
 
+type BatchApplyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The workspace to apply the resources to.
+	// Format: workspaces/{workspace}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The desired resources.
+	// Instances are applied first, then projects, IAM policies and the others,
+	// so a resource can refer to another one created in the same request.
+	// The settings are written in the transaction, so an instance cannot refer
+	// to an environment added in the same request.
+	// If a resource fails to apply, the resources already applied are restored.
+	Resources []*BatchApplyRequest_Resource `protobuf:"bytes,2,rep,name=resources,proto3" json:"resources,omitempty"`
+	// If set, the resources are validated and the results report what would change,
+	// but nothing is written.
+	ValidateOnly  bool `protobuf:"varint,3,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchApplyRequest) Reset() {
+	*x = BatchApplyRequest{}
+	mi := &file_v1_workspace_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchApplyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchApplyRequest) ProtoMessage() {}
+
+func (x *BatchApplyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_workspace_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchApplyRequest.ProtoReflect.Descriptor instead.
+func (*BatchApplyRequest) Descriptor() ([]byte, []int) {
+	return file_v1_workspace_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BatchApplyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BatchApplyRequest) GetResources() []*BatchApplyRequest_Resource {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+func (x *BatchApplyRequest) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
+type BatchApplyResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The results in the order of the request resources.
+	Results       []*BatchApplyResponse_Result `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchApplyResponse) Reset() {
+	*x = BatchApplyResponse{}
+	mi := &file_v1_workspace_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchApplyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchApplyResponse) ProtoMessage() {}
+
+func (x *BatchApplyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_workspace_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchApplyResponse.ProtoReflect.Descriptor instead.
+func (*BatchApplyResponse) Descriptor() ([]byte, []int) {
+	return file_v1_workspace_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BatchApplyResponse) GetResults() []*BatchApplyResponse_Result {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// A desired resource.
+type BatchApplyRequest_Resource struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The desired state of the resource, identified by its name.
+	// A resource that does not exist is created.
+	//
+	// Types that are valid to be assigned to Resource:
+	//
+	//	*BatchApplyRequest_Resource_Instance
+	//	*BatchApplyRequest_Resource_Project
+	//	*BatchApplyRequest_Resource_IamPolicy
+	//	*BatchApplyRequest_Resource_Policy
+	//	*BatchApplyRequest_Resource_Setting
+	//	*BatchApplyRequest_Resource_ReviewConfig
+	//	*BatchApplyRequest_Resource_DatabaseGroups
+	Resource isBatchApplyRequest_Resource_Resource `protobuf_oneof:"resource"`
+	// The fields managed by the request.
+	// If not set, all fields supported by the update method of the resource are managed,
+	// except the instance data sources, which are only used to create the instance.
+	// Only the managed fields that differ from the current state are updated.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,5,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// The etag returned by a previous apply.
+	// If set and the resource has changed since, the resource fails with ABORTED.
+	Etag          string `protobuf:"bytes,6,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchApplyRequest_Resource) Reset() {
+	*x = BatchApplyRequest_Resource{}
+	mi := &file_v1_workspace_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchApplyRequest_Resource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchApplyRequest_Resource) ProtoMessage() {}
+
+func (x *BatchApplyRequest_Resource) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_workspace_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchApplyRequest_Resource.ProtoReflect.Descriptor instead.
+func (*BatchApplyRequest_Resource) Descriptor() ([]byte, []int) {
+	return file_v1_workspace_service_proto_rawDescGZIP(), []int{5, 0}
+}
+
+func (x *BatchApplyRequest_Resource) GetResource() isBatchApplyRequest_Resource_Resource {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+func (x *BatchApplyRequest_Resource) GetInstance() *Instance {
+	if x != nil {
+		if x, ok := x.Resource.(*BatchApplyRequest_Resource_Instance); ok {
+			return x.Instance
+		}
+	}
+	return nil
+}
+
+func (x *BatchApplyRequest_Resource) GetProject() *Project {
+	if x != nil {
+		if x, ok := x.Resource.(*BatchApplyRequest_Resource_Project); ok {
+			return x.Project
+		}
+	}
+	return nil
+}
+
+func (x *BatchApplyRequest_Resource) GetIamPolicy() *BatchApplyRequest_IamPolicyResource {
+	if x != nil {
+		if x, ok := x.Resource.(*BatchApplyRequest_Resource_IamPolicy); ok {
+			return x.IamPolicy
+		}
+	}
+	return nil
+}
+
+func (x *BatchApplyRequest_Resource) GetPolicy() *Policy {
+	if x != nil {
+		if x, ok := x.Resource.(*BatchApplyRequest_Resource_Policy); ok {
+			return x.Policy
+		}
+	}
+	return nil
+}
+
+func (x *BatchApplyRequest_Resource) GetSetting() *Setting {
+	if x != nil {
+		if x, ok := x.Resource.(*BatchApplyRequest_Resource_Setting); ok {
+			return x.Setting
+		}
+	}
+	return nil
+}
+
+func (x *BatchApplyRequest_Resource) GetReviewConfig() *ReviewConfig {
+	if x != nil {
+		if x, ok := x.Resource.(*BatchApplyRequest_Resource_ReviewConfig); ok {
+			return x.ReviewConfig
+		}
+	}
+	return nil
+}
+
+func (x *BatchApplyRequest_Resource) GetDatabaseGroups() *BatchApplyRequest_DatabaseGroupsResource {
+	if x != nil {
+		if x, ok := x.Resource.(*BatchApplyRequest_Resource_DatabaseGroups); ok {
+			return x.DatabaseGroups
+		}
+	}
+	return nil
+}
+
+func (x *BatchApplyRequest_Resource) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *BatchApplyRequest_Resource) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type isBatchApplyRequest_Resource_Resource interface {
+	isBatchApplyRequest_Resource_Resource()
+}
+
+type BatchApplyRequest_Resource_Instance struct {
+	Instance *Instance `protobuf:"bytes,1,opt,name=instance,proto3,oneof"`
+}
+
+type BatchApplyRequest_Resource_Project struct {
+	Project *Project `protobuf:"bytes,2,opt,name=project,proto3,oneof"`
+}
+
+type BatchApplyRequest_Resource_IamPolicy struct {
+	IamPolicy *BatchApplyRequest_IamPolicyResource `protobuf:"bytes,3,opt,name=iam_policy,json=iamPolicy,proto3,oneof"`
+}
+
+type BatchApplyRequest_Resource_Policy struct {
+	Policy *Policy `protobuf:"bytes,4,opt,name=policy,proto3,oneof"`
+}
+
+type BatchApplyRequest_Resource_Setting struct {
+	// Only the ENVIRONMENT and WORKSPACE_APPROVAL settings are supported.
+	Setting *Setting `protobuf:"bytes,7,opt,name=setting,proto3,oneof"`
+}
+
+type BatchApplyRequest_Resource_ReviewConfig struct {
+	ReviewConfig *ReviewConfig `protobuf:"bytes,8,opt,name=review_config,json=reviewConfig,proto3,oneof"`
+}
+
+type BatchApplyRequest_Resource_DatabaseGroups struct {
+	DatabaseGroups *BatchApplyRequest_DatabaseGroupsResource `protobuf:"bytes,9,opt,name=database_groups,json=databaseGroups,proto3,oneof"`
+}
+
+func (*BatchApplyRequest_Resource_Instance) isBatchApplyRequest_Resource_Resource() {}
+
+func (*BatchApplyRequest_Resource_Project) isBatchApplyRequest_Resource_Resource() {}
+
+func (*BatchApplyRequest_Resource_IamPolicy) isBatchApplyRequest_Resource_Resource() {}
+
+func (*BatchApplyRequest_Resource_Policy) isBatchApplyRequest_Resource_Resource() {}
+
+func (*BatchApplyRequest_Resource_Setting) isBatchApplyRequest_Resource_Resource() {}
+
+func (*BatchApplyRequest_Resource_ReviewConfig) isBatchApplyRequest_Resource_Resource() {}
+
+func (*BatchApplyRequest_Resource_DatabaseGroups) isBatchApplyRequest_Resource_Resource() {}
+
+// The IAM policy of a project or the workspace.
+type BatchApplyRequest_IamPolicyResource struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Format: projects/{project} or workspaces/{workspace}
+	Resource string `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	// The desired IAM policy.
+	Policy        *IamPolicy `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchApplyRequest_IamPolicyResource) Reset() {
+	*x = BatchApplyRequest_IamPolicyResource{}
+	mi := &file_v1_workspace_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchApplyRequest_IamPolicyResource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchApplyRequest_IamPolicyResource) ProtoMessage() {}
+
+func (x *BatchApplyRequest_IamPolicyResource) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_workspace_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchApplyRequest_IamPolicyResource.ProtoReflect.Descriptor instead.
+func (*BatchApplyRequest_IamPolicyResource) Descriptor() ([]byte, []int) {
+	return file_v1_workspace_service_proto_rawDescGZIP(), []int{5, 1}
+}
+
+func (x *BatchApplyRequest_IamPolicyResource) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *BatchApplyRequest_IamPolicyResource) GetPolicy() *IamPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+// All the database groups of a project.
+// The database groups absent from the resource are deleted.
+type BatchApplyRequest_DatabaseGroupsResource struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Format: projects/{project}
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// The desired database groups.
+	// Only the name, title and database_expr are applied.
+	DatabaseGroups []*DatabaseGroup `protobuf:"bytes,2,rep,name=database_groups,json=databaseGroups,proto3" json:"database_groups,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BatchApplyRequest_DatabaseGroupsResource) Reset() {
+	*x = BatchApplyRequest_DatabaseGroupsResource{}
+	mi := &file_v1_workspace_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchApplyRequest_DatabaseGroupsResource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchApplyRequest_DatabaseGroupsResource) ProtoMessage() {}
+
+func (x *BatchApplyRequest_DatabaseGroupsResource) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_workspace_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchApplyRequest_DatabaseGroupsResource.ProtoReflect.Descriptor instead.
+func (*BatchApplyRequest_DatabaseGroupsResource) Descriptor() ([]byte, []int) {
+	return file_v1_workspace_service_proto_rawDescGZIP(), []int{5, 2}
+}
+
+func (x *BatchApplyRequest_DatabaseGroupsResource) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *BatchApplyRequest_DatabaseGroupsResource) GetDatabaseGroups() []*DatabaseGroup {
+	if x != nil {
+		return x.DatabaseGroups
+	}
+	return nil
+}
+
+// The result of applying a resource.
+type BatchApplyResponse_Result struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the resource.
+	Name   string                           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Status BatchApplyResponse_Result_Status `protobuf:"varint,2,opt,name=status,proto3,enum=bytebase.v1.BatchApplyResponse_Result_Status" json:"status,omitempty"`
+	// The error if the status is FAILED.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// The etag of the resource after the apply.
+	// Pass it in the next request to detect concurrent changes.
+	Etag          string `protobuf:"bytes,4,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchApplyResponse_Result) Reset() {
+	*x = BatchApplyResponse_Result{}
+	mi := &file_v1_workspace_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchApplyResponse_Result) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchApplyResponse_Result) ProtoMessage() {}
+
+func (x *BatchApplyResponse_Result) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_workspace_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchApplyResponse_Result.ProtoReflect.Descriptor instead.
+func (*BatchApplyResponse_Result) Descriptor() ([]byte, []int) {
+	return file_v1_workspace_service_proto_rawDescGZIP(), []int{6, 0}
+}
+
+func (x *BatchApplyResponse_Result) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BatchApplyResponse_Result) GetStatus() BatchApplyResponse_Result_Status {
+	if x != nil {
+		return x.Status
+	}
+	return BatchApplyResponse_Result_STATUS_UNSPECIFIED
+}
+
+func (x *BatchApplyResponse_Result) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *BatchApplyResponse_Result) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
 var File_v1_workspace_service_proto protoreflect.FileDescriptor
 
 const file_v1_workspace_service_proto_rawDesc = "" +
 	"\n" +
-	"\x1av1/workspace_service.proto\x12\vbytebase.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a google/protobuf/field_mask.proto\x1a\x13v1/annotation.proto\x1a\x13v1/iam_policy.proto\"\x17\n" +
+	"\x1av1/workspace_service.proto\x12\vbytebase.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a google/protobuf/field_mask.proto\x1a\x13v1/annotation.proto\x1a\x1fv1/database_group_service.proto\x1a\x13v1/iam_policy.proto\x1a\x19v1/instance_service.proto\x1a\x1bv1/org_policy_service.proto\x1a\x18v1/project_service.proto\x1a\x1ev1/review_config_service.proto\x1a\x18v1/setting_service.proto\"\x17\n" +
 	"\x15ListWorkspacesRequest\"P\n" +
 	"\x16ListWorkspacesResponse\x126\n" +
 	"\n" +
@@ -282,13 +836,55 @@ const file_v1_workspace_service_proto_rawDesc = "" +
 	"\x16UpdateWorkspaceRequest\x129\n" +
 	"\tworkspace\x18\x01 \x01(\v2\x16.bytebase.v1.WorkspaceB\x03\xe0A\x02R\tworkspace\x12;\n" +
 	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
-	"updateMask2\xdc\x05\n" +
+	"updateMask\"\xc3\a\n" +
+	"\x11BatchApplyRequest\x122\n" +
+	"\x04name\x18\x01 \x01(\tB\x1e\xe0A\x02\xfaA\x18\n" +
+	"\x16bytebase.com/WorkspaceR\x04name\x12E\n" +
+	"\tresources\x18\x02 \x03(\v2'.bytebase.v1.BatchApplyRequest.ResourceR\tresources\x12#\n" +
+	"\rvalidate_only\x18\x03 \x01(\bR\fvalidateOnly\x1a\xa6\x04\n" +
+	"\bResource\x123\n" +
+	"\binstance\x18\x01 \x01(\v2\x15.bytebase.v1.InstanceH\x00R\binstance\x120\n" +
+	"\aproject\x18\x02 \x01(\v2\x14.bytebase.v1.ProjectH\x00R\aproject\x12Q\n" +
+	"\n" +
+	"iam_policy\x18\x03 \x01(\v20.bytebase.v1.BatchApplyRequest.IamPolicyResourceH\x00R\tiamPolicy\x12-\n" +
+	"\x06policy\x18\x04 \x01(\v2\x13.bytebase.v1.PolicyH\x00R\x06policy\x120\n" +
+	"\asetting\x18\a \x01(\v2\x14.bytebase.v1.SettingH\x00R\asetting\x12@\n" +
+	"\rreview_config\x18\b \x01(\v2\x19.bytebase.v1.ReviewConfigH\x00R\freviewConfig\x12`\n" +
+	"\x0fdatabase_groups\x18\t \x01(\v25.bytebase.v1.BatchApplyRequest.DatabaseGroupsResourceH\x00R\x0edatabaseGroups\x12;\n" +
+	"\vupdate_mask\x18\x05 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12\x12\n" +
+	"\x04etag\x18\x06 \x01(\tR\x04etagB\n" +
+	"\n" +
+	"\bresource\x1ai\n" +
+	"\x11IamPolicyResource\x12\x1f\n" +
+	"\bresource\x18\x01 \x01(\tB\x03\xe0A\x02R\bresource\x123\n" +
+	"\x06policy\x18\x02 \x01(\v2\x16.bytebase.v1.IamPolicyB\x03\xe0A\x02R\x06policy\x1az\n" +
+	"\x16DatabaseGroupsResource\x12\x1b\n" +
+	"\x06parent\x18\x01 \x01(\tB\x03\xe0A\x02R\x06parent\x12C\n" +
+	"\x0fdatabase_groups\x18\x02 \x03(\v2\x1a.bytebase.v1.DatabaseGroupR\x0edatabaseGroups\"\xce\x02\n" +
+	"\x12BatchApplyResponse\x12@\n" +
+	"\aresults\x18\x01 \x03(\v2&.bytebase.v1.BatchApplyResponse.ResultR\aresults\x1a\xf5\x01\n" +
+	"\x06Result\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12E\n" +
+	"\x06status\x18\x02 \x01(\x0e2-.bytebase.v1.BatchApplyResponse.Result.StatusR\x06status\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x12\x12\n" +
+	"\x04etag\x18\x04 \x01(\tR\x04etag\"f\n" +
+	"\x06Status\x12\x16\n" +
+	"\x12STATUS_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aCREATED\x10\x01\x12\v\n" +
+	"\aUPDATED\x10\x02\x12\r\n" +
+	"\tUNCHANGED\x10\x03\x12\n" +
+	"\n" +
+	"\x06FAILED\x10\x04\x12\x0f\n" +
+	"\vNOT_APPLIED\x10\x052\xe3\x06\n" +
 	"\x10WorkspaceService\x12m\n" +
 	"\fGetWorkspace\x12 .bytebase.v1.GetWorkspaceRequest\x1a\x16.bytebase.v1.Workspace\"#\x80\xea0\x01\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/{name=workspaces/*}\x12u\n" +
 	"\x0eListWorkspaces\x12\".bytebase.v1.ListWorkspacesRequest\x1a#.bytebase.v1.ListWorkspacesResponse\"\x1a\x90\xea0\x02\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/workspaces\x12\x9c\x01\n" +
 	"\x0fUpdateWorkspace\x12#.bytebase.v1.UpdateWorkspaceRequest\x1a\x16.bytebase.v1.Workspace\"L\x8a\xea0\x14bb.workspaces.update\x90\xea0\x01\x98\xea0\x01\x82\xd3\xe4\x93\x02&:\x01*2!/v1/{workspace.name=workspaces/*}\x12\x9c\x01\n" +
 	"\fGetIamPolicy\x12 .bytebase.v1.GetIamPolicyRequest\x1a\x16.bytebase.v1.IamPolicy\"R\x8a\xea0\x1abb.workspaces.getIamPolicy\x90\xea0\x01\x82\xd3\xe4\x93\x02*\x12(/v1/{resource=workspaces/*}:getIamPolicy\x12\xa3\x01\n" +
-	"\fSetIamPolicy\x12 .bytebase.v1.SetIamPolicyRequest\x1a\x16.bytebase.v1.IamPolicy\"Y\x8a\xea0\x1abb.workspaces.setIamPolicy\x90\xea0\x01\x98\xea0\x01\x82\xd3\xe4\x93\x02-:\x01*\"(/v1/{resource=workspaces/*}:setIamPolicyB\xab\x01\n" +
+	"\fSetIamPolicy\x12 .bytebase.v1.SetIamPolicyRequest\x1a\x16.bytebase.v1.IamPolicy\"Y\x8a\xea0\x1abb.workspaces.setIamPolicy\x90\xea0\x01\x98\xea0\x01\x82\xd3\xe4\x93\x02-:\x01*\"(/v1/{resource=workspaces/*}:setIamPolicy\x12\x84\x01\n" +
+	"\n" +
+	"BatchApply\x12\x1e.bytebase.v1.BatchApplyRequest\x1a\x1f.bytebase.v1.BatchApplyResponse\"5\x90\xea0\x02\x98\xea0\x01\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/{name=workspaces/*}:batchApplyB\xab\x01\n" +
 	"\x0fcom.bytebase.v1B\x15WorkspaceServiceProtoP\x01Z4github.com/bytebase/bytebase/backend/generated-go/v1\xa2\x02\x03BXX\xaa\x02\vBytebase.V1\xca\x02\vBytebase\\V1\xe2\x02\x17Bytebase\\V1\\GPBMetadata\xea\x02\fBytebase::V1b\x06proto3"
 
 var (
@@ -303,37 +899,66 @@ func file_v1_workspace_service_proto_rawDescGZIP() []byte {
 	return file_v1_workspace_service_proto_rawDescData
 }
 
-var file_v1_workspace_service_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_v1_workspace_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_v1_workspace_service_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_v1_workspace_service_proto_goTypes = []any{
-	(*ListWorkspacesRequest)(nil),  // 0: bytebase.v1.ListWorkspacesRequest
-	(*ListWorkspacesResponse)(nil), // 1: bytebase.v1.ListWorkspacesResponse
-	(*GetWorkspaceRequest)(nil),    // 2: bytebase.v1.GetWorkspaceRequest
-	(*Workspace)(nil),              // 3: bytebase.v1.Workspace
-	(*UpdateWorkspaceRequest)(nil), // 4: bytebase.v1.UpdateWorkspaceRequest
-	(*fieldmaskpb.FieldMask)(nil),  // 5: google.protobuf.FieldMask
-	(*GetIamPolicyRequest)(nil),    // 6: bytebase.v1.GetIamPolicyRequest
-	(*SetIamPolicyRequest)(nil),    // 7: bytebase.v1.SetIamPolicyRequest
-	(*IamPolicy)(nil),              // 8: bytebase.v1.IamPolicy
+	(BatchApplyResponse_Result_Status)(0),            // 0: bytebase.v1.BatchApplyResponse.Result.Status
+	(*ListWorkspacesRequest)(nil),                    // 1: bytebase.v1.ListWorkspacesRequest
+	(*ListWorkspacesResponse)(nil),                   // 2: bytebase.v1.ListWorkspacesResponse
+	(*GetWorkspaceRequest)(nil),                      // 3: bytebase.v1.GetWorkspaceRequest
+	(*Workspace)(nil),                                // 4: bytebase.v1.Workspace
+	(*UpdateWorkspaceRequest)(nil),                   // 5: bytebase.v1.UpdateWorkspaceRequest
+	(*BatchApplyRequest)(nil),                        // 6: bytebase.v1.BatchApplyRequest
+	(*BatchApplyResponse)(nil),                       // 7: bytebase.v1.BatchApplyResponse
+	(*BatchApplyRequest_Resource)(nil),               // 8: bytebase.v1.BatchApplyRequest.Resource
+	(*BatchApplyRequest_IamPolicyResource)(nil),      // 9: bytebase.v1.BatchApplyRequest.IamPolicyResource
+	(*BatchApplyRequest_DatabaseGroupsResource)(nil), // 10: bytebase.v1.BatchApplyRequest.DatabaseGroupsResource
+	(*BatchApplyResponse_Result)(nil),                // 11: bytebase.v1.BatchApplyResponse.Result
+	(*fieldmaskpb.FieldMask)(nil),                    // 12: google.protobuf.FieldMask
+	(*Instance)(nil),                                 // 13: bytebase.v1.Instance
+	(*Project)(nil),                                  // 14: bytebase.v1.Project
+	(*Policy)(nil),                                   // 15: bytebase.v1.Policy
+	(*Setting)(nil),                                  // 16: bytebase.v1.Setting
+	(*ReviewConfig)(nil),                             // 17: bytebase.v1.ReviewConfig
+	(*IamPolicy)(nil),                                // 18: bytebase.v1.IamPolicy
+	(*DatabaseGroup)(nil),                            // 19: bytebase.v1.DatabaseGroup
+	(*GetIamPolicyRequest)(nil),                      // 20: bytebase.v1.GetIamPolicyRequest
+	(*SetIamPolicyRequest)(nil),                      // 21: bytebase.v1.SetIamPolicyRequest
 }
 var file_v1_workspace_service_proto_depIdxs = []int32{
-	3, // 0: bytebase.v1.ListWorkspacesResponse.workspaces:type_name -> bytebase.v1.Workspace
-	3, // 1: bytebase.v1.UpdateWorkspaceRequest.workspace:type_name -> bytebase.v1.Workspace
-	5, // 2: bytebase.v1.UpdateWorkspaceRequest.update_mask:type_name -> google.protobuf.FieldMask
-	2, // 3: bytebase.v1.WorkspaceService.GetWorkspace:input_type -> bytebase.v1.GetWorkspaceRequest
-	0, // 4: bytebase.v1.WorkspaceService.ListWorkspaces:input_type -> bytebase.v1.ListWorkspacesRequest
-	4, // 5: bytebase.v1.WorkspaceService.UpdateWorkspace:input_type -> bytebase.v1.UpdateWorkspaceRequest
-	6, // 6: bytebase.v1.WorkspaceService.GetIamPolicy:input_type -> bytebase.v1.GetIamPolicyRequest
-	7, // 7: bytebase.v1.WorkspaceService.SetIamPolicy:input_type -> bytebase.v1.SetIamPolicyRequest
-	3, // 8: bytebase.v1.WorkspaceService.GetWorkspace:output_type -> bytebase.v1.Workspace
-	1, // 9: bytebase.v1.WorkspaceService.ListWorkspaces:output_type -> bytebase.v1.ListWorkspacesResponse
-	3, // 10: bytebase.v1.WorkspaceService.UpdateWorkspace:output_type -> bytebase.v1.Workspace
-	8, // 11: bytebase.v1.WorkspaceService.GetIamPolicy:output_type -> bytebase.v1.IamPolicy
-	8, // 12: bytebase.v1.WorkspaceService.SetIamPolicy:output_type -> bytebase.v1.IamPolicy
-	8, // [8:13] is the sub-list for method output_type
-	3, // [3:8] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	4,  // 0: bytebase.v1.ListWorkspacesResponse.workspaces:type_name -> bytebase.v1.Workspace
+	4,  // 1: bytebase.v1.UpdateWorkspaceRequest.workspace:type_name -> bytebase.v1.Workspace
+	12, // 2: bytebase.v1.UpdateWorkspaceRequest.update_mask:type_name -> google.protobuf.FieldMask
+	8,  // 3: bytebase.v1.BatchApplyRequest.resources:type_name -> bytebase.v1.BatchApplyRequest.Resource
+	11, // 4: bytebase.v1.BatchApplyResponse.results:type_name -> bytebase.v1.BatchApplyResponse.Result
+	13, // 5: bytebase.v1.BatchApplyRequest.Resource.instance:type_name -> bytebase.v1.Instance
+	14, // 6: bytebase.v1.BatchApplyRequest.Resource.project:type_name -> bytebase.v1.Project
+	9,  // 7: bytebase.v1.BatchApplyRequest.Resource.iam_policy:type_name -> bytebase.v1.BatchApplyRequest.IamPolicyResource
+	15, // 8: bytebase.v1.BatchApplyRequest.Resource.policy:type_name -> bytebase.v1.Policy
+	16, // 9: bytebase.v1.BatchApplyRequest.Resource.setting:type_name -> bytebase.v1.Setting
+	17, // 10: bytebase.v1.BatchApplyRequest.Resource.review_config:type_name -> bytebase.v1.ReviewConfig
+	10, // 11: bytebase.v1.BatchApplyRequest.Resource.database_groups:type_name -> bytebase.v1.BatchApplyRequest.DatabaseGroupsResource
+	12, // 12: bytebase.v1.BatchApplyRequest.Resource.update_mask:type_name -> google.protobuf.FieldMask
+	18, // 13: bytebase.v1.BatchApplyRequest.IamPolicyResource.policy:type_name -> bytebase.v1.IamPolicy
+	19, // 14: bytebase.v1.BatchApplyRequest.DatabaseGroupsResource.database_groups:type_name -> bytebase.v1.DatabaseGroup
+	0,  // 15: bytebase.v1.BatchApplyResponse.Result.status:type_name -> bytebase.v1.BatchApplyResponse.Result.Status
+	3,  // 16: bytebase.v1.WorkspaceService.GetWorkspace:input_type -> bytebase.v1.GetWorkspaceRequest
+	1,  // 17: bytebase.v1.WorkspaceService.ListWorkspaces:input_type -> bytebase.v1.ListWorkspacesRequest
+	5,  // 18: bytebase.v1.WorkspaceService.UpdateWorkspace:input_type -> bytebase.v1.UpdateWorkspaceRequest
+	20, // 19: bytebase.v1.WorkspaceService.GetIamPolicy:input_type -> bytebase.v1.GetIamPolicyRequest
+	21, // 20: bytebase.v1.WorkspaceService.SetIamPolicy:input_type -> bytebase.v1.SetIamPolicyRequest
+	6,  // 21: bytebase.v1.WorkspaceService.BatchApply:input_type -> bytebase.v1.BatchApplyRequest
+	4,  // 22: bytebase.v1.WorkspaceService.GetWorkspace:output_type -> bytebase.v1.Workspace
+	2,  // 23: bytebase.v1.WorkspaceService.ListWorkspaces:output_type -> bytebase.v1.ListWorkspacesResponse
+	4,  // 24: bytebase.v1.WorkspaceService.UpdateWorkspace:output_type -> bytebase.v1.Workspace
+	18, // 25: bytebase.v1.WorkspaceService.GetIamPolicy:output_type -> bytebase.v1.IamPolicy
+	18, // 26: bytebase.v1.WorkspaceService.SetIamPolicy:output_type -> bytebase.v1.IamPolicy
+	7,  // 27: bytebase.v1.WorkspaceService.BatchApply:output_type -> bytebase.v1.BatchApplyResponse
+	22, // [22:28] is the sub-list for method output_type
+	16, // [16:22] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_v1_workspace_service_proto_init() }
@@ -342,19 +967,35 @@ func file_v1_workspace_service_proto_init() {
 		return
 	}
 	file_v1_annotation_proto_init()
+	file_v1_database_group_service_proto_init()
 	file_v1_iam_policy_proto_init()
+	file_v1_instance_service_proto_init()
+	file_v1_org_policy_service_proto_init()
+	file_v1_project_service_proto_init()
+	file_v1_review_config_service_proto_init()
+	file_v1_setting_service_proto_init()
+	file_v1_workspace_service_proto_msgTypes[7].OneofWrappers = []any{
+		(*BatchApplyRequest_Resource_Instance)(nil),
+		(*BatchApplyRequest_Resource_Project)(nil),
+		(*BatchApplyRequest_Resource_IamPolicy)(nil),
+		(*BatchApplyRequest_Resource_Policy)(nil),
+		(*BatchApplyRequest_Resource_Setting)(nil),
+		(*BatchApplyRequest_Resource_ReviewConfig)(nil),
+		(*BatchApplyRequest_Resource_DatabaseGroups)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_workspace_service_proto_rawDesc), len(file_v1_workspace_service_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   5,
+			NumEnums:      1,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_v1_workspace_service_proto_goTypes,
 		DependencyIndexes: file_v1_workspace_service_proto_depIdxs,
+		EnumInfos:         file_v1_workspace_service_proto_enumTypes,
 		MessageInfos:      file_v1_workspace_service_proto_msgTypes,
 	}.Build()
 	File_v1_workspace_service_proto = out.File
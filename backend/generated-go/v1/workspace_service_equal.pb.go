@@ -87,3 +87,145 @@ func (x *UpdateWorkspaceRequest) Equal(y *UpdateWorkspaceRequest) bool {
 	}
 	return true
 }
+
+func (x *BatchApplyRequest_Resource) Equal(y *BatchApplyRequest_Resource) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if !x.GetInstance().Equal(y.GetInstance()) {
+		return false
+	}
+	if !x.GetProject().Equal(y.GetProject()) {
+		return false
+	}
+	if !x.GetIamPolicy().Equal(y.GetIamPolicy()) {
+		return false
+	}
+	if !x.GetPolicy().Equal(y.GetPolicy()) {
+		return false
+	}
+	if !x.GetSetting().Equal(y.GetSetting()) {
+		return false
+	}
+	if !x.GetReviewConfig().Equal(y.GetReviewConfig()) {
+		return false
+	}
+	if !x.GetDatabaseGroups().Equal(y.GetDatabaseGroups()) {
+		return false
+	}
+	if equal, ok := interface{}(x.UpdateMask).(interface {
+		Equal(*fieldmaskpb.FieldMask) bool
+	}); !ok || !equal.Equal(y.UpdateMask) {
+		return false
+	} else if !proto.Equal(x.UpdateMask, y.UpdateMask) {
+		return false
+	}
+	if x.Etag != y.Etag {
+		return false
+	}
+	return true
+}
+
+func (x *BatchApplyRequest_IamPolicyResource) Equal(y *BatchApplyRequest_IamPolicyResource) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Resource != y.Resource {
+		return false
+	}
+	if !x.Policy.Equal(y.Policy) {
+		return false
+	}
+	return true
+}
+
+func (x *BatchApplyRequest_DatabaseGroupsResource) Equal(y *BatchApplyRequest_DatabaseGroupsResource) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Parent != y.Parent {
+		return false
+	}
+	if len(x.DatabaseGroups) != len(y.DatabaseGroups) {
+		return false
+	}
+	for i := 0; i < len(x.DatabaseGroups); i++ {
+		if !x.DatabaseGroups[i].Equal(y.DatabaseGroups[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (x *BatchApplyRequest) Equal(y *BatchApplyRequest) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if len(x.Resources) != len(y.Resources) {
+		return false
+	}
+	for i := 0; i < len(x.Resources); i++ {
+		if !x.Resources[i].Equal(y.Resources[i]) {
+			return false
+		}
+	}
+	if x.ValidateOnly != y.ValidateOnly {
+		return false
+	}
+	return true
+}
+
+func (x *BatchApplyResponse_Result) Equal(y *BatchApplyResponse_Result) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if x.Name != y.Name {
+		return false
+	}
+	if x.Status != y.Status {
+		return false
+	}
+	if x.Error != y.Error {
+		return false
+	}
+	if x.Etag != y.Etag {
+		return false
+	}
+	return true
+}
+
+func (x *BatchApplyResponse) Equal(y *BatchApplyResponse) bool {
+	if x == y {
+		return true
+	}
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if len(x.Results) != len(y.Results) {
+		return false
+	}
+	for i := 0; i < len(x.Results); i++ {
+		if !x.Results[i].Equal(y.Results[i]) {
+			return false
+		}
+	}
+	return true
+}
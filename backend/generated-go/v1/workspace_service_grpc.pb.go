@@ -24,6 +24,7 @@ const (
 	WorkspaceService_UpdateWorkspace_FullMethodName = "/bytebase.v1.WorkspaceService/UpdateWorkspace"
 	WorkspaceService_GetIamPolicy_FullMethodName    = "/bytebase.v1.WorkspaceService/GetIamPolicy"
 	WorkspaceService_SetIamPolicy_FullMethodName    = "/bytebase.v1.WorkspaceService/SetIamPolicy"
+	WorkspaceService_BatchApply_FullMethodName      = "/bytebase.v1.WorkspaceService/BatchApply"
 )
 
 // WorkspaceServiceClient is the client API for WorkspaceService service.
@@ -48,6 +49,15 @@ type WorkspaceServiceClient interface {
 	// Sets IAM policy for the workspace.
 	// Permissions required: bb.workspaces.setIamPolicy
 	SetIamPolicy(ctx context.Context, in *SetIamPolicyRequest, opts ...grpc.CallOption) (*IamPolicy, error)
+	// Declaratively applies a set of instances, projects, IAM policies, policies,
+	// settings, review configs and database groups.
+	// All resources are validated before any of them is written, and resources
+	// already in the desired state are left untouched.
+	// The resources other than the instances and new projects are written in a
+	// single transaction. The instances and new projects are written before it,
+	// and they are reverted on a best-effort basis if a later write fails.
+	// Permissions required: the permissions to create or update each resource.
+	BatchApply(ctx context.Context, in *BatchApplyRequest, opts ...grpc.CallOption) (*BatchApplyResponse, error)
 }
 
 type workspaceServiceClient struct {
@@ -108,6 +118,16 @@ func (c *workspaceServiceClient) SetIamPolicy(ctx context.Context, in *SetIamPol
 	return out, nil
 }
 
+func (c *workspaceServiceClient) BatchApply(ctx context.Context, in *BatchApplyRequest, opts ...grpc.CallOption) (*BatchApplyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchApplyResponse)
+	err := c.cc.Invoke(ctx, WorkspaceService_BatchApply_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WorkspaceServiceServer is the server API for WorkspaceService service.
 // All implementations must embed UnimplementedWorkspaceServiceServer
 // for forward compatibility.
@@ -130,6 +150,15 @@ type WorkspaceServiceServer interface {
 	// Sets IAM policy for the workspace.
 	// Permissions required: bb.workspaces.setIamPolicy
 	SetIamPolicy(context.Context, *SetIamPolicyRequest) (*IamPolicy, error)
+	// Declaratively applies a set of instances, projects, IAM policies, policies,
+	// settings, review configs and database groups.
+	// All resources are validated before any of them is written, and resources
+	// already in the desired state are left untouched.
+	// The resources other than the instances and new projects are written in a
+	// single transaction. The instances and new projects are written before it,
+	// and they are reverted on a best-effort basis if a later write fails.
+	// Permissions required: the permissions to create or update each resource.
+	BatchApply(context.Context, *BatchApplyRequest) (*BatchApplyResponse, error)
 	mustEmbedUnimplementedWorkspaceServiceServer()
 }
 
@@ -155,6 +184,9 @@ func (UnimplementedWorkspaceServiceServer) GetIamPolicy(context.Context, *GetIam
 func (UnimplementedWorkspaceServiceServer) SetIamPolicy(context.Context, *SetIamPolicyRequest) (*IamPolicy, error) {
 	return nil, status.Error(codes.Unimplemented, "method SetIamPolicy not implemented")
 }
+func (UnimplementedWorkspaceServiceServer) BatchApply(context.Context, *BatchApplyRequest) (*BatchApplyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchApply not implemented")
+}
 func (UnimplementedWorkspaceServiceServer) mustEmbedUnimplementedWorkspaceServiceServer() {}
 func (UnimplementedWorkspaceServiceServer) testEmbeddedByValue()                          {}
 
@@ -266,6 +298,24 @@ func _WorkspaceService_SetIamPolicy_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorkspaceService_BatchApply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchApplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).BatchApply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_BatchApply_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).BatchApply(ctx, req.(*BatchApplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // WorkspaceService_ServiceDesc is the grpc.ServiceDesc for WorkspaceService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -293,6 +343,10 @@ var WorkspaceService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetIamPolicy",
 			Handler:    _WorkspaceService_SetIamPolicy_Handler,
 		},
+		{
+			MethodName: "BatchApply",
+			Handler:    _WorkspaceService_BatchApply_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/workspace_service.proto",
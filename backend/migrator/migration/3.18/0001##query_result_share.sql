@@ -0,0 +1,13 @@
+CREATE TABLE query_result_share (
+    resource_id text PRIMARY KEY DEFAULT gen_random_uuid()::text,
+    project text NOT NULL REFERENCES project(resource_id),
+    creator text NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    expire_time timestamptz NOT NULL,
+    database text NOT NULL,
+    statement text NOT NULL,
+    bytes bytea,
+    payload jsonb NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX idx_query_result_share_expire_time ON query_result_share(expire_time);
@@ -0,0 +1,17 @@
+CREATE TABLE classification_suggestion (
+    resource_id text PRIMARY KEY DEFAULT gen_random_uuid()::text,
+    instance text NOT NULL,
+    db_name text NOT NULL,
+    schema text NOT NULL DEFAULT '',
+    table_name text NOT NULL,
+    column_name text NOT NULL,
+    classification text NOT NULL DEFAULT '',
+    semantic_type text NOT NULL DEFAULT '',
+    state text NOT NULL DEFAULT 'PENDING' CHECK (state IN ('PENDING', 'ACCEPTED', 'REJECTED')),
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now(),
+    payload jsonb NOT NULL DEFAULT '{}',
+    CONSTRAINT classification_suggestion_instance_db_name_fkey FOREIGN KEY(instance, db_name) REFERENCES db(instance, name)
+);
+
+CREATE UNIQUE INDEX idx_classification_suggestion_unique_column ON classification_suggestion(instance, db_name, schema, table_name, column_name);
@@ -0,0 +1,20 @@
+CREATE TABLE column_lineage (
+    id bigserial PRIMARY KEY,
+    instance text NOT NULL,
+    db_name text NOT NULL,
+    schema text NOT NULL DEFAULT '',
+    table_name text NOT NULL,
+    column_name text NOT NULL,
+    source_db_name text NOT NULL,
+    source_schema text NOT NULL DEFAULT '',
+    source_table text NOT NULL,
+    source_column text NOT NULL,
+    origin text NOT NULL CHECK (origin IN ('CHANGE', 'VIEW')),
+    updated_at timestamptz NOT NULL DEFAULT now(),
+    payload jsonb NOT NULL DEFAULT '{}',
+    CONSTRAINT column_lineage_instance_db_name_fkey FOREIGN KEY(instance, db_name) REFERENCES db(instance, name)
+);
+
+CREATE UNIQUE INDEX idx_column_lineage_unique_edge ON column_lineage(instance, db_name, schema, table_name, column_name, source_db_name, source_schema, source_table, source_column);
+
+CREATE INDEX idx_column_lineage_source ON column_lineage(instance, source_db_name, source_schema, source_table, source_column);
@@ -0,0 +1,23 @@
+CREATE TABLE access_review (
+    id text PRIMARY KEY,
+    workspace text NOT NULL REFERENCES workspace(resource_id),
+    creator text NOT NULL,
+    state text NOT NULL DEFAULT 'ACTIVE' CHECK (state IN ('ACTIVE', 'COMPLETED')),
+    end_time timestamptz NOT NULL,
+    payload jsonb NOT NULL DEFAULT '{}',
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_access_review_workspace_state_end_time ON access_review(workspace, state, end_time);
+
+CREATE TABLE access_review_entry (
+    id bigserial PRIMARY KEY,
+    review text NOT NULL REFERENCES access_review(id),
+    project text NOT NULL DEFAULT '',
+    decision text NOT NULL DEFAULT 'PENDING' CHECK (decision IN ('PENDING', 'KEEP', 'REVOKE')),
+    payload jsonb NOT NULL DEFAULT '{}',
+    updated_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_access_review_entry_review_decision ON access_review_entry(review, decision);
@@ -0,0 +1,14 @@
+CREATE TABLE operation (
+    id bigserial PRIMARY KEY,
+    workspace text NOT NULL REFERENCES workspace(resource_id),
+    creator text NOT NULL,
+    state text NOT NULL DEFAULT 'RUNNING' CHECK (state IN ('RUNNING', 'SUCCEEDED', 'FAILED', 'CANCELED')),
+    replica_id text,
+    payload jsonb NOT NULL DEFAULT '{}',
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_operation_workspace_creator_created_at ON operation(workspace, creator, created_at);
+
+CREATE INDEX idx_operation_state_updated_at ON operation(state, updated_at);
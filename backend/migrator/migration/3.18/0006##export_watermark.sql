@@ -0,0 +1,8 @@
+CREATE TABLE export_watermark (
+    id text PRIMARY KEY,
+    workspace text NOT NULL REFERENCES workspace(resource_id),
+    created_at timestamptz NOT NULL DEFAULT now(),
+    payload jsonb NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX idx_export_watermark_workspace ON export_watermark(workspace);
@@ -0,0 +1 @@
+CREATE INDEX idx_access_grant_break_glass_has_issue ON access_grant((COALESCE((payload->>'breakGlass')::boolean, FALSE)), (jsonb_exists(payload, 'issueId')));
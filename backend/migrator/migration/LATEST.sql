@@ -86,7 +86,7 @@ CREATE TABLE policy (
     resource_type text NOT NULL,
     -- resource: resource name in format like "environments/{environment}", "projects/{project}", etc.
     resource TEXT NOT NULL,
-    -- type: ROLLOUT, MASKING_EXCEPTION, QUERY_DATA, MASKING_RULE, IAM, TAG
+    -- type: ROLLOUT, MASKING_EXCEPTION, QUERY_DATA, MASKING_RULE, IAM, TAG, ROW_FILTER
     -- Enum: Policy.Type (proto/store/store/policy.proto)
     type text NOT NULL,
     -- Stored as different types based on policy type (proto/store/store/policy.proto):
@@ -96,6 +96,7 @@ CREATE TABLE policy (
     -- MASKING_RULE: MaskingRulePolicy
     -- IAM: IamPolicy
     -- TAG: TagPolicy
+    -- ROW_FILTER: RowFilterPolicy
     payload jsonb NOT NULL DEFAULT '{}',
     inherit_from_parent boolean NOT NULL DEFAULT TRUE,
     PRIMARY KEY (resource_type, resource, type)
@@ -169,6 +170,37 @@ CREATE TABLE export_archive (
     payload jsonb NOT NULL DEFAULT '{}'
 );
 
+-- export_watermark records the exports, so that the watermarks of the export archives can be verified.
+CREATE TABLE export_watermark (
+    -- The ID embedded in the watermark.
+    id text PRIMARY KEY,
+    workspace text NOT NULL REFERENCES workspace(resource_id),
+    created_at timestamptz NOT NULL DEFAULT now(),
+    -- Stored as ExportWatermarkPayload (proto/store/store/export_watermark.proto)
+    payload jsonb NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX idx_export_watermark_workspace ON export_watermark(workspace);
+
+-- operation tracks the long-running operations, e.g. instance syncs and async exports.
+CREATE TABLE operation (
+    id bigserial PRIMARY KEY,
+    workspace text NOT NULL REFERENCES workspace(resource_id),
+    creator text NOT NULL,
+    -- state: RUNNING, SUCCEEDED, FAILED, CANCELED
+    state text NOT NULL DEFAULT 'RUNNING' CHECK (state IN ('RUNNING', 'SUCCEEDED', 'FAILED', 'CANCELED')),
+    -- The replica running the operation, used to fail the operations of dead replicas.
+    replica_id text,
+    -- Stored as OperationPayload (proto/store/store/operation.proto)
+    payload jsonb NOT NULL DEFAULT '{}',
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_operation_workspace_creator_created_at ON operation(workspace, creator, created_at);
+
+CREATE INDEX idx_operation_state_updated_at ON operation(state, updated_at);
+
 -----------------------
 -- Project and project-scoped tables
 -----------------------
@@ -407,6 +439,38 @@ CREATE TABLE access_grant (
 
 CREATE INDEX idx_access_grant_project_creator_expire_time ON access_grant(project, creator, expire_time);
 
+CREATE INDEX idx_access_grant_break_glass_has_issue ON access_grant((COALESCE((payload->>'breakGlass')::boolean, FALSE)), (jsonb_exists(payload, 'issueId')));
+
+CREATE TABLE access_review (
+    -- global unique
+    id text PRIMARY KEY,
+    workspace text NOT NULL REFERENCES workspace(resource_id),
+    creator text NOT NULL,
+    -- state: ACTIVE, COMPLETED
+    state text NOT NULL DEFAULT 'ACTIVE' CHECK (state IN ('ACTIVE', 'COMPLETED')),
+    end_time timestamptz NOT NULL,
+    -- Stored as AccessReviewPayload (proto/store/store/access_review.proto)
+    payload jsonb NOT NULL DEFAULT '{}',
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_access_review_workspace_state_end_time ON access_review(workspace, state, end_time);
+
+CREATE TABLE access_review_entry (
+    id bigserial PRIMARY KEY,
+    review text NOT NULL REFERENCES access_review(id),
+    -- Empty for the workspace IAM policy bindings.
+    project text NOT NULL DEFAULT '',
+    -- decision: PENDING, KEEP, REVOKE
+    decision text NOT NULL DEFAULT 'PENDING' CHECK (decision IN ('PENDING', 'KEEP', 'REVOKE')),
+    -- Stored as AccessReviewEntryPayload (proto/store/store/access_review.proto)
+    payload jsonb NOT NULL DEFAULT '{}',
+    updated_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_access_review_entry_review_decision ON access_review_entry(review, decision);
+
 CREATE TABLE query_history (
     -- global unique
     resource_id text PRIMARY KEY DEFAULT gen_random_uuid()::text,
@@ -424,6 +488,23 @@ CREATE TABLE query_history (
 
 CREATE INDEX idx_query_history_creator_created_at_project ON query_history(creator, created_at, project DESC);
 
+CREATE TABLE query_result_share (
+    -- global unique
+    resource_id text PRIMARY KEY DEFAULT gen_random_uuid()::text,
+    project text NOT NULL REFERENCES project(resource_id),
+    creator text NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    expire_time timestamptz NOT NULL,
+    database text NOT NULL, -- the database resource name, for example, instances/{instance}/databases/{database}
+    statement text NOT NULL,
+    -- the results before masking, stored as the marshaled bytebase.v1.QueryResponse
+    bytes bytea,
+    -- Stored as QueryResultSharePayload (proto/store/store/query_result_share.proto)
+    payload jsonb NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX idx_query_result_share_expire_time ON query_result_share(expire_time);
+
 -----------------------
 -- Instance and instance-scoped tables
 -----------------------
@@ -469,6 +550,52 @@ CREATE TABLE db_schema (
     CONSTRAINT db_schema_instance_db_name_fkey FOREIGN KEY(instance, db_name) REFERENCES db(instance, name)
 );
 
+CREATE TABLE classification_suggestion (
+    -- global unique
+    resource_id text PRIMARY KEY DEFAULT gen_random_uuid()::text,
+    instance text NOT NULL,
+    db_name text NOT NULL,
+    schema text NOT NULL DEFAULT '',
+    table_name text NOT NULL,
+    column_name text NOT NULL,
+    classification text NOT NULL DEFAULT '',
+    semantic_type text NOT NULL DEFAULT '',
+    -- PENDING, ACCEPTED, REJECTED
+    state text NOT NULL DEFAULT 'PENDING' CHECK (state IN ('PENDING', 'ACCEPTED', 'REJECTED')),
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now(),
+    -- Stored as ClassificationSuggestionPayload (proto/store/store/classification_suggestion.proto)
+    payload jsonb NOT NULL DEFAULT '{}',
+    CONSTRAINT classification_suggestion_instance_db_name_fkey FOREIGN KEY(instance, db_name) REFERENCES db(instance, name)
+);
+
+CREATE UNIQUE INDEX idx_classification_suggestion_unique_column ON classification_suggestion(instance, db_name, schema, table_name, column_name);
+
+CREATE TABLE column_lineage (
+    id bigserial PRIMARY KEY,
+    -- The target column written from the source column.
+    instance text NOT NULL,
+    db_name text NOT NULL,
+    schema text NOT NULL DEFAULT '',
+    table_name text NOT NULL,
+    column_name text NOT NULL,
+    -- The source column in the same instance.
+    source_db_name text NOT NULL,
+    source_schema text NOT NULL DEFAULT '',
+    source_table text NOT NULL,
+    source_column text NOT NULL,
+    -- CHANGE, VIEW
+    origin text NOT NULL CHECK (origin IN ('CHANGE', 'VIEW')),
+    updated_at timestamptz NOT NULL DEFAULT now(),
+    -- Stored as ColumnLineagePayload (proto/store/store/column_lineage.proto)
+    payload jsonb NOT NULL DEFAULT '{}',
+    CONSTRAINT column_lineage_instance_db_name_fkey FOREIGN KEY(instance, db_name) REFERENCES db(instance, name)
+);
+
+CREATE UNIQUE INDEX idx_column_lineage_unique_edge ON column_lineage(instance, db_name, schema, table_name, column_name, source_db_name, source_schema, source_table, source_column);
+
+CREATE INDEX idx_column_lineage_source ON column_lineage(instance, source_db_name, source_schema, source_table, source_column);
+
 CREATE TABLE revision (
     -- global unique
     resource_id text PRIMARY KEY DEFAULT gen_random_uuid()::text,
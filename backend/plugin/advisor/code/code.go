@@ -82,6 +82,7 @@ const (
 	SDLDropOperation                          Code = 255
 	SDLReplaceOperation                       Code = 256
 	StatementDisallowTruncate                 Code = 257
+	StatementMissingIndex                     Code = 258
 
 	// 260 DDL simulation error code.
 	DDLSimulationFailed Code = 260
@@ -130,6 +130,9 @@ type QueryContext struct {
 	// The maximum number of bytes for sql results in response body.
 	MaximumSQLResultSize int64
 	Timeout              *durationpb.Duration
+	// Parameters are the values bound to the placeholders of the statement.
+	// The statement must be a single statement if parameters are given.
+	Parameters []any
 }
 
 // Driver is the interface for database driver.
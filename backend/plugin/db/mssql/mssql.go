@@ -455,7 +455,7 @@ func (*Driver) queryBatch(ctx context.Context, conn *sql.Conn, batch string, que
 
 			queryResult, err := func() (*v1pb.QueryResult, error) {
 				// Execute query to get execution plan
-				rows, err := conn.QueryContext(ctx, singleSQL.Text)
+				rows, err := conn.QueryContext(ctx, singleSQL.Text, queryContext.Parameters...)
 				if err != nil {
 					return nil, errors.Wrap(err, "failed to get execution plan")
 				}
@@ -471,6 +471,19 @@ func (*Driver) queryBatch(ctx context.Context, conn *sql.Conn, batch string, que
 					return nil, errors.Wrap(err, "error after processing rows")
 				}
 
+				var plan *v1pb.QueryPlan
+				if explain == "SHOWPLAN_XML" {
+					if len(r.Rows) > 0 && len(r.Rows[0].Values) > 0 {
+						plan, err = parsePlan(r.Rows[0].Values[0].GetStringValue())
+					}
+				} else {
+					plan, err = parsePlanRows(r)
+				}
+				if err != nil {
+					slog.Debug("failed to parse normalized query plan", log.BBError(err))
+				}
+				r.Plan = plan
+
 				return r, nil
 			}()
 
@@ -520,7 +533,8 @@ func (*Driver) queryBatch(ctx context.Context, conn *sql.Conn, batch string, que
 
 	refinedBatch := batchBuf.String()
 	retmsg := &sqlexp.ReturnMessage{}
-	rows, qe := conn.QueryContext(ctx, refinedBatch, retmsg) // NOSONAR(go:S2077) intentional execution of user-authored SQL in SQL Editor
+	args := append([]any{retmsg}, queryContext.Parameters...)
+	rows, qe := conn.QueryContext(ctx, refinedBatch, args...) // NOSONAR(go:S2077) intentional execution of user-authored SQL in SQL Editor
 	if qe != nil {
 		return nil, qe
 	}
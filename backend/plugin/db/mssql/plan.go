@@ -0,0 +1,309 @@
+package mssql
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+	"github.com/bytebase/bytebase/backend/plugin/advisor/code"
+	"github.com/bytebase/bytebase/backend/plugin/db/util"
+)
+
+// showplanElement is a generic element of the showplan XML.
+// The showplan schema has dozens of operator elements, so we walk the generic tree instead of mapping each of them.
+type showplanElement struct {
+	XMLName  xml.Name
+	Attrs    []xml.Attr        `xml:",any,attr"`
+	Children []showplanElement `xml:",any"`
+}
+
+func (e *showplanElement) attr(name string) string {
+	for _, a := range e.Attrs {
+		if a.Name.Local == name {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+// find returns the first descendant with the given name, without descending into nested operators.
+func (e *showplanElement) find(name string) *showplanElement {
+	for i := range e.Children {
+		child := &e.Children[i]
+		if child.XMLName.Local == name {
+			return child
+		}
+		if child.XMLName.Local == "RelOp" {
+			continue
+		}
+		if found := child.find(name); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// findAll returns all descendants with the given name, without descending into matched elements.
+func (e *showplanElement) findAll(name string) []*showplanElement {
+	var result []*showplanElement
+	for i := range e.Children {
+		child := &e.Children[i]
+		if child.XMLName.Local == name {
+			result = append(result, child)
+			continue
+		}
+		result = append(result, child.findAll(name)...)
+	}
+	return result
+}
+
+// parsePlan parses the SHOWPLAN_XML output of a single statement.
+func parsePlan(output string) (*v1pb.QueryPlan, error) {
+	var root showplanElement
+	decoder := xml.NewDecoder(strings.NewReader(output))
+	// The plan may declare utf-16 encoding, but it has been decoded by the driver already.
+	decoder.CharsetReader = func(_ string, input io.Reader) (io.Reader, error) {
+		return input, nil
+	}
+	if err := decoder.Decode(&root); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal showplan")
+	}
+	queryPlans := root.findAll("QueryPlan")
+	if len(queryPlans) == 0 {
+		return nil, errors.New("QueryPlan not found in showplan")
+	}
+	queryPlan := queryPlans[0]
+	relOp := queryPlan.find("RelOp")
+	if relOp == nil {
+		return nil, errors.New("RelOp not found in showplan")
+	}
+
+	var warnings []*v1pb.QueryPlanWarning
+	for _, missingIndex := range queryPlan.findAll("MissingIndex") {
+		relation := showplanObjectName(missingIndex.attr("Schema"), missingIndex.attr("Table"))
+		var groups []string
+		for _, group := range missingIndex.findAll("ColumnGroup") {
+			var columns []string
+			for _, column := range group.findAll("Column") {
+				columns = append(columns, column.attr("Name"))
+			}
+			groups = append(groups, fmt.Sprintf("%s (%s)", strings.ToLower(group.attr("Usage")), strings.Join(columns, ", ")))
+		}
+		warnings = append(warnings, &v1pb.QueryPlanWarning{
+			Type:     v1pb.QueryPlanWarning_MISSING_INDEX,
+			Relation: relation,
+			Content:  fmt.Sprintf("Missing index on %q: %s", relation, strings.Join(groups, "; ")),
+			Code:     code.StatementMissingIndex.Int32(),
+		})
+	}
+	return util.NewQueryPlan(convertRelOp(relOp), warnings...), nil
+}
+
+func convertRelOp(relOp *showplanElement) *v1pb.QueryPlanNode {
+	physicalOp := relOp.attr("PhysicalOp")
+	node := &v1pb.QueryPlanNode{
+		NodeType:      physicalOp,
+		EstimatedRows: util.ParsePlanNumber(relOp.attr("EstimateRows")),
+		Cost:          util.ParsePlanNumber(relOp.attr("EstimatedTotalSubtreeCost")),
+		FullScan:      physicalOp == "Table Scan" || physicalOp == "Clustered Index Scan",
+	}
+	if object := relOp.find("Object"); object != nil {
+		node.Relation = showplanObjectName(object.attr("Schema"), object.attr("Table"))
+		node.Index = strings.Trim(object.attr("Index"), "[]")
+	}
+	if predicate := relOp.find("Predicate"); predicate != nil {
+		if scalar := predicate.find("ScalarOperator"); scalar != nil {
+			node.Filter = scalar.attr("ScalarString")
+		}
+	}
+	if runtime := relOp.find("RunTimeInformation"); runtime != nil {
+		var rows, elapsed float64
+		var hasRows, hasElapsed bool
+		for _, counter := range runtime.findAll("RunTimeCountersPerThread") {
+			if v := util.ParsePlanNumber(counter.attr("ActualRows")); v != nil {
+				rows += *v
+				hasRows = true
+			}
+			// Threads run in parallel, so the elapsed time of the operator is the slowest thread.
+			if v := util.ParsePlanNumber(counter.attr("ActualElapsedms")); v != nil {
+				elapsed = max(elapsed, *v)
+				hasElapsed = true
+			}
+		}
+		if hasRows {
+			node.ActualRows = &rows
+		}
+		if hasElapsed {
+			node.ActualTimeMs = &elapsed
+		}
+	}
+	for i := range relOp.Children {
+		for _, child := range relOp.Children[i].relOps() {
+			node.Children = append(node.Children, convertRelOp(child))
+		}
+	}
+	return node
+}
+
+// relOps returns the operators directly nested in the element.
+func (e *showplanElement) relOps() []*showplanElement {
+	if e.XMLName.Local == "RelOp" {
+		return []*showplanElement{e}
+	}
+	var result []*showplanElement
+	for i := range e.Children {
+		result = append(result, e.Children[i].relOps()...)
+	}
+	return result
+}
+
+func showplanObjectName(schema, table string) string {
+	table = strings.Trim(table, "[]")
+	if schema = strings.Trim(schema, "[]"); schema != "" {
+		return fmt.Sprintf("%s.%s", schema, table)
+	}
+	return table
+}
+
+// parsePlanRows parses the SHOWPLAN_ALL output of a single statement.
+// Each operator is a row linked to its parent operator by the NodeId and Parent columns.
+func parsePlanRows(result *v1pb.QueryResult) (*v1pb.QueryPlan, error) {
+	columnIndex := make(map[string]int)
+	for i, name := range result.ColumnNames {
+		columnIndex[name] = i
+	}
+	for _, name := range []string{"StmtId", "NodeId", "Parent", "PhysicalOp", "Argument", "EstimateRows", "TotalSubtreeCost"} {
+		if _, ok := columnIndex[name]; !ok {
+			return nil, errors.Errorf("column %q not found in showplan", name)
+		}
+	}
+	value := func(row *v1pb.QueryRow, name string) *v1pb.RowValue {
+		i := columnIndex[name]
+		if i >= len(row.Values) {
+			return nil
+		}
+		return row.Values[i]
+	}
+
+	type planRow struct {
+		parent int64
+		node   *v1pb.QueryPlanNode
+	}
+	var stmtID int64
+	var nodeIDs []int64
+	rows := make(map[int64]*planRow)
+	for _, row := range result.Rows {
+		physicalOp := value(row, "PhysicalOp").GetStringValue()
+		// The statement rows have no physical operator.
+		if physicalOp == "" {
+			continue
+		}
+		if len(nodeIDs) == 0 {
+			stmtID = value(row, "StmtId").GetInt64Value()
+		} else if value(row, "StmtId").GetInt64Value() != stmtID {
+			break
+		}
+		argument := value(row, "Argument").GetStringValue()
+		node := &v1pb.QueryPlanNode{
+			NodeType:      physicalOp,
+			EstimatedRows: showplanNumber(value(row, "EstimateRows")),
+			Cost:          showplanNumber(value(row, "TotalSubtreeCost")),
+			FullScan:      physicalOp == "Table Scan" || physicalOp == "Clustered Index Scan",
+		}
+		node.Relation, node.Index = showplanObject(showplanArgument(argument, "OBJECT"))
+		node.Filter = showplanArgument(argument, "WHERE")
+		if node.Filter == "" {
+			node.Filter = showplanArgument(argument, "SEEK")
+		}
+		nodeID := value(row, "NodeId").GetInt64Value()
+		nodeIDs = append(nodeIDs, nodeID)
+		rows[nodeID] = &planRow{parent: value(row, "Parent").GetInt64Value(), node: node}
+	}
+	if len(nodeIDs) == 0 {
+		return nil, errors.New("no operator found in showplan")
+	}
+
+	var root *v1pb.QueryPlanNode
+	for _, nodeID := range nodeIDs {
+		row := rows[nodeID]
+		parent, ok := rows[row.parent]
+		if !ok {
+			if root == nil {
+				root = row.node
+			}
+			continue
+		}
+		parent.node.Children = append(parent.node.Children, row.node)
+	}
+	return util.NewQueryPlan(root), nil
+}
+
+// showplanArgument returns the parenthesized value of the key in the SHOWPLAN_ALL argument,
+// e.g. the value of WHERE in "OBJECT:([db].[dbo].[t]), WHERE:([db].[dbo].[t].[a]=(1))".
+func showplanArgument(argument, key string) string {
+	prefix := key + ":("
+	start := strings.Index(argument, prefix)
+	if start < 0 {
+		return ""
+	}
+	start += len(prefix)
+	depth := 1
+	inBracket := false
+	for i := start; i < len(argument); i++ {
+		switch c := argument[i]; {
+		case inBracket:
+			inBracket = c != ']'
+		case c == '[':
+			inBracket = true
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+			if depth == 0 {
+				return argument[start:i]
+			}
+		}
+	}
+	return ""
+}
+
+// showplanObject returns the relation and index of the object in the SHOWPLAN_ALL argument,
+// e.g. "[db].[dbo].[t].[PK_t] AS [x]".
+func showplanObject(object string) (string, string) {
+	if i := strings.Index(object, " AS "); i >= 0 {
+		object = object[:i]
+	}
+	if object == "" {
+		return "", ""
+	}
+	var parts []string
+	for _, part := range strings.Split(object, "].") {
+		parts = append(parts, strings.Trim(part, "[]"))
+	}
+	switch len(parts) {
+	case 4:
+		return showplanObjectName(parts[1], parts[2]), parts[3]
+	case 3:
+		return showplanObjectName(parts[1], parts[2]), ""
+	default:
+		return strings.Join(parts, "."), ""
+	}
+}
+
+func showplanNumber(value *v1pb.RowValue) *float64 {
+	switch v := value.GetKind().(type) {
+	case *v1pb.RowValue_DoubleValue:
+		return &v.DoubleValue
+	case *v1pb.RowValue_FloatValue:
+		f := float64(v.FloatValue)
+		return &f
+	case *v1pb.RowValue_StringValue:
+		return util.ParsePlanNumber(v.StringValue)
+	default:
+		return nil
+	}
+}
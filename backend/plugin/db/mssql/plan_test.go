@@ -0,0 +1,117 @@
+package mssql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+)
+
+func TestParsePlan(t *testing.T) {
+	a := require.New(t)
+
+	output := `<?xml version="1.0" encoding="utf-16"?>
+<ShowPlanXML xmlns="http://schemas.microsoft.com/sqlserver/2004/07/showplan" Version="1.564" Build="16.0.1000.6">
+  <BatchSequence>
+    <Batch>
+      <Statements>
+        <StmtSimple StatementText="SELECT * FROM t WHERE a = 1" StatementId="1" StatementType="SELECT">
+          <QueryPlan CachedPlanSize="16">
+            <MissingIndexes>
+              <MissingIndexGroup Impact="95.1">
+                <MissingIndex Database="[db]" Schema="[dbo]" Table="[t]">
+                  <ColumnGroup Usage="EQUALITY">
+                    <Column Name="[a]" ColumnId="2" />
+                  </ColumnGroup>
+                </MissingIndex>
+              </MissingIndexGroup>
+            </MissingIndexes>
+            <RelOp NodeId="0" PhysicalOp="Parallelism" LogicalOp="Gather Streams" EstimateRows="10" EstimatedTotalSubtreeCost="1.25">
+              <Parallelism>
+                <RelOp NodeId="1" PhysicalOp="Table Scan" LogicalOp="Table Scan" EstimateRows="10" EstimatedTotalSubtreeCost="1.2">
+                  <TableScan Ordered="0">
+                    <Object Database="[db]" Schema="[dbo]" Table="[t]" />
+                    <Predicate>
+                      <ScalarOperator ScalarString="[db].[dbo].[t].[a]=(1)" />
+                    </Predicate>
+                  </TableScan>
+                </RelOp>
+              </Parallelism>
+            </RelOp>
+          </QueryPlan>
+        </StmtSimple>
+      </Statements>
+    </Batch>
+  </BatchSequence>
+</ShowPlanXML>`
+	plan, err := parsePlan(output)
+	a.NoError(err)
+
+	root := plan.Root
+	a.Equal("Parallelism", root.NodeType)
+	a.Equal(1.25, root.GetCost())
+	a.Len(root.Children, 1)
+
+	scan := root.Children[0]
+	a.Equal("Table Scan", scan.NodeType)
+	a.Equal("dbo.t", scan.Relation)
+	a.Equal("[db].[dbo].[t].[a]=(1)", scan.Filter)
+	a.Equal(10.0, scan.GetEstimatedRows())
+	a.True(scan.FullScan)
+
+	// The engine-reported missing index replaces the derived one.
+	a.Len(plan.Warnings, 2)
+	a.Equal(v1pb.QueryPlanWarning_MISSING_INDEX, plan.Warnings[0].Type)
+	a.Equal(`Missing index on "dbo.t": equality ([a])`, plan.Warnings[0].Content)
+	a.Equal(v1pb.QueryPlanWarning_FULL_SCAN, plan.Warnings[1].Type)
+}
+
+func TestParsePlanRows(t *testing.T) {
+	a := require.New(t)
+
+	str := func(s string) *v1pb.RowValue {
+		if s == "" {
+			return &v1pb.RowValue{Kind: &v1pb.RowValue_NullValue{}}
+		}
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: s}}
+	}
+	num := func(v int64) *v1pb.RowValue {
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_Int64Value{Int64Value: v}}
+	}
+	float := func(v float64) *v1pb.RowValue {
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_DoubleValue{DoubleValue: v}}
+	}
+	result := &v1pb.QueryResult{
+		ColumnNames: []string{"StmtText", "StmtId", "NodeId", "Parent", "PhysicalOp", "Argument", "EstimateRows", "TotalSubtreeCost"},
+		Rows: []*v1pb.QueryRow{
+			{Values: []*v1pb.RowValue{str("SELECT * FROM t JOIN u ON t.id = u.id WHERE t.a = 1"), num(1), num(1), num(0), str(""), str(""), float(10), float(0.5)}},
+			{Values: []*v1pb.RowValue{str("|--Nested Loops"), num(1), num(2), num(1), str("Nested Loops"), str("OUTER REFERENCES:([db].[dbo].[t].[id])"), float(10), float(0.5)}},
+			{Values: []*v1pb.RowValue{str("|--Table Scan"), num(1), num(3), num(2), str("Table Scan"), str("OBJECT:([db].[dbo].[t]), WHERE:([db].[dbo].[t].[a]=(1))"), float(10), float(0.2)}},
+			{Values: []*v1pb.RowValue{str("|--Clustered Index Seek"), num(1), num(4), num(2), str("Clustered Index Seek"), str("OBJECT:([db].[dbo].[u].[PK_u] AS [x]), SEEK:([x].[id]=[db].[dbo].[t].[id]) ORDERED FORWARD"), float(1), float(0.003)}},
+		},
+	}
+	plan, err := parsePlanRows(result)
+	a.NoError(err)
+
+	root := plan.Root
+	a.Equal("Nested Loops", root.NodeType)
+	a.Equal(0.5, root.GetCost())
+	a.Len(root.Children, 2)
+
+	scan := root.Children[0]
+	a.Equal("Table Scan", scan.NodeType)
+	a.Equal("dbo.t", scan.Relation)
+	a.Equal("[db].[dbo].[t].[a]=(1)", scan.Filter)
+	a.True(scan.FullScan)
+
+	seek := root.Children[1]
+	a.Equal("dbo.u", seek.Relation)
+	a.Equal("PK_u", seek.Index)
+	a.Equal("[x].[id]=[db].[dbo].[t].[id]", seek.Filter)
+	a.False(seek.FullScan)
+
+	a.Len(plan.Warnings, 2)
+	a.Equal(v1pb.QueryPlanWarning_FULL_SCAN, plan.Warnings[0].Type)
+	a.Equal(v1pb.QueryPlanWarning_MISSING_INDEX, plan.Warnings[1].Type)
+}
@@ -545,7 +545,7 @@ func (d *Driver) QueryConn(ctx context.Context, conn *sql.Conn, statement string
 		startTime := time.Now()
 		queryResult, err := func() (*v1pb.QueryResult, error) {
 			if allQuery {
-				rows, err := conn.QueryContext(ctx, sqlWithBytebaseAppComment)
+				rows, err := conn.QueryContext(ctx, sqlWithBytebaseAppComment, queryContext.Parameters...)
 				if err != nil {
 					return nil, err
 				}
@@ -560,7 +560,7 @@ func (d *Driver) QueryConn(ctx context.Context, conn *sql.Conn, statement string
 				return r, nil
 			}
 
-			sqlResult, err := conn.ExecContext(ctx, statement)
+			sqlResult, err := conn.ExecContext(ctx, statement, queryContext.Parameters...)
 			if err != nil {
 				return nil, err
 			}
@@ -582,6 +582,12 @@ func (d *Driver) QueryConn(ctx context.Context, conn *sql.Conn, statement string
 				Error: err.Error(),
 			}
 			stop = true
+		} else if queryContext.Explain && d.dbType == storepb.Engine_MYSQL {
+			plan, err := explainPlan(ctx, conn, singleSQL.Text, queryContext.Parameters)
+			if err != nil {
+				slog.Debug("failed to get normalized query plan", log.BBError(err))
+			}
+			queryResult.Plan = plan
 		}
 		queryResult.Statement = statement
 		queryResult.Latency = durationpb.New(time.Since(startTime))
@@ -0,0 +1,186 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+	"github.com/bytebase/bytebase/backend/plugin/db/util"
+)
+
+// planOperations are the EXPLAIN FORMAT=JSON keys wrapping a nested plan, and their node types.
+var planOperations = []struct {
+	key      string
+	nodeType string
+}{
+	{key: "ordering_operation", nodeType: "Sort"},
+	{key: "grouping_operation", nodeType: "Group"},
+	{key: "duplicates_removal", nodeType: "Duplicates Removal"},
+	{key: "windowing", nodeType: "Window"},
+	{key: "buffer_result", nodeType: "Buffer Result"},
+}
+
+// accessTypes maps the access_type of a table to its node type.
+// https://dev.mysql.com/doc/refman/8.0/en/explain-output.html#explain-join-types
+var accessTypes = map[string]string{
+	"system":          "System Table Access",
+	"const":           "Constant Lookup",
+	"eq_ref":          "Unique Index Lookup",
+	"ref":             "Index Lookup",
+	"fulltext":        "Fulltext Index Lookup",
+	"ref_or_null":     "Index Lookup",
+	"index_merge":     "Index Merge",
+	"unique_subquery": "Unique Subquery",
+	"index_subquery":  "Index Subquery",
+	"range":           "Index Range Scan",
+	"index":           "Full Index Scan",
+	"ALL":             "Full Table Scan",
+}
+
+// explainPlan returns the normalized plan of the statement.
+func explainPlan(ctx context.Context, conn *sql.Conn, statement string, args []any) (*v1pb.QueryPlan, error) {
+	var output string
+	if err := conn.QueryRowContext(ctx, fmt.Sprintf("EXPLAIN FORMAT=JSON %s", statement), args...).Scan(&output); err != nil {
+		return nil, err
+	}
+	return parsePlan(output)
+}
+
+// parsePlan parses the EXPLAIN FORMAT=JSON output.
+func parsePlan(output string) (*v1pb.QueryPlan, error) {
+	var plan map[string]any
+	if err := json.Unmarshal([]byte(output), &plan); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal plan")
+	}
+	queryBlock, ok := plan["query_block"].(map[string]any)
+	if !ok {
+		return nil, errors.New("query_block not found in plan")
+	}
+	return util.NewQueryPlan(convertQueryBlock(queryBlock)), nil
+}
+
+func convertQueryBlock(block map[string]any) *v1pb.QueryPlanNode {
+	node := &v1pb.QueryPlanNode{
+		NodeType: "Query Block",
+		Cost:     planNumber(block, "cost_info", "query_cost"),
+	}
+	if union, ok := block["union_result"].(map[string]any); ok {
+		node.NodeType = "Union"
+		for _, spec := range planObjects(union["query_specifications"]) {
+			if b, ok := spec["query_block"].(map[string]any); ok {
+				node.Children = append(node.Children, convertQueryBlock(b))
+			}
+		}
+		return node
+	}
+	node.Children = convertPlanBody(block)
+	return node
+}
+
+// convertPlanBody converts the tables and operations of a query block or operation.
+func convertPlanBody(body map[string]any) []*v1pb.QueryPlanNode {
+	for _, op := range planOperations {
+		if v, ok := body[op.key].(map[string]any); ok {
+			node := &v1pb.QueryPlanNode{NodeType: op.nodeType}
+			if op.key == "ordering_operation" && v["using_filesort"] == true {
+				node.NodeType = "Filesort"
+			}
+			node.Children = convertPlanBody(v)
+			return []*v1pb.QueryPlanNode{node}
+		}
+	}
+
+	var children []*v1pb.QueryPlanNode
+	if table, ok := body["table"].(map[string]any); ok {
+		children = append(children, convertTable(table))
+	}
+	if loops := planObjects(body["nested_loop"]); len(loops) > 0 {
+		node := &v1pb.QueryPlanNode{NodeType: "Nested Loop"}
+		for _, loop := range loops {
+			if table, ok := loop["table"].(map[string]any); ok {
+				node.Children = append(node.Children, convertTable(table))
+			}
+		}
+		children = append(children, node)
+	}
+	for _, key := range []string{"attached_subqueries", "optimized_away_subqueries", "order_by_subqueries", "group_by_subqueries", "having_subqueries", "select_list_subqueries"} {
+		for _, subquery := range planObjects(body[key]) {
+			if b, ok := subquery["query_block"].(map[string]any); ok {
+				children = append(children, convertQueryBlock(b))
+			}
+		}
+	}
+	return children
+}
+
+func convertTable(table map[string]any) *v1pb.QueryPlanNode {
+	accessType, _ := table["access_type"].(string)
+	nodeType, ok := accessTypes[accessType]
+	if !ok {
+		nodeType = "Table Access"
+	}
+	relation, _ := table["table_name"].(string)
+	index, _ := table["key"].(string)
+	filter, _ := table["attached_condition"].(string)
+	node := &v1pb.QueryPlanNode{
+		NodeType:      nodeType,
+		Relation:      relation,
+		Index:         index,
+		Filter:        filter,
+		EstimatedRows: planNumber(table, "rows_examined_per_scan"),
+		Cost:          planNumber(table, "cost_info", "prefix_cost"),
+		FullScan:      accessType == "ALL",
+	}
+	if subquery, ok := table["materialized_from_subquery"].(map[string]any); ok {
+		node.NodeType = "Materialize"
+		node.FullScan = false
+		if b, ok := subquery["query_block"].(map[string]any); ok {
+			node.Children = append(node.Children, convertQueryBlock(b))
+		}
+	}
+	for _, subquery := range planObjects(table["attached_subqueries"]) {
+		if b, ok := subquery["query_block"].(map[string]any); ok {
+			node.Children = append(node.Children, convertQueryBlock(b))
+		}
+	}
+	return node
+}
+
+// planNumber returns the number at the given path. MySQL reports costs as strings and row counts as numbers.
+func planNumber(m map[string]any, path ...string) *float64 {
+	var v any = m
+	for _, key := range path {
+		obj, ok := v.(map[string]any)
+		if !ok {
+			return nil
+		}
+		v = obj[key]
+	}
+	switch n := v.(type) {
+	case float64:
+		return &n
+	case string:
+		return util.ParsePlanNumber(strings.TrimSpace(n))
+	default:
+		return nil
+	}
+}
+
+func planObjects(v any) []map[string]any {
+	list, ok := v.([]any)
+	if !ok {
+		return nil
+	}
+	var result []map[string]any
+	for _, item := range list {
+		if obj, ok := item.(map[string]any); ok {
+			result = append(result, obj)
+		}
+	}
+	return result
+}
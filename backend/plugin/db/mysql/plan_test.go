@@ -0,0 +1,82 @@
+package mysql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+)
+
+func TestParsePlan(t *testing.T) {
+	a := require.New(t)
+
+	output := `{
+  "query_block": {
+    "select_id": 1,
+    "cost_info": {
+      "query_cost": "12.50"
+    },
+    "ordering_operation": {
+      "using_filesort": true,
+      "nested_loop": [
+        {
+          "table": {
+            "table_name": "e",
+            "access_type": "ALL",
+            "possible_keys": null,
+            "rows_examined_per_scan": 100,
+            "rows_produced_per_join": 33,
+            "filtered": "33.33",
+            "cost_info": {
+              "read_cost": "7.00",
+              "eval_cost": "3.30",
+              "prefix_cost": "10.30"
+            },
+            "attached_condition": "(` + "`db`.`e`.`age`" + ` > 30)"
+          }
+        },
+        {
+          "table": {
+            "table_name": "d",
+            "access_type": "eq_ref",
+            "possible_keys": ["PRIMARY"],
+            "key": "PRIMARY",
+            "rows_examined_per_scan": 1,
+            "cost_info": {
+              "prefix_cost": "12.50"
+            }
+          }
+        }
+      ]
+    }
+  }
+}`
+	plan, err := parsePlan(output)
+	a.NoError(err)
+
+	root := plan.Root
+	a.Equal("Query Block", root.NodeType)
+	a.Equal(12.5, root.GetCost())
+	a.Len(root.Children, 1)
+
+	sort := root.Children[0]
+	a.Equal("Filesort", sort.NodeType)
+	a.Len(sort.Children, 1)
+
+	loop := sort.Children[0]
+	a.Equal("Nested Loop", loop.NodeType)
+	a.Len(loop.Children, 2)
+	a.Equal("Full Table Scan", loop.Children[0].NodeType)
+	a.True(loop.Children[0].FullScan)
+	a.Equal(100.0, loop.Children[0].GetEstimatedRows())
+	a.Equal("Unique Index Lookup", loop.Children[1].NodeType)
+	a.Equal("PRIMARY", loop.Children[1].Index)
+
+	a.Len(plan.Warnings, 2)
+	a.Equal(v1pb.QueryPlanWarning_FULL_SCAN, plan.Warnings[0].Type)
+	a.Equal(v1pb.QueryPlanWarning_MISSING_INDEX, plan.Warnings[1].Type)
+
+	_, err = parsePlan(`{}`)
+	a.Error(err)
+}
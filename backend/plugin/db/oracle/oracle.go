@@ -239,7 +239,11 @@ func (d *Driver) QueryConn(ctx context.Context, conn *sql.Conn, statement string
 	var results []*v1pb.QueryResult
 	for _, singleSQL := range singleSQLs {
 		statement := singleSQL.Text
+		args := queryContext.Parameters
+		var plan *v1pb.QueryPlan
 		if queryContext.Explain {
+			// EXPLAIN PLAN does not bind values, the placeholders are left unbound.
+			args = nil
 			startTime := time.Now()
 			randNum, err := rand.Int(rand.Reader, big.NewInt(999))
 			if err != nil {
@@ -250,6 +254,9 @@ func (d *Driver) QueryConn(ctx context.Context, conn *sql.Conn, statement string
 			if _, err := conn.ExecContext(ctx, fmt.Sprintf("EXPLAIN PLAN SET STATEMENT_ID = '%s' FOR %s", randomID, statement)); err != nil {
 				return nil, err
 			}
+			if plan, err = explainPlan(ctx, conn, randomID); err != nil {
+				slog.Debug("failed to get normalized query plan", log.BBError(err))
+			}
 			statement = fmt.Sprintf(`SELECT LPAD(' ', LEVEL-1) || OPERATION || ' (' || OPTIONS || ')' "Operation", OBJECT_NAME "Object", OPTIMIZER "Optimizer", COST "Cost", CARDINALITY "Cardinality", BYTES "Bytes", PARTITION_START "Partition Start", PARTITION_ID "Partition ID", ACCESS_PREDICATES "Access Predicates" FROM PLAN_TABLE START WITH ID = 0 AND statement_id = '%s' CONNECT BY PRIOR ID=PARENT_ID AND statement_id = '%s' ORDER BY id`, randomID, randomID)
 		}
 
@@ -264,7 +271,7 @@ func (d *Driver) QueryConn(ctx context.Context, conn *sql.Conn, statement string
 		startTime := time.Now()
 		queryResult, err := func() (*v1pb.QueryResult, error) {
 			if allQuery {
-				rows, err := conn.QueryContext(ctx, statement)
+				rows, err := conn.QueryContext(ctx, statement, args...)
 				if err != nil {
 					return nil, err
 				}
@@ -279,7 +286,7 @@ func (d *Driver) QueryConn(ctx context.Context, conn *sql.Conn, statement string
 				return r, nil
 			}
 
-			sqlResult, err := conn.ExecContext(ctx, statement)
+			sqlResult, err := conn.ExecContext(ctx, statement, args...)
 			if err != nil {
 				return nil, err
 			}
@@ -295,6 +302,8 @@ func (d *Driver) QueryConn(ctx context.Context, conn *sql.Conn, statement string
 				Error: err.Error(),
 			}
 			stop = true
+		} else {
+			queryResult.Plan = plan
 		}
 		queryResult.Statement = statement
 		queryResult.Latency = durationpb.New(time.Since(startTime))
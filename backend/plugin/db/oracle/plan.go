@@ -0,0 +1,201 @@
+package oracle
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+	"github.com/bytebase/bytebase/backend/plugin/db/util"
+)
+
+// predicateRegexp matches the first line of a predicate in the DBMS_XPLAN output, e.g. `   1 - filter("ID"=1)`.
+var predicateRegexp = regexp.MustCompile(`^\s*(\d+)\s+-\s+(access|filter)\((.*)$`)
+
+// explainPlan returns the normalized plan of the statement explained with the statement ID.
+func explainPlan(ctx context.Context, conn *sql.Conn, statementID string) (*v1pb.QueryPlan, error) {
+	rows, err := conn.QueryContext(ctx, fmt.Sprintf("SELECT PLAN_TABLE_OUTPUT FROM TABLE(DBMS_XPLAN.DISPLAY('PLAN_TABLE', '%s', 'TYPICAL'))", statementID))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var lines []string
+	for rows.Next() {
+		var line sql.NullString
+		if err := rows.Scan(&line); err != nil {
+			return nil, err
+		}
+		lines = append(lines, line.String)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return parsePlan(lines)
+}
+
+type xplanRow struct {
+	id    int
+	depth int
+	node  *v1pb.QueryPlanNode
+}
+
+// parsePlan parses the DBMS_XPLAN.DISPLAY output.
+func parsePlan(lines []string) (*v1pb.QueryPlan, error) {
+	var header []string
+	var planRows []*xplanRow
+	predicates := make(map[int]map[string]string)
+	var lastPredicate struct {
+		id   int
+		kind string
+	}
+	inPredicates := false
+	for _, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "Predicate Information") {
+			inPredicates = true
+			continue
+		}
+		if inPredicates {
+			if trimmed == "" || strings.HasPrefix(trimmed, "---") {
+				continue
+			}
+			if strings.HasPrefix(trimmed, "Note") {
+				break
+			}
+			if matches := predicateRegexp.FindStringSubmatch(line); matches != nil {
+				id, _ := strconv.Atoi(matches[1])
+				if predicates[id] == nil {
+					predicates[id] = make(map[string]string)
+				}
+				predicates[id][matches[2]] = matches[2] + "(" + matches[3]
+				lastPredicate.id, lastPredicate.kind = id, matches[2]
+				continue
+			}
+			if kind, rest, ok := strings.Cut(trimmed, "("); ok && (kind == "access" || kind == "filter") && predicates[lastPredicate.id] != nil {
+				// A second predicate of the same operation.
+				predicates[lastPredicate.id][kind] = kind + "(" + rest
+				lastPredicate.kind = kind
+				continue
+			}
+			if predicates[lastPredicate.id] != nil {
+				// The predicate is wrapped over multiple lines.
+				predicates[lastPredicate.id][lastPredicate.kind] += " " + trimmed
+			}
+			continue
+		}
+
+		if !strings.HasPrefix(trimmed, "|") {
+			continue
+		}
+		cells := strings.Split(strings.Trim(trimmed, "|"), "|")
+		if header == nil {
+			for _, cell := range cells {
+				header = append(header, strings.TrimSpace(cell))
+			}
+			continue
+		}
+		row, err := parseXplanRow(header, cells)
+		if err != nil {
+			return nil, err
+		}
+		planRows = append(planRows, row)
+	}
+	if len(planRows) == 0 {
+		return nil, errors.New("no operation found in plan")
+	}
+
+	// The operations are listed in depth-first order, and the depth is given by the indentation.
+	root := planRows[0]
+	stack := []*xplanRow{root}
+	for _, row := range planRows {
+		if p := predicates[row.id]; p != nil {
+			row.node.Filter = p["filter"]
+			if row.node.Filter == "" {
+				row.node.Filter = p["access"]
+			}
+		}
+		if row == root {
+			continue
+		}
+		for len(stack) > 1 && stack[len(stack)-1].depth >= row.depth {
+			stack = stack[:len(stack)-1]
+		}
+		parent := stack[len(stack)-1]
+		parent.node.Children = append(parent.node.Children, row.node)
+		stack = append(stack, row)
+	}
+	return util.NewQueryPlan(root.node), nil
+}
+
+func parseXplanRow(header []string, cells []string) (*xplanRow, error) {
+	if len(cells) != len(header) {
+		return nil, errors.Errorf("expect %d columns but got %d in plan row %q", len(header), len(cells), strings.Join(cells, "|"))
+	}
+	row := &xplanRow{node: &v1pb.QueryPlanNode{}}
+	for i, name := range header {
+		cell := cells[i]
+		value := strings.TrimSpace(cell)
+		switch name {
+		case "Id":
+			id, err := strconv.Atoi(strings.TrimSpace(strings.TrimLeft(value, "*")))
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid operation id %q", value)
+			}
+			row.id = id
+		case "Operation":
+			// The operation is indented by one space per level after the leading padding.
+			row.depth = len(cell) - len(strings.TrimLeft(cell, " ")) - 1
+			row.node.NodeType = value
+			row.node.FullScan = strings.HasPrefix(value, "TABLE ACCESS") && strings.HasSuffix(value, "FULL")
+		case "Name":
+			row.node.Relation = value
+		case "Rows":
+			row.node.EstimatedRows = parseXplanNumber(value)
+		case "Cost (%CPU)", "Cost":
+			cost, _, _ := strings.Cut(value, " ")
+			row.node.Cost = parseXplanNumber(cost)
+		default:
+		}
+	}
+	// The name of index operations is the index rather than the table.
+	if strings.HasPrefix(row.node.NodeType, "INDEX") {
+		row.node.Index, row.node.Relation = row.node.Relation, ""
+	}
+	return row, nil
+}
+
+// parseXplanNumber parses numbers like "10K" in the DBMS_XPLAN output.
+func parseXplanNumber(s string) *float64 {
+	multiplier := 1.0
+	if s != "" {
+		switch s[len(s)-1] {
+		case 'K':
+			multiplier = 1e3
+		case 'M':
+			multiplier = 1e6
+		case 'G':
+			multiplier = 1e9
+		case 'T':
+			multiplier = 1e12
+		case 'P':
+			multiplier = 1e15
+		case 'E':
+			multiplier = 1e18
+		default:
+		}
+		if multiplier != 1 {
+			s = s[:len(s)-1]
+		}
+	}
+	v := util.ParsePlanNumber(s)
+	if v == nil {
+		return nil
+	}
+	return proto.Float64(*v * multiplier)
+}
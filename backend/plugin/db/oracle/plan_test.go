@@ -0,0 +1,61 @@
+package oracle
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+)
+
+func TestParsePlan(t *testing.T) {
+	a := require.New(t)
+
+	output := `Plan hash value: 2341252972
+
+-------------------------------------------------------------------------------------------
+| Id  | Operation                    | Name       | Rows  | Bytes | Cost (%CPU)| Time     |
+-------------------------------------------------------------------------------------------
+|   0 | SELECT STATEMENT             |            |    10K|   400K|   110   (1)| 00:00:01 |
+|*  1 |  HASH JOIN                   |            |    10K|   400K|   110   (1)| 00:00:01 |
+|   2 |   TABLE ACCESS BY INDEX ROWID| DEPT       |     4 |    52 |     2   (0)| 00:00:01 |
+|*  3 |    INDEX RANGE SCAN          | PK_DEPT    |     4 |       |     1   (0)| 00:00:01 |
+|*  4 |   TABLE ACCESS FULL          | EMP        |    10K|   273K|   107   (1)| 00:00:01 |
+-------------------------------------------------------------------------------------------
+
+Predicate Information (identified by operation id):
+---------------------------------------------------
+
+   1 - access("E"."DEPTNO"="D"."DEPTNO")
+   3 - access("D"."DEPTNO">10)
+   4 - filter("E"."SAL">1000 AND
+              "E"."DEPTNO">10)
+`
+	plan, err := parsePlan(strings.Split(output, "\n"))
+	a.NoError(err)
+
+	root := plan.Root
+	a.Equal("SELECT STATEMENT", root.NodeType)
+	a.Equal(10000.0, root.GetEstimatedRows())
+	a.Len(root.Children, 1)
+
+	join := root.Children[0]
+	a.Equal("HASH JOIN", join.NodeType)
+	a.Equal(110.0, join.GetCost())
+	a.Len(join.Children, 2)
+
+	byIndex := join.Children[0]
+	a.Equal("DEPT", byIndex.Relation)
+	a.Len(byIndex.Children, 1)
+	a.Equal("PK_DEPT", byIndex.Children[0].Index)
+
+	full := join.Children[1]
+	a.Equal("EMP", full.Relation)
+	a.True(full.FullScan)
+	a.Equal(`filter("E"."SAL">1000 AND "E"."DEPTNO">10)`, full.Filter)
+
+	a.Len(plan.Warnings, 2)
+	a.Equal(v1pb.QueryPlanWarning_FULL_SCAN, plan.Warnings[0].Type)
+	a.Equal(v1pb.QueryPlanWarning_MISSING_INDEX, plan.Warnings[1].Type)
+}
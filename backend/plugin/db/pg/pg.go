@@ -802,7 +802,7 @@ func (d *Driver) QueryConn(ctx context.Context, conn *sql.Conn, statement string
 		startTime := time.Now()
 		queryResult, err := func() (*v1pb.QueryResult, error) {
 			if allQuery {
-				rows, err := conn.QueryContext(ctx, statement)
+				rows, err := conn.QueryContext(ctx, statement, queryContext.Parameters...)
 				if err != nil {
 					return nil, err
 				}
@@ -818,7 +818,7 @@ func (d *Driver) QueryConn(ctx context.Context, conn *sql.Conn, statement string
 				return r, nil
 			}
 
-			sqlResult, err := conn.ExecContext(ctx, statement)
+			sqlResult, err := conn.ExecContext(ctx, statement, queryContext.Parameters...)
 			if err != nil {
 				return nil, err
 			}
@@ -835,6 +835,12 @@ func (d *Driver) QueryConn(ctx context.Context, conn *sql.Conn, statement string
 				DetailedError: getPgError(err),
 			}
 			stop = true
+		} else if queryContext.Explain {
+			plan, err := explainPlan(ctx, conn, singleSQL.Text, queryContext.Parameters)
+			if err != nil {
+				slog.Debug("failed to get normalized query plan", log.BBError(err))
+			}
+			queryResult.Plan = plan
 		}
 		queryResult.Statement = statement
 		queryResult.Latency = durationpb.New(time.Since(startTime))
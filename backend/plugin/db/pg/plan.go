@@ -0,0 +1,88 @@
+package pg
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+	"github.com/bytebase/bytebase/backend/plugin/db/util"
+)
+
+// pgPlanNode is a node of the EXPLAIN (FORMAT JSON) output.
+type pgPlanNode struct {
+	NodeType        string        `json:"Node Type"`
+	RelationName    string        `json:"Relation Name"`
+	Schema          string        `json:"Schema"`
+	IndexName       string        `json:"Index Name"`
+	Filter          string        `json:"Filter"`
+	IndexCond       string        `json:"Index Cond"`
+	TotalCost       *float64      `json:"Total Cost"`
+	PlanRows        *float64      `json:"Plan Rows"`
+	ActualRows      *float64      `json:"Actual Rows"`
+	ActualTotalTime *float64      `json:"Actual Total Time"`
+	ActualLoops     *float64      `json:"Actual Loops"`
+	Plans           []*pgPlanNode `json:"Plans"`
+}
+
+// explainPlan returns the normalized plan of the statement.
+func explainPlan(ctx context.Context, conn *sql.Conn, statement string, args []any) (*v1pb.QueryPlan, error) {
+	var output string
+	if err := conn.QueryRowContext(ctx, fmt.Sprintf("EXPLAIN (FORMAT JSON) %s", statement), args...).Scan(&output); err != nil {
+		return nil, err
+	}
+	return parsePlan(output)
+}
+
+// parsePlan parses the EXPLAIN (FORMAT JSON) output.
+func parsePlan(output string) (*v1pb.QueryPlan, error) {
+	var plans []struct {
+		Plan *pgPlanNode `json:"Plan"`
+	}
+	if err := json.Unmarshal([]byte(output), &plans); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal plan")
+	}
+	if len(plans) == 0 || plans[0].Plan == nil {
+		return nil, errors.New("empty plan")
+	}
+	return util.NewQueryPlan(convertPlanNode(plans[0].Plan)), nil
+}
+
+func convertPlanNode(node *pgPlanNode) *v1pb.QueryPlanNode {
+	relation := node.RelationName
+	if relation != "" && node.Schema != "" {
+		relation = fmt.Sprintf("%s.%s", node.Schema, relation)
+	}
+	filter := node.Filter
+	if filter == "" {
+		filter = node.IndexCond
+	}
+	result := &v1pb.QueryPlanNode{
+		NodeType:      node.NodeType,
+		Relation:      relation,
+		Index:         node.IndexName,
+		Filter:        filter,
+		EstimatedRows: node.PlanRows,
+		Cost:          node.TotalCost,
+		FullScan:      node.NodeType == "Seq Scan",
+	}
+	// Actual rows and time are averaged per loop in Postgres.
+	loops := 1.0
+	if node.ActualLoops != nil && *node.ActualLoops > 0 {
+		loops = *node.ActualLoops
+	}
+	if node.ActualRows != nil {
+		result.ActualRows = proto.Float64(*node.ActualRows * loops)
+	}
+	if node.ActualTotalTime != nil {
+		result.ActualTimeMs = proto.Float64(*node.ActualTotalTime * loops)
+	}
+	for _, child := range node.Plans {
+		result.Children = append(result.Children, convertPlanNode(child))
+	}
+	return result
+}
@@ -0,0 +1,83 @@
+package pg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+)
+
+func TestParsePlan(t *testing.T) {
+	a := require.New(t)
+
+	output := `[
+  {
+    "Plan": {
+      "Node Type": "Hash Join",
+      "Join Type": "Inner",
+      "Startup Cost": 1.09,
+      "Total Cost": 2.21,
+      "Plan Rows": 4,
+      "Actual Rows": 4,
+      "Actual Total Time": 0.05,
+      "Actual Loops": 1,
+      "Plans": [
+        {
+          "Node Type": "Seq Scan",
+          "Parent Relationship": "Outer",
+          "Relation Name": "employee",
+          "Alias": "e",
+          "Total Cost": 1.05,
+          "Plan Rows": 5,
+          "Actual Rows": 5,
+          "Actual Total Time": 0.01,
+          "Actual Loops": 1,
+          "Filter": "(age > 30)"
+        },
+        {
+          "Node Type": "Index Scan",
+          "Parent Relationship": "Inner",
+          "Relation Name": "dept",
+          "Index Name": "dept_pkey",
+          "Total Cost": 0.15,
+          "Plan Rows": 1,
+          "Actual Rows": 1,
+          "Actual Total Time": 0.002,
+          "Actual Loops": 4,
+          "Index Cond": "(id = e.dept_id)"
+        }
+      ]
+    },
+    "Planning Time": 0.1,
+    "Execution Time": 0.08
+  }
+]`
+	plan, err := parsePlan(output)
+	a.NoError(err)
+
+	root := plan.Root
+	a.Equal("Hash Join", root.NodeType)
+	a.Equal(4.0, root.GetEstimatedRows())
+	a.Equal(2.21, root.GetCost())
+	a.Len(root.Children, 2)
+
+	seqScan := root.Children[0]
+	a.Equal("employee", seqScan.Relation)
+	a.Equal("(age > 30)", seqScan.Filter)
+	a.True(seqScan.FullScan)
+
+	indexScan := root.Children[1]
+	a.Equal("dept_pkey", indexScan.Index)
+	a.False(indexScan.FullScan)
+	a.Equal(4.0, indexScan.GetActualRows())
+	a.Equal(0.008, indexScan.GetActualTimeMs())
+
+	a.Len(plan.Warnings, 2)
+	a.Equal(v1pb.QueryPlanWarning_FULL_SCAN, plan.Warnings[0].Type)
+	a.Equal(v1pb.QueryPlanWarning_MISSING_INDEX, plan.Warnings[1].Type)
+	a.Equal("employee", plan.Warnings[1].Relation)
+
+	_, err = parsePlan("[]")
+	a.Error(err)
+}
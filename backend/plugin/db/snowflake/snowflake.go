@@ -368,7 +368,7 @@ func (*Driver) QueryConn(ctx context.Context, conn *sql.Conn, statement string,
 		startTime := time.Now()
 		queryResult, err := func() (*v1pb.QueryResult, error) {
 			if allQuery {
-				rows, err := conn.QueryContext(ctx, statement)
+				rows, err := conn.QueryContext(ctx, statement, queryContext.Parameters...)
 				if err != nil {
 					return nil, err
 				}
@@ -383,7 +383,7 @@ func (*Driver) QueryConn(ctx context.Context, conn *sql.Conn, statement string,
 				return r, nil
 			}
 
-			sqlResult, err := conn.ExecContext(ctx, statement)
+			sqlResult, err := conn.ExecContext(ctx, statement, queryContext.Parameters...)
 			if err != nil {
 				return nil, err
 			}
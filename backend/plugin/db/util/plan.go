@@ -0,0 +1,70 @@
+package util
+
+import (
+	"fmt"
+	"strconv"
+
+	"google.golang.org/protobuf/proto"
+
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+	"github.com/bytebase/bytebase/backend/plugin/advisor/code"
+)
+
+// NewQueryPlan builds the query plan for the plan tree rooted at root.
+// The given engine-reported warnings are kept, and warnings for full scans and
+// filtered full scans (which usually indicate a missing index) are derived from the tree.
+func NewQueryPlan(root *v1pb.QueryPlanNode, warnings ...*v1pb.QueryPlanWarning) *v1pb.QueryPlan {
+	missingIndex := make(map[string]bool)
+	for _, w := range warnings {
+		if w.Type == v1pb.QueryPlanWarning_MISSING_INDEX {
+			missingIndex[w.Relation] = true
+		}
+	}
+
+	var derived []*v1pb.QueryPlanWarning
+	var walk func(node *v1pb.QueryPlanNode)
+	walk = func(node *v1pb.QueryPlanNode) {
+		if node == nil {
+			return
+		}
+		if node.FullScan && node.Relation != "" {
+			content := fmt.Sprintf("Full scan on %q", node.Relation)
+			if node.EstimatedRows != nil {
+				content += fmt.Sprintf(", estimated %s rows", strconv.FormatFloat(node.GetEstimatedRows(), 'f', -1, 64))
+			}
+			derived = append(derived, &v1pb.QueryPlanWarning{
+				Type:     v1pb.QueryPlanWarning_FULL_SCAN,
+				Relation: node.Relation,
+				Content:  content,
+				Code:     code.StatementHasTableFullScan.Int32(),
+			})
+			if node.Filter != "" && !missingIndex[node.Relation] {
+				missingIndex[node.Relation] = true
+				derived = append(derived, &v1pb.QueryPlanWarning{
+					Type:     v1pb.QueryPlanWarning_MISSING_INDEX,
+					Relation: node.Relation,
+					Content:  fmt.Sprintf("Full scan on %q is filtered by %s, consider adding an index on the filtered columns", node.Relation, node.Filter),
+					Code:     code.StatementMissingIndex.Int32(),
+				})
+			}
+		}
+		for _, child := range node.Children {
+			walk(child)
+		}
+	}
+	walk(root)
+
+	return &v1pb.QueryPlan{
+		Root:     root,
+		Warnings: append(warnings, derived...),
+	}
+}
+
+// ParsePlanNumber parses a numeric plan attribute, returning nil if it is empty or not a number.
+func ParsePlanNumber(s string) *float64 {
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return nil
+	}
+	return proto.Float64(v)
+}
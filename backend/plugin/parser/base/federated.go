@@ -0,0 +1,317 @@
+package base
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// federatedDialect is the dialect of federated statements, which are executed by SQLite.
+var federatedDialect = &FormatDialect{
+	BacktickIdentifiers: true,
+	BracketIdentifiers:  true,
+}
+
+// FederatedReference is a table referenced by a federated statement as instance.database.table
+// or instance.database.schema.table.
+type FederatedReference struct {
+	Instance string
+	Database string
+	// Schema is empty for three-part references.
+	Schema string
+	Table  string
+	// Start and End are the byte offsets of the reference in the statement.
+	Start int
+	End   int
+}
+
+// ExtractFederatedReferences returns the three-part and four-part names in the federated statement.
+// Names in strings and comments are ignored. The caller decides which names resolve to databases.
+func ExtractFederatedReferences(statement string) ([]FederatedReference, error) {
+	lexer := newFormatLexer(federatedDialect, statement)
+	tokens := lexer.lex()
+	if lexer.unterminated {
+		return nil, errors.New("statement has an unterminated string, identifier or comment")
+	}
+
+	var references []FederatedReference
+	for i := 0; i < len(tokens); i++ {
+		if !isFederatedNamePart(tokens[i]) || (i > 0 && tokens[i-1].is(".")) {
+			continue
+		}
+		parts := []string{unquoteFederatedName(tokens[i].text)}
+		j := i
+		for j+2 < len(tokens) && tokens[j+1].is(".") && isFederatedNamePart(tokens[j+2]) {
+			parts = append(parts, unquoteFederatedName(tokens[j+2].text))
+			j += 2
+		}
+		reference := FederatedReference{Start: tokens[i].start, End: tokens[j].end}
+		switch len(parts) {
+		case 3:
+			reference.Instance, reference.Database, reference.Table = parts[0], parts[1], parts[2]
+		case 4:
+			reference.Instance, reference.Database, reference.Schema, reference.Table = parts[0], parts[1], parts[2], parts[3]
+		default:
+			i = j
+			continue
+		}
+		references = append(references, reference)
+		i = j
+	}
+	return references, nil
+}
+
+// ExtractFederatedIdentifiers returns the unquoted identifiers in the federated statement, ignoring strings and comments.
+func ExtractFederatedIdentifiers(statement string) []string {
+	var identifiers []string
+	for _, token := range newFormatLexer(federatedDialect, statement).lex() {
+		if isFederatedNamePart(token) {
+			identifiers = append(identifiers, unquoteFederatedName(token.text))
+		}
+	}
+	return identifiers
+}
+
+func isFederatedNamePart(token formatToken) bool {
+	return (token.kind == formatWord && !strings.HasPrefix(token.text, ":") && !strings.HasPrefix(token.text, "$")) || token.kind == formatQuoted
+}
+
+func unquoteFederatedName(text string) string {
+	if len(text) < 2 {
+		return text
+	}
+	switch text[0] {
+	case '"':
+		return strings.ReplaceAll(text[1:len(text)-1], `""`, `"`)
+	case '`':
+		return strings.ReplaceAll(text[1:len(text)-1], "``", "`")
+	case '[':
+		return text[1 : len(text)-1]
+	default:
+		return text
+	}
+}
+
+// FederatedPredicate is a condition of the federated statement on a single reference.
+// The condition can be pushed down to the database of the reference so that fewer rows are read,
+// while the statement still evaluates the condition over the rows read.
+type FederatedPredicate struct {
+	// Reference is the index of the reference in the references.
+	Reference int
+	Column    string
+	// Operator is one of =, <, <=, >, >= and IN.
+	Operator string
+	// Values are the literals compared with the column, as written in the statement.
+	Values []string
+}
+
+// ExtractFederatedPredicates returns the conditions of the top-level WHERE clause that can be pushed down to the references.
+// Only the conjuncts comparing a column of a reference in the top-level FROM clause with literals are returned.
+// The comparisons reject NULLs, so they stay correct on either side of an outer join. String literals are only
+// compared for equality, since the collation of the database may order strings differently.
+func ExtractFederatedPredicates(statement string, references []FederatedReference) []FederatedPredicate {
+	lexer := newFormatLexer(federatedDialect, statement)
+	var tokens []formatToken
+	for _, token := range lexer.lex() {
+		if !token.isComment() {
+			tokens = append(tokens, token)
+		}
+	}
+	if lexer.unterminated || len(tokens) == 0 || !formatWordIs(tokens[0], "SELECT") {
+		return nil
+	}
+
+	// Find the FROM and WHERE clauses of the top-level query.
+	from, where, whereEnd := -1, -1, len(tokens)
+	depth := 0
+	for i, token := range tokens {
+		switch {
+		case token.is("("):
+			depth++
+		case token.is(")"):
+			depth--
+		case depth > 0:
+		case formatWordIs(token, "UNION", "INTERSECT", "EXCEPT"):
+			return nil
+		case formatWordIs(token, "FROM") && from < 0:
+			from = i
+		case formatWordIs(token, "WHERE") && where < 0:
+			where = i
+		case where >= 0 && whereEnd == len(tokens) && (formatWordIs(token, "GROUP", "ORDER", "HAVING", "LIMIT", "OFFSET", "WINDOW") || token.kind == formatTerminator):
+			whereEnd = i
+		}
+	}
+	if from < 0 || where < from {
+		return nil
+	}
+
+	// Map the qualifiers of the references in the top-level FROM clause to the references.
+	qualifiers := make(map[string]int)
+	var fromReferences []int
+	for i := from + 1; i < where; i++ {
+		for j, reference := range references {
+			if tokens[i].start != reference.Start || federatedDepth(tokens[from:i]) != 0 {
+				continue
+			}
+			fromReferences = append(fromReferences, j)
+			k := i
+			for k < where && tokens[k].end != reference.End {
+				k++
+			}
+			alias := ""
+			if k+1 < where && formatWordIs(tokens[k+1], "AS") {
+				k++
+			}
+			if k+1 < where && (tokens[k+1].kind == formatQuoted || (tokens[k+1].kind == formatWord && !formatKeywords[strings.ToUpper(tokens[k+1].text)])) {
+				alias = unquoteFederatedName(tokens[k+1].text)
+			}
+			if alias == "" {
+				alias = reference.Table
+			}
+			// Ambiguous qualifiers are not pushed down.
+			if _, ok := qualifiers[strings.ToLower(alias)]; ok {
+				qualifiers[strings.ToLower(alias)] = -1
+			} else {
+				qualifiers[strings.ToLower(alias)] = j
+			}
+		}
+	}
+	// Unqualified columns belong to the only table of the FROM clause.
+	unqualified := -1
+	if len(fromReferences) == 1 && federatedFromHasSingleTable(tokens[from+1:where]) {
+		unqualified = fromReferences[0]
+	}
+
+	var predicates []FederatedPredicate
+	for _, conjunct := range splitFederatedConjuncts(tokens[where+1 : whereEnd]) {
+		if conjunct == nil {
+			return nil
+		}
+		if predicate, ok := parseFederatedPredicate(conjunct, qualifiers, unqualified); ok {
+			predicates = append(predicates, predicate)
+		}
+	}
+	return predicates
+}
+
+func federatedDepth(tokens []formatToken) int {
+	depth := 0
+	for _, token := range tokens {
+		switch {
+		case token.is("("):
+			depth++
+		case token.is(")"):
+			depth--
+		}
+	}
+	return depth
+}
+
+// federatedFromHasSingleTable reports whether the FROM clause reads a single table, i.e. it has no joins or subqueries.
+func federatedFromHasSingleTable(tokens []formatToken) bool {
+	for _, token := range tokens {
+		if token.is(",") || token.is("(") || formatWordIs(token, "JOIN") {
+			return false
+		}
+	}
+	return true
+}
+
+// splitFederatedConjuncts splits the condition by the top-level ANDs.
+// It returns a nil conjunct if the condition is not a conjunction, e.g. it has a top-level OR.
+func splitFederatedConjuncts(tokens []formatToken) [][]formatToken {
+	var conjuncts [][]formatToken
+	depth, start := 0, 0
+	between := false
+	for i, token := range tokens {
+		switch {
+		case token.is("("):
+			depth++
+		case token.is(")"):
+			depth--
+		case depth > 0:
+		case formatWordIs(token, "OR"):
+			return [][]formatToken{nil}
+		case formatWordIs(token, "BETWEEN"):
+			between = true
+		case formatWordIs(token, "AND") && between:
+			between = false
+		case formatWordIs(token, "AND"):
+			conjuncts = append(conjuncts, tokens[start:i])
+			start = i + 1
+		}
+	}
+	return append(conjuncts, tokens[start:])
+}
+
+// parseFederatedPredicate parses the conjunct of the form column op literal, literal op column or column IN (literals).
+func parseFederatedPredicate(tokens []formatToken, qualifiers map[string]int, unqualified int) (FederatedPredicate, bool) {
+	column := func(tokens []formatToken) (int, string, bool) {
+		isName := func(token formatToken) bool {
+			return token.kind == formatQuoted || (token.kind == formatWord && !formatKeywords[strings.ToUpper(token.text)])
+		}
+		switch {
+		case len(tokens) == 1 && isName(tokens[0]) && unqualified >= 0:
+			return unqualified, unquoteFederatedName(tokens[0].text), true
+		case len(tokens) == 3 && isName(tokens[0]) && tokens[1].is(".") && isName(tokens[2]):
+			reference, ok := qualifiers[strings.ToLower(unquoteFederatedName(tokens[0].text))]
+			if !ok || reference < 0 {
+				return 0, "", false
+			}
+			return reference, unquoteFederatedName(tokens[2].text), true
+		default:
+			return 0, "", false
+		}
+	}
+	isLiteral := func(token formatToken, operator string) bool {
+		if token.kind == formatNumber {
+			return true
+		}
+		// Backslashes are escapes in some databases.
+		return (operator == "=" || operator == "IN") && token.kind == formatString && strings.HasPrefix(token.text, "'") && !strings.Contains(token.text, `\`)
+	}
+	// operators maps the comparison operators to the operators with the operands swapped.
+	operators := map[string]string{"=": "=", "==": "=", "<": ">", "<=": ">=", ">": "<", ">=": "<="}
+
+	// column IN (literal, ...)
+	for i, token := range tokens {
+		if !formatWordIs(token, "IN") {
+			continue
+		}
+		reference, name, ok := column(tokens[:i])
+		if !ok || i+2 >= len(tokens) || !tokens[i+1].is("(") || !tokens[len(tokens)-1].is(")") {
+			return FederatedPredicate{}, false
+		}
+		predicate := FederatedPredicate{Reference: reference, Column: name, Operator: "IN"}
+		for j, value := range tokens[i+2 : len(tokens)-1] {
+			if j%2 == 1 {
+				if !value.is(",") {
+					return FederatedPredicate{}, false
+				}
+				continue
+			}
+			if !isLiteral(value, "IN") {
+				return FederatedPredicate{}, false
+			}
+			predicate.Values = append(predicate.Values, value.text)
+		}
+		return predicate, len(predicate.Values) > 0
+	}
+
+	// column op literal or literal op column.
+	for i, token := range tokens {
+		swapped, ok := operators[token.text]
+		if token.kind != formatOperator || !ok {
+			continue
+		}
+		operator := operators[swapped]
+		if reference, name, ok := column(tokens[:i]); ok && i == len(tokens)-2 && isLiteral(tokens[i+1], operator) {
+			return FederatedPredicate{Reference: reference, Column: name, Operator: operator, Values: []string{tokens[i+1].text}}, true
+		}
+		if reference, name, ok := column(tokens[i+1:]); ok && i == 1 && isLiteral(tokens[0], swapped) {
+			return FederatedPredicate{Reference: reference, Column: name, Operator: swapped, Values: []string{tokens[0].text}}, true
+		}
+		return FederatedPredicate{}, false
+	}
+	return FederatedPredicate{}, false
+}
@@ -0,0 +1,75 @@
+package base
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractFederatedReferences(t *testing.T) {
+	a := require.New(t)
+	statement := "SELECT o.id, c.name FROM pg.shop.public.orders o JOIN `mysql-prod`.crm.customers c ON o.customer_id = c.id -- pg.shop.public.x\nWHERE c.name <> 'pg.shop.public.y' AND o.id > 1.5"
+	references, err := ExtractFederatedReferences(statement)
+	a.NoError(err)
+	a.Equal([]FederatedReference{
+		{Instance: "pg", Database: "shop", Schema: "public", Table: "orders", Start: 25, End: 46},
+		{Instance: "mysql-prod", Database: "crm", Table: "customers", Start: 54, End: 80},
+	}, references)
+	a.Equal("pg.shop.public.orders", statement[references[0].Start:references[0].End])
+	a.Equal("`mysql-prod`.crm.customers", statement[references[1].Start:references[1].End])
+
+	// Two-part and five-part names are not references.
+	references, err = ExtractFederatedReferences(`SELECT t.id, "a"."b"."c"."d"."e" FROM t`)
+	a.NoError(err)
+	a.Empty(references)
+
+	_, err = ExtractFederatedReferences("SELECT 'unterminated")
+	a.Error(err)
+}
+
+func TestExtractFederatedPredicates(t *testing.T) {
+	a := require.New(t)
+	statement := "SELECT o.id, c.name FROM pg.shop.public.orders o LEFT JOIN mysql.crm.customers AS c ON o.customer_id = c.id " +
+		"WHERE o.amount >= 10 AND 'vip' = c.tier AND c.region IN ('us', 'eu') AND o.created BETWEEN 1 AND 2 AND c.name LIKE 'a%' " +
+		"AND c.name < 'm' AND o.status = 'it''s' AND o.id > (SELECT 1) ORDER BY o.id"
+	references, err := ExtractFederatedReferences(statement)
+	a.NoError(err)
+	a.Equal([]FederatedPredicate{
+		{Reference: 0, Column: "amount", Operator: ">=", Values: []string{"10"}},
+		{Reference: 1, Column: "tier", Operator: "=", Values: []string{"'vip'"}},
+		{Reference: 1, Column: "region", Operator: "IN", Values: []string{"'us'", "'eu'"}},
+		{Reference: 0, Column: "status", Operator: "=", Values: []string{"'it''s'"}},
+	}, ExtractFederatedPredicates(statement, references))
+
+	// Unqualified columns belong to the only table, and literals on the left are swapped.
+	statement = "SELECT * FROM pg.shop.public.orders WHERE 10 < amount AND orders.id = 1"
+	references, err = ExtractFederatedReferences(statement)
+	a.NoError(err)
+	a.Equal([]FederatedPredicate{
+		{Reference: 0, Column: "amount", Operator: ">", Values: []string{"10"}},
+		{Reference: 0, Column: "id", Operator: "=", Values: []string{"1"}},
+	}, ExtractFederatedPredicates(statement, references))
+
+	for _, statement := range []string{
+		// Disjunctions and set operations are not pushed down.
+		"SELECT * FROM pg.shop.public.orders o WHERE o.id = 1 OR o.id = 2",
+		"SELECT * FROM pg.shop.public.orders o WHERE o.id = 1 UNION SELECT * FROM pg.shop.public.orders o WHERE o.id = 2",
+		// Unqualified columns are ambiguous with joins.
+		"SELECT * FROM pg.shop.public.orders o JOIN mysql.crm.customers c ON o.customer_id = c.id WHERE id = 1",
+		// The same qualifier for two tables is ambiguous.
+		"SELECT * FROM pg.shop.public.t JOIN mysql.crm.t ON 1 = 1 WHERE t.id = 1",
+		// Conditions in subqueries are not pushed down.
+		"SELECT * FROM (SELECT * FROM pg.shop.public.orders o WHERE o.id = 1) x",
+		// Backslashes are escapes in some databases.
+		`SELECT * FROM pg.shop.public.orders o WHERE o.name = 'a\'`,
+	} {
+		references, err := ExtractFederatedReferences(statement)
+		a.NoError(err)
+		a.Empty(ExtractFederatedPredicates(statement, references), statement)
+	}
+}
+
+func TestExtractFederatedIdentifiers(t *testing.T) {
+	a := require.New(t)
+	a.Equal([]string{"SELECT", "o", "Email", "FROM", "t", "o", "WHERE", "o", "note"}, ExtractFederatedIdentifiers("SELECT o.`Email` FROM t o WHERE o.note = 'name' -- phone"))
+}
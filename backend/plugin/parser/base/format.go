@@ -0,0 +1,1332 @@
+package base
+
+import (
+	"maps"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// FormatOptions controls the layout produced by the SQL formatter.
+type FormatOptions struct {
+	// IndentSize is the number of spaces per indentation level. Defaults to 2.
+	IndentSize int
+	// UseTab indents with tabs instead of spaces.
+	UseTab bool
+}
+
+// FormatDialect describes the lexical rules of a SQL dialect for FormatSQL.
+//
+// The formatter only rewrites whitespace and the case of reserved keywords, so
+// the dialect only needs to describe how to tokenize the script and where the
+// statement boundaries are. Routine bodies and procedural blocks are kept verbatim.
+type FormatDialect struct {
+	// BackslashEscapes allows backslash escapes in single-quoted strings.
+	BackslashEscapes bool
+	// DoubleQuotedStrings treats double-quoted text as a string literal instead of an identifier.
+	DoubleQuotedStrings bool
+	// BacktickIdentifiers enables `quoted` identifiers.
+	BacktickIdentifiers bool
+	// BracketIdentifiers enables [quoted] identifiers.
+	BracketIdentifiers bool
+	// DollarQuotes enables $tag$...$tag$ string literals.
+	DollarQuotes bool
+	// NestedComments allows nested /* */ comments.
+	NestedComments bool
+	// HashComments enables # line comments.
+	HashComments bool
+	// QQuotes enables q'[...]' string literals.
+	QQuotes bool
+	// IdentifierStart lists the extra characters an identifier or variable may start with.
+	IdentifierStart string
+	// IdentifierChars lists the extra characters allowed inside an identifier.
+	IdentifierChars string
+	// StagePaths enables @stage/path references that end at whitespace.
+	StagePaths bool
+
+	// BatchSeparator enables GO lines that separate batches.
+	BatchSeparator bool
+	// SlashTerminator enables / lines that terminate PL/SQL blocks.
+	SlashTerminator bool
+	// DelimiterCommand enables the DELIMITER client command.
+	DelimiterCommand bool
+	// MetaCommands enables backslash client commands such as \connect.
+	MetaCommands bool
+
+	// BlockStatements treats top-level BEGIN ... END as a procedural block.
+	BlockStatements bool
+	// DeclareBlocks treats top-level DECLARE ... BEGIN ... END as a procedural block.
+	DeclareBlocks bool
+	// ControlFlowStatements treats top-level IF and WHILE statements as procedural.
+	ControlFlowStatements bool
+
+	// Keywords lists the extra reserved words of the dialect that the formatter uppercases.
+	Keywords []string
+}
+
+// FormatSQL formats the statement with the lexical rules of the dialect.
+//
+// Formatting only changes whitespace and the case of reserved keywords. Queries are
+// laid out one clause per line, other statements keep their line structure with
+// normalized indentation, and procedural statements are kept as written.
+func FormatSQL(dialect *FormatDialect, statement string, opts FormatOptions) string {
+	if strings.TrimSpace(statement) == "" {
+		return ""
+	}
+	unit := strings.Repeat(" ", 2)
+	if opts.UseTab {
+		unit = "\t"
+	} else if opts.IndentSize > 0 {
+		unit = strings.Repeat(" ", opts.IndentSize)
+	}
+
+	lexer := newFormatLexer(dialect, statement)
+	tokens := lexer.lex()
+	if lexer.unterminated {
+		// Leave incomplete scripts untouched rather than guess where the literal ends.
+		return statement
+	}
+	var buf strings.Builder
+	for i, chunk := range splitFormatChunks(dialect, tokens) {
+		if i > 0 {
+			buf.WriteString("\n")
+			if chunk.tokens[0].newlines > 1 {
+				buf.WriteString("\n")
+			}
+		}
+		switch {
+		case chunk.command:
+			buf.WriteString(strings.TrimSpace(chunk.tokens[0].text))
+		case chunk.verbatim:
+			last := chunk.tokens[len(chunk.tokens)-1]
+			buf.WriteString(strings.TrimRight(statement[chunk.tokens[0].start:last.end], " \t\r\n"))
+		default:
+			buf.WriteString(formatTokens(dialect, chunk.tokens, unit))
+		}
+	}
+	buf.WriteString("\n")
+	return buf.String()
+}
+
+type formatTokenKind int
+
+const (
+	formatWord formatTokenKind = iota
+	formatQuoted
+	formatString
+	formatNumber
+	formatOperator
+	formatPunct
+	formatTerminator
+	formatLineComment
+	formatBlockComment
+	formatCommand
+)
+
+type formatToken struct {
+	kind  formatTokenKind
+	text  string
+	start int
+	end   int
+	// newlines is the number of line breaks between the previous token and this one.
+	newlines int
+	// spaced reports whether whitespace precedes the token.
+	spaced bool
+}
+
+func (t formatToken) isComment() bool {
+	return t.kind == formatLineComment || t.kind == formatBlockComment
+}
+
+func (t formatToken) is(text string) bool {
+	return (t.kind == formatPunct || t.kind == formatOperator) && t.text == text
+}
+
+var (
+	formatGoRegex        = regexp.MustCompile(`(?i)^GO(\s+\d+)?\s*(--.*)?$`)
+	formatDelimiterRegex = regexp.MustCompile(`(?i)^DELIMITER\s+(\S+)`)
+)
+
+type formatLexer struct {
+	dialect   *FormatDialect
+	src       string
+	pos       int
+	delimiter string
+	tokens    []formatToken
+	// unterminated reports a string, identifier or comment running to the end of the input.
+	unterminated bool
+}
+
+func newFormatLexer(dialect *FormatDialect, src string) *formatLexer {
+	return &formatLexer{dialect: dialect, src: src, delimiter: ";"}
+}
+
+func (l *formatLexer) lex() []formatToken {
+	newlines, spaced := 0, false
+	for l.pos < len(l.src) {
+		c := l.src[l.pos]
+		if c == ' ' || c == '\t' || c == '\r' || c == '\n' || c == '\f' {
+			if c == '\n' {
+				newlines++
+			}
+			spaced = true
+			l.pos++
+			continue
+		}
+		start := l.pos
+		kind := l.next(newlines > 0 || len(l.tokens) == 0)
+		l.tokens = append(l.tokens, formatToken{
+			kind:     kind,
+			text:     l.src[start:l.pos],
+			start:    start,
+			end:      l.pos,
+			newlines: newlines,
+			spaced:   spaced,
+		})
+		newlines, spaced = 0, false
+	}
+	return l.tokens
+}
+
+func (l *formatLexer) peek(offset int) byte {
+	if l.pos+offset < len(l.src) {
+		return l.src[l.pos+offset]
+	}
+	return 0
+}
+
+func (l *formatLexer) skipLine() {
+	for l.pos < len(l.src) && l.src[l.pos] != '\n' {
+		l.pos++
+	}
+}
+
+func (l *formatLexer) lineEnd() int {
+	if i := strings.IndexByte(l.src[l.pos:], '\n'); i >= 0 {
+		return l.pos + i
+	}
+	return len(l.src)
+}
+
+// next consumes one token and returns its kind.
+func (l *formatLexer) next(lineStart bool) formatTokenKind {
+	if lineStart {
+		if kind, ok := l.command(); ok {
+			return kind
+		}
+	}
+	if l.delimiter != ";" && strings.HasPrefix(l.src[l.pos:], l.delimiter) {
+		l.pos += len(l.delimiter)
+		return formatTerminator
+	}
+
+	c := l.src[l.pos]
+	switch {
+	case c == '-' && l.peek(1) == '-' && (!l.dialect.HashComments || isFormatSpace(l.peek(2))):
+		l.skipLine()
+		l.trimTrailingSpace()
+		return formatLineComment
+	case c == '#' && l.dialect.HashComments:
+		l.skipLine()
+		l.trimTrailingSpace()
+		return formatLineComment
+	case c == '/' && l.peek(1) == '*':
+		l.blockComment()
+		return formatBlockComment
+	case c == '\'':
+		l.quoted('\'', l.dialect.BackslashEscapes)
+		return formatString
+	case c == '"':
+		l.quoted('"', l.dialect.DoubleQuotedStrings && l.dialect.BackslashEscapes)
+		if l.dialect.DoubleQuotedStrings {
+			return formatString
+		}
+		return formatQuoted
+	case c == '`' && l.dialect.BacktickIdentifiers:
+		l.quoted('`', false)
+		return formatQuoted
+	case c == '[' && l.dialect.BracketIdentifiers:
+		l.quoted(']', false)
+		return formatQuoted
+	case c == '@' && l.dialect.StagePaths:
+		for l.pos < len(l.src) && !isFormatSpace(l.src[l.pos]) && strings.IndexByte(",;()", l.src[l.pos]) < 0 {
+			l.pos++
+		}
+		return formatWord
+	case c == '$' && l.dialect.DollarQuotes && l.dollarQuote():
+		return formatString
+	case c == '$' && isFormatWordChar(l.peek(1)):
+		l.pos++
+		l.word()
+		return formatWord
+	case isFormatDigit(c) || (c == '.' && isFormatDigit(l.peek(1)) && !l.afterOperand()):
+		return l.number()
+	case isFormatWordStart(c) || (strings.IndexByte(l.dialect.IdentifierStart, c) >= 0 && l.pos+1 < len(l.src) && (isFormatWordChar(l.peek(1)) || l.peek(1) == c)):
+		return l.wordOrPrefixedString()
+	case c == ':' && l.peek(1) == ':':
+		l.pos += 2
+		return formatOperator
+	case c == ':' && l.peek(1) == '=':
+		l.pos += 2
+		return formatOperator
+	case c == ':' && isFormatWordChar(l.peek(1)):
+		l.pos++
+		l.word()
+		return formatWord
+	case c == ';':
+		l.pos++
+		if l.delimiter == ";" {
+			return formatTerminator
+		}
+		return formatPunct
+	case strings.IndexByte("(),.[]{}", c) >= 0:
+		l.pos++
+		return formatPunct
+	case strings.IndexByte(formatOperatorChars, c) >= 0:
+		l.operator()
+		return formatOperator
+	default:
+		_, size := utf8.DecodeRuneInString(l.src[l.pos:])
+		l.pos += size
+		return formatPunct
+	}
+}
+
+// command consumes a client command occupying a whole line.
+func (l *formatLexer) command() (formatTokenKind, bool) {
+	end := l.lineEnd()
+	line := strings.TrimSpace(l.src[l.pos:end])
+	switch {
+	case l.dialect.BatchSeparator && formatGoRegex.MatchString(line):
+	case l.dialect.SlashTerminator && line == "/":
+	case l.dialect.MetaCommands && strings.HasPrefix(line, `\`):
+	case l.dialect.DelimiterCommand && formatDelimiterRegex.MatchString(line):
+		l.delimiter = formatDelimiterRegex.FindStringSubmatch(line)[1]
+	default:
+		return 0, false
+	}
+	l.pos = end
+	l.trimTrailingSpace()
+	return formatCommand, true
+}
+
+func (l *formatLexer) trimTrailingSpace() {
+	for l.pos > 0 && (l.src[l.pos-1] == ' ' || l.src[l.pos-1] == '\t' || l.src[l.pos-1] == '\r') {
+		l.pos--
+	}
+}
+
+func (l *formatLexer) blockComment() {
+	l.pos += 2
+	depth := 1
+	for l.pos < len(l.src) {
+		switch {
+		case l.src[l.pos] == '*' && l.peek(1) == '/':
+			l.pos += 2
+			depth--
+			if depth == 0 || !l.dialect.NestedComments {
+				return
+			}
+		case l.src[l.pos] == '/' && l.peek(1) == '*' && l.dialect.NestedComments:
+			l.pos += 2
+			depth++
+		default:
+			l.pos++
+		}
+	}
+	l.unterminated = true
+}
+
+// quoted consumes a literal starting at the current position and ending at the
+// closing character, which is escaped by doubling it.
+func (l *formatLexer) quoted(closing byte, backslash bool) {
+	l.pos++
+	for l.pos < len(l.src) {
+		c := l.src[l.pos]
+		switch {
+		case backslash && c == '\\':
+			l.pos += 2
+		case c == closing && l.peek(1) == closing:
+			l.pos += 2
+		case c == closing:
+			l.pos++
+			return
+		default:
+			l.pos++
+		}
+	}
+	l.pos = len(l.src)
+	l.unterminated = true
+}
+
+func (l *formatLexer) dollarQuote() bool {
+	end := l.pos + 1
+	for end < len(l.src) && isFormatWordChar(l.src[end]) && l.src[end] != '$' {
+		end++
+	}
+	if end >= len(l.src) || l.src[end] != '$' || (end > l.pos+1 && isFormatDigit(l.src[l.pos+1])) {
+		return false
+	}
+	tag := l.src[l.pos : end+1]
+	if i := strings.Index(l.src[end+1:], tag); i >= 0 {
+		l.pos = end + 1 + i + len(tag)
+	} else {
+		l.pos = len(l.src)
+		l.unterminated = true
+	}
+	return true
+}
+
+func (l *formatLexer) word() {
+	for l.pos < len(l.src) && (isFormatWordChar(l.src[l.pos]) || strings.IndexByte(l.dialect.IdentifierChars, l.src[l.pos]) >= 0) {
+		l.pos++
+	}
+}
+
+func (l *formatLexer) wordOrPrefixedString() formatTokenKind {
+	start := l.pos
+	for l.pos < len(l.src) && strings.IndexByte(l.dialect.IdentifierStart, l.src[l.pos]) >= 0 {
+		l.pos++
+	}
+	if l.pos == start {
+		l.pos++
+	}
+	l.word()
+	if l.pos >= len(l.src) || l.src[l.pos] != '\'' {
+		return formatWord
+	}
+	prefix := strings.ToUpper(l.src[start:l.pos])
+	switch {
+	case l.dialect.QQuotes && (prefix == "Q" || prefix == "NQ") && l.pos+1 < len(l.src):
+		open := l.src[l.pos+1]
+		closing := open
+		switch open {
+		case '[':
+			closing = ']'
+		case '(':
+			closing = ')'
+		case '{':
+			closing = '}'
+		case '<':
+			closing = '>'
+		default:
+		}
+		if i := strings.Index(l.src[l.pos+2:], string(closing)+"'"); i >= 0 {
+			l.pos += 2 + i + 2
+		} else {
+			l.pos = len(l.src)
+			l.unterminated = true
+		}
+		return formatString
+	case prefix == "E":
+		l.quoted('\'', true)
+		return formatString
+	case prefix == "N" || prefix == "X" || prefix == "B" || strings.HasPrefix(prefix, "_"):
+		l.quoted('\'', l.dialect.BackslashEscapes)
+		return formatString
+	default:
+		return formatWord
+	}
+}
+
+func (l *formatLexer) number() formatTokenKind {
+	if l.src[l.pos] == '0' && (l.peek(1) == 'x' || l.peek(1) == 'X') {
+		l.pos += 2
+		l.word()
+		return formatNumber
+	}
+	for l.pos < len(l.src) && isFormatDigit(l.src[l.pos]) {
+		l.pos++
+	}
+	if l.pos < len(l.src) && l.src[l.pos] == '.' {
+		l.pos++
+		for l.pos < len(l.src) && isFormatDigit(l.src[l.pos]) {
+			l.pos++
+		}
+	}
+	if c := l.peek(0); c == 'e' || c == 'E' {
+		if isFormatDigit(l.peek(1)) {
+			l.pos++
+		} else if (l.peek(1) == '+' || l.peek(1) == '-') && isFormatDigit(l.peek(2)) {
+			l.pos += 2
+		}
+		for l.pos < len(l.src) && isFormatDigit(l.src[l.pos]) {
+			l.pos++
+		}
+	}
+	if l.pos < len(l.src) && isFormatWordChar(l.src[l.pos]) {
+		// Identifiers such as 1abc are allowed by some engines.
+		l.word()
+		return formatWord
+	}
+	return formatNumber
+}
+
+// afterOperand reports whether the previous token can be followed by a member access.
+func (l *formatLexer) afterOperand() bool {
+	if len(l.tokens) == 0 {
+		return false
+	}
+	prev := l.tokens[len(l.tokens)-1]
+	if prev.end != l.pos {
+		return false
+	}
+	return prev.kind == formatWord || prev.kind == formatQuoted || prev.is(")") || prev.is("]")
+}
+
+const formatOperatorChars = "+-*/<>=~!@#%^&|`?:"
+
+func (l *formatLexer) operator() {
+	start := l.pos
+	for l.pos < len(l.src) && strings.IndexByte(formatOperatorChars, l.src[l.pos]) >= 0 {
+		c := l.src[l.pos]
+		if l.pos > start {
+			rest := l.src[l.pos:]
+			// Stop before comments, variables and the client delimiter.
+			if strings.HasPrefix(rest, "--") || strings.HasPrefix(rest, "/*") ||
+				(c == '#' && l.dialect.HashComments) ||
+				(strings.IndexByte(l.dialect.IdentifierStart, c) >= 0 && isFormatWordChar(l.peek(1))) ||
+				(c == ':' && isFormatWordChar(l.peek(1))) ||
+				(l.delimiter != ";" && strings.HasPrefix(rest, l.delimiter)) {
+				break
+			}
+		}
+		l.pos++
+	}
+	// A multi-character operator cannot end in + or - unless it contains
+	// one of ~ ! @ # % ^ & | ` ?, so that a=-1 reads as a = -1.
+	if !strings.ContainsAny(l.src[start:l.pos], "~!@#%^&|`?") {
+		for l.pos-start > 1 && (l.src[l.pos-1] == '+' || l.src[l.pos-1] == '-') {
+			l.pos--
+		}
+	}
+}
+
+func isFormatSpace(c byte) bool {
+	return c == 0 || c == ' ' || c == '\t' || c == '\r' || c == '\n'
+}
+
+func isFormatDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isFormatWordStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c >= utf8.RuneSelf
+}
+
+func isFormatWordChar(c byte) bool {
+	return isFormatWordStart(c) || isFormatDigit(c) || c == '$'
+}
+
+type formatChunk struct {
+	tokens   []formatToken
+	command  bool
+	verbatim bool
+}
+
+// splitFormatChunks groups the tokens into statements and client commands.
+func splitFormatChunks(dialect *FormatDialect, tokens []formatToken) []formatChunk {
+	var chunks []formatChunk
+	for i := 0; i < len(tokens); {
+		if tokens[i].kind == formatCommand {
+			chunks = append(chunks, formatChunk{tokens: tokens[i : i+1], command: true})
+			i++
+			continue
+		}
+		start := i
+		procedural := false
+		if first := nextFormatSignificant(tokens, i-1); first >= 0 && tokens[first].kind != formatCommand {
+			procedural = isFormatProcedural(dialect, tokens, first)
+		}
+		var end int
+		if procedural {
+			end = proceduralFormatEnd(dialect, tokens, start)
+		} else {
+			end = start
+			for end < len(tokens) && tokens[end].kind != formatCommand {
+				end++
+				if tokens[end-1].kind == formatTerminator {
+					break
+				}
+			}
+		}
+		// Keep comments on the same line as the terminator with the statement.
+		for end < len(tokens) && end > start && tokens[end].isComment() && tokens[end].newlines == 0 {
+			end++
+		}
+		chunks = append(chunks, formatChunk{tokens: tokens[start:end], verbatim: procedural})
+		i = end
+	}
+	return chunks
+}
+
+// proceduralFormatEnd returns the end index of the procedural statement starting at start.
+func proceduralFormatEnd(dialect *FormatDialect, tokens []formatToken, start int) int {
+	if dialect.BatchSeparator || dialect.SlashTerminator {
+		// Procedural statements extend to the next GO or / line.
+		end := start
+		for end < len(tokens) && tokens[end].kind != formatCommand {
+			end++
+		}
+		return end
+	}
+	first := nextFormatSignificant(tokens, start-1)
+	needBegin := formatWordIs(tokens[first], "DECLARE")
+	depth := 0
+	for i := start; i < len(tokens); i++ {
+		t := tokens[i]
+		switch {
+		case t.kind == formatCommand:
+			return i
+		case t.kind == formatTerminator:
+			if t.text != ";" || (depth <= 0 && !needBegin) {
+				return i + 1
+			}
+		case formatWordIs(t, "BEGIN"):
+			depth++
+			needBegin = false
+		case formatWordIs(t, "CASE"):
+			depth++
+		case formatWordIs(t, "END"):
+			next := nextFormatSignificant(tokens, i)
+			if next >= 0 && formatWordIs(tokens[next], "IF", "LOOP", "WHILE", "REPEAT", "FOR") {
+				i = next
+				continue
+			}
+			if next >= 0 && formatWordIs(tokens[next], "CASE") {
+				i = next
+			}
+			depth--
+		default:
+		}
+	}
+	return len(tokens)
+}
+
+// isFormatProcedural reports whether the statement starting at i is a routine,
+// trigger, package or procedural block that must be kept verbatim.
+func isFormatProcedural(dialect *FormatDialect, tokens []formatToken, i int) bool {
+	t := tokens[i]
+	switch {
+	case formatWordIs(t, "CREATE", "ALTER"):
+		definer := false
+		for j, steps := nextFormatSignificant(tokens, i), 0; j >= 0 && steps < 16; j, steps = nextFormatSignificant(tokens, j), steps+1 {
+			next := tokens[j]
+			switch {
+			case formatWordIs(next, "FUNCTION", "PROCEDURE", "PROC", "TRIGGER", "PACKAGE", "EVENT"):
+				return true
+			case formatWordIs(next, "TYPE"):
+				k := nextFormatSignificant(tokens, j)
+				return k >= 0 && formatWordIs(tokens[k], "BODY")
+			case formatWordIs(next, "OR", "REPLACE", "ALTER", "EDITIONABLE", "NONEDITIONABLE", "EDITIONING", "TEMP", "TEMPORARY", "SECURE", "AGGREGATE", "CONSTRAINT"):
+			case formatWordIs(next, "DEFINER"):
+				definer = true
+			case definer && !formatWordIs(next, "VIEW", "SQL", "ALGORITHM", "TABLE", "INDEX") && next.kind != formatTerminator:
+			default:
+				return false
+			}
+		}
+		return false
+	case formatWordIs(t, "BEGIN") && dialect.BlockStatements:
+		next := nextFormatSignificant(tokens, i)
+		return next >= 0 && tokens[next].kind != formatTerminator && tokens[next].kind != formatCommand &&
+			!formatWordIs(tokens[next], "TRAN", "TRANSACTION", "WORK", "NAME", "DISTRIBUTED", "ISOLATION")
+	case formatWordIs(t, "DECLARE") && dialect.DeclareBlocks:
+		return true
+	case formatWordIs(t, "IF", "WHILE") && dialect.ControlFlowStatements:
+		return true
+	default:
+		return false
+	}
+}
+
+// nextFormatSignificant returns the index of the first non-comment token after i, or -1.
+func nextFormatSignificant(tokens []formatToken, i int) int {
+	for i++; i < len(tokens); i++ {
+		if !tokens[i].isComment() {
+			return i
+		}
+	}
+	return -1
+}
+
+func prevFormatSignificant(tokens []formatToken, i int) int {
+	for i--; i >= 0; i-- {
+		if !tokens[i].isComment() {
+			return i
+		}
+	}
+	return -1
+}
+
+func formatWordIs(t formatToken, words ...string) bool {
+	if t.kind != formatWord {
+		return false
+	}
+	for _, w := range words {
+		if strings.EqualFold(t.text, w) {
+			return true
+		}
+	}
+	return false
+}
+
+// formatKeywords are the reserved words that the formatter uppercases.
+var formatKeywords = func() map[string]bool {
+	m := make(map[string]bool)
+	for _, w := range strings.Fields(`
+		ADD ALL ALTER AND ANY AS ASC BEGIN BETWEEN BY CASE CAST CHECK COLLATE COLUMN COMMIT
+		CONFLICT CONSTRAINT CREATE CROSS DATABASE DEFAULT DELETE DESC DISTINCT DO DROP DUPLICATE ELSE END ESCAPE
+		EXCEPT EXISTS EXPLAIN FALSE FETCH FOR FOREIGN FROM FULL FUNCTION GRANT GROUP HAVING
+		IF ILIKE IN INDEX INNER INSERT INTERSECT INTO IS JOIN KEY LATERAL LEFT LIKE LIMIT MATCHED MERGE
+		MINUS NATURAL NOT NOTHING NULL OFFSET ON OR ORDER OUTER OVER PRIMARY PROCEDURE QUALIFY
+		RECURSIVE REFERENCES RENAME RETURNING REVOKE RIGHT ROLLBACK SCHEMA SELECT SEQUENCE
+		SET SOME TABLE THEN TO TRIGGER TRUE TRUNCATE UNION UNIQUE UPDATE USING VALUES VIEW
+		WHEN WHERE WITH
+		PARTITION ROWS RANGE PRECEDING FOLLOWING UNBOUNDED CURRENT ROW`) {
+		m[w] = true
+	}
+	return m
+}()
+
+type formatContextKind int
+
+const (
+	formatStatementContext formatContextKind = iota
+	formatSubqueryContext
+	formatColumnsContext
+)
+
+type formatContext struct {
+	kind   formatContextKind
+	indent int
+	// closeIndent is the indentation of the closing parenthesis.
+	closeIndent int
+	// query lays the tokens out clause by clause instead of keeping the line structure.
+	query bool
+	// dml is the leading data manipulation keyword of the query.
+	dml string
+	// depth is the nesting of inline parentheses.
+	depth     int
+	caseDepth int
+	clause    string
+	// listBreak puts each item of the current clause on its own line.
+	listBreak   bool
+	listPending bool
+	setPending  bool
+	between     bool
+	emitted     int
+	itemStart   bool
+}
+
+type formatPrinter struct {
+	unit          string
+	lines         []string
+	line          strings.Builder
+	lineIndent    int
+	hasText       bool
+	pending       bool
+	pendingIndent int
+	// blank keeps an empty line before the pending line break.
+	blank bool
+}
+
+func (p *formatPrinter) breakLine(indent int) {
+	p.pending = true
+	p.pendingIndent = indent
+}
+
+func (p *formatPrinter) start(indent int) {
+	p.line.Reset()
+	p.line.WriteString(strings.Repeat(p.unit, indent))
+	p.lineIndent = indent
+	p.hasText = false
+}
+
+func (p *formatPrinter) flush() {
+	if p.hasText {
+		p.lines = append(p.lines, strings.TrimRight(p.line.String(), " \t"))
+	}
+}
+
+func (p *formatPrinter) write(s string, space bool) {
+	if p.pending {
+		p.pending = false
+		if p.hasText {
+			p.flush()
+			if p.blank {
+				p.lines = append(p.lines, "")
+			}
+		}
+		p.blank = false
+		p.start(p.pendingIndent)
+	} else if p.hasText && space {
+		p.line.WriteByte(' ')
+	}
+	p.line.WriteString(s)
+	p.hasText = true
+}
+
+// writeTrailing appends s to the current line even if a line break is pending.
+func (p *formatPrinter) writeTrailing(s string) {
+	if !p.hasText {
+		p.write(s, false)
+		return
+	}
+	p.line.WriteByte(' ')
+	p.line.WriteString(s)
+}
+
+func (p *formatPrinter) String() string {
+	p.flush()
+	return strings.Join(p.lines, "\n")
+}
+
+type formatter struct {
+	tokens   []formatToken
+	p        *formatPrinter
+	stack    []*formatContext
+	columns  int
+	keywords map[string]bool
+}
+
+func formatTokens(dialect *FormatDialect, tokens []formatToken, unit string) string {
+	keywords := formatKeywords
+	if len(dialect.Keywords) > 0 {
+		keywords = maps.Clone(formatKeywords)
+		for _, w := range dialect.Keywords {
+			keywords[strings.ToUpper(w)] = true
+		}
+	}
+	f := &formatter{tokens: tokens, p: &formatPrinter{unit: unit}, columns: -1, keywords: keywords}
+	ctx := &formatContext{kind: formatStatementContext}
+	if first := f.nextSignificant(-1); first >= 0 {
+		lead := first
+		for lead >= 0 && tokens[lead].is("(") {
+			lead = f.nextSignificant(lead)
+		}
+		if lead >= 0 && f.isQueryStart(lead) {
+			ctx.query = true
+			ctx.dml = strings.ToUpper(tokens[lead].text)
+		}
+		f.columns = f.columnListParen(first)
+	}
+	f.stack = append(f.stack, ctx)
+	f.p.start(0)
+	for i := range tokens {
+		f.format(i)
+	}
+	return f.p.String()
+}
+
+func (f *formatter) top() *formatContext {
+	return f.stack[len(f.stack)-1]
+}
+
+func (f *formatter) nextSignificant(i int) int {
+	return nextFormatSignificant(f.tokens, i)
+}
+
+func (f *formatter) prevSignificant(i int) int {
+	return prevFormatSignificant(f.tokens, i)
+}
+
+// keyword returns the upper-cased word at i, or "" if the token is not a bare
+// word or is qualified by a dot.
+func (f *formatter) keyword(i int) string {
+	if i < 0 || i >= len(f.tokens) || f.tokens[i].kind != formatWord {
+		return ""
+	}
+	if (i > 0 && f.tokens[i-1].is(".")) || (i+1 < len(f.tokens) && f.tokens[i+1].is(".")) {
+		return ""
+	}
+	return strings.ToUpper(f.tokens[i].text)
+}
+
+func (f *formatter) nextKeyword(i int) string {
+	return f.keyword(f.nextSignificant(i))
+}
+
+func (f *formatter) prevKeyword(i int) string {
+	return f.keyword(f.prevSignificant(i))
+}
+
+func (f *formatter) isQueryStart(i int) bool {
+	switch f.keyword(i) {
+	case "SELECT", "INSERT", "UPDATE", "DELETE", "MERGE", "REPLACE", "VALUES":
+		return true
+	case "WITH":
+		return f.isCTE(i)
+	default:
+		return false
+	}
+}
+
+// isCTE reports whether the WITH at i starts a common table expression.
+func (f *formatter) isCTE(i int) bool {
+	next := f.nextSignificant(i)
+	if next < 0 {
+		return false
+	}
+	if f.keyword(next) == "RECURSIVE" {
+		return true
+	}
+	if f.tokens[next].kind != formatWord && f.tokens[next].kind != formatQuoted {
+		return false
+	}
+	after := f.nextSignificant(next)
+	return after >= 0 && (f.keyword(after) == "AS" || f.tokens[after].is("("))
+}
+
+// columnListParen returns the index of the column list parenthesis of a CREATE TABLE statement, or -1.
+func (f *formatter) columnListParen(first int) int {
+	if f.keyword(first) != "CREATE" {
+		return -1
+	}
+	i := f.nextSignificant(first)
+	for steps := 0; i >= 0 && f.keyword(i) != "TABLE"; steps++ {
+		if steps > 4 || f.tokens[i].kind != formatWord {
+			return -1
+		}
+		i = f.nextSignificant(i)
+	}
+	if i < 0 {
+		return -1
+	}
+	i = f.nextSignificant(i)
+	for f.keyword(i) == "IF" || f.keyword(i) == "NOT" || f.keyword(i) == "EXISTS" {
+		i = f.nextSignificant(i)
+	}
+	for i >= 0 && (f.tokens[i].kind == formatWord || f.tokens[i].kind == formatQuoted) {
+		i = f.nextSignificant(i)
+		if i < 0 || !f.tokens[i].is(".") {
+			break
+		}
+		i = f.nextSignificant(i)
+	}
+	if i >= 0 && f.tokens[i].is("(") {
+		return i
+	}
+	return -1
+}
+
+// contIndent is the indentation of a line that continues the current item.
+func (f *formatter) contIndent() int {
+	ctx := f.top()
+	if ctx.emitted == 0 || (ctx.kind == formatColumnsContext && ctx.itemStart) {
+		return ctx.indent
+	}
+	return ctx.indent + 1
+}
+
+func (f *formatter) format(i int) {
+	t := f.tokens[i]
+	p := f.p
+	ctx := f.top()
+
+	if t.isComment() {
+		switch {
+		case t.newlines > 0 && p.hasText:
+			if !p.pending {
+				p.breakLine(f.contIndent())
+			}
+			p.blank = t.newlines > 1
+			p.write(t.text, true)
+		case !p.hasText:
+			p.write(t.text, false)
+		default:
+			p.writeTrailing(t.text)
+		}
+		if t.kind == formatLineComment && !p.pending {
+			p.breakLine(f.contIndent())
+		}
+		return
+	}
+
+	if t.is(")") && ctx.depth == 0 && ctx.kind != formatStatementContext {
+		f.stack = f.stack[:len(f.stack)-1]
+		p.breakLine(ctx.closeIndent)
+		p.write(")", false)
+		f.top().emitted++
+		return
+	}
+
+	if ctx.depth == 0 {
+		if ctx.query {
+			f.layoutClause(i)
+		} else if kw := f.keyword(i); (kw == "SELECT" && formatModeSwitch[f.prevKeyword(i)]) || (kw == "WITH" && f.isCTE(i)) {
+			ctx.query = true
+			ctx.dml = kw
+			if f.prevKeyword(i) == "AS" {
+				p.breakLine(ctx.indent)
+			}
+			f.startClause(i, kw)
+		}
+		if t.is(",") {
+			switch {
+			case ctx.kind == formatColumnsContext:
+				p.write(",", false)
+				p.breakLine(ctx.indent)
+				ctx.itemStart = true
+				return
+			case ctx.query && ctx.listBreak:
+				p.write(",", false)
+				p.breakLine(ctx.indent + 1)
+				return
+			default:
+			}
+		}
+	}
+
+	prevComment := i > 0 && f.tokens[i-1].isComment()
+	if t.newlines > 0 && p.hasText && (prevComment || (!ctx.query && ctx.kind != formatColumnsContext)) {
+		if !p.pending {
+			p.breakLine(f.contIndent())
+		}
+		p.blank = t.newlines > 1
+	}
+
+	text := t.text
+	if kw := f.keyword(i); f.keywords[kw] {
+		text = kw
+	}
+	p.write(text, f.spaceBefore(i))
+	ctx.emitted++
+	ctx.itemStart = false
+
+	switch {
+	case t.is("("):
+		next := f.nextSignificant(i)
+		switch {
+		case next >= 0 && formatSubqueryStart[f.keyword(next)] && (f.keyword(next) != "WITH" || f.isCTE(next)):
+			sub := &formatContext{kind: formatSubqueryContext, indent: p.lineIndent + 1, closeIndent: p.lineIndent, query: true, dml: f.keyword(next)}
+			f.stack = append(f.stack, sub)
+			p.breakLine(sub.indent)
+		case i == f.columns:
+			cols := &formatContext{kind: formatColumnsContext, indent: p.lineIndent + 1, closeIndent: p.lineIndent, itemStart: true}
+			f.stack = append(f.stack, cols)
+			p.breakLine(cols.indent)
+		default:
+			ctx.depth++
+		}
+	case t.is(")"):
+		if ctx.depth > 0 {
+			ctx.depth--
+		}
+	default:
+	}
+}
+
+// formatModeSwitch lists the words after which a SELECT starts the query part of another statement.
+var formatModeSwitch = map[string]bool{
+	"AS": true, "FOR": true, "EXPLAIN": true, "ANALYZE": true, "VERBOSE": true,
+}
+
+var formatSubqueryStart = map[string]bool{
+	"SELECT": true, "WITH": true, "VALUES": true,
+}
+
+var formatJoinWords = map[string]bool{
+	"INNER": true, "LEFT": true, "RIGHT": true, "FULL": true, "CROSS": true, "NATURAL": true,
+	"OUTER": true, "SEMI": true, "ANTI": true, "ASOF": true,
+}
+
+var formatSelectModifiers = map[string]bool{
+	"ALL": true, "DISTINCT": true, "DISTINCTROW": true, "UNIQUE": true, "TOP": true, "PERCENT": true,
+	"HIGH_PRIORITY": true, "STRAIGHT_JOIN": true, "SQL_SMALL_RESULT": true, "SQL_BIG_RESULT": true,
+	"SQL_BUFFER_RESULT": true, "SQL_NO_CACHE": true, "SQL_CACHE": true, "SQL_CALC_FOUND_ROWS": true,
+	"TIES": true,
+}
+
+// layoutClause requests the line breaks before the token at i in a query.
+func (f *formatter) layoutClause(i int) {
+	t := f.tokens[i]
+	ctx := f.top()
+	p := f.p
+	kw := f.keyword(i)
+	prev := f.prevKeyword(i)
+	prevIdx := f.prevSignificant(i)
+	prevClose := prevIdx >= 0 && f.tokens[prevIdx].is(")")
+
+	if ctx.setPending {
+		if kw == "ALL" || kw == "DISTINCT" {
+			return
+		}
+		ctx.setPending = false
+		p.breakLine(ctx.indent)
+	}
+	if ctx.listPending {
+		modifier := ctx.clause == "SELECT" && (formatSelectModifiers[kw] ||
+			(kw == "ON" && prev == "DISTINCT") ||
+			(t.is("(") && (prev == "ON" || prev == "TOP")) ||
+			(t.kind == formatNumber && prev == "TOP") ||
+			(kw == "WITH" && f.nextKeyword(i) == "TIES"))
+		if !modifier {
+			ctx.listPending = false
+			p.breakLine(ctx.indent + 1)
+		}
+	}
+
+	switch kw {
+	case "CASE":
+		ctx.caseDepth++
+		return
+	case "END":
+		if ctx.caseDepth > 0 {
+			ctx.caseDepth--
+		}
+		return
+	case "BETWEEN":
+		ctx.between = true
+		return
+	case "AND", "OR":
+		if kw == "AND" && ctx.between {
+			ctx.between = false
+			return
+		}
+		switch ctx.clause {
+		case "WHERE", "HAVING", "QUALIFY", "JOIN":
+			if ctx.caseDepth == 0 {
+				p.breakLine(ctx.indent + 1)
+			}
+		default:
+		}
+		return
+	default:
+	}
+	if ctx.caseDepth > 0 {
+		return
+	}
+
+	clause := ""
+	switch kw {
+	case "SELECT":
+		if ctx.emitted > 0 && prev != "UNION" && prev != "INTERSECT" && prev != "EXCEPT" && prev != "MINUS" && prev != "ALL" && prev != "DISTINCT" {
+			clause = kw
+		} else {
+			f.startClause(i, kw)
+		}
+	case "FROM":
+		if prev != "DELETE" && prev != "DISTINCT" {
+			clause = kw
+		}
+	case "WHERE", "HAVING", "QUALIFY", "LIMIT", "RETURNING":
+		clause = kw
+	case "INTO":
+		if ctx.clause == "SELECT" {
+			clause = kw
+		}
+	case "OFFSET":
+		if ctx.clause != "LIMIT" {
+			clause = kw
+		}
+	case "FETCH", "WINDOW":
+		if ctx.emitted > 0 {
+			clause = kw
+		}
+	case "GROUP", "ORDER":
+		if f.nextKeyword(i) == "BY" {
+			clause = kw
+		}
+	case "VALUES":
+		prevOperand := prevIdx >= 0 && (prevClose || f.tokens[prevIdx].kind == formatWord || f.tokens[prevIdx].kind == formatQuoted)
+		if ctx.emitted > 0 && prevOperand && prev != "DEFAULT" && ctx.clause != "CONFLICT" && ctx.dml != "MERGE" {
+			clause = kw
+		} else {
+			f.startClause(i, kw)
+		}
+	case "SET":
+		if ctx.dml == "UPDATE" && ctx.clause != "SET" {
+			clause = kw
+		}
+	case "UNION", "INTERSECT", "EXCEPT", "MINUS":
+		clause = kw
+	case "WITH":
+		if ctx.emitted > 0 && f.isCTE(i) {
+			clause = kw
+		}
+	case "INSERT", "UPDATE", "DELETE", "MERGE":
+		if prevClose {
+			clause = kw
+			ctx.dml = kw
+		}
+	case "ON":
+		if next := f.nextKeyword(i); next == "CONFLICT" || next == "DUPLICATE" {
+			clause = "CONFLICT"
+		}
+	case "FOR":
+		if next := f.nextKeyword(i); next == "UPDATE" || next == "SHARE" || next == "NO" || next == "KEY" {
+			clause = kw
+		}
+	case "USING", "WHEN":
+		if ctx.dml == "MERGE" {
+			clause = kw
+		}
+	case "JOIN", "STRAIGHT_JOIN", "APPLY":
+		if !formatJoinWords[prev] && (kw != "STRAIGHT_JOIN" || ctx.clause != "SELECT") {
+			clause = "JOIN"
+		}
+	default:
+		if formatJoinWords[kw] && !formatJoinWords[prev] && f.isJoin(i) {
+			clause = "JOIN"
+		}
+	}
+	if clause == "" {
+		return
+	}
+	p.breakLine(ctx.indent)
+	f.startClause(i, clause)
+	switch clause {
+	case "UNION", "INTERSECT", "EXCEPT", "MINUS":
+		ctx.setPending = true
+	default:
+	}
+}
+
+// isJoin reports whether the words starting at i form a join operator.
+func (f *formatter) isJoin(i int) bool {
+	for j := i; j >= 0; j = f.nextSignificant(j) {
+		switch kw := f.keyword(j); {
+		case kw == "JOIN" || kw == "APPLY":
+			return true
+		case !formatJoinWords[kw]:
+			return false
+		default:
+		}
+	}
+	return false
+}
+
+func (f *formatter) startClause(i int, clause string) {
+	ctx := f.top()
+	ctx.clause = clause
+	ctx.between = false
+	ctx.listBreak = false
+	ctx.listPending = false
+	switch clause {
+	case "SELECT", "SET", "VALUES":
+		if f.hasListItems(i, clause) {
+			ctx.listBreak = true
+			ctx.listPending = true
+		}
+	default:
+	}
+}
+
+// hasListItems reports whether the clause starting at i has more than one item.
+func (f *formatter) hasListItems(i int, clause string) bool {
+	depth := 0
+	for j := f.nextSignificant(i); j >= 0; j = f.nextSignificant(j) {
+		t := f.tokens[j]
+		switch {
+		case t.kind == formatTerminator || t.kind == formatCommand:
+			return false
+		case t.is("(") || t.is("["):
+			depth++
+		case t.is(")") || t.is("]"):
+			depth--
+			if depth < 0 {
+				return false
+			}
+		case depth > 0:
+		case t.is(","):
+			return true
+		case formatListEnd(clause, f.keyword(j)) && !(f.keyword(j) == "ON" && f.prevKeyword(j) == "DISTINCT"):
+			return false
+		default:
+		}
+	}
+	return false
+}
+
+func formatListEnd(clause, kw string) bool {
+	switch kw {
+	case "UNION", "INTERSECT", "EXCEPT", "MINUS", "ORDER", "LIMIT", "RETURNING":
+		return true
+	default:
+	}
+	switch clause {
+	case "SELECT":
+		switch kw {
+		case "FROM", "INTO", "WHERE", "GROUP", "HAVING", "OFFSET", "FETCH", "QUALIFY", "WINDOW", "FOR", "ON":
+			return true
+		default:
+		}
+	case "SET":
+		switch kw {
+		case "FROM", "WHERE", "OUTPUT", "WHEN":
+			return true
+		default:
+		}
+	case "VALUES":
+		switch kw {
+		case "ON", "AS", "SELECT":
+			return true
+		default:
+		}
+	default:
+	}
+	return false
+}
+
+// spaceBefore reports whether a space separates the token at i from the previous one.
+func (f *formatter) spaceBefore(i int) bool {
+	t := f.tokens[i]
+	if i == 0 {
+		return false
+	}
+	prev := f.tokens[i-1]
+	switch {
+	case t.kind == formatTerminator:
+		return t.text != ";" && t.spaced
+	case t.is(",") || t.is(")") || t.is("]") || t.is("."):
+		return false
+	case prev.is("(") || prev.is("[") || prev.is("."):
+		return false
+	case t.is("::") || prev.is("::"):
+		return false
+	case t.is("(") || t.is("["):
+		return t.spaced
+	case t.kind == formatPunct && !t.is(","):
+		return t.spaced
+	case prev.kind == formatPunct && !prev.is(",") && !prev.is(")") && !prev.is("]"):
+		return t.spaced
+	case t.kind == formatWord && strings.HasPrefix(t.text, ":"):
+		return t.spaced
+	case f.isUnary(i - 1):
+		return false
+	default:
+		return true
+	}
+}
+
+// isUnary reports whether the token at i is a sign attached to the following operand.
+func (f *formatter) isUnary(i int) bool {
+	t := f.tokens[i]
+	if !t.is("-") && !t.is("+") {
+		return false
+	}
+	if i+1 >= len(f.tokens) {
+		return false
+	}
+	switch f.tokens[i+1].kind {
+	case formatWord, formatQuoted, formatNumber, formatString:
+	default:
+		if !f.tokens[i+1].is("(") {
+			return false
+		}
+	}
+	prev := f.prevSignificant(i)
+	if prev < 0 {
+		return true
+	}
+	p := f.tokens[prev]
+	switch {
+	case p.kind == formatOperator, p.is("("), p.is(","), p.is("["):
+		return true
+	case p.kind == formatWord:
+		return f.keywords[f.keyword(prev)]
+	default:
+		return false
+	}
+}
@@ -0,0 +1,180 @@
+package base
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatSQL(t *testing.T) {
+	dialect := &FormatDialect{DollarQuotes: true, NestedComments: true}
+	tests := []struct {
+		name     string
+		input    string
+		opts     FormatOptions
+		expected string
+	}{
+		{
+			name:     "empty",
+			input:    " \n\t",
+			expected: "",
+		},
+		{
+			name:  "select clauses",
+			input: "select a, b from t join u on t.id = u.id and u.x > 1 where a = 1 and b between 1 and 2 order by a limit 10",
+			expected: `SELECT
+  a,
+  b
+FROM t
+JOIN u ON t.id = u.id
+  AND u.x > 1
+WHERE a = 1
+  AND b BETWEEN 1 AND 2
+ORDER BY a
+LIMIT 10
+`,
+		},
+		{
+			name:  "subquery",
+			input: "select * from t where id in (select id from u where u.v = -1);",
+			expected: `SELECT *
+FROM t
+WHERE id IN (
+  SELECT id
+  FROM u
+  WHERE u.v = -1
+);
+`,
+		},
+		{
+			name:  "window function",
+			input: "select rank() over (partition by a order by b rows between unbounded preceding and current row) from t",
+			expected: `SELECT rank() OVER (PARTITION BY a ORDER BY b ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW)
+FROM t
+`,
+		},
+		{
+			name:  "insert values",
+			input: "insert into t (a, b) values (1, 'x'), (2, 'y');",
+			expected: `INSERT INTO t (a, b)
+VALUES
+  (1, 'x'),
+  (2, 'y');
+`,
+		},
+		{
+			name:  "create table",
+			input: "create table t (id int primary key, -- identifier\nname text not null);",
+			expected: `CREATE TABLE t (
+  id int PRIMARY KEY, -- identifier
+  name text NOT NULL
+);
+`,
+		},
+		{
+			name:  "ddl keeps line structure",
+			input: "alter table t\n        add column a int,\n        add column b int;\n\n\ncreate view v as select a from t;",
+			expected: `ALTER TABLE t
+  ADD COLUMN a int,
+  ADD COLUMN b int;
+
+CREATE VIEW v AS
+SELECT a
+FROM t;
+`,
+		},
+		{
+			name:  "routine body is kept",
+			input: "create function f() returns int as $$ select   1 $$ language sql;\nselect f();",
+			expected: `create function f() returns int as $$ select   1 $$ language sql;
+SELECT f();
+`,
+		},
+		{
+			name:     "tab indentation",
+			input:    "select a, b from t",
+			opts:     FormatOptions{UseTab: true},
+			expected: "SELECT\n\ta,\n\tb\nFROM t\n",
+		},
+		{
+			name:     "indent size",
+			input:    "select a, b from t",
+			opts:     FormatOptions{IndentSize: 4},
+			expected: "SELECT\n    a,\n    b\nFROM t\n",
+		},
+		{
+			name:     "unterminated literal",
+			input:    "select 'abc",
+			expected: "select 'abc",
+		},
+	}
+
+	a := require.New(t)
+	for _, tc := range tests {
+		got := FormatSQL(dialect, tc.input, tc.opts)
+		a.Equal(tc.expected, got, tc.name)
+		a.Equal(got, FormatSQL(dialect, got, tc.opts), "%s: not idempotent", tc.name)
+	}
+}
+
+func TestFormatSQLDialect(t *testing.T) {
+	tests := []struct {
+		name     string
+		dialect  *FormatDialect
+		input    string
+		expected string
+	}{
+		{
+			name:    "backslash escapes and hash comments",
+			dialect: &FormatDialect{BackslashEscapes: true, HashComments: true, BacktickIdentifiers: true, IdentifierStart: "@"},
+			input:   "select `a`, @@version from t where b = 'x\\'y' # note\nlimit 1",
+			expected: `SELECT
+  ` + "`a`" + `,
+  @@version
+FROM t
+WHERE b = 'x\'y' # note
+LIMIT 1
+`,
+		},
+		{
+			name:    "delimiter command",
+			dialect: &FormatDialect{DelimiterCommand: true},
+			input:   "DELIMITER //\ncreate procedure p() begin select 1; end//\nDELIMITER ;\nselect 1;",
+			expected: `DELIMITER //
+create procedure p() begin select 1; end//
+DELIMITER ;
+SELECT 1;
+`,
+		},
+		{
+			name:    "batch separator",
+			dialect: &FormatDialect{BracketIdentifiers: true, BatchSeparator: true, ControlFlowStatements: true},
+			input:   "select [a] from [t]\ngo\nif 1 = 1 select 1; else select 2;\nGO",
+			expected: `SELECT [a]
+FROM [t]
+go
+if 1 = 1 select 1; else select 2;
+GO
+`,
+		},
+		{
+			name:    "slash terminator",
+			dialect: &FormatDialect{QQuotes: true, SlashTerminator: true, BlockStatements: true},
+			input:   "begin\n  null;\nend;\n/\nselect q'[it's]' from dual;",
+			expected: `begin
+  null;
+end;
+/
+SELECT q'[it's]'
+FROM dual;
+`,
+		},
+	}
+
+	a := require.New(t)
+	for _, tc := range tests {
+		got := FormatSQL(tc.dialect, tc.input, FormatOptions{})
+		a.Equal(tc.expected, got, tc.name)
+		a.Equal(got, FormatSQL(tc.dialect, got, FormatOptions{}), "%s: not idempotent", tc.name)
+	}
+}
@@ -0,0 +1,57 @@
+package base
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/bytebase/bytebase/backend/common/yamltest"
+)
+
+// FormatTestCase represents a single formatter test case in YAML.
+type FormatTestCase struct {
+	Description string `yaml:"description"`
+	Input       string `yaml:"input"`
+	Output      string `yaml:"output"`
+}
+
+// RunFormatTests loads YAML test cases and runs them against a Format function.
+// It also checks that formatting the output again does not change it.
+//
+// When run with -record flag, it updates the YAML file with actual results:
+//
+//	go test -run TestXxxFormat -args -record
+func RunFormatTests(t *testing.T, testDataPath string, format FormatFunc) {
+	t.Helper()
+
+	_, callerFile, _, ok := runtime.Caller(1)
+	require.True(t, ok, "failed to get caller info")
+	fullPath := filepath.Join(filepath.Dir(callerFile), testDataPath)
+
+	data, err := os.ReadFile(fullPath)
+	require.NoError(t, err, "failed to read test file: %s", fullPath)
+	var testCases []FormatTestCase
+	require.NoError(t, yaml.Unmarshal(data, &testCases), "failed to parse YAML test file: %s", fullPath)
+	require.NotEmpty(t, testCases, "no test cases found in %s", testDataPath)
+
+	for i, tc := range testCases {
+		got, err := format(tc.Input, FormatOptions{})
+		require.NoError(t, err, tc.Description)
+		if *record {
+			testCases[i].Output = got
+			continue
+		}
+		require.Equal(t, tc.Output, got, tc.Description)
+		again, err := format(got, FormatOptions{})
+		require.NoError(t, err, tc.Description)
+		require.Equal(t, got, again, "%s: formatting is not idempotent", tc.Description)
+	}
+
+	if *record {
+		yamltest.Record(t, fullPath, testCases)
+	}
+}
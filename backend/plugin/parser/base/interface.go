@@ -52,6 +52,8 @@ var (
 	generateRestoreSQL      = make(map[storepb.Engine]GenerateRestoreSQLFunc)
 	statementParsers        = make(map[storepb.Engine]ParseStatementsFunc)
 	statementTypeGetters    = make(map[storepb.Engine]GetStatementTypesFunc)
+	formatters              = make(map[storepb.Engine]FormatFunc)
+	parameterBinders        = make(map[storepb.Engine]BindParametersFunc)
 )
 
 type ValidateSQLForEditorFunc func(string) (bool, bool, error)
@@ -78,6 +80,13 @@ type ParseStatementsFunc func(statement string) ([]ParsedStatement, error)
 // Statement types include: INSERT, UPDATE, DELETE (DML), CREATE_TABLE, ALTER_TABLE, DROP_TABLE, etc. (DDL).
 type GetStatementTypesFunc func([]AST) ([]storepb.StatementType, error)
 
+// FormatFunc formats the SQL script with the given options.
+type FormatFunc func(statement string, opts FormatOptions) (string, error)
+
+// BindParametersFunc replaces the named parameter references in the statement with the driver placeholders.
+// It returns the bound statement and the parameter name of each placeholder in order.
+type BindParametersFunc func(statement string) (string, []string, error)
+
 func RegisterQueryValidator(engine storepb.Engine, f ValidateSQLForEditorFunc) {
 	mux.Lock()
 	defer mux.Unlock()
@@ -315,6 +324,44 @@ func GetStatementTypes(engine storepb.Engine, asts []AST) ([]storepb.StatementTy
 	return f(asts)
 }
 
+// RegisterFormatFunc registers the SQL formatter for the engine.
+func RegisterFormatFunc(engine storepb.Engine, f FormatFunc) {
+	mux.Lock()
+	defer mux.Unlock()
+	if _, dup := formatters[engine]; dup {
+		panic(fmt.Sprintf("Register called twice %s", engine))
+	}
+	formatters[engine] = f
+}
+
+// Format formats the SQL script for the engine.
+func Format(engine storepb.Engine, statement string, opts FormatOptions) (string, error) {
+	f, ok := formatters[engine]
+	if !ok {
+		return "", errors.Errorf("engine %s is not supported", engine)
+	}
+	return f(statement, opts)
+}
+
+// RegisterBindParametersFunc registers the parameter binder for the engine.
+func RegisterBindParametersFunc(engine storepb.Engine, f BindParametersFunc) {
+	mux.Lock()
+	defer mux.Unlock()
+	if _, dup := parameterBinders[engine]; dup {
+		panic(fmt.Sprintf("Register called twice %s", engine))
+	}
+	parameterBinders[engine] = f
+}
+
+// BindParameters replaces the named parameter references in the statement with the driver placeholders for the engine.
+func BindParameters(engine storepb.Engine, statement string) (string, []string, error) {
+	f, ok := parameterBinders[engine]
+	if !ok {
+		return "", nil, errors.Errorf("engine %s is not supported", engine)
+	}
+	return f(statement)
+}
+
 // IsAllDML checks if all statements are DML (INSERT, UPDATE, DELETE).
 // Returns false for unsupported engines or parse errors (conservative approach).
 // Results are cached to avoid repeated parsing of the same statement.
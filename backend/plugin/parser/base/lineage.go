@@ -0,0 +1,102 @@
+package base
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+// ColumnLineage is the lineage of a column written by a statement.
+type ColumnLineage struct {
+	// Target is the column written by the statement.
+	Target ColumnResource
+	// Sources are the columns that the written value derives from.
+	Sources SourceColumnSet
+}
+
+// ExtractColumnLineageFunc extracts the column lineage of a single statement that writes query results into a relation,
+// e.g. INSERT ... SELECT, CREATE TABLE ... AS SELECT and CREATE VIEW.
+// It returns nil for the other statements.
+type ExtractColumnLineageFunc func(ctx context.Context, gCtx GetQuerySpanContext, statement string, database, schema string) ([]*ColumnLineage, error)
+
+var columnLineageExtractors = make(map[storepb.Engine]ExtractColumnLineageFunc)
+
+// RegisterExtractColumnLineageFunc registers the column lineage extractor for the engine.
+func RegisterExtractColumnLineageFunc(engine storepb.Engine, f ExtractColumnLineageFunc) {
+	mux.Lock()
+	defer mux.Unlock()
+	if _, dup := columnLineageExtractors[engine]; dup {
+		panic(fmt.Sprintf("Register called twice %s", engine))
+	}
+	columnLineageExtractors[engine] = f
+}
+
+// SupportColumnLineage returns true if the engine supports extracting column lineage from statements.
+func SupportColumnLineage(engine storepb.Engine) bool {
+	_, ok := columnLineageExtractors[engine]
+	return ok
+}
+
+// ExtractColumnLineage extracts the column lineage of the pre-split statements.
+func ExtractColumnLineage(ctx context.Context, gCtx GetQuerySpanContext, engine storepb.Engine, statements []Statement, database, schema string) ([]*ColumnLineage, error) {
+	f, ok := columnLineageExtractors[engine]
+	if !ok {
+		return nil, errors.Errorf("engine %s is not supported", engine)
+	}
+	gCtx.Engine = engine
+	gCtx.TempTables = make(map[string]*PhysicalTable)
+	var results []*ColumnLineage
+	for _, stmt := range statements {
+		if stmt.Empty {
+			continue
+		}
+		lineages, err := f(ctx, gCtx, stmt.Text, database, schema)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, lineages...)
+	}
+	return results, nil
+}
+
+// GetColumnLineageFromQuerySpan maps the result columns of the query span to the columns of the target relation by position.
+// The result names are used if the columns are not specified.
+// The sources from linked servers and the results without sources are skipped.
+func GetColumnLineageFromQuerySpan(target ColumnResource, columns []string, span *QuerySpan) ([]*ColumnLineage, error) {
+	if span == nil {
+		return nil, nil
+	}
+	if len(columns) > 0 && len(columns) != len(span.Results) {
+		return nil, errors.Errorf("expect %d result columns for %q, got %d", len(columns), target.Table, len(span.Results))
+	}
+	var lineages []*ColumnLineage
+	for i, result := range span.Results {
+		sources := make(SourceColumnSet)
+		for source := range result.SourceColumns {
+			if source.Server != "" || source.Column == "" {
+				continue
+			}
+			sources[source] = true
+		}
+		if len(sources) == 0 {
+			continue
+		}
+		column := result.Name
+		if len(columns) > 0 {
+			column = columns[i]
+		}
+		if column == "" {
+			continue
+		}
+		t := target
+		t.Column = column
+		lineages = append(lineages, &ColumnLineage{
+			Target:  t,
+			Sources: sources,
+		})
+	}
+	return lineages, nil
+}
@@ -0,0 +1,47 @@
+package base
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// parameterNameRegex matches the names of named parameters.
+var parameterNameRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// IsValidParameterName reports whether the name can be referenced as a named parameter.
+func IsValidParameterName(name string) bool {
+	return parameterNameRegex.MatchString(name)
+}
+
+// BindNamedParameters replaces the named parameter references `:name` in the statement with the placeholders
+// returned by placeholder, where index is the 1-based position of the placeholder.
+// References in strings, quoted identifiers and comments are left untouched.
+// It returns the bound statement and the parameter name of each placeholder in order.
+func BindNamedParameters(dialect *FormatDialect, statement string, placeholder func(index int) string) (string, []string, error) {
+	lexer := newFormatLexer(dialect, statement)
+	tokens := lexer.lex()
+	if lexer.unterminated {
+		return "", nil, errors.New("statement has an unterminated string, identifier or comment")
+	}
+
+	var buf strings.Builder
+	var names []string
+	last := 0
+	for _, token := range tokens {
+		if token.kind != formatWord || !strings.HasPrefix(token.text, ":") {
+			continue
+		}
+		name := token.text[1:]
+		if !IsValidParameterName(name) {
+			continue
+		}
+		names = append(names, name)
+		buf.WriteString(statement[last:token.start])
+		buf.WriteString(placeholder(len(names)))
+		last = token.end
+	}
+	buf.WriteString(statement[last:])
+	return buf.String(), names, nil
+}
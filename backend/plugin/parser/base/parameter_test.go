@@ -0,0 +1,54 @@
+package base
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBindNamedParameters(t *testing.T) {
+	dialect := &FormatDialect{DollarQuotes: true, NestedComments: true}
+	tests := []struct {
+		name      string
+		statement string
+		bound     string
+		names     []string
+	}{
+		{
+			name:      "no parameter",
+			statement: "SELECT 1",
+			bound:     "SELECT 1",
+		},
+		{
+			name:      "parameters",
+			statement: "SELECT * FROM t WHERE id = :id AND created_at > :since OR id = :id",
+			bound:     "SELECT * FROM t WHERE id = $1 AND created_at > $2 OR id = $3",
+			names:     []string{"id", "since", "id"},
+		},
+		{
+			name:      "casts and literals",
+			statement: "SELECT ':id', \":id\", $$:id$$, a::int -- :id\nFROM t /* :id */ WHERE b = :b::text",
+			bound:     "SELECT ':id', \":id\", $$:id$$, a::int -- :id\nFROM t /* :id */ WHERE b = $1::text",
+			names:     []string{"b"},
+		},
+		{
+			name:      "invalid names",
+			statement: "SELECT a[1:2] FROM t",
+			bound:     "SELECT a[1:2] FROM t",
+		},
+	}
+
+	a := require.New(t)
+	for _, tc := range tests {
+		bound, names, err := BindNamedParameters(dialect, tc.statement, func(index int) string {
+			return fmt.Sprintf("$%d", index)
+		})
+		a.NoError(err, tc.name)
+		a.Equal(tc.bound, bound, tc.name)
+		a.Equal(tc.names, names, tc.name)
+	}
+
+	_, _, err := BindNamedParameters(dialect, "SELECT ':id", func(int) string { return "?" })
+	a.Error(err)
+}
@@ -0,0 +1,99 @@
+package base
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+// RowFilter is the predicate that restricts the rows of a table that a query can read.
+type RowFilter struct {
+	// ID identifies the filter to the caller.
+	ID string
+	// Database is the database of the table. It is used by engines without schemas, e.g. MySQL.
+	Database string
+	// Schema is the schema of the table. It is empty for engines without schemas.
+	Schema string
+	Table  string
+	// Predicate is a boolean SQL expression over the unqualified columns of the table.
+	Predicate string
+}
+
+// RowFilterRewrite is the result of rewriting a statement with row filters.
+type RowFilterRewrite struct {
+	// Statement is the rewritten statement.
+	Statement string
+	// References are the relations referenced directly by the statement, excluding common table expressions.
+	References []ColumnResource
+	// Applied are the row filters applied to the statement.
+	Applied []RowFilter
+}
+
+// RewriteRowFiltersFunc rewrites the table references of a single query statement into subqueries filtered by the row filters.
+// Unqualified names are resolved with the default database and schema.
+// It returns an error if a filtered table is referenced in a way that cannot be rewritten.
+type RewriteRowFiltersFunc func(statement string, defaultDatabase string, defaultSchema string, filters []RowFilter) (*RowFilterRewrite, error)
+
+var rowFilterRewriters = make(map[storepb.Engine]RewriteRowFiltersFunc)
+
+// RegisterRewriteRowFiltersFunc registers the row filter rewriter for the engine.
+func RegisterRewriteRowFiltersFunc(engine storepb.Engine, f RewriteRowFiltersFunc) {
+	mux.Lock()
+	defer mux.Unlock()
+	if _, dup := rowFilterRewriters[engine]; dup {
+		panic(fmt.Sprintf("Register called twice %s", engine))
+	}
+	rowFilterRewriters[engine] = f
+}
+
+// SupportRowFilters returns true if the engine supports row filters.
+func SupportRowFilters(engine storepb.Engine) bool {
+	_, ok := rowFilterRewriters[engine]
+	return ok
+}
+
+// RewriteRowFilters rewrites the statement with the row filters for the engine.
+func RewriteRowFilters(engine storepb.Engine, statement string, defaultDatabase string, defaultSchema string, filters []RowFilter) (*RowFilterRewrite, error) {
+	f, ok := rowFilterRewriters[engine]
+	if !ok {
+		return nil, errors.Errorf("engine %s is not supported", engine)
+	}
+	return f(statement, defaultDatabase, defaultSchema, filters)
+}
+
+// RowFilterEdit replaces the text between Start and End of a statement.
+type RowFilterEdit struct {
+	Start       int
+	End         int
+	Replacement string
+}
+
+// TrimRowFilterEditEnd moves the end of the range before the trailing whitespace of the text.
+func TrimRowFilterEditEnd(statement string, start, end int) int {
+	return start + len(strings.TrimRightFunc(statement[start:end], unicode.IsSpace))
+}
+
+// ApplyRowFilterEdits applies the non-overlapping edits to the statement.
+func ApplyRowFilterEdits(statement string, edits []RowFilterEdit) (string, error) {
+	edits = slices.Clone(edits)
+	slices.SortFunc(edits, func(a, b RowFilterEdit) int {
+		return a.Start - b.Start
+	})
+	var buf strings.Builder
+	last := 0
+	for _, edit := range edits {
+		if edit.Start < last || edit.End < edit.Start || edit.End > len(statement) {
+			return "", errors.Errorf("invalid edit range [%d, %d)", edit.Start, edit.End)
+		}
+		buf.WriteString(statement[last:edit.Start])
+		buf.WriteString(edit.Replacement)
+		last = edit.End
+	}
+	buf.WriteString(statement[last:])
+	return buf.String(), nil
+}
@@ -0,0 +1,26 @@
+package mysql
+
+import (
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func init() {
+	base.RegisterFormatFunc(storepb.Engine_MYSQL, Format)
+	base.RegisterFormatFunc(storepb.Engine_MARIADB, Format)
+	base.RegisterFormatFunc(storepb.Engine_OCEANBASE, Format)
+}
+
+var formatDialect = &base.FormatDialect{
+	BackslashEscapes:    true,
+	DoubleQuotedStrings: true,
+	BacktickIdentifiers: true,
+	HashComments:        true,
+	IdentifierStart:     "@",
+	DelimiterCommand:    true,
+}
+
+// Format formats the MySQL script.
+func Format(statement string, opts base.FormatOptions) (string, error) {
+	return base.FormatSQL(formatDialect, statement, opts), nil
+}
@@ -0,0 +1,11 @@
+package mysql
+
+import (
+	"testing"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func TestMySQLFormat(t *testing.T) {
+	base.RunFormatTests(t, "test-data/test_format.yaml", Format)
+}
@@ -0,0 +1,87 @@
+package mysql
+
+import (
+	"context"
+
+	"github.com/bytebase/omni/mysql/ast"
+	"github.com/pkg/errors"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func init() {
+	base.RegisterExtractColumnLineageFunc(storepb.Engine_MYSQL, ExtractColumnLineage)
+}
+
+// ExtractColumnLineage extracts the column lineage of INSERT ... SELECT, CREATE TABLE ... SELECT and CREATE VIEW statements.
+// The schema is ignored.
+func ExtractColumnLineage(ctx context.Context, gCtx base.GetQuerySpanContext, statement string, database, _ string) ([]*base.ColumnLineage, error) {
+	list, err := ParseMySQLOmni(statement)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse statement")
+	}
+	if list == nil || len(list.Items) != 1 {
+		return nil, nil
+	}
+
+	var relation *ast.TableRef
+	var columns []string
+	var query *ast.SelectStmt
+	isInsert := false
+	switch n := list.Items[0].(type) {
+	case *ast.InsertStmt:
+		relation, query, isInsert = n.Table, n.Select, true
+		for _, column := range n.Columns {
+			columns = append(columns, column.Column)
+		}
+	case *ast.CreateViewStmt:
+		relation, query, columns = n.Name, n.Select, n.Columns
+	case *ast.CreateTableStmt:
+		relation, query = n.Table, n.Select
+	default:
+		return nil, nil
+	}
+	if relation == nil || query == nil {
+		return nil, nil
+	}
+	if query.Loc.Start < 0 || query.Loc.End > len(statement) || query.Loc.Start >= query.Loc.End {
+		return nil, errors.Errorf("unknown location of the query in %q", statement)
+	}
+
+	target := base.ColumnResource{
+		Database: relation.Schema,
+		Table:    relation.Name,
+	}
+	if target.Database == "" {
+		target.Database = database
+	}
+	span, err := GetQuerySpan(ctx, gCtx, base.Statement{Text: statement[query.Loc.Start:query.Loc.End]}, database, "", false)
+	if err != nil {
+		return nil, err
+	}
+	if isInsert && len(columns) == 0 {
+		// INSERT without a column list writes the leading columns of the table.
+		if gCtx.GetDatabaseMetadataFunc == nil {
+			return nil, errors.New("GetDatabaseMetadataFunc is not set in GetQuerySpanContext")
+		}
+		_, meta, err := gCtx.GetDatabaseMetadataFunc(ctx, gCtx.InstanceID, target.Database)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get database metadata for instance %q and database %q", gCtx.InstanceID, target.Database)
+		}
+		if meta == nil {
+			return nil, errors.Errorf("database metadata for instance %q and database %q not found", gCtx.InstanceID, target.Database)
+		}
+		table := meta.GetSchemaMetadata("").GetTable(target.Table)
+		if table == nil {
+			return nil, errors.Errorf("table %q.%q not found", target.Database, target.Table)
+		}
+		for _, column := range table.GetProto().GetColumns() {
+			if len(columns) == len(span.Results) {
+				break
+			}
+			columns = append(columns, column.Name)
+		}
+	}
+	return base.GetColumnLineageFromQuerySpan(target, columns, span)
+}
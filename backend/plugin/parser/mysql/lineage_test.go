@@ -0,0 +1,72 @@
+package mysql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func TestExtractColumnLineage(t *testing.T) {
+	getter, lister := buildMockDatabaseMetadataGetter([]*storepb.DatabaseSchemaMetadata{
+		{
+			Name: "app",
+			Schemas: []*storepb.SchemaMetadata{{
+				Tables: []*storepb.TableMetadata{
+					{Name: "users", Columns: []*storepb.ColumnMetadata{{Name: "id"}, {Name: "email"}}},
+				},
+			}},
+		},
+		{
+			Name: "report",
+			Schemas: []*storepb.SchemaMetadata{{
+				Tables: []*storepb.TableMetadata{
+					{Name: "contacts", Columns: []*storepb.ColumnMetadata{{Name: "user_id"}, {Name: "email"}, {Name: "note"}}},
+				},
+			}},
+		},
+	})
+	users := func(column string) base.ColumnResource {
+		return base.ColumnResource{Database: "app", Table: "users", Column: column}
+	}
+
+	tests := []struct {
+		statement string
+		want      []*base.ColumnLineage
+	}{
+		{
+			statement: "INSERT INTO report.contacts SELECT id, email FROM users",
+			want: []*base.ColumnLineage{
+				{Target: base.ColumnResource{Database: "report", Table: "contacts", Column: "user_id"}, Sources: base.SourceColumnSet{users("id"): true}},
+				{Target: base.ColumnResource{Database: "report", Table: "contacts", Column: "email"}, Sources: base.SourceColumnSet{users("email"): true}},
+			},
+		},
+		{
+			statement: "CREATE VIEW user_emails (address) AS SELECT CONCAT(email, id) FROM users",
+			want: []*base.ColumnLineage{
+				{Target: base.ColumnResource{Database: "app", Table: "user_emails", Column: "address"}, Sources: base.SourceColumnSet{users("id"): true, users("email"): true}},
+			},
+		},
+		{
+			statement: "CREATE TABLE users_copy AS SELECT email FROM users",
+			want: []*base.ColumnLineage{
+				{Target: base.ColumnResource{Database: "app", Table: "users_copy", Column: "email"}, Sources: base.SourceColumnSet{users("email"): true}},
+			},
+		},
+		{statement: "INSERT INTO users VALUES (1, 'a@example.com')"},
+		{statement: "CREATE TABLE t (id INT)"},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		got, err := ExtractColumnLineage(context.Background(), base.GetQuerySpanContext{
+			GetDatabaseMetadataFunc: getter,
+			ListDatabaseNamesFunc:   lister,
+		}, test.statement, "app", "")
+		a.NoError(err, test.statement)
+		a.Equal(test.want, got, test.statement)
+	}
+}
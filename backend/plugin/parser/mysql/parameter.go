@@ -0,0 +1,19 @@
+package mysql
+
+import (
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func init() {
+	base.RegisterBindParametersFunc(storepb.Engine_MYSQL, BindParameters)
+	base.RegisterBindParametersFunc(storepb.Engine_MARIADB, BindParameters)
+	base.RegisterBindParametersFunc(storepb.Engine_OCEANBASE, BindParameters)
+}
+
+// BindParameters replaces the named parameter references in the MySQL statement with ? placeholders.
+func BindParameters(statement string) (string, []string, error) {
+	return base.BindNamedParameters(formatDialect, statement, func(_ int) string {
+		return "?"
+	})
+}
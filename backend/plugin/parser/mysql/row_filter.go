@@ -0,0 +1,159 @@
+package mysql
+
+import (
+	"maps"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/omni/mysql/ast"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func init() {
+	base.RegisterRewriteRowFiltersFunc(storepb.Engine_MYSQL, RewriteRowFilters)
+}
+
+// RewriteRowFilters replaces the references of the filtered tables with filtered subqueries, e.g.
+// "FROM db.t AS a" becomes "FROM (SELECT * FROM db.t AS a WHERE (region = 'emea')) AS `a`".
+// The schema of the default database and the row filters is ignored.
+func RewriteRowFilters(statement string, defaultDatabase string, _ string, filters []base.RowFilter) (*base.RowFilterRewrite, error) {
+	list, err := ParseMySQLOmni(statement)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse statement")
+	}
+	if list == nil || len(list.Items) != 1 {
+		count := 0
+		if list != nil {
+			count = len(list.Items)
+		}
+		return nil, errors.Errorf("expect one statement, got %d", count)
+	}
+	root := list.Items[0]
+
+	_, isSelect := root.(*ast.SelectStmt)
+	result := &base.RowFilterRewrite{}
+	var edits []base.RowFilterEdit
+	applied := make(map[int]bool)
+	var walkErr error
+	ast.Walk(&cteScope{names: make(map[string]bool), visit: func(ref *ast.TableRef, cte bool) bool {
+		if walkErr != nil {
+			return false
+		}
+		if ref.Loc.End <= ref.Loc.Start {
+			return true
+		}
+		if cte {
+			// Fail closed if a filtered table name is not rewritten at every occurrence.
+			for _, filter := range filters {
+				if strings.EqualFold(filter.Database, defaultDatabase) && strings.EqualFold(filter.Table, ref.Name) {
+					walkErr = errors.Errorf("common table expression %q shadows the filtered table %q", ref.Name, filter.Database+"."+filter.Table)
+					return false
+				}
+			}
+			return true
+		}
+		database := ref.Schema
+		if database == "" {
+			database = defaultDatabase
+		}
+		result.References = append(result.References, base.ColumnResource{Database: database, Table: ref.Name})
+
+		var predicates []string
+		for i, filter := range filters {
+			if strings.EqualFold(filter.Database, database) && strings.EqualFold(filter.Table, ref.Name) {
+				predicates = append(predicates, "("+filter.Predicate+")")
+				applied[i] = true
+			}
+		}
+		if len(predicates) == 0 {
+			return true
+		}
+		if !isSelect {
+			walkErr = errors.Errorf("row filters of table %q only support SELECT statements", database+"."+ref.Name)
+			return false
+		}
+
+		// The original reference is kept in the subquery, so partitions and index hints still apply.
+		end := base.TrimRowFilterEditEnd(statement, ref.Loc.Start, ref.Loc.End)
+		alias := ref.Alias
+		if alias == "" {
+			alias = ref.Name
+		}
+		edits = append(edits, base.RowFilterEdit{
+			Start:       ref.Loc.Start,
+			End:         end,
+			Replacement: "(SELECT * FROM " + statement[ref.Loc.Start:end] + " WHERE " + strings.Join(predicates, " AND ") + ") AS " + quoteIdentifier(alias),
+		})
+		return true
+	}}, root)
+	if walkErr != nil {
+		return nil, walkErr
+	}
+
+	if result.Statement, err = base.ApplyRowFilterEdits(statement, edits); err != nil {
+		return nil, err
+	}
+	for i, filter := range filters {
+		if applied[i] {
+			result.Applied = append(result.Applied, filter)
+		}
+	}
+	return result, nil
+}
+
+// cteScope walks the statement and reports whether each table reference refers to a common table
+// expression visible at the reference, i.e. one of the enclosing WITH clauses.
+type cteScope struct {
+	names map[string]bool
+	// ctes is the WITH clause of the enclosing statement. Its expressions only see the preceding
+	// expressions, unless the expression is recursive.
+	ctes  []*ast.CommonTableExpr
+	outer map[string]bool
+	// visit returns false to skip the children of the reference.
+	visit func(ref *ast.TableRef, cte bool) bool
+}
+
+func (s *cteScope) Visit(n ast.Node) ast.Visitor {
+	switch n := n.(type) {
+	case nil:
+		return nil
+	case *ast.TableRef:
+		if !s.visit(n, n.Schema == "" && s.names[strings.ToLower(n.Name)]) {
+			return nil
+		}
+		return s
+	case *ast.CommonTableExpr:
+		if len(s.ctes) == 0 {
+			return s
+		}
+		names := maps.Clone(s.outer)
+		for _, cte := range s.ctes {
+			if cte == n {
+				if cte.Recursive {
+					names[strings.ToLower(cte.Name)] = true
+				}
+				break
+			}
+			names[strings.ToLower(cte.Name)] = true
+		}
+		return &cteScope{names: names, visit: s.visit}
+	case *ast.SelectStmt:
+		if len(n.CTEs) == 0 {
+			return s
+		}
+		names := maps.Clone(s.names)
+		for _, cte := range n.CTEs {
+			names[strings.ToLower(cte.Name)] = true
+		}
+		return &cteScope{names: names, ctes: n.CTEs, outer: s.names, visit: s.visit}
+	default:
+		return s
+	}
+}
+
+func quoteIdentifier(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
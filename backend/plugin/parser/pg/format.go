@@ -0,0 +1,22 @@
+package pg
+
+import (
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func init() {
+	base.RegisterFormatFunc(storepb.Engine_POSTGRES, Format)
+	base.RegisterFormatFunc(storepb.Engine_COCKROACHDB, Format)
+}
+
+var formatDialect = &base.FormatDialect{
+	DollarQuotes:   true,
+	NestedComments: true,
+	MetaCommands:   true,
+}
+
+// Format formats the PostgreSQL script.
+func Format(statement string, opts base.FormatOptions) (string, error) {
+	return base.FormatSQL(formatDialect, statement, opts), nil
+}
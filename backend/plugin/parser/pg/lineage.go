@@ -0,0 +1,133 @@
+package pg
+
+import (
+	"context"
+
+	"github.com/bytebase/omni/pg/ast"
+	"github.com/pkg/errors"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func init() {
+	base.RegisterExtractColumnLineageFunc(storepb.Engine_POSTGRES, ExtractColumnLineage)
+}
+
+// ExtractColumnLineage extracts the column lineage of INSERT ... SELECT, CREATE TABLE ... AS and CREATE [MATERIALIZED] VIEW statements.
+func ExtractColumnLineage(ctx context.Context, gCtx base.GetQuerySpanContext, statement string, database, schema string) ([]*base.ColumnLineage, error) {
+	stmts, err := ParsePg(statement)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse statement")
+	}
+	if len(stmts) != 1 {
+		return nil, errors.Errorf("expect one statement, got %d", len(stmts))
+	}
+
+	var relation *ast.RangeVar
+	var columns []string
+	var query ast.Node
+	var with *ast.WithClause
+	switch n := stmts[0].AST.(type) {
+	case *ast.InsertStmt:
+		relation, query, with = n.Relation, n.SelectStmt, n.WithClause
+		if n.Cols != nil {
+			for _, item := range n.Cols.Items {
+				target, ok := item.(*ast.ResTarget)
+				if !ok {
+					return nil, nil
+				}
+				columns = append(columns, target.Name)
+			}
+		}
+	case *ast.ViewStmt:
+		relation, query = n.View, n.Query
+		columns = stringListItems(n.Aliases)
+	case *ast.CreateTableAsStmt:
+		if n.Into == nil {
+			return nil, nil
+		}
+		relation, query = n.Into.Rel, n.Query
+		columns = stringListItems(n.Into.ColNames)
+	default:
+		return nil, nil
+	}
+	sel, ok := query.(*ast.SelectStmt)
+	if !ok || relation == nil || sel.ValuesLists != nil {
+		return nil, nil
+	}
+	if sel.Loc.Start < 0 || sel.Loc.End > len(statement) || sel.Loc.Start >= sel.Loc.End {
+		return nil, errors.Errorf("unknown location of the query in %q", statement)
+	}
+	text := statement[sel.Loc.Start:sel.Loc.End]
+	if with != nil && with.Loc.Start >= 0 && with.Loc.End <= len(statement) {
+		text = statement[with.Loc.Start:with.Loc.End] + " " + text
+	}
+
+	if gCtx.GetDatabaseMetadataFunc == nil {
+		return nil, errors.New("GetDatabaseMetadataFunc is not set in GetQuerySpanContext")
+	}
+	_, meta, err := gCtx.GetDatabaseMetadataFunc(ctx, gCtx.InstanceID, database)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get database metadata for instance %q and database %q", gCtx.InstanceID, database)
+	}
+	if meta == nil {
+		return nil, errors.Errorf("database metadata for instance %q and database %q not found", gCtx.InstanceID, database)
+	}
+	target := base.ColumnResource{
+		Database: database,
+		Schema:   relation.Schemaname,
+		Table:    relation.Relname,
+	}
+	if relation.Catalogname != "" {
+		target.Database = relation.Catalogname
+	}
+	if target.Schema == "" {
+		target.Schema = schema
+	}
+	if target.Schema == "" {
+		target.Schema = "public"
+		if searchPath := meta.GetSearchPath(); len(searchPath) > 0 {
+			target.Schema = searchPath[0]
+		}
+	}
+
+	span, err := GetQuerySpan(ctx, gCtx, base.Statement{Text: text}, database, schema, false)
+	if err != nil {
+		return nil, err
+	}
+	if len(columns) == 0 {
+		if _, ok := stmts[0].AST.(*ast.InsertStmt); ok {
+			// INSERT without a column list writes the leading columns of the table.
+			table := meta.GetSchemaMetadata(target.Schema).GetTable(target.Table)
+			if table == nil {
+				return nil, errors.Errorf("table %q.%q not found", target.Schema, target.Table)
+			}
+			for _, column := range table.GetProto().GetColumns() {
+				if len(columns) == len(span.Results) {
+					break
+				}
+				columns = append(columns, column.Name)
+			}
+		}
+	} else if len(columns) < len(span.Results) {
+		// The result names are kept for the columns beyond the alias list.
+		for _, result := range span.Results[len(columns):] {
+			columns = append(columns, result.Name)
+		}
+	}
+	return base.GetColumnLineageFromQuerySpan(target, columns, span)
+}
+
+func stringListItems(list *ast.List) []string {
+	if list == nil {
+		return nil
+	}
+	var items []string
+	for _, item := range list.Items {
+		if s, ok := item.(*ast.String); ok {
+			items = append(items, s.Str)
+		}
+	}
+	return items
+}
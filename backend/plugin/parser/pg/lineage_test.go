@@ -0,0 +1,76 @@
+package pg
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func TestExtractColumnLineage(t *testing.T) {
+	metadata := &storepb.DatabaseSchemaMetadata{
+		Name: "db",
+		Schemas: []*storepb.SchemaMetadata{
+			{
+				Name: "public",
+				Tables: []*storepb.TableMetadata{
+					{Name: "users", Columns: []*storepb.ColumnMetadata{{Name: "id"}, {Name: "email"}, {Name: "first_name"}, {Name: "last_name"}}},
+					{Name: "contacts", Columns: []*storepb.ColumnMetadata{{Name: "user_id"}, {Name: "email"}, {Name: "note"}}},
+				},
+			},
+		},
+	}
+	getter, lister := buildMockDatabaseMetadataGetter([]*storepb.DatabaseSchemaMetadata{metadata})
+	column := func(table, column string) base.ColumnResource {
+		return base.ColumnResource{Database: "db", Schema: "public", Table: table, Column: column}
+	}
+
+	tests := []struct {
+		statement string
+		want      []*base.ColumnLineage
+	}{
+		{
+			statement: "INSERT INTO contacts (email, user_id) SELECT email, id FROM users WHERE id > 10",
+			want: []*base.ColumnLineage{
+				{Target: column("contacts", "email"), Sources: base.SourceColumnSet{column("users", "email"): true}},
+				{Target: column("contacts", "user_id"), Sources: base.SourceColumnSet{column("users", "id"): true}},
+			},
+		},
+		{
+			statement: "INSERT INTO contacts SELECT id, email, first_name || ' ' || last_name FROM users",
+			want: []*base.ColumnLineage{
+				{Target: column("contacts", "user_id"), Sources: base.SourceColumnSet{column("users", "id"): true}},
+				{Target: column("contacts", "email"), Sources: base.SourceColumnSet{column("users", "email"): true}},
+				{Target: column("contacts", "note"), Sources: base.SourceColumnSet{column("users", "first_name"): true, column("users", "last_name"): true}},
+			},
+		},
+		{
+			statement: "CREATE VIEW user_emails (address) AS SELECT email FROM users",
+			want: []*base.ColumnLineage{
+				{Target: column("user_emails", "address"), Sources: base.SourceColumnSet{column("users", "email"): true}},
+			},
+		},
+		{
+			statement: "CREATE TABLE archive.old_users AS SELECT u.id, lower(u.email) AS email FROM users u",
+			want: []*base.ColumnLineage{
+				{Target: base.ColumnResource{Database: "db", Schema: "archive", Table: "old_users", Column: "id"}, Sources: base.SourceColumnSet{column("users", "id"): true}},
+				{Target: base.ColumnResource{Database: "db", Schema: "archive", Table: "old_users", Column: "email"}, Sources: base.SourceColumnSet{column("users", "email"): true}},
+			},
+		},
+		{statement: "INSERT INTO contacts VALUES (1, 'a@example.com', '')"},
+		{statement: "UPDATE users SET email = ''"},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		got, err := ExtractColumnLineage(context.Background(), base.GetQuerySpanContext{
+			GetDatabaseMetadataFunc: getter,
+			ListDatabaseNamesFunc:   lister,
+		}, test.statement, "db", "")
+		a.NoError(err, test.statement)
+		a.Equal(test.want, got, test.statement)
+	}
+}
@@ -0,0 +1,215 @@
+package pg
+
+import (
+	"maps"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/omni/pg/ast"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func init() {
+	base.RegisterRewriteRowFiltersFunc(storepb.Engine_POSTGRES, RewriteRowFilters)
+}
+
+// RewriteRowFilters replaces the references of the filtered tables with filtered subqueries, e.g.
+// `FROM public.t AS a` becomes `FROM (SELECT * FROM "public"."t" WHERE (region = 'emea')) AS "a"`.
+func RewriteRowFilters(statement string, defaultDatabase string, defaultSchema string, filters []base.RowFilter) (*base.RowFilterRewrite, error) {
+	stmts, err := ParsePg(statement)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse statement")
+	}
+	var nonEmpty []ast.Node
+	for _, stmt := range stmts {
+		if !stmt.Empty() {
+			nonEmpty = append(nonEmpty, stmt.AST)
+		}
+	}
+	if len(nonEmpty) != 1 {
+		return nil, errors.Errorf("expect one statement, got %d", len(nonEmpty))
+	}
+	root := nonEmpty[0]
+	if defaultSchema == "" {
+		defaultSchema = "public"
+	}
+
+	// Sampled relations are collected first because the walker visits them after the FROM clause.
+	sampled := make(map[*ast.RangeVar]bool)
+	ast.Inspect(root, func(n ast.Node) bool {
+		if n, ok := n.(*ast.RangeTableSample); ok {
+			if rv, ok := n.Relation.(*ast.RangeVar); ok {
+				sampled[rv] = true
+			}
+		}
+		return true
+	})
+
+	_, isSelect := root.(*ast.SelectStmt)
+	result := &base.RowFilterRewrite{}
+	var edits []base.RowFilterEdit
+	applied := make(map[int]bool)
+	var walkErr error
+	ast.Walk(&cteScope{names: make(map[string]bool), visit: func(rv *ast.RangeVar, cte bool) bool {
+		if walkErr != nil {
+			return false
+		}
+		// Relations without locations are not table references, e.g. the relations of FOR UPDATE OF.
+		if rv.Loc.Start < 0 || rv.Loc.End <= rv.Loc.Start {
+			return true
+		}
+		if cte {
+			// Fail closed if a filtered table name is not rewritten at every occurrence.
+			for _, filter := range filters {
+				if filter.Schema == defaultSchema && filter.Table == rv.Relname {
+					walkErr = errors.Errorf("common table expression %q shadows the filtered table %q", rv.Relname, filter.Schema+"."+filter.Table)
+					return false
+				}
+			}
+			return true
+		}
+		if rv.Catalogname != "" && rv.Catalogname != defaultDatabase {
+			result.References = append(result.References, base.ColumnResource{Database: rv.Catalogname, Schema: rv.Schemaname, Table: rv.Relname})
+			return true
+		}
+		schema := rv.Schemaname
+		if schema == "" {
+			schema = defaultSchema
+		}
+		result.References = append(result.References, base.ColumnResource{Database: defaultDatabase, Schema: schema, Table: rv.Relname})
+
+		var predicates []string
+		for i, filter := range filters {
+			if filter.Schema == schema && filter.Table == rv.Relname {
+				predicates = append(predicates, "("+filter.Predicate+")")
+				applied[i] = true
+			}
+		}
+		if len(predicates) == 0 {
+			return true
+		}
+		switch {
+		case !isSelect:
+			walkErr = errors.Errorf("row filters of table %q only support SELECT statements", schema+"."+rv.Relname)
+			return false
+		case sampled[rv]:
+			walkErr = errors.Errorf("row filters of table %q do not support TABLESAMPLE", schema+"."+rv.Relname)
+			return false
+		default:
+		}
+
+		var buf strings.Builder
+		buf.WriteString("(SELECT * FROM ")
+		if !rv.Inh {
+			buf.WriteString("ONLY ")
+		}
+		buf.WriteString(quoteIdent(schema))
+		buf.WriteString(".")
+		buf.WriteString(quoteIdent(rv.Relname))
+		buf.WriteString(" WHERE ")
+		buf.WriteString(strings.Join(predicates, " AND "))
+		buf.WriteString(") AS ")
+		if rv.Alias != nil && rv.Alias.Aliasname != "" {
+			buf.WriteString(quoteIdent(rv.Alias.Aliasname))
+			if rv.Alias.Colnames != nil && len(rv.Alias.Colnames.Items) > 0 {
+				var columns []string
+				for _, item := range rv.Alias.Colnames.Items {
+					if s, ok := item.(*ast.String); ok {
+						columns = append(columns, quoteIdent(s.Str))
+					}
+				}
+				buf.WriteString("(" + strings.Join(columns, ", ") + ")")
+			}
+		} else {
+			buf.WriteString(quoteIdent(rv.Relname))
+		}
+		edits = append(edits, base.RowFilterEdit{
+			Start:       rv.Loc.Start,
+			End:         base.TrimRowFilterEditEnd(statement, rv.Loc.Start, rv.Loc.End),
+			Replacement: buf.String(),
+		})
+		return true
+	}}, root)
+	if walkErr != nil {
+		return nil, walkErr
+	}
+
+	if result.Statement, err = base.ApplyRowFilterEdits(statement, edits); err != nil {
+		return nil, err
+	}
+	for i, filter := range filters {
+		if applied[i] {
+			result.Applied = append(result.Applied, filter)
+		}
+	}
+	return result, nil
+}
+
+// cteScope walks the statement and reports whether each relation refers to a common table expression
+// visible at the relation, i.e. one of the enclosing WITH clauses.
+type cteScope struct {
+	names map[string]bool
+	// with is the WITH clause of the enclosing statement. Its expressions only see the preceding
+	// expressions, unless the clause is recursive.
+	with  *ast.WithClause
+	outer map[string]bool
+	// visit returns false to skip the children of the relation.
+	visit func(rv *ast.RangeVar, cte bool) bool
+}
+
+func (s *cteScope) Visit(n ast.Node) ast.Visitor {
+	switch n := n.(type) {
+	case nil:
+		return nil
+	case *ast.RangeVar:
+		if !s.visit(n, n.Schemaname == "" && s.names[n.Relname]) {
+			return nil
+		}
+		return s
+	case *ast.CommonTableExpr:
+		if s.with == nil {
+			return s
+		}
+		names := maps.Clone(s.outer)
+		for _, item := range s.with.Ctes.Items {
+			cte, ok := item.(*ast.CommonTableExpr)
+			if !ok {
+				continue
+			}
+			if cte == n && !s.with.Recursive {
+				break
+			}
+			names[cte.Ctename] = true
+		}
+		return &cteScope{names: names, visit: s.visit}
+	default:
+	}
+
+	var with *ast.WithClause
+	switch n := n.(type) {
+	case *ast.SelectStmt:
+		with = n.WithClause
+	case *ast.InsertStmt:
+		with = n.WithClause
+	case *ast.UpdateStmt:
+		with = n.WithClause
+	case *ast.DeleteStmt:
+		with = n.WithClause
+	case *ast.MergeStmt:
+		with = n.WithClause
+	default:
+	}
+	if with == nil || with.Ctes == nil {
+		return s
+	}
+	names := maps.Clone(s.names)
+	for _, item := range with.Ctes.Items {
+		if cte, ok := item.(*ast.CommonTableExpr); ok {
+			names[cte.Ctename] = true
+		}
+	}
+	return &cteScope{names: names, with: with, outer: s.names, visit: s.visit}
+}
@@ -0,0 +1,91 @@
+package pg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func TestRewriteRowFilters(t *testing.T) {
+	filters := []base.RowFilter{
+		{Schema: "public", Table: "orders", Predicate: `"region" = 'emea'`},
+	}
+	testCases := []struct {
+		statement string
+		want      string
+		applied   int
+		err       bool
+	}{
+		{
+			statement: `SELECT * FROM orders WHERE id = 1`,
+			want:      `SELECT * FROM (SELECT * FROM "public"."orders" WHERE ("region" = 'emea')) AS "orders" WHERE id = 1`,
+			applied:   1,
+		},
+		{
+			statement: `SELECT o.id FROM public.orders AS o JOIN customers c ON o.customer_id = c.id`,
+			want:      `SELECT o.id FROM (SELECT * FROM "public"."orders" WHERE ("region" = 'emea')) AS "o" JOIN customers c ON o.customer_id = c.id`,
+			applied:   1,
+		},
+		{
+			statement: `SELECT * FROM ONLY orders o(a, b)`,
+			want:      `SELECT * FROM (SELECT * FROM ONLY "public"."orders" WHERE ("region" = 'emea')) AS "o"("a", "b")`,
+			applied:   1,
+		},
+		{
+			statement: `WITH orders AS (SELECT 1) SELECT * FROM orders`,
+			err:       true,
+		},
+		{
+			// The CTE shadowing the filtered table is rejected, even if only visible in the derived table.
+			statement: `SELECT * FROM (WITH orders AS (SELECT 1) SELECT * FROM orders) x, orders`,
+			err:       true,
+		},
+		{
+			statement: `SELECT * FROM (WITH t AS (SELECT 1) SELECT * FROM t) x, orders`,
+			want:      `SELECT * FROM (WITH t AS (SELECT 1) SELECT * FROM t) x, (SELECT * FROM "public"."orders" WHERE ("region" = 'emea')) AS "orders"`,
+			applied:   1,
+		},
+		{
+			statement: `WITH t AS (SELECT * FROM orders) SELECT * FROM t`,
+			want:      `WITH t AS (SELECT * FROM (SELECT * FROM "public"."orders" WHERE ("region" = 'emea')) AS "orders") SELECT * FROM t`,
+			applied:   1,
+		},
+		{
+			// Non-recursive expressions do not see themselves, so the reference is the table.
+			statement: `WITH orders AS (SELECT * FROM orders) SELECT 1`,
+			want:      `WITH orders AS (SELECT * FROM (SELECT * FROM "public"."orders" WHERE ("region" = 'emea')) AS "orders") SELECT 1`,
+			applied:   1,
+		},
+		{
+			statement: `WITH t AS (SELECT 1) SELECT * FROM t, (SELECT * FROM t) u`,
+			want:      `WITH t AS (SELECT 1) SELECT * FROM t, (SELECT * FROM t) u`,
+		},
+		{
+			statement: `SELECT (SELECT count(*) FROM orders) FROM other.orders`,
+			want:      `SELECT (SELECT count(*) FROM (SELECT * FROM "public"."orders" WHERE ("region" = 'emea')) AS "orders") FROM other.orders`,
+			applied:   1,
+		},
+		{
+			statement: `SELECT * FROM orders TABLESAMPLE SYSTEM (10)`,
+			err:       true,
+		},
+		{
+			statement: `DELETE FROM orders`,
+			err:       true,
+		},
+	}
+
+	a := require.New(t)
+	for _, tc := range testCases {
+		got, err := RewriteRowFilters(tc.statement, "db", "", filters)
+		if tc.err {
+			a.Error(err, tc.statement)
+			continue
+		}
+		a.NoError(err, tc.statement)
+		a.Equal(tc.want, got.Statement)
+		a.Len(got.Applied, tc.applied, tc.statement)
+	}
+}
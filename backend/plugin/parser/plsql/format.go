@@ -0,0 +1,23 @@
+package plsql
+
+import (
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func init() {
+	base.RegisterFormatFunc(storepb.Engine_ORACLE, Format)
+}
+
+var formatDialect = &base.FormatDialect{
+	QQuotes:         true,
+	IdentifierChars: "#@",
+	SlashTerminator: true,
+	BlockStatements: true,
+	DeclareBlocks:   true,
+}
+
+// Format formats the PL/SQL script.
+func Format(statement string, opts base.FormatOptions) (string, error) {
+	return base.FormatSQL(formatDialect, statement, opts), nil
+}
@@ -0,0 +1,23 @@
+package snowflake
+
+import (
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func init() {
+	base.RegisterFormatFunc(storepb.Engine_SNOWFLAKE, Format)
+}
+
+var formatDialect = &base.FormatDialect{
+	BackslashEscapes: true,
+	DollarQuotes:     true,
+	StagePaths:       true,
+	BlockStatements:  true,
+	DeclareBlocks:    true,
+}
+
+// Format formats the Snowflake script.
+func Format(statement string, opts base.FormatOptions) (string, error) {
+	return base.FormatSQL(formatDialect, statement, opts), nil
+}
@@ -0,0 +1,11 @@
+package snowflake
+
+import (
+	"testing"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func TestSnowflakeFormat(t *testing.T) {
+	base.RunFormatTests(t, "test-data/test_format.yaml", Format)
+}
@@ -0,0 +1,17 @@
+package snowflake
+
+import (
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func init() {
+	base.RegisterBindParametersFunc(storepb.Engine_SNOWFLAKE, BindParameters)
+}
+
+// BindParameters replaces the named parameter references in the Snowflake statement with ? placeholders.
+func BindParameters(statement string) (string, []string, error) {
+	return base.BindNamedParameters(formatDialect, statement, func(_ int) string {
+		return "?"
+	})
+}
@@ -0,0 +1,25 @@
+package tsql
+
+import (
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func init() {
+	base.RegisterFormatFunc(storepb.Engine_MSSQL, Format)
+}
+
+var formatDialect = &base.FormatDialect{
+	BracketIdentifiers:    true,
+	IdentifierStart:       "@#",
+	IdentifierChars:       "@#",
+	BatchSeparator:        true,
+	BlockStatements:       true,
+	ControlFlowStatements: true,
+	Keywords:              []string{"TOP", "PERCENT", "TIES", "NOLOCK"},
+}
+
+// Format formats the T-SQL script.
+func Format(statement string, opts base.FormatOptions) (string, error) {
+	return base.FormatSQL(formatDialect, statement, opts), nil
+}
@@ -0,0 +1,11 @@
+package tsql
+
+import (
+	"testing"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func TestTSQLFormat(t *testing.T) {
+	base.RunFormatTests(t, "test-data/test_format.yaml", Format)
+}
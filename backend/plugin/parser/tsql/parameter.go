@@ -0,0 +1,19 @@
+package tsql
+
+import (
+	"fmt"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func init() {
+	base.RegisterBindParametersFunc(storepb.Engine_MSSQL, BindParameters)
+}
+
+// BindParameters replaces the named parameter references in the T-SQL statement with @pn placeholders.
+func BindParameters(statement string) (string, []string, error) {
+	return base.BindNamedParameters(formatDialect, statement, func(index int) string {
+		return fmt.Sprintf("@p%d", index)
+	})
+}
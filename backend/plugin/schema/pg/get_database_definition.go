@@ -3672,6 +3672,25 @@ func GetMultiFileDatabaseDefinition(ctx schema.GetDefinitionContext, metadata *s
 			schemaName = "public"
 		}
 
+		// Generate the schema file with CREATE SCHEMA and the schema comment
+		if ctx.ObjectPerFile {
+			var buf strings.Builder
+			if err := writeSchema(&buf, schemaMetadata); err != nil {
+				return nil, errors.Wrapf(err, "failed to generate schema SDL for %s", schemaName)
+			}
+			if len(schemaMetadata.Comment) > 0 {
+				if err := writeSchemaCommentSDL(&buf, schemaMetadata); err != nil {
+					return nil, errors.Wrapf(err, "failed to generate schema comment for %s", schemaName)
+				}
+			}
+			if buf.Len() > 0 {
+				files = append(files, schema.File{
+					Name:    fmt.Sprintf("schemas/%s/schema.sql", schemaName),
+					Content: strings.TrimRight(buf.String(), "\n") + "\n",
+				})
+			}
+		}
+
 		// Collect independent sequences (no owner) for this schema
 		var independentSequences []*storepb.SequenceMetadata
 		for _, sequence := range schemaMetadata.Sequences {
@@ -3898,8 +3917,34 @@ func GetMultiFileDatabaseDefinition(ctx schema.GetDefinitionContext, metadata *s
 			})
 		}
 
-		// Generate a single file for all enum types in this schema
-		if len(schemaMetadata.EnumTypes) > 0 {
+		// Generate a file for each enum type in this schema
+		if ctx.ObjectPerFile {
+			for _, enumType := range schemaMetadata.EnumTypes {
+				if enumType.SkipDump {
+					continue
+				}
+
+				var buf strings.Builder
+				if err := writeEnum(&buf, schemaName, enumType); err != nil {
+					return nil, errors.Wrapf(err, "failed to generate enum type SDL for %s.%s", schemaName, enumType.Name)
+				}
+				buf.WriteString(";\n")
+
+				// Add enum type comment if present
+				if len(enumType.Comment) > 0 {
+					buf.WriteString("\n")
+					if err := writeEnumComment(&buf, schemaName, enumType); err != nil {
+						return nil, errors.Wrapf(err, "failed to generate enum type comment for %s.%s", schemaName, enumType.Name)
+					}
+				}
+
+				files = append(files, schema.File{
+					Name:    fmt.Sprintf("schemas/%s/types/%s.sql", schemaName, enumType.Name),
+					Content: buf.String(),
+				})
+			}
+		} else if len(schemaMetadata.EnumTypes) > 0 {
+			// Generate a single file for all enum types in this schema
 			var buf strings.Builder
 			hasEnumTypes := false
 			for i, enumType := range schemaMetadata.EnumTypes {
@@ -3938,8 +3983,30 @@ func GetMultiFileDatabaseDefinition(ctx schema.GetDefinitionContext, metadata *s
 			}
 		}
 
-		// Generate a single file for all independent sequences (no owner) in this schema
-		if len(independentSequences) > 0 {
+		// Generate a file for each independent sequence (no owner) in this schema
+		if ctx.ObjectPerFile {
+			for _, sequence := range independentSequences {
+				var buf strings.Builder
+				if err := writeSequenceSDL(&buf, schemaName, sequence); err != nil {
+					return nil, errors.Wrapf(err, "failed to generate sequence SDL for %s.%s", schemaName, sequence.Name)
+				}
+				buf.WriteString(";\n")
+
+				// Add sequence comment if present
+				if len(sequence.Comment) > 0 {
+					buf.WriteString("\n")
+					if err := writeSequenceCommentSDL(&buf, schemaName, sequence); err != nil {
+						return nil, errors.Wrapf(err, "failed to generate sequence comment for %s.%s", schemaName, sequence.Name)
+					}
+				}
+
+				files = append(files, schema.File{
+					Name:    fmt.Sprintf("schemas/%s/sequences/%s.sql", schemaName, sequence.Name),
+					Content: buf.String(),
+				})
+			}
+		} else if len(independentSequences) > 0 {
+			// Generate a single file for all independent sequences (no owner) in this schema
 			var buf strings.Builder
 			for i, sequence := range independentSequences {
 				if i > 0 {
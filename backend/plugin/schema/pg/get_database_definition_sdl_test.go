@@ -1855,6 +1855,67 @@ func TestGetMultiFileDatabaseDefinition_SerialColumnWithSequence(t *testing.T) {
 	require.Equal(t, 3, len(result.Files), "Should have exactly 3 files (2 tables + 1 consolidated sequences file)")
 }
 
+func TestGetMultiFileDatabaseDefinition_ObjectPerFile(t *testing.T) {
+	metadata := &storepb.DatabaseSchemaMetadata{
+		Schemas: []*storepb.SchemaMetadata{
+			{
+				Name: "public",
+				Tables: []*storepb.TableMetadata{
+					{
+						Name: "users",
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "id", Type: "bigint", Nullable: false},
+							{Name: "status", Type: "app.status", Nullable: false},
+						},
+					},
+				},
+			},
+			{
+				Name:    "app",
+				Comment: "Application schema",
+				EnumTypes: []*storepb.EnumTypeMetadata{
+					{Name: "status", Values: []string{"active", "inactive"}},
+					{Name: "priority", Values: []string{"low", "high"}},
+				},
+				Sequences: []*storepb.SequenceMetadata{
+					{Name: "order_seq", DataType: "bigint", Start: "1", Increment: "1", MinValue: "1", MaxValue: "9223372036854775807"},
+					{Name: "invoice_seq", DataType: "bigint", Start: "100", Increment: "1", MinValue: "1", MaxValue: "9223372036854775807", Comment: "Invoice numbers"},
+				},
+			},
+		},
+	}
+
+	result, err := GetMultiFileDatabaseDefinition(schema.GetDefinitionContext{
+		SDLFormat:       true,
+		MultiFileFormat: true,
+		ObjectPerFile:   true,
+	}, metadata)
+	require.NoError(t, err)
+
+	fileMap := make(map[string]string)
+	for _, file := range result.Files {
+		fileMap[file.Name] = file.Content
+	}
+	require.Len(t, fileMap, 6)
+
+	// The public schema has no file as it's neither created nor commented.
+	require.NotContains(t, fileMap, "schemas/public/schema.sql")
+	require.Contains(t, fileMap["schemas/app/schema.sql"], `CREATE SCHEMA IF NOT EXISTS "app";`)
+	require.Contains(t, fileMap["schemas/app/schema.sql"], `COMMENT ON SCHEMA "app" IS 'Application schema';`)
+
+	require.Contains(t, fileMap["schemas/public/tables/users.sql"], `CREATE TABLE "public"."users"`)
+
+	require.Contains(t, fileMap["schemas/app/types/status.sql"], `CREATE TYPE "app"."status" AS ENUM`)
+	require.NotContains(t, fileMap["schemas/app/types/status.sql"], `"priority"`)
+	require.Contains(t, fileMap["schemas/app/types/priority.sql"], `CREATE TYPE "app"."priority" AS ENUM`)
+	require.NotContains(t, fileMap, "schemas/app/types.sql")
+
+	require.Contains(t, fileMap["schemas/app/sequences/order_seq.sql"], `CREATE SEQUENCE "app"."order_seq"`)
+	require.NotContains(t, fileMap["schemas/app/sequences/order_seq.sql"], "invoice_seq")
+	require.Contains(t, fileMap["schemas/app/sequences/invoice_seq.sql"], `COMMENT ON SEQUENCE "app"."invoice_seq" IS 'Invoice numbers'`)
+	require.NotContains(t, fileMap, "schemas/app/sequences.sql")
+}
+
 func TestGetDatabaseDefinitionSDLFormat_MultipleSequencesClaimingOwnership(t *testing.T) {
 	// This test verifies that when multiple sequences claim ownership of the same column,
 	// only the sequence referenced in the DEFAULT clause is skipped (treated as serial sequence).
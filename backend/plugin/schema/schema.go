@@ -65,6 +65,9 @@ type GetDefinitionContext struct {
 	// MultiFileFormat indicates whether to generate multi-file SDL output.
 	// When true, the result should be organized as multiple files.
 	MultiFileFormat bool
+	// ObjectPerFile indicates whether to write every schema object into its own file in the multi-file SDL output,
+	// including the schemas, enum types and independent sequences that are otherwise consolidated per schema.
+	ObjectPerFile bool
 }
 
 // File represents a single file in a multi-file schema output.
@@ -0,0 +1,373 @@
+package accessreview
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/genproto/googleapis/type/expr"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+	"github.com/bytebase/bytebase/backend/generated-go/v1/v1connect"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
+)
+
+// maxRevokeAttempts is the number of attempts to update an IAM policy that is updated concurrently.
+const maxRevokeAttempts = 3
+
+// Snapshot builds the entries of the campaign from the IAM policy bindings and the active access grants in its scope.
+// The entries of a project are reviewed by the project owners, or by the workspace admins if the project has no owner.
+// The entries of the workspace are reviewed by the workspace admins.
+func Snapshot(ctx context.Context, stores *store.Store, review *store.AccessReviewMessage) ([]*store.AccessReviewEntryMessage, error) {
+	now := time.Now()
+	workspacePolicy, err := stores.GetWorkspaceIamPolicy(ctx, review.Workspace)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get workspace iam policy")
+	}
+	workspaceAdmins := getRoleMembers(workspacePolicy.Policy, common.FormatRole(store.WorkspaceAdminRole), now)
+
+	var entries []*store.AccessReviewEntryMessage
+	if review.Payload.IncludeWorkspace {
+		entries = append(entries, buildBindingEntries("", workspacePolicy.Policy, workspaceAdmins, now)...)
+	}
+
+	find := &store.FindProjectMessage{Workspace: review.Workspace}
+	for _, project := range review.Payload.Projects {
+		projectID, err := common.GetProjectID(project)
+		if err != nil {
+			return nil, err
+		}
+		find.ResourceIDs = append(find.ResourceIDs, projectID)
+	}
+	if len(review.Payload.Projects) > 0 && len(find.ResourceIDs) == 0 {
+		return entries, nil
+	}
+	projects, err := stores.ListProjects(ctx, find)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list projects")
+	}
+	for _, project := range projects {
+		projectPolicy, err := stores.GetProjectIamPolicy(ctx, review.Workspace, project.ResourceID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get iam policy of project %q", project.ResourceID)
+		}
+		reviewers := getRoleMembers(projectPolicy.Policy, common.FormatRole(store.ProjectOwnerRole), now)
+		if len(reviewers) == 0 {
+			reviewers = workspaceAdmins
+		}
+		entries = append(entries, buildBindingEntries(project.ResourceID, projectPolicy.Policy, reviewers, now)...)
+
+		projectID := project.ResourceID
+		grants, err := stores.ListAccessGrants(ctx, &store.FindAccessGrantMessage{
+			Workspace: review.Workspace,
+			ProjectID: &projectID,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to list access grants of project %q", project.ResourceID)
+		}
+		for _, grant := range grants {
+			if grant.Status != storepb.AccessGrant_ACTIVE {
+				continue
+			}
+			if grant.ExpireTime != nil && grant.ExpireTime.Before(now) {
+				continue
+			}
+			accessGrant := &storepb.AccessReviewEntryPayload_AccessGrant{
+				Name:    common.FormatAccessGrant(grant.ProjectID, grant.ID),
+				Creator: common.FormatUserEmail(grant.Creator),
+				Targets: grant.Payload.Targets,
+				Query:   grant.Payload.Query,
+				Unmask:  grant.Payload.Unmask,
+			}
+			if grant.ExpireTime != nil {
+				accessGrant.ExpireTime = timestamppb.New(*grant.ExpireTime)
+			}
+			entries = append(entries, &store.AccessReviewEntryMessage{
+				ProjectID: project.ResourceID,
+				Decision:  storepb.AccessReviewEntry_PENDING,
+				Payload: &storepb.AccessReviewEntryPayload{
+					Target:    &storepb.AccessReviewEntryPayload_AccessGrant_{AccessGrant: accessGrant},
+					Reviewers: reviewers,
+				},
+			})
+		}
+	}
+	return entries, nil
+}
+
+// buildBindingEntries builds an entry for each member of the bindings that are in effect.
+func buildBindingEntries(projectID string, policy *storepb.IamPolicy, reviewers []string, now time.Time) []*store.AccessReviewEntryMessage {
+	var entries []*store.AccessReviewEntryMessage
+	for _, binding := range policy.GetBindings() {
+		if !isBindingInEffect(binding, now) {
+			continue
+		}
+		for _, member := range binding.Members {
+			entries = append(entries, &store.AccessReviewEntryMessage{
+				ProjectID: projectID,
+				Decision:  storepb.AccessReviewEntry_PENDING,
+				Payload: &storepb.AccessReviewEntryPayload{
+					Target: &storepb.AccessReviewEntryPayload_Binding_{Binding: &storepb.AccessReviewEntryPayload_Binding{
+						Role:                binding.Role,
+						Member:              member,
+						ConditionExpression: binding.GetCondition().GetExpression(),
+						ConditionTitle:      binding.GetCondition().GetTitle(),
+					}},
+					Reviewers: reviewers,
+				},
+			})
+		}
+	}
+	return entries
+}
+
+// getRoleMembers returns the members of the role in the bindings that are in effect.
+func getRoleMembers(policy *storepb.IamPolicy, role string, now time.Time) []string {
+	var members []string
+	for _, binding := range policy.GetBindings() {
+		if binding.Role != role || !isBindingInEffect(binding, now) {
+			continue
+		}
+		for _, member := range binding.Members {
+			if !slices.Contains(members, member) {
+				members = append(members, member)
+			}
+		}
+	}
+	return members
+}
+
+func isBindingInEffect(binding *storepb.Binding, now time.Time) bool {
+	ok, err := common.EvalBindingCondition(binding.GetCondition().GetExpression(), now)
+	return err == nil && ok
+}
+
+// IsEntrySubject returns true if the access of the entry is granted to the user,
+// either by a binding whose member contains the user or by an access grant created by the user.
+func IsEntrySubject(ctx context.Context, stores *store.Store, workspace string, entry *store.AccessReviewEntryMessage, user *store.UserMessage) bool {
+	if binding := entry.Payload.GetBinding(); binding != nil && binding.Member != common.AllUsers {
+		if utils.MemberContainsUser(ctx, stores, workspace, binding.Member, user) {
+			return true
+		}
+	}
+	if grant := entry.Payload.GetAccessGrant(); grant != nil && grant.Creator == common.FormatUserEmail(user.Email) {
+		return true
+	}
+	return false
+}
+
+// CanReview returns true if the user is a reviewer of the entry.
+// The user cannot review the access of their own.
+func CanReview(ctx context.Context, stores *store.Store, workspace string, entry *store.AccessReviewEntryMessage, user *store.UserMessage) bool {
+	if IsEntrySubject(ctx, stores, workspace, entry, user) {
+		return false
+	}
+	for _, reviewer := range entry.Payload.Reviewers {
+		if utils.MemberContainsUser(ctx, stores, workspace, reviewer, user) {
+			return true
+		}
+	}
+	return false
+}
+
+// Complete completes an active campaign.
+// The entries that are not reviewed are revoked, and the revocations are applied to the IAM policies and the access grants.
+// The completer is empty if the campaign is completed automatically at the end time.
+func Complete(ctx context.Context, stores *store.Store, review *store.AccessReviewMessage, completer string) error {
+	if review.State != storepb.AccessReview_ACTIVE {
+		return errors.Errorf("access review %q is not active", review.ID)
+	}
+	entries, err := stores.ListAccessReviewEntries(ctx, &store.FindAccessReviewEntryMessage{
+		Workspace: review.Workspace,
+		ReviewID:  &review.ID,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to list access review entries")
+	}
+
+	var revoked []*store.AccessReviewEntryMessage
+	bindingEntries := make(map[string][]*store.AccessReviewEntryMessage)
+	for _, entry := range entries {
+		switch entry.Decision {
+		case storepb.AccessReviewEntry_PENDING:
+			entry.Decision = storepb.AccessReviewEntry_REVOKE
+			entry.Payload.AutoRevoked = true
+		case storepb.AccessReviewEntry_REVOKE:
+		default:
+			continue
+		}
+		revoked = append(revoked, entry)
+		if entry.Payload.GetBinding() != nil {
+			bindingEntries[entry.ProjectID] = append(bindingEntries[entry.ProjectID], entry)
+		}
+		if grant := entry.Payload.GetAccessGrant(); grant != nil {
+			if err := revokeAccessGrant(ctx, stores, review.Workspace, grant.Name); err != nil {
+				entry.Payload.RevokeError = err.Error()
+			}
+		}
+	}
+	for projectID, entries := range bindingEntries {
+		if err := revokeBindings(ctx, stores, review.Workspace, projectID, completer, entries); err != nil {
+			for _, entry := range entries {
+				entry.Payload.RevokeError = err.Error()
+			}
+		}
+	}
+
+	for _, entry := range revoked {
+		if err := stores.UpdateAccessReviewEntry(ctx, entry.ID, &store.UpdateAccessReviewEntryMessage{
+			Decision: &entry.Decision,
+			Payload:  entry.Payload,
+		}); err != nil {
+			return errors.Wrapf(err, "failed to update access review entry %d", entry.ID)
+		}
+	}
+	review.Payload.Completer = completer
+	return stores.UpdateAccessReview(ctx, review.ID, &store.UpdateAccessReviewMessage{
+		State:   new(storepb.AccessReview_COMPLETED),
+		Payload: review.Payload,
+	})
+}
+
+func revokeAccessGrant(ctx context.Context, stores *store.Store, workspace, name string) error {
+	projectID, grantID, err := common.GetProjectIDAccessGrantID(name)
+	if err != nil {
+		return err
+	}
+	grant, err := stores.GetAccessGrant(ctx, &store.FindAccessGrantMessage{
+		Workspace: workspace,
+		ID:        &grantID,
+		ProjectID: &projectID,
+	})
+	if err != nil {
+		return err
+	}
+	// The grant is already revoked or removed.
+	if grant == nil || grant.Status != storepb.AccessGrant_ACTIVE {
+		return nil
+	}
+	_, err = stores.UpdateAccessGrant(ctx, grantID, &store.UpdateAccessGrantMessage{
+		Status: new(storepb.AccessGrant_REVOKED),
+	})
+	return err
+}
+
+// revokeBindings removes the members of the revoked entries from the IAM policy of the project, or the workspace if the project is empty.
+// The entries that would remove the last workspace admin are kept with an error.
+// The policy is updated with its etag like SetIamPolicy, and retried if it is updated concurrently.
+// The removed bindings are recorded in the audit log as an IAM policy update by the completer.
+func revokeBindings(ctx context.Context, stores *store.Store, workspace, projectID, completer string, entries []*store.AccessReviewEntryMessage) error {
+	resourceType, resource, method := storepb.Policy_WORKSPACE, common.FormatWorkspace(workspace), v1connect.WorkspaceServiceSetIamPolicyProcedure
+	if projectID != "" {
+		resourceType, resource, method = storepb.Policy_PROJECT, common.FormatProject(projectID), v1connect.ProjectServiceSetIamPolicyProcedure
+	}
+
+	for attempt := 1; ; attempt++ {
+		var policy *store.IamPolicyMessage
+		var err error
+		if projectID == "" {
+			policy, err = stores.GetWorkspaceIamPolicy(ctx, workspace)
+		} else {
+			policy, err = stores.GetProjectIamPolicy(ctx, workspace, projectID)
+		}
+		if err != nil {
+			return err
+		}
+		// The policy does not exist, so there is no binding to revoke.
+		if policy.Etag == "" {
+			return nil
+		}
+
+		var deltas []*v1pb.BindingDelta
+		for _, entry := range entries {
+			entry.Payload.RevokeError = ""
+			binding := entry.Payload.GetBinding()
+			if projectID == "" && binding.Role == common.FormatRole(store.WorkspaceAdminRole) {
+				admins := getRoleMembers(policy.Policy, binding.Role, time.Now())
+				if len(admins) == 1 && admins[0] == binding.Member {
+					entry.Payload.RevokeError = "cannot revoke the last workspace admin"
+					continue
+				}
+			}
+			if !removeBindingMember(policy.Policy, binding) {
+				continue
+			}
+			delta := &v1pb.BindingDelta{
+				Action: v1pb.BindingDelta_REMOVE,
+				Role:   binding.Role,
+				Member: binding.Member,
+			}
+			if binding.ConditionExpression != "" {
+				delta.Condition = &expr.Expr{Expression: binding.ConditionExpression, Title: binding.ConditionTitle}
+			}
+			deltas = append(deltas, delta)
+		}
+		if len(deltas) == 0 {
+			return nil
+		}
+
+		payload, err := protojson.Marshal(policy.Policy)
+		if err != nil {
+			return err
+		}
+		updated, err := stores.UpdatePolicy(ctx, &store.UpdatePolicyMessage{
+			Workspace:    workspace,
+			ResourceType: resourceType,
+			Resource:     resource,
+			Type:         storepb.Policy_IAM,
+			Payload:      new(string(payload)),
+			Etag:         &policy.Etag,
+		})
+		if err != nil {
+			return err
+		}
+		if updated == nil {
+			if attempt < maxRevokeAttempts {
+				continue
+			}
+			return errors.Errorf("there is concurrent update to the iam policy of %q", resource)
+		}
+
+		serviceData, err := anypb.New(&v1pb.AuditData{PolicyDelta: &v1pb.PolicyDelta{BindingDeltas: deltas}})
+		if err != nil {
+			return err
+		}
+		return stores.CreateAuditLog(ctx, workspace, &storepb.AuditLog{
+			Parent:      resource,
+			Method:      method,
+			Resource:    resource,
+			Severity:    storepb.AuditLog_INFO,
+			User:        completer,
+			ServiceData: serviceData,
+		})
+	}
+}
+
+// removeBindingMember removes the member from the bindings with the same role and condition, and returns true if the member is removed.
+// The bindings without members are removed.
+func removeBindingMember(policy *storepb.IamPolicy, target *storepb.AccessReviewEntryPayload_Binding) bool {
+	removed := false
+	var bindings []*storepb.Binding
+	for _, binding := range policy.Bindings {
+		if binding.Role == target.Role && binding.GetCondition().GetExpression() == target.ConditionExpression {
+			n := len(binding.Members)
+			binding.Members = slices.DeleteFunc(binding.Members, func(member string) bool {
+				return member == target.Member
+			})
+			removed = removed || len(binding.Members) < n
+			if len(binding.Members) == 0 {
+				continue
+			}
+		}
+		bindings = append(bindings, binding)
+	}
+	policy.Bindings = bindings
+	return removed
+}
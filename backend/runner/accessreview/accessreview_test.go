@@ -0,0 +1,75 @@
+package accessreview
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/type/expr"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+func TestBuildBindingEntries(t *testing.T) {
+	a := require.New(t)
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	policy := &storepb.IamPolicy{
+		Bindings: []*storepb.Binding{
+			{Role: "roles/projectOwner", Members: []string{"users/owner@example.com"}},
+			{Role: "roles/sqlEditorUser", Members: []string{"users/dev@example.com", "groups/qa@example.com"}},
+			{
+				Role:      "roles/projectDeveloper",
+				Members:   []string{"users/expired@example.com"},
+				Condition: &expr.Expr{Expression: `request.time < timestamp("2025-01-01T00:00:00Z")`},
+			},
+		},
+	}
+
+	owners := getRoleMembers(policy, "roles/projectOwner", now)
+	a.Equal([]string{"users/owner@example.com"}, owners)
+
+	entries := buildBindingEntries("db", policy, owners, now)
+	a.Len(entries, 3)
+	var members []string
+	for _, entry := range entries {
+		a.Equal("db", entry.ProjectID)
+		a.Equal(storepb.AccessReviewEntry_PENDING, entry.Decision)
+		a.Equal(owners, entry.Payload.Reviewers)
+		members = append(members, entry.Payload.GetBinding().Member)
+	}
+	a.Equal([]string{"users/owner@example.com", "users/dev@example.com", "groups/qa@example.com"}, members)
+}
+
+func TestRemoveBindingMember(t *testing.T) {
+	a := require.New(t)
+	condition := &expr.Expr{Expression: `request.time < timestamp("2030-01-01T00:00:00Z")`}
+	policy := &storepb.IamPolicy{
+		Bindings: []*storepb.Binding{
+			{Role: "roles/sqlEditorUser", Members: []string{"users/dev@example.com", "users/qa@example.com"}},
+			{Role: "roles/sqlEditorUser", Members: []string{"users/dev@example.com"}, Condition: condition},
+			{Role: "roles/projectOwner", Members: []string{"users/dev@example.com"}},
+		},
+	}
+
+	a.True(removeBindingMember(policy, &storepb.AccessReviewEntryPayload_Binding{
+		Role:                "roles/sqlEditorUser",
+		Member:              "users/dev@example.com",
+		ConditionExpression: condition.Expression,
+	}))
+	a.Len(policy.Bindings, 2)
+	a.Equal([]string{"users/dev@example.com", "users/qa@example.com"}, policy.Bindings[0].Members)
+
+	a.True(removeBindingMember(policy, &storepb.AccessReviewEntryPayload_Binding{
+		Role:   "roles/sqlEditorUser",
+		Member: "users/dev@example.com",
+	}))
+	a.Equal([]string{"users/qa@example.com"}, policy.Bindings[0].Members)
+	a.Equal([]string{"users/dev@example.com"}, policy.Bindings[1].Members)
+
+	// The member is already removed.
+	a.False(removeBindingMember(policy, &storepb.AccessReviewEntryPayload_Binding{
+		Role:   "roles/sqlEditorUser",
+		Member: "users/dev@example.com",
+	}))
+	a.Len(policy.Bindings, 2)
+}
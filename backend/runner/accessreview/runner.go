@@ -0,0 +1,90 @@
+// Package accessreview is a runner that completes the access review campaigns at their end time.
+package accessreview
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+const accessReviewInterval = 10 * time.Minute
+
+// Runner completes the access review campaigns that have ended.
+type Runner struct {
+	store *store.Store
+}
+
+// NewRunner creates an access review runner.
+func NewRunner(store *store.Store) *Runner {
+	return &Runner{
+		store: store,
+	}
+}
+
+// Run starts the access review runner.
+func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	ticker := time.NewTicker(accessReviewInterval)
+	defer ticker.Stop()
+
+	slog.Debug(fmt.Sprintf("Access review runner started and will run every %v", accessReviewInterval))
+
+	for {
+		select {
+		case <-ticker.C:
+			r.completeEnded(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *Runner) completeEnded(ctx context.Context) {
+	defer func() {
+		if p := recover(); p != nil {
+			err, ok := p.(error)
+			if !ok {
+				err = errors.Errorf("%v", p)
+			}
+			slog.Error("Access review runner PANIC RECOVER", log.BBError(err), log.BBStack("panic-stack"))
+		}
+	}()
+
+	lock, acquired, err := store.TryAdvisoryLock(ctx, r.store.GetDB(), store.AdvisoryLockKeyAccessReview)
+	if err != nil {
+		slog.Error("Failed to acquire access review advisory lock", log.BBError(err))
+		return
+	}
+	if !acquired {
+		slog.Debug("Access review advisory lock held by another replica, skipping")
+		return
+	}
+	defer func() {
+		if err := lock.Release(); err != nil {
+			slog.Error("Failed to release access review advisory lock", log.BBError(err))
+		}
+	}()
+
+	reviews, err := r.store.ListAccessReviews(ctx, &store.FindAccessReviewMessage{
+		State:     new(storepb.AccessReview_ACTIVE),
+		EndBefore: new(time.Now()),
+	})
+	if err != nil {
+		slog.Error("Failed to list ended access reviews", log.BBError(err))
+		return
+	}
+	for _, review := range reviews {
+		if err := Complete(ctx, r.store, review, ""); err != nil {
+			slog.Error("Failed to complete access review", slog.String("access_review", review.ID), log.BBError(err))
+		}
+	}
+}
@@ -162,49 +162,7 @@ func findApprovalTemplateForIssue(ctx context.Context, stores *store.Store, webh
 		return errors.Errorf("project %s not found", issue.ProjectID)
 	}
 
-	approvalTemplate, celVarsList, done, err := func() (*storepb.ApprovalTemplate, []map[string]any, bool, error) {
-		// no need to find if feature is not enabled
-		if licenseService.IsFeatureEnabled(ctx, project.Workspace, v1pb.PlanFeature_FEATURE_APPROVAL_WORKFLOW) != nil {
-			// nolint:nilerr
-			return nil, nil, true, nil
-		}
-
-		// Step 1: Determine approval source from issue type
-		approvalSource, err := getApprovalSourceFromIssue(ctx, stores, issue)
-		if err != nil {
-			return nil, nil, false, errors.Wrap(err, "failed to get approval source from issue")
-		}
-		if approvalSource == storepb.WorkspaceApprovalSetting_Rule_SOURCE_UNSPECIFIED {
-			// Cannot determine source, no approval needed
-			return nil, nil, true, nil
-		}
-
-		// Step 2: Build CEL variables for evaluation
-		celVarsList, done, err := buildCELVariablesForIssue(ctx, stores, issue)
-		if err != nil {
-			return nil, nil, false, errors.Wrap(err, "failed to build CEL variables for issue")
-		}
-		if !done {
-			// Not ready yet (e.g., waiting for plan check runs)
-			return nil, nil, false, nil
-		}
-
-		// Step 3: Inject risk level into CEL variables for CHANGE_DATABASE issues
-		// Risk level is calculated from statement types and injected so approval rules
-		// can use conditions like: risk_level == "HIGH"
-		if approvalSource == storepb.WorkspaceApprovalSetting_Rule_CHANGE_DATABASE {
-			riskLevel := calculateRiskLevelFromCELVars(celVarsList)
-			injectRiskLevelIntoCELVars(celVarsList, riskLevel)
-		}
-
-		// Step 4: Find matching approval template
-		approvalTemplate, err := getApprovalTemplate(approvalSetting, approvalSource, celVarsList)
-		if err != nil {
-			return nil, nil, false, errors.Wrapf(err, "failed to get approval template for source: %v", approvalSource)
-		}
-
-		return approvalTemplate, celVarsList, true, nil
-	}()
+	approvalTemplate, celVarsList, done, err := findApprovalTemplate(ctx, stores, licenseService, project, issue, approvalSetting)
 	if err != nil {
 		// Don't persist error - it will be logged by caller
 		// User can rerun plan check to retry
@@ -239,6 +197,72 @@ func findApprovalTemplateForIssue(ctx context.Context, stores *store.Store, webh
 	return nil
 }
 
+// PreviewApprovalTemplateForPlan finds the approval template and the risk level for the issue of the plan
+// without creating the issue. It returns false if the plan check run is still running.
+func PreviewApprovalTemplateForPlan(ctx context.Context, stores *store.Store, licenseService *enterprise.LicenseService, project *store.ProjectMessage, plan *store.PlanMessage) (*storepb.ApprovalTemplate, storepb.RiskLevel, bool, error) {
+	approvalSetting, err := stores.GetWorkspaceApprovalSetting(ctx, project.Workspace)
+	if err != nil {
+		return nil, storepb.RiskLevel_RISK_LEVEL_UNSPECIFIED, false, errors.Wrap(err, "failed to get workspace approval setting")
+	}
+	// The issue is transient, it's only used to build the CEL variables of the plan.
+	issue := &store.IssueMessage{
+		ProjectID: project.ResourceID,
+		PlanUID:   &plan.UID,
+		Type:      storepb.Issue_DATABASE_CHANGE,
+	}
+	approvalTemplate, celVarsList, done, err := findApprovalTemplate(ctx, stores, licenseService, project, issue, approvalSetting)
+	if err != nil || !done {
+		return nil, storepb.RiskLevel_RISK_LEVEL_UNSPECIFIED, done, err
+	}
+	return approvalTemplate, calculateRiskLevelFromCELVars(celVarsList), true, nil
+}
+
+// findApprovalTemplate finds the approval template for the issue.
+// It returns false if the issue is not ready for the approval finding, e.g. waiting for plan check runs.
+func findApprovalTemplate(ctx context.Context, stores *store.Store, licenseService *enterprise.LicenseService, project *store.ProjectMessage, issue *store.IssueMessage, approvalSetting *storepb.WorkspaceApprovalSetting) (*storepb.ApprovalTemplate, []map[string]any, bool, error) {
+	// no need to find if feature is not enabled
+	if licenseService.IsFeatureEnabled(ctx, project.Workspace, v1pb.PlanFeature_FEATURE_APPROVAL_WORKFLOW) != nil {
+		// nolint:nilerr
+		return nil, nil, true, nil
+	}
+
+	// Step 1: Determine approval source from issue type
+	approvalSource, err := getApprovalSourceFromIssue(ctx, stores, issue)
+	if err != nil {
+		return nil, nil, false, errors.Wrap(err, "failed to get approval source from issue")
+	}
+	if approvalSource == storepb.WorkspaceApprovalSetting_Rule_SOURCE_UNSPECIFIED {
+		// Cannot determine source, no approval needed
+		return nil, nil, true, nil
+	}
+
+	// Step 2: Build CEL variables for evaluation
+	celVarsList, done, err := buildCELVariablesForIssue(ctx, stores, issue)
+	if err != nil {
+		return nil, nil, false, errors.Wrap(err, "failed to build CEL variables for issue")
+	}
+	if !done {
+		// Not ready yet (e.g., waiting for plan check runs)
+		return nil, nil, false, nil
+	}
+
+	// Step 3: Inject risk level into CEL variables for CHANGE_DATABASE issues
+	// Risk level is calculated from statement types and injected so approval rules
+	// can use conditions like: risk_level == "HIGH"
+	if approvalSource == storepb.WorkspaceApprovalSetting_Rule_CHANGE_DATABASE {
+		riskLevel := calculateRiskLevelFromCELVars(celVarsList)
+		injectRiskLevelIntoCELVars(celVarsList, riskLevel)
+	}
+
+	// Step 4: Find matching approval template
+	approvalTemplate, err := getApprovalTemplate(approvalSetting, approvalSource, celVarsList)
+	if err != nil {
+		return nil, nil, false, errors.Wrapf(err, "failed to get approval template for source: %v", approvalSource)
+	}
+
+	return approvalTemplate, celVarsList, true, nil
+}
+
 // calculateRiskLevelFromCELVars calculates the risk level from CEL variables.
 // This is separated from approval flow generation to allow independent evolution.
 func calculateRiskLevelFromCELVars(celVarsList []map[string]any) storepb.RiskLevel {
@@ -508,6 +532,16 @@ func unfoldSpecTargets(ctx context.Context, stores *store.Store, specs []*storep
 					sheetSha256: config.ExportDataConfig.SheetSha256,
 				})
 			}
+
+		case *storepb.PlanConfig_Spec_CloneDatabaseConfig:
+			// Data leaves the source database, so approval is evaluated against it.
+			db := databaseMap[config.CloneDatabaseConfig.Source]
+			if db == nil {
+				return nil, errors.Errorf("database %q not found", config.CloneDatabaseConfig.Source)
+			}
+			targets = append(targets, specTarget{
+				database: db,
+			})
 		default:
 		}
 	}
@@ -862,7 +896,7 @@ func getApprovalSourceFromPlan(config *storepb.PlanConfig) storepb.WorkspaceAppr
 			return storepb.WorkspaceApprovalSetting_Rule_CREATE_DATABASE
 		case *storepb.PlanConfig_Spec_ChangeDatabaseConfig:
 			return storepb.WorkspaceApprovalSetting_Rule_CHANGE_DATABASE
-		case *storepb.PlanConfig_Spec_ExportDataConfig:
+		case *storepb.PlanConfig_Spec_ExportDataConfig, *storepb.PlanConfig_Spec_CloneDatabaseConfig:
 			return storepb.WorkspaceApprovalSetting_Rule_EXPORT_DATA
 		}
 	}
@@ -0,0 +1,150 @@
+package breakglass
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/webhook"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/runner/approval"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+const (
+	// maximumReviewQueries is the maximum number of session queries listed in the review issue.
+	maximumReviewQueries = 100
+	// maximumReviewStatementLength is the maximum length of a statement listed in the review issue.
+	maximumReviewStatementLength = 200
+)
+
+// CreateReviewIssue creates the post-hoc review issue of an ended break-glass access grant.
+// The issue lists the queries run in the session and requires the approval of a project owner.
+func CreateReviewIssue(ctx context.Context, stores *store.Store, webhookManager *webhook.Manager, grant *store.AccessGrantMessage) (*store.IssueMessage, error) {
+	project, err := stores.GetProjectByResourceID(ctx, grant.ProjectID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get project %q", grant.ProjectID)
+	}
+	if project == nil {
+		return nil, errors.Errorf("project %q not found", grant.ProjectID)
+	}
+
+	histories, err := stores.ListQueryHistories(ctx, &store.FindQueryHistoryMessage{
+		Project:       &grant.ProjectID,
+		AccessGrantID: &grant.ID,
+		Limit:         new(maximumReviewQueries + 1),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list the queries of access grant %q", grant.ID)
+	}
+
+	issue, err := stores.CreateIssue(ctx, &store.IssueMessage{
+		ProjectID:    grant.ProjectID,
+		CreatorEmail: grant.Creator,
+		Title:        fmt.Sprintf("Break-glass access review for %s", grant.Creator),
+		Type:         storepb.Issue_ACCESS_GRANT,
+		Description:  buildReviewDescription(grant, histories),
+		Payload: &storepb.Issue{
+			// The review is mandatory, so the approval flow is set here instead of
+			// being matched against the workspace approval rules.
+			Approval: &storepb.IssuePayloadApproval{
+				ApprovalFindingDone: true,
+				ApprovalTemplate: &storepb.ApprovalTemplate{
+					Title:       "Break-glass access review",
+					Description: "The break-glass access must be reviewed by a project owner.",
+					Flow: &storepb.ApprovalFlow{
+						Roles: []string{common.FormatRole(store.ProjectOwnerRole)},
+					},
+				},
+			},
+			AccessGrantId: grant.ID,
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create review issue")
+	}
+
+	grant.Payload.IssueId = issue.UID
+	if _, err := stores.UpdateAccessGrant(ctx, grant.ID, &store.UpdateAccessGrantMessage{
+		Payload: grant.Payload,
+	}); err != nil {
+		return nil, errors.Wrapf(err, "failed to update access grant payload")
+	}
+
+	creator, err := stores.GetAccountByEmail(ctx, grant.Creator)
+	if err != nil {
+		slog.Warn("failed to get break-glass access grant creator", slog.String("creator", grant.Creator), log.BBError(err))
+	} else if creator != nil {
+		webhookManager.CreateEvent(ctx, &webhook.Event{
+			Type:    storepb.Activity_ISSUE_CREATED,
+			Project: webhook.NewProject(project),
+			IssueCreated: &webhook.EventIssueCreated{
+				Creator: &webhook.User{
+					Name:  creator.Name,
+					Email: creator.Email,
+				},
+				Issue: webhook.NewIssue(issue),
+			},
+		})
+	}
+	approval.NotifyApprovalRequested(ctx, stores, webhookManager, issue, project)
+
+	return issue, nil
+}
+
+// getEndTime returns the time when the break-glass access grant ended,
+// which is the earlier of the expiration and the revocation.
+func getEndTime(grant *store.AccessGrantMessage) time.Time {
+	if grant.ExpireTime == nil {
+		return grant.UpdatedAt
+	}
+	if grant.Status != storepb.AccessGrant_REVOKED || grant.ExpireTime.Before(grant.UpdatedAt) {
+		return *grant.ExpireTime
+	}
+	return grant.UpdatedAt
+}
+
+func buildReviewDescription(grant *store.AccessGrantMessage, histories []*store.QueryHistoryMessage) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s used break-glass access on %s from %s to %s.\n",
+		grant.Creator,
+		strings.Join(grant.Payload.GetTargets(), ", "),
+		grant.CreatedAt.UTC().Format(time.RFC3339),
+		getEndTime(grant).UTC().Format(time.RFC3339),
+	)
+	if grant.Status == storepb.AccessGrant_REVOKED {
+		b.WriteString("The access was revoked before it expired.\n")
+	}
+	if grant.Payload.GetAdmin() {
+		b.WriteString("The access allowed running statements in the admin mode.\n")
+	}
+	fmt.Fprintf(&b, "\nReason: %s\n", grant.Payload.GetReason())
+
+	if len(histories) == 0 {
+		b.WriteString("\nNo query was run in the session.\n")
+		return b.String()
+	}
+	b.WriteString("\nQueries run in the session, most recent first:\n")
+	for i, history := range histories {
+		if i == maximumReviewQueries {
+			fmt.Fprintf(&b, "- ... more queries are in the query history tagged with %s\n", common.FormatAccessGrant(grant.ProjectID, grant.ID))
+			break
+		}
+		statement := strings.Join(strings.Fields(history.Statement), " ")
+		if truncated, ok := common.TruncateString(statement, maximumReviewStatementLength); ok {
+			statement = truncated + "..."
+		}
+		status := "succeeded"
+		if history.Payload.GetError() != "" {
+			status = "failed"
+		}
+		fmt.Fprintf(&b, "- %s %s (%s): %s\n", history.CreatedAt.UTC().Format(time.RFC3339), history.Database, status, statement)
+	}
+	return b.String()
+}
@@ -0,0 +1,79 @@
+package breakglass
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+func TestGetEndTime(t *testing.T) {
+	a := require.New(t)
+	createTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	expireTime := createTime.Add(time.Hour)
+	revokeTime := createTime.Add(30 * time.Minute)
+
+	grant := &store.AccessGrantMessage{
+		Status:     storepb.AccessGrant_ACTIVE,
+		ExpireTime: &expireTime,
+		UpdatedAt:  createTime,
+	}
+	a.Equal(expireTime, getEndTime(grant))
+
+	grant.Status = storepb.AccessGrant_REVOKED
+	grant.UpdatedAt = revokeTime
+	a.Equal(revokeTime, getEndTime(grant))
+
+	// Revoking an expired grant doesn't extend the session.
+	grant.UpdatedAt = expireTime.Add(time.Hour)
+	a.Equal(expireTime, getEndTime(grant))
+}
+
+func TestBuildReviewDescription(t *testing.T) {
+	a := require.New(t)
+	createTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	expireTime := createTime.Add(time.Hour)
+	grant := &store.AccessGrantMessage{
+		ID:         "grant",
+		ProjectID:  "db",
+		Creator:    "oncall@example.com",
+		Status:     storepb.AccessGrant_ACTIVE,
+		ExpireTime: &expireTime,
+		CreatedAt:  createTime,
+		Payload: &storepb.AccessGrantPayload{
+			Targets:    []string{"instances/prod/databases/orders"},
+			Reason:     "INC-42",
+			BreakGlass: true,
+			Admin:      true,
+		},
+	}
+
+	description := buildReviewDescription(grant, nil)
+	a.Contains(description, "oncall@example.com used break-glass access on instances/prod/databases/orders from 2026-01-01T00:00:00Z to 2026-01-01T01:00:00Z.")
+	a.Contains(description, "admin mode")
+	a.Contains(description, "Reason: INC-42")
+	a.Contains(description, "No query was run in the session.")
+
+	histories := []*store.QueryHistoryMessage{
+		{
+			Database:  "instances/prod/databases/orders",
+			Statement: "UPDATE orders\n  SET status = 'done'\n  WHERE id = 1",
+			CreatedAt: createTime.Add(10 * time.Minute),
+			Payload:   &storepb.QueryHistoryPayload{Error: new("syntax error")},
+		},
+		{
+			Database:  "instances/prod/databases/orders",
+			Statement: "SELECT " + strings.Repeat("x", maximumReviewStatementLength),
+			CreatedAt: createTime.Add(5 * time.Minute),
+			Payload:   &storepb.QueryHistoryPayload{},
+		},
+	}
+	description = buildReviewDescription(grant, histories)
+	a.Contains(description, "- 2026-01-01T00:10:00Z instances/prod/databases/orders (failed): UPDATE orders SET status = 'done' WHERE id = 1\n")
+	a.Contains(description, "(succeeded): SELECT "+strings.Repeat("x", maximumReviewStatementLength-len("SELECT "))+"...\n")
+	a.NotContains(description, "more queries")
+}
@@ -0,0 +1,99 @@
+// Package breakglass is a runner that creates the review issues of the ended break-glass access grants.
+package breakglass
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/webhook"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+const breakGlassReviewInterval = time.Minute
+
+// Runner creates the review issues of the break-glass access grants that have expired or been revoked.
+type Runner struct {
+	store          *store.Store
+	webhookManager *webhook.Manager
+}
+
+// NewRunner creates a break-glass review runner.
+func NewRunner(store *store.Store, webhookManager *webhook.Manager) *Runner {
+	return &Runner{
+		store:          store,
+		webhookManager: webhookManager,
+	}
+}
+
+// Run starts the break-glass review runner.
+func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	ticker := time.NewTicker(breakGlassReviewInterval)
+	defer ticker.Stop()
+
+	slog.Debug(fmt.Sprintf("Break-glass review runner started and will run every %v", breakGlassReviewInterval))
+
+	for {
+		select {
+		case <-ticker.C:
+			r.createReviewIssues(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *Runner) createReviewIssues(ctx context.Context) {
+	defer func() {
+		if p := recover(); p != nil {
+			err, ok := p.(error)
+			if !ok {
+				err = errors.Errorf("%v", p)
+			}
+			slog.Error("Break-glass review runner PANIC RECOVER", log.BBError(err), log.BBStack("panic-stack"))
+		}
+	}()
+
+	lock, acquired, err := store.TryAdvisoryLock(ctx, r.store.GetDB(), store.AdvisoryLockKeyBreakGlassReview)
+	if err != nil {
+		slog.Error("Failed to acquire break-glass review advisory lock", log.BBError(err))
+		return
+	}
+	if !acquired {
+		slog.Debug("Break-glass review advisory lock held by another replica, skipping")
+		return
+	}
+	defer func() {
+		if err := lock.Release(); err != nil {
+			slog.Error("Failed to release break-glass review advisory lock", log.BBError(err))
+		}
+	}()
+
+	filterQ, err := store.GetListAccessGrantFilter(fmt.Sprintf(
+		`break_glass == true && has_issue == false && (status == "REVOKED" || expire_time <= %q)`,
+		time.Now().UTC().Format(time.RFC3339),
+	))
+	if err != nil {
+		slog.Error("Failed to build break-glass access grant filter", log.BBError(err))
+		return
+	}
+	grants, err := r.store.ListAccessGrants(ctx, &store.FindAccessGrantMessage{
+		FilterQ: filterQ,
+	})
+	if err != nil {
+		slog.Error("Failed to list ended break-glass access grants", log.BBError(err))
+		return
+	}
+	for _, grant := range grants {
+		if _, err := CreateReviewIssue(ctx, r.store, r.webhookManager, grant); err != nil {
+			slog.Error("Failed to create break-glass review issue", slog.String("access_grant", grant.ID), log.BBError(err))
+		}
+	}
+}
@@ -18,6 +18,7 @@ const (
 	planCheckRunTimeout          = 10 * time.Minute
 	heartbeatRetentionPeriod     = 1 * time.Hour
 	exportArchiveRetentionPeriod = 24 * time.Hour
+	operationRetentionPeriod     = 24 * time.Hour
 	oauth2ClientRetentionPeriod  = 30 * 24 * time.Hour // 30 days of inactivity
 )
 
@@ -53,6 +54,7 @@ func (c *DataCleaner) Run(ctx context.Context, wg *sync.WaitGroup) {
 	c.cleanup(ctx)
 	c.detectStaleTaskRuns(ctx)
 	c.detectStalePlanCheckRuns(ctx)
+	c.detectStaleOperations(ctx)
 
 	for {
 		select {
@@ -69,6 +71,7 @@ func (c *DataCleaner) Run(ctx context.Context, wg *sync.WaitGroup) {
 			}
 			c.detectStaleTaskRuns(ctx)
 			c.detectStalePlanCheckRuns(ctx)
+			c.detectStaleOperations(ctx)
 		case <-ctx.Done():
 			return
 		}
@@ -77,10 +80,12 @@ func (c *DataCleaner) Run(ctx context.Context, wg *sync.WaitGroup) {
 
 func (c *DataCleaner) cleanup(ctx context.Context) {
 	c.cleanupExportArchives(ctx)
+	c.cleanupQueryResultShares(ctx)
 	c.cleanupOAuth2Data(ctx)
 	c.cleanupWebRefreshTokens(ctx)
 	c.cleanupEmailVerificationCodes(ctx)
 	c.cleanupStaleHeartbeats(ctx)
+	c.cleanupOperations(ctx)
 }
 
 func (c *DataCleaner) detectStaleTaskRuns(ctx context.Context) {
@@ -105,6 +110,17 @@ func (c *DataCleaner) detectStalePlanCheckRuns(ctx context.Context) {
 	}
 }
 
+func (c *DataCleaner) detectStaleOperations(ctx context.Context) {
+	rowsAffected, err := c.store.FailStaleOperations(ctx, stalenessThreshold)
+	if err != nil {
+		slog.Error("Failed to detect stale operations", log.BBError(err))
+		return
+	}
+	if rowsAffected > 0 {
+		slog.Info("Marked stale operations as failed", slog.Int64("count", rowsAffected))
+	}
+}
+
 func (c *DataCleaner) cleanupStaleHeartbeats(ctx context.Context) {
 	rowsAffected, err := c.store.DeleteStaleReplicaHeartbeats(ctx, heartbeatRetentionPeriod)
 	if err != nil {
@@ -127,6 +143,28 @@ func (c *DataCleaner) cleanupExportArchives(ctx context.Context) {
 	}
 }
 
+func (c *DataCleaner) cleanupOperations(ctx context.Context) {
+	rowsAffected, err := c.store.DeleteExpiredOperations(ctx, operationRetentionPeriod)
+	if err != nil {
+		slog.Error("Failed to clean up expired operations", log.BBError(err))
+		return
+	}
+	if rowsAffected > 0 {
+		slog.Info("Cleaned up expired operations", slog.Int64("count", rowsAffected))
+	}
+}
+
+func (c *DataCleaner) cleanupQueryResultShares(ctx context.Context) {
+	rowsAffected, err := c.store.DeleteExpiredQueryResultSharesAll(ctx)
+	if err != nil {
+		slog.Error("Failed to clean up expired query result shares", log.BBError(err))
+		return
+	}
+	if rowsAffected > 0 {
+		slog.Info("Cleaned up expired query result shares", slog.Int64("count", rowsAffected))
+	}
+}
+
 func (c *DataCleaner) cleanupOAuth2Data(ctx context.Context) {
 	// Clean up expired authorization codes
 	if rowsAffected, err := c.store.DeleteExpiredOAuth2AuthorizationCodes(ctx); err != nil {
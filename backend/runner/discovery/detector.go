@@ -0,0 +1,215 @@
+package discovery
+
+import (
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+const defaultMinMatchRatio = 0.8
+
+// Detector matches columns against a configured detector.
+type Detector struct {
+	config     *storepb.DataClassificationSetting_DataClassificationConfig_Detector
+	columnName *regexp.Regexp
+	comment    *regexp.Regexp
+	value      *regexp.Regexp
+	dictionary map[string]bool
+}
+
+// NewDetector compiles the detector config.
+func NewDetector(config *storepb.DataClassificationSetting_DataClassificationConfig_Detector) (*Detector, error) {
+	if config.Id == "" {
+		return nil, errors.New("detector id is required")
+	}
+	if config.ClassificationId == "" && config.SemanticTypeId == "" {
+		return nil, errors.Errorf("detector %q must propose a classification or a semantic type", config.Id)
+	}
+	if config.MinMatchRatio < 0 || config.MinMatchRatio > 1 {
+		return nil, errors.Errorf("detector %q has invalid min match ratio %v", config.Id, config.MinMatchRatio)
+	}
+	d := &Detector{config: config}
+	var err error
+	if d.columnName, err = compilePattern(config.ColumnNamePattern); err != nil {
+		return nil, errors.Wrapf(err, "detector %q has invalid column name pattern", config.Id)
+	}
+	if d.comment, err = compilePattern(config.CommentPattern); err != nil {
+		return nil, errors.Wrapf(err, "detector %q has invalid comment pattern", config.Id)
+	}
+	if config.ValuePattern != "" {
+		// Value patterns match the whole value.
+		if d.value, err = regexp.Compile(`^(?:` + config.ValuePattern + `)$`); err != nil {
+			return nil, errors.Wrapf(err, "detector %q has invalid value pattern", config.Id)
+		}
+	}
+	if len(config.Dictionary) > 0 {
+		d.dictionary = make(map[string]bool, len(config.Dictionary))
+		for _, word := range config.Dictionary {
+			d.dictionary[strings.ToLower(strings.TrimSpace(word))] = true
+		}
+	}
+	if d.columnName == nil && d.comment == nil && !d.HasValueRules() {
+		return nil, errors.Errorf("detector %q has no rules", config.Id)
+	}
+	return d, nil
+}
+
+// NewDetectors compiles the detectors of the classification config.
+func NewDetectors(config *storepb.DataClassificationSetting_DataClassificationConfig) ([]*Detector, error) {
+	var detectors []*Detector
+	ids := make(map[string]bool)
+	for _, c := range config.GetDetectors() {
+		if ids[c.Id] {
+			return nil, errors.Errorf("duplicate detector id %q", c.Id)
+		}
+		ids[c.Id] = true
+		if c.ClassificationId != "" {
+			if _, ok := config.Classification[c.ClassificationId]; !ok {
+				return nil, errors.Errorf("detector %q references unknown classification %q", c.Id, c.ClassificationId)
+			}
+		}
+		d, err := NewDetector(c)
+		if err != nil {
+			return nil, err
+		}
+		detectors = append(detectors, d)
+	}
+	return detectors, nil
+}
+
+func compilePattern(pattern string) (*regexp.Regexp, error) {
+	if pattern == "" {
+		return nil, nil
+	}
+	return regexp.Compile(pattern)
+}
+
+// Config returns the detector config.
+func (d *Detector) Config() *storepb.DataClassificationSetting_DataClassificationConfig_Detector {
+	return d.config
+}
+
+// HasValueRules returns true if the detector needs sampled values.
+func (d *Detector) HasValueRules() bool {
+	return d.value != nil || d.dictionary != nil || d.config.Checksum != storepb.DataClassificationSetting_DataClassificationConfig_Detector_CHECKSUM_UNSPECIFIED
+}
+
+// MatchColumn matches the column name and comment.
+// It returns MATCH_SOURCE_UNSPECIFIED if neither matches.
+func (d *Detector) MatchColumn(name, comment string) storepb.ClassificationSuggestionPayload_MatchSource {
+	if d.columnName != nil && d.columnName.MatchString(name) {
+		return storepb.ClassificationSuggestionPayload_COLUMN_NAME
+	}
+	if d.comment != nil && comment != "" && d.comment.MatchString(comment) {
+		return storepb.ClassificationSuggestionPayload_COLUMN_COMMENT
+	}
+	return storepb.ClassificationSuggestionPayload_MATCH_SOURCE_UNSPECIFIED
+}
+
+// MatchValues matches the sampled values against the value rules.
+// Empty values are ignored. It returns the match ratio and the number of non-empty values.
+func (d *Detector) MatchValues(values []string) (bool, float64, int) {
+	if !d.HasValueRules() {
+		return false, 0, 0
+	}
+	count, matched := 0, 0
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+		count++
+		if d.matchValue(value) {
+			matched++
+		}
+	}
+	if count == 0 {
+		return false, 0, 0
+	}
+	minMatchRatio := d.config.MinMatchRatio
+	if minMatchRatio == 0 {
+		minMatchRatio = defaultMinMatchRatio
+	}
+	ratio := float64(matched) / float64(count)
+	return ratio >= minMatchRatio, ratio, count
+}
+
+func (d *Detector) matchValue(value string) bool {
+	if d.value != nil && !d.value.MatchString(value) {
+		return false
+	}
+	if d.dictionary != nil && !d.dictionary[strings.ToLower(value)] {
+		return false
+	}
+	switch d.config.Checksum {
+	case storepb.DataClassificationSetting_DataClassificationConfig_Detector_LUHN:
+		return isLuhn(value)
+	case storepb.DataClassificationSetting_DataClassificationConfig_Detector_IBAN:
+		return isIBAN(value)
+	default:
+	}
+	return true
+}
+
+// isLuhn validates the Luhn checksum of a number with 12 to 19 digits, ignoring spaces and dashes.
+func isLuhn(value string) bool {
+	var digits []int
+	for _, r := range value {
+		switch {
+		case r >= '0' && r <= '9':
+			digits = append(digits, int(r-'0'))
+		case r == ' ' || r == '-':
+		default:
+			return false
+		}
+	}
+	if len(digits) < 12 || len(digits) > 19 {
+		return false
+	}
+	sum := 0
+	for i := range digits {
+		digit := digits[len(digits)-1-i]
+		if i%2 == 1 {
+			digit *= 2
+			if digit > 9 {
+				digit -= 9
+			}
+		}
+		sum += digit
+	}
+	return sum%10 == 0
+}
+
+// isIBAN validates the ISO 13616 checksum of an IBAN, ignoring spaces.
+func isIBAN(value string) bool {
+	iban := strings.ToUpper(strings.ReplaceAll(value, " ", ""))
+	if len(iban) < 15 || len(iban) > 34 {
+		return false
+	}
+	for i, r := range iban {
+		isLetter := r >= 'A' && r <= 'Z'
+		isDigit := r >= '0' && r <= '9'
+		if (i < 2 && !isLetter) || (i >= 2 && i < 4 && !isDigit) || (!isLetter && !isDigit) {
+			return false
+		}
+	}
+	// Move the country code and check digits to the end, and replace letters with numbers.
+	var numeric strings.Builder
+	for _, r := range iban[4:] + iban[:4] {
+		if r >= 'A' && r <= 'Z' {
+			numeric.WriteString(strconv.Itoa(int(r-'A') + 10))
+		} else {
+			numeric.WriteRune(r)
+		}
+	}
+	n, ok := new(big.Int).SetString(numeric.String(), 10)
+	if !ok {
+		return false
+	}
+	return new(big.Int).Mod(n, big.NewInt(97)).Int64() == 1
+}
@@ -0,0 +1,103 @@
+package discovery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+func TestDetectorMatchColumn(t *testing.T) {
+	a := require.New(t)
+	d, err := NewDetector(&storepb.DataClassificationSetting_DataClassificationConfig_Detector{
+		Id:                "email",
+		ColumnNamePattern: `(?i)e_?mail`,
+		CommentPattern:    `(?i)email address`,
+		ClassificationId:  "1-1",
+	})
+	a.NoError(err)
+	a.False(d.HasValueRules())
+	a.Equal(storepb.ClassificationSuggestionPayload_COLUMN_NAME, d.MatchColumn("user_Email", ""))
+	a.Equal(storepb.ClassificationSuggestionPayload_COLUMN_COMMENT, d.MatchColumn("contact", "The Email Address of the user"))
+	a.Equal(storepb.ClassificationSuggestionPayload_MATCH_SOURCE_UNSPECIFIED, d.MatchColumn("name", ""))
+}
+
+func TestDetectorMatchValues(t *testing.T) {
+	a := require.New(t)
+	card, err := NewDetector(&storepb.DataClassificationSetting_DataClassificationConfig_Detector{
+		Id:             "card",
+		Checksum:       storepb.DataClassificationSetting_DataClassificationConfig_Detector_LUHN,
+		SemanticTypeId: "card",
+	})
+	a.NoError(err)
+	matched, ratio, count := card.MatchValues([]string{"4111 1111 1111 1111", "5500-0000-0000-0004", "", "1234567812345678"})
+	a.False(matched)
+	a.InDelta(2.0/3, ratio, 0.001)
+	a.Equal(3, count)
+	matched, _, _ = card.MatchValues([]string{"4111111111111111", "378282246310005", "6011111111111117", "5105105105105100", "1"})
+	a.True(matched)
+
+	iban, err := NewDetector(&storepb.DataClassificationSetting_DataClassificationConfig_Detector{
+		Id:               "iban",
+		Checksum:         storepb.DataClassificationSetting_DataClassificationConfig_Detector_IBAN,
+		MinMatchRatio:    1,
+		ClassificationId: "1-2",
+	})
+	a.NoError(err)
+	matched, _, _ = iban.MatchValues([]string{"GB82 WEST 1234 5698 7654 32", "DE89370400440532013000"})
+	a.True(matched)
+	matched, _, _ = iban.MatchValues([]string{"GB82 WEST 1234 5698 7654 33"})
+	a.False(matched)
+
+	country, err := NewDetector(&storepb.DataClassificationSetting_DataClassificationConfig_Detector{
+		Id:               "country",
+		ValuePattern:     `[A-Za-z ]+`,
+		Dictionary:       []string{"France", "Germany", "Japan"},
+		ClassificationId: "1-3",
+	})
+	a.NoError(err)
+	matched, ratio, _ = country.MatchValues([]string{"france", "GERMANY", "Japan", "Mars", "japan"})
+	a.True(matched)
+	a.InDelta(0.8, ratio, 0.001)
+	// Empty samples never match.
+	matched, _, _ = country.MatchValues(nil)
+	a.False(matched)
+}
+
+func TestNewDetectors(t *testing.T) {
+	a := require.New(t)
+	config := &storepb.DataClassificationSetting_DataClassificationConfig{
+		Classification: map[string]*storepb.DataClassificationSetting_DataClassificationConfig_DataClassification{
+			"1-1": {Id: "1-1"},
+		},
+		Detectors: []*storepb.DataClassificationSetting_DataClassificationConfig_Detector{
+			{Id: "email", ColumnNamePattern: "email", ClassificationId: "1-1"},
+		},
+	}
+	detectors, err := NewDetectors(config)
+	a.NoError(err)
+	a.Len(detectors, 1)
+
+	for _, detector := range []*storepb.DataClassificationSetting_DataClassificationConfig_Detector{
+		{Id: "email", ColumnNamePattern: "email", ClassificationId: "1-1"},
+		{Id: "unknown", ColumnNamePattern: "email", ClassificationId: "9-9"},
+		{Id: "invalid", ColumnNamePattern: "(", ClassificationId: "1-1"},
+		{Id: "empty", ClassificationId: "1-1"},
+		{Id: "no-proposal", ColumnNamePattern: "email"},
+		{Id: "ratio", ValuePattern: "x", MinMatchRatio: 2, ClassificationId: "1-1"},
+	} {
+		_, err := NewDetectors(&storepb.DataClassificationSetting_DataClassificationConfig{
+			Classification: config.Classification,
+			Detectors:      append(config.Detectors, detector),
+		})
+		a.Error(err, detector.Id)
+	}
+}
+
+func TestGetSampleStatement(t *testing.T) {
+	a := require.New(t)
+	a.Equal(`SELECT "a""b", "c" FROM "public"."t"`, getSampleStatement(getQuoteFunc(storepb.Engine_POSTGRES), "public", "t", []string{`a"b`, "c"}))
+	a.Equal("SELECT `a` FROM `t`", getSampleStatement(getQuoteFunc(storepb.Engine_MYSQL), "", "t", []string{"a"}))
+	a.Nil(getQuoteFunc(storepb.Engine_MONGODB))
+}
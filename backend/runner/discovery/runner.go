@@ -0,0 +1,376 @@
+// Package discovery is a runner that proposes column classifications by sensitive data discovery.
+package discovery
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/dbfactory"
+	"github.com/bytebase/bytebase/backend/enterprise"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+const (
+	discoveryInterval = 24 * time.Hour
+	databaseTimeout   = 10 * time.Minute
+	// sampleSize is the maximum number of rows sampled from each table.
+	sampleSize = 100
+)
+
+// Runner samples unclassified columns and records classification suggestions.
+type Runner struct {
+	store          *store.Store
+	dbFactory      *dbfactory.DBFactory
+	licenseService *enterprise.LicenseService
+}
+
+// NewRunner creates a sensitive data discovery runner.
+func NewRunner(store *store.Store, dbFactory *dbfactory.DBFactory, licenseService *enterprise.LicenseService) *Runner {
+	return &Runner{
+		store:          store,
+		dbFactory:      dbFactory,
+		licenseService: licenseService,
+	}
+}
+
+// Run starts the sensitive data discovery runner.
+func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	ticker := time.NewTicker(discoveryInterval)
+	defer ticker.Stop()
+
+	slog.Debug(fmt.Sprintf("Sensitive data discovery runner started and will run every %v", discoveryInterval))
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := r.licenseService.CheckReplicaLimit(ctx); err != nil {
+				slog.Warn("Sensitive data discovery skipped due to HA license restriction", log.BBError(err))
+				continue
+			}
+			r.discoverAll(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *Runner) discoverAll(ctx context.Context) {
+	defer func() {
+		if p := recover(); p != nil {
+			err, ok := p.(error)
+			if !ok {
+				err = errors.Errorf("%v", p)
+			}
+			slog.Error("Sensitive data discovery PANIC RECOVER", log.BBError(err), log.BBStack("panic-stack"))
+		}
+	}()
+
+	lock, acquired, err := store.TryAdvisoryLock(ctx, r.store.GetDB(), store.AdvisoryLockKeySensitiveDataDiscovery)
+	if err != nil {
+		slog.Error("Failed to acquire sensitive data discovery advisory lock", log.BBError(err))
+		return
+	}
+	if !acquired {
+		slog.Debug("Sensitive data discovery advisory lock held by another replica, skipping")
+		return
+	}
+	defer func() {
+		if err := lock.Release(); err != nil {
+			slog.Error("Failed to release sensitive data discovery advisory lock", log.BBError(err))
+		}
+	}()
+
+	instances, err := r.store.ListAllInstances(ctx, false)
+	if err != nil {
+		slog.Error("Failed to list instances", log.BBError(err))
+		return
+	}
+	// The detectors of each workspace, keyed by the classification config id.
+	workspaceDetectors := make(map[string]map[string][]*Detector)
+	for _, instance := range instances {
+		detectors, ok := workspaceDetectors[instance.Workspace]
+		if !ok {
+			detectors = r.getWorkspaceDetectors(ctx, instance.Workspace)
+			workspaceDetectors[instance.Workspace] = detectors
+		}
+		if len(detectors) == 0 {
+			continue
+		}
+		instanceID := instance.ResourceID
+		databases, err := r.store.ListDatabases(ctx, &store.FindDatabaseMessage{InstanceID: &instanceID})
+		if err != nil {
+			slog.Error("Failed to list databases", slog.String("instance", instance.ResourceID), log.BBError(err))
+			continue
+		}
+		for _, database := range databases {
+			project, err := r.store.GetProjectByResourceID(ctx, database.ProjectID)
+			if err != nil {
+				slog.Error("Failed to get project", slog.String("project", database.ProjectID), log.BBError(err))
+				continue
+			}
+			if project == nil || project.Setting.GetDataClassificationConfigId() == "" {
+				continue
+			}
+			projectDetectors := detectors[project.Setting.GetDataClassificationConfigId()]
+			if len(projectDetectors) == 0 {
+				continue
+			}
+			if err := r.discoverDatabase(ctx, instance, database, projectDetectors); err != nil {
+				slog.Debug("Failed to discover sensitive data",
+					slog.String("instance", database.InstanceID),
+					slog.String("database", database.DatabaseName),
+					log.BBError(err))
+			}
+		}
+	}
+}
+
+func (r *Runner) getWorkspaceDetectors(ctx context.Context, workspace string) map[string][]*Detector {
+	if err := r.licenseService.IsFeatureEnabled(ctx, workspace, v1pb.PlanFeature_FEATURE_DATA_CLASSIFICATION); err != nil {
+		return nil
+	}
+	setting, err := r.store.GetDataClassificationSetting(ctx, workspace)
+	if err != nil {
+		slog.Error("Failed to get data classification setting", slog.String("workspace", workspace), log.BBError(err))
+		return nil
+	}
+	detectors := make(map[string][]*Detector)
+	for _, config := range setting.GetConfigs() {
+		configDetectors, err := NewDetectors(config)
+		if err != nil {
+			slog.Warn("Invalid sensitive data detectors", slog.String("workspace", workspace), slog.String("config", config.Id), log.BBError(err))
+			continue
+		}
+		if len(configDetectors) > 0 {
+			detectors[config.Id] = configDetectors
+		}
+	}
+	return detectors
+}
+
+// sampleTable is a table with the columns whose values need to be sampled.
+type sampleTable struct {
+	schema  string
+	table   string
+	columns []string
+}
+
+func (r *Runner) discoverDatabase(ctx context.Context, instance *store.InstanceMessage, database *store.DatabaseMessage, detectors []*Detector) error {
+	dbMetadata, err := r.store.GetDBSchema(ctx, &store.FindDBSchemaMessage{
+		InstanceID:   database.InstanceID,
+		DatabaseName: database.DatabaseName,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get database schema")
+	}
+	if dbMetadata == nil {
+		return nil
+	}
+	existing, err := r.store.ListClassificationSuggestions(ctx, &store.FindClassificationSuggestionMessage{
+		InstanceID:   database.InstanceID,
+		DatabaseName: database.DatabaseName,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to list classification suggestions")
+	}
+	suggested := make(map[string]bool)
+	for _, suggestion := range existing {
+		suggested[getColumnKey(suggestion.Schema, suggestion.Table, suggestion.Column)] = true
+	}
+	classified := getClassifiedColumns(dbMetadata.GetConfig())
+
+	needSample := false
+	for _, d := range detectors {
+		if d.HasValueRules() {
+			needSample = true
+		}
+	}
+	canSample := needSample && getQuoteFunc(instance.Metadata.GetEngine()) != nil
+
+	var sampleTables []*sampleTable
+	for _, schema := range dbMetadata.GetProto().GetSchemas() {
+		for _, table := range schema.GetTables() {
+			st := &sampleTable{schema: schema.Name, table: table.Name}
+			for _, column := range table.GetColumns() {
+				key := getColumnKey(schema.Name, table.Name, column.Name)
+				if classified[key] || suggested[key] {
+					continue
+				}
+				if d, source := matchColumn(detectors, column.Name, column.Comment); d != nil {
+					if err := r.store.UpsertClassificationSuggestion(ctx, newSuggestion(database, schema.Name, table.Name, column.Name, d, &storepb.ClassificationSuggestionPayload{
+						DetectorId:  d.config.Id,
+						MatchSource: source,
+					})); err != nil {
+						return err
+					}
+					continue
+				}
+				if canSample {
+					st.columns = append(st.columns, column.Name)
+				}
+			}
+			if len(st.columns) > 0 {
+				sampleTables = append(sampleTables, st)
+			}
+		}
+	}
+	if len(sampleTables) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, databaseTimeout)
+	defer cancel()
+	driver, err := r.dbFactory.GetAdminDatabaseDriver(ctx, instance, database, db.ConnectionContext{})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get driver")
+	}
+	defer driver.Close(ctx)
+	conn, err := driver.GetDB().Conn(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get connection")
+	}
+	defer conn.Close()
+
+	for _, st := range sampleTables {
+		statement := getSampleStatement(getQuoteFunc(instance.Metadata.GetEngine()), st.schema, st.table, st.columns)
+		results, err := driver.QueryConn(ctx, conn, statement, db.QueryContext{Limit: sampleSize, Schema: st.schema})
+		if err != nil {
+			slog.Debug("Failed to sample table",
+				slog.String("instance", database.InstanceID),
+				slog.String("database", database.DatabaseName),
+				slog.String("table", st.table),
+				log.BBError(err))
+			continue
+		}
+		if len(results) != 1 || results[0].Error != "" {
+			continue
+		}
+		for i, column := range st.columns {
+			values := getColumnValues(results[0], i)
+			for _, d := range detectors {
+				matched, ratio, count := d.MatchValues(values)
+				if !matched {
+					continue
+				}
+				if err := r.store.UpsertClassificationSuggestion(ctx, newSuggestion(database, st.schema, st.table, column, d, &storepb.ClassificationSuggestionPayload{
+					DetectorId:  d.config.Id,
+					MatchSource: storepb.ClassificationSuggestionPayload_VALUE,
+					MatchRatio:  ratio,
+					SampleCount: int32(count),
+				})); err != nil {
+					return err
+				}
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// matchColumn returns the first detector matching the column name or comment.
+func matchColumn(detectors []*Detector, name, comment string) (*Detector, storepb.ClassificationSuggestionPayload_MatchSource) {
+	for _, d := range detectors {
+		if source := d.MatchColumn(name, comment); source != storepb.ClassificationSuggestionPayload_MATCH_SOURCE_UNSPECIFIED {
+			return d, source
+		}
+	}
+	return nil, storepb.ClassificationSuggestionPayload_MATCH_SOURCE_UNSPECIFIED
+}
+
+func newSuggestion(database *store.DatabaseMessage, schema, table, column string, d *Detector, payload *storepb.ClassificationSuggestionPayload) *store.ClassificationSuggestionMessage {
+	return &store.ClassificationSuggestionMessage{
+		InstanceID:     database.InstanceID,
+		DatabaseName:   database.DatabaseName,
+		Schema:         schema,
+		Table:          table,
+		Column:         column,
+		Classification: d.config.ClassificationId,
+		SemanticType:   d.config.SemanticTypeId,
+		Payload:        payload,
+	}
+}
+
+func getColumnKey(schema, table, column string) string {
+	return strings.Join([]string{schema, table, column}, "\x00")
+}
+
+// getClassifiedColumns returns the columns with a classification or semantic type in the catalog.
+func getClassifiedColumns(config *storepb.DatabaseConfig) map[string]bool {
+	classified := make(map[string]bool)
+	for _, schema := range config.GetSchemas() {
+		for _, table := range schema.GetTables() {
+			for _, column := range table.GetColumns() {
+				if column.Classification != "" || column.SemanticType != "" {
+					classified[getColumnKey(schema.Name, table.Name, column.Name)] = true
+				}
+			}
+		}
+	}
+	return classified
+}
+
+// getQuoteFunc returns the identifier quote function of the engine, or nil if sampling is not supported.
+func getQuoteFunc(engine storepb.Engine) func(string) string {
+	switch engine {
+	case storepb.Engine_MYSQL, storepb.Engine_TIDB, storepb.Engine_MARIADB, storepb.Engine_OCEANBASE:
+		return func(s string) string { return "`" + strings.ReplaceAll(s, "`", "``") + "`" }
+	case storepb.Engine_POSTGRES, storepb.Engine_REDSHIFT, storepb.Engine_COCKROACHDB, storepb.Engine_ORACLE, storepb.Engine_SNOWFLAKE:
+		return func(s string) string { return `"` + strings.ReplaceAll(s, `"`, `""`) + `"` }
+	case storepb.Engine_MSSQL:
+		return func(s string) string { return "[" + strings.ReplaceAll(s, "]", "]]") + "]" }
+	default:
+		return nil
+	}
+}
+
+// getSampleStatement returns the statement selecting the columns of the table.
+// The row limit is applied by the driver.
+func getSampleStatement(quote func(string) string, schema, table string, columns []string) string {
+	var quoted []string
+	for _, column := range columns {
+		quoted = append(quoted, quote(column))
+	}
+	from := quote(table)
+	if schema != "" {
+		from = quote(schema) + "." + from
+	}
+	return fmt.Sprintf("SELECT %s FROM %s", strings.Join(quoted, ", "), from)
+}
+
+// getColumnValues returns the sampled values of the i-th column as strings.
+// Values that are not strings or integers are ignored.
+func getColumnValues(result *v1pb.QueryResult, i int) []string {
+	var values []string
+	for _, row := range result.Rows {
+		if i >= len(row.Values) {
+			continue
+		}
+		switch v := row.Values[i].Kind.(type) {
+		case *v1pb.RowValue_StringValue:
+			values = append(values, v.StringValue)
+		case *v1pb.RowValue_Int32Value:
+			values = append(values, strconv.FormatInt(int64(v.Int32Value), 10))
+		case *v1pb.RowValue_Int64Value:
+			values = append(values, strconv.FormatInt(v.Int64Value, 10))
+		case *v1pb.RowValue_Uint32Value:
+			values = append(values, strconv.FormatUint(uint64(v.Uint32Value), 10))
+		case *v1pb.RowValue_Uint64Value:
+			values = append(values, strconv.FormatUint(v.Uint64Value, 10))
+		default:
+		}
+	}
+	return values
+}
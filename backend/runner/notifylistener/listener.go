@@ -99,6 +99,10 @@ func (l *Listener) handleNotification(payload string) {
 		if cancel, ok := l.bus.RunningTaskRunsCancelFunc.Load(ref); ok {
 			cancel.(context.CancelFunc)()
 		}
+	case storepb.Signal_CANCEL_OPERATION:
+		if cancel, ok := l.bus.RunningOperationsCancelFunc.Load(signal.Uid); ok {
+			cancel.(context.CancelFunc)()
+		}
 	default:
 		slog.Warn("unknown signal type", "type", signal.Type)
 	}
@@ -22,6 +22,8 @@ func DeriveCheckTargets(ctx context.Context, s *store.Store, project *store.Proj
 			// No checks for create database.
 		case *storepb.PlanConfig_Spec_ExportDataConfig:
 			// No checks for export data.
+		case *storepb.PlanConfig_Spec_CloneDatabaseConfig:
+			// No checks for clone database.
 		case *storepb.PlanConfig_Spec_ChangeDatabaseConfig:
 			// Skip plan checks for releases.
 			if config.ChangeDatabaseConfig.Release != "" {
@@ -20,6 +20,7 @@ import (
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/component/dbfactory"
+	"github.com/bytebase/bytebase/backend/component/lineage"
 	"github.com/bytebase/bytebase/backend/enterprise"
 	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
 	"github.com/bytebase/bytebase/backend/plugin/db"
@@ -39,9 +40,10 @@ const (
 // NewSyncer creates a schema syncer.
 func NewSyncer(stores *store.Store, dbFactory *dbfactory.DBFactory, licenseService *enterprise.LicenseService) *Syncer {
 	return &Syncer{
-		store:          stores,
-		dbFactory:      dbFactory,
-		licenseService: licenseService,
+		store:           stores,
+		dbFactory:       dbFactory,
+		licenseService:  licenseService,
+		databaseSyncMap: make(map[string]*databaseSyncRequest),
 	}
 }
 
@@ -49,10 +51,18 @@ func NewSyncer(stores *store.Store, dbFactory *dbfactory.DBFactory, licenseServi
 type Syncer struct {
 	sync.Mutex
 
-	store           *store.Store
-	dbFactory       *dbfactory.DBFactory
-	licenseService  *enterprise.LicenseService
-	databaseSyncMap sync.Map // map[string]*store.DatabaseMessage
+	store          *store.Store
+	dbFactory      *dbfactory.DBFactory
+	licenseService *enterprise.LicenseService
+	// databaseSyncMap is the queue of the databases to sync, keyed by the database. It's guarded by the mutex.
+	databaseSyncMap map[string]*databaseSyncRequest
+}
+
+// databaseSyncRequest is a database in the sync queue.
+type databaseSyncRequest struct {
+	database *store.DatabaseMessage
+	// waiters receive the sync result of the database.
+	waiters []chan<- error
 }
 
 // Run will run the schema syncer once.
@@ -99,41 +109,20 @@ func (s *Syncer) Run(ctx context.Context, wg *sync.WaitGroup) {
 					instanceMap[instance.ResourceID] = instance
 				}
 				dbwp := pool.New().WithMaxGoroutines(MaximumOutstanding)
-				s.databaseSyncMap.Range(func(key, value any) bool {
-					database, ok := value.(*store.DatabaseMessage)
-					if !ok {
-						return true
-					}
-
-					s.databaseSyncMap.Delete(key)
+				for _, request := range s.dequeueDatabaseSyncs() {
 					dbwp.Go(func() {
+						database := request.database
 						slog.Debug("Sync database schema", slog.String("instance", database.InstanceID), slog.String("database", database.DatabaseName))
-						if err := s.SyncDatabaseSchema(ctx, database); err != nil {
-							slog.Debug("Failed to sync database schema",
-								slog.String("instance", database.InstanceID),
-								slog.String("databaseName", database.DatabaseName),
-								log.BBError(err))
-							// Save sync error to database metadata
-							if _, updateErr := s.store.UpdateDatabase(ctx, &store.UpdateDatabaseMessage{
-								InstanceID:   database.InstanceID,
-								DatabaseName: database.DatabaseName,
-								MetadataUpdates: []func(*storepb.DatabaseMetadata){
-									func(md *storepb.DatabaseMetadata) {
-										md.SyncStatus = storepb.SyncStatus_SYNC_STATUS_FAILED
-										md.SyncError = err.Error()
-										md.LastSyncTime = timestamppb.Now()
-									},
-								},
-							}); updateErr != nil {
-								slog.Error("Failed to update database sync error",
-									slog.String("instance", database.InstanceID),
-									slog.String("database", database.DatabaseName),
-									log.BBError(updateErr))
-							}
+						err := s.SyncDatabaseSchema(ctx, database)
+						if err != nil {
+							s.recordSyncError(ctx, database, err)
+							err = errors.Wrapf(err, "failed to sync database %q", database.DatabaseName)
+						}
+						for _, waiter := range request.waiters {
+							waiter <- err
 						}
 					})
-					return true
-				})
+				}
 				dbwp.Wait()
 			case <-ctx.Done(): // if cancel() execute
 				return
@@ -233,7 +222,7 @@ func (s *Syncer) trySyncAll(ctx context.Context) {
 			continue
 		}
 
-		s.databaseSyncMap.Store(database.String(), database)
+		s.enqueueDatabaseSync(database, nil)
 	}
 }
 
@@ -254,7 +243,7 @@ func (s *Syncer) SyncAllDatabases(ctx context.Context, instance *store.InstanceM
 		if database.Deleted {
 			continue
 		}
-		s.databaseSyncMap.Store(database.String(), database)
+		s.enqueueDatabaseSync(database, nil)
 	}
 }
 
@@ -262,7 +251,7 @@ func (s *Syncer) SyncDatabaseAsync(database *store.DatabaseMessage) {
 	if database == nil || database.Deleted {
 		return
 	}
-	s.databaseSyncMap.Store(database.String(), database)
+	s.enqueueDatabaseSync(database, nil)
 }
 
 func (s *Syncer) SyncDatabasesAsync(databases []*store.DatabaseMessage) {
@@ -271,6 +260,91 @@ func (s *Syncer) SyncDatabasesAsync(databases []*store.DatabaseMessage) {
 	}
 }
 
+// enqueueDatabaseSync adds the database to the sync queue.
+// If the database is already queued, the waiter waits for the queued sync instead.
+func (s *Syncer) enqueueDatabaseSync(database *store.DatabaseMessage, waiter chan<- error) {
+	s.Lock()
+	defer s.Unlock()
+	key := database.String()
+	request, ok := s.databaseSyncMap[key]
+	if !ok {
+		request = &databaseSyncRequest{}
+		s.databaseSyncMap[key] = request
+	}
+	request.database = database
+	if waiter != nil {
+		request.waiters = append(request.waiters, waiter)
+	}
+}
+
+// dequeueDatabaseSyncs takes all the databases in the sync queue.
+func (s *Syncer) dequeueDatabaseSyncs() []*databaseSyncRequest {
+	s.Lock()
+	defer s.Unlock()
+	var requests []*databaseSyncRequest
+	for _, request := range s.databaseSyncMap {
+		requests = append(requests, request)
+	}
+	clear(s.databaseSyncMap)
+	return requests
+}
+
+// SyncDatabases queues the databases for the background sync and waits for them, reporting the number of synced databases.
+// The databases share the bounded concurrency of the sync queue, and a database already in the queue is synced once.
+// The sync errors are recorded in the database metadata like the background syncs.
+func (s *Syncer) SyncDatabases(ctx context.Context, databases []*store.DatabaseMessage, progress func(completed int)) error {
+	// The channel is buffered so that the queue never blocks on a cancelled caller.
+	results := make(chan error, len(databases))
+	for _, database := range databases {
+		s.enqueueDatabaseSync(database, results)
+	}
+
+	var failed int
+	var firstErr error
+	for completed := 1; completed <= len(databases); completed++ {
+		select {
+		case err := <-results:
+			if err != nil {
+				failed++
+				if firstErr == nil {
+					firstErr = err
+				}
+			}
+			progress(completed)
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	if failed > 0 {
+		return errors.Wrapf(firstErr, "failed to sync %d of %d databases", failed, len(databases))
+	}
+	return nil
+}
+
+func (s *Syncer) recordSyncError(ctx context.Context, database *store.DatabaseMessage, err error) {
+	slog.Debug("Failed to sync database schema",
+		slog.String("instance", database.InstanceID),
+		slog.String("databaseName", database.DatabaseName),
+		log.BBError(err))
+	// Save sync error to database metadata
+	if _, updateErr := s.store.UpdateDatabase(ctx, &store.UpdateDatabaseMessage{
+		InstanceID:   database.InstanceID,
+		DatabaseName: database.DatabaseName,
+		MetadataUpdates: []func(*storepb.DatabaseMetadata){
+			func(md *storepb.DatabaseMetadata) {
+				md.SyncStatus = storepb.SyncStatus_SYNC_STATUS_FAILED
+				md.SyncError = err.Error()
+				md.LastSyncTime = timestamppb.Now()
+			},
+		},
+	}); updateErr != nil {
+		slog.Error("Failed to update database sync error",
+			slog.String("instance", database.InstanceID),
+			slog.String("database", database.DatabaseName),
+			log.BBError(updateErr))
+	}
+}
+
 // GetInstanceMeta gets the instance metadata.
 func (s *Syncer) GetInstanceMeta(ctx context.Context, instance *store.InstanceMessage) (*db.InstanceMetadata, error) {
 	driver, err := s.dbFactory.GetAdminDatabaseDriver(ctx, instance, nil /* database */, db.ConnectionContext{})
@@ -446,6 +520,12 @@ func (s *Syncer) doSyncDatabaseSchema(ctx context.Context, database *store.Datab
 		}
 		return "", errors.Wrapf(err, "failed to upsert database schema for database %q", database.DatabaseName)
 	}
+	if err := lineage.SyncViews(ctx, s.store, instance, database, syncedDatabaseMetadata); err != nil {
+		slog.Warn("failed to sync view column lineage",
+			slog.String("instance", database.InstanceID),
+			slog.String("database", database.DatabaseName),
+			log.BBError(err))
+	}
 
 	// Create sync history if requested
 	if createSyncHistory {
@@ -49,3 +49,24 @@ func TestGetOrDefaultSyncIntervalUsesEffectiveActivation(t *testing.T) {
 	instance.Metadata.Activation = true
 	require.Equal(t, customInterval, s.getOrDefaultSyncInterval(ctx, instance))
 }
+
+func TestDatabaseSyncQueueDedup(t *testing.T) {
+	s := NewSyncer(nil, nil, nil)
+	database := &store.DatabaseMessage{InstanceID: "prod", DatabaseName: "hr"}
+	results := make(chan error, 2)
+	s.SyncDatabaseAsync(database)
+	s.enqueueDatabaseSync(database, results)
+	s.enqueueDatabaseSync(&store.DatabaseMessage{InstanceID: "prod", DatabaseName: "hr"}, results)
+
+	// The database is synced once for all the callers.
+	requests := s.dequeueDatabaseSyncs()
+	require.Len(t, requests, 1)
+	require.Len(t, requests[0].waiters, 2)
+	require.Empty(t, s.dequeueDatabaseSyncs())
+
+	// The caller stops waiting when the context is cancelled.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := s.SyncDatabases(ctx, []*store.DatabaseMessage{database}, func(int) {})
+	require.ErrorIs(t, err, context.Canceled)
+}
@@ -0,0 +1,500 @@
+package taskrun
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	apiv1 "github.com/bytebase/bytebase/backend/api/v1"
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/config"
+	"github.com/bytebase/bytebase/backend/component/dbfactory"
+	"github.com/bytebase/bytebase/backend/component/export"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/db/util"
+	parserbase "github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/runner/schemasync"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+// cloneBatchSize is the number of rows copied per INSERT batch.
+const cloneBatchSize = 1000
+
+// NewDatabaseCloneExecutor creates a database clone task executor.
+func NewDatabaseCloneExecutor(store *store.Store, dbFactory *dbfactory.DBFactory, schemaSyncer *schemasync.Syncer, profile *config.Profile) Executor {
+	return &DatabaseCloneExecutor{
+		store:        store,
+		dbFactory:    dbFactory,
+		schemaSyncer: schemaSyncer,
+		profile:      profile,
+	}
+}
+
+// DatabaseCloneExecutor is the database clone task executor.
+// It copies the schema of the source database and its masked data into the task database.
+type DatabaseCloneExecutor struct {
+	store        *store.Store
+	dbFactory    *dbfactory.DBFactory
+	schemaSyncer *schemasync.Syncer
+	profile      *config.Profile
+}
+
+// RunOnce will run the database clone task executor once.
+func (exec *DatabaseCloneExecutor) RunOnce(ctx context.Context, driverCtx context.Context, task *store.TaskMessage, taskRunUID int64) (*storepb.TaskRunResult, error) {
+	plan, err := exec.store.GetPlan(ctx, &store.FindPlanMessage{ProjectID: task.ProjectID, UID: &task.PlanID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get plan")
+	}
+	if plan == nil {
+		return nil, errors.Errorf("plan not found")
+	}
+	var cloneConfig *storepb.PlanConfig_CloneDatabaseConfig
+	for _, spec := range plan.Config.GetSpecs() {
+		if spec.Id == task.Payload.GetSpecId() {
+			cloneConfig = spec.GetCloneDatabaseConfig()
+		}
+	}
+	if cloneConfig == nil {
+		return nil, errors.Errorf("spec %q does not contain clone database config", task.Payload.GetSpecId())
+	}
+
+	targetInstance, err := exec.store.GetInstanceByResourceID(ctx, task.InstanceID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get instance")
+	}
+	if targetInstance == nil {
+		return nil, errors.Errorf("instance not found for task %v", task.ID)
+	}
+	targetDatabase, err := exec.store.GetDatabase(ctx, &store.FindDatabaseMessage{InstanceID: &task.InstanceID, DatabaseName: task.DatabaseName})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get database")
+	}
+	if targetDatabase == nil {
+		return nil, errors.Errorf("database not found for task %v", task.ID)
+	}
+
+	sourceInstanceID, sourceDatabaseName, err := common.GetInstanceDatabaseID(cloneConfig.Source)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid source database %q", cloneConfig.Source)
+	}
+	sourceInstance, err := exec.store.GetInstanceByResourceID(ctx, sourceInstanceID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get source instance")
+	}
+	if sourceInstance == nil {
+		return nil, errors.Errorf("source instance %q not found", sourceInstanceID)
+	}
+	sourceDatabase, err := exec.store.GetDatabase(ctx, &store.FindDatabaseMessage{InstanceID: &sourceInstanceID, DatabaseName: &sourceDatabaseName})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get source database")
+	}
+	if sourceDatabase == nil {
+		return nil, errors.Errorf("source database %q not found", cloneConfig.Source)
+	}
+
+	engine := sourceInstance.Metadata.GetEngine()
+	if engine != targetInstance.Metadata.GetEngine() {
+		return nil, errors.Errorf("source engine %s does not match target engine %s", engine, targetInstance.Metadata.GetEngine())
+	}
+	if engine != storepb.Engine_POSTGRES && engine != storepb.Engine_MYSQL {
+		return nil, errors.Errorf("cloning %s database is not supported", engine)
+	}
+
+	// The masking rules and semantic types are workspace-scoped.
+	ctx = context.WithValue(ctx, common.WorkspaceIDContextKey, sourceInstance.Workspace)
+	// Sync the source database first, so that the masker evaluates the columns added since the last sync.
+	if err := exec.schemaSyncer.SyncDatabaseSchema(ctx, sourceDatabase); err != nil {
+		return nil, errors.Wrap(err, "failed to sync source database schema")
+	}
+	tableMasker, err := apiv1.NewTableMasker(ctx, exec.store, sourceDatabase)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create table masker")
+	}
+
+	sourceDriver, err := exec.dbFactory.GetAdminDatabaseDriver(driverCtx, sourceInstance, sourceDatabase, db.ConnectionContext{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get source database driver")
+	}
+	defer sourceDriver.Close(driverCtx)
+	targetDriver, err := exec.dbFactory.GetAdminDatabaseDriver(driverCtx, targetInstance, targetDatabase, db.ConnectionContext{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get target database driver")
+	}
+	defer targetDriver.Close(driverCtx)
+
+	createTaskRunLog := func(l *storepb.TaskRunLog) {
+		exec.store.CreateTaskRunLogS(ctx, task.ProjectID, taskRunUID, time.Now(), exec.profile.ReplicaID, l)
+	}
+
+	// Copy the schema.
+	createTaskRunLog(&storepb.TaskRunLog{
+		Type:            storepb.TaskRunLog_SCHEMA_DUMP_START,
+		SchemaDumpStart: &storepb.TaskRunLog_SchemaDumpStart{},
+	})
+	sourceMetadata, err := exec.copySchema(driverCtx, sourceDriver, targetDriver)
+	schemaDumpEnd := &storepb.TaskRunLog_SchemaDumpEnd{}
+	if err != nil {
+		schemaDumpEnd.Error = err.Error()
+	}
+	createTaskRunLog(&storepb.TaskRunLog{
+		Type:          storepb.TaskRunLog_SCHEMA_DUMP_END,
+		SchemaDumpEnd: schemaDumpEnd,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Copy the masked data.
+	for _, schema := range sourceMetadata.GetSchemas() {
+		for _, table := range schema.GetTables() {
+			if !matchCloneTable(schema.Name, table.Name, cloneConfig.IncludeTables, cloneConfig.ExcludeTables) {
+				continue
+			}
+			if err := exec.copyTable(driverCtx, engine, sourceDriver, targetDriver, tableMasker, schema.Name, table, cloneConfig.SamplePercent, createTaskRunLog); err != nil {
+				return nil, errors.Wrapf(err, "failed to copy table %q", table.Name)
+			}
+		}
+	}
+
+	// The foreign keys are created after the data is copied, so the tables can be copied in any order.
+	if err := copyForeignKeys(driverCtx, engine, targetDriver, sourceMetadata); err != nil {
+		return nil, err
+	}
+
+	if err := exec.schemaSyncer.SyncDatabaseSchema(ctx, targetDatabase); err != nil {
+		slog.Error("failed to sync database schema",
+			slog.String("instanceName", targetInstance.ResourceID),
+			slog.String("databaseName", targetDatabase.DatabaseName),
+			log.BBError(err),
+		)
+	}
+	return &storepb.TaskRunResult{}, nil
+}
+
+// copySchema applies the schema dump of the source database without foreign keys to the target database.
+// It returns the source database metadata.
+func (*DatabaseCloneExecutor) copySchema(ctx context.Context, sourceDriver, targetDriver db.Driver) (*storepb.DatabaseSchemaMetadata, error) {
+	metadata, err := sourceDriver.SyncDBSchema(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sync source database schema")
+	}
+	withoutForeignKeys, ok := proto.Clone(metadata).(*storepb.DatabaseSchemaMetadata)
+	if !ok {
+		return nil, errors.New("failed to clone source database schema")
+	}
+	for _, schema := range withoutForeignKeys.GetSchemas() {
+		for _, table := range schema.GetTables() {
+			table.ForeignKeys = nil
+		}
+	}
+	var buf bytes.Buffer
+	if err := sourceDriver.Dump(ctx, &buf, withoutForeignKeys); err != nil {
+		return nil, errors.Wrap(err, "failed to dump source database schema")
+	}
+	if buf.Len() > 0 {
+		if _, err := targetDriver.Execute(ctx, buf.String(), db.ExecuteOptions{}); err != nil {
+			return nil, errors.Wrap(err, "failed to apply schema to target database")
+		}
+	}
+	return metadata, nil
+}
+
+// copyTable streams the rows of the table from the source database, masks them and inserts them into the target database in batches.
+func (*DatabaseCloneExecutor) copyTable(
+	ctx context.Context,
+	engine storepb.Engine,
+	sourceDriver, targetDriver db.Driver,
+	tableMasker *apiv1.TableMasker,
+	schemaName string,
+	table *storepb.TableMetadata,
+	samplePercent int32,
+	createTaskRunLog func(*storepb.TaskRunLog),
+) (retErr error) {
+	tableCopy := &storepb.TaskRunLog_TableCopy{
+		Schema: schemaName,
+		Table:  table.Name,
+	}
+	logProgress := func() {
+		createTaskRunLog(&storepb.TaskRunLog{
+			Type:      storepb.TaskRunLog_TABLE_COPY,
+			TableCopy: tableCopy,
+		})
+	}
+	defer func() {
+		if retErr != nil {
+			tableCopy.Error = retErr.Error()
+		}
+		logProgress()
+	}()
+
+	var columnNames []string
+	for _, column := range table.GetColumns() {
+		// Generated columns are computed by the target database.
+		if column.Generation != nil {
+			continue
+		}
+		columnNames = append(columnNames, column.Name)
+	}
+	if len(columnNames) == 0 {
+		return nil
+	}
+
+	rows, err := sourceDriver.GetDB().QueryContext(ctx, buildCloneSelectStatement(engine, schemaName, table.Name, columnNames, samplePercent))
+	if err != nil {
+		return errors.Wrap(err, "failed to query source table")
+	}
+	defer rows.Close()
+	columnTypes, err := rows.ColumnTypes()
+	if err != nil {
+		return err
+	}
+	columnTypeNames := make([]string, len(columnTypes))
+	for i, v := range columnTypes {
+		columnTypeNames[i] = strings.ToUpper(v.DatabaseTypeName())
+	}
+	prefix, err := export.SQLStatementPrefix(engine, []parserbase.SchemaResource{{Schema: schemaName, Table: table.Name}}, columnNames)
+	if err != nil {
+		return err
+	}
+
+	batch := &v1pb.QueryResult{ColumnNames: columnNames}
+	flush := func() error {
+		if len(batch.Rows) == 0 {
+			return nil
+		}
+		if err := maskCloneBatch(tableMasker, schemaName, table, batch); err != nil {
+			return err
+		}
+		var buf bytes.Buffer
+		if err := export.SQLToWriter(&buf, engine, prefix, batch); err != nil {
+			return err
+		}
+		if _, err := targetDriver.Execute(ctx, buf.String(), db.ExecuteOptions{}); err != nil {
+			return errors.Wrap(err, "failed to insert rows into target table")
+		}
+		tableCopy.CopiedRows += int64(len(batch.Rows))
+		batch.Rows = nil
+		logProgress()
+		return nil
+	}
+
+	for rows.Next() {
+		values := make([]any, len(columnTypes))
+		for i, v := range columnTypeNames {
+			values[i] = util.MakeCommonValueByTypeName(v, columnTypes[i])
+		}
+		if err := rows.Scan(values...); err != nil {
+			return err
+		}
+		row := &v1pb.QueryRow{}
+		for i, v := range values {
+			row.Values = append(row.Values, util.ConvertCommonValue(columnTypeNames[i], columnTypes[i], v))
+		}
+		batch.Rows = append(batch.Rows, row)
+		if len(batch.Rows) >= cloneBatchSize {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	return flush()
+}
+
+// maskCloneBatch masks the rows of the table.
+// The masked values of non-text columns are replaced with NULL, or the zero value of the column if it is not nullable,
+// because the masked strings cannot be inserted into these columns.
+func maskCloneBatch(tableMasker *apiv1.TableMasker, schemaName string, table *storepb.TableMetadata, batch *v1pb.QueryResult) error {
+	originals := make([][]*v1pb.RowValue, len(batch.Rows))
+	for i, row := range batch.Rows {
+		originals[i] = slices.Clone(row.Values)
+	}
+	if err := tableMasker.MaskResult(schemaName, table.Name, batch); err != nil {
+		return err
+	}
+	columns := make(map[string]*storepb.ColumnMetadata)
+	for _, column := range table.GetColumns() {
+		columns[column.Name] = column
+	}
+	for i, row := range batch.Rows {
+		for j, value := range row.Values {
+			column := columns[batch.ColumnNames[j]]
+			original := originals[i][j]
+			if column == nil || isCloneTextColumn(column.Type) || original == nil || proto.Equal(value, original) {
+				continue
+			}
+			row.Values[j] = getCloneMaskedValue(column, original)
+		}
+	}
+	return nil
+}
+
+// isCloneTextColumn reports whether the column can store the masked strings.
+func isCloneTextColumn(columnType string) bool {
+	columnType = strings.ToLower(columnType)
+	return strings.Contains(columnType, "char") || strings.Contains(columnType, "text")
+}
+
+// getCloneMaskedValue returns NULL, or the zero value of the original value kind if the column is not nullable.
+func getCloneMaskedValue(column *storepb.ColumnMetadata, original *v1pb.RowValue) *v1pb.RowValue {
+	value := &v1pb.RowValue{Kind: &v1pb.RowValue_NullValue{NullValue: structpb.NullValue_NULL_VALUE}}
+	if column.Nullable {
+		return value
+	}
+	message := original.ProtoReflect()
+	field := message.WhichOneof(message.Descriptor().Oneofs().ByName("kind"))
+	if field == nil {
+		return value
+	}
+	value = &v1pb.RowValue{}
+	value.ProtoReflect().Set(field, value.ProtoReflect().NewField(field))
+	return value
+}
+
+// copyForeignKeys creates the foreign keys of the source database in the target database.
+// The rows referencing rows that are not copied, e.g. because of sampling or excluded tables, are deleted first.
+// Deleting rows may orphan the rows referencing them, so it repeats until no rows are deleted.
+func copyForeignKeys(ctx context.Context, engine storepb.Engine, targetDriver db.Driver, metadata *storepb.DatabaseSchemaMetadata) error {
+	for {
+		var deleted int64
+		for _, schema := range metadata.GetSchemas() {
+			for _, table := range schema.GetTables() {
+				for _, fk := range table.GetForeignKeys() {
+					result, err := targetDriver.GetDB().ExecContext(ctx, buildCloneOrphanDeleteStatement(engine, schema.Name, table.Name, fk))
+					if err != nil {
+						return errors.Wrapf(err, "failed to delete the rows violating foreign key %q", fk.Name)
+					}
+					affected, err := result.RowsAffected()
+					if err != nil {
+						return err
+					}
+					deleted += affected
+				}
+			}
+		}
+		if deleted == 0 {
+			break
+		}
+	}
+	for _, schema := range metadata.GetSchemas() {
+		for _, table := range schema.GetTables() {
+			for _, fk := range table.GetForeignKeys() {
+				if _, err := targetDriver.GetDB().ExecContext(ctx, buildCloneForeignKeyStatement(engine, schema.Name, table.Name, fk)); err != nil {
+					return errors.Wrapf(err, "failed to create foreign key %q", fk.Name)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// buildCloneOrphanDeleteStatement builds the statement deleting the rows of the table without referenced rows.
+// The rows with NULL referencing columns do not need referenced rows.
+func buildCloneOrphanDeleteStatement(engine storepb.Engine, schemaName, tableName string, fk *storepb.ForeignKeyMetadata) string {
+	quote := getCloneQuoteFunc(engine)
+	var notNull, join []string
+	for i, column := range fk.Columns {
+		notNull = append(notNull, fmt.Sprintf("c.%s IS NOT NULL", quote(column)))
+		if i < len(fk.ReferencedColumns) {
+			join = append(join, fmt.Sprintf("p.%s = c.%s", quote(fk.ReferencedColumns[i]), quote(column)))
+		}
+	}
+	table := getCloneTableName(engine, schemaName, tableName)
+	referencedTable := getCloneTableName(engine, fk.ReferencedSchema, fk.ReferencedTable)
+	if engine == storepb.Engine_MYSQL {
+		// MySQL cannot read the table deleted from in a subquery, which self-referencing foreign keys need.
+		return fmt.Sprintf("DELETE c FROM %s AS c LEFT JOIN %s AS p ON %s WHERE p.%s IS NULL AND %s",
+			table, referencedTable, strings.Join(join, " AND "), quote(fk.ReferencedColumns[0]), strings.Join(notNull, " AND "))
+	}
+	return fmt.Sprintf("DELETE FROM %s AS c WHERE %s AND NOT EXISTS (SELECT 1 FROM %s AS p WHERE %s)",
+		table, strings.Join(notNull, " AND "), referencedTable, strings.Join(join, " AND "))
+}
+
+// buildCloneForeignKeyStatement builds the statement creating the foreign key of the table.
+func buildCloneForeignKeyStatement(engine storepb.Engine, schemaName, tableName string, fk *storepb.ForeignKeyMetadata) string {
+	quote := getCloneQuoteFunc(engine)
+	quoteList := func(names []string) string {
+		var quoted []string
+		for _, name := range names {
+			quoted = append(quoted, quote(name))
+		}
+		return strings.Join(quoted, ", ")
+	}
+	var buf strings.Builder
+	fmt.Fprintf(&buf, "ALTER TABLE %s ADD CONSTRAINT %s FOREIGN KEY (%s) REFERENCES %s (%s)",
+		getCloneTableName(engine, schemaName, tableName), quote(fk.Name), quoteList(fk.Columns),
+		getCloneTableName(engine, fk.ReferencedSchema, fk.ReferencedTable), quoteList(fk.ReferencedColumns))
+	if engine == storepb.Engine_POSTGRES && fk.MatchType != "" && !strings.EqualFold(fk.MatchType, "SIMPLE") {
+		fmt.Fprintf(&buf, " MATCH %s", fk.MatchType)
+	}
+	if fk.OnDelete != "" && !strings.EqualFold(fk.OnDelete, "NO ACTION") {
+		fmt.Fprintf(&buf, " ON DELETE %s", fk.OnDelete)
+	}
+	if fk.OnUpdate != "" && !strings.EqualFold(fk.OnUpdate, "NO ACTION") {
+		fmt.Fprintf(&buf, " ON UPDATE %s", fk.OnUpdate)
+	}
+	return buf.String()
+}
+
+func getCloneQuoteFunc(engine storepb.Engine) func(string) string {
+	if engine == storepb.Engine_MYSQL {
+		return func(s string) string {
+			return "`" + strings.ReplaceAll(s, "`", "``") + "`"
+		}
+	}
+	return func(s string) string {
+		return `"` + strings.ReplaceAll(s, `"`, `""`) + `"`
+	}
+}
+
+// getCloneTableName returns the quoted table name. The schema is ignored for MySQL, whose referenced schema
+// is the source database.
+func getCloneTableName(engine storepb.Engine, schemaName, tableName string) string {
+	quote := getCloneQuoteFunc(engine)
+	if schemaName == "" || engine == storepb.Engine_MYSQL {
+		return quote(tableName)
+	}
+	return quote(schemaName) + "." + quote(tableName)
+}
+
+// buildCloneSelectStatement builds the statement reading the columns of the table, sampling rows if needed.
+func buildCloneSelectStatement(engine storepb.Engine, schemaName, tableName string, columnNames []string, samplePercent int32) string {
+	quote := getCloneQuoteFunc(engine)
+	var columns []string
+	for _, name := range columnNames {
+		columns = append(columns, quote(name))
+	}
+	from := quote(tableName)
+	if schemaName != "" {
+		from = quote(schemaName) + "." + from
+	}
+	statement := fmt.Sprintf("SELECT %s FROM %s", strings.Join(columns, ", "), from)
+	if samplePercent <= 0 || samplePercent >= 100 {
+		return statement
+	}
+	if engine == storepb.Engine_MYSQL {
+		return fmt.Sprintf("%s WHERE RAND() < %f", statement, float64(samplePercent)/100)
+	}
+	return fmt.Sprintf("%s TABLESAMPLE BERNOULLI (%d)", statement, samplePercent)
+}
+
+// matchCloneTable reports whether the table should be cloned. Exclusion takes precedence over inclusion.
+func matchCloneTable(schemaName, tableName string, includeTables, excludeTables []string) bool {
+	if common.MatchTablePatterns(schemaName, tableName, excludeTables) {
+		return false
+	}
+	return len(includeTables) == 0 || common.MatchTablePatterns(schemaName, tableName, includeTables)
+}
@@ -0,0 +1,80 @@
+package taskrun
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
+)
+
+func TestMatchCloneTable(t *testing.T) {
+	tests := []struct {
+		schema  string
+		table   string
+		include []string
+		exclude []string
+		want    bool
+	}{
+		{schema: "public", table: "orders", want: true},
+		{schema: "public", table: "orders", include: []string{"orders"}, want: true},
+		{schema: "public", table: "orders", include: []string{"public.order*"}, want: true},
+		{schema: "sales", table: "orders", include: []string{"public.*"}, want: false},
+		{schema: "public", table: "orders", include: []string{"*"}, exclude: []string{"orders"}, want: false},
+		{schema: "", table: "users", exclude: []string{"audit_*"}, want: true},
+		{schema: "", table: "audit_log", exclude: []string{"audit_*"}, want: false},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		got := matchCloneTable(test.schema, test.table, test.include, test.exclude)
+		a.Equal(test.want, got, "%s.%s", test.schema, test.table)
+	}
+}
+
+func TestBuildCloneSelectStatement(t *testing.T) {
+	a := require.New(t)
+	a.Equal(`SELECT "id", "a""b" FROM "public"."t"`, buildCloneSelectStatement(storepb.Engine_POSTGRES, "public", "t", []string{"id", `a"b`}, 0))
+	a.Equal(`SELECT "id" FROM "public"."t" TABLESAMPLE BERNOULLI (10)`, buildCloneSelectStatement(storepb.Engine_POSTGRES, "public", "t", []string{"id"}, 10))
+	a.Equal("SELECT `id` FROM `t` WHERE RAND() < 0.250000", buildCloneSelectStatement(storepb.Engine_MYSQL, "", "t", []string{"id"}, 25))
+	a.Equal("SELECT `id` FROM `t`", buildCloneSelectStatement(storepb.Engine_MYSQL, "", "t", []string{"id"}, 100))
+}
+
+func TestBuildCloneForeignKeyStatements(t *testing.T) {
+	a := require.New(t)
+	fk := &storepb.ForeignKeyMetadata{
+		Name:              "fk_orders_customer",
+		Columns:           []string{"tenant_id", "customer_id"},
+		ReferencedSchema:  "public",
+		ReferencedTable:   "customers",
+		ReferencedColumns: []string{"tenant_id", "id"},
+		OnDelete:          "CASCADE",
+		OnUpdate:          "NO ACTION",
+		MatchType:         "FULL",
+	}
+	a.Equal(`DELETE FROM "public"."orders" AS c WHERE c."tenant_id" IS NOT NULL AND c."customer_id" IS NOT NULL AND NOT EXISTS (SELECT 1 FROM "public"."customers" AS p WHERE p."tenant_id" = c."tenant_id" AND p."id" = c."customer_id")`,
+		buildCloneOrphanDeleteStatement(storepb.Engine_POSTGRES, "public", "orders", fk))
+	a.Equal(`ALTER TABLE "public"."orders" ADD CONSTRAINT "fk_orders_customer" FOREIGN KEY ("tenant_id", "customer_id") REFERENCES "public"."customers" ("tenant_id", "id") MATCH FULL ON DELETE CASCADE`,
+		buildCloneForeignKeyStatement(storepb.Engine_POSTGRES, "public", "orders", fk))
+
+	fk.ReferencedSchema = "shop"
+	a.Equal("DELETE c FROM `orders` AS c LEFT JOIN `customers` AS p ON p.`tenant_id` = c.`tenant_id` AND p.`id` = c.`customer_id` WHERE p.`tenant_id` IS NULL AND c.`tenant_id` IS NOT NULL AND c.`customer_id` IS NOT NULL",
+		buildCloneOrphanDeleteStatement(storepb.Engine_MYSQL, "", "orders", fk))
+	a.Equal("ALTER TABLE `orders` ADD CONSTRAINT `fk_orders_customer` FOREIGN KEY (`tenant_id`, `customer_id`) REFERENCES `customers` (`tenant_id`, `id`) ON DELETE CASCADE",
+		buildCloneForeignKeyStatement(storepb.Engine_MYSQL, "", "orders", fk))
+}
+
+func TestGetCloneMaskedValue(t *testing.T) {
+	a := require.New(t)
+	original := &v1pb.RowValue{Kind: &v1pb.RowValue_Int64Value{Int64Value: 42}}
+	a.IsType(&v1pb.RowValue_NullValue{}, getCloneMaskedValue(&storepb.ColumnMetadata{Type: "bigint", Nullable: true}, original).Kind)
+	got := getCloneMaskedValue(&storepb.ColumnMetadata{Type: "bigint"}, original)
+	a.IsType(&v1pb.RowValue_Int64Value{}, got.Kind)
+	a.Equal(int64(0), got.GetInt64Value())
+
+	a.True(isCloneTextColumn("character varying(255)"))
+	a.True(isCloneTextColumn("LONGTEXT"))
+	a.False(isCloneTextColumn("integer"))
+	a.False(isCloneTextColumn("timestamp with time zone"))
+}
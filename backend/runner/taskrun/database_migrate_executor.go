@@ -18,6 +18,7 @@ import (
 	"github.com/bytebase/bytebase/backend/component/config"
 	"github.com/bytebase/bytebase/backend/component/dbfactory"
 	"github.com/bytebase/bytebase/backend/component/ghost"
+	"github.com/bytebase/bytebase/backend/component/lineage"
 	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
 	"github.com/bytebase/bytebase/backend/plugin/db"
 	"github.com/bytebase/bytebase/backend/plugin/db/oracle"
@@ -285,11 +286,25 @@ func (exec *DatabaseMigrateExecutor) runStandardMigration(ctx context.Context, d
 		return nil, migrationErr
 	}
 
+	exec.recordColumnLineage(ctx, instance, database, changelogID, sheet.Statement)
+
 	return &storepb.TaskRunResult{
 		HasPriorBackup: priorBackupDetail != nil && len(priorBackupDetail.Items) > 0,
 	}, nil
 }
 
+// recordColumnLineage records the column lineage of the executed statement.
+// The failure is logged and does not fail the task.
+func (exec *DatabaseMigrateExecutor) recordColumnLineage(ctx context.Context, instance *store.InstanceMessage, database *store.DatabaseMessage, changelogID string, statement string) {
+	changelog := common.FormatChangelog(database.InstanceID, database.DatabaseName, changelogID)
+	if err := lineage.RecordChange(ctx, exec.store, instance, database, statement, changelog); err != nil {
+		slog.Warn("failed to record column lineage",
+			slog.String("instance", database.InstanceID),
+			slog.String("database", database.DatabaseName),
+			log.BBError(err))
+	}
+}
+
 func executeGhostMigration(ctx context.Context, driverCtx context.Context, task *store.TaskMessage, sheet *store.SheetMessage, instance *store.InstanceMessage, database *store.DatabaseMessage, driver db.Driver) error {
 	flags, err := ghost.ParseGhostDirective(sheet.Statement)
 	if err != nil {
@@ -492,6 +507,7 @@ func (exec *DatabaseMigrateExecutor) runVersionedRelease(ctx context.Context, dr
 	defer driver.Close(ctx)
 
 	var migrationErr error
+	var executedStatements []string
 
 	// Execute unapplied files in order
 	for _, file := range release.Payload.Files {
@@ -540,6 +556,7 @@ func (exec *DatabaseMigrateExecutor) runVersionedRelease(ctx context.Context, dr
 			migrationErr = errors.Wrapf(err, "failed to execute release file %s (version %s)", file.Path, file.Version)
 			break
 		}
+		executedStatements = append(executedStatements, sheet.Statement)
 
 		// Create revision for this file
 		r := &store.RevisionMessage{
@@ -588,6 +605,10 @@ func (exec *DatabaseMigrateExecutor) runVersionedRelease(ctx context.Context, dr
 		return nil, migrationErr
 	}
 
+	for _, statement := range executedStatements {
+		exec.recordColumnLineage(ctx, instance, database, changelogID, statement)
+	}
+
 	// Update database release to the current release
 	if _, err := exec.store.UpdateDatabase(ctx, &store.UpdateDatabaseMessage{
 		InstanceID:   database.InstanceID,
@@ -998,7 +1019,8 @@ func computeNeedDump(taskType storepb.Task_Type, engine storepb.Engine, statemen
 		return true
 	case
 		storepb.Task_TASK_TYPE_UNSPECIFIED,
-		storepb.Task_DATABASE_EXPORT:
+		storepb.Task_DATABASE_EXPORT,
+		storepb.Task_DATABASE_CLONE:
 		return false
 	default:
 		return false
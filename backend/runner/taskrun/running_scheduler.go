@@ -282,7 +282,8 @@ func isSequentialTask(task *store.TaskMessage) bool {
 	case storepb.Task_DATABASE_MIGRATE:
 		return task.Payload.GetRelease() != ""
 	case storepb.Task_DATABASE_CREATE,
-		storepb.Task_DATABASE_EXPORT:
+		storepb.Task_DATABASE_EXPORT,
+		storepb.Task_DATABASE_CLONE:
 		return false
 	case storepb.Task_TASK_TYPE_UNSPECIFIED:
 		return false
@@ -256,14 +256,14 @@ func (s *Scheduler) checkPlanCompletion(ctx context.Context, ref bus.PlanRef) {
 }
 
 // isDeferredRolloutPlan returns true if the plan contains only deferred rollout specs
-// (exportDataConfig or createDatabaseConfig).
+// (exportDataConfig, createDatabaseConfig or cloneDatabaseConfig).
 func isDeferredRolloutPlan(plan *store.PlanMessage) bool {
 	specs := plan.Config.GetSpecs()
 	if len(specs) == 0 {
 		return false
 	}
 	for _, spec := range specs {
-		if spec.GetExportDataConfig() == nil && spec.GetCreateDatabaseConfig() == nil {
+		if spec.GetExportDataConfig() == nil && spec.GetCreateDatabaseConfig() == nil && spec.GetCloneDatabaseConfig() == nil {
 			return false
 		}
 	}
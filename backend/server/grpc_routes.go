@@ -27,6 +27,7 @@ import (
 	"github.com/bytebase/bytebase/backend/component/config"
 	"github.com/bytebase/bytebase/backend/component/dbfactory"
 	"github.com/bytebase/bytebase/backend/component/iam"
+	"github.com/bytebase/bytebase/backend/component/operation"
 	"github.com/bytebase/bytebase/backend/component/sampleinstance"
 	"github.com/bytebase/bytebase/backend/component/sheet"
 	"github.com/bytebase/bytebase/backend/component/webhook"
@@ -87,37 +88,41 @@ func configureGrpcRouters(
 			grpcruntime.DefaultHTTPErrorHandler(ctx, sm, m, w, r, err)
 		}),
 	)
+	operationManager := operation.NewManager(stores, bus, profile)
+
 	aiService := apiv1.NewAIService(stores)
-	accessGrantService := apiv1.NewAccessGrantService(stores, licenseService, webhookManager, bus)
+	accessGrantService := apiv1.NewAccessGrantService(stores, licenseService, webhookManager, bus, iamManager)
+	accessReviewService := apiv1.NewAccessReviewService(stores, licenseService, iamManager)
 	actuatorService := apiv1.NewActuatorService(stores, profile, schemaSyncer, licenseService, sampleInstanceManager)
 	auditLogService := apiv1.NewAuditLogService(stores, licenseService)
 	authService := apiv1.NewAuthService(stores, secret, licenseService, profile, iamManager)
 	celService := apiv1.NewCelService()
-	databaseCatalogService := apiv1.NewDatabaseCatalogService(stores)
+	databaseCatalogService := apiv1.NewDatabaseCatalogService(stores, iamManager)
 	databaseGroupService := apiv1.NewDatabaseGroupService(stores, licenseService)
-	databaseService := apiv1.NewDatabaseService(stores, schemaSyncer, profile, iamManager, licenseService)
+	databaseService := apiv1.NewDatabaseService(stores, schemaSyncer, profile, iamManager, licenseService, dbFactory, operationManager)
 	groupService := apiv1.NewGroupService(stores, iamManager, licenseService)
 	identityProviderService := apiv1.NewIdentityProviderService(stores, licenseService, profile)
 	instanceRoleService := apiv1.NewInstanceRoleService(stores)
-	instanceService := apiv1.NewInstanceService(stores, profile, licenseService, dbFactory, schemaSyncer, sampleInstanceManager)
+	instanceService := apiv1.NewInstanceService(stores, profile, licenseService, dbFactory, schemaSyncer, sampleInstanceManager, operationManager)
 	issueService := apiv1.NewIssueService(stores, webhookManager, bus, licenseService, iamManager)
+	operationService := apiv1.NewOperationService(stores, operationManager)
 	orgPolicyService := apiv1.NewOrgPolicyService(stores, licenseService, iamManager)
-	planService := apiv1.NewPlanService(stores, bus, iamManager, webhookManager, licenseService)
+	planService := apiv1.NewPlanService(stores, bus, iamManager, webhookManager, licenseService, operationManager)
 	projectService := apiv1.NewProjectService(stores, profile, iamManager)
-	releaseService := apiv1.NewReleaseService(stores, sheetManager, dbFactory)
+	releaseService := apiv1.NewReleaseService(stores, sheetManager, dbFactory, iamManager, secret)
 	reviewConfigService := apiv1.NewReviewConfigService(stores)
 	revisionService := apiv1.NewRevisionService(stores)
 	roleService := apiv1.NewRoleService(stores, iamManager, licenseService)
 	rolloutService := apiv1.NewRolloutService(stores, dbFactory, bus, webhookManager, iamManager)
 	settingService := apiv1.NewSettingService(stores, profile, licenseService, iamManager)
 	sheetService := apiv1.NewSheetService(stores)
-	sqlService := apiv1.NewSQLService(stores, schemaSyncer, dbFactory, licenseService, iamManager)
+	sqlService := apiv1.NewSQLService(stores, schemaSyncer, dbFactory, licenseService, iamManager, secret, operationManager)
 	subscriptionService := apiv1.NewSubscriptionService(profile, stores, licenseService)
 	userService := apiv1.NewUserService(stores, licenseService, profile, iamManager)
 	serviceAccountService := apiv1.NewServiceAccountService(stores, profile, iamManager)
 	workloadIdentityService := apiv1.NewWorkloadIdentityService(stores, profile, iamManager)
 	worksheetService := apiv1.NewWorksheetService(stores, iamManager)
-	workspaceService := apiv1.NewWorkspaceService(stores, iamManager, profile, licenseService)
+	workspaceService := apiv1.NewWorkspaceService(stores, iamManager, profile, licenseService, instanceService, projectService, orgPolicyService, settingService, reviewConfigService)
 
 	onPanic := func(_ context.Context, s connect.Spec, _ http.Header, p any) error {
 		stack := stacktrace.TakeStacktrace(20 /* n */, 5 /* skip */)
@@ -147,6 +152,9 @@ func configureGrpcRouters(
 	accessGrantPath, accessGrantHandler := v1connect.NewAccessGrantServiceHandler(accessGrantService, handlerOpts)
 	connectHandlers[accessGrantPath] = accessGrantHandler
 
+	accessReviewPath, accessReviewHandler := v1connect.NewAccessReviewServiceHandler(accessReviewService, handlerOpts)
+	connectHandlers[accessReviewPath] = accessReviewHandler
+
 	actuatorPath, actuatorHandler := v1connect.NewActuatorServiceHandler(actuatorService, handlerOpts)
 	connectHandlers[actuatorPath] = actuatorHandler
 
@@ -183,6 +191,9 @@ func configureGrpcRouters(
 	issuePath, issueHandler := v1connect.NewIssueServiceHandler(issueService, handlerOpts)
 	connectHandlers[issuePath] = issueHandler
 
+	operationPath, operationHandler := v1connect.NewOperationServiceHandler(operationService, handlerOpts)
+	connectHandlers[operationPath] = operationHandler
+
 	orgPolicyPath, orgPolicyHandler := v1connect.NewOrgPolicyServiceHandler(orgPolicyService, handlerOpts)
 	connectHandlers[orgPolicyPath] = orgPolicyHandler
 
@@ -238,6 +249,7 @@ func configureGrpcRouters(
 	reflector := grpcreflect.NewStaticReflector(
 		v1connect.AIServiceName,
 		v1connect.AccessGrantServiceName,
+		v1connect.AccessReviewServiceName,
 		v1connect.ActuatorServiceName,
 		v1connect.AuditLogServiceName,
 		v1connect.AuthServiceName,
@@ -250,6 +262,7 @@ func configureGrpcRouters(
 		v1connect.InstanceRoleServiceName,
 		v1connect.InstanceServiceName,
 		v1connect.IssueServiceName,
+		v1connect.OperationServiceName,
 		v1connect.OrgPolicyServiceName,
 		v1connect.PlanServiceName,
 		v1connect.ProjectServiceName,
@@ -293,6 +306,9 @@ func configureGrpcRouters(
 	if err := v1pb.RegisterAccessGrantServiceHandler(ctx, mux, grpcConn); err != nil {
 		return err
 	}
+	if err := v1pb.RegisterAccessReviewServiceHandler(ctx, mux, grpcConn); err != nil {
+		return err
+	}
 	if err := v1pb.RegisterActuatorServiceHandler(ctx, mux, grpcConn); err != nil {
 		return err
 	}
@@ -329,6 +345,9 @@ func configureGrpcRouters(
 	if err := v1pb.RegisterIssueServiceHandler(ctx, mux, grpcConn); err != nil {
 		return err
 	}
+	if err := v1pb.RegisterOperationServiceHandler(ctx, mux, grpcConn); err != nil {
+		return err
+	}
 	if err := v1pb.RegisterOrgPolicyServiceHandler(ctx, mux, grpcConn); err != nil {
 		return err
 	}
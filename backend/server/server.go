@@ -37,8 +37,11 @@ import (
 	v1pb "github.com/bytebase/bytebase/backend/generated-go/v1"
 	"github.com/bytebase/bytebase/backend/migrator"
 	"github.com/bytebase/bytebase/backend/resources/postgres"
+	"github.com/bytebase/bytebase/backend/runner/accessreview"
 	"github.com/bytebase/bytebase/backend/runner/approval"
+	"github.com/bytebase/bytebase/backend/runner/breakglass"
 	"github.com/bytebase/bytebase/backend/runner/cleaner"
+	"github.com/bytebase/bytebase/backend/runner/discovery"
 	"github.com/bytebase/bytebase/backend/runner/heartbeat"
 	"github.com/bytebase/bytebase/backend/runner/monitor"
 	"github.com/bytebase/bytebase/backend/runner/notifylistener"
@@ -67,6 +70,9 @@ type Server struct {
 	notifyListener     *notifylistener.Listener
 	dataCleaner        *cleaner.DataCleaner
 	heartbeatRunner    *heartbeat.Runner
+	discoveryRunner    *discovery.Runner
+	accessReviewRunner *accessreview.Runner
+	breakGlassRunner   *breakglass.Runner
 	runnerWG           sync.WaitGroup
 
 	webhookManager        *webhook.Manager
@@ -226,6 +232,7 @@ func NewServer(ctx context.Context, profile *config.Profile) (*Server, error) {
 	s.taskScheduler.Register(storepb.Task_DATABASE_CREATE, taskrun.NewDatabaseCreateExecutor(stores, s.dbFactory, s.schemaSyncer))
 	s.taskScheduler.Register(storepb.Task_DATABASE_MIGRATE, taskrun.NewDatabaseMigrateExecutor(stores, s.dbFactory, s.bus, s.schemaSyncer, profile))
 	s.taskScheduler.Register(storepb.Task_DATABASE_EXPORT, taskrun.NewDataExportExecutor(stores, s.dbFactory, s.licenseService))
+	s.taskScheduler.Register(storepb.Task_DATABASE_CLONE, taskrun.NewDatabaseCloneExecutor(stores, s.dbFactory, s.schemaSyncer, profile))
 
 	combinedExecutor := plancheck.NewCombinedExecutor(stores, sheetManager, s.dbFactory)
 	s.planCheckScheduler = plancheck.NewScheduler(stores, s.bus, combinedExecutor, s.licenseService)
@@ -237,6 +244,15 @@ func NewServer(ctx context.Context, profile *config.Profile) (*Server, error) {
 	// Heartbeat runner
 	s.heartbeatRunner = heartbeat.NewRunner(stores, profile)
 
+	// Sensitive data discovery runner
+	s.discoveryRunner = discovery.NewRunner(stores, s.dbFactory, s.licenseService)
+
+	// Access review runner
+	s.accessReviewRunner = accessreview.NewRunner(stores)
+
+	// Break-glass review runner
+	s.breakGlassRunner = breakglass.NewRunner(stores, s.webhookManager)
+
 	// LSP server.
 	s.lspServer = lsp.NewServer(s.store, profile, secret, s.bus, s.iamManager, s.licenseService)
 
@@ -279,6 +295,15 @@ func (s *Server) Run(ctx context.Context, port int) error {
 	s.runnerWG.Add(1)
 	go s.heartbeatRunner.Run(ctx, &s.runnerWG)
 
+	s.runnerWG.Add(1)
+	go s.discoveryRunner.Run(ctx, &s.runnerWG)
+
+	s.runnerWG.Add(1)
+	go s.accessReviewRunner.Run(ctx, &s.runnerWG)
+
+	s.runnerWG.Add(1)
+	go s.breakGlassRunner.Run(ctx, &s.runnerWG)
+
 	s.runnerWG.Add(1)
 	go s.notifyListener.Run(ctx, &s.runnerWG)
 
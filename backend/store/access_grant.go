@@ -383,6 +383,18 @@ func GetListAccessGrantFilter(filter string) (*qb.Query, error) {
 						return nil, errors.Errorf("target value must be a string")
 					}
 					return qb.Q().Space("access_grant.payload->'targets' @> jsonb_build_array(to_jsonb(?::text))", targetStr), nil
+				case "break_glass":
+					breakGlass, ok := value.(bool)
+					if !ok {
+						return nil, errors.Errorf("break_glass value must be a bool")
+					}
+					return qb.Q().Space("COALESCE((access_grant.payload->>'breakGlass')::boolean, FALSE) = ?", breakGlass), nil
+				case "has_issue":
+					hasIssue, ok := value.(bool)
+					if !ok {
+						return nil, errors.Errorf("has_issue value must be a bool")
+					}
+					return qb.Q().Space("jsonb_exists(access_grant.payload, 'issueId') = ?", hasIssue), nil
 				default:
 					return nil, errors.Errorf("unsupported variable %q", variable)
 				}
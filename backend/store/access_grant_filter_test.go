@@ -36,6 +36,26 @@ func TestGetListAccessGrantFilter(t *testing.T) {
 			wantArgs: []any{"%SELECT * FROM users%"},
 			wantErr:  false,
 		},
+		{
+			name:     "break glass",
+			filter:   `break_glass == true`,
+			wantSQL:  "(COALESCE((access_grant.payload->>'breakGlass')::boolean, FALSE) = $1)",
+			wantArgs: []any{true},
+			wantErr:  false,
+		},
+		{
+			name:        "break glass must be a bool",
+			filter:      `break_glass == "true"`,
+			wantErr:     true,
+			errContains: "break_glass value must be a bool",
+		},
+		{
+			name:     "has issue",
+			filter:   `has_issue == false`,
+			wantSQL:  "(jsonb_exists(access_grant.payload, 'issueId') = $1)",
+			wantArgs: []any{false},
+			wantErr:  false,
+		},
 		{
 			name:        "matches is unsupported",
 			filter:      `query.matches("SELECT")`,
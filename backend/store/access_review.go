@@ -0,0 +1,413 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/qb"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+// AccessReviewMessage is the message for an access review campaign.
+type AccessReviewMessage struct {
+	Workspace string
+	// Creator is the email of the creator.
+	Creator string
+	State   storepb.AccessReview_State
+	EndTime time.Time
+	Payload *storepb.AccessReviewPayload
+
+	// Output only.
+	ID        string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// AccessReviewEntryMessage is the message for an entry of an access review campaign.
+type AccessReviewEntryMessage struct {
+	ReviewID string
+	// ProjectID is empty for the workspace IAM policy bindings.
+	ProjectID string
+	Decision  storepb.AccessReviewEntry_Decision
+	Payload   *storepb.AccessReviewEntryPayload
+
+	// Output only.
+	ID        int64
+	UpdatedAt time.Time
+}
+
+// FindAccessReviewMessage is the message for finding access review campaigns.
+type FindAccessReviewMessage struct {
+	Workspace string
+	ID        *string
+	State     *storepb.AccessReview_State
+	// EndBefore finds the campaigns whose end time is before the time.
+	EndBefore *time.Time
+	Limit     *int
+	Offset    *int
+}
+
+// FindAccessReviewEntryMessage is the message for finding access review entries.
+type FindAccessReviewEntryMessage struct {
+	Workspace string
+	ReviewID  *string
+	ID        *int64
+	Decision  *storepb.AccessReviewEntry_Decision
+	Limit     *int
+	Offset    *int
+}
+
+// UpdateAccessReviewMessage is the message for updating an access review campaign.
+type UpdateAccessReviewMessage struct {
+	State   *storepb.AccessReview_State
+	Payload *storepb.AccessReviewPayload
+}
+
+// UpdateAccessReviewEntryMessage is the message for updating an access review entry.
+type UpdateAccessReviewEntryMessage struct {
+	Decision *storepb.AccessReviewEntry_Decision
+	Payload  *storepb.AccessReviewEntryPayload
+}
+
+// CreateAccessReview creates an access review campaign with its entries.
+func (s *Store) CreateAccessReview(ctx context.Context, create *AccessReviewMessage, entries []*AccessReviewEntryMessage) (*AccessReviewMessage, error) {
+	payload, err := protojson.Marshal(create.Payload)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to marshal payload")
+	}
+
+	tx, err := s.GetDB().BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	create.ID = uuid.NewString()
+	q := qb.Q().Space(`
+		INSERT INTO access_review (
+			id,
+			workspace,
+			creator,
+			state,
+			end_time,
+			payload
+		) VALUES (
+			?, ?, ?, ?, ?, ?
+		) RETURNING created_at, updated_at
+	`, create.ID, create.Workspace, create.Creator, create.State.String(), create.EndTime, payload)
+	query, args, err := q.ToSQL()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to build sql")
+	}
+	if err := tx.QueryRowContext(ctx, query, args...).Scan(&create.CreatedAt, &create.UpdatedAt); err != nil {
+		return nil, errors.Wrapf(err, "failed to insert access review")
+	}
+
+	for _, entry := range entries {
+		entryPayload, err := protojson.Marshal(entry.Payload)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to marshal entry payload")
+		}
+		q := qb.Q().Space(`
+			INSERT INTO access_review_entry (
+				review,
+				project,
+				decision,
+				payload
+			) VALUES (
+				?, ?, ?, ?
+			)
+		`, create.ID, entry.ProjectID, entry.Decision.String(), entryPayload)
+		query, args, err := q.ToSQL()
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to build sql")
+		}
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			return nil, errors.Wrapf(err, "failed to insert access review entry")
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "failed to commit transaction")
+	}
+	return create, nil
+}
+
+// GetAccessReview gets an access review campaign.
+func (s *Store) GetAccessReview(ctx context.Context, find *FindAccessReviewMessage) (*AccessReviewMessage, error) {
+	reviews, err := s.ListAccessReviews(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(reviews) == 0 {
+		return nil, nil
+	}
+	if len(reviews) > 1 {
+		return nil, errors.Errorf("expect to find one access review, found %d", len(reviews))
+	}
+	return reviews[0], nil
+}
+
+// ListAccessReviews lists access review campaigns.
+func (s *Store) ListAccessReviews(ctx context.Context, find *FindAccessReviewMessage) ([]*AccessReviewMessage, error) {
+	q := qb.Q().Space(`
+		SELECT
+			id,
+			workspace,
+			creator,
+			state,
+			end_time,
+			payload,
+			created_at,
+			updated_at
+		FROM access_review
+		WHERE TRUE
+	`)
+	if find.Workspace != "" {
+		q.And("workspace = ?", find.Workspace)
+	}
+	if v := find.ID; v != nil {
+		q.And("id = ?", *v)
+	}
+	if v := find.State; v != nil {
+		q.And("state = ?", v.String())
+	}
+	if v := find.EndBefore; v != nil {
+		q.And("end_time < ?", *v)
+	}
+	q.Space("ORDER BY created_at DESC")
+	if v := find.Limit; v != nil {
+		q.Space("LIMIT ?", *v)
+	}
+	if v := find.Offset; v != nil {
+		q.Space("OFFSET ?", *v)
+	}
+
+	query, args, err := q.ToSQL()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to build sql")
+	}
+	rows, err := s.GetDB().QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to select access reviews")
+	}
+	defer rows.Close()
+
+	var reviews []*AccessReviewMessage
+	for rows.Next() {
+		review := AccessReviewMessage{
+			Payload: &storepb.AccessReviewPayload{},
+		}
+		var state string
+		var payload []byte
+		if err := rows.Scan(
+			&review.ID,
+			&review.Workspace,
+			&review.Creator,
+			&state,
+			&review.EndTime,
+			&payload,
+			&review.CreatedAt,
+			&review.UpdatedAt,
+		); err != nil {
+			return nil, errors.Wrapf(err, "failed to scan access review")
+		}
+		stateValue, ok := storepb.AccessReview_State_value[state]
+		if !ok {
+			return nil, errors.Errorf("invalid access review state %q", state)
+		}
+		review.State = storepb.AccessReview_State(stateValue)
+		if err := common.ProtojsonUnmarshaler.Unmarshal(payload, review.Payload); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal payload")
+		}
+		reviews = append(reviews, &review)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrapf(err, "failed to iterate access reviews")
+	}
+	return reviews, nil
+}
+
+// UpdateAccessReview updates an access review campaign.
+func (s *Store) UpdateAccessReview(ctx context.Context, id string, update *UpdateAccessReviewMessage) error {
+	set := qb.Q()
+	if v := update.State; v != nil {
+		set.Comma("state = ?", v.String())
+	}
+	if v := update.Payload; v != nil {
+		p, err := protojson.Marshal(v)
+		if err != nil {
+			return errors.Wrapf(err, "failed to marshal payload")
+		}
+		set.Comma("payload = ?", p)
+	}
+	if set.Len() == 0 {
+		return errors.New("no update field provided")
+	}
+	set.Comma("updated_at = ?", time.Now())
+
+	query, args, err := qb.Q().Space("UPDATE access_review SET ? WHERE id = ?", set, id).ToSQL()
+	if err != nil {
+		return errors.Wrapf(err, "failed to build sql")
+	}
+	if _, err := s.GetDB().ExecContext(ctx, query, args...); err != nil {
+		return errors.Wrapf(err, "failed to update access review")
+	}
+	return nil
+}
+
+// GetAccessReviewEntry gets an access review entry.
+func (s *Store) GetAccessReviewEntry(ctx context.Context, find *FindAccessReviewEntryMessage) (*AccessReviewEntryMessage, error) {
+	entries, err := s.ListAccessReviewEntries(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(entries) == 0 {
+		return nil, nil
+	}
+	if len(entries) > 1 {
+		return nil, errors.Errorf("expect to find one access review entry, found %d", len(entries))
+	}
+	return entries[0], nil
+}
+
+// ListAccessReviewEntries lists access review entries.
+func (s *Store) ListAccessReviewEntries(ctx context.Context, find *FindAccessReviewEntryMessage) ([]*AccessReviewEntryMessage, error) {
+	q := qb.Q().Space(`
+		SELECT
+			id,
+			review,
+			project,
+			decision,
+			payload,
+			updated_at
+		FROM access_review_entry
+		WHERE TRUE
+	`)
+	if find.Workspace != "" {
+		q.And("review IN (SELECT id FROM access_review WHERE workspace = ?)", find.Workspace)
+	}
+	if v := find.ReviewID; v != nil {
+		q.And("review = ?", *v)
+	}
+	if v := find.ID; v != nil {
+		q.And("id = ?", *v)
+	}
+	if v := find.Decision; v != nil {
+		q.And("decision = ?", v.String())
+	}
+	q.Space("ORDER BY id")
+	if v := find.Limit; v != nil {
+		q.Space("LIMIT ?", *v)
+	}
+	if v := find.Offset; v != nil {
+		q.Space("OFFSET ?", *v)
+	}
+
+	query, args, err := q.ToSQL()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to build sql")
+	}
+	rows, err := s.GetDB().QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to select access review entries")
+	}
+	defer rows.Close()
+
+	var entries []*AccessReviewEntryMessage
+	for rows.Next() {
+		entry := AccessReviewEntryMessage{
+			Payload: &storepb.AccessReviewEntryPayload{},
+		}
+		var decision string
+		var payload []byte
+		if err := rows.Scan(
+			&entry.ID,
+			&entry.ReviewID,
+			&entry.ProjectID,
+			&decision,
+			&payload,
+			&entry.UpdatedAt,
+		); err != nil {
+			return nil, errors.Wrapf(err, "failed to scan access review entry")
+		}
+		decisionValue, ok := storepb.AccessReviewEntry_Decision_value[decision]
+		if !ok {
+			return nil, errors.Errorf("invalid access review entry decision %q", decision)
+		}
+		entry.Decision = storepb.AccessReviewEntry_Decision(decisionValue)
+		if err := common.ProtojsonUnmarshaler.Unmarshal(payload, entry.Payload); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal payload")
+		}
+		entries = append(entries, &entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrapf(err, "failed to iterate access review entries")
+	}
+	return entries, nil
+}
+
+// UpdateAccessReviewEntry updates an access review entry.
+func (s *Store) UpdateAccessReviewEntry(ctx context.Context, id int64, update *UpdateAccessReviewEntryMessage) error {
+	set := qb.Q()
+	if v := update.Decision; v != nil {
+		set.Comma("decision = ?", v.String())
+	}
+	if v := update.Payload; v != nil {
+		p, err := protojson.Marshal(v)
+		if err != nil {
+			return errors.Wrapf(err, "failed to marshal payload")
+		}
+		set.Comma("payload = ?", p)
+	}
+	if set.Len() == 0 {
+		return errors.New("no update field provided")
+	}
+	set.Comma("updated_at = ?", time.Now())
+
+	query, args, err := qb.Q().Space("UPDATE access_review_entry SET ? WHERE id = ?", set, id).ToSQL()
+	if err != nil {
+		return errors.Wrapf(err, "failed to build sql")
+	}
+	if _, err := s.GetDB().ExecContext(ctx, query, args...); err != nil {
+		return errors.Wrapf(err, "failed to update access review entry")
+	}
+	return nil
+}
+
+// CountAccessReviewEntries counts the entries of an access review campaign by decision.
+func (s *Store) CountAccessReviewEntries(ctx context.Context, reviewID string) (map[storepb.AccessReviewEntry_Decision]int, error) {
+	query, args, err := qb.Q().Space(`
+		SELECT decision, COUNT(*)
+		FROM access_review_entry
+		WHERE review = ?
+		GROUP BY decision
+	`, reviewID).ToSQL()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to build sql")
+	}
+	rows, err := s.GetDB().QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to count access review entries")
+	}
+	defer rows.Close()
+
+	counts := make(map[storepb.AccessReviewEntry_Decision]int)
+	for rows.Next() {
+		var decision string
+		var count int
+		if err := rows.Scan(&decision, &count); err != nil {
+			return nil, errors.Wrapf(err, "failed to scan access review entry count")
+		}
+		counts[storepb.AccessReviewEntry_Decision(storepb.AccessReviewEntry_Decision_value[decision])] = count
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrapf(err, "failed to iterate access review entry counts")
+	}
+	return counts, nil
+}
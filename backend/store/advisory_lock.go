@@ -19,6 +19,15 @@ const (
 	// AdvisoryLockKeySchemaSyncer is used by the schema syncer to ensure only
 	// one replica runs periodic schema sync at a time.
 	AdvisoryLockKeySchemaSyncer AdvisoryLockKey = 1003
+	// AdvisoryLockKeySensitiveDataDiscovery is used by the sensitive data discovery
+	// runner to ensure only one replica samples databases at a time.
+	AdvisoryLockKeySensitiveDataDiscovery AdvisoryLockKey = 1004
+	// AdvisoryLockKeyAccessReview is used by the access review runner to ensure only
+	// one replica completes the ended campaigns at a time.
+	AdvisoryLockKeyAccessReview AdvisoryLockKey = 1005
+	// AdvisoryLockKeyBreakGlassReview is used by the break-glass review runner to ensure only
+	// one replica creates the review issues of the ended break-glass access grants at a time.
+	AdvisoryLockKeyBreakGlassReview AdvisoryLockKey = 1006
 )
 
 // AdvisoryLock holds a dedicated connection for a session-level advisory lock.
@@ -177,6 +177,9 @@ func GetSearchAuditLogsFilter(filter string) (*qb.Query, error) {
 				}
 				switch variable {
 				case "resource", "method", "user", "severity":
+				case "ticket":
+					// The ticket is in the query justification of the SQL Editor requests.
+					return qb.Q().Space("(payload->>'request')::jsonb->'justification'->>'ticket' = ?", value), nil
 				default:
 					return nil, errors.Errorf("unknown variable %s", variable)
 				}
@@ -47,6 +47,13 @@ func TestGetSearchAuditLogsFilter_WithoutUserLookup(t *testing.T) {
 			wantArgs: []any{"bytebase.v1.ProjectService.GetProject"},
 			wantErr:  false,
 		},
+		{
+			name:     "ticket filter",
+			filter:   `ticket == "INC-123"`,
+			wantSQL:  "((payload->>'request')::jsonb->'justification'->>'ticket' = $1)",
+			wantArgs: []any{"INC-123"},
+			wantErr:  false,
+		},
 		{
 			name:     "severity filter",
 			filter:   `severity == "INFO"`,
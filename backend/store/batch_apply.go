@@ -0,0 +1,466 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/genproto/googleapis/type/expr"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/qb"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+// BatchApplyMessage is the message for applying projects, policies, settings, review configs
+// and database groups in a single transaction.
+type BatchApplyMessage struct {
+	Workspace string
+	// Settings are the settings to write.
+	Settings []*BatchApplySettingMessage
+	// RemovedEnvironments are the IDs of the environments removed by the ENVIRONMENT setting.
+	// They are unset from the instances and databases.
+	RemovedEnvironments []string
+	// Projects are the project patches.
+	Projects []*BatchApplyProjectMessage
+	// ReviewConfigs are the review configs to write.
+	ReviewConfigs []*BatchApplyReviewConfigMessage
+	// CreatePolicies are the policies to create. The policy must not exist.
+	CreatePolicies []*PolicyMessage
+	// UpdatePolicies are the policy patches. The Etag is required.
+	UpdatePolicies []*UpdatePolicyMessage
+	// DatabaseGroups are the database groups of the projects to replace.
+	DatabaseGroups []*BatchApplyDatabaseGroupsMessage
+}
+
+// BatchApplySettingMessage is the message for writing a setting in BatchApply.
+type BatchApplySettingMessage struct {
+	Setting *SettingMessage
+	// Base is the setting that the new one is built on, nil if the setting does not exist.
+	// The setting is only written if it has not changed since then.
+	Base *SettingMessage
+}
+
+// BatchApplyReviewConfigMessage is the message for writing a review config in BatchApply.
+type BatchApplyReviewConfigMessage struct {
+	ReviewConfig *ReviewConfigMessage
+	// Base is the review config that the new one is built on, nil to create the review config.
+	// The review config is only written if it has not changed since then.
+	Base *ReviewConfigMessage
+}
+
+// BatchApplyDatabaseGroupsMessage is the message for replacing the database groups of a project in BatchApply.
+type BatchApplyDatabaseGroupsMessage struct {
+	ProjectID      string
+	DatabaseGroups []*DatabaseGroupMessage
+	// Base is the database groups of the project that the new ones are built on.
+	// The database groups are only replaced if they have not changed since then.
+	Base []*DatabaseGroupMessage
+}
+
+// BatchApplyProjectMessage is the message for patching a project in BatchApply.
+type BatchApplyProjectMessage struct {
+	Patch *UpdateProjectMessage
+	// Base is the project state that the patch is built on.
+	// The patch is only applied if the project has not changed since then.
+	Base *ProjectMessage
+}
+
+// BatchApply applies the projects and policies in a single transaction.
+// It returns the name of the resource that has changed since the patch was built,
+// in which case nothing is applied.
+func (s *Store) BatchApply(ctx context.Context, apply *BatchApplyMessage) (string, error) {
+	tx, err := s.GetDB().BeginTx(ctx, nil)
+	if err != nil {
+		return "", err
+	}
+	defer tx.Rollback()
+
+	for _, setting := range apply.Settings {
+		ok, err := upsertBatchApplySetting(ctx, tx, apply.Workspace, setting)
+		if err != nil {
+			return "", err
+		}
+		if !ok {
+			return common.SettingNamePrefix + setting.Setting.Name.String(), nil
+		}
+	}
+	if len(apply.RemovedEnvironments) > 0 {
+		if err := unsetBatchApplyEnvironments(ctx, tx, apply.Workspace, apply.RemovedEnvironments); err != nil {
+			return "", err
+		}
+	}
+	for _, project := range apply.Projects {
+		ok, err := updateBatchApplyProject(ctx, tx, apply.Workspace, project)
+		if err != nil {
+			return "", err
+		}
+		if !ok {
+			return common.FormatProject(project.Base.ResourceID), nil
+		}
+	}
+	for _, reviewConfig := range apply.ReviewConfigs {
+		ok, err := upsertBatchApplyReviewConfig(ctx, tx, apply.Workspace, reviewConfig)
+		if err != nil {
+			return "", err
+		}
+		if !ok {
+			return common.FormatReviewConfig(reviewConfig.ReviewConfig.ID), nil
+		}
+	}
+	for _, create := range apply.CreatePolicies {
+		ok, err := createBatchApplyPolicy(ctx, tx, create)
+		if err != nil {
+			return "", err
+		}
+		if !ok {
+			return create.Resource, nil
+		}
+	}
+	for _, patch := range apply.UpdatePolicies {
+		if patch.Etag == nil {
+			return "", errors.Errorf("etag is required to update policy %s of %s", patch.Type, patch.Resource)
+		}
+		policy, err := updatePolicyImpl(ctx, tx, patch)
+		if err != nil {
+			return "", err
+		}
+		if policy == nil {
+			return patch.Resource, nil
+		}
+	}
+	for _, databaseGroups := range apply.DatabaseGroups {
+		ok, err := replaceBatchApplyDatabaseGroups(ctx, tx, apply.Workspace, databaseGroups)
+		if err != nil {
+			return "", err
+		}
+		if !ok {
+			return common.FormatProject(databaseGroups.ProjectID), nil
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return "", err
+	}
+
+	for _, setting := range apply.Settings {
+		s.settingCache.Remove(getSettingCacheKey(apply.Workspace, setting.Setting.Name))
+	}
+	if len(apply.RemovedEnvironments) > 0 {
+		s.instanceCache.Purge()
+		s.databaseCache.Purge()
+	}
+	for _, project := range apply.Projects {
+		s.removeProjectCache(project.Base.ResourceID)
+	}
+	for _, policy := range apply.CreatePolicies {
+		s.removeBatchApplyPolicyCache(policy.Workspace, policy.ResourceType, policy.Resource, policy.Type)
+	}
+	for _, patch := range apply.UpdatePolicies {
+		s.removeBatchApplyPolicyCache(patch.Workspace, patch.ResourceType, patch.Resource, patch.Type)
+	}
+	return "", nil
+}
+
+func (s *Store) removeBatchApplyPolicyCache(workspace string, resourceType storepb.Policy_Resource, resource string, policyType storepb.Policy_Type) {
+	s.policyCache.Remove(getPolicyCacheKey(workspace, resourceType, resource, policyType))
+	if policyType == storepb.Policy_IAM {
+		s.iamPolicyCache.Remove(getIamPolicyCacheKey(workspace, resourceType, resource))
+	}
+}
+
+// updateBatchApplyProject locks the project and updates it if it still matches the base.
+func updateBatchApplyProject(ctx context.Context, txn *sql.Tx, workspace string, project *BatchApplyProjectMessage) (bool, error) {
+	query, args, err := qb.Q().Space("SELECT name, setting FROM project WHERE resource_id = ? AND workspace = ? AND deleted = FALSE FOR UPDATE", project.Base.ResourceID, workspace).ToSQL()
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to build sql")
+	}
+	var title string
+	var payload []byte
+	if err := txn.QueryRowContext(ctx, query, args...).Scan(&title, &payload); err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, err
+	}
+	setting := &storepb.Project{}
+	if err := common.ProtojsonUnmarshaler.Unmarshal(payload, setting); err != nil {
+		return false, err
+	}
+	if title != project.Base.Title || !proto.Equal(setting, project.Base.Setting) {
+		return false, nil
+	}
+
+	set := qb.Q()
+	if v := project.Patch.Title; v != nil {
+		set.Comma("name = ?", *v)
+	}
+	if v := project.Patch.Setting; v != nil {
+		payload, err := protojson.Marshal(v)
+		if err != nil {
+			return false, err
+		}
+		set.Comma("setting = ?", payload)
+	}
+	if set.Len() == 0 {
+		return true, nil
+	}
+	query, args, err = qb.Q().Space("UPDATE project SET ? WHERE resource_id = ? AND workspace = ?", set, project.Base.ResourceID, workspace).ToSQL()
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to build sql")
+	}
+	if _, err := txn.ExecContext(ctx, query, args...); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// createBatchApplyPolicy creates the policy if it does not exist.
+func createBatchApplyPolicy(ctx context.Context, txn *sql.Tx, create *PolicyMessage) (bool, error) {
+	query, args, err := qb.Q().Space(`
+		INSERT INTO policy (
+			workspace,
+			resource_type,
+			resource,
+			inherit_from_parent,
+			type,
+			payload,
+			enforce,
+			updated_at
+		)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT(workspace, resource_type, resource, type) DO NOTHING
+	`,
+		create.Workspace,
+		create.ResourceType.String(),
+		create.Resource,
+		create.InheritFromParent,
+		create.Type.String(),
+		create.Payload,
+		create.Enforce,
+		time.Now(),
+	).ToSQL()
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to build sql")
+	}
+	result, err := txn.ExecContext(ctx, query, args...)
+	if err != nil {
+		return false, err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
+
+// upsertBatchApplySetting locks the setting and writes it if it still matches the base.
+func upsertBatchApplySetting(ctx context.Context, txn *sql.Tx, workspace string, setting *BatchApplySettingMessage) (bool, error) {
+	query, args, err := qb.Q().Space("SELECT value FROM setting WHERE name = ? AND workspace = ? FOR UPDATE", setting.Setting.Name.String(), workspace).ToSQL()
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to build sql")
+	}
+	var value []byte
+	if err := txn.QueryRowContext(ctx, query, args...).Scan(&value); err != nil {
+		if err != sql.ErrNoRows {
+			return false, err
+		}
+		if setting.Base != nil {
+			return false, nil
+		}
+	} else {
+		if setting.Base == nil {
+			return false, nil
+		}
+		current, err := getSettingMessage(setting.Setting.Name)
+		if err != nil {
+			return false, err
+		}
+		if err := common.ProtojsonUnmarshaler.Unmarshal(value, current); err != nil {
+			return false, err
+		}
+		if !proto.Equal(current, setting.Base.Value) {
+			return false, nil
+		}
+	}
+
+	payload, err := protojson.Marshal(setting.Setting.Value)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to marshal setting value")
+	}
+	query, args, err = qb.Q().Space(`
+		INSERT INTO setting (name, workspace, value)
+		VALUES (?, ?, ?)
+		ON CONFLICT (name, workspace) DO UPDATE SET value = EXCLUDED.value
+	`, setting.Setting.Name.String(), workspace, string(payload)).ToSQL()
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to build sql")
+	}
+	if _, err := txn.ExecContext(ctx, query, args...); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// unsetBatchApplyEnvironments unsets the removed environments from the instances and databases.
+func unsetBatchApplyEnvironments(ctx context.Context, txn *sql.Tx, workspace string, environments []string) error {
+	query, args, err := qb.Q().Space("UPDATE instance SET environment = NULL WHERE workspace = ? AND environment = ANY(?)", workspace, environments).ToSQL()
+	if err != nil {
+		return errors.Wrapf(err, "failed to build sql")
+	}
+	if _, err := txn.ExecContext(ctx, query, args...); err != nil {
+		return errors.Wrapf(err, "failed to unset environments for instances")
+	}
+	query, args, err = qb.Q().Space("UPDATE db SET environment = NULL WHERE environment = ANY(?) AND instance IN (SELECT resource_id FROM instance WHERE workspace = ?)", environments, workspace).ToSQL()
+	if err != nil {
+		return errors.Wrapf(err, "failed to build sql")
+	}
+	if _, err := txn.ExecContext(ctx, query, args...); err != nil {
+		return errors.Wrapf(err, "failed to unset environments for databases")
+	}
+	return nil
+}
+
+// upsertBatchApplyReviewConfig locks the review config and writes it if it still matches the base.
+func upsertBatchApplyReviewConfig(ctx context.Context, txn *sql.Tx, workspace string, reviewConfig *BatchApplyReviewConfigMessage) (bool, error) {
+	create := reviewConfig.ReviewConfig
+	payload, err := protojson.Marshal(create.Payload)
+	if err != nil {
+		return false, err
+	}
+	if reviewConfig.Base == nil {
+		query, args, err := qb.Q().Space(`
+			INSERT INTO review_config (id, workspace, enabled, name, payload)
+			VALUES (?, ?, ?, ?, ?)
+			ON CONFLICT DO NOTHING
+		`, create.ID, workspace, create.Enforce, create.Name, payload).ToSQL()
+		if err != nil {
+			return false, errors.Wrapf(err, "failed to build sql")
+		}
+		result, err := txn.ExecContext(ctx, query, args...)
+		if err != nil {
+			return false, err
+		}
+		rows, err := result.RowsAffected()
+		if err != nil {
+			return false, err
+		}
+		return rows > 0, nil
+	}
+
+	query, args, err := qb.Q().Space("SELECT enabled, name, payload FROM review_config WHERE id = ? AND workspace = ? FOR UPDATE", create.ID, workspace).ToSQL()
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to build sql")
+	}
+	var enabled bool
+	var name string
+	var currentPayload []byte
+	if err := txn.QueryRowContext(ctx, query, args...).Scan(&enabled, &name, &currentPayload); err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, err
+	}
+	current := &storepb.ReviewConfigPayload{}
+	if err := common.ProtojsonUnmarshaler.Unmarshal(currentPayload, current); err != nil {
+		return false, err
+	}
+	base := reviewConfig.Base
+	if enabled != base.Enforce || name != base.Name || !proto.Equal(current, base.Payload) {
+		return false, nil
+	}
+	query, args, err = qb.Q().Space("UPDATE review_config SET enabled = ?, name = ?, payload = ? WHERE id = ? AND workspace = ?", create.Enforce, create.Name, payload, create.ID, workspace).ToSQL()
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to build sql")
+	}
+	if _, err := txn.ExecContext(ctx, query, args...); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// replaceBatchApplyDatabaseGroups replaces the database groups of the project if they still match the base.
+func replaceBatchApplyDatabaseGroups(ctx context.Context, txn *sql.Tx, workspace string, databaseGroups *BatchApplyDatabaseGroupsMessage) (bool, error) {
+	// Lock the project to serialize the replacements of its database groups.
+	query, args, err := qb.Q().Space("SELECT resource_id FROM project WHERE resource_id = ? AND workspace = ? AND deleted = FALSE FOR UPDATE", databaseGroups.ProjectID, workspace).ToSQL()
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to build sql")
+	}
+	var projectID string
+	if err := txn.QueryRowContext(ctx, query, args...).Scan(&projectID); err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, err
+	}
+
+	query, args, err = qb.Q().Space("SELECT resource_id, name, expression FROM db_group WHERE project = ? FOR UPDATE", projectID).ToSQL()
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to build sql")
+	}
+	rows, err := txn.QueryContext(ctx, query, args...)
+	if err != nil {
+		return false, err
+	}
+	defer rows.Close()
+	current := map[string]*DatabaseGroupMessage{}
+	for rows.Next() {
+		group := &DatabaseGroupMessage{ProjectID: projectID}
+		var expression []byte
+		if err := rows.Scan(&group.ResourceID, &group.Title, &expression); err != nil {
+			return false, err
+		}
+		group.Expression = &expr.Expr{}
+		if err := common.ProtojsonUnmarshaler.Unmarshal(expression, group.Expression); err != nil {
+			return false, errors.Wrapf(err, "failed to unmarshal expression")
+		}
+		current[group.ResourceID] = group
+	}
+	if err := rows.Err(); err != nil {
+		return false, err
+	}
+	rows.Close()
+	if len(current) != len(databaseGroups.Base) {
+		return false, nil
+	}
+	for _, base := range databaseGroups.Base {
+		group, ok := current[base.ResourceID]
+		if !ok || group.Title != base.Title || !proto.Equal(group.Expression, base.Expression) {
+			return false, nil
+		}
+	}
+
+	// The empty array keeps none of the database groups.
+	keep := []string{}
+	for _, group := range databaseGroups.DatabaseGroups {
+		keep = append(keep, group.ResourceID)
+	}
+	query, args, err = qb.Q().Space("DELETE FROM db_group WHERE project = ? AND NOT (resource_id = ANY(?))", projectID, keep).ToSQL()
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to build sql")
+	}
+	if _, err := txn.ExecContext(ctx, query, args...); err != nil {
+		return false, err
+	}
+	for _, group := range databaseGroups.DatabaseGroups {
+		expression, err := protojson.Marshal(group.Expression)
+		if err != nil {
+			return false, errors.Wrapf(err, "failed to marshal expression")
+		}
+		query, args, err := qb.Q().Space(`
+			INSERT INTO db_group (project, resource_id, name, expression)
+			VALUES (?, ?, ?, ?)
+			ON CONFLICT (project, resource_id) DO UPDATE SET name = EXCLUDED.name, expression = EXCLUDED.expression
+		`, projectID, group.ResourceID, group.Title, expression).ToSQL()
+		if err != nil {
+			return false, errors.Wrapf(err, "failed to build sql")
+		}
+		if _, err := txn.ExecContext(ctx, query, args...); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,221 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/qb"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+// ClassificationSuggestionState is the review state of a classification suggestion.
+type ClassificationSuggestionState string
+
+const (
+	ClassificationSuggestionStatePending  ClassificationSuggestionState = "PENDING"
+	ClassificationSuggestionStateAccepted ClassificationSuggestionState = "ACCEPTED"
+	ClassificationSuggestionStateRejected ClassificationSuggestionState = "REJECTED"
+)
+
+// ClassificationSuggestionMessage is the message for a column classification proposed by sensitive data discovery.
+type ClassificationSuggestionMessage struct {
+	InstanceID     string
+	DatabaseName   string
+	Schema         string
+	Table          string
+	Column         string
+	Classification string
+	SemanticType   string
+	Payload        *storepb.ClassificationSuggestionPayload
+
+	// Output only fields
+	ResourceID string
+	State      ClassificationSuggestionState
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// FindClassificationSuggestionMessage is the message for finding classification suggestions.
+type FindClassificationSuggestionMessage struct {
+	InstanceID   string
+	DatabaseName string
+	ResourceIDs  []string
+	State        *ClassificationSuggestionState
+
+	Limit  *int
+	Offset *int
+}
+
+// UpsertClassificationSuggestion proposes a classification for a column.
+// Suggestions that have been reviewed are kept as is, so rejected columns are not proposed again.
+func (s *Store) UpsertClassificationSuggestion(ctx context.Context, upsert *ClassificationSuggestionMessage) error {
+	payload, err := protojson.Marshal(upsert.Payload)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal payload")
+	}
+
+	q := qb.Q().Space(`
+		INSERT INTO classification_suggestion (
+			instance,
+			db_name,
+			schema,
+			table_name,
+			column_name,
+			classification,
+			semantic_type,
+			payload
+		)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (instance, db_name, schema, table_name, column_name) DO UPDATE SET
+			classification = EXCLUDED.classification,
+			semantic_type = EXCLUDED.semantic_type,
+			payload = EXCLUDED.payload,
+			updated_at = now()
+		WHERE classification_suggestion.state = 'PENDING'
+	`, upsert.InstanceID, upsert.DatabaseName, upsert.Schema, upsert.Table, upsert.Column, upsert.Classification, upsert.SemanticType, payload)
+
+	query, args, err := q.ToSQL()
+	if err != nil {
+		return errors.Wrapf(err, "failed to build sql")
+	}
+	if _, err := s.GetDB().ExecContext(ctx, query, args...); err != nil {
+		return errors.Wrapf(err, "failed to upsert classification suggestion")
+	}
+	return nil
+}
+
+// ListClassificationSuggestions lists the classification suggestions of a database.
+func (s *Store) ListClassificationSuggestions(ctx context.Context, find *FindClassificationSuggestionMessage) ([]*ClassificationSuggestionMessage, error) {
+	q := qb.Q().Space(`
+		SELECT
+			resource_id,
+			instance,
+			db_name,
+			schema,
+			table_name,
+			column_name,
+			classification,
+			semantic_type,
+			state,
+			created_at,
+			updated_at,
+			payload
+		FROM classification_suggestion
+		WHERE instance = ? AND db_name = ?
+	`, find.InstanceID, find.DatabaseName)
+	if len(find.ResourceIDs) > 0 {
+		q.And("resource_id = ANY(?)", find.ResourceIDs)
+	}
+	if v := find.State; v != nil {
+		q.And("state = ?", string(*v))
+	}
+	q.Space("ORDER BY schema, table_name, column_name")
+	if v := find.Limit; v != nil {
+		q.Space("LIMIT ?", *v)
+	}
+	if v := find.Offset; v != nil {
+		q.Space("OFFSET ?", *v)
+	}
+
+	query, args, err := q.ToSQL()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to build sql")
+	}
+	rows, err := s.GetDB().QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list classification suggestions")
+	}
+	defer rows.Close()
+
+	var suggestions []*ClassificationSuggestionMessage
+	for rows.Next() {
+		suggestion, err := scanClassificationSuggestion(rows)
+		if err != nil {
+			return nil, err
+		}
+		suggestions = append(suggestions, suggestion)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrapf(err, "failed to scan rows")
+	}
+	return suggestions, nil
+}
+
+// ReviewClassificationSuggestions sets the state of the pending suggestions and returns the updated suggestions.
+func (s *Store) ReviewClassificationSuggestions(ctx context.Context, instanceID, databaseName string, resourceIDs []string, state ClassificationSuggestionState) ([]*ClassificationSuggestionMessage, error) {
+	q := qb.Q().Space(`
+		UPDATE classification_suggestion
+		SET state = ?, updated_at = now()
+		WHERE instance = ? AND db_name = ? AND resource_id = ANY(?) AND state = 'PENDING'
+		RETURNING
+			resource_id,
+			instance,
+			db_name,
+			schema,
+			table_name,
+			column_name,
+			classification,
+			semantic_type,
+			state,
+			created_at,
+			updated_at,
+			payload
+	`, string(state), instanceID, databaseName, resourceIDs)
+
+	query, args, err := q.ToSQL()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to build sql")
+	}
+	rows, err := s.GetDB().QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to review classification suggestions")
+	}
+	defer rows.Close()
+
+	var suggestions []*ClassificationSuggestionMessage
+	for rows.Next() {
+		suggestion, err := scanClassificationSuggestion(rows)
+		if err != nil {
+			return nil, err
+		}
+		suggestions = append(suggestions, suggestion)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrapf(err, "failed to scan rows")
+	}
+	return suggestions, nil
+}
+
+func scanClassificationSuggestion(rows *sql.Rows) (*ClassificationSuggestionMessage, error) {
+	var suggestion ClassificationSuggestionMessage
+	var state string
+	var payload []byte
+	if err := rows.Scan(
+		&suggestion.ResourceID,
+		&suggestion.InstanceID,
+		&suggestion.DatabaseName,
+		&suggestion.Schema,
+		&suggestion.Table,
+		&suggestion.Column,
+		&suggestion.Classification,
+		&suggestion.SemanticType,
+		&state,
+		&suggestion.CreatedAt,
+		&suggestion.UpdatedAt,
+		&payload,
+	); err != nil {
+		return nil, errors.Wrapf(err, "failed to scan classification suggestion")
+	}
+	suggestion.State = ClassificationSuggestionState(state)
+	suggestionPayload := &storepb.ClassificationSuggestionPayload{}
+	if err := common.ProtojsonUnmarshaler.Unmarshal(payload, suggestionPayload); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal payload")
+	}
+	suggestion.Payload = suggestionPayload
+	return &suggestion, nil
+}
@@ -0,0 +1,242 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/qb"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+// ColumnLineageOrigin is where a column lineage edge is recorded from.
+type ColumnLineageOrigin string
+
+const (
+	// ColumnLineageOriginChange is the lineage from the statements executed by rollouts.
+	ColumnLineageOriginChange ColumnLineageOrigin = "CHANGE"
+	// ColumnLineageOriginView is the lineage from the view definitions synced from the database.
+	ColumnLineageOriginView ColumnLineageOrigin = "VIEW"
+)
+
+// ColumnLineageColumn identifies a column in the lineage graph of an instance.
+type ColumnLineageColumn struct {
+	DatabaseName string
+	Schema       string
+	Table        string
+	Column       string
+}
+
+// ColumnLineageMessage is the message for a column lineage edge from a source column to a target column in the same instance.
+type ColumnLineageMessage struct {
+	InstanceID string
+	Target     ColumnLineageColumn
+	Source     ColumnLineageColumn
+	Origin     ColumnLineageOrigin
+	Payload    *storepb.ColumnLineagePayload
+
+	// Output only fields
+	UpdatedAt time.Time
+}
+
+// FindColumnLineageMessage is the message for finding column lineage edges.
+// Edges matching any of the targets or any of the sources are returned.
+type FindColumnLineageMessage struct {
+	InstanceID string
+	Targets    []ColumnLineageColumn
+	Sources    []ColumnLineageColumn
+}
+
+// UpsertColumnLineages records the column lineage edges.
+func (s *Store) UpsertColumnLineages(ctx context.Context, lineages []*ColumnLineageMessage) error {
+	if len(lineages) == 0 {
+		return nil
+	}
+	tx, err := s.GetDB().BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+	if err := upsertColumnLineagesTx(ctx, tx, lineages); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return errors.Wrap(err, "failed to commit transaction")
+	}
+	return nil
+}
+
+// ReplaceViewColumnLineages replaces the lineage of the views in a database with the lineages from the synced view definitions.
+// The edges to the tables that no longer exist in the database are removed as well.
+// The tables are the existing tables of the database in the "schema.table" format.
+func (s *Store) ReplaceViewColumnLineages(ctx context.Context, instanceID, databaseName string, tables []string, lineages []*ColumnLineageMessage) error {
+	if tables == nil {
+		tables = []string{}
+	}
+	tx, err := s.GetDB().BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	q := qb.Q().Space(`
+		DELETE FROM column_lineage
+		WHERE instance = ? AND db_name = ? AND NOT (schema || '.' || table_name = ANY(?))
+	`, instanceID, databaseName, tables)
+	query, args, err := q.ToSQL()
+	if err != nil {
+		return errors.Wrapf(err, "failed to build sql")
+	}
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return errors.Wrapf(err, "failed to delete column lineage")
+	}
+	if err := upsertColumnLineagesTx(ctx, tx, lineages); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return errors.Wrap(err, "failed to commit transaction")
+	}
+	return nil
+}
+
+func upsertColumnLineagesTx(ctx context.Context, tx *sql.Tx, lineages []*ColumnLineageMessage) error {
+	for _, lineage := range lineages {
+		payload, err := protojson.Marshal(lineage.Payload)
+		if err != nil {
+			return errors.Wrapf(err, "failed to marshal payload")
+		}
+		q := qb.Q().Space(`
+			INSERT INTO column_lineage (
+				instance,
+				db_name,
+				schema,
+				table_name,
+				column_name,
+				source_db_name,
+				source_schema,
+				source_table,
+				source_column,
+				origin,
+				payload
+			)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+			ON CONFLICT (instance, db_name, schema, table_name, column_name, source_db_name, source_schema, source_table, source_column) DO UPDATE SET
+				origin = EXCLUDED.origin,
+				payload = EXCLUDED.payload,
+				updated_at = now()
+		`,
+			lineage.InstanceID,
+			lineage.Target.DatabaseName,
+			lineage.Target.Schema,
+			lineage.Target.Table,
+			lineage.Target.Column,
+			lineage.Source.DatabaseName,
+			lineage.Source.Schema,
+			lineage.Source.Table,
+			lineage.Source.Column,
+			string(lineage.Origin),
+			payload,
+		)
+		query, args, err := q.ToSQL()
+		if err != nil {
+			return errors.Wrapf(err, "failed to build sql")
+		}
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			return errors.Wrapf(err, "failed to upsert column lineage")
+		}
+	}
+	return nil
+}
+
+// ListColumnLineages lists the column lineage edges of an instance.
+func (s *Store) ListColumnLineages(ctx context.Context, find *FindColumnLineageMessage) ([]*ColumnLineageMessage, error) {
+	if len(find.Targets) == 0 && len(find.Sources) == 0 {
+		return nil, nil
+	}
+	where := qb.Q()
+	if len(find.Targets) > 0 {
+		databases, schemas, tables, columns := splitColumnLineageColumns(find.Targets)
+		where.Or(`(db_name, schema, table_name, column_name) IN (SELECT * FROM unnest(?::TEXT[], ?::TEXT[], ?::TEXT[], ?::TEXT[]))`, databases, schemas, tables, columns)
+	}
+	if len(find.Sources) > 0 {
+		databases, schemas, tables, columns := splitColumnLineageColumns(find.Sources)
+		where.Or(`(source_db_name, source_schema, source_table, source_column) IN (SELECT * FROM unnest(?::TEXT[], ?::TEXT[], ?::TEXT[], ?::TEXT[]))`, databases, schemas, tables, columns)
+	}
+	q := qb.Q().Space(`
+		SELECT
+			instance,
+			db_name,
+			schema,
+			table_name,
+			column_name,
+			source_db_name,
+			source_schema,
+			source_table,
+			source_column,
+			origin,
+			updated_at,
+			payload
+		FROM column_lineage
+		WHERE instance = ? AND (?)
+		ORDER BY db_name, schema, table_name, column_name, source_db_name, source_schema, source_table, source_column
+	`, find.InstanceID, where)
+
+	query, args, err := q.ToSQL()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to build sql")
+	}
+	rows, err := s.GetDB().QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list column lineage")
+	}
+	defer rows.Close()
+
+	var lineages []*ColumnLineageMessage
+	for rows.Next() {
+		var lineage ColumnLineageMessage
+		var origin string
+		var payload []byte
+		if err := rows.Scan(
+			&lineage.InstanceID,
+			&lineage.Target.DatabaseName,
+			&lineage.Target.Schema,
+			&lineage.Target.Table,
+			&lineage.Target.Column,
+			&lineage.Source.DatabaseName,
+			&lineage.Source.Schema,
+			&lineage.Source.Table,
+			&lineage.Source.Column,
+			&origin,
+			&lineage.UpdatedAt,
+			&payload,
+		); err != nil {
+			return nil, errors.Wrapf(err, "failed to scan column lineage")
+		}
+		lineage.Origin = ColumnLineageOrigin(origin)
+		lineagePayload := &storepb.ColumnLineagePayload{}
+		if err := common.ProtojsonUnmarshaler.Unmarshal(payload, lineagePayload); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal payload")
+		}
+		lineage.Payload = lineagePayload
+		lineages = append(lineages, &lineage)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrapf(err, "failed to scan rows")
+	}
+	return lineages, nil
+}
+
+func splitColumnLineageColumns(columns []ColumnLineageColumn) ([]string, []string, []string, []string) {
+	var databases, schemas, tables, names []string
+	for _, column := range columns {
+		databases = append(databases, column.DatabaseName)
+		schemas = append(schemas, column.Schema)
+		tables = append(tables, column.Table)
+		names = append(names, column.Column)
+	}
+	return databases, schemas, tables, names
+}
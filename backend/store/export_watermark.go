@@ -0,0 +1,89 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/qb"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+// ExportWatermarkMessage is the record of an export.
+type ExportWatermarkMessage struct {
+	ID        string
+	Workspace string
+	Payload   *storepb.ExportWatermarkPayload
+
+	// Output only fields.
+	CreatedAt time.Time
+}
+
+// GetExportWatermark gets the record of an export in the workspace.
+func (s *Store) GetExportWatermark(ctx context.Context, workspaceID string, id string) (*ExportWatermarkMessage, error) {
+	q := qb.Q().Space(`
+		SELECT
+			id,
+			workspace,
+			created_at,
+			payload
+		FROM export_watermark
+		WHERE id = ?
+	`, id).And("workspace = ?", workspaceID)
+
+	query, args, err := q.ToSQL()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to build sql")
+	}
+
+	var watermark ExportWatermarkMessage
+	var payload []byte
+	if err := s.GetDB().QueryRowContext(ctx, query, args...).Scan(
+		&watermark.ID,
+		&watermark.Workspace,
+		&watermark.CreatedAt,
+		&payload,
+	); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	watermark.Payload = &storepb.ExportWatermarkPayload{}
+	if err := common.ProtojsonUnmarshaler.Unmarshal(payload, watermark.Payload); err != nil {
+		return nil, err
+	}
+	return &watermark, nil
+}
+
+// CreateExportWatermark records an export.
+func (s *Store) CreateExportWatermark(ctx context.Context, create *ExportWatermarkMessage) (*ExportWatermarkMessage, error) {
+	payload, err := protojson.Marshal(create.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	q := qb.Q().Space(`
+		INSERT INTO export_watermark (
+			id,
+			workspace,
+			payload
+		)
+		VALUES (?, ?, ?)
+		RETURNING created_at
+	`, create.ID, create.Workspace, payload)
+
+	query, args, err := q.ToSQL()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to build sql")
+	}
+
+	if err := s.GetDB().QueryRowContext(ctx, query, args...).Scan(&create.CreatedAt); err != nil {
+		return nil, err
+	}
+	return create, nil
+}
@@ -667,6 +667,30 @@ func (s *Store) DeleteInstance(ctx context.Context, workspace string, resourceID
 		return errors.Wrapf(err, "failed to delete revisions for instance %s", resourceID)
 	}
 
+	// Delete classification suggestions associated with this instance
+	q = qb.Q().Space(`
+		DELETE FROM classification_suggestion WHERE instance = ?
+	`, resourceID)
+	query, args, err = q.ToSQL()
+	if err != nil {
+		return errors.Wrapf(err, "failed to build sql")
+	}
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return errors.Wrapf(err, "failed to delete classification suggestions for instance %s", resourceID)
+	}
+
+	// Delete column lineage associated with this instance
+	q = qb.Q().Space(`
+		DELETE FROM column_lineage WHERE instance = ?
+	`, resourceID)
+	query, args, err = q.ToSQL()
+	if err != nil {
+		return errors.Wrapf(err, "failed to build sql")
+	}
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return errors.Wrapf(err, "failed to delete column lineage for instance %s", resourceID)
+	}
+
 	// Update worksheets to nullify instance and db_name references
 	q = qb.Q().Space(`
 		UPDATE worksheet
@@ -0,0 +1,247 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/qb"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+// OperationMessage is the message for a long-running operation.
+type OperationMessage struct {
+	Workspace string
+	// Creator is the email of the creator.
+	Creator string
+	// ReplicaID is the replica running the operation.
+	ReplicaID string
+	Payload   *storepb.OperationPayload
+
+	// Output only.
+	ID        int64
+	State     storepb.Operation_State
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// FindOperationMessage is the message for finding operations.
+type FindOperationMessage struct {
+	Workspace string
+	ID        *int64
+	Creator   *string
+	Limit     *int
+	Offset    *int
+}
+
+// UpdateOperationMessage is the message for updating an operation.
+type UpdateOperationMessage struct {
+	State   *storepb.Operation_State
+	Payload *storepb.OperationPayload
+}
+
+// CreateOperation creates a running operation.
+func (s *Store) CreateOperation(ctx context.Context, create *OperationMessage) (*OperationMessage, error) {
+	payload, err := protojson.Marshal(create.Payload)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to marshal payload")
+	}
+
+	q := qb.Q().Space(`
+		INSERT INTO operation (
+			workspace,
+			creator,
+			state,
+			replica_id,
+			payload
+		) VALUES (
+			?, ?, ?, ?, ?
+		) RETURNING id, created_at, updated_at
+	`, create.Workspace, create.Creator, storepb.Operation_RUNNING.String(), create.ReplicaID, payload)
+	query, args, err := q.ToSQL()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to build sql")
+	}
+	if err := s.GetDB().QueryRowContext(ctx, query, args...).Scan(&create.ID, &create.CreatedAt, &create.UpdatedAt); err != nil {
+		return nil, errors.Wrapf(err, "failed to insert operation")
+	}
+	create.State = storepb.Operation_RUNNING
+	return create, nil
+}
+
+// GetOperation gets an operation.
+func (s *Store) GetOperation(ctx context.Context, find *FindOperationMessage) (*OperationMessage, error) {
+	operations, err := s.ListOperations(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(operations) == 0 {
+		return nil, nil
+	}
+	if len(operations) > 1 {
+		return nil, errors.Errorf("expect to find one operation, found %d", len(operations))
+	}
+	return operations[0], nil
+}
+
+// ListOperations lists operations, newest first.
+func (s *Store) ListOperations(ctx context.Context, find *FindOperationMessage) ([]*OperationMessage, error) {
+	q := qb.Q().Space(`
+		SELECT
+			id,
+			workspace,
+			creator,
+			state,
+			COALESCE(replica_id, ''),
+			payload,
+			created_at,
+			updated_at
+		FROM operation
+		WHERE TRUE
+	`)
+	if find.Workspace != "" {
+		q.And("workspace = ?", find.Workspace)
+	}
+	if v := find.ID; v != nil {
+		q.And("id = ?", *v)
+	}
+	if v := find.Creator; v != nil {
+		q.And("creator = ?", *v)
+	}
+	q.Space("ORDER BY created_at DESC, id DESC")
+	if v := find.Limit; v != nil {
+		q.Space("LIMIT ?", *v)
+	}
+	if v := find.Offset; v != nil {
+		q.Space("OFFSET ?", *v)
+	}
+
+	query, args, err := q.ToSQL()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to build sql")
+	}
+	rows, err := s.GetDB().QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to select operations")
+	}
+	defer rows.Close()
+
+	var operations []*OperationMessage
+	for rows.Next() {
+		operation := OperationMessage{
+			Payload: &storepb.OperationPayload{},
+		}
+		var state string
+		var payload []byte
+		if err := rows.Scan(
+			&operation.ID,
+			&operation.Workspace,
+			&operation.Creator,
+			&state,
+			&operation.ReplicaID,
+			&payload,
+			&operation.CreatedAt,
+			&operation.UpdatedAt,
+		); err != nil {
+			return nil, errors.Wrapf(err, "failed to scan operation")
+		}
+		stateValue, ok := storepb.Operation_State_value[state]
+		if !ok {
+			return nil, errors.Errorf("invalid operation state %q", state)
+		}
+		operation.State = storepb.Operation_State(stateValue)
+		if err := common.ProtojsonUnmarshaler.Unmarshal(payload, operation.Payload); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal payload")
+		}
+		operations = append(operations, &operation)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrapf(err, "failed to iterate operations")
+	}
+	return operations, nil
+}
+
+// UpdateOperation updates a running operation.
+// It returns false if the operation is not running anymore, so a finished operation is never overwritten.
+func (s *Store) UpdateOperation(ctx context.Context, id int64, update *UpdateOperationMessage) (bool, error) {
+	set := qb.Q()
+	if v := update.State; v != nil {
+		set.Comma("state = ?", v.String())
+	}
+	if v := update.Payload; v != nil {
+		p, err := protojson.Marshal(v)
+		if err != nil {
+			return false, errors.Wrapf(err, "failed to marshal payload")
+		}
+		set.Comma("payload = ?", p)
+	}
+	if set.Len() == 0 {
+		return false, errors.New("no update field provided")
+	}
+	set.Comma("updated_at = ?", time.Now())
+
+	query, args, err := qb.Q().Space("UPDATE operation SET ? WHERE id = ? AND state = ?", set, id, storepb.Operation_RUNNING.String()).ToSQL()
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to build sql")
+	}
+	result, err := s.GetDB().ExecContext(ctx, query, args...)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to update operation")
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to get rows affected")
+	}
+	return rowsAffected > 0, nil
+}
+
+// FailStaleOperations marks the running operations whose replica stopped sending heartbeats as failed.
+func (s *Store) FailStaleOperations(ctx context.Context, stalenessThreshold time.Duration) (int64, error) {
+	q := qb.Q().Space(`
+		UPDATE operation
+		SET state = ?,
+		    payload = payload || '{"errorCode": 10, "error": "Operation abandoned: owning replica stopped responding"}',
+		    updated_at = now()
+		WHERE state = ?
+		  AND replica_id IS NOT NULL
+		  AND NOT EXISTS (
+		    SELECT 1 FROM replica_heartbeat rh
+		    WHERE rh.replica_id = operation.replica_id
+		      AND rh.last_heartbeat >= now() - ?::INTERVAL
+		  )
+	`, storepb.Operation_FAILED.String(), storepb.Operation_RUNNING.String(), stalenessThreshold.String())
+
+	query, args, err := q.ToSQL()
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to build sql")
+	}
+
+	result, err := s.GetDB().ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to fail stale operations")
+	}
+	return result.RowsAffected()
+}
+
+// DeleteExpiredOperations deletes the finished operations not updated within the retention period.
+func (s *Store) DeleteExpiredOperations(ctx context.Context, retentionPeriod time.Duration) (int64, error) {
+	q := qb.Q().Space(`
+		DELETE FROM operation
+		WHERE state <> ?
+		  AND updated_at < now() - ?::INTERVAL
+	`, storepb.Operation_RUNNING.String(), retentionPeriod.String())
+
+	query, args, err := q.ToSQL()
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to build sql")
+	}
+
+	result, err := s.GetDB().ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to delete expired operations")
+	}
+	return result.RowsAffected()
+}
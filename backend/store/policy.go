@@ -7,6 +7,7 @@ import (
 	"log/slog"
 	"math"
 	"slices"
+	"strconv"
 	"time"
 
 	"github.com/pkg/errors"
@@ -199,6 +200,9 @@ type EffectiveQueryDataPolicy struct {
 	DisableExport            bool
 	MaxQueryTimeoutInSeconds int64
 	AllowAdminDataSource     bool
+	// JustificationClassificationLevel is the classification level at or above which queries require a justification.
+	// Zero means no justification is required.
+	JustificationClassificationLevel int32
 }
 
 func formatEffectiveQueryDataPolicy(policy *storepb.QueryDataPolicy) *EffectiveQueryDataPolicy {
@@ -208,10 +212,11 @@ func formatEffectiveQueryDataPolicy(policy *storepb.QueryDataPolicy) *EffectiveQ
 	}
 
 	return &EffectiveQueryDataPolicy{
-		MaximumResultRows:    maximumResultRows,
-		DisableCopyData:      policy.GetDisableCopyData(),
-		DisableExport:        policy.GetDisableExport(),
-		AllowAdminDataSource: policy.GetAllowAdminDataSource(),
+		MaximumResultRows:                maximumResultRows,
+		DisableCopyData:                  policy.GetDisableCopyData(),
+		DisableExport:                    policy.GetDisableExport(),
+		AllowAdminDataSource:             policy.GetAllowAdminDataSource(),
+		JustificationClassificationLevel: max(policy.GetJustificationClassificationLevel(), 0),
 	}
 }
 
@@ -246,9 +251,25 @@ func (s *Store) GetEffectiveQueryDataPolicy(ctx context.Context, workspaceID str
 		MaximumResultSize:        maximumResultSize,
 		MaxQueryTimeoutInSeconds: queryTimeout,
 		AllowAdminDataSource:     formatWorkspacePolicy.AllowAdminDataSource,
+		JustificationClassificationLevel: getStricterClassificationLevel(
+			formatWorkspacePolicy.JustificationClassificationLevel,
+			formatProjectPolicy.JustificationClassificationLevel,
+		),
 	}, nil
 }
 
+// getStricterClassificationLevel returns the lower positive classification level, which covers more data.
+// Zero means not set.
+func getStricterClassificationLevel(a, b int32) int32 {
+	if a == 0 {
+		return b
+	}
+	if b == 0 {
+		return a
+	}
+	return min(a, b)
+}
+
 func (s *Store) getQueryDataPolicy(ctx context.Context, workspaceID string, resource string) (*storepb.QueryDataPolicy, error) {
 	resourceType, _, err := common.GetPolicyResourceTypeAndResource(resource)
 	if err != nil {
@@ -401,6 +422,31 @@ func (s *Store) GetMaskingExemptionPolicyByProject(ctx context.Context, workspac
 	return p, nil
 }
 
+// GetRowFilterPolicyByProject gets the row filter policy for a project.
+// Row filters of a policy that is not enforced are not returned.
+func (s *Store) GetRowFilterPolicyByProject(ctx context.Context, workspaceID string, projectID string) (*storepb.RowFilterPolicy, error) {
+	policy, err := s.GetPolicy(ctx, &FindPolicyMessage{
+		Workspace:    workspaceID,
+		ResourceType: new(storepb.Policy_PROJECT),
+		Resource:     new(common.FormatProject(projectID)),
+		Type:         new(storepb.Policy_ROW_FILTER),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if policy == nil || !policy.Enforce {
+		return &storepb.RowFilterPolicy{}, nil
+	}
+
+	p := new(storepb.RowFilterPolicy)
+	if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(policy.Payload), p); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
 // PolicyMessage is the mssage for policy.
 type PolicyMessage struct {
 	Workspace         string
@@ -414,6 +460,11 @@ type PolicyMessage struct {
 	UpdatedAt time.Time
 }
 
+// GetEtag returns the etag of the policy.
+func (p *PolicyMessage) GetEtag() string {
+	return generateEtag(p.UpdatedAt)
+}
+
 // FindPolicyMessage is the message for finding policies.
 type FindPolicyMessage struct {
 	Workspace    string
@@ -433,6 +484,9 @@ type UpdatePolicyMessage struct {
 	InheritFromParent *bool
 	Payload           *string
 	Enforce           *bool
+	// Etag, if set, only updates the policy if it has not changed since the etag was read.
+	// The update returns nil if the etag does not match.
+	Etag *string
 }
 
 // GetPolicy gets a policy.
@@ -575,6 +629,34 @@ func (s *Store) CreatePolicy(ctx context.Context, create *PolicyMessage) (*Polic
 
 // UpdatePolicy updates the policy.
 func (s *Store) UpdatePolicy(ctx context.Context, patch *UpdatePolicyMessage) (*PolicyMessage, error) {
+	tx, err := s.GetDB().BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	policy, err := updatePolicyImpl(ctx, tx, patch)
+	if err != nil {
+		return nil, err
+	}
+	if policy == nil {
+		return nil, nil
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	s.policyCache.Add(getPolicyCacheKey(patch.Workspace, patch.ResourceType, patch.Resource, patch.Type), policy)
+	if patch.Type == storepb.Policy_IAM {
+		s.iamPolicyCache.Remove(getIamPolicyCacheKey(patch.Workspace, patch.ResourceType, patch.Resource))
+	}
+
+	return policy, nil
+}
+
+// updatePolicyImpl updates the policy, and returns nil if the policy is not found or the etag does not match.
+func updatePolicyImpl(ctx context.Context, txn *sql.Tx, patch *UpdatePolicyMessage) (*PolicyMessage, error) {
 	set := qb.Q()
 	set.Comma("updated_at = ?", time.Now())
 	if v := patch.InheritFromParent; v != nil {
@@ -587,7 +669,16 @@ func (s *Store) UpdatePolicy(ctx context.Context, patch *UpdatePolicyMessage) (*
 		set.Comma("enforce = ?", *v)
 	}
 
-	query, args, err := qb.Q().Space("UPDATE policy SET ? WHERE resource_type = ? AND resource = ? AND type = ? AND workspace = ? RETURNING payload, inherit_from_parent, enforce, updated_at", set, patch.ResourceType, patch.Resource, patch.Type.String(), patch.Workspace).ToSQL()
+	where := qb.Q().Space("resource_type = ? AND resource = ? AND type = ? AND workspace = ?", patch.ResourceType, patch.Resource, patch.Type.String(), patch.Workspace)
+	if v := patch.Etag; v != nil {
+		updatedAt, err := strconv.ParseInt(*v, 10, 64)
+		if err != nil {
+			return nil, errors.Errorf("invalid etag %q", *v)
+		}
+		// The etag is the update time in milliseconds, see generateEtag.
+		where.And("FLOOR(EXTRACT(EPOCH FROM updated_at) * 1000)::BIGINT = ?", updatedAt)
+	}
+	query, args, err := qb.Q().Space("UPDATE policy SET ? WHERE ? RETURNING payload, inherit_from_parent, enforce, updated_at", set, where).ToSQL()
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to build sql")
 	}
@@ -599,7 +690,7 @@ func (s *Store) UpdatePolicy(ctx context.Context, patch *UpdatePolicyMessage) (*
 		Type:         patch.Type,
 	}
 
-	if err := s.GetDB().QueryRowContext(ctx, query, args...).Scan(
+	if err := txn.QueryRowContext(ctx, query, args...).Scan(
 		&policy.Payload,
 		&policy.InheritFromParent,
 		&policy.Enforce,
@@ -610,12 +701,6 @@ func (s *Store) UpdatePolicy(ctx context.Context, patch *UpdatePolicyMessage) (*
 		}
 		return nil, err
 	}
-
-	s.policyCache.Add(getPolicyCacheKey(patch.Workspace, patch.ResourceType, patch.Resource, patch.Type), policy)
-	if patch.Type == storepb.Policy_IAM {
-		s.iamPolicyCache.Remove(getIamPolicyCacheKey(patch.Workspace, patch.ResourceType, patch.Resource))
-	}
-
 	return policy, nil
 }
 
@@ -32,10 +32,16 @@ var PredefinedRoles = []*RoleMessage{
 		Predefined: true,
 		Permissions: permissionSet(
 			permission.AccessGrantsActivate,
+			permission.AccessGrantsBreakGlass,
 			permission.AccessGrantsCreate,
 			permission.AccessGrantsGet,
 			permission.AccessGrantsList,
 			permission.AccessGrantsRevoke,
+			permission.AccessReviewsCreate,
+			permission.AccessReviewsExport,
+			permission.AccessReviewsGet,
+			permission.AccessReviewsList,
+			permission.AccessReviewsUpdate,
 			permission.AuditLogsExport,
 			permission.AuditLogsSearch,
 			permission.ChangelogsGet,
@@ -180,10 +186,14 @@ var PredefinedRoles = []*RoleMessage{
 		Predefined: true,
 		Permissions: permissionSet(
 			permission.AccessGrantsActivate,
+			permission.AccessGrantsBreakGlass,
 			permission.AccessGrantsCreate,
 			permission.AccessGrantsGet,
 			permission.AccessGrantsList,
 			permission.AccessGrantsRevoke,
+			permission.AccessReviewsExport,
+			permission.AccessReviewsGet,
+			permission.AccessReviewsList,
 			permission.AuditLogsExport,
 			permission.AuditLogsSearch,
 			permission.ChangelogsGet,
@@ -332,6 +342,7 @@ var PredefinedRoles = []*RoleMessage{
 		Predefined: true,
 		Permissions: permissionSet(
 			permission.AccessGrantsActivate,
+			permission.AccessGrantsBreakGlass,
 			permission.AccessGrantsCreate,
 			permission.AccessGrantsGet,
 			permission.AccessGrantsList,
@@ -741,6 +741,84 @@ func (s *Store) UpdateUserEmail(ctx context.Context, user *UserMessage, newEmail
 	}
 	rows.Close()
 
+	// 3c. Update ROW_FILTER policy filters
+	rowFilterPolicySQL := `
+		UPDATE policy
+		SET payload = (
+			SELECT jsonb_set(
+				policy.payload,
+				'{filters}',
+				COALESCE(
+					(
+						SELECT jsonb_agg(
+							CASE
+								WHEN $1 = ANY(SELECT jsonb_array_elements_text(filter->'members')) THEN
+									jsonb_set(
+										filter,
+										'{members}',
+										COALESCE(
+											(
+												SELECT jsonb_agg(
+													CASE WHEN member = $1 THEN $2::text ELSE member END
+												)
+												FROM jsonb_array_elements_text(filter->'members') AS member
+											),
+											'[]'::jsonb
+										)
+									)
+								ELSE filter
+							END
+						)
+						FROM jsonb_array_elements(policy.payload->'filters') AS filter
+					),
+					'[]'::jsonb
+				)
+			)
+		)
+		WHERE type = $3
+		  AND payload ? 'filters'
+		  AND EXISTS (
+			  SELECT 1
+			  FROM jsonb_array_elements(payload->'filters') AS filter,
+			       jsonb_array_elements_text(filter->'members') AS member
+			  WHERE member = $1
+		  )
+		RETURNING workspace, resource_type, resource, type`
+
+	rows, err = tx.QueryContext(ctx, rowFilterPolicySQL, oldUserRef, newUserRef, storepb.Policy_ROW_FILTER.String())
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to update ROW_FILTER policies")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var workspace, resourceTypeStr, resource, typeStr string
+		if err := rows.Scan(&workspace, &resourceTypeStr, &resource, &typeStr); err != nil {
+			return nil, errors.Wrapf(err, "failed to scan updated ROW_FILTER policy")
+		}
+
+		var invalidation struct {
+			Workspace    string
+			ResourceType storepb.Policy_Resource
+			Resource     string
+			Type         storepb.Policy_Type
+		}
+		invalidation.Workspace = workspace
+		invalidation.Resource = resource
+
+		if val, ok := storepb.Policy_Resource_value[resourceTypeStr]; ok {
+			invalidation.ResourceType = storepb.Policy_Resource(val)
+		}
+		if val, ok := storepb.Policy_Type_value[typeStr]; ok {
+			invalidation.Type = storepb.Policy_Type(val)
+		}
+		invalidatedPolicies = append(invalidatedPolicies, invalidation)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
 	// 4. Update User Groups
 	// Update user_group.payload to replace old user reference with new one in members array.
 	// Members are stored as GroupMember objects with member field in "users/{email}" format.
@@ -53,6 +53,8 @@ type FindQueryHistoryMessage struct {
 	// Database is database resource name like instances/{instance}/databases/{database}.
 	Database *string
 	Type     *QueryHistoryType
+	// AccessGrantID is the ID of the access grant that authorized the queries.
+	AccessGrantID *string
 
 	Limit   *int
 	Offset  *int
@@ -136,6 +138,9 @@ func (s *Store) ListQueryHistories(ctx context.Context, find *FindQueryHistoryMe
 	if v := find.Type; v != nil {
 		q.And("query_history.type = ?", *v)
 	}
+	if v := find.AccessGrantID; v != nil {
+		q.And("query_history.payload->>'accessGrantId' = ?", *v)
+	}
 
 	q.Space("ORDER BY created_at DESC")
 	if v := find.Limit; v != nil {
@@ -221,6 +226,14 @@ func GetListQueryHistoryFilter(filter string) (*qb.Query, error) {
 			return qb.Q().Space("query_history.type = ?", historyType), nil
 		case "statement":
 			return qb.Q().Space("query_history.statement LIKE ?", value), nil
+		case "access_grant":
+			_, accessGrantID, err := common.GetProjectIDAccessGrantID(value.(string))
+			if err != nil {
+				return nil, errors.Errorf("invalid access grant filter %q", value)
+			}
+			return qb.Q().Space("query_history.payload->>'accessGrantId' = ?", accessGrantID), nil
+		case "ticket":
+			return qb.Q().Space("query_history.payload->>'ticket' = ?", value), nil
 		default:
 			return nil, errors.Errorf("unsupport variable %q", variable)
 		}
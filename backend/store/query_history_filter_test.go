@@ -57,6 +57,20 @@ func TestGetListQueryHistoryFilter(t *testing.T) {
 			wantArgs: []any{"SELECT * FROM users"},
 			wantErr:  false,
 		},
+		{
+			name:     "access grant",
+			filter:   `access_grant == "projects/test-project/accessGrants/grant-1"`,
+			wantSQL:  "(query_history.payload->>'accessGrantId' = $1)",
+			wantArgs: []any{"grant-1"},
+			wantErr:  false,
+		},
+		{
+			name:     "ticket",
+			filter:   `ticket == "INC-123"`,
+			wantSQL:  "(query_history.payload->>'ticket' = $1)",
+			wantArgs: []any{"INC-123"},
+			wantErr:  false,
+		},
 		{
 			name:     "statement contains operator",
 			filter:   `statement.contains("SELECT")`,
@@ -0,0 +1,121 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/qb"
+	storepb "github.com/bytebase/bytebase/backend/generated-go/store"
+)
+
+// QueryResultShareMessage is the message for a shared query result snapshot.
+type QueryResultShareMessage struct {
+	ProjectID  string
+	Creator    string
+	ExpireTime time.Time
+	// Database is the database resource name, like instances/{instance}/databases/{database}
+	Database  string
+	Statement string
+	// Bytes are the results before masking.
+	Bytes   []byte
+	Payload *storepb.QueryResultSharePayload
+
+	// Output only fields
+	ResourceID string
+	CreatedAt  time.Time
+}
+
+// FindQueryResultShareMessage is the message for finding a query result share.
+type FindQueryResultShareMessage struct {
+	// Workspace filters shares by the parent project's workspace.
+	Workspace  string
+	ProjectID  string
+	ResourceID string
+}
+
+// CreateQueryResultShare creates a query result share.
+func (s *Store) CreateQueryResultShare(ctx context.Context, create *QueryResultShareMessage) (*QueryResultShareMessage, error) {
+	payload, err := protojson.Marshal(create.Payload)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to marshal payload")
+	}
+
+	q := qb.Q().Space(`
+		INSERT INTO query_result_share (
+			project,
+			creator,
+			expire_time,
+			database,
+			statement,
+			bytes,
+			payload
+		)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+		RETURNING resource_id, created_at
+	`, create.ProjectID, create.Creator, create.ExpireTime, create.Database, create.Statement, create.Bytes, payload)
+
+	query, args, err := q.ToSQL()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to build sql")
+	}
+	if err := s.GetDB().QueryRowContext(ctx, query, args...).Scan(&create.ResourceID, &create.CreatedAt); err != nil {
+		return nil, errors.Wrapf(err, "failed to insert query result share")
+	}
+	return create, nil
+}
+
+// GetQueryResultShare gets a query result share. Expired shares are not returned.
+func (s *Store) GetQueryResultShare(ctx context.Context, find *FindQueryResultShareMessage) (*QueryResultShareMessage, error) {
+	q := qb.Q().Space(`
+		SELECT
+			resource_id,
+			project,
+			creator,
+			created_at,
+			expire_time,
+			database,
+			statement,
+			bytes,
+			payload
+		FROM query_result_share
+		WHERE resource_id = ?
+	`, find.ResourceID).
+		And("project = ?", find.ProjectID).
+		And("project IN (SELECT resource_id FROM project WHERE workspace = ?)", find.Workspace).
+		And("expire_time > ?", time.Now())
+
+	query, args, err := q.ToSQL()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to build sql")
+	}
+
+	var share QueryResultShareMessage
+	var payload []byte
+	if err := s.GetDB().QueryRowContext(ctx, query, args...).Scan(
+		&share.ResourceID,
+		&share.ProjectID,
+		&share.Creator,
+		&share.CreatedAt,
+		&share.ExpireTime,
+		&share.Database,
+		&share.Statement,
+		&share.Bytes,
+		&payload,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to get query result share")
+	}
+	sharePayload := &storepb.QueryResultSharePayload{}
+	if err := common.ProtojsonUnmarshaler.Unmarshal(payload, sharePayload); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal payload")
+	}
+	share.Payload = sharePayload
+	return &share, nil
+}
@@ -114,6 +114,8 @@ func convertV1ToStoreTaskType(taskType v1pb.Task_Type) storepb.Task_Type {
 		return storepb.Task_DATABASE_MIGRATE
 	case v1pb.Task_DATABASE_EXPORT:
 		return storepb.Task_DATABASE_EXPORT
+	case v1pb.Task_DATABASE_CLONE:
+		return storepb.Task_DATABASE_CLONE
 	case v1pb.Task_TYPE_UNSPECIFIED, v1pb.Task_GENERAL:
 		return storepb.Task_TASK_TYPE_UNSPECIFIED
 	default:
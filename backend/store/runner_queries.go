@@ -182,6 +182,21 @@ func (s *Store) ListAllInstances(ctx context.Context, showDeleted bool) ([]*Inst
 	return instances, nil
 }
 
+// DeleteExpiredQueryResultSharesAll deletes expired query result shares across all workspaces.
+// For use by the cleaner runner.
+func (s *Store) DeleteExpiredQueryResultSharesAll(ctx context.Context) (int64, error) {
+	q := qb.Q().Space("DELETE FROM query_result_share WHERE expire_time < ?", time.Now())
+	query, args, err := q.ToSQL()
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to build sql")
+	}
+	result, err := s.GetDB().ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 // DeleteExpiredExportArchivesAll deletes expired export archives across all workspaces.
 // For use by the cleaner runner.
 func (s *Store) DeleteExpiredExportArchivesAll(ctx context.Context, retentionPeriod time.Duration) (int64, error) {
@@ -45,6 +45,7 @@ type WorkSheetMessage struct {
 	Title      string
 	Statement  string
 	Visibility WorkSheetVisibility
+	Payload    *storepb.WorksheetPayload
 
 	// Output only fields
 	Size      int64
@@ -76,6 +77,7 @@ type PatchWorkSheetMessage struct {
 	Visibility   *string
 	InstanceID   *string
 	DatabaseName *string
+	Payload      *storepb.WorksheetPayload
 }
 
 // GetWorkSheet gets a sheet.
@@ -117,6 +119,7 @@ func (s *Store) ListWorkSheets(ctx context.Context, find *FindWorkSheetMessage)
 			worksheet.name,
 			%s,
 			worksheet.visibility,
+			worksheet.payload,
 			OCTET_LENGTH(worksheet.statement),
 			COALESCE(worksheet_organizer.payload, '{}')
 		FROM worksheet
@@ -152,7 +155,7 @@ func (s *Store) ListWorkSheets(ctx context.Context, find *FindWorkSheetMessage)
 	for rows.Next() {
 		var sheet WorkSheetMessage
 		var instanceID, databaseName sql.NullString
-		var payloadBytes []byte
+		var worksheetPayloadBytes, payloadBytes []byte
 		if err := rows.Scan(
 			&sheet.ResourceID,
 			&sheet.Creator,
@@ -164,6 +167,7 @@ func (s *Store) ListWorkSheets(ctx context.Context, find *FindWorkSheetMessage)
 			&sheet.Title,
 			&sheet.Statement,
 			&sheet.Visibility,
+			&worksheetPayloadBytes,
 			&sheet.Size,
 			&payloadBytes,
 		); err != nil {
@@ -176,6 +180,12 @@ func (s *Store) ListWorkSheets(ctx context.Context, find *FindWorkSheetMessage)
 			sheet.DatabaseName = &databaseName.String
 		}
 
+		worksheetPayload := &storepb.WorksheetPayload{}
+		if err := common.ProtojsonUnmarshaler.Unmarshal(worksheetPayloadBytes, worksheetPayload); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal worksheet payload")
+		}
+		sheet.Payload = worksheetPayload
+
 		var payload storepb.WorkSheetOrganizerPayload
 		if err := common.ProtojsonUnmarshaler.Unmarshal(payloadBytes, &payload); err != nil {
 			return nil, errors.Wrapf(err, "failed to unmarshal worksheet organizer payload")
@@ -194,6 +204,14 @@ func (s *Store) ListWorkSheets(ctx context.Context, find *FindWorkSheetMessage)
 
 // CreateWorkSheet creates a new sheet.
 func (s *Store) CreateWorkSheet(ctx context.Context, create *WorkSheetMessage) (*WorkSheetMessage, error) {
+	if create.Payload == nil {
+		create.Payload = &storepb.WorksheetPayload{}
+	}
+	payload, err := protojson.Marshal(create.Payload)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to marshal worksheet payload")
+	}
+
 	q := qb.Q().Space(`
 		INSERT INTO worksheet (
 			creator,
@@ -205,9 +223,9 @@ func (s *Store) CreateWorkSheet(ctx context.Context, create *WorkSheetMessage) (
 			visibility,
 			payload
 		)
-		VALUES (?, ?, ?, ?, ?, ?, ?, '{}')
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
 		RETURNING resource_id, created_at, updated_at, OCTET_LENGTH(statement)
-	`, create.Creator, create.ProjectID, create.InstanceID, create.DatabaseName, create.Title, create.Statement, create.Visibility)
+	`, create.Creator, create.ProjectID, create.InstanceID, create.DatabaseName, create.Title, create.Statement, create.Visibility, payload)
 
 	query, args, err := q.ToSQL()
 	if err != nil {
@@ -242,6 +260,13 @@ func (s *Store) PatchWorkSheet(ctx context.Context, patch *PatchWorkSheetMessage
 	if v := patch.Visibility; v != nil {
 		set.Comma("visibility = ?", *v)
 	}
+	if v := patch.Payload; v != nil {
+		payload, err := protojson.Marshal(v)
+		if err != nil {
+			return errors.Wrapf(err, "failed to marshal worksheet payload")
+		}
+		set.Comma("payload = ?", payload)
+	}
 	if v := patch.InstanceID; v != nil {
 		if *v == "" {
 			set.Comma("instance = ?", nil)